@@ -197,6 +197,11 @@ type ClusterDeploymentSpec struct {
 	// provision AWS clusters to use Amazon's Security Token Service.
 	// +optional
 	BoundServiceAccountSignkingKeySecretRef *corev1.LocalObjectReference `json:"boundServiceAccountSigningKeySecretRef,omitempty"`
+
+	// Upgrade is an upgrade of the installed cluster to a new release, which Hive starts once the cluster passes the
+	// pre-flight checks within a maintenance window.
+	// +optional
+	Upgrade *ClusterUpgrade `json:"upgrade,omitempty"`
 }
 
 // ClusterInstallLocalReference provides reference to an object that implements
@@ -259,6 +264,67 @@ type Provisioning struct {
 	// additional features of the installer.
 	// +optional
 	InstallerEnv []corev1.EnvVar `json:"installerEnv,omitempty"`
+
+	// ResumeInterruptedInstall enables resuming installs whose install pod was interrupted, e.g. evicted, after the
+	// installer generated its assets. The installer state is saved as the install progresses, to the object store
+	// configured for install logs if any, otherwise to a Secret, and the provision that replaces the interrupted one
+	// continues the install from that state rather than cleaning up the resources of the interrupted install and
+	// starting over. Installs that fail are still cleaned up.
+	// +optional
+	ResumeInterruptedInstall bool `json:"resumeInterruptedInstall,omitempty"`
+
+	// PostInstallValidation configures checks of the installed cluster that must pass before the cluster is
+	// considered provisioned. A provision whose checks do not pass within the timeout is failed, and retried like
+	// any other failed provision. Clusters installed through a ClusterInstallRef are not validated, as their
+	// ClusterInstall decides when they are installed.
+	// +optional
+	PostInstallValidation *PostInstallValidation `json:"postInstallValidation,omitempty"`
+}
+
+// PostInstallValidation configures checks of a newly installed cluster.
+type PostInstallValidation struct {
+	// ClusterOperatorsAvailable requires all ClusterOperators of the cluster to be Available and not Degraded.
+	// +optional
+	ClusterOperatorsAvailable bool `json:"clusterOperatorsAvailable,omitempty"`
+
+	// MachinePoolNodes requires the cluster to have at least as many Ready compute nodes as the MachinePools of the
+	// ClusterDeployment ask for. Autoscaled MachinePools count with their minimum number of replicas.
+	// +optional
+	MachinePoolNodes bool `json:"machinePoolNodes,omitempty"`
+
+	// Job is a Job to run on the cluster, which must complete successfully.
+	// +optional
+	Job *PostInstallValidationJob `json:"job,omitempty"`
+
+	// Timeout is how long the checks may take to pass after the installer completed. Defaults to 30m.
+	// This is a Duration value; see https://pkg.go.dev/time#ParseDuration for accepted formats.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// PostInstallValidationJob is a Job run on a newly installed cluster to validate it.
+type PostInstallValidationJob struct {
+	// Namespace is the namespace of the cluster the Job is created in. It is created if it does not exist.
+	// Defaults to "default".
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Image is the image of the container of the Job.
+	Image string `json:"image"`
+
+	// Command is the entrypoint of the container of the Job. The entrypoint of the image is used if not set.
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// Args are the arguments of the entrypoint.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// ServiceAccountName is the name of the service account in the namespace of the Job to run the Job as.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
 }
 
 // ClusterImageSetReference is a reference to a ClusterImageSet
@@ -374,6 +440,10 @@ type ClusterDeploymentStatus struct {
 	// perform the installation.
 	// +optional
 	Platform *PlatformStatus `json:"platformStatus,omitempty"`
+
+	// Upgrade is the status of the upgrade of the cluster requested in the spec.
+	// +optional
+	Upgrade *ClusterUpgradeStatus `json:"upgrade,omitempty"`
 }
 
 // ClusterDeploymentCondition contains details for the current condition of a cluster deployment
@@ -461,6 +531,10 @@ const (
 	// DeprovisionLaunchErrorCondition is set when a cluster deprovision fails to launch.
 	DeprovisionLaunchErrorCondition ClusterDeploymentConditionType = "DeprovisionLaunchError"
 
+	// PostInstallValidationFailedCondition is set when the checks of the post install validation of the cluster did
+	// not pass within the timeout, failing the provision.
+	PostInstallValidationFailedCondition ClusterDeploymentConditionType = "PostInstallValidationFailed"
+
 	// ProvisionStoppedCondition is set when cluster provisioning is stopped.
 	// This indicates that at least one provision attempt was made, but there will be no further
 	// retries (without InstallAttemptsLimit changes or other hive configuration stopping further retries).
@@ -577,6 +651,8 @@ const (
 	ProvisionedReasonProvisioning = "Provisioning"
 	// ProvisionedReasonProvisionStopped means cluster provisioning is stopped. The ProvisionStopped condition may contain more detail.
 	ProvisionedReasonProvisionStopped = "ProvisionStopped"
+	// ProvisionedReasonValidating is set while the installed cluster is checked by its post install validation.
+	ProvisionedReasonValidating = "Validating"
 	// ProvisionedReasonProvisioned is set when the provision is successful.
 	ProvisionedReasonProvisioned = "Provisioned"
 	// ProvisionedReasonDeprovisioning is set when we start to deprovision the cluster.
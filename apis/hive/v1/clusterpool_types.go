@@ -81,6 +81,11 @@ type ClusterPoolSpec struct {
 	// +optional
 	InstallAttemptsLimit *int32 `json:"installAttemptsLimit,omitempty"`
 
+	// PostInstallValidation will be applied to new ClusterDeployments created for the pool. Clusters are only added
+	// to the pool once its checks pass.
+	// +optional
+	PostInstallValidation *PostInstallValidation `json:"postInstallValidation,omitempty"`
+
 	// SkipMachinePools allows creating clusterpools where the machinepools are not managed by hive after cluster creation
 	// +optional
 	SkipMachinePools bool `json:"skipMachinePools,omitempty"`
@@ -55,6 +55,11 @@ type ClusterProvisionSpec struct {
 
 	// PrevProvisionName is the name of the previous failed provision attempt.
 	PrevProvisionName *string `json:"prevProvisionName,omitempty"`
+
+	// ExcludedZones are availability zones in which this provision attempt does not place machines, because earlier
+	// attempts failed in them with a failure whose retry policy changes zones on retry.
+	// +optional
+	ExcludedZones []string `json:"excludedZones,omitempty"`
 }
 
 // ClusterProvisionStatus defines the observed state of ClusterProvision.
@@ -65,6 +70,105 @@ type ClusterProvisionStatus struct {
 	// Conditions includes more detailed status for the cluster provision
 	// +optional
 	Conditions []ClusterProvisionCondition `json:"conditions,omitempty"`
+
+	// LogURLs are the locations of the logs of this provision that were uploaded to object storage, either because
+	// the provision failed or because archiving of all provision attempts is enabled.
+	// See HiveConfig.Spec.FailedProvisionConfig.
+	// +optional
+	LogURLs []string `json:"logURLs,omitempty"`
+
+	// FailureClassification is the classification of the failure of this provision, determined from the install log
+	// by the matchers of the install-log-regexes ConfigMaps.
+	// +optional
+	FailureClassification *ProvisionFailureClassification `json:"failureClassification,omitempty"`
+
+	// Milestones are the milestones of the install reached by this provision, in the order they were reached, as
+	// observed in the installer log.
+	// +optional
+	Milestones []ProvisionMilestone `json:"milestones,omitempty"`
+}
+
+// ProvisionMilestone is a milestone of the install reached by a provision.
+type ProvisionMilestone struct {
+	// Name is the name of the milestone.
+	Name ProvisionMilestoneName `json:"name"`
+
+	// Time is when the milestone was reached.
+	Time metav1.Time `json:"time"`
+}
+
+// ProvisionMilestoneName is the name of a milestone of the install.
+// +kubebuilder:validation:Enum=InfrastructureCreated;APIUp;BootstrapComplete;InstallComplete
+type ProvisionMilestoneName string
+
+const (
+	// ProvisionMilestoneInfrastructureCreated is reached when the installer has created the infrastructure of the
+	// cluster and starts waiting for the Kubernetes API.
+	ProvisionMilestoneInfrastructureCreated ProvisionMilestoneName = "InfrastructureCreated"
+	// ProvisionMilestoneAPIUp is reached when the Kubernetes API of the cluster responds.
+	ProvisionMilestoneAPIUp ProvisionMilestoneName = "APIUp"
+	// ProvisionMilestoneBootstrapComplete is reached when the control plane no longer needs the bootstrap node.
+	ProvisionMilestoneBootstrapComplete ProvisionMilestoneName = "BootstrapComplete"
+	// ProvisionMilestoneInstallComplete is reached when the cluster has initialized.
+	ProvisionMilestoneInstallComplete ProvisionMilestoneName = "InstallComplete"
+)
+
+// ProvisionFailureClassification classifies the failure of a provision.
+type ProvisionFailureClassification struct {
+	// Matcher is the name of the install log matcher that classified the failure. Empty if no matcher matched.
+	// +optional
+	Matcher string `json:"matcher,omitempty"`
+
+	// Category is the broad category of the failure.
+	Category ProvisionFailureCategory `json:"category"`
+
+	// Zone is the availability zone the failure was attributed to, as captured by the "zone" named group of the
+	// search regex that matched.
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// ConsecutiveFailures is the number of consecutive provision attempts of the ClusterDeployment, including this
+	// one, that failed with the same reason.
+	ConsecutiveFailures int32 `json:"consecutiveFailures"`
+
+	// RetryPolicy is the retry policy of the matcher that classified the failure.
+	// +optional
+	RetryPolicy *ProvisionRetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// ProvisionFailureCategory is the broad category of a provision failure.
+// +kubebuilder:validation:Enum=UserError;Infrastructure;Quota;Unknown
+type ProvisionFailureCategory string
+
+const (
+	// ProvisionFailureCategoryUserError indicates a failure caused by the configuration of the cluster or of the
+	// cloud account, which is unlikely to go away without user intervention.
+	ProvisionFailureCategoryUserError ProvisionFailureCategory = "UserError"
+	// ProvisionFailureCategoryInfrastructure indicates a failure of the cloud provider or of the installer, which is
+	// likely to be transient.
+	ProvisionFailureCategoryInfrastructure ProvisionFailureCategory = "Infrastructure"
+	// ProvisionFailureCategoryQuota indicates a failure caused by a quota or limit of the cloud account.
+	ProvisionFailureCategoryQuota ProvisionFailureCategory = "Quota"
+	// ProvisionFailureCategoryUnknown indicates a failure that could not be classified.
+	ProvisionFailureCategoryUnknown ProvisionFailureCategory = "Unknown"
+)
+
+// ProvisionRetryPolicy controls how provisions that failed for a particular reason are retried.
+type ProvisionRetryPolicy struct {
+	// MaxRetries is the maximum number of consecutive times a provision that failed for this reason is retried.
+	// Zero means it is not retried. If unset, retries are limited only by the InstallAttemptsLimit of the
+	// ClusterDeployment.
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+
+	// Backoff is how long to wait after the failure before retrying.
+	// +optional
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+
+	// ChangeZoneOnRetry excludes the zone the failure was attributed to from the following provision attempts.
+	// This only has an effect when the matcher captures the zone, and is supported on AWS, GCP and Azure.
+	// +optional
+	ChangeZoneOnRetry bool `json:"changeZoneOnRetry,omitempty"`
 }
 
 // ClusterProvisionStage is the stage of provisioning.
@@ -129,6 +233,10 @@ const (
 
 	// InstallPodStuckCondition is set when the install pod is stuck
 	InstallPodStuckCondition ClusterProvisionConditionType = "InstallPodStuck"
+
+	// InstallStateSaveFailedCondition is set when the install state of an install that is to be resumed if interrupted
+	// could not be saved.
+	InstallStateSaveFailedCondition ClusterProvisionConditionType = "InstallStateSaveFailed"
 )
 
 // +genclient
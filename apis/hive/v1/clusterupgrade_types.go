@@ -0,0 +1,114 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterUpgrade is an upgrade of an installed cluster to a new release. Once the cluster passes the pre-flight
+// checks and a maintenance window is open, Hive sets the channel and desired update of the cluster's ClusterVersion,
+// and reports the progress of the upgrade in the status of the ClusterDeployment.
+type ClusterUpgrade struct {
+	// Channel is the update channel to set on the cluster's ClusterVersion. The channel of the cluster is left as it
+	// is if unset.
+	// +optional
+	Channel string `json:"channel,omitempty"`
+
+	// Version is the version to upgrade the cluster to. Unless Image is also set, it must be one of the updates
+	// available to the cluster in its channel.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Image is the release image to upgrade the cluster to. It takes precedence over Version, and allows upgrading
+	// the cluster to a release which is not one of its available updates.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Force skips the pre-flight checks and asks the cluster version operator to skip its verification of the
+	// release and its Upgradeable condition.
+	// +optional
+	Force bool `json:"force,omitempty"`
+
+	// MaintenanceWindows are the recurring periods of time in which the upgrade may be started. An upgrade that was
+	// started is not interrupted when its window closes. The upgrade may be started at any time if there are none.
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+}
+
+// MaintenanceWindow is a recurring period of time.
+type MaintenanceWindow struct {
+	// Days are the days of the week on which the window opens. The window opens every day if unset.
+	// +optional
+	Days []Weekday `json:"days,omitempty"`
+
+	// StartTime is the time of day, in UTC, at which the window opens, formatted as HH:MM.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	StartTime string `json:"startTime"`
+
+	// Duration is how long the window stays open.
+	// This is a Duration value; see https://pkg.go.dev/time#ParseDuration for accepted formats.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	Duration metav1.Duration `json:"duration"`
+}
+
+// Weekday is a day of the week.
+// +kubebuilder:validation:Enum=Sunday;Monday;Tuesday;Wednesday;Thursday;Friday;Saturday
+type Weekday string
+
+// ClusterUpgradePhase is the phase of the upgrade of a cluster.
+// +kubebuilder:validation:Enum=Pending;Blocked;Progressing;Failing;Completed
+type ClusterUpgradePhase string
+
+const (
+	// PendingClusterUpgradePhase indicates that the upgrade is waiting for a maintenance window to open.
+	PendingClusterUpgradePhase ClusterUpgradePhase = "Pending"
+
+	// BlockedClusterUpgradePhase indicates that the cluster does not pass the pre-flight checks of the upgrade.
+	// The checks are repeated until they pass.
+	BlockedClusterUpgradePhase ClusterUpgradePhase = "Blocked"
+
+	// ProgressingClusterUpgradePhase indicates that the cluster is upgrading.
+	ProgressingClusterUpgradePhase ClusterUpgradePhase = "Progressing"
+
+	// FailingClusterUpgradePhase indicates that the cluster version operator reports that the upgrade is failing.
+	// The cluster version operator keeps trying to complete the upgrade.
+	FailingClusterUpgradePhase ClusterUpgradePhase = "Failing"
+
+	// CompletedClusterUpgradePhase indicates that the cluster has completed the upgrade.
+	CompletedClusterUpgradePhase ClusterUpgradePhase = "Completed"
+)
+
+// ClusterUpgradeStatus is the status of the upgrade of a cluster.
+type ClusterUpgradeStatus struct {
+	// Phase is the phase of the upgrade.
+	Phase ClusterUpgradePhase `json:"phase"`
+
+	// Version is the version the cluster is being upgraded to, as requested in the spec of the ClusterDeployment.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Image is the release image the cluster is being upgraded to, as requested in the spec of the
+	// ClusterDeployment.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// StartedTime is the time Hive set the desired update of the cluster.
+	// +optional
+	StartedTime *metav1.Time `json:"startedTime,omitempty"`
+
+	// CompletionTime is the time the cluster completed the upgrade.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// NextMaintenanceWindow is the time the next maintenance window opens, while the upgrade is pending.
+	// +optional
+	NextMaintenanceWindow *metav1.Time `json:"nextMaintenanceWindow,omitempty"`
+
+	// Message is a human-readable description of the state of the upgrade.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the last time the phase of the upgrade changed.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
@@ -0,0 +1,147 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// FinalizerClusterUpgradeRollout is used on ClusterUpgradeRollouts to release the ClusterDeployments they have
+	// upgraded when they are deleted.
+	FinalizerClusterUpgradeRollout = "hive.openshift.io/cluster-upgrade-rollout"
+)
+
+// ClusterUpgradeRolloutSpec defines an upgrade of the clusters selected by each of a sequence of waves.
+type ClusterUpgradeRolloutSpec struct {
+	// Upgrade is the upgrade set on the ClusterDeployments of the clusters in the waves of the rollout.
+	Upgrade ClusterUpgrade `json:"upgrade"`
+
+	// Waves are the groups of clusters to upgrade, in order. The upgrade of the clusters in a wave is started once
+	// every cluster in the previous wave has completed the upgrade. A cluster that is selected by more than one wave
+	// is upgraded in the first of them.
+	// +kubebuilder:validation:MinItems=1
+	Waves []ClusterUpgradeWave `json:"waves"`
+}
+
+// ClusterUpgradeWave is a group of clusters upgraded together.
+type ClusterUpgradeWave struct {
+	// Name is the name of the wave.
+	Name string `json:"name"`
+
+	// ClusterDeploymentSelector is a LabelSelector indicating which clusters in any namespace are in the wave.
+	ClusterDeploymentSelector metav1.LabelSelector `json:"clusterDeploymentSelector"`
+
+	// MaxConcurrent is the maximum number of clusters in the wave that are upgrading at the same time. All of the
+	// clusters in the wave are upgraded at once if unset.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrent *int32 `json:"maxConcurrent,omitempty"`
+
+	// SoakTime is how long every cluster in the wave must have completed the upgrade before the next wave is started.
+	// This is a Duration value; see https://pkg.go.dev/time#ParseDuration for accepted formats.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	// +optional
+	SoakTime *metav1.Duration `json:"soakTime,omitempty"`
+}
+
+// ClusterUpgradeRolloutPhase is the phase of a ClusterUpgradeRollout.
+// +kubebuilder:validation:Enum=Progressing;Complete
+type ClusterUpgradeRolloutPhase string
+
+const (
+	// ProgressingClusterUpgradeRolloutPhase indicates that the clusters are being upgraded in waves.
+	ProgressingClusterUpgradeRolloutPhase ClusterUpgradeRolloutPhase = "Progressing"
+
+	// CompleteClusterUpgradeRolloutPhase indicates that every cluster in every wave has completed the upgrade.
+	CompleteClusterUpgradeRolloutPhase ClusterUpgradeRolloutPhase = "Complete"
+)
+
+// ClusterUpgradeRolloutStatus defines the observed state of a ClusterUpgradeRollout.
+type ClusterUpgradeRolloutStatus struct {
+	// ObservedGeneration is the generation of the ClusterUpgradeRollout being rolled out. The rollout starts over
+	// from the first wave when the ClusterUpgradeRollout is changed.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Phase is the phase of the rollout.
+	// +optional
+	Phase ClusterUpgradeRolloutPhase `json:"phase,omitempty"`
+
+	// CurrentWave is the index of the wave being upgraded.
+	// +optional
+	CurrentWave int32 `json:"currentWave,omitempty"`
+
+	// Waves are the status of each of the waves that have been started.
+	// +optional
+	Waves []ClusterUpgradeWaveStatus `json:"waves,omitempty"`
+
+	// Message is a human-readable description of the state of the rollout.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the last time the phase or wave of the rollout changed.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// ClusterUpgradeWaveStatus is the status of a wave of a ClusterUpgradeRollout.
+type ClusterUpgradeWaveStatus struct {
+	// Name is the name of the wave.
+	Name string `json:"name"`
+
+	// TotalClusters is the number of clusters in the wave.
+	TotalClusters int32 `json:"totalClusters"`
+
+	// UpgradingClusters is the number of clusters in the wave whose upgrade was started and has not completed.
+	UpgradingClusters int32 `json:"upgradingClusters"`
+
+	// BlockedClusters is the number of upgrading clusters that do not pass the pre-flight checks or whose upgrade is
+	// failing.
+	BlockedClusters int32 `json:"blockedClusters"`
+
+	// CompletedClusters is the number of clusters in the wave that have completed the upgrade.
+	CompletedClusters int32 `json:"completedClusters"`
+
+	// StartTime is the time the wave was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time every cluster in the wave had completed the upgrade. It is cleared whenever a
+	// cluster in the wave has not.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterUpgradeRollout upgrades the clusters selected by a sequence of waves, one wave after another.
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Wave",type="integer",JSONPath=".status.currentWave"
+// +kubebuilder:resource:scope=Cluster
+type ClusterUpgradeRollout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterUpgradeRolloutSpec   `json:"spec,omitempty"`
+	Status ClusterUpgradeRolloutStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterUpgradeRolloutList contains a list of ClusterUpgradeRollouts
+type ClusterUpgradeRolloutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterUpgradeRollout `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&ClusterUpgradeRollout{},
+		&ClusterUpgradeRolloutList{},
+	)
+}
@@ -46,6 +46,76 @@ type DNSZoneSpec struct {
 	// Azure specifes Azure-specific cloud configuration
 	// +optional
 	Azure *AzureDNSZoneSpec `json:"azure,omitempty"`
+
+	// IBMCloud specifies IBM Cloud-specific cloud configuration
+	// +optional
+	IBMCloud *IBMCloudDNSZoneSpec `json:"ibmcloud,omitempty"`
+
+	// AlibabaCloud specifies Alibaba Cloud-specific cloud configuration
+	// +optional
+	AlibabaCloud *AlibabaCloudDNSZoneSpec `json:"alibabacloud,omitempty"`
+
+	// RFC2136 specifies the configuration for managing the zone on a DNS server, such as BIND, with RFC 2136
+	// dynamic updates. The zone must already exist on the DNS server.
+	// +optional
+	RFC2136 *RFC2136DNSZoneSpec `json:"rfc2136,omitempty"`
+
+	// DNSSEC enables DNSSEC signing of the zone. When LinkToParentDomain is set, the DS records for the
+	// zone are published in the parent domain alongside the NS records.
+	// DNSSEC is only supported for zones in AWS and GCP.
+	// +optional
+	DNSSEC *DNSSECSpec `json:"dnssec,omitempty"`
+
+	// Records is a list of records to manage in the zone. Records that already exist in the zone are not
+	// modified unless Hive created them, and Hive only deletes the records that it created.
+	// Records are only supported for zones in AWS and GCP, and for zones managed with RFC 2136.
+	// +optional
+	Records []DNSRecord `json:"records,omitempty"`
+}
+
+// DNSRecord is a record managed in a DNSZone
+type DNSRecord struct {
+	// Name is the name of the record relative to the zone, such as "*.apps" or "_acme-challenge".
+	// Use "@" for the apex of the zone.
+	Name string `json:"name"`
+
+	// Type is the type of the record.
+	Type DNSRecordType `json:"type"`
+
+	// TTL is the time to live of the record in seconds.
+	// This defaults to 60.
+	// +optional
+	TTL int64 `json:"ttl,omitempty"`
+
+	// Values is the list of values of the record. A CNAME record must have exactly one value.
+	// The values of a TXT record are given without quotes.
+	Values []string `json:"values"`
+}
+
+// DNSRecordType is the type of a record managed in a DNSZone.
+// +kubebuilder:validation:Enum=A;AAAA;CNAME;TXT
+type DNSRecordType string
+
+const (
+	DNSRecordTypeA     DNSRecordType = "A"
+	DNSRecordTypeAAAA  DNSRecordType = "AAAA"
+	DNSRecordTypeCNAME DNSRecordType = "CNAME"
+	DNSRecordTypeTXT   DNSRecordType = "TXT"
+)
+
+// DNSSECSpec contains the configuration for DNSSEC signing of a DNSZone
+type DNSSECSpec struct {
+	// AWS contains the AWS-specific DNSSEC configuration. Required when the zone is hosted in AWS.
+	// +optional
+	AWS *AWSDNSSECSpec `json:"aws,omitempty"`
+}
+
+// AWSDNSSECSpec contains the AWS-specific DNSSEC configuration
+type AWSDNSSECSpec struct {
+	// KMSKeyARN is the ARN of the customer managed KMS key that Route53 uses for the key-signing key of
+	// the zone. The key must be an asymmetric ECC_NIST_P256 key for signing and verification in us-east-1,
+	// and its key policy must allow the Route53 DNSSEC service to use it.
+	KMSKeyARN string `json:"kmsKeyARN"`
 }
 
 // AWSDNSZoneSpec contains AWS-specific DNSZone specifications
@@ -107,6 +177,66 @@ type AzureDNSZoneSpec struct {
 	CloudName azure.CloudEnvironment `json:"cloudName,omitempty"`
 }
 
+// IBMCloudDNSZoneSpec contains IBM Cloud-specific DNSZone specifications
+type IBMCloudDNSZoneSpec struct {
+	// CredentialsSecretRef references a secret that will be used to authenticate with
+	// IBM Cloud Internet Services. It will need permission to create and manage zones in the CIS instance.
+	// Secret should have a key named 'ibmcloud_api_key'.
+	CredentialsSecretRef corev1.LocalObjectReference `json:"credentialsSecretRef"`
+
+	// CISInstanceCRN is the IBM Cloud Resource Name of the Cloud Internet Services instance in which the zone
+	// should be created. If empty, the zone is created in the CIS instance hosting the zone of the parent domain.
+	// +optional
+	CISInstanceCRN string `json:"cisInstanceCRN,omitempty"`
+}
+
+// AlibabaCloudDNSZoneSpec contains Alibaba Cloud-specific DNSZone specifications
+type AlibabaCloudDNSZoneSpec struct {
+	// CredentialsSecretRef references a secret that will be used to authenticate with
+	// Alibaba Cloud DNS. It will need permission to create and manage domains and their records.
+	// Secret should have keys named 'alibaba_cloud_access_key_id' and 'alibaba_cloud_access_key_secret'.
+	CredentialsSecretRef corev1.LocalObjectReference `json:"credentialsSecretRef"`
+
+	// Region is the Alibaba Cloud region to use for API requests.
+	Region string `json:"region"`
+}
+
+// RFC2136DNSZoneSpec contains the configuration for managing a DNSZone with RFC 2136 dynamic updates
+type RFC2136DNSZoneSpec struct {
+	// Server is the address of the primary DNS server for the zone, as host or host:port.
+	// The port defaults to 53.
+	Server string `json:"server"`
+
+	// TSIGKeyName is the name of the TSIG key used to sign the dynamic updates and zone transfers.
+	TSIGKeyName string `json:"tsigKeyName"`
+
+	// TSIGAlgorithm is the algorithm of the TSIG key.
+	// This defaults to hmac-sha256.
+	// +optional
+	TSIGAlgorithm TSIGAlgorithm `json:"tsigAlgorithm,omitempty"`
+
+	// TSIGSecretRef references a secret that contains the TSIG key used to sign the dynamic updates and
+	// zone transfers. The DNS server must allow the key to update the zone and to transfer it.
+	// Secret should have a key named 'tsigSecret' containing the base64-encoded secret of the key.
+	// If unset, the zone must be a subdomain of one of the RFC 2136 managed domains of the HiveConfig with
+	// the same Server and TSIGKeyName, and the TSIG key of the managed domain is read from the Hive
+	// namespace. The key of the managed domain is never copied to the namespace of the DNSZone.
+	// +optional
+	TSIGSecretRef *corev1.LocalObjectReference `json:"tsigSecretRef,omitempty"`
+}
+
+// TSIGAlgorithm is the algorithm of a TSIG key.
+// +kubebuilder:validation:Enum=hmac-sha1;hmac-sha224;hmac-sha256;hmac-sha384;hmac-sha512
+type TSIGAlgorithm string
+
+const (
+	TSIGAlgorithmHMACSHA1   TSIGAlgorithm = "hmac-sha1"
+	TSIGAlgorithmHMACSHA224 TSIGAlgorithm = "hmac-sha224"
+	TSIGAlgorithmHMACSHA256 TSIGAlgorithm = "hmac-sha256"
+	TSIGAlgorithmHMACSHA384 TSIGAlgorithm = "hmac-sha384"
+	TSIGAlgorithmHMACSHA512 TSIGAlgorithm = "hmac-sha512"
+)
+
 // DNSZoneStatus defines the observed state of DNSZone
 type DNSZoneStatus struct {
 	// LastSyncTimestamp is the time that the zone was last sync'd.
@@ -133,6 +263,23 @@ type DNSZoneStatus struct {
 	// AzureDNSZoneStatus contains status information specific to Azure
 	Azure *AzureDNSZoneStatus `json:"azure,omitempty"`
 
+	// IBMCloudDNSZoneStatus contains status information specific to IBM Cloud
+	// +optional
+	IBMCloud *IBMCloudDNSZoneStatus `json:"ibmcloud,omitempty"`
+
+	// DNSSEC contains status information about the DNSSEC signing of the zone
+	// +optional
+	DNSSEC *DNSSECStatus `json:"dnssec,omitempty"`
+
+	// PublishedDSRecords is the list of DS records of the zone that Hive has published in the parent domain
+	// +optional
+	PublishedDSRecords []string `json:"publishedDSRecords,omitempty"`
+
+	// Records contains the status of the records from the spec and of the records that Hive created in the
+	// zone and has not yet deleted
+	// +optional
+	Records []DNSRecordStatus `json:"records,omitempty"`
+
 	// Conditions includes more detailed status for the DNSZone
 	// +optional
 	Conditions []DNSZoneCondition `json:"conditions,omitempty"`
@@ -149,6 +296,67 @@ type AWSDNSZoneStatus struct {
 type AzureDNSZoneStatus struct {
 }
 
+// IBMCloudDNSZoneStatus contains status information specific to IBM Cloud Internet Services zones
+type IBMCloudDNSZoneStatus struct {
+	// CISInstanceCRN is the IBM Cloud Resource Name of the Cloud Internet Services instance hosting the zone
+	// +optional
+	CISInstanceCRN *string `json:"cisInstanceCRN,omitempty"`
+
+	// ZoneID is the ID of the zone in IBM Cloud Internet Services
+	// +optional
+	ZoneID *string `json:"zoneID,omitempty"`
+}
+
+// DNSSECStatus contains status information about the DNSSEC signing of a DNS zone
+type DNSSECStatus struct {
+	// KeyState is the state of the key-signing key of the zone as reported by the cloud provider
+	// +optional
+	KeyState string `json:"keyState,omitempty"`
+
+	// DSRecords is the list of DS records that must be published in the parent domain, in presentation
+	// format ("<key tag> <algorithm> <digest type> <digest>").
+	// +optional
+	DSRecords []string `json:"dsRecords,omitempty"`
+}
+
+// DNSRecordStatus contains status information about a record managed in a DNS zone
+type DNSRecordStatus struct {
+	// Name is the name of the record relative to the zone
+	Name string `json:"name"`
+
+	// Type is the type of the record
+	Type DNSRecordType `json:"type"`
+
+	// Owned is true when Hive created the record in the zone, or is creating it. Hive only deletes the records that
+	// it owns.
+	// +optional
+	Owned bool `json:"owned,omitempty"`
+
+	// State is the state of the record in the zone
+	State DNSRecordState `json:"state"`
+
+	// Message is a human-readable message with details about the state of the record
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// DNSRecordState is the state of a record managed in a DNS zone
+type DNSRecordState string
+
+const (
+	// DNSRecordStateSynced means that the record in the zone matches the spec
+	DNSRecordStateSynced DNSRecordState = "Synced"
+	// DNSRecordStateCreating means that Hive has taken ownership of the record and is creating it in the zone
+	DNSRecordStateCreating DNSRecordState = "Creating"
+	// DNSRecordStateConflict means that a record with the same name and type, which was not created by Hive,
+	// already exists in the zone
+	DNSRecordStateConflict DNSRecordState = "Conflict"
+	// DNSRecordStateDeleting means that the record was removed from the spec and is being deleted from the zone
+	DNSRecordStateDeleting DNSRecordState = "Deleting"
+	// DNSRecordStateFailed means that the record could not be synced to the zone
+	DNSRecordStateFailed DNSRecordState = "Failed"
+)
+
 // GCPDNSZoneStatus contains status information specific to GCP Cloud DNS zones
 type GCPDNSZoneStatus struct {
 	// ZoneName is the name of the zone in GCP Cloud DNS
@@ -209,6 +417,13 @@ const (
 	// GenericDNSErrorsCondition is true when there's some DNS Zone related error that isn't related to
 	// authentication or credentials, and needs to be bubbled up to ClusterDeployment
 	GenericDNSErrorsCondition DNSZoneConditionType = "DNSError"
+	// DNSSECSigningCondition is true when the zone is signed with DNSSEC and its DS records are available
+	DNSSECSigningCondition DNSZoneConditionType = "DNSSECSigning"
+	// DSRecordsPublishedCondition is true when the DS records of the zone have been published in the parent domain
+	DSRecordsPublishedCondition DNSZoneConditionType = "DSRecordsPublished"
+	// RecordsSyncedCondition is true when all the records from the spec are synced to the zone and the records
+	// removed from the spec have been deleted
+	RecordsSyncedCondition DNSZoneConditionType = "RecordsSynced"
 )
 
 // +genclient
@@ -4,6 +4,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/openshift/hive/apis/hive/v1/aws"
 	"github.com/openshift/hive/apis/hive/v1/azure"
 	"github.com/openshift/hive/apis/hive/v1/metricsconfig"
 )
@@ -66,6 +67,29 @@ type HiveConfigSpec struct {
 	// The default reapply interval is two hours.
 	SyncSetReapplyInterval string `json:"syncSetReapplyInterval,omitempty"`
 
+	// SyncSetSecretsVolume is a CSI volume, such as one provided by the Secrets Store CSI driver, that is mounted
+	// into the hive-clustersync pods for SelectorSyncSets to sync secrets from with a file ExternalSource.
+	// +optional
+	SyncSetSecretsVolume *corev1.CSIVolumeSource `json:"syncSetSecretsVolume,omitempty"`
+
+	// SyncSetInsecureRegistries is a list of registries, in the form host[:port], from which the OCI sources of
+	// SyncSets and SelectorSyncSets may be fetched over plain HTTP. Sources that set insecure for any other registry
+	// fail to fetch.
+	// +optional
+	SyncSetInsecureRegistries []string `json:"syncSetInsecureRegistries,omitempty"`
+
+	// SyncSetHelmChartRepoHosts is a list of hosts, in the form host[:port], from which the Helm charts of SyncSets
+	// and SelectorSyncSets may be pulled. Charts may be pulled from any host if empty. Charts are always pulled over
+	// https.
+	// +optional
+	SyncSetHelmChartRepoHosts []string `json:"syncSetHelmChartRepoHosts,omitempty"`
+
+	// SyncSetVaultAddresses is a list of the URLs of the Vault servers, e.g. https://vault.example.com:8200, from which
+	// the Vault ExternalSources of SyncSets and SelectorSyncSets may read secrets. Vault ExternalSources cannot be used
+	// if empty.
+	// +optional
+	SyncSetVaultAddresses []string `json:"syncSetVaultAddresses,omitempty"`
+
 	// MaintenanceMode can be set to true to disable the hive controllers in situations where we need to ensure
 	// nothing is running that will add or act upon finalizers on Hive types. This should rarely be needed.
 	// Sets replicas to 0 for the hive-controllers deployment to accomplish this.
@@ -400,8 +424,29 @@ type FailedProvisionConfig struct {
 	// TODO: Figure out how to mark SkipGatherLogs as deprecated (more than just a comment)
 
 	// DEPRECATED: This flag is no longer respected and will be removed in the future.
-	SkipGatherLogs bool                      `json:"skipGatherLogs,omitempty"`
-	AWS            *FailedProvisionAWSConfig `json:"aws,omitempty"`
+	SkipGatherLogs bool `json:"skipGatherLogs,omitempty"`
+
+	// AWS configures uploading the logs of failed provisions to AWS S3 or an S3 compatible object store.
+	// At most one of AWS, GCP and Azure should be set. If several are set, AWS is preferred over GCP, which is
+	// preferred over Azure.
+	// +optional
+	AWS *FailedProvisionAWSConfig `json:"aws,omitempty"`
+
+	// GCP configures uploading the logs of failed provisions to Google Cloud Storage.
+	// +optional
+	GCP *FailedProvisionGCPConfig `json:"gcp,omitempty"`
+
+	// Azure configures uploading the logs of failed provisions to Azure Blob Storage.
+	// +optional
+	Azure *FailedProvisionAzureConfig `json:"azure,omitempty"`
+
+	// Archive configures archiving the installer log, the redacted install-config and the manifests of every
+	// provision attempt, successful or not, to the object store configured by AWS, GCP or Azure. Use
+	// `hiveutil provision logs` to fetch them. Hive does not delete archived files, so configure a lifecycle
+	// rule for the "archive/" prefix of the object store to limit how long they are kept.
+	// +optional
+	Archive *InstallLogArchiveConfig `json:"archive,omitempty"`
+
 	// RetryReasons is a list of installFailingReason strings from the [additional-]install-log-regexes ConfigMaps.
 	// If specified, Hive will only retry a failed installation if it results in one of the listed reasons. If
 	// omitted (not the same thing as empty!), Hive will retry regardless of the failure reason. (The total number
@@ -428,6 +473,19 @@ type ManageDNSConfig struct {
 	// +optional
 	Azure *ManageDNSAzureConfig `json:"azure,omitempty"`
 
+	// IBMCloud contains IBM Cloud-specific settings for external DNS
+	// +optional
+	IBMCloud *ManageDNSIBMCloudConfig `json:"ibmcloud,omitempty"`
+
+	// AlibabaCloud contains Alibaba Cloud-specific settings for external DNS
+	// +optional
+	AlibabaCloud *ManageDNSAlibabaCloudConfig `json:"alibabacloud,omitempty"`
+
+	// RFC2136 contains the settings for managing the domains on a DNS server, such as BIND, with RFC 2136
+	// dynamic updates
+	// +optional
+	RFC2136 *ManageDNSRFC2136Config `json:"rfc2136,omitempty"`
+
 	// As other cloud providers are supported, additional fields will be
 	// added for each of those cloud providers. Only a single cloud provider
 	// may be configured at a time.
@@ -450,13 +508,50 @@ type FailedProvisionAWSConfig struct {
 	// +optional
 	Region string `json:"region,omitempty"`
 
-	// ServiceEndpoint is the url to connect to an S3 compatible provider.
+	// ServiceEndpoint is the url to connect to an S3 compatible provider, such as MinIO, instead of AWS S3.
+	// Buckets of such providers are addressed path-style, i.e. <serviceEndpoint>/<bucket>/<key>.
 	ServiceEndpoint string `json:"serviceEndpoint,omitempty"`
 
 	// Bucket is the S3 bucket to store the logs in.
 	Bucket string `json:"bucket,omitempty"`
 }
 
+// InstallLogArchiveConfig contains settings for archiving the logs and assets of every provision attempt.
+type InstallLogArchiveConfig struct {
+}
+
+// FailedProvisionGCPConfig contains GCP-specific info to upload log files.
+type FailedProvisionGCPConfig struct {
+	// CredentialsSecretRef references a secret in the TargetNamespace that will be used to authenticate with
+	// Google Cloud Storage. It will need permission to create objects in the bucket.
+	// Secret should have a key named 'osServiceAccount.json'.
+	CredentialsSecretRef corev1.LocalObjectReference `json:"credentialsSecretRef"`
+
+	// Bucket is the GCS bucket to store the logs in.
+	Bucket string `json:"bucket"`
+}
+
+// FailedProvisionAzureConfig contains Azure-specific info to upload log files.
+type FailedProvisionAzureConfig struct {
+	// CredentialsSecretRef references a secret in the TargetNamespace that will be used to authenticate with
+	// Azure Blob Storage. The service principal will need permission to write blobs in the container, for example
+	// through the 'Storage Blob Data Contributor' role.
+	// Secret should have a key named 'osServicePrincipal.json'.
+	CredentialsSecretRef corev1.LocalObjectReference `json:"credentialsSecretRef"`
+
+	// StorageAccountName is the name of the storage account containing the container.
+	StorageAccountName string `json:"storageAccountName"`
+
+	// Container is the blob container to store the logs in.
+	Container string `json:"container"`
+
+	// CloudName is the name of the Azure cloud environment which can be used to configure the Azure SDK
+	// with the appropriate Azure API endpoints.
+	// If empty, the value is equal to "AzurePublicCloud".
+	// +optional
+	CloudName azure.CloudEnvironment `json:"cloudName,omitempty"`
+}
+
 // ManageDNSAWSConfig contains AWS-specific info to manage a given domain.
 type ManageDNSAWSConfig struct {
 	// CredentialsSecretRef references a secret in the TargetNamespace that will be used to authenticate with
@@ -465,6 +560,13 @@ type ManageDNSAWSConfig struct {
 	// Secret should have AWS keys named 'aws_access_key_id' and 'aws_secret_access_key'.
 	CredentialsSecretRef corev1.LocalObjectReference `json:"credentialsSecretRef"`
 
+	// CredentialsAssumeRole refers to the IAM role that is assumed, using the credentials in CredentialsSecretRef,
+	// to manage entries in the hosted zones of the managed domains. This allows the hosted zones of the managed
+	// domains to live in another AWS account than the hosted zones of the clusters, which are created with the
+	// credentials of each cluster.
+	// +optional
+	CredentialsAssumeRole *aws.AssumeRole `json:"credentialsAssumeRole,omitempty"`
+
 	// Region is the AWS region to use for route53 operations.
 	// This defaults to us-east-1.
 	// For AWS China, use cn-northwest-1.
@@ -481,6 +583,12 @@ type ManageDNSGCPConfig struct {
 	// Secret should have a key named 'osServiceAccount.json'.
 	// The credentials must specify the project to use.
 	CredentialsSecretRef corev1.LocalObjectReference `json:"credentialsSecretRef"`
+
+	// ProjectID is the GCP project containing the managed zones of the managed domains, when it differs from the
+	// project of the credentials. The service account of the credentials must be granted permission to manage
+	// entries in the managed zones of that project.
+	// +optional
+	ProjectID string `json:"projectID,omitempty"`
 }
 
 type DeleteProtectionType string
@@ -501,6 +609,12 @@ type ManageDNSAzureConfig struct {
 	// for the domains being managed.
 	ResourceGroupName string `json:"resourceGroupName"`
 
+	// SubscriptionID is the Azure subscription containing the DNS zones of the managed domains, when it differs
+	// from the subscription of the credentials. The service principal of the credentials must be granted
+	// permission to manage entries in the DNS zones of that subscription.
+	// +optional
+	SubscriptionID string `json:"subscriptionID,omitempty"`
+
 	// CloudName is the name of the Azure cloud environment which can be used to configure the Azure SDK
 	// with the appropriate Azure API endpoints.
 	// If empty, the value is equal to "AzurePublicCloud".
@@ -508,6 +622,48 @@ type ManageDNSAzureConfig struct {
 	CloudName azure.CloudEnvironment `json:"cloudName,omitempty"`
 }
 
+// ManageDNSIBMCloudConfig contains IBM Cloud-specific info to manage a given domain
+type ManageDNSIBMCloudConfig struct {
+	// CredentialsSecretRef references a secret in the TargetNamespace that will be used to authenticate with
+	// IBM Cloud Internet Services. It will need permission to manage entries in each of the
+	// managed domains listed in the parent ManageDNSConfig object.
+	// Secret should have a key named 'ibmcloud_api_key'
+	CredentialsSecretRef corev1.LocalObjectReference `json:"credentialsSecretRef"`
+}
+
+// ManageDNSAlibabaCloudConfig contains Alibaba Cloud-specific info to manage a given domain
+type ManageDNSAlibabaCloudConfig struct {
+	// CredentialsSecretRef references a secret in the TargetNamespace that will be used to authenticate with
+	// Alibaba Cloud DNS. It will need permission to manage entries in each of the
+	// managed domains listed in the parent ManageDNSConfig object.
+	// Secret should have keys named 'alibaba_cloud_access_key_id' and 'alibaba_cloud_access_key_secret'
+	CredentialsSecretRef corev1.LocalObjectReference `json:"credentialsSecretRef"`
+
+	// Region is the Alibaba Cloud region to use for API requests.
+	Region string `json:"region"`
+}
+
+// ManageDNSRFC2136Config contains the settings to manage a given domain with RFC 2136 dynamic updates
+type ManageDNSRFC2136Config struct {
+	// Server is the address of the primary DNS server for the zones of the managed domains, as host or host:port.
+	// The port defaults to 53.
+	Server string `json:"server"`
+
+	// TSIGKeyName is the name of the TSIG key used to sign the dynamic updates and zone transfers.
+	TSIGKeyName string `json:"tsigKeyName"`
+
+	// TSIGAlgorithm is the algorithm of the TSIG key.
+	// This defaults to hmac-sha256.
+	// +optional
+	TSIGAlgorithm TSIGAlgorithm `json:"tsigAlgorithm,omitempty"`
+
+	// TSIGSecretRef references a secret in the TargetNamespace that contains the TSIG key. The DNS server
+	// must allow the key to update and transfer the zones of each of the managed domains listed in the parent
+	// ManageDNSConfig object.
+	// Secret should have a key named 'tsigSecret' containing the base64-encoded secret of the key.
+	TSIGSecretRef corev1.LocalObjectReference `json:"tsigSecretRef"`
+}
+
 // ControllerConfig contains the configuration for a controller
 type ControllerConfig struct {
 	// ConcurrentReconciles specifies number of concurrent reconciles for a controller
@@ -531,7 +687,7 @@ type ControllerConfig struct {
 	Replicas *int32 `json:"replicas,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=clusterDeployment;clusterrelocate;clusterstate;clusterversion;controlPlaneCerts;dnsendpoint;dnszone;remoteingress;remotemachineset;machinepool;syncidentityprovider;unreachable;velerobackup;clusterprovision;clusterDeprovision;clusterpool;clusterpoolnamespace;hibernation;clusterclaim;metrics;clustersync
+// +kubebuilder:validation:Enum=clusterDeployment;clusterrelocate;clusterstate;clusterversion;controlPlaneCerts;dnsendpoint;dnszone;remoteingress;remotemachineset;machinepool;syncidentityprovider;unreachable;velerobackup;clusterprovision;clusterDeprovision;clusterpool;clusterpoolnamespace;hibernation;clusterclaim;metrics;clustersync;selectorsyncsetrollout;syncsetsource;syncrbac;agentimageclusterinstall;clusterupgraderollout
 type ControllerName string
 
 func (controllerName ControllerName) String() string {
@@ -554,28 +710,33 @@ func (c ControllerNames) Contains(controllerName ControllerName) bool {
 
 // WARNING: All the controller names below should also be added to the kubebuilder validation of the type ControllerName
 const (
-	ClusterClaimControllerName         ControllerName = "clusterclaim"
-	ClusterDeploymentControllerName    ControllerName = "clusterDeployment"
-	ClusterDeprovisionControllerName   ControllerName = "clusterDeprovision"
-	ClusterpoolControllerName          ControllerName = "clusterpool"
-	ClusterpoolNamespaceControllerName ControllerName = "clusterpoolnamespace"
-	ClusterProvisionControllerName     ControllerName = "clusterProvision"
-	ClusterRelocateControllerName      ControllerName = "clusterRelocate"
-	ClusterStateControllerName         ControllerName = "clusterState"
-	ClusterVersionControllerName       ControllerName = "clusterversion"
-	ControlPlaneCertsControllerName    ControllerName = "controlPlaneCerts"
-	DNSEndpointControllerName          ControllerName = "dnsendpoint"
-	DNSZoneControllerName              ControllerName = "dnszone"
-	FakeClusterInstallControllerName   ControllerName = "fakeclusterinstall"
-	HibernationControllerName          ControllerName = "hibernation"
-	RemoteIngressControllerName        ControllerName = "remoteingress"
-	SyncIdentityProviderControllerName ControllerName = "syncidentityprovider"
-	UnreachableControllerName          ControllerName = "unreachable"
-	VeleroBackupControllerName         ControllerName = "velerobackup"
-	MetricsControllerName              ControllerName = "metrics"
-	ClustersyncControllerName          ControllerName = "clustersync"
-	AWSPrivateLinkControllerName       ControllerName = "awsprivatelink"
-	HiveControllerName                 ControllerName = "hive"
+	AgentImageClusterInstallControllerName ControllerName = "agentimageclusterinstall"
+	ClusterClaimControllerName             ControllerName = "clusterclaim"
+	ClusterDeploymentControllerName        ControllerName = "clusterDeployment"
+	ClusterDeprovisionControllerName       ControllerName = "clusterDeprovision"
+	ClusterpoolControllerName              ControllerName = "clusterpool"
+	ClusterpoolNamespaceControllerName     ControllerName = "clusterpoolnamespace"
+	ClusterProvisionControllerName         ControllerName = "clusterProvision"
+	ClusterRelocateControllerName          ControllerName = "clusterRelocate"
+	ClusterStateControllerName             ControllerName = "clusterState"
+	ClusterUpgradeRolloutControllerName    ControllerName = "clusterupgraderollout"
+	ClusterVersionControllerName           ControllerName = "clusterversion"
+	ControlPlaneCertsControllerName        ControllerName = "controlPlaneCerts"
+	DNSEndpointControllerName              ControllerName = "dnsendpoint"
+	DNSZoneControllerName                  ControllerName = "dnszone"
+	FakeClusterInstallControllerName       ControllerName = "fakeclusterinstall"
+	HibernationControllerName              ControllerName = "hibernation"
+	RemoteIngressControllerName            ControllerName = "remoteingress"
+	SelectorSyncSetRolloutControllerName   ControllerName = "selectorsyncsetrollout"
+	SyncIdentityProviderControllerName     ControllerName = "syncidentityprovider"
+	SyncRBACControllerName                 ControllerName = "syncrbac"
+	SyncSetSourceControllerName            ControllerName = "syncsetsource"
+	UnreachableControllerName              ControllerName = "unreachable"
+	VeleroBackupControllerName             ControllerName = "velerobackup"
+	MetricsControllerName                  ControllerName = "metrics"
+	ClustersyncControllerName              ControllerName = "clustersync"
+	AWSPrivateLinkControllerName           ControllerName = "awsprivatelink"
+	HiveControllerName                     ControllerName = "hive"
 
 	// DeprecatedRemoteMachinesetControllerName was deprecated but can be used to disable the
 	// MachinePool controller which supercedes it for compatability.
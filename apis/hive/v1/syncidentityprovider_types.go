@@ -11,6 +11,55 @@ type SyncIdentityProviderCommonSpec struct {
 	//IdentityProviders is an ordered list of ways for a user to identify themselves
 	// +required
 	IdentityProviders []openshiftapiv1.IdentityProvider `json:"identityProviders"`
+
+	// TokenConfig contains options for the authorization and access tokens issued by the OAuth server of the
+	// clusters. If more than one identity provider for a cluster sets TokenConfig, the one from the SyncIdentityProvider,
+	// or else the SelectorSyncIdentityProvider, that is first by name is used.
+	// +optional
+	TokenConfig *openshiftapiv1.TokenConfig `json:"tokenConfig,omitempty"`
+
+	// ReferencedObjects enables copying the Secrets and ConfigMaps referenced by the identity providers, such as
+	// client secrets and CA bundles, from the namespace of each ClusterDeployment on the hub into the openshift-config
+	// namespace of the cluster. When not set, the referenced Secrets and ConfigMaps must already exist in the clusters.
+	// +optional
+	ReferencedObjects *IdentityProviderReferencedObjects `json:"referencedObjects,omitempty"`
+}
+
+// IdentityProviderReferencedObjects configures the copying of the Secrets and ConfigMaps referenced by identity
+// providers from the namespace of each ClusterDeployment on the hub. Since each ClusterDeployment has its own
+// namespace, each cluster can have its own client secrets.
+type IdentityProviderReferencedObjects struct {
+	// Mappings maps Secrets and ConfigMaps referenced by the identity providers to Secrets and ConfigMaps with other
+	// names on the hub. Referenced Secrets and ConfigMaps without a mapping are copied from the Secret or ConfigMap
+	// with the same name.
+	// +optional
+	Mappings []IdentityProviderObjectMapping `json:"mappings,omitempty"`
+}
+
+// IdentityProviderObjectKind is the kind of an object referenced by an identity provider.
+// +kubebuilder:validation:Enum=Secret;ConfigMap
+type IdentityProviderObjectKind string
+
+const (
+	// SecretIdentityProviderObjectKind is a Secret, such as a client secret or bind password.
+	SecretIdentityProviderObjectKind IdentityProviderObjectKind = "Secret"
+
+	// ConfigMapIdentityProviderObjectKind is a ConfigMap, such as a CA bundle.
+	ConfigMapIdentityProviderObjectKind IdentityProviderObjectKind = "ConfigMap"
+)
+
+// IdentityProviderObjectMapping maps a Secret or ConfigMap referenced by an identity provider to the Secret or
+// ConfigMap on the hub from which it is copied.
+type IdentityProviderObjectMapping struct {
+	// Kind is the kind of the referenced object.
+	Kind IdentityProviderObjectKind `json:"kind"`
+
+	// Name is the name of the object referenced by the identity provider in the openshift-config namespace of the
+	// cluster.
+	Name string `json:"name"`
+
+	// SourceName is the name of the object in the namespace of the ClusterDeployment on the hub.
+	SourceName string `json:"sourceName"`
 }
 
 // SelectorSyncIdentityProviderSpec defines the SyncIdentityProviderCommonSpec to sync to
@@ -37,8 +86,46 @@ type SyncIdentityProviderSpec struct {
 	ClusterDeploymentRefs []corev1.LocalObjectReference `json:"clusterDeploymentRefs"`
 }
 
-// IdentityProviderStatus defines the observed state of SyncSet
+// IdentityProviderStatus defines the observed state of SyncIdentityProvider and SelectorSyncIdentityProvider
 type IdentityProviderStatus struct {
+	// ClusterDeployments is the status of the application of the identity providers to each of the clusters to which
+	// they apply.
+	// +optional
+	ClusterDeployments []IdentityProviderClusterStatus `json:"clusterDeployments,omitempty"`
+}
+
+// IdentityProviderApplyResult is the result of applying identity providers to a cluster.
+type IdentityProviderApplyResult string
+
+const (
+	// AppliedIdentityProviderApplyResult is the result when the identity providers have been applied to the cluster.
+	AppliedIdentityProviderApplyResult IdentityProviderApplyResult = "Applied"
+
+	// PendingIdentityProviderApplyResult is the result when the identity providers have not yet been applied to the
+	// cluster, e.g. because the cluster is still installing.
+	PendingIdentityProviderApplyResult IdentityProviderApplyResult = "Pending"
+
+	// FailedIdentityProviderApplyResult is the result when the identity providers could not be applied to the cluster.
+	FailedIdentityProviderApplyResult IdentityProviderApplyResult = "Failed"
+)
+
+// IdentityProviderClusterStatus is the status of the application of identity providers to a cluster.
+type IdentityProviderClusterStatus struct {
+	// Namespace is the namespace of the ClusterDeployment.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the ClusterDeployment.
+	Name string `json:"name"`
+
+	// Result is the result of applying the identity providers to the cluster.
+	Result IdentityProviderApplyResult `json:"result"`
+
+	// Message explains why the identity providers have not been applied to the cluster.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the time when the result or message last changed.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
 }
 
 // +genclient
@@ -47,6 +134,7 @@ type IdentityProviderStatus struct {
 
 // SelectorSyncIdentityProvider is the Schema for the SelectorSyncSet API
 // +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
 type SelectorSyncIdentityProvider struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -61,6 +149,7 @@ type SelectorSyncIdentityProvider struct {
 
 // SyncIdentityProvider is the Schema for the SyncIdentityProvider API
 // +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Namespaced
 type SyncIdentityProvider struct {
 	metav1.TypeMeta   `json:",inline"`
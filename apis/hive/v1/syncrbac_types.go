@@ -0,0 +1,160 @@
+package v1
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SelectorSyncRBACSpec defines the groups and role bindings to sync to the clusters matching the
+// ClusterDeploymentSelector in any namespace.
+type SelectorSyncRBACSpec struct {
+	// Groups are the OpenShift groups to create in the clusters. When more than one SelectorSyncRBAC for a cluster
+	// declares the same group, the users of the group in the cluster are all of the users declared for the group.
+	// +optional
+	Groups []SyncRBACGroup `json:"groups,omitempty"`
+
+	// ClusterRoleBindings are the ClusterRoleBindings to create in the clusters. When more than one SelectorSyncRBAC
+	// for a cluster declares a ClusterRoleBinding with the same name and role, the subjects of the ClusterRoleBinding
+	// in the cluster are all of the subjects declared for it.
+	// +optional
+	ClusterRoleBindings []SyncRBACClusterRoleBinding `json:"clusterRoleBindings,omitempty"`
+
+	// RoleBindings are the RoleBindings to create in the clusters. When more than one SelectorSyncRBAC for a cluster
+	// declares a RoleBinding with the same namespace, name and role, the subjects of the RoleBinding in the cluster are
+	// all of the subjects declared for it.
+	// +optional
+	RoleBindings []SyncRBACRoleBinding `json:"roleBindings,omitempty"`
+
+	// ClusterDeploymentSelector is a LabelSelector indicating which clusters the SelectorSyncRBAC applies to in any
+	// namespace.
+	// +optional
+	ClusterDeploymentSelector metav1.LabelSelector `json:"clusterDeploymentSelector,omitempty"`
+}
+
+// SyncRBACGroup is an OpenShift group to create in the clusters.
+type SyncRBACGroup struct {
+	// Name is the name of the group.
+	Name string `json:"name"`
+
+	// Users are the names of the users in the group.
+	// +optional
+	Users []string `json:"users,omitempty"`
+}
+
+// SyncRBACClusterRoleBinding is a ClusterRoleBinding to create in the clusters.
+type SyncRBACClusterRoleBinding struct {
+	// Name is the name of the ClusterRoleBinding.
+	Name string `json:"name"`
+
+	// RoleRef is the ClusterRole to which the subjects are bound.
+	RoleRef rbacv1.RoleRef `json:"roleRef"`
+
+	// Subjects are the users, groups and service accounts bound to the role.
+	// +optional
+	Subjects []rbacv1.Subject `json:"subjects,omitempty"`
+}
+
+// SyncRBACRoleBinding is a RoleBinding to create in the clusters.
+type SyncRBACRoleBinding struct {
+	// Namespace is the namespace of the RoleBinding in the clusters. The namespace must already exist in the clusters.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the RoleBinding.
+	Name string `json:"name"`
+
+	// RoleRef is the Role or ClusterRole to which the subjects are bound.
+	RoleRef rbacv1.RoleRef `json:"roleRef"`
+
+	// Subjects are the users, groups and service accounts bound to the role.
+	// +optional
+	Subjects []rbacv1.Subject `json:"subjects,omitempty"`
+}
+
+// SelectorSyncRBACStatus defines the observed state of SelectorSyncRBAC
+type SelectorSyncRBACStatus struct {
+	// ClusterDeployments is the status of the groups and role bindings in each of the clusters to which the
+	// SelectorSyncRBAC applies.
+	// +optional
+	ClusterDeployments []SyncRBACClusterStatus `json:"clusterDeployments,omitempty"`
+}
+
+// SyncRBACResult is the result of syncing groups and role bindings to a cluster.
+type SyncRBACResult string
+
+const (
+	// AppliedSyncRBACResult is the result when the groups and role bindings have been applied to the cluster and match
+	// those in the cluster.
+	AppliedSyncRBACResult SyncRBACResult = "Applied"
+
+	// PendingSyncRBACResult is the result when the groups and role bindings have not yet been applied to the cluster,
+	// e.g. because the cluster is still installing.
+	PendingSyncRBACResult SyncRBACResult = "Pending"
+
+	// FailedSyncRBACResult is the result when the groups and role bindings could not be applied to the cluster.
+	FailedSyncRBACResult SyncRBACResult = "Failed"
+
+	// DriftedSyncRBACResult is the result when the groups and role bindings have been applied to the cluster but have
+	// since been changed or deleted in the cluster. They are restored when the SyncSet is next reapplied.
+	DriftedSyncRBACResult SyncRBACResult = "Drifted"
+)
+
+// SyncRBACClusterStatus is the status of the groups and role bindings in a cluster.
+type SyncRBACClusterStatus struct {
+	// Namespace is the namespace of the ClusterDeployment.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the ClusterDeployment.
+	Name string `json:"name"`
+
+	// Result is the result of syncing the groups and role bindings to the cluster.
+	Result SyncRBACResult `json:"result"`
+
+	// Message explains why the groups and role bindings have not been applied to the cluster.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Groups are the groups declared by the SelectorSyncRBAC with all of the users of the group in the cluster,
+	// including those declared by other SelectorSyncRBACs.
+	// +optional
+	Groups []SyncRBACGroup `json:"groups,omitempty"`
+
+	// DriftedResources are the groups and role bindings declared by the SelectorSyncRBAC that have been changed or
+	// deleted in the cluster, e.g. "ClusterRoleBinding/team-admins".
+	// +optional
+	DriftedResources []string `json:"driftedResources,omitempty"`
+
+	// LastTransitionTime is the time when the result or message last changed.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SelectorSyncRBAC is the Schema for the SelectorSyncRBAC API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+type SelectorSyncRBAC struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SelectorSyncRBACSpec   `json:"spec,omitempty"`
+	Status SelectorSyncRBACStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SelectorSyncRBACList contains a list of SelectorSyncRBACs
+type SelectorSyncRBACList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SelectorSyncRBAC `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&SelectorSyncRBAC{},
+		&SelectorSyncRBACList{},
+	)
+}
@@ -96,13 +96,81 @@ type SecretReference struct {
 // SecretMapping defines a source and destination for a secret to be synced by a SyncSet
 type SecretMapping struct {
 
-	// SourceRef specifies the name and namespace of a secret on the management cluster
+	// SourceRef specifies the name and namespace of a secret on the management cluster.
+	// Exactly one of SourceRef or ExternalSource must be set.
+	// +optional
 	SourceRef SecretReference `json:"sourceRef"`
 
+	// ExternalSource specifies a secret in a secret store outside of the management cluster.
+	// Exactly one of SourceRef or ExternalSource must be set.
+	// +optional
+	ExternalSource *ExternalSecretSource `json:"externalSource,omitempty"`
+
 	// TargetRef specifies the target name and namespace of the secret on the target cluster
 	TargetRef SecretReference `json:"targetRef"`
 }
 
+// ExternalSecretSource is a secret in a secret store outside of the management cluster. The secret is read
+// by the hive-clustersync pods and cached for the RefreshInterval. When the secret changes in the store, it is
+// re-synced to the clusters once it has been read again. Exactly one of Vault or File must be set.
+type ExternalSecretSource struct {
+	// Vault is a secret in a KV secrets engine of HashiCorp Vault.
+	// +optional
+	Vault *VaultSecretSource `json:"vault,omitempty"`
+
+	// File is a directory of files mounted into the hive-clustersync pods. Only SelectorSyncSets may use file
+	// sources, since the files are available to every syncset.
+	// +optional
+	File *FileSecretSource `json:"file,omitempty"`
+
+	// RefreshInterval is how long the secret is cached before it is read from the store again. Defaults to 5m.
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// VaultSecretSource is a secret in a KV secrets engine of HashiCorp Vault. Each key of the data of the Vault
+// secret becomes a key of the synced secret.
+type VaultSecretSource struct {
+	// Address is the URL of the Vault server, e.g. https://vault.example.com:8200. It must be one of the
+	// SyncSetVaultAddresses of the HiveConfig.
+	Address string `json:"address"`
+
+	// Namespace is the Vault Enterprise namespace containing the KV secrets engine.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Mount is the path at which the KV secrets engine is mounted. Defaults to "secret".
+	// +optional
+	Mount string `json:"mount,omitempty"`
+
+	// Path is the path of the secret within the KV secrets engine.
+	Path string `json:"path"`
+
+	// KVVersion is the version of the KV secrets engine. Defaults to 2.
+	// +kubebuilder:validation:Enum=1;2
+	// +optional
+	KVVersion int `json:"kvVersion,omitempty"`
+
+	// Version pins the version of the secret to sync. Only supported by version 2 of the KV secrets engine.
+	// Defaults to the latest version.
+	// +optional
+	Version *int `json:"version,omitempty"`
+
+	// TokenSecretRef is a reference to a secret on the management cluster with the "token" key used to
+	// authenticate with Vault, and optionally the "ca.crt" key with the CA bundle used to verify the certificate
+	// of the Vault server. The namespace of the secret is required for SelectorSyncSets and must be the namespace
+	// of the SyncSet for SyncSets.
+	TokenSecretRef SecretReference `json:"tokenSecretRef"`
+}
+
+// FileSecretSource is a directory of files mounted into the hive-clustersync pods, such as by the
+// SyncSetSecretsVolume of the HiveConfig. Each file in the directory becomes a key of the synced secret.
+type FileSecretSource struct {
+	// Path is the directory containing the files, relative to the directory in which the SyncSetSecretsVolume
+	// is mounted.
+	Path string `json:"path"`
+}
+
 // SyncConditionType is a valid value for SyncCondition.Type
 type SyncConditionType string
 
@@ -243,6 +311,178 @@ type SyncSetCommonSpec struct {
 	// labels, and other map entries in general.
 	// +optional
 	ApplyBehavior SyncSetApplyBehavior `json:"applyBehavior,omitempty"`
+
+	// Source is a reference to a Git repository or OCI artifact containing additional manifests to sync.
+	// The manifests are fetched on the hub and applied along with Resources.
+	// +optional
+	Source *SyncSetSource `json:"source,omitempty"`
+
+	// Kustomize renders additional manifests to sync by building a Kustomize overlay on the hub. The overlay
+	// is built from the files of the Source, if any, along with the Files of the overlay.
+	// Only one of Kustomize or HelmChart may be set.
+	// +optional
+	Kustomize *KustomizeSyncSetRender `json:"kustomize,omitempty"`
+
+	// HelmChart renders additional manifests to sync by templating a Helm chart on the hub for each cluster.
+	// Only one of Kustomize or HelmChart may be set.
+	// +optional
+	HelmChart *HelmChartSyncSetRender `json:"helmChart,omitempty"`
+
+	// HealthCheck enables the assessment of the health of the resources of the syncset in the target cluster. The
+	// health of each syncset is reported in the ClusterSync of the cluster.
+	// +optional
+	HealthCheck *SyncSetHealthCheck `json:"healthCheck,omitempty"`
+}
+
+// SyncSetHealthCheck configures the assessment of the health of the resources of a syncset in the target cluster.
+// Deployments, DaemonSets, Jobs, Subscriptions and ClusterServiceVersions are assessed with built-in rules. Resources
+// of other kinds are assessed only if there is a custom rule for their kind.
+type SyncSetHealthCheck struct {
+	// CustomRules assess the health of resources with CEL expressions. A custom rule for a kind with a built-in rule
+	// replaces the built-in rule.
+	// +optional
+	CustomRules []CustomHealthRule `json:"customRules,omitempty"`
+}
+
+// CustomHealthRule assesses the health of resources of a kind with CEL expressions. The resource is available to the
+// expressions as `object`. A resource which is neither healthy nor degraded is progressing.
+type CustomHealthRule struct {
+	// APIVersion is the group/version of the kind of the resources to assess.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind is the kind of the resources to assess.
+	Kind string `json:"kind"`
+
+	// Healthy is a CEL expression which evaluates to true when the resource is healthy,
+	// e.g. `has(object.status) && has(object.status.phase) && object.status.phase == "Ready"`.
+	Healthy string `json:"healthy"`
+
+	// Degraded is a CEL expression which evaluates to true when the resource is degraded.
+	// +optional
+	Degraded string `json:"degraded,omitempty"`
+}
+
+// KustomizeSyncSetRender is a Kustomize overlay to build into manifests to sync.
+type KustomizeSyncSetRender struct {
+	// Path is the directory containing the kustomization.yaml to build, relative to the Path of the Source.
+	// Defaults to the Path of the Source.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Files are added to the files of the Source before the overlay is built, keyed by their path relative to
+	// the Path of the Source. They may be used to overlay the Source with a kustomization.yaml, or to build an
+	// overlay when there is no Source. Remote bases are not supported.
+	// +optional
+	Files map[string]string `json:"files,omitempty"`
+}
+
+// HelmChartSyncSetRender is a Helm chart to template into manifests to sync.
+type HelmChartSyncSetRender struct {
+	// Chart is the name of the chart in the Repo, or the directory or packaged chart containing the chart relative
+	// to the Path of the Source when there is no Repo.
+	Chart string `json:"chart"`
+
+	// Repo is the https URL of the chart repository to pull the Chart from. The chart is pulled on the hub into the
+	// cache of the syncset, in the same way as a Source, so the syncset may not have a Source as well.
+	// +optional
+	Repo string `json:"repo,omitempty"`
+
+	// Version is the version, or version constraint, of the Chart to pull from the Repo. Defaults to the latest
+	// version.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// ReleaseName is the name of the release the chart is templated as. Defaults to the name of the syncset.
+	// +optional
+	ReleaseName string `json:"releaseName,omitempty"`
+
+	// Namespace is the namespace the chart is templated for. Defaults to the default namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// IncludeCRDs includes the CRDs of the chart in the manifests to sync.
+	// +optional
+	IncludeCRDs bool `json:"includeCRDs,omitempty"`
+
+	// Values is a YAML document of values for the chart, overriding the defaults of the chart.
+	// +optional
+	Values string `json:"values,omitempty"`
+
+	// TemplateValues renders the Values as a Go template for each cluster before templating the chart. The
+	// template may refer to the name, namespace, and labels of the ClusterDeployment as
+	// {{ .ClusterDeployment.Name }}, {{ .ClusterDeployment.Namespace }}, and
+	// {{ index .ClusterDeployment.Labels "key" }}.
+	// +optional
+	TemplateValues bool `json:"templateValues,omitempty"`
+}
+
+// SyncSetSource is a reference to an external source of manifests for a SyncSet or SelectorSyncSet.
+// Exactly one of Git or OCI must be set.
+type SyncSetSource struct {
+	// Git is a reference to a path and revision of a Git repository.
+	// +optional
+	Git *GitSyncSetSource `json:"git,omitempty"`
+
+	// OCI is a reference to an OCI artifact.
+	// +optional
+	OCI *OCISyncSetSource `json:"oci,omitempty"`
+
+	// Path is the directory within the source containing the manifests to sync. Files in the directory and
+	// its subdirectories ending in .yaml, .yml, or .json are applied in lexical order of their paths.
+	// Defaults to the root of the source.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// RefreshInterval is how often the source is checked for a new revision. Defaults to 5m.
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+
+	// CredentialsSecretRef is a reference to a secret on the management cluster with the "username" and
+	// "password" keys used to authenticate with the Git server or OCI registry. The namespace of the secret
+	// is required for SelectorSyncSets and must be the namespace of the SyncSet for SyncSets.
+	// +optional
+	CredentialsSecretRef *SecretReference `json:"credentialsSecretRef,omitempty"`
+}
+
+// GitSyncSetSource is a reference to a revision of a Git repository.
+type GitSyncSetSource struct {
+	// URL is the URL of the Git repository.
+	URL string `json:"url"`
+
+	// Revision is the branch, tag, or commit to sync. Defaults to HEAD.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+}
+
+// OCISyncSetSource is a reference to an OCI artifact. The manifests are read from the layers of the artifact,
+// which may either be tar archives or individual files named by the org.opencontainers.image.title annotation.
+type OCISyncSetSource struct {
+	// Image is the reference of the artifact, e.g. quay.io/example/manifests:v1 or
+	// quay.io/example/manifests@sha256:<digest>.
+	Image string `json:"image"`
+
+	// Insecure allows the artifact to be fetched from the registry over plain HTTP. The registry must be listed in
+	// the SyncSetInsecureRegistries of the HiveConfig.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// SyncSetSourceStatus is the status of fetching the Source of a SyncSet or SelectorSyncSet.
+type SyncSetSourceStatus struct {
+	// ObservedGeneration is the generation of the syncset for which the source was last fetched.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// ResolvedRevision is the Git commit or OCI artifact digest of the manifests most recently fetched.
+	// +optional
+	ResolvedRevision string `json:"resolvedRevision,omitempty"`
+
+	// LastFetchTime is the last time the source was successfully fetched.
+	// +optional
+	LastFetchTime *metav1.Time `json:"lastFetchTime,omitempty"`
+
+	// FailureMessage describes why the last attempt to fetch the source failed.
+	// +optional
+	FailureMessage string `json:"failureMessage,omitempty"`
 }
 
 // SelectorSyncSetSpec defines the SyncSetCommonSpec resources and patches to sync along
@@ -255,6 +495,57 @@ type SelectorSyncSetSpec struct {
 	// applies to in any namespace.
 	// +optional
 	ClusterDeploymentSelector metav1.LabelSelector `json:"clusterDeploymentSelector,omitempty"`
+
+	// RolloutStrategy configures a progressive rollout of changes to the SelectorSyncSet across the
+	// clusters it applies to. When unset, a new generation is applied to all matching clusters at once.
+	// +optional
+	RolloutStrategy *SelectorSyncSetRolloutStrategy `json:"rolloutStrategy,omitempty"`
+}
+
+// SelectorSyncSetRolloutStrategy defines how a new generation of a SelectorSyncSet is rolled out to the
+// clusters it applies to. The new generation is first applied to a canary subset of clusters. Once every
+// cluster in the current wave has successfully applied it for WaveInterval, the rollout expands by
+// WavePercentage of the matching clusters, until all clusters have been updated. Clusters which are not
+// yet part of the rollout keep the resources from the generation they last applied.
+type SelectorSyncSetRolloutStrategy struct {
+	// Canary defines the clusters that receive a new generation of the SelectorSyncSet first.
+	// If unset, the first wave is WavePercentage of the matching clusters.
+	// +optional
+	Canary *SelectorSyncSetCanary `json:"canary,omitempty"`
+
+	// WavePercentage is the percentage of the clusters matching the SelectorSyncSet that is added to
+	// the rollout in each wave after the canary. Defaults to 25.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	WavePercentage *int32 `json:"wavePercentage,omitempty"`
+
+	// WaveInterval is how long all of the clusters in a wave must have successfully applied the new
+	// generation before the rollout expands to the next wave. Defaults to 10m.
+	// +optional
+	WaveInterval *metav1.Duration `json:"waveInterval,omitempty"`
+
+	// MaxFailurePercentage is the percentage of the clusters in the rollout that may fail to apply the
+	// new generation before the rollout is halted. A halted rollout does not expand any further until
+	// the SelectorSyncSet is changed again. Defaults to 0, halting on the first failure.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxFailurePercentage int32 `json:"maxFailurePercentage,omitempty"`
+}
+
+// SelectorSyncSetCanary defines the clusters which receive a new generation of a SelectorSyncSet first.
+// A cluster is in the canary if it matches the Selector or falls within the Percentage.
+type SelectorSyncSetCanary struct {
+	// Percentage is the percentage of the clusters matching the SelectorSyncSet that are part of the canary.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percentage int32 `json:"percentage,omitempty"`
+
+	// Selector is a LabelSelector identifying ClusterDeployments that are part of the canary.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 }
 
 // SyncSetSpec defines the SyncSetCommonSpec resources and patches to sync along with
@@ -271,10 +562,83 @@ type SyncSetSpec struct {
 
 // SyncSetStatus defines the observed state of a SyncSet
 type SyncSetStatus struct {
+	// Source is the status of fetching the Source of the SyncSet.
+	// +optional
+	Source *SyncSetSourceStatus `json:"source,omitempty"`
 }
 
 // SelectorSyncSetStatus defines the observed state of a SelectorSyncSet
 type SelectorSyncSetStatus struct {
+	// Source is the status of fetching the Source of the SelectorSyncSet.
+	// +optional
+	Source *SyncSetSourceStatus `json:"source,omitempty"`
+
+	// Rollout is the status of the rollout of the current generation of the SelectorSyncSet. It is only
+	// set when the SelectorSyncSet has a RolloutStrategy.
+	// +optional
+	Rollout *SelectorSyncSetRolloutStatus `json:"rollout,omitempty"`
+}
+
+// SelectorSyncSetRolloutPhase is the phase of a SelectorSyncSet rollout.
+// +kubebuilder:validation:Enum=Progressing;Halted;Complete
+type SelectorSyncSetRolloutPhase string
+
+const (
+	// ProgressingSelectorSyncSetRolloutPhase indicates that the new generation is being rolled out in waves.
+	ProgressingSelectorSyncSetRolloutPhase SelectorSyncSetRolloutPhase = "Progressing"
+
+	// HaltedSelectorSyncSetRolloutPhase indicates that too many clusters failed to apply the new generation
+	// and the rollout will not expand until the SelectorSyncSet is changed again.
+	HaltedSelectorSyncSetRolloutPhase SelectorSyncSetRolloutPhase = "Halted"
+
+	// CompleteSelectorSyncSetRolloutPhase indicates that the new generation has been rolled out to all
+	// matching clusters.
+	CompleteSelectorSyncSetRolloutPhase SelectorSyncSetRolloutPhase = "Complete"
+)
+
+// SelectorSyncSetRolloutStatus is the status of the rollout of a generation of a SelectorSyncSet.
+type SelectorSyncSetRolloutStatus struct {
+	// ObservedGeneration is the generation of the SelectorSyncSet being rolled out.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Phase is the phase of the rollout.
+	Phase SelectorSyncSetRolloutPhase `json:"phase"`
+
+	// Wave is the current wave of the rollout. Wave 0 is the canary.
+	Wave int32 `json:"wave"`
+
+	// Percentage is the percentage of the matching clusters, in addition to any clusters selected by the
+	// canary selector, that are currently part of the rollout.
+	Percentage int32 `json:"percentage"`
+
+	// WaveStartTime is the time the current wave was started.
+	// +optional
+	WaveStartTime *metav1.Time `json:"waveStartTime,omitempty"`
+
+	// WaveSucceededTime is the time all of the clusters in the current wave had successfully applied the
+	// new generation. It is cleared whenever a cluster in the wave is not up to date.
+	// +optional
+	WaveSucceededTime *metav1.Time `json:"waveSucceededTime,omitempty"`
+
+	// TotalClusters is the number of clusters matching the SelectorSyncSet.
+	TotalClusters int32 `json:"totalClusters"`
+
+	// UpdatedClusters is the number of clusters that have successfully applied the generation being rolled out.
+	UpdatedClusters int32 `json:"updatedClusters"`
+
+	// FailedClusters is the number of clusters that failed to apply the generation being rolled out.
+	FailedClusters int32 `json:"failedClusters"`
+
+	// OutdatedClusters is the number of clusters that have not yet applied the generation being rolled out.
+	OutdatedClusters int32 `json:"outdatedClusters"`
+
+	// Message is a human-readable description of the state of the rollout.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the last time the phase of the rollout changed.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // +genclient
@@ -79,6 +79,22 @@ func (in *AWSClusterDeprovision) DeepCopy() *AWSClusterDeprovision {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSDNSSECSpec) DeepCopyInto(out *AWSDNSSECSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSDNSSECSpec.
+func (in *AWSDNSSECSpec) DeepCopy() *AWSDNSSECSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSDNSSECSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSDNSZoneSpec) DeepCopyInto(out *AWSDNSZoneSpec) {
 	*out = *in
@@ -262,6 +278,23 @@ func (in *AlibabaCloudClusterDeprovision) DeepCopy() *AlibabaCloudClusterDeprovi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlibabaCloudDNSZoneSpec) DeepCopyInto(out *AlibabaCloudDNSZoneSpec) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlibabaCloudDNSZoneSpec.
+func (in *AlibabaCloudDNSZoneSpec) DeepCopy() *AlibabaCloudDNSZoneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlibabaCloudDNSZoneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgoCDConfig) DeepCopyInto(out *ArgoCDConfig) {
 	*out = *in
@@ -892,6 +925,11 @@ func (in *ClusterDeploymentSpec) DeepCopyInto(out *ClusterDeploymentSpec) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(ClusterUpgrade)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -953,6 +991,11 @@ func (in *ClusterDeploymentStatus) DeepCopyInto(out *ClusterDeploymentStatus) {
 		*out = new(PlatformStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(ClusterUpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1575,6 +1618,11 @@ func (in *ClusterPoolSpec) DeepCopyInto(out *ClusterPoolSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.PostInstallValidation != nil {
+		in, out := &in.PostInstallValidation, &out.PostInstallValidation
+		*out = new(PostInstallValidation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ClaimLifetime != nil {
 		in, out := &in.ClaimLifetime, &out.ClaimLifetime
 		*out = new(ClusterPoolClaimLifetime)
@@ -1760,6 +1808,11 @@ func (in *ClusterProvisionSpec) DeepCopyInto(out *ClusterProvisionSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ExcludedZones != nil {
+		in, out := &in.ExcludedZones, &out.ExcludedZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1788,6 +1841,23 @@ func (in *ClusterProvisionStatus) DeepCopyInto(out *ClusterProvisionStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LogURLs != nil {
+		in, out := &in.LogURLs, &out.LogURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailureClassification != nil {
+		in, out := &in.FailureClassification, &out.FailureClassification
+		*out = new(ProvisionFailureClassification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Milestones != nil {
+		in, out := &in.Milestones, &out.Milestones
+		*out = make([]ProvisionMilestone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2000,6 +2070,218 @@ func (in *ClusterStateStatus) DeepCopy() *ClusterStateStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterUpgrade) DeepCopyInto(out *ClusterUpgrade) {
+	*out = *in
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpgrade.
+func (in *ClusterUpgrade) DeepCopy() *ClusterUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterUpgradeRollout) DeepCopyInto(out *ClusterUpgradeRollout) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpgradeRollout.
+func (in *ClusterUpgradeRollout) DeepCopy() *ClusterUpgradeRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterUpgradeRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterUpgradeRollout) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterUpgradeRolloutList) DeepCopyInto(out *ClusterUpgradeRolloutList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterUpgradeRollout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpgradeRolloutList.
+func (in *ClusterUpgradeRolloutList) DeepCopy() *ClusterUpgradeRolloutList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterUpgradeRolloutList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterUpgradeRolloutList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterUpgradeRolloutSpec) DeepCopyInto(out *ClusterUpgradeRolloutSpec) {
+	*out = *in
+	in.Upgrade.DeepCopyInto(&out.Upgrade)
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]ClusterUpgradeWave, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpgradeRolloutSpec.
+func (in *ClusterUpgradeRolloutSpec) DeepCopy() *ClusterUpgradeRolloutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterUpgradeRolloutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterUpgradeRolloutStatus) DeepCopyInto(out *ClusterUpgradeRolloutStatus) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]ClusterUpgradeWaveStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpgradeRolloutStatus.
+func (in *ClusterUpgradeRolloutStatus) DeepCopy() *ClusterUpgradeRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterUpgradeRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterUpgradeStatus) DeepCopyInto(out *ClusterUpgradeStatus) {
+	*out = *in
+	if in.StartedTime != nil {
+		in, out := &in.StartedTime, &out.StartedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextMaintenanceWindow != nil {
+		in, out := &in.NextMaintenanceWindow, &out.NextMaintenanceWindow
+		*out = (*in).DeepCopy()
+	}
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpgradeStatus.
+func (in *ClusterUpgradeStatus) DeepCopy() *ClusterUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterUpgradeWave) DeepCopyInto(out *ClusterUpgradeWave) {
+	*out = *in
+	in.ClusterDeploymentSelector.DeepCopyInto(&out.ClusterDeploymentSelector)
+	if in.MaxConcurrent != nil {
+		in, out := &in.MaxConcurrent, &out.MaxConcurrent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SoakTime != nil {
+		in, out := &in.SoakTime, &out.SoakTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpgradeWave.
+func (in *ClusterUpgradeWave) DeepCopy() *ClusterUpgradeWave {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterUpgradeWave)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterUpgradeWaveStatus) DeepCopyInto(out *ClusterUpgradeWaveStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterUpgradeWaveStatus.
+func (in *ClusterUpgradeWaveStatus) DeepCopy() *ClusterUpgradeWaveStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterUpgradeWaveStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlaneAdditionalCertificate) DeepCopyInto(out *ControlPlaneAdditionalCertificate) {
 	*out = *in
@@ -2149,105 +2431,227 @@ func (in *ControllersConfig) DeepCopy() *ControllersConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSZone) DeepCopyInto(out *DNSZone) {
+func (in *CustomHealthRule) DeepCopyInto(out *CustomHealthRule) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSZone.
-func (in *DNSZone) DeepCopy() *DNSZone {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomHealthRule.
+func (in *CustomHealthRule) DeepCopy() *CustomHealthRule {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSZone)
+	out := new(CustomHealthRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DNSZone) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSZoneCondition) DeepCopyInto(out *DNSZoneCondition) {
+func (in *DNSRecord) DeepCopyInto(out *DNSRecord) {
 	*out = *in
-	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
-	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSZoneCondition.
-func (in *DNSZoneCondition) DeepCopy() *DNSZoneCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecord.
+func (in *DNSRecord) DeepCopy() *DNSRecord {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSZoneCondition)
+	out := new(DNSRecord)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSZoneList) DeepCopyInto(out *DNSZoneList) {
+func (in *DNSRecordStatus) DeepCopyInto(out *DNSRecordStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]DNSZone, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSZoneList.
-func (in *DNSZoneList) DeepCopy() *DNSZoneList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordStatus.
+func (in *DNSRecordStatus) DeepCopy() *DNSRecordStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSZoneList)
+	out := new(DNSRecordStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DNSZoneList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSZoneSpec) DeepCopyInto(out *DNSZoneSpec) {
+func (in *DNSSECSpec) DeepCopyInto(out *DNSSECSpec) {
 	*out = *in
 	if in.AWS != nil {
 		in, out := &in.AWS, &out.AWS
-		*out = new(AWSDNSZoneSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.GCP != nil {
-		in, out := &in.GCP, &out.GCP
-		*out = new(GCPDNSZoneSpec)
-		**out = **in
-	}
-	if in.Azure != nil {
-		in, out := &in.Azure, &out.Azure
-		*out = new(AzureDNSZoneSpec)
+		*out = new(AWSDNSSECSpec)
 		**out = **in
 	}
 	return
 }
 
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSECSpec.
+func (in *DNSSECSpec) DeepCopy() *DNSSECSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSECSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSECStatus) DeepCopyInto(out *DNSSECStatus) {
+	*out = *in
+	if in.DSRecords != nil {
+		in, out := &in.DSRecords, &out.DSRecords
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSECStatus.
+func (in *DNSSECStatus) DeepCopy() *DNSSECStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSECStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSZone) DeepCopyInto(out *DNSZone) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSZone.
+func (in *DNSZone) DeepCopy() *DNSZone {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSZone) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSZoneCondition) DeepCopyInto(out *DNSZoneCondition) {
+	*out = *in
+	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSZoneCondition.
+func (in *DNSZoneCondition) DeepCopy() *DNSZoneCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSZoneCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSZoneList) DeepCopyInto(out *DNSZoneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DNSZone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSZoneList.
+func (in *DNSZoneList) DeepCopy() *DNSZoneList {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSZoneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSZoneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSZoneSpec) DeepCopyInto(out *DNSZoneSpec) {
+	*out = *in
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSDNSZoneSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GCP != nil {
+		in, out := &in.GCP, &out.GCP
+		*out = new(GCPDNSZoneSpec)
+		**out = **in
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureDNSZoneSpec)
+		**out = **in
+	}
+	if in.IBMCloud != nil {
+		in, out := &in.IBMCloud, &out.IBMCloud
+		*out = new(IBMCloudDNSZoneSpec)
+		**out = **in
+	}
+	if in.AlibabaCloud != nil {
+		in, out := &in.AlibabaCloud, &out.AlibabaCloud
+		*out = new(AlibabaCloudDNSZoneSpec)
+		**out = **in
+	}
+	if in.RFC2136 != nil {
+		in, out := &in.RFC2136, &out.RFC2136
+		*out = new(RFC2136DNSZoneSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSSEC != nil {
+		in, out := &in.DNSSEC, &out.DNSSEC
+		*out = new(DNSSECSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Records != nil {
+		in, out := &in.Records, &out.Records
+		*out = make([]DNSRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSZoneSpec.
 func (in *DNSZoneSpec) DeepCopy() *DNSZoneSpec {
 	if in == nil {
@@ -2285,6 +2689,26 @@ func (in *DNSZoneStatus) DeepCopyInto(out *DNSZoneStatus) {
 		*out = new(AzureDNSZoneStatus)
 		**out = **in
 	}
+	if in.IBMCloud != nil {
+		in, out := &in.IBMCloud, &out.IBMCloud
+		*out = new(IBMCloudDNSZoneStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSSEC != nil {
+		in, out := &in.DNSSEC, &out.DNSSEC
+		*out = new(DNSSECStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PublishedDSRecords != nil {
+		in, out := &in.PublishedDSRecords, &out.PublishedDSRecords
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Records != nil {
+		in, out := &in.Records, &out.Records
+		*out = make([]DNSRecordStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]DNSZoneCondition, len(*in))
@@ -2326,6 +2750,37 @@ func (in *DeploymentConfig) DeepCopy() *DeploymentConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalSecretSource) DeepCopyInto(out *ExternalSecretSource) {
+	*out = *in
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultSecretSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileSecretSource)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretSource.
+func (in *ExternalSecretSource) DeepCopy() *ExternalSecretSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalSecretSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FailedProvisionAWSConfig) DeepCopyInto(out *FailedProvisionAWSConfig) {
 	*out = *in
@@ -2343,6 +2798,23 @@ func (in *FailedProvisionAWSConfig) DeepCopy() *FailedProvisionAWSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailedProvisionAzureConfig) DeepCopyInto(out *FailedProvisionAzureConfig) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailedProvisionAzureConfig.
+func (in *FailedProvisionAzureConfig) DeepCopy() *FailedProvisionAzureConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FailedProvisionAzureConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FailedProvisionConfig) DeepCopyInto(out *FailedProvisionConfig) {
 	*out = *in
@@ -2351,6 +2823,21 @@ func (in *FailedProvisionConfig) DeepCopyInto(out *FailedProvisionConfig) {
 		*out = new(FailedProvisionAWSConfig)
 		**out = **in
 	}
+	if in.GCP != nil {
+		in, out := &in.GCP, &out.GCP
+		*out = new(FailedProvisionGCPConfig)
+		**out = **in
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(FailedProvisionAzureConfig)
+		**out = **in
+	}
+	if in.Archive != nil {
+		in, out := &in.Archive, &out.Archive
+		*out = new(InstallLogArchiveConfig)
+		**out = **in
+	}
 	if in.RetryReasons != nil {
 		in, out := &in.RetryReasons, &out.RetryReasons
 		*out = new([]string)
@@ -2373,6 +2860,23 @@ func (in *FailedProvisionConfig) DeepCopy() *FailedProvisionConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailedProvisionGCPConfig) DeepCopyInto(out *FailedProvisionGCPConfig) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailedProvisionGCPConfig.
+func (in *FailedProvisionGCPConfig) DeepCopy() *FailedProvisionGCPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FailedProvisionGCPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureGateSelection) DeepCopyInto(out *FeatureGateSelection) {
 	*out = *in
@@ -2415,6 +2919,22 @@ func (in *FeatureGatesEnabled) DeepCopy() *FeatureGatesEnabled {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSecretSource) DeepCopyInto(out *FileSecretSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSecretSource.
+func (in *FileSecretSource) DeepCopy() *FileSecretSource {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSecretSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPClusterDeprovision) DeepCopyInto(out *GCPClusterDeprovision) {
 	*out = *in
@@ -2479,6 +2999,38 @@ func (in *GCPDNSZoneStatus) DeepCopy() *GCPDNSZoneStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSyncSetSource) DeepCopyInto(out *GitSyncSetSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSyncSetSource.
+func (in *GitSyncSetSource) DeepCopy() *GitSyncSetSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GitSyncSetSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChartSyncSetRender) DeepCopyInto(out *HelmChartSyncSetRender) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChartSyncSetRender.
+func (in *HelmChartSyncSetRender) DeepCopy() *HelmChartSyncSetRender {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmChartSyncSetRender)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HibernationConfig) DeepCopyInto(out *HibernationConfig) {
 	*out = *in
@@ -2598,6 +3150,26 @@ func (in *HiveConfigSpec) DeepCopyInto(out *HiveConfigSpec) {
 	in.Backup.DeepCopyInto(&out.Backup)
 	in.FailedProvisionConfig.DeepCopyInto(&out.FailedProvisionConfig)
 	in.ServiceProviderCredentialsConfig.DeepCopyInto(&out.ServiceProviderCredentialsConfig)
+	if in.SyncSetSecretsVolume != nil {
+		in, out := &in.SyncSetSecretsVolume, &out.SyncSetSecretsVolume
+		*out = new(corev1.CSIVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SyncSetInsecureRegistries != nil {
+		in, out := &in.SyncSetInsecureRegistries, &out.SyncSetInsecureRegistries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SyncSetHelmChartRepoHosts != nil {
+		in, out := &in.SyncSetHelmChartRepoHosts, &out.SyncSetHelmChartRepoHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SyncSetVaultAddresses != nil {
+		in, out := &in.SyncSetVaultAddresses, &out.SyncSetVaultAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.MaintenanceMode != nil {
 		in, out := &in.MaintenanceMode, &out.MaintenanceMode
 		*out = new(bool)
@@ -2686,6 +3258,49 @@ func (in *HiveConfigStatus) DeepCopy() *HiveConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMCloudDNSZoneSpec) DeepCopyInto(out *IBMCloudDNSZoneSpec) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMCloudDNSZoneSpec.
+func (in *IBMCloudDNSZoneSpec) DeepCopy() *IBMCloudDNSZoneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMCloudDNSZoneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMCloudDNSZoneStatus) DeepCopyInto(out *IBMCloudDNSZoneStatus) {
+	*out = *in
+	if in.CISInstanceCRN != nil {
+		in, out := &in.CISInstanceCRN, &out.CISInstanceCRN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneID != nil {
+		in, out := &in.ZoneID, &out.ZoneID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMCloudDNSZoneStatus.
+func (in *IBMCloudDNSZoneStatus) DeepCopy() *IBMCloudDNSZoneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMCloudDNSZoneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMClusterDeprovision) DeepCopyInto(out *IBMClusterDeprovision) {
 	*out = *in
@@ -2703,9 +3318,70 @@ func (in *IBMClusterDeprovision) DeepCopy() *IBMClusterDeprovision {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityProviderClusterStatus) DeepCopyInto(out *IdentityProviderClusterStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProviderClusterStatus.
+func (in *IdentityProviderClusterStatus) DeepCopy() *IdentityProviderClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityProviderClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityProviderObjectMapping) DeepCopyInto(out *IdentityProviderObjectMapping) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProviderObjectMapping.
+func (in *IdentityProviderObjectMapping) DeepCopy() *IdentityProviderObjectMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityProviderObjectMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityProviderReferencedObjects) DeepCopyInto(out *IdentityProviderReferencedObjects) {
+	*out = *in
+	if in.Mappings != nil {
+		in, out := &in.Mappings, &out.Mappings
+		*out = make([]IdentityProviderObjectMapping, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProviderReferencedObjects.
+func (in *IdentityProviderReferencedObjects) DeepCopy() *IdentityProviderReferencedObjects {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityProviderReferencedObjects)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IdentityProviderStatus) DeepCopyInto(out *IdentityProviderStatus) {
 	*out = *in
+	if in.ClusterDeployments != nil {
+		in, out := &in.ClusterDeployments, &out.ClusterDeployments
+		*out = make([]IdentityProviderClusterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2719,6 +3395,22 @@ func (in *IdentityProviderStatus) DeepCopy() *IdentityProviderStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallLogArchiveConfig) DeepCopyInto(out *InstallLogArchiveConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallLogArchiveConfig.
+func (in *InstallLogArchiveConfig) DeepCopy() *InstallLogArchiveConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallLogArchiveConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InventoryEntry) DeepCopyInto(out *InventoryEntry) {
 	*out = *in
@@ -2746,7 +3438,30 @@ func (in *KubeconfigSecretReference) DeepCopy() *KubeconfigSecretReference {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeconfigSecretReference)
+	out := new(KubeconfigSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KustomizeSyncSetRender) DeepCopyInto(out *KustomizeSyncSetRender) {
+	*out = *in
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizeSyncSetRender.
+func (in *KustomizeSyncSetRender) DeepCopy() *KustomizeSyncSetRender {
+	if in == nil {
+		return nil
+	}
+	out := new(KustomizeSyncSetRender)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3103,10 +3818,37 @@ func (in *MachineSetStatus) DeepCopy() *MachineSetStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManageDNSAWSConfig) DeepCopyInto(out *ManageDNSAWSConfig) {
 	*out = *in
 	out.CredentialsSecretRef = in.CredentialsSecretRef
+	if in.CredentialsAssumeRole != nil {
+		in, out := &in.CredentialsAssumeRole, &out.CredentialsAssumeRole
+		*out = new(aws.AssumeRole)
+		**out = **in
+	}
 	return
 }
 
@@ -3120,6 +3862,23 @@ func (in *ManageDNSAWSConfig) DeepCopy() *ManageDNSAWSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManageDNSAlibabaCloudConfig) DeepCopyInto(out *ManageDNSAlibabaCloudConfig) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManageDNSAlibabaCloudConfig.
+func (in *ManageDNSAlibabaCloudConfig) DeepCopy() *ManageDNSAlibabaCloudConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ManageDNSAlibabaCloudConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManageDNSAzureConfig) DeepCopyInto(out *ManageDNSAzureConfig) {
 	*out = *in
@@ -3148,7 +3907,7 @@ func (in *ManageDNSConfig) DeepCopyInto(out *ManageDNSConfig) {
 	if in.AWS != nil {
 		in, out := &in.AWS, &out.AWS
 		*out = new(ManageDNSAWSConfig)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.GCP != nil {
 		in, out := &in.GCP, &out.GCP
@@ -3160,6 +3919,21 @@ func (in *ManageDNSConfig) DeepCopyInto(out *ManageDNSConfig) {
 		*out = new(ManageDNSAzureConfig)
 		**out = **in
 	}
+	if in.IBMCloud != nil {
+		in, out := &in.IBMCloud, &out.IBMCloud
+		*out = new(ManageDNSIBMCloudConfig)
+		**out = **in
+	}
+	if in.AlibabaCloud != nil {
+		in, out := &in.AlibabaCloud, &out.AlibabaCloud
+		*out = new(ManageDNSAlibabaCloudConfig)
+		**out = **in
+	}
+	if in.RFC2136 != nil {
+		in, out := &in.RFC2136, &out.RFC2136
+		*out = new(ManageDNSRFC2136Config)
+		**out = **in
+	}
 	return
 }
 
@@ -3190,6 +3964,56 @@ func (in *ManageDNSGCPConfig) DeepCopy() *ManageDNSGCPConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManageDNSIBMCloudConfig) DeepCopyInto(out *ManageDNSIBMCloudConfig) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManageDNSIBMCloudConfig.
+func (in *ManageDNSIBMCloudConfig) DeepCopy() *ManageDNSIBMCloudConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ManageDNSIBMCloudConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManageDNSRFC2136Config) DeepCopyInto(out *ManageDNSRFC2136Config) {
+	*out = *in
+	out.TSIGSecretRef = in.TSIGSecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManageDNSRFC2136Config.
+func (in *ManageDNSRFC2136Config) DeepCopy() *ManageDNSRFC2136Config {
+	if in == nil {
+		return nil
+	}
+	out := new(ManageDNSRFC2136Config)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCISyncSetSource) DeepCopyInto(out *OCISyncSetSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCISyncSetSource.
+func (in *OCISyncSetSource) DeepCopy() *OCISyncSetSource {
+	if in == nil {
+		return nil
+	}
+	out := new(OCISyncSetSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenStackClusterDeprovision) DeepCopyInto(out *OpenStackClusterDeprovision) {
 	*out = *in
@@ -3342,6 +4166,122 @@ func (in *PlatformStatus) DeepCopy() *PlatformStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostInstallValidation) DeepCopyInto(out *PostInstallValidation) {
+	*out = *in
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(PostInstallValidationJob)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostInstallValidation.
+func (in *PostInstallValidation) DeepCopy() *PostInstallValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(PostInstallValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostInstallValidationJob) DeepCopyInto(out *PostInstallValidationJob) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostInstallValidationJob.
+func (in *PostInstallValidationJob) DeepCopy() *PostInstallValidationJob {
+	if in == nil {
+		return nil
+	}
+	out := new(PostInstallValidationJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisionFailureClassification) DeepCopyInto(out *ProvisionFailureClassification) {
+	*out = *in
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(ProvisionRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisionFailureClassification.
+func (in *ProvisionFailureClassification) DeepCopy() *ProvisionFailureClassification {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisionFailureClassification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisionMilestone) DeepCopyInto(out *ProvisionMilestone) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisionMilestone.
+func (in *ProvisionMilestone) DeepCopy() *ProvisionMilestone {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisionMilestone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisionRetryPolicy) DeepCopyInto(out *ProvisionRetryPolicy) {
+	*out = *in
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisionRetryPolicy.
+func (in *ProvisionRetryPolicy) DeepCopy() *ProvisionRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisionRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Provisioning) DeepCopyInto(out *Provisioning) {
 	*out = *in
@@ -3382,6 +4322,11 @@ func (in *Provisioning) DeepCopyInto(out *Provisioning) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PostInstallValidation != nil {
+		in, out := &in.PostInstallValidation, &out.PostInstallValidation
+		*out = new(PostInstallValidation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3395,6 +4340,27 @@ func (in *Provisioning) DeepCopy() *Provisioning {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RFC2136DNSZoneSpec) DeepCopyInto(out *RFC2136DNSZoneSpec) {
+	*out = *in
+	if in.TSIGSecretRef != nil {
+		in, out := &in.TSIGSecretRef, &out.TSIGSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RFC2136DNSZoneSpec.
+func (in *RFC2136DNSZoneSpec) DeepCopy() *RFC2136DNSZoneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RFC2136DNSZoneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReleaseImageVerificationConfigMapReference) DeepCopyInto(out *ReleaseImageVerificationConfigMapReference) {
 	*out = *in
@@ -3415,6 +4381,11 @@ func (in *ReleaseImageVerificationConfigMapReference) DeepCopy() *ReleaseImageVe
 func (in *SecretMapping) DeepCopyInto(out *SecretMapping) {
 	*out = *in
 	out.SourceRef = in.SourceRef
+	if in.ExternalSource != nil {
+		in, out := &in.ExternalSource, &out.ExternalSource
+		*out = new(ExternalSecretSource)
+		(*in).DeepCopyInto(*out)
+	}
 	out.TargetRef = in.TargetRef
 	return
 }
@@ -3451,7 +4422,7 @@ func (in *SelectorSyncIdentityProvider) DeepCopyInto(out *SelectorSyncIdentityPr
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -3474,13 +4445,92 @@ func (in *SelectorSyncIdentityProvider) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SelectorSyncIdentityProviderList) DeepCopyInto(out *SelectorSyncIdentityProviderList) {
+func (in *SelectorSyncIdentityProviderList) DeepCopyInto(out *SelectorSyncIdentityProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SelectorSyncIdentityProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorSyncIdentityProviderList.
+func (in *SelectorSyncIdentityProviderList) DeepCopy() *SelectorSyncIdentityProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectorSyncIdentityProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SelectorSyncIdentityProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectorSyncIdentityProviderSpec) DeepCopyInto(out *SelectorSyncIdentityProviderSpec) {
+	*out = *in
+	in.SyncIdentityProviderCommonSpec.DeepCopyInto(&out.SyncIdentityProviderCommonSpec)
+	in.ClusterDeploymentSelector.DeepCopyInto(&out.ClusterDeploymentSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorSyncIdentityProviderSpec.
+func (in *SelectorSyncIdentityProviderSpec) DeepCopy() *SelectorSyncIdentityProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectorSyncIdentityProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectorSyncRBAC) DeepCopyInto(out *SelectorSyncRBAC) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorSyncRBAC.
+func (in *SelectorSyncRBAC) DeepCopy() *SelectorSyncRBAC {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectorSyncRBAC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SelectorSyncRBAC) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectorSyncRBACList) DeepCopyInto(out *SelectorSyncRBACList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SelectorSyncIdentityProvider, len(*in))
+		*out = make([]SelectorSyncRBAC, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -3488,18 +4538,18 @@ func (in *SelectorSyncIdentityProviderList) DeepCopyInto(out *SelectorSyncIdenti
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorSyncIdentityProviderList.
-func (in *SelectorSyncIdentityProviderList) DeepCopy() *SelectorSyncIdentityProviderList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorSyncRBACList.
+func (in *SelectorSyncRBACList) DeepCopy() *SelectorSyncRBACList {
 	if in == nil {
 		return nil
 	}
-	out := new(SelectorSyncIdentityProviderList)
+	out := new(SelectorSyncRBACList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SelectorSyncIdentityProviderList) DeepCopyObject() runtime.Object {
+func (in *SelectorSyncRBACList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3507,19 +4557,62 @@ func (in *SelectorSyncIdentityProviderList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SelectorSyncIdentityProviderSpec) DeepCopyInto(out *SelectorSyncIdentityProviderSpec) {
+func (in *SelectorSyncRBACSpec) DeepCopyInto(out *SelectorSyncRBACSpec) {
 	*out = *in
-	in.SyncIdentityProviderCommonSpec.DeepCopyInto(&out.SyncIdentityProviderCommonSpec)
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]SyncRBACGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClusterRoleBindings != nil {
+		in, out := &in.ClusterRoleBindings, &out.ClusterRoleBindings
+		*out = make([]SyncRBACClusterRoleBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RoleBindings != nil {
+		in, out := &in.RoleBindings, &out.RoleBindings
+		*out = make([]SyncRBACRoleBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.ClusterDeploymentSelector.DeepCopyInto(&out.ClusterDeploymentSelector)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorSyncIdentityProviderSpec.
-func (in *SelectorSyncIdentityProviderSpec) DeepCopy() *SelectorSyncIdentityProviderSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorSyncRBACSpec.
+func (in *SelectorSyncRBACSpec) DeepCopy() *SelectorSyncRBACSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SelectorSyncIdentityProviderSpec)
+	out := new(SelectorSyncRBACSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectorSyncRBACStatus) DeepCopyInto(out *SelectorSyncRBACStatus) {
+	*out = *in
+	if in.ClusterDeployments != nil {
+		in, out := &in.ClusterDeployments, &out.ClusterDeployments
+		*out = make([]SyncRBACClusterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorSyncRBACStatus.
+func (in *SelectorSyncRBACStatus) DeepCopy() *SelectorSyncRBACStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectorSyncRBACStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3530,7 +4623,7 @@ func (in *SelectorSyncSet) DeepCopyInto(out *SelectorSyncSet) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -3552,6 +4645,27 @@ func (in *SelectorSyncSet) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectorSyncSetCanary) DeepCopyInto(out *SelectorSyncSetCanary) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorSyncSetCanary.
+func (in *SelectorSyncSetCanary) DeepCopy() *SelectorSyncSetCanary {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectorSyncSetCanary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SelectorSyncSetList) DeepCopyInto(out *SelectorSyncSetList) {
 	*out = *in
@@ -3585,11 +4699,72 @@ func (in *SelectorSyncSetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectorSyncSetRolloutStatus) DeepCopyInto(out *SelectorSyncSetRolloutStatus) {
+	*out = *in
+	if in.WaveStartTime != nil {
+		in, out := &in.WaveStartTime, &out.WaveStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.WaveSucceededTime != nil {
+		in, out := &in.WaveSucceededTime, &out.WaveSucceededTime
+		*out = (*in).DeepCopy()
+	}
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorSyncSetRolloutStatus.
+func (in *SelectorSyncSetRolloutStatus) DeepCopy() *SelectorSyncSetRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectorSyncSetRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectorSyncSetRolloutStrategy) DeepCopyInto(out *SelectorSyncSetRolloutStrategy) {
+	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(SelectorSyncSetCanary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WavePercentage != nil {
+		in, out := &in.WavePercentage, &out.WavePercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.WaveInterval != nil {
+		in, out := &in.WaveInterval, &out.WaveInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorSyncSetRolloutStrategy.
+func (in *SelectorSyncSetRolloutStrategy) DeepCopy() *SelectorSyncSetRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectorSyncSetRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SelectorSyncSetSpec) DeepCopyInto(out *SelectorSyncSetSpec) {
 	*out = *in
 	in.SyncSetCommonSpec.DeepCopyInto(&out.SyncSetCommonSpec)
 	in.ClusterDeploymentSelector.DeepCopyInto(&out.ClusterDeploymentSelector)
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(SelectorSyncSetRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3606,6 +4781,16 @@ func (in *SelectorSyncSetSpec) DeepCopy() *SelectorSyncSetSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SelectorSyncSetStatus) DeepCopyInto(out *SelectorSyncSetStatus) {
 	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(SyncSetSourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(SelectorSyncSetRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3681,7 +4866,7 @@ func (in *SyncIdentityProvider) DeepCopyInto(out *SyncIdentityProvider) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -3713,6 +4898,16 @@ func (in *SyncIdentityProviderCommonSpec) DeepCopyInto(out *SyncIdentityProvider
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TokenConfig != nil {
+		in, out := &in.TokenConfig, &out.TokenConfig
+		*out = new(configv1.TokenConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReferencedObjects != nil {
+		in, out := &in.ReferencedObjects, &out.ReferencedObjects
+		*out = new(IdentityProviderReferencedObjects)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3797,13 +4992,107 @@ func (in *SyncObjectPatch) DeepCopy() *SyncObjectPatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncRBACClusterRoleBinding) DeepCopyInto(out *SyncRBACClusterRoleBinding) {
+	*out = *in
+	out.RoleRef = in.RoleRef
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]rbacv1.Subject, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncRBACClusterRoleBinding.
+func (in *SyncRBACClusterRoleBinding) DeepCopy() *SyncRBACClusterRoleBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncRBACClusterRoleBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncRBACClusterStatus) DeepCopyInto(out *SyncRBACClusterStatus) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]SyncRBACGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncRBACClusterStatus.
+func (in *SyncRBACClusterStatus) DeepCopy() *SyncRBACClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncRBACClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncRBACGroup) DeepCopyInto(out *SyncRBACGroup) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncRBACGroup.
+func (in *SyncRBACGroup) DeepCopy() *SyncRBACGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncRBACGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncRBACRoleBinding) DeepCopyInto(out *SyncRBACRoleBinding) {
+	*out = *in
+	out.RoleRef = in.RoleRef
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]rbacv1.Subject, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncRBACRoleBinding.
+func (in *SyncRBACRoleBinding) DeepCopy() *SyncRBACRoleBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncRBACRoleBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncSet) DeepCopyInto(out *SyncSet) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -3843,7 +5132,29 @@ func (in *SyncSetCommonSpec) DeepCopyInto(out *SyncSetCommonSpec) {
 	if in.Secrets != nil {
 		in, out := &in.Secrets, &out.Secrets
 		*out = make([]SecretMapping, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(SyncSetSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kustomize != nil {
+		in, out := &in.Kustomize, &out.Kustomize
+		*out = new(KustomizeSyncSetRender)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HelmChart != nil {
+		in, out := &in.HelmChart, &out.HelmChart
+		*out = new(HelmChartSyncSetRender)
+		**out = **in
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(SyncSetHealthCheck)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -3858,6 +5169,27 @@ func (in *SyncSetCommonSpec) DeepCopy() *SyncSetCommonSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncSetHealthCheck) DeepCopyInto(out *SyncSetHealthCheck) {
+	*out = *in
+	if in.CustomRules != nil {
+		in, out := &in.CustomRules, &out.CustomRules
+		*out = make([]CustomHealthRule, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncSetHealthCheck.
+func (in *SyncSetHealthCheck) DeepCopy() *SyncSetHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncSetHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncSetList) DeepCopyInto(out *SyncSetList) {
 	*out = *in
@@ -3935,6 +5267,62 @@ func (in *SyncSetObjectStatus) DeepCopy() *SyncSetObjectStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncSetSource) DeepCopyInto(out *SyncSetSource) {
+	*out = *in
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(GitSyncSetSource)
+		**out = **in
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCISyncSetSource)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncSetSource.
+func (in *SyncSetSource) DeepCopy() *SyncSetSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncSetSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncSetSourceStatus) DeepCopyInto(out *SyncSetSourceStatus) {
+	*out = *in
+	if in.LastFetchTime != nil {
+		in, out := &in.LastFetchTime, &out.LastFetchTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncSetSourceStatus.
+func (in *SyncSetSourceStatus) DeepCopy() *SyncSetSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncSetSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncSetSpec) DeepCopyInto(out *SyncSetSpec) {
 	*out = *in
@@ -3960,6 +5348,11 @@ func (in *SyncSetSpec) DeepCopy() *SyncSetSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncSetStatus) DeepCopyInto(out *SyncSetStatus) {
 	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(SyncSetSourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4030,6 +5423,28 @@ func (in *VSphereClusterDeprovision) DeepCopy() *VSphereClusterDeprovision {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultSecretSource) DeepCopyInto(out *VaultSecretSource) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(int)
+		**out = **in
+	}
+	out.TokenSecretRef = in.TokenSecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSecretSource.
+func (in *VaultSecretSource) DeepCopy() *VaultSecretSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSecretSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VeleroBackupConfig) DeepCopyInto(out *VeleroBackupConfig) {
 	*out = *in
@@ -0,0 +1,131 @@
+package v1alpha1
+
+import (
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AgentImageClusterInstallSpec defines the desired state of the AgentImageClusterInstall.
+type AgentImageClusterInstallSpec struct {
+
+	// ImageSetRef is a reference to a ClusterImageSet. The release image specified in the ClusterImageSet will be used
+	// to install the cluster.
+	ImageSetRef hivev1.ClusterImageSetReference `json:"imageSetRef"`
+
+	// ClusterDeploymentRef is a reference to the ClusterDeployment associated with this AgentImageClusterInstall.
+	ClusterDeploymentRef corev1.LocalObjectReference `json:"clusterDeploymentRef"`
+
+	// InstallConfigSecretRef is the reference to a secret that contains an openshift-install
+	// InstallConfig, under the install-config.yaml key. The platform of the InstallConfig must be none or baremetal.
+	// The merged pull secret of the ClusterDeployment is added to it.
+	InstallConfigSecretRef corev1.LocalObjectReference `json:"installConfigSecretRef"`
+
+	// AgentConfigSecretRef is the reference to a secret that contains an openshift-install AgentConfig, under the
+	// agent-config.yaml key, describing the hosts to install and their networking.
+	// +optional
+	AgentConfigSecretRef *corev1.LocalObjectReference `json:"agentConfigSecretRef,omitempty"`
+
+	// ImageStorage is where the agent ISO is stored for the hosts to boot from.
+	ImageStorage AgentImageStorage `json:"imageStorage"`
+
+	// InstallTimeout is how long to wait for the cluster to finish installing once the agent ISO is created, before
+	// the install is considered failed. When not set, the install is waited for until the ClusterDeployment is deleted.
+	// +optional
+	InstallTimeout *metav1.Duration `json:"installTimeout,omitempty"`
+
+	// ClusterMetadata contains metadata information about the installed cluster. It is populated once the agent ISO
+	// is created.
+	ClusterMetadata *hivev1.ClusterMetadata `json:"clusterMetadata,omitempty"`
+}
+
+// AgentImageStorage is where the agent ISO is stored. Exactly one of the storages must be set.
+type AgentImageStorage struct {
+	// PersistentVolumeClaim stores the agent ISO on a volume served over HTTP.
+	// +optional
+	PersistentVolumeClaim *AgentImagePersistentVolumeClaimStorage `json:"persistentVolumeClaim,omitempty"`
+
+	// S3 stores the agent ISO in an S3 bucket, or a bucket of an S3 compatible object store.
+	// +optional
+	S3 *AgentImageS3Storage `json:"s3,omitempty"`
+}
+
+// AgentImagePersistentVolumeClaimStorage stores the agent ISO on a volume served over HTTP.
+type AgentImagePersistentVolumeClaimStorage struct {
+	// ClaimName is the name of the PersistentVolumeClaim, in the namespace of the AgentImageClusterInstall, of the
+	// volume. The agent ISO is written to the directory of the volume named after the AgentImageClusterInstall.
+	ClaimName string `json:"claimName"`
+
+	// BaseURL is the URL at which the root of the volume is served. The download URL of the agent ISO is the path of
+	// the agent ISO on the volume, relative to the BaseURL.
+	BaseURL string `json:"baseURL"`
+}
+
+// AgentImageS3Storage stores the agent ISO in an S3 bucket, or a bucket of an S3 compatible object store.
+type AgentImageS3Storage struct {
+	// Bucket is the name of the bucket. The agent ISO is stored under the namespace and name of the
+	// AgentImageClusterInstall.
+	Bucket string `json:"bucket"`
+
+	// Region is the region of the bucket.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// ServiceEndpoint is the URL of an S3 compatible object store, such as MinIO. When not set, AWS S3 is used.
+	// +optional
+	ServiceEndpoint string `json:"serviceEndpoint,omitempty"`
+
+	// CredentialsSecretRef refers to a secret, in the namespace of the AgentImageClusterInstall, that contains the
+	// aws_access_key_id and aws_secret_access_key to store the agent ISO with.
+	CredentialsSecretRef corev1.LocalObjectReference `json:"credentialsSecretRef"`
+
+	// URLExpiration is how long the presigned download URL of the agent ISO is valid for. It cannot exceed 7 days,
+	// which is the default.
+	// +optional
+	URLExpiration *metav1.Duration `json:"urlExpiration,omitempty"`
+}
+
+// AgentImageClusterInstallStatus defines the observed state of the AgentImageClusterInstall.
+type AgentImageClusterInstallStatus struct {
+	// Conditions includes more detailed status for the cluster install.
+	// +optional
+	Conditions []hivev1.ClusterInstallCondition `json:"conditions,omitempty"`
+
+	// ImageURL is the URL to download the agent ISO from, to boot the hosts of the cluster with.
+	// +optional
+	ImageURL string `json:"imageURL,omitempty"`
+
+	// ImageCreatedTime is when the agent ISO was created.
+	// +optional
+	ImageCreatedTime *metav1.Time `json:"imageCreatedTime,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AgentImageClusterInstall represents a request to install a cluster from an agent ISO created with
+// openshift-install, for the none and baremetal platforms.
+//
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ImageURL",type=string,priority=1,JSONPath=`.status.imageURL`
+type AgentImageClusterInstall struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AgentImageClusterInstallSpec   `json:"spec"`
+	Status AgentImageClusterInstallStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AgentImageClusterInstallList contains a list of AgentImageClusterInstall
+type AgentImageClusterInstallList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AgentImageClusterInstall `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AgentImageClusterInstall{}, &AgentImageClusterInstallList{})
+}
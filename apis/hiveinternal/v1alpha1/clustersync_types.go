@@ -24,7 +24,30 @@ type ClusterSync struct {
 }
 
 // ClusterSyncSpec defines the desired state of ClusterSync
-type ClusterSyncSpec struct{}
+type ClusterSyncSpec struct {
+	// Resync requests that SyncSets and SelectorSyncSets be re-applied to the cluster immediately rather than waiting
+	// for the next periodic re-apply.
+	// +optional
+	Resync *ClusterSyncResync `json:"resync,omitempty"`
+}
+
+// ClusterSyncResync is a request to re-apply SyncSets and SelectorSyncSets to the cluster.
+type ClusterSyncResync struct {
+	// RequestID identifies the resync request. The resync is performed once for each distinct RequestID, so the
+	// RequestID must be changed to request another resync.
+	// +kubebuilder:validation:MinLength=1
+	RequestID string `json:"requestID"`
+
+	// SyncSets is the names of the SyncSets to re-apply. If neither SyncSets nor SelectorSyncSets are specified, then
+	// all of the SyncSets and SelectorSyncSets for the cluster are re-applied.
+	// +optional
+	SyncSets []string `json:"syncSets,omitempty"`
+
+	// SelectorSyncSets is the names of the SelectorSyncSets to re-apply. If neither SyncSets nor SelectorSyncSets are
+	// specified, then all of the SyncSets and SelectorSyncSets for the cluster are re-applied.
+	// +optional
+	SelectorSyncSets []string `json:"selectorSyncSets,omitempty"`
+}
 
 // ClusterSyncStatus defines the observed state of ClusterSync
 type ClusterSyncStatus struct {
@@ -49,6 +72,21 @@ type ClusterSyncStatus struct {
 	// recently handled the ClusterSync. If the hive-clustersync statefulset is scaled up or down, the
 	// controlling replica can change, potentially causing logs to be spread across multiple pods.
 	ControlledByReplica *int64 `json:"controlledByReplica,omitempty"`
+
+	// LastResyncRequestID is the RequestID of the most recent resync request that has been performed.
+	// +optional
+	LastResyncRequestID string `json:"lastResyncRequestID,omitempty"`
+
+	// SyncSetHistory is the history of the most recent attempts to apply each of the SyncSets for the cluster. Up to 10
+	// attempts are kept for each SyncSet, and fewer when the cluster has so many SyncSets and SelectorSyncSets that
+	// the history of all of them would exceed 200 attempts.
+	// +optional
+	SyncSetHistory []SyncHistory `json:"syncSetHistory,omitempty"`
+
+	// SelectorSyncSetHistory is the history of the most recent attempts to apply each of the SelectorSyncSets for the
+	// cluster.
+	// +optional
+	SelectorSyncSetHistory []SyncHistory `json:"selectorSyncSetHistory,omitempty"`
 }
 
 // SyncStatus is the status of applying a specific SyncSet or SelectorSyncSet to the cluster.
@@ -59,6 +97,14 @@ type SyncStatus struct {
 	// ObservedGeneration is the generation of the SyncSet or SelectorSyncSet that was last observed.
 	ObservedGeneration int64 `json:"observedGeneration"`
 
+	// SourceRevision is the revision of the source of the SyncSet or SelectorSyncSet that was last applied.
+	// +optional
+	SourceRevision string `json:"sourceRevision,omitempty"`
+
+	// ExternalSecretsHash is a hash of the secrets read from external secret stores that were last applied.
+	// +optional
+	ExternalSecretsHash string `json:"externalSecretsHash,omitempty"`
+
 	// ResourcesToDelete is the list of resources in the cluster that should be deleted when the SyncSet or SelectorSyncSet
 	// is deleted or is no longer matched to the cluster.
 	// +optional
@@ -78,6 +124,93 @@ type SyncStatus struct {
 	// FirstSuccessTime is the time when the SyncSet or SelectorSyncSet was first successfully applied to the cluster.
 	// +optional
 	FirstSuccessTime *metav1.Time `json:"firstSuccessTime,omitempty"`
+
+	// Health is the health of the resources of the SyncSet or SelectorSyncSet in the cluster. It is only set when the
+	// SyncSet or SelectorSyncSet has a health check.
+	// +optional
+	Health SyncSetHealth `json:"health,omitempty"`
+
+	// UnhealthyResources is the list of resources of the SyncSet or SelectorSyncSet in the cluster that are
+	// progressing or degraded.
+	// +optional
+	UnhealthyResources []ResourceHealth `json:"unhealthyResources,omitempty"`
+}
+
+// SyncSetHealth is the health of resources in the cluster.
+// +kubebuilder:validation:Enum=Healthy;Progressing;Degraded
+type SyncSetHealth string
+
+const (
+	// HealthySyncSetHealth is the health when all of the resources are healthy.
+	HealthySyncSetHealth SyncSetHealth = "Healthy"
+
+	// ProgressingSyncSetHealth is the health when none of the resources are degraded but some of the resources have
+	// not yet become healthy, e.g. a Deployment which is rolling out.
+	ProgressingSyncSetHealth SyncSetHealth = "Progressing"
+
+	// DegradedSyncSetHealth is the health when some of the resources are degraded, e.g. a Job which has failed.
+	DegradedSyncSetHealth SyncSetHealth = "Degraded"
+)
+
+// ResourceHealth is the health of a resource in the cluster.
+type ResourceHealth struct {
+	SyncResourceReference `json:",inline"`
+
+	// Health is the health of the resource.
+	Health SyncSetHealth `json:"health"`
+
+	// Message describes why the resource is not healthy.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// SyncHistory is the history of the most recent attempts to apply a specific SyncSet or SelectorSyncSet to the
+// cluster.
+type SyncHistory struct {
+	// Name is the name of the SyncSet or SelectorSyncSet.
+	Name string `json:"name"`
+
+	// Attempts is the most recent attempts to apply the SyncSet or SelectorSyncSet, ordered from oldest to newest.
+	// +optional
+	Attempts []SyncAttempt `json:"attempts,omitempty"`
+}
+
+// SyncAttempt is a record of an attempt to apply a SyncSet or SelectorSyncSet to the cluster.
+type SyncAttempt struct {
+	// Time is the time when the attempt started.
+	Time metav1.Time `json:"time"`
+
+	// Result is the result of the attempt.
+	Result SyncSetResult `json:"result"`
+
+	// Duration is how long the attempt took.
+	Duration metav1.Duration `json:"duration"`
+
+	// ResourcesChanged is the list of resources in the cluster that were created, updated, or deleted by the attempt,
+	// limited to the first 5 of them to bound the size of the history. Resources that were patched are not included,
+	// since the patches may not have changed them.
+	// +optional
+	ResourcesChanged []SyncResourceReference `json:"resourcesChanged,omitempty"`
+
+	// ResourcesChangedCount is the number of resources in the cluster that were created, updated, or deleted by the
+	// attempt, including those left out of ResourcesChanged.
+	// +optional
+	ResourcesChangedCount int32 `json:"resourcesChangedCount,omitempty"`
+
+	// FailureMessage is a message describing why the attempt failed.
+	// +optional
+	FailureMessage string `json:"failureMessage,omitempty"`
+
+	// Count is the number of consecutive failed attempts with the same failure message that this record stands for.
+	// Such attempts are recorded once, so that a syncset that keeps failing does not push the rest of its history
+	// out. Time is when the first of them started and LastTime is when the most recent of them started. When unset,
+	// the record is for a single attempt.
+	// +optional
+	Count int32 `json:"count,omitempty"`
+
+	// LastTime is the time when the most recent of the attempts counted in Count started.
+	// +optional
+	LastTime *metav1.Time `json:"lastTime,omitempty"`
 }
 
 // SyncResourceReference is a reference to a resource that is synced to a cluster via a SyncSet or SelectorSyncSet.
@@ -18,6 +18,37 @@ type FakeClusterInstallSpec struct {
 
 	// ClusterMetadata contains metadata information about the installed cluster. It should be populated once the cluster install is completed. (it can be populated sooner if desired, but Hive will not copy back to ClusterDeployment until the Installed condition goes True.
 	ClusterMetadata *hivev1.ClusterMetadata `json:"clusterMetadata,omitempty"`
+
+	// InstallDuration is how long each simulated install attempt takes. Defaults to 30 seconds.
+	// +optional
+	InstallDuration *metav1.Duration `json:"installDuration,omitempty"`
+
+	// FailedAttempts is the number of install attempts that fail before an attempt is allowed to succeed.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	FailedAttempts int `json:"failedAttempts,omitempty"`
+
+	// FailurePercent is the chance, in percent, that an install attempt that is allowed to succeed fails anyway.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	FailurePercent int `json:"failurePercent,omitempty"`
+
+	// FailureReason is the reason failed install attempts report, as a real install failing for that reason would.
+	// It should be the installFailingReason of one of the install log regexes, whose installFailingMessage is then
+	// reported with it. Defaults to UnknownError.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// HibernateDuration is how long the fake cluster takes to stop when hibernated. It only applies to
+	// ClusterDeployments with the hive.openshift.io/fake-cluster annotation. Defaults to stopping at once.
+	// +optional
+	HibernateDuration *metav1.Duration `json:"hibernateDuration,omitempty"`
+
+	// ResumeDuration is how long the fake cluster takes to become ready when resumed. It only applies to
+	// ClusterDeployments with the hive.openshift.io/fake-cluster annotation. Defaults to resuming at once.
+	// +optional
+	ResumeDuration *metav1.Duration `json:"resumeDuration,omitempty"`
 }
 
 // FakeClusterInstallStatus defines the observed state of the FakeClusterInstall.
@@ -25,6 +56,14 @@ type FakeClusterInstallStatus struct {
 	// Conditions includes more detailed status for the cluster install.
 	// +optional
 	Conditions []hivev1.ClusterInstallCondition `json:"conditions,omitempty"`
+
+	// InstallRestarts is the number of simulated install attempts that failed.
+	// +optional
+	InstallRestarts int `json:"installRestarts,omitempty"`
+
+	// AttemptStartedTime is when the current simulated install attempt started.
+	// +optional
+	AttemptStartedTime *metav1.Time `json:"attemptStartedTime,omitempty"`
 }
 
 // +genclient
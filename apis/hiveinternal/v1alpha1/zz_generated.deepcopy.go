@@ -6,16 +6,205 @@
 package v1alpha1
 
 import (
-	v1 "github.com/openshift/hive/apis/hive/v1"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentImageClusterInstall) DeepCopyInto(out *AgentImageClusterInstall) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentImageClusterInstall.
+func (in *AgentImageClusterInstall) DeepCopy() *AgentImageClusterInstall {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentImageClusterInstall)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgentImageClusterInstall) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentImageClusterInstallList) DeepCopyInto(out *AgentImageClusterInstallList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AgentImageClusterInstall, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentImageClusterInstallList.
+func (in *AgentImageClusterInstallList) DeepCopy() *AgentImageClusterInstallList {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentImageClusterInstallList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgentImageClusterInstallList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentImageClusterInstallSpec) DeepCopyInto(out *AgentImageClusterInstallSpec) {
+	*out = *in
+	out.ImageSetRef = in.ImageSetRef
+	out.ClusterDeploymentRef = in.ClusterDeploymentRef
+	out.InstallConfigSecretRef = in.InstallConfigSecretRef
+	if in.AgentConfigSecretRef != nil {
+		in, out := &in.AgentConfigSecretRef, &out.AgentConfigSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	in.ImageStorage.DeepCopyInto(&out.ImageStorage)
+	if in.InstallTimeout != nil {
+		in, out := &in.InstallTimeout, &out.InstallTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ClusterMetadata != nil {
+		in, out := &in.ClusterMetadata, &out.ClusterMetadata
+		*out = new(hivev1.ClusterMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentImageClusterInstallSpec.
+func (in *AgentImageClusterInstallSpec) DeepCopy() *AgentImageClusterInstallSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentImageClusterInstallSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentImageClusterInstallStatus) DeepCopyInto(out *AgentImageClusterInstallStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]hivev1.ClusterInstallCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImageCreatedTime != nil {
+		in, out := &in.ImageCreatedTime, &out.ImageCreatedTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentImageClusterInstallStatus.
+func (in *AgentImageClusterInstallStatus) DeepCopy() *AgentImageClusterInstallStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentImageClusterInstallStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentImagePersistentVolumeClaimStorage) DeepCopyInto(out *AgentImagePersistentVolumeClaimStorage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentImagePersistentVolumeClaimStorage.
+func (in *AgentImagePersistentVolumeClaimStorage) DeepCopy() *AgentImagePersistentVolumeClaimStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentImagePersistentVolumeClaimStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentImageS3Storage) DeepCopyInto(out *AgentImageS3Storage) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+	if in.URLExpiration != nil {
+		in, out := &in.URLExpiration, &out.URLExpiration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentImageS3Storage.
+func (in *AgentImageS3Storage) DeepCopy() *AgentImageS3Storage {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentImageS3Storage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentImageStorage) DeepCopyInto(out *AgentImageStorage) {
+	*out = *in
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(AgentImagePersistentVolumeClaimStorage)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(AgentImageS3Storage)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentImageStorage.
+func (in *AgentImageStorage) DeepCopy() *AgentImageStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentImageStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterSync) DeepCopyInto(out *ClusterSync) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -166,9 +355,40 @@ func (in *ClusterSyncList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSyncResync) DeepCopyInto(out *ClusterSyncResync) {
+	*out = *in
+	if in.SyncSets != nil {
+		in, out := &in.SyncSets, &out.SyncSets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SelectorSyncSets != nil {
+		in, out := &in.SelectorSyncSets, &out.SelectorSyncSets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSyncResync.
+func (in *ClusterSyncResync) DeepCopy() *ClusterSyncResync {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSyncResync)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterSyncSpec) DeepCopyInto(out *ClusterSyncSpec) {
 	*out = *in
+	if in.Resync != nil {
+		in, out := &in.Resync, &out.Resync
+		*out = new(ClusterSyncResync)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -215,6 +435,20 @@ func (in *ClusterSyncStatus) DeepCopyInto(out *ClusterSyncStatus) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.SyncSetHistory != nil {
+		in, out := &in.SyncSetHistory, &out.SyncSetHistory
+		*out = make([]SyncHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SelectorSyncSetHistory != nil {
+		in, out := &in.SelectorSyncSetHistory, &out.SelectorSyncSetHistory
+		*out = make([]SyncHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -296,9 +530,24 @@ func (in *FakeClusterInstallSpec) DeepCopyInto(out *FakeClusterInstallSpec) {
 	out.ClusterDeploymentRef = in.ClusterDeploymentRef
 	if in.ClusterMetadata != nil {
 		in, out := &in.ClusterMetadata, &out.ClusterMetadata
-		*out = new(v1.ClusterMetadata)
+		*out = new(hivev1.ClusterMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.InstallDuration != nil {
+		in, out := &in.InstallDuration, &out.InstallDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.HibernateDuration != nil {
+		in, out := &in.HibernateDuration, &out.HibernateDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ResumeDuration != nil {
+		in, out := &in.ResumeDuration, &out.ResumeDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -317,11 +566,15 @@ func (in *FakeClusterInstallStatus) DeepCopyInto(out *FakeClusterInstallStatus)
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.ClusterInstallCondition, len(*in))
+		*out = make([]hivev1.ClusterInstallCondition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AttemptStartedTime != nil {
+		in, out := &in.AttemptStartedTime, &out.AttemptStartedTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -335,6 +588,73 @@ func (in *FakeClusterInstallStatus) DeepCopy() *FakeClusterInstallStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceHealth) DeepCopyInto(out *ResourceHealth) {
+	*out = *in
+	out.SyncResourceReference = in.SyncResourceReference
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceHealth.
+func (in *ResourceHealth) DeepCopy() *ResourceHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncAttempt) DeepCopyInto(out *SyncAttempt) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	out.Duration = in.Duration
+	if in.ResourcesChanged != nil {
+		in, out := &in.ResourcesChanged, &out.ResourcesChanged
+		*out = make([]SyncResourceReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastTime != nil {
+		in, out := &in.LastTime, &out.LastTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncAttempt.
+func (in *SyncAttempt) DeepCopy() *SyncAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncHistory) DeepCopyInto(out *SyncHistory) {
+	*out = *in
+	if in.Attempts != nil {
+		in, out := &in.Attempts, &out.Attempts
+		*out = make([]SyncAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncHistory.
+func (in *SyncHistory) DeepCopy() *SyncHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncResourceReference) DeepCopyInto(out *SyncResourceReference) {
 	*out = *in
@@ -364,6 +684,11 @@ func (in *SyncStatus) DeepCopyInto(out *SyncStatus) {
 		in, out := &in.FirstSuccessTime, &out.FirstSuccessTime
 		*out = (*in).DeepCopy()
 	}
+	if in.UnhealthyResources != nil {
+		in, out := &in.UnhealthyResources, &out.UnhealthyResources
+		*out = make([]ResourceHealth, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
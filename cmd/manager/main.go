@@ -27,6 +27,7 @@ import (
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
 	cmdutil "github.com/openshift/hive/cmd/util"
 	"github.com/openshift/hive/pkg/constants"
+	"github.com/openshift/hive/pkg/controller/agentimageclusterinstall"
 	"github.com/openshift/hive/pkg/controller/argocdregister"
 	"github.com/openshift/hive/pkg/controller/awsprivatelink"
 	"github.com/openshift/hive/pkg/controller/clusterclaim"
@@ -38,6 +39,7 @@ import (
 	"github.com/openshift/hive/pkg/controller/clusterrelocate"
 	"github.com/openshift/hive/pkg/controller/clusterstate"
 	"github.com/openshift/hive/pkg/controller/clustersync"
+	"github.com/openshift/hive/pkg/controller/clusterupgraderollout"
 	"github.com/openshift/hive/pkg/controller/clusterversion"
 	"github.com/openshift/hive/pkg/controller/controlplanecerts"
 	"github.com/openshift/hive/pkg/controller/dnsendpoint"
@@ -47,7 +49,10 @@ import (
 	"github.com/openshift/hive/pkg/controller/machinepool"
 	"github.com/openshift/hive/pkg/controller/metrics"
 	"github.com/openshift/hive/pkg/controller/remoteingress"
+	"github.com/openshift/hive/pkg/controller/selectorsyncsetrollout"
 	"github.com/openshift/hive/pkg/controller/syncidentityprovider"
+	"github.com/openshift/hive/pkg/controller/syncrbac"
+	"github.com/openshift/hive/pkg/controller/syncsetsource"
 	"github.com/openshift/hive/pkg/controller/unreachable"
 	"github.com/openshift/hive/pkg/controller/utils"
 	"github.com/openshift/hive/pkg/controller/velerobackup"
@@ -66,29 +71,34 @@ const (
 type controllerSetupFunc func(manager.Manager) error
 
 var controllerFuncs = map[hivev1.ControllerName]controllerSetupFunc{
-	clusterclaim.ControllerName:         clusterclaim.Add,
-	clusterdeployment.ControllerName:    clusterdeployment.Add,
-	clusterdeprovision.ControllerName:   clusterdeprovision.Add,
-	clusterpoolnamespace.ControllerName: clusterpoolnamespace.Add,
-	clusterprovision.ControllerName:     clusterprovision.Add,
-	clusterrelocate.ControllerName:      clusterrelocate.Add,
-	clusterstate.ControllerName:         clusterstate.Add,
-	clustersync.ControllerName:          clustersync.Add,
-	clusterversion.ControllerName:       clusterversion.Add,
-	controlplanecerts.ControllerName:    controlplanecerts.Add,
-	dnsendpoint.ControllerName:          dnsendpoint.Add,
-	dnszone.ControllerName:              dnszone.Add,
-	fakeclusterinstall.ControllerName:   fakeclusterinstall.Add,
-	metrics.ControllerName:              metrics.Add,
-	remoteingress.ControllerName:        remoteingress.Add,
-	machinepool.ControllerName:          machinepool.Add,
-	syncidentityprovider.ControllerName: syncidentityprovider.Add,
-	unreachable.ControllerName:          unreachable.Add,
-	velerobackup.ControllerName:         velerobackup.Add,
-	clusterpool.ControllerName:          clusterpool.Add,
-	hibernation.ControllerName:          hibernation.Add,
-	awsprivatelink.ControllerName:       awsprivatelink.Add,
-	argocdregister.ControllerName:       argocdregister.Add,
+	clusterclaim.ControllerName:             clusterclaim.Add,
+	clusterdeployment.ControllerName:        clusterdeployment.Add,
+	clusterdeprovision.ControllerName:       clusterdeprovision.Add,
+	clusterpoolnamespace.ControllerName:     clusterpoolnamespace.Add,
+	clusterprovision.ControllerName:         clusterprovision.Add,
+	clusterrelocate.ControllerName:          clusterrelocate.Add,
+	clusterstate.ControllerName:             clusterstate.Add,
+	clustersync.ControllerName:              clustersync.Add,
+	clusterupgraderollout.ControllerName:    clusterupgraderollout.Add,
+	clusterversion.ControllerName:           clusterversion.Add,
+	controlplanecerts.ControllerName:        controlplanecerts.Add,
+	dnsendpoint.ControllerName:              dnsendpoint.Add,
+	dnszone.ControllerName:                  dnszone.Add,
+	fakeclusterinstall.ControllerName:       fakeclusterinstall.Add,
+	metrics.ControllerName:                  metrics.Add,
+	remoteingress.ControllerName:            remoteingress.Add,
+	machinepool.ControllerName:              machinepool.Add,
+	selectorsyncsetrollout.ControllerName:   selectorsyncsetrollout.Add,
+	syncidentityprovider.ControllerName:     syncidentityprovider.Add,
+	syncrbac.ControllerName:                 syncrbac.Add,
+	syncsetsource.ControllerName:            syncsetsource.Add,
+	unreachable.ControllerName:              unreachable.Add,
+	velerobackup.ControllerName:             velerobackup.Add,
+	clusterpool.ControllerName:              clusterpool.Add,
+	hibernation.ControllerName:              hibernation.Add,
+	awsprivatelink.ControllerName:           awsprivatelink.Add,
+	argocdregister.ControllerName:           argocdregister.Add,
+	agentimageclusterinstall.ControllerName: agentimageclusterinstall.Add,
 }
 
 // disabledControllerEquivalents contains a mapping of old controller names to their new equivalent so that CLI parameters like --controllers and --disabled-controllers continue to work
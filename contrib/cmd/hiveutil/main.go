@@ -13,10 +13,13 @@ import (
 	"github.com/openshift/hive/contrib/pkg/clusterpool"
 	"github.com/openshift/hive/contrib/pkg/createcluster"
 	"github.com/openshift/hive/contrib/pkg/deprovision"
+	"github.com/openshift/hive/contrib/pkg/provision"
 	"github.com/openshift/hive/contrib/pkg/report"
+	"github.com/openshift/hive/contrib/pkg/syncset"
 	"github.com/openshift/hive/contrib/pkg/testresource"
 	"github.com/openshift/hive/contrib/pkg/verification"
 	"github.com/openshift/hive/contrib/pkg/version"
+	"github.com/openshift/hive/pkg/agentimage"
 	"github.com/openshift/hive/pkg/imageset"
 	"github.com/openshift/hive/pkg/installmanager"
 )
@@ -48,6 +51,7 @@ func newHiveutilCommand() *cobra.Command {
 	cmd.AddCommand(verification.NewVerifyImportsCommand())
 	cmd.AddCommand(installmanager.NewInstallManagerCommand())
 	cmd.AddCommand(imageset.NewUpdateInstallerImageCommand())
+	cmd.AddCommand(agentimage.NewCreateAgentImageCommand())
 	cmd.AddCommand(testresource.NewTestResourceCommand())
 	cmd.AddCommand(createcluster.NewCreateClusterCommand())
 	cmd.AddCommand(report.NewClusterReportCommand())
@@ -56,6 +60,8 @@ func newHiveutilCommand() *cobra.Command {
 	cmd.AddCommand(version.NewVersionCommand())
 	cmd.AddCommand(clusterpool.NewClusterPoolCommand())
 	cmd.AddCommand(awsprivatelink.NewAWSPrivateLinkCommand())
+	cmd.AddCommand(syncset.NewSyncSetCommand())
+	cmd.AddCommand(provision.NewProvisionCommand())
 
 	return cmd
 }
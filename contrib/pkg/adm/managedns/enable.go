@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hivev1aws "github.com/openshift/hive/apis/hive/v1/aws"
 	hiveutils "github.com/openshift/hive/contrib/pkg/utils"
 	awsutils "github.com/openshift/hive/contrib/pkg/utils/aws"
 	azureutils "github.com/openshift/hive/contrib/pkg/utils/azure"
@@ -61,7 +62,13 @@ type Options struct {
 	CredsFile string
 	homeDir   string
 
-	AzureResourceGroup string
+	AzureResourceGroup  string
+	AzureSubscriptionID string
+
+	AWSAssumeRoleARN        string
+	AWSAssumeRoleExternalID string
+
+	GCPProjectID string
 
 	dynamicClient dynamic.Interface
 	hiveClient    *hiveclient.Clientset
@@ -100,6 +107,10 @@ func NewEnableManageDNSCommand() *cobra.Command {
 	flags.StringVar(&opt.Cloud, "cloud", cloudAWS, "Cloud provider: aws(default)|gcp|azure)")
 	flags.StringVar(&opt.CredsFile, "creds-file", "", "Cloud credentials file (defaults vary depending on cloud)")
 	flags.StringVar(&opt.AzureResourceGroup, "azure-resource-group-name", "os4-common", "Azure Resource Group (Only applicable if --cloud azure)")
+	flags.StringVar(&opt.AzureSubscriptionID, "azure-subscription-id", "", "Azure subscription containing the DNS zones of the domains, if different from the subscription of the credentials (Only applicable if --cloud azure)")
+	flags.StringVar(&opt.AWSAssumeRoleARN, "aws-assume-role-arn", "", "IAM role to assume with the credentials to manage the hosted zones of the domains in another account (Only applicable if --cloud aws)")
+	flags.StringVar(&opt.AWSAssumeRoleExternalID, "aws-assume-role-external-id", "", "External ID to use when assuming the IAM role (Only applicable if --cloud aws)")
+	flags.StringVar(&opt.GCPProjectID, "gcp-project-id", "", "GCP project containing the managed zones of the domains, if different from the project of the credentials (Only applicable if --cloud gcp)")
 	return cmd
 }
 
@@ -149,6 +160,12 @@ func (o *Options) Run(args []string) error {
 		dnsConf.AWS = &hivev1.ManageDNSAWSConfig{
 			CredentialsSecretRef: corev1.LocalObjectReference{Name: credsSecret.Name},
 		}
+		if o.AWSAssumeRoleARN != "" {
+			dnsConf.AWS.CredentialsAssumeRole = &hivev1aws.AssumeRole{
+				RoleARN:    o.AWSAssumeRoleARN,
+				ExternalID: o.AWSAssumeRoleExternalID,
+			}
+		}
 	case cloudGCP:
 		// Apply a secret for credentials to manage the root domain:
 		credsSecret, err = o.generateGCPCredentialsSecret()
@@ -157,6 +174,7 @@ func (o *Options) Run(args []string) error {
 		}
 		dnsConf.GCP = &hivev1.ManageDNSGCPConfig{
 			CredentialsSecretRef: corev1.LocalObjectReference{Name: credsSecret.Name},
+			ProjectID:            o.GCPProjectID,
 		}
 	case cloudAzure:
 		credsSecret, err = o.generateAzureCredentialsSecret()
@@ -166,6 +184,7 @@ func (o *Options) Run(args []string) error {
 		dnsConf.Azure = &hivev1.ManageDNSAzureConfig{
 			CredentialsSecretRef: corev1.LocalObjectReference{Name: credsSecret.Name},
 			ResourceGroupName:    o.AzureResourceGroup,
+			SubscriptionID:       o.AzureSubscriptionID,
 		}
 	default:
 		log.WithField("cloud", o.Cloud).Fatal("unsupported cloud")
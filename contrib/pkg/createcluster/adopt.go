@@ -0,0 +1,144 @@
+package createcluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/contrib/pkg/utils"
+	"github.com/openshift/hive/pkg/clusterresource"
+	"github.com/openshift/hive/pkg/constants"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+	"github.com/openshift/hive/pkg/remoteclient"
+)
+
+var adoptPlatformClouds = map[configv1.PlatformType]string{
+	configv1.AlibabaCloudPlatformType: cloudAlibaba,
+	configv1.AWSPlatformType:          cloudAWS,
+	configv1.AzurePlatformType:        cloudAzure,
+	configv1.GCPPlatformType:          cloudGCP,
+	configv1.IBMCloudPlatformType:     cloudIBM,
+	configv1.OpenStackPlatformType:    cloudOpenStack,
+	configv1.OvirtPlatformType:        cloudOVirt,
+	configv1.VSpherePlatformType:      cloudVSphere,
+}
+
+// discoverAdoptedCluster connects to the cluster being adopted with its admin kubeconfig and fills in whatever
+// options were not given on the command line from what the cluster reports about itself. Options that were given
+// must agree with the cluster.
+func (o *Options) discoverAdoptedCluster(cmd *cobra.Command) error {
+	kubeconfig, err := os.ReadFile(o.AdoptAdminKubeConfig)
+	if err != nil {
+		return errors.Wrap(err, "could not read --adopt-admin-kubeconfig")
+	}
+	remoteClient, err := remoteclient.NewBuilderFromKubeconfig(nil, &corev1.Secret{
+		Data: map[string][]byte{constants.KubeconfigSecretKey: kubeconfig},
+	}).Build()
+	if err != nil {
+		return errors.Wrap(err, "could not connect to the cluster being adopted")
+	}
+	adopted, err := clusterresource.DiscoverAdoptedCluster(remoteClient, o.log)
+	if err != nil {
+		return errors.Wrap(err, "could not discover the cluster being adopted")
+	}
+	o.log.WithField("infraID", adopted.InfraID).WithField("platform", adopted.Platform).Info("discovered cluster to adopt")
+
+	cloud, ok := adoptPlatformClouds[adopted.Platform]
+	if !ok {
+		return fmt.Errorf("cannot adopt a cluster on platform %s", adopted.Platform)
+	}
+	var azureResourceGroupName string
+	if adopted.PlatformMetadata != nil && adopted.PlatformMetadata.Azure != nil && adopted.PlatformMetadata.Azure.ResourceGroupName != nil {
+		azureResourceGroupName = *adopted.PlatformMetadata.Azure.ResourceGroupName
+	}
+	for _, opt := range []struct {
+		flag       string
+		value      *string
+		discovered string
+	}{
+		{flag: "adopt-infra-id", value: &o.AdoptInfraID, discovered: adopted.InfraID},
+		{flag: "adopt-cluster-id", value: &o.AdoptClusterID, discovered: adopted.ClusterID},
+		{flag: "cloud", value: &o.Cloud, discovered: cloud},
+		{flag: "region", value: &o.Region, discovered: adopted.Region},
+		{flag: "base-domain", value: &o.BaseDomain, discovered: adopted.BaseDomain},
+		{flag: "azure-base-domain-resource-group-name", value: &o.AzureBaseDomainResourceGroupName, discovered: adopted.BaseDomainResourceGroupName},
+		{flag: "azure-resource-group-name", value: &o.AzureResourceGroupName, discovered: azureResourceGroupName},
+	} {
+		if opt.discovered == "" {
+			continue
+		}
+		if cmd.Flags().Changed(opt.flag) && *opt.value != opt.discovered {
+			return fmt.Errorf("--%s=%s does not match the cluster being adopted: %s", opt.flag, *opt.value, opt.discovered)
+		}
+		*opt.value = opt.discovered
+	}
+	if adopted.Name != "" && adopted.Name != o.Name {
+		o.log.Warnf("cluster was installed as %s; its ClusterDeployment will be named %s", adopted.Name, o.Name)
+	}
+
+	o.manageDNSSet = cmd.Flags().Changed("manage-dns")
+	o.adoptedCluster = adopted
+	return nil
+}
+
+// configureAdoptedDNS decides whether Hive should manage DNS for the cluster being adopted. That is only the case
+// if the cluster's base domain is in one of Hive's managed domains and the DNSZone controller would take over the
+// zone already serving it, rather than create a new one and break the cluster's DNS. dnsZoneAdoptable reports the
+// latter and is nil for platforms on which Hive does not manage DNS.
+func (o *Options) configureAdoptedDNS(builder *clusterresource.Builder, dnsZoneAdoptable func(dnsZoneNamespace, dnsZoneName string) (bool, error)) error {
+	manage, reason, err := o.adoptedDNSManageable(dnsZoneAdoptable)
+	if err != nil {
+		return err
+	}
+	switch {
+	case manage && !o.manageDNSSet:
+		o.log.Info("enabling DNS management for the adopted cluster")
+		builder.ManageDNS = true
+	case !manage && o.ManageDNS:
+		return fmt.Errorf("cannot use --manage-dns for the cluster being adopted: %s", reason)
+	case !manage:
+		o.log.Infof("not managing DNS for the adopted cluster: %s", reason)
+	}
+	return nil
+}
+
+func (o *Options) adoptedDNSManageable(dnsZoneAdoptable func(dnsZoneNamespace, dnsZoneName string) (bool, error)) (bool, string, error) {
+	if dnsZoneAdoptable == nil {
+		return false, fmt.Sprintf("Hive does not manage DNS on %s", o.Cloud), nil
+	}
+	// Without the hub's HiveConfig we cannot tell which domains are managed; that only matters if DNS management
+	// was explicitly asked for.
+	hubClient, err := utils.GetClient()
+	if err != nil {
+		return false, fmt.Sprintf("could not get a client for the hub cluster: %v", err), nil
+	}
+	hiveConfig := &hivev1.HiveConfig{}
+	if err := hubClient.Get(context.TODO(), types.NamespacedName{Name: constants.HiveConfigName}, hiveConfig); err != nil {
+		return false, fmt.Sprintf("could not get HiveConfig: %v", err), nil
+	}
+	if !o.adoptedCluster.InManagedDomain(hiveConfig.Spec.ManagedDomains) {
+		return false, fmt.Sprintf("base domain %s is not in a managed domain", o.adoptedCluster.BaseDomain), nil
+	}
+	namespace := o.Namespace
+	if namespace == "" {
+		if namespace, err = utils.DefaultNamespace(); err != nil {
+			return false, "", err
+		}
+	}
+	adoptable, err := dnsZoneAdoptable(namespace, controllerutils.DNSZoneName(o.Name))
+	if err != nil {
+		return false, "", err
+	}
+	if !adoptable {
+		return false, fmt.Sprintf("the DNS zone for %s would not be adopted by Hive", o.adoptedCluster.BaseDomain), nil
+	}
+	return true, "", nil
+}
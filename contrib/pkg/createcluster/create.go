@@ -28,6 +28,8 @@ import (
 	gcputils "github.com/openshift/hive/contrib/pkg/utils/gcp"
 	openstackutils "github.com/openshift/hive/contrib/pkg/utils/openstack"
 	ovirtutils "github.com/openshift/hive/contrib/pkg/utils/ovirt"
+	"github.com/openshift/hive/pkg/awsclient"
+	"github.com/openshift/hive/pkg/azureclient"
 	"github.com/openshift/hive/pkg/clusterresource"
 	"github.com/openshift/hive/pkg/constants"
 	"github.com/openshift/hive/pkg/gcpclient"
@@ -216,6 +218,11 @@ type Options struct {
 
 	homeDir string
 	log     log.FieldLogger
+
+	// adoptedCluster is what was discovered from the cluster being adopted, if any.
+	adoptedCluster *clusterresource.AdoptedCluster
+	// manageDNSSet records whether --manage-dns was given explicitly, as opposed to defaulted.
+	manageDNSSet bool
 }
 
 // NewCreateClusterCommand creates a command that generates and applies cluster deployment artifacts.
@@ -322,8 +329,8 @@ OpenShift Installer publishes all the services of the cluster like API server an
 	// Flags related to adoption.
 	flags.BoolVar(&opt.Adopt, "adopt", false, "Enable adoption mode for importing a pre-existing cluster into Hive. Will require additional flags for adoption info.")
 	flags.StringVar(&opt.AdoptAdminKubeConfig, "adopt-admin-kubeconfig", "", "Path to a cluster admin kubeconfig file for a cluster being adopted. (required if using --adopt)")
-	flags.StringVar(&opt.AdoptInfraID, "adopt-infra-id", "", "Infrastructure ID for this cluster's cloud provider. (required if using --adopt)")
-	flags.StringVar(&opt.AdoptClusterID, "adopt-cluster-id", "", "Cluster UUID used for telemetry. (required if using --adopt)")
+	flags.StringVar(&opt.AdoptInfraID, "adopt-infra-id", "", "Infrastructure ID for this cluster's cloud provider. (discovered from the cluster if omitted)")
+	flags.StringVar(&opt.AdoptClusterID, "adopt-cluster-id", "", "Cluster UUID used for telemetry. (discovered from the cluster if omitted)")
 	flags.StringVar(&opt.AdoptAdminUsername, "adopt-admin-username", "", "Username for cluster web console administrator. (optional)")
 	flags.StringVar(&opt.AdoptAdminPassword, "adopt-admin-password", "", "Password for cluster web console administrator. (optional)")
 
@@ -377,6 +384,12 @@ OpenShift Installer publishes all the services of the cluster like API server an
 func (o *Options) Complete(cmd *cobra.Command, args []string) error {
 	o.Name = args[0]
 
+	if o.Adopt && o.AdoptAdminKubeConfig != "" {
+		if err := o.discoverAdoptedCluster(cmd); err != nil {
+			return err
+		}
+	}
+
 	if o.Region == "" {
 		switch o.Cloud {
 		case cloudAlibaba:
@@ -453,12 +466,8 @@ func (o *Options) Validate(cmd *cobra.Command) error {
 	}
 
 	if o.Adopt {
-		if o.AdoptAdminKubeConfig == "" || o.AdoptInfraID == "" || o.AdoptClusterID == "" {
-			return fmt.Errorf("must specify the following options when using --adopt: --adopt-admin-kube-config, --adopt-infra-id, --adopt-cluster-id")
-		}
-
-		if _, err := os.Stat(o.AdoptAdminKubeConfig); os.IsNotExist(err) {
-			return fmt.Errorf("--adopt-admin-kubeconfig does not exist: %s", o.AdoptAdminKubeConfig)
+		if o.AdoptAdminKubeConfig == "" {
+			return fmt.Errorf("must specify --adopt-admin-kubeconfig when using --adopt")
 		}
 
 		// Admin username and password must both be specified if either are.
@@ -612,7 +621,13 @@ func (o *Options) GenerateObjects() ([]runtime.Object, error) {
 		builder.AdoptAdminKubeconfig = kubeconfigBytes
 		builder.AdoptAdminUsername = o.AdoptAdminUsername
 		builder.AdoptAdminPassword = o.AdoptAdminPassword
+		if o.adoptedCluster != nil {
+			builder.AdoptPlatformMetadata = o.adoptedCluster.PlatformMetadata
+			builder.AdoptMachinePools = o.adoptedCluster.MachinePools
+		}
 	}
+	// dnsZoneAdoptable is set while adopting a cluster on a platform on which Hive can manage DNS.
+	var dnsZoneAdoptable func(dnsZoneNamespace, dnsZoneName string) (bool, error)
 	if len(o.BoundServiceAccountSigningKeyFile) != 0 {
 		signingKey, err := os.ReadFile(o.BoundServiceAccountSigningKeyFile)
 		if err != nil {
@@ -663,6 +678,23 @@ func (o *Options) GenerateObjects() ([]runtime.Object, error) {
 			PrivateLink:     o.AWSPrivateLink,
 		}
 		builder.CloudBuilder = awsProvider
+		if o.adoptedCluster != nil {
+			awsClient, err := awsclient.NewClientFromSecret(&corev1.Secret{
+				Data: map[string][]byte{
+					constants.AWSAccessKeyIDSecretKey:     []byte(accessKeyID),
+					constants.AWSSecretAccessKeySecretKey: []byte(secretAccessKey),
+				},
+			}, o.Region)
+			if err != nil {
+				return nil, err
+			}
+			if err := o.adoptedCluster.ValidateAWSCredentials(awsClient); err != nil {
+				return nil, errors.Wrap(err, "AWS credentials cannot manage the cluster being adopted")
+			}
+			dnsZoneAdoptable = func(dnsZoneNamespace, dnsZoneName string) (bool, error) {
+				return o.adoptedCluster.AWSDNSZoneAdoptable(awsClient, dnsZoneNamespace, dnsZoneName)
+			}
+		}
 	case cloudAzure:
 		creds, err := azurecredutil.GetCreds(o.CredsFile)
 		if err != nil {
@@ -678,6 +710,18 @@ func (o *Options) GenerateObjects() ([]runtime.Object, error) {
 			ResourceGroupName:           o.AzureResourceGroupName,
 		}
 		builder.CloudBuilder = azureProvider
+		if o.adoptedCluster != nil {
+			azureClient, err := azureclient.NewClient(creds, o.AzureCloudName)
+			if err != nil {
+				return nil, err
+			}
+			if err := o.adoptedCluster.ValidateAzureCredentials(azureClient); err != nil {
+				return nil, errors.Wrap(err, "Azure credentials cannot manage the cluster being adopted")
+			}
+			dnsZoneAdoptable = func(string, string) (bool, error) {
+				return o.adoptedCluster.AzureDNSZoneAdoptable(azureClient)
+			}
+		}
 	case cloudGCP:
 		creds, err := gcputils.GetCreds(o.CredsFile)
 		if err != nil {
@@ -694,6 +738,18 @@ func (o *Options) GenerateObjects() ([]runtime.Object, error) {
 			Region:         o.Region,
 		}
 		builder.CloudBuilder = gcpProvider
+		if o.adoptedCluster != nil {
+			gcpClient, err := gcpclient.NewClient(creds)
+			if err != nil {
+				return nil, err
+			}
+			if err := o.adoptedCluster.ValidateGCPCredentials(gcpClient); err != nil {
+				return nil, errors.Wrap(err, "GCP credentials cannot manage the cluster being adopted")
+			}
+			dnsZoneAdoptable = func(string, string) (bool, error) {
+				return o.adoptedCluster.GCPDNSZoneAdoptable(gcpClient)
+			}
+		}
 	case cloudOpenStack:
 		cloudsYAMLContent, err := openstackutils.GetCreds(o.CredsFile)
 		if err != nil {
@@ -818,6 +874,12 @@ func (o *Options) GenerateObjects() ([]runtime.Object, error) {
 		builder.CloudBuilder = ibmCloudProvider
 	}
 
+	if o.adoptedCluster != nil {
+		if err := o.configureAdoptedDNS(builder, dnsZoneAdoptable); err != nil {
+			return nil, err
+		}
+	}
+
 	if o.Internal {
 		builder.PublishStrategy = "Internal"
 	}
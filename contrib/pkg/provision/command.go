@@ -0,0 +1,16 @@
+package provision
+
+import "github.com/spf13/cobra"
+
+// NewProvisionCommand is the entrypoint to create the 'provision' subcommand
+func NewProvisionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provision",
+		Short: "Utilities for cluster provisions",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Usage()
+		},
+	}
+	cmd.AddCommand(NewLogsCommand())
+	return cmd
+}
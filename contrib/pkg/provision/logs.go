@@ -0,0 +1,328 @@
+package provision
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/contrib/pkg/utils"
+	"github.com/openshift/hive/pkg/awsclient"
+	"github.com/openshift/hive/pkg/azureclient"
+	"github.com/openshift/hive/pkg/constants"
+	"github.com/openshift/hive/pkg/gcpclient"
+	"github.com/openshift/hive/pkg/installmanager"
+)
+
+const (
+	hiveConfigName = "hive"
+
+	// defaultS3Region is the region used when FailedProvisionAWSConfig.Region is not set, as by the install manager.
+	defaultS3Region = "us-east-1"
+)
+
+// LogsOptions is the set of options for fetching the archived logs of a provision attempt.
+type LogsOptions struct {
+	// ClusterDeployment is the name of the ClusterDeployment of the cluster.
+	ClusterDeployment string
+	// Namespace is the namespace of the ClusterDeployment.
+	Namespace string
+	// Attempt is the provision attempt to fetch the files of. Negative means the latest archived attempt.
+	Attempt int
+	// Dest is the directory to write the files to.
+	Dest string
+
+	log log.FieldLogger
+}
+
+// NewLogsCommand creates a command that fetches the archived logs of a provision attempt.
+func NewLogsCommand() *cobra.Command {
+	opt := &LogsOptions{log: log.WithField("command", "provision logs")}
+	cmd := &cobra.Command{
+		Use:   "logs CLUSTER_DEPLOYMENT_NAME [--attempt N]",
+		Short: "Fetches the archived installer log, install config and manifests of a provision attempt",
+		Long: `Fetches the archived installer log, install config and manifests of a provision attempt.
+
+Files are archived for every provision attempt when the failedProvisionConfig of HiveConfig has an archive section.
+They are fetched from the object store configured there, with the credentials secret referenced there, so access
+to the secret in the Hive namespace is required. The latest archived attempt is fetched unless --attempt is set.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			log.SetLevel(log.InfoLevel)
+			opt.ClusterDeployment = args[0]
+			if err := opt.Run(); err != nil {
+				opt.log.WithError(err).Fatal("Error")
+			}
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVarP(&opt.Namespace, "namespace", "n", "", "Namespace of the ClusterDeployment. Defaults to the namespace of the current context")
+	flags.IntVar(&opt.Attempt, "attempt", -1, "Provision attempt to fetch the files of. Defaults to the latest archived attempt")
+	flags.StringVar(&opt.Dest, "dest", "", "Directory to write the files to. Defaults to CLUSTER_NAME-attempt-N in the current directory")
+	return cmd
+}
+
+// Run fetches the archived files of the provision attempt.
+func (o *LogsOptions) Run() error {
+	ctx := context.Background()
+	c, err := utils.GetClient()
+	if err != nil {
+		return errors.Wrap(err, "could not create client")
+	}
+	if o.Namespace == "" {
+		if o.Namespace, err = utils.DefaultNamespace(); err != nil {
+			return errors.Wrap(err, "cannot determine default namespace")
+		}
+	}
+
+	// The archive is keyed by the name of the cluster, which is normally, but not necessarily, the name of the
+	// ClusterDeployment. Fall back to the given name if the ClusterDeployment is gone.
+	clusterName := o.ClusterDeployment
+	cd := &hivev1.ClusterDeployment{}
+	switch err := c.Get(ctx, types.NamespacedName{Namespace: o.Namespace, Name: o.ClusterDeployment}, cd); {
+	case apierrors.IsNotFound(err):
+		o.log.Warn("ClusterDeployment not found, assuming the cluster name is the name of the ClusterDeployment")
+	case err != nil:
+		return errors.Wrap(err, "could not get ClusterDeployment")
+	default:
+		clusterName = cd.Spec.ClusterName
+	}
+
+	store, err := newLogStore(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	attempt := o.Attempt
+	if attempt < 0 {
+		if attempt, err = latestAttempt(store, clusterName, o.Namespace); err != nil {
+			return err
+		}
+	}
+	folder := installmanager.ArchiveFolder(clusterName, o.Namespace, attempt)
+	keys, err := store.list(folder + "/")
+	if err != nil {
+		return errors.Wrap(err, "could not list archived files")
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("no archived files found for attempt %d of cluster %s", attempt, clusterName)
+	}
+
+	dest := o.Dest
+	if dest == "" {
+		dest = fmt.Sprintf("%s-attempt-%d", clusterName, attempt)
+	}
+	if err := os.MkdirAll(dest, 0700); err != nil {
+		return errors.Wrap(err, "could not create destination directory")
+	}
+	for _, key := range keys {
+		filename := filepath.Join(dest, path.Base(key))
+		if err := fetch(store, key, filename); err != nil {
+			return errors.Wrapf(err, "could not fetch %s", key)
+		}
+		fmt.Println(filename)
+	}
+	return nil
+}
+
+// latestAttempt returns the highest provision attempt of the cluster with archived files.
+func latestAttempt(store logStore, clusterName, namespace string) (int, error) {
+	prefix := installmanager.ArchiveClusterFolder(clusterName, namespace) + "/"
+	keys, err := store.list(prefix)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not list archived files")
+	}
+	latest := -1
+	for _, key := range keys {
+		attempt, err := strconv.Atoi(strings.SplitN(strings.TrimPrefix(key, prefix), "/", 2)[0])
+		if err == nil && attempt > latest {
+			latest = attempt
+		}
+	}
+	if latest < 0 {
+		return 0, fmt.Errorf("no archived files found for cluster %s", clusterName)
+	}
+	return latest, nil
+}
+
+func fetch(store logStore, key, filename string) error {
+	body, err := store.get(key)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	file, err := os.OpenFile(filename, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(file, body)
+	return err
+}
+
+// logStore is the object store to which provision attempts are archived.
+type logStore interface {
+	// list returns the keys of the objects whose keys start with the prefix.
+	list(prefix string) ([]string, error)
+	// get returns the contents of the object. The caller is responsible for closing them.
+	get(key string) (io.ReadCloser, error)
+}
+
+// newLogStore builds the client for the object store configured in HiveConfig, with the credentials configured there.
+func newLogStore(ctx context.Context, c client.Client) (logStore, error) {
+	hc := &hivev1.HiveConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: hiveConfigName}, hc); err != nil {
+		return nil, errors.Wrap(err, "could not get HiveConfig")
+	}
+	fpConfig := hc.Spec.FailedProvisionConfig
+	if fpConfig.Archive == nil {
+		log.Warn("archiving is not enabled in HiveConfig, only files of earlier attempts may be found")
+	}
+	hiveNS := hc.Spec.TargetNamespace
+	if hiveNS == "" {
+		hiveNS = constants.DefaultHiveNamespace
+	}
+
+	switch {
+	case fpConfig.AWS != nil:
+		region := fpConfig.AWS.Region
+		if region == "" {
+			region = defaultS3Region
+		}
+		var awsClient awsclient.Client
+		var err error
+		if fpConfig.AWS.ServiceEndpoint != "" {
+			awsClient, err = awsclient.NewS3CompatibleClient(c, fpConfig.AWS.CredentialsSecretRef.Name, hiveNS, region, fpConfig.AWS.ServiceEndpoint)
+		} else {
+			awsClient, err = awsclient.NewClient(c, fpConfig.AWS.CredentialsSecretRef.Name, hiveNS, region)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create AWS client")
+		}
+		return &s3LogStore{client: awsClient, bucket: fpConfig.AWS.Bucket}, nil
+	case fpConfig.GCP != nil:
+		secret, err := getSecret(ctx, c, fpConfig.GCP.CredentialsSecretRef.Name, hiveNS)
+		if err != nil {
+			return nil, err
+		}
+		gcpClient, err := gcpclient.NewClientFromSecret(secret)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create GCP client")
+		}
+		return &gcsLogStore{client: gcpClient, bucket: fpConfig.GCP.Bucket}, nil
+	case fpConfig.Azure != nil:
+		secret, err := getSecret(ctx, c, fpConfig.Azure.CredentialsSecretRef.Name, hiveNS)
+		if err != nil {
+			return nil, err
+		}
+		azureClient, err := azureclient.NewClientFromSecret(secret, fpConfig.Azure.CloudName.Name())
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create Azure client")
+		}
+		return &azureBlobLogStore{
+			ctx:            ctx,
+			client:         azureClient,
+			storageAccount: fpConfig.Azure.StorageAccountName,
+			container:      fpConfig.Azure.Container,
+		}, nil
+	}
+	return nil, errors.New("no object store for install logs is configured in HiveConfig")
+}
+
+func getSecret(ctx context.Context, c client.Client, name, namespace string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+		return nil, errors.Wrap(err, "could not get credentials secret")
+	}
+	return secret, nil
+}
+
+type s3LogStore struct {
+	client awsclient.Client
+	bucket string
+}
+
+func (s *s3LogStore) list(prefix string) ([]string, error) {
+	keys := []string{}
+	err := s.client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, o := range page.Contents {
+			keys = append(keys, aws.StringValue(o.Key))
+		}
+		return true
+	})
+	return keys, err
+}
+
+func (s *s3LogStore) get(key string) (io.ReadCloser, error) {
+	out, err := s.client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Body, nil
+}
+
+type gcsLogStore struct {
+	client gcpclient.Client
+	bucket string
+}
+
+func (s *gcsLogStore) list(prefix string) ([]string, error) {
+	objects, err := s.client.ListObjects(s.bucket, prefix)
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{}
+	for _, o := range objects {
+		keys = append(keys, o.Name)
+	}
+	return keys, nil
+}
+
+func (s *gcsLogStore) get(key string) (io.ReadCloser, error) {
+	return s.client.GetObject(s.bucket, key)
+}
+
+type azureBlobLogStore struct {
+	ctx            context.Context
+	client         azureclient.Client
+	storageAccount string
+	container      string
+}
+
+func (s *azureBlobLogStore) list(prefix string) ([]string, error) {
+	blobs, err := s.client.ListBlobs(s.ctx, s.storageAccount, s.container, prefix)
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{}
+	for _, b := range blobs {
+		keys = append(keys, b.Name)
+	}
+	return keys, nil
+}
+
+func (s *azureBlobLogStore) get(key string) (io.ReadCloser, error) {
+	return s.client.GetBlob(s.ctx, s.storageAccount, s.container, key)
+}
@@ -0,0 +1,213 @@
+package syncset
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveintv1alpha1 "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	"github.com/openshift/hive/contrib/pkg/utils"
+	"github.com/openshift/hive/pkg/constants"
+	"github.com/openshift/hive/pkg/controller/clustersync"
+	"github.com/openshift/hive/pkg/remoteclient"
+	"github.com/openshift/hive/pkg/resource"
+	"github.com/openshift/hive/pkg/util/scheme"
+)
+
+// DiffOptions is the set of options for previewing the changes a syncset would make to a cluster.
+type DiffOptions struct {
+	// Name is the name of the SyncSet or SelectorSyncSet in the management cluster.
+	Name string
+	// Filename is a file holding a SyncSet or SelectorSyncSet to preview instead of one in the management cluster.
+	Filename string
+	// Selector previews the SelectorSyncSet with the given Name rather than the SyncSet.
+	Selector bool
+	// Namespace is the namespace of the ClusterDeployment and of the SyncSet.
+	Namespace string
+	// ClusterDeployment is the name of the ClusterDeployment of the target cluster.
+	ClusterDeployment string
+	// StatusOnly prints only the change to each object rather than the diffs.
+	StatusOnly bool
+
+	log log.FieldLogger
+}
+
+// NewDiffCommand creates a command that previews the changes a syncset would make to a cluster.
+func NewDiffCommand() *cobra.Command {
+	opt := &DiffOptions{log: log.WithField("command", "syncset diff")}
+	cmd := &cobra.Command{
+		Use:   "diff [SYNCSET_NAME] --cluster-deployment CLUSTER_DEPLOYMENT",
+		Short: "Previews the changes a SyncSet or SelectorSyncSet would make to a cluster",
+		Long: `Previews the changes a SyncSet or SelectorSyncSet would make to a cluster.
+
+The resources and secrets of the syncset are rendered as the clustersync controller would render them, and are
+applied to the cluster with server-side dry runs of the same applies and patches that the clustersync controller
+makes. The difference between each object in the cluster and the
+result of the dry-run is printed, along with the objects that would be deleted because they were removed from a
+syncset with the Sync resource apply mode. The data of secrets is replaced by hashes of the data.
+
+The syncset is either read from the management cluster by name, or from a file with --filename.`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			log.SetLevel(log.WarnLevel)
+			if len(args) > 0 {
+				opt.Name = args[0]
+			}
+			if err := opt.Validate(); err != nil {
+				opt.log.WithError(err).Fatal("Invalid options")
+			}
+			if err := opt.Run(os.Stdout); err != nil {
+				opt.log.WithError(err).Fatal("Error")
+			}
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVarP(&opt.Filename, "filename", "f", "", "File holding the SyncSet or SelectorSyncSet to preview")
+	flags.BoolVar(&opt.Selector, "selector", false, "Preview the SelectorSyncSet with the given name rather than the SyncSet")
+	flags.StringVarP(&opt.Namespace, "namespace", "n", "", "Namespace of the ClusterDeployment and SyncSet. Defaults to the namespace of the current context")
+	flags.StringVarP(&opt.ClusterDeployment, "cluster-deployment", "c", "", "Name of the ClusterDeployment of the target cluster")
+	flags.BoolVar(&opt.StatusOnly, "status", false, "Only print whether each object would be created, updated, deleted or left unchanged")
+	return cmd
+}
+
+// Validate ensures that option values make sense
+func (o *DiffOptions) Validate() error {
+	if (o.Name == "") == (o.Filename == "") {
+		return errors.New("exactly one of a syncset name or --filename is required")
+	}
+	if o.Selector && o.Filename != "" {
+		return errors.New("--selector cannot be used with --filename")
+	}
+	if o.ClusterDeployment == "" {
+		return errors.New("--cluster-deployment is required")
+	}
+	return nil
+}
+
+// Run previews the syncset and prints the changes to out.
+func (o *DiffOptions) Run(out io.Writer) error {
+	ctx := context.Background()
+	c, err := utils.GetClient()
+	if err != nil {
+		return errors.Wrap(err, "could not create client")
+	}
+	if o.Namespace == "" {
+		if o.Namespace, err = utils.DefaultNamespace(); err != nil {
+			return errors.Wrap(err, "cannot determine default namespace")
+		}
+	}
+
+	syncSet, err := o.getSyncSet(ctx, c)
+	if err != nil {
+		return err
+	}
+	cd := &hivev1.ClusterDeployment{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: o.Namespace, Name: o.ClusterDeployment}, cd); err != nil {
+		return errors.Wrap(err, "could not get ClusterDeployment")
+	}
+	clusterSync := &hiveintv1alpha1.ClusterSync{}
+	switch err := c.Get(ctx, types.NamespacedName{Namespace: o.Namespace, Name: o.ClusterDeployment}, clusterSync); {
+	case apierrors.IsNotFound(err):
+		clusterSync = nil
+	case err != nil:
+		return errors.Wrap(err, "could not get ClusterSync")
+	}
+	// External secrets are read from the same Vault servers that the clustersync controller may read them from.
+	hiveConfig := &hivev1.HiveConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: constants.HiveConfigName}, hiveConfig); err != nil {
+		return errors.Wrap(err, "could not get HiveConfig")
+	}
+	remoteBuilder := remoteclient.NewBuilder(c, cd, "hiveutil")
+	remoteClient, err := remoteBuilder.Build()
+	if err != nil {
+		return errors.Wrap(err, "could not connect to cluster")
+	}
+	restConfig, err := remoteBuilder.RESTConfig()
+	if err != nil {
+		return errors.Wrap(err, "could not connect to cluster")
+	}
+	dryRunHelper, err := resource.NewDryRunHelperFromRESTConfig(restConfig, o.log)
+	if err != nil {
+		return errors.Wrap(err, "could not connect to cluster")
+	}
+
+	previews, err := clustersync.NewPreviewer(c, hiveConfig.Spec.SyncSetVaultAddresses, o.log).Preview(
+		ctx, syncSet, cd, clusterSync, remoteClient, dryRunHelper)
+	if err != nil {
+		return errors.Wrap(err, "could not preview syncset")
+	}
+	printPreviews(out, previews, o.StatusOnly)
+	return nil
+}
+
+func (o *DiffOptions) getSyncSet(ctx context.Context, c client.Client) (clustersync.CommonSyncSet, error) {
+	if o.Filename != "" {
+		data, err := os.ReadFile(o.Filename)
+		if err != nil {
+			return nil, err
+		}
+		obj, _, err := serializer.NewCodecFactory(scheme.GetScheme()).UniversalDeserializer().Decode(data, nil, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode syncset")
+		}
+		switch ss := obj.(type) {
+		case *hivev1.SyncSet:
+			ss.Namespace = o.Namespace
+			return (*clustersync.SyncSetAsCommon)(ss), nil
+		case *hivev1.SelectorSyncSet:
+			return (*clustersync.SelectorSyncSetAsCommon)(ss), nil
+		default:
+			return nil, fmt.Errorf("%s is not a SyncSet or SelectorSyncSet", o.Filename)
+		}
+	}
+	if o.Selector {
+		ss := &hivev1.SelectorSyncSet{}
+		if err := c.Get(ctx, types.NamespacedName{Name: o.Name}, ss); err != nil {
+			return nil, errors.Wrap(err, "could not get SelectorSyncSet")
+		}
+		return (*clustersync.SelectorSyncSetAsCommon)(ss), nil
+	}
+	ss := &hivev1.SyncSet{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: o.Namespace, Name: o.Name}, ss); err != nil {
+		return nil, errors.Wrap(err, "could not get SyncSet")
+	}
+	return (*clustersync.SyncSetAsCommon)(ss), nil
+}
+
+func printPreviews(out io.Writer, previews []clustersync.ObjectPreview, statusOnly bool) {
+	if statusOnly {
+		w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, "ACTION\tSOURCE\tAPIVERSION\tKIND\tNAMESPACE\tNAME\tERROR")
+		for _, p := range previews {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				p.Action, p.Source, p.Resource.APIVersion, p.Resource.Kind, p.Resource.Namespace, p.Resource.Name, p.Error)
+		}
+		w.Flush()
+		return
+	}
+	for _, p := range previews {
+		name := p.Resource.Name
+		if p.Resource.Namespace != "" {
+			name = p.Resource.Namespace + "/" + name
+		}
+		fmt.Fprintf(out, "# %s: %s %s %s (%s)\n", p.Action, p.Resource.APIVersion, p.Resource.Kind, name, p.Source)
+		switch {
+		case p.Error != "":
+			fmt.Fprintf(out, "error: %s\n", p.Error)
+		case p.Diff != "":
+			fmt.Fprint(out, p.Diff)
+		}
+	}
+}
@@ -0,0 +1,481 @@
+package agentimage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveint "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	contributils "github.com/openshift/hive/contrib/pkg/utils"
+	"github.com/openshift/hive/pkg/awsclient"
+	"github.com/openshift/hive/pkg/constants"
+	k8slabels "github.com/openshift/hive/pkg/util/labels"
+	"github.com/openshift/hive/pkg/util/scheme"
+)
+
+const (
+	// InstallConfigKey is the key of the install config in the install config secret.
+	InstallConfigKey = "install-config.yaml"
+	// AgentConfigKey is the key of the agent config in the agent config secret.
+	AgentConfigKey = "agent-config.yaml"
+
+	// AdminKubeconfigSecretStringTemplate and AdminPasswordSecretStringTemplate are the names of the secrets of the
+	// admin credentials of the cluster, for the name of the AgentImageClusterInstall.
+	AdminKubeconfigSecretStringTemplate = "%s-admin-kubeconfig"
+	AdminPasswordSecretStringTemplate   = "%s-admin-password"
+
+	adminKubeconfigRelativePath = "auth/kubeconfig"
+	adminPasswordRelativePath   = "auth/kubeadmin-password"
+	kubeadminUsername           = "kubeadmin"
+
+	// agentImageGlob matches the agent ISO created by openshift-install, which is named after the architecture of
+	// the hosts.
+	agentImageGlob = "agent.*.iso"
+
+	// DefaultS3URLExpiration is how long presigned download URLs are valid for by default. It is also the longest
+	// S3 allows.
+	DefaultS3URLExpiration = 7 * 24 * time.Hour
+)
+
+// CreateAgentImageOptions contains options for running the command to create the agent ISO of an
+// AgentImageClusterInstall.
+type CreateAgentImageOptions struct {
+	LogLevel     string
+	WorkDir      string
+	OutputDir    string
+	InstallerDir string
+	Namespace    string
+	Name         string
+	log          log.FieldLogger
+	client       client.Client
+
+	// runInstaller runs openshift-install with the arguments in the WorkDir.
+	runInstaller func(args ...string) error
+	// s3ClientFn builds the client to store the agent ISO in S3 with.
+	s3ClientFn func(c client.Client, secretName, namespace, region, serviceEndpoint string) (awsclient.Client, error)
+	// presignFn returns the presigned download URL of an object stored in S3.
+	presignFn func(awsc awsclient.Client, bucket, key string, expiration time.Duration) (string, error)
+}
+
+// NewCreateAgentImageCommand returns a command to create the agent ISO of an AgentImageClusterInstall.
+func NewCreateAgentImageCommand() *cobra.Command {
+	opt := &CreateAgentImageOptions{}
+	cmd := &cobra.Command{
+		Use:   "create-agent-image NAMESPACE AGENT-IMAGE-CLUSTER-INSTALL-NAME",
+		Short: "Creates the agent ISO of an AgentImageClusterInstall and stores it",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := opt.Complete(args); err != nil {
+				log.WithError(err).Fatal("cannot complete command")
+				return
+			}
+
+			if err := opt.Validate(); err != nil {
+				log.WithError(err).Fatal("invalid command options")
+				return
+			}
+
+			if err := opt.Run(); err != nil {
+				log.WithError(err).Fatal("failed to create agent image")
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opt.LogLevel, "log-level", "info", "log level, one of: debug, info, warn, error, fatal, panic")
+	flags.StringVar(&opt.WorkDir, "work-dir", workDir, "scratch directory to run openshift-install in")
+	flags.StringVar(&opt.OutputDir, "output-dir", "", "directory of the volume to copy the agent ISO to, when it is stored on a persistent volume claim")
+	flags.StringVar(&opt.InstallerDir, "installer-dir", installerDir, "directory of the openshift-install binary")
+	return cmd
+}
+
+// Complete sets remaining fields on the CreateAgentImageOptions based on command options and arguments.
+func (o *CreateAgentImageOptions) Complete(args []string) error {
+	o.Namespace = args[0]
+	o.Name = args[1]
+
+	var err error
+	o.log, err = contributils.NewLogger(o.LogLevel)
+	if err != nil {
+		return err
+	}
+	o.log = o.log.WithField("agentimageclusterinstall", types.NamespacedName{Namespace: o.Namespace, Name: o.Name})
+
+	o.client, err = contributils.GetClient()
+	if err != nil {
+		return errors.Wrap(err, "cannot obtain API client")
+	}
+
+	o.runInstaller = o.runOpenShiftInstall
+	o.s3ClientFn = getS3Client
+	o.presignFn = presignS3URL
+	return nil
+}
+
+// Validate ensures the given options and arguments are valid.
+func (o *CreateAgentImageOptions) Validate() error {
+	dirs := []string{o.WorkDir, o.InstallerDir}
+	if o.OutputDir != "" {
+		dirs = append(dirs, o.OutputDir)
+	}
+	for _, dir := range dirs {
+		fi, err := os.Stat(dir)
+		if err != nil {
+			return errors.Wrapf(err, "could not access %s", dir)
+		}
+		if !fi.IsDir() {
+			return fmt.Errorf("%s is not a directory", dir)
+		}
+	}
+	return nil
+}
+
+// Run creates the agent ISO, saves the admin credentials of the cluster to secrets, stores the agent ISO and records
+// where to download it from on the AgentImageClusterInstall.
+func (o *CreateAgentImageOptions) Run() error {
+	aci := &hiveint.AgentImageClusterInstall{}
+	if err := o.client.Get(context.TODO(), types.NamespacedName{Namespace: o.Namespace, Name: o.Name}, aci); err != nil {
+		return errors.Wrap(err, "error getting AgentImageClusterInstall")
+	}
+	cd := &hivev1.ClusterDeployment{}
+	if err := o.client.Get(context.TODO(), types.NamespacedName{Namespace: o.Namespace, Name: aci.Spec.ClusterDeploymentRef.Name}, cd); err != nil {
+		return errors.Wrap(err, "error getting ClusterDeployment")
+	}
+
+	// A previous attempt may have left its files behind, which openshift-install would pick up.
+	if err := clearDir(o.WorkDir); err != nil {
+		return errors.Wrap(err, "error clearing work dir")
+	}
+	if err := o.writeConfigs(aci, cd); err != nil {
+		return err
+	}
+
+	o.log.Info("creating agent image")
+	if err := o.runInstaller("agent", "create", "image", "--dir", o.WorkDir, "--log-level", "debug"); err != nil {
+		return errors.Wrap(err, "error creating agent image")
+	}
+	isos, err := filepath.Glob(filepath.Join(o.WorkDir, agentImageGlob))
+	if err != nil || len(isos) != 1 {
+		return fmt.Errorf("expected one agent image in work dir, found %d", len(isos))
+	}
+	iso := isos[0]
+
+	metadata, err := o.saveAdminCredentials(aci)
+	if err != nil {
+		return err
+	}
+
+	imageURL, err := o.storeImage(aci, iso)
+	if err != nil {
+		return err
+	}
+	o.log.WithField("imageURL", imageURL).Info("stored agent image")
+
+	return o.updateClusterInstall(metadata, imageURL)
+}
+
+// writeConfigs writes the install config, with the merged pull secret of the ClusterDeployment pasted in, and the agent
+// config to the WorkDir.
+func (o *CreateAgentImageOptions) writeConfigs(aci *hiveint.AgentImageClusterInstall, cd *hivev1.ClusterDeployment) error {
+	installConfig, err := o.loadSecretKey(aci.Spec.InstallConfigSecretRef.Name, InstallConfigKey)
+	if err != nil {
+		return err
+	}
+	pullSecret, err := o.loadSecretKey(constants.GetMergedPullSecretName(cd), corev1.DockerConfigJsonKey)
+	if err != nil {
+		return err
+	}
+	ic := map[string]interface{}{}
+	if err := yaml.Unmarshal(installConfig, &ic); err != nil {
+		return errors.Wrap(err, "error parsing install config")
+	}
+	ic["pullSecret"] = string(pullSecret)
+	installConfig, err = yaml.Marshal(ic)
+	if err != nil {
+		return errors.Wrap(err, "error serializing install config")
+	}
+	if err := os.WriteFile(filepath.Join(o.WorkDir, InstallConfigKey), installConfig, 0600); err != nil {
+		return errors.Wrap(err, "error writing install config")
+	}
+
+	if ref := aci.Spec.AgentConfigSecretRef; ref != nil {
+		agentConfig, err := o.loadSecretKey(ref.Name, AgentConfigKey)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(o.WorkDir, AgentConfigKey), agentConfig, 0600); err != nil {
+			return errors.Wrap(err, "error writing agent config")
+		}
+	}
+	return nil
+}
+
+func (o *CreateAgentImageOptions) loadSecretKey(name, key string) ([]byte, error) {
+	secret := &corev1.Secret{}
+	if err := o.client.Get(context.TODO(), types.NamespacedName{Namespace: o.Namespace, Name: name}, secret); err != nil {
+		return nil, errors.Wrapf(err, "error getting secret %s", name)
+	}
+	data, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("secret %s has no %s key", name, key)
+	}
+	return data, nil
+}
+
+// saveAdminCredentials saves the admin kubeconfig and password created by openshift-install to secrets owned by the
+// AgentImageClusterInstall, and returns the cluster metadata referring to them.
+func (o *CreateAgentImageOptions) saveAdminCredentials(aci *hiveint.AgentImageClusterInstall) (*hivev1.ClusterMetadata, error) {
+	kubeconfig, err := os.ReadFile(filepath.Join(o.WorkDir, adminKubeconfigRelativePath))
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading admin kubeconfig")
+	}
+	password, err := os.ReadFile(filepath.Join(o.WorkDir, adminPasswordRelativePath))
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading admin password")
+	}
+
+	kubeconfigSecretName := fmt.Sprintf(AdminKubeconfigSecretStringTemplate, aci.Name)
+	if err := o.saveSecret(aci, kubeconfigSecretName, constants.SecretTypeKubeConfig, map[string][]byte{
+		constants.KubeconfigSecretKey: kubeconfig,
+	}); err != nil {
+		return nil, err
+	}
+	passwordSecretName := fmt.Sprintf(AdminPasswordSecretStringTemplate, aci.Name)
+	if err := o.saveSecret(aci, passwordSecretName, constants.SecretTypeKubeAdminCreds, map[string][]byte{
+		constants.UsernameSecretKey: []byte(kubeadminUsername),
+		constants.PasswordSecretKey: []byte(strings.TrimSpace(string(password))),
+	}); err != nil {
+		return nil, err
+	}
+
+	metadata := &hivev1.ClusterMetadata{}
+	if aci.Spec.ClusterMetadata != nil {
+		metadata = aci.Spec.ClusterMetadata.DeepCopy()
+	}
+	metadata.AdminKubeconfigSecretRef = corev1.LocalObjectReference{Name: kubeconfigSecretName}
+	metadata.AdminPasswordSecretRef = &corev1.LocalObjectReference{Name: passwordSecretName}
+	return metadata, nil
+}
+
+// saveSecret creates or, if a previous attempt created it, updates a secret owned by the AgentImageClusterInstall.
+func (o *CreateAgentImageOptions) saveSecret(aci *hiveint.AgentImageClusterInstall, name, secretType string, data map[string][]byte) error {
+	aciGVK, err := apiutil.GVKForObject(aci, scheme.GetScheme())
+	if err != nil {
+		return errors.Wrap(err, "error getting GVK for AgentImageClusterInstall")
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: aci.Namespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion:         aciGVK.GroupVersion().String(),
+				Kind:               aciGVK.Kind,
+				Name:               aci.Name,
+				UID:                aci.UID,
+				BlockOwnerDeletion: pointer.BoolPtr(true),
+			}},
+		},
+		Data: data,
+	}
+	secret.Labels = k8slabels.AddLabel(secret.Labels, constants.ClusterDeploymentNameLabel, aci.Spec.ClusterDeploymentRef.Name)
+	secret.Labels = k8slabels.AddLabel(secret.Labels, constants.SecretTypeLabel, secretType)
+
+	err = o.client.Create(context.TODO(), secret)
+	if apierrors.IsAlreadyExists(err) {
+		existing := &corev1.Secret{}
+		if err := o.client.Get(context.TODO(), types.NamespacedName{Namespace: aci.Namespace, Name: name}, existing); err != nil {
+			return errors.Wrapf(err, "error getting secret %s", name)
+		}
+		existing.Data = data
+		err = o.client.Update(context.TODO(), existing)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "error saving secret %s", name)
+	}
+	o.log.WithField("secret", name).Info("saved secret")
+	return nil
+}
+
+// storeImage stores the agent ISO as the AgentImageClusterInstall asks, and returns the URL to download it from.
+func (o *CreateAgentImageOptions) storeImage(aci *hiveint.AgentImageClusterInstall, iso string) (string, error) {
+	storage := aci.Spec.ImageStorage
+	switch {
+	case storage.PersistentVolumeClaim != nil:
+		if o.OutputDir == "" {
+			return "", errors.New("no output dir to copy the agent image to")
+		}
+		if err := copyImage(iso, o.OutputDir); err != nil {
+			return "", errors.Wrap(err, "error copying agent image to output dir")
+		}
+		return PersistentVolumeClaimImageURL(storage.PersistentVolumeClaim.BaseURL, aci.Name, filepath.Base(iso))
+	case storage.S3 != nil:
+		s3Storage := storage.S3
+		awsc, err := o.s3ClientFn(o.client, s3Storage.CredentialsSecretRef.Name, aci.Namespace, s3Storage.Region, s3Storage.ServiceEndpoint)
+		if err != nil {
+			return "", errors.Wrap(err, "error creating S3 client")
+		}
+		file, err := os.Open(iso)
+		if err != nil {
+			return "", errors.Wrap(err, "error opening agent image")
+		}
+		defer file.Close()
+		key := path.Join(aci.Namespace, aci.Name, filepath.Base(iso))
+		o.log.Infof("uploading agent image to S3: s3://%s/%s", s3Storage.Bucket, key)
+		if _, err := awsc.Upload(&s3manager.UploadInput{
+			Bucket: aws.String(s3Storage.Bucket),
+			Key:    aws.String(key),
+			Body:   file,
+		}); err != nil {
+			return "", errors.Wrap(err, "error uploading agent image")
+		}
+		expiration := DefaultS3URLExpiration
+		if s3Storage.URLExpiration != nil && s3Storage.URLExpiration.Duration < expiration {
+			expiration = s3Storage.URLExpiration.Duration
+		}
+		imageURL, err := o.presignFn(awsc, s3Storage.Bucket, key, expiration)
+		return imageURL, errors.Wrap(err, "error presigning agent image URL")
+	}
+	return "", errors.New("no image storage configured")
+}
+
+// updateClusterInstall records the cluster metadata and the download URL of the agent ISO on the
+// AgentImageClusterInstall.
+func (o *CreateAgentImageOptions) updateClusterInstall(metadata *hivev1.ClusterMetadata, imageURL string) error {
+	name := types.NamespacedName{Namespace: o.Namespace, Name: o.Name}
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		aci := &hiveint.AgentImageClusterInstall{}
+		if err := o.client.Get(context.TODO(), name, aci); err != nil {
+			return err
+		}
+		aci.Spec.ClusterMetadata = metadata
+		return o.client.Update(context.TODO(), aci)
+	}); err != nil {
+		return errors.Wrap(err, "error updating cluster metadata of AgentImageClusterInstall")
+	}
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		aci := &hiveint.AgentImageClusterInstall{}
+		if err := o.client.Get(context.TODO(), name, aci); err != nil {
+			return err
+		}
+		now := metav1.Now()
+		aci.Status.ImageURL = imageURL
+		aci.Status.ImageCreatedTime = &now
+		return o.client.Status().Update(context.TODO(), aci)
+	}); err != nil {
+		return errors.Wrap(err, "error updating image URL of AgentImageClusterInstall")
+	}
+	return nil
+}
+
+func (o *CreateAgentImageOptions) runOpenShiftInstall(args ...string) error {
+	o.log.WithField("args", args).Info("running openshift-install binary")
+	cmd := exec.Command(filepath.Join(o.InstallerDir, "openshift-install"), args...)
+	cmd.Dir = o.WorkDir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// PersistentVolumeClaimImageURL returns the download URL of an agent ISO stored on a volume served at the base URL.
+func PersistentVolumeClaimImageURL(baseURL, aciName, iso string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid base URL")
+	}
+	u.Path = path.Join("/", u.Path, aciName, iso)
+	return u.String(), nil
+}
+
+// copyImage copies the agent ISO to the directory, which is served to the hosts, and removes anything else from it.
+// The ISO is copied under a temporary name then renamed, so that a partially copied ISO is never served.
+func copyImage(iso, dir string) error {
+	name := filepath.Base(iso)
+	src, err := os.Open(iso)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	tmp := filepath.Join(dir, name+".tmp")
+	dst, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filepath.Join(dir, name)); err != nil {
+		return err
+	}
+	// Previous attempts may have left other files behind, including ones created by the installer.
+	return clearDir(dir, name)
+}
+
+// clearDir removes the contents of the directory, except for the named files.
+func clearDir(dir string, keep ...string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if contains(keep, entry.Name()) {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func getS3Client(c client.Client, secretName, namespace, region, serviceEndpoint string) (awsclient.Client, error) {
+	if serviceEndpoint != "" {
+		return awsclient.NewS3CompatibleClient(c, secretName, namespace, region, serviceEndpoint)
+	}
+	return awsclient.NewClient(c, secretName, namespace, region)
+}
+
+func presignS3URL(awsc awsclient.Client, bucket, key string, expiration time.Duration) (string, error) {
+	req, _ := awsc.GetS3API().GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	return req.Presign(expiration)
+}
@@ -0,0 +1,265 @@
+package agentimage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/golang/mock/gomock"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveint "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	"github.com/openshift/hive/pkg/awsclient"
+	mockaws "github.com/openshift/hive/pkg/awsclient/mock"
+	"github.com/openshift/hive/pkg/constants"
+	testfake "github.com/openshift/hive/pkg/test/fake"
+)
+
+const (
+	testNamespace     = "test-namespace"
+	testName          = "test-aci"
+	testCDName        = "test-cd"
+	testISO           = "agent.x86_64.iso"
+	testInstallConfig = `apiVersion: v1
+metadata:
+  name: test-cluster
+platform:
+  none: {}
+`
+)
+
+func TestPersistentVolumeClaimImageURL(t *testing.T) {
+	tests := []struct {
+		name        string
+		baseURL     string
+		expectedURL string
+	}{
+		{
+			name:        "host",
+			baseURL:     "http://images.example.com",
+			expectedURL: "http://images.example.com/test-aci/agent.x86_64.iso",
+		},
+		{
+			name:        "path",
+			baseURL:     "https://images.example.com:8443/agent/",
+			expectedURL: "https://images.example.com:8443/agent/test-aci/agent.x86_64.iso",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			imageURL, err := PersistentVolumeClaimImageURL(test.baseURL, testName, testISO)
+			require.NoError(t, err, "unexpected error")
+			assert.Equal(t, test.expectedURL, imageURL, "unexpected image URL")
+		})
+	}
+}
+
+func TestCreateAgentImage(t *testing.T) {
+	tests := []struct {
+		name            string
+		storage         hiveint.AgentImageStorage
+		setupAWSMock     func(*mockaws.MockClient)
+		expectedURL      string
+		expectedInOutput []string
+		expectedErr      bool
+		installerOutput  []string
+	}{
+		{
+			name: "persistent volume claim",
+			storage: hiveint.AgentImageStorage{
+				PersistentVolumeClaim: &hiveint.AgentImagePersistentVolumeClaimStorage{
+					ClaimName: "images",
+					BaseURL:   "http://images.example.com",
+				},
+			},
+			installerOutput:  []string{testISO},
+			expectedURL:      "http://images.example.com/test-aci/agent.x86_64.iso",
+			expectedInOutput: []string{testISO},
+		},
+		{
+			name: "s3",
+			storage: hiveint.AgentImageStorage{
+				S3: &hiveint.AgentImageS3Storage{
+					Bucket:               "images",
+					CredentialsSecretRef: corev1.LocalObjectReference{Name: "s3-creds"},
+					URLExpiration:        &metav1.Duration{Duration: time.Hour},
+				},
+			},
+			setupAWSMock: func(m *mockaws.MockClient) {
+				m.EXPECT().Upload(gomock.Any()).DoAndReturn(func(input *s3manager.UploadInput) (*s3manager.UploadOutput, error) {
+					assert.Equal(t, "images", *input.Bucket, "unexpected bucket")
+					assert.Equal(t, "test-namespace/test-aci/agent.x86_64.iso", *input.Key, "unexpected key")
+					return &s3manager.UploadOutput{}, nil
+				})
+			},
+			installerOutput: []string{testISO},
+			expectedURL:     "https://images.s3.amazonaws.com/test-namespace/test-aci/agent.x86_64.iso?expires=1h0m0s",
+		},
+		{
+			name: "no agent image",
+			storage: hiveint.AgentImageStorage{
+				PersistentVolumeClaim: &hiveint.AgentImagePersistentVolumeClaimStorage{
+					ClaimName: "images",
+					BaseURL:   "http://images.example.com",
+				},
+			},
+			expectedErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAWSClient := mockaws.NewMockClient(mockCtrl)
+			if test.setupAWSMock != nil {
+				test.setupAWSMock(mockAWSClient)
+			}
+
+			workDir := t.TempDir()
+			// Left behind by a previous attempt.
+			require.NoError(t, os.WriteFile(filepath.Join(workDir, ".openshift_install_state.json"), []byte("{}"), 0600))
+			outputDir := ""
+			if test.storage.PersistentVolumeClaim != nil {
+				outputDir = t.TempDir()
+				// Left on the volume by a previous attempt, which ran the installer there.
+				require.NoError(t, os.MkdirAll(filepath.Join(outputDir, "auth"), 0700))
+				require.NoError(t, os.WriteFile(filepath.Join(outputDir, adminKubeconfigRelativePath), []byte("kubeconfig"), 0600))
+				require.NoError(t, os.WriteFile(filepath.Join(outputDir, testISO), []byte("old iso"), 0600))
+			}
+
+			aci := testAgentImageClusterInstall()
+			aci.Spec.ImageStorage = test.storage
+			c := testfake.NewFakeClientBuilder().WithRuntimeObjects(
+				aci,
+				testClusterDeployment(),
+				testSecret("install-config", InstallConfigKey, testInstallConfig),
+				testSecret("agent-config", AgentConfigKey, "apiVersion: v1alpha1\n"),
+				testSecret(constants.GetMergedPullSecretName(testClusterDeployment()), corev1.DockerConfigJsonKey, `{"auths":{}}`),
+			).Build()
+
+			o := &CreateAgentImageOptions{
+				WorkDir:   workDir,
+				OutputDir: outputDir,
+				Namespace: testNamespace,
+				Name:      testName,
+				log:       log.WithField("test", t.Name()),
+				client:    c,
+				runInstaller: func(args ...string) error {
+					assert.Equal(t, []string{"agent", "create", "image", "--dir", workDir, "--log-level", "debug"}, args, "unexpected installer args")
+					_, err := os.Stat(filepath.Join(workDir, ".openshift_install_state.json"))
+					assert.True(t, os.IsNotExist(err), "expected work dir to be cleared")
+
+					ic, err := os.ReadFile(filepath.Join(workDir, InstallConfigKey))
+					require.NoError(t, err, "expected install config")
+					icMap := map[string]interface{}{}
+					require.NoError(t, yaml.Unmarshal(ic, &icMap))
+					assert.Equal(t, `{"auths":{}}`, icMap["pullSecret"], "expected pull secret in install config")
+					assert.FileExists(t, filepath.Join(workDir, AgentConfigKey), "expected agent config")
+
+					require.NoError(t, os.MkdirAll(filepath.Join(workDir, "auth"), 0700))
+					require.NoError(t, os.WriteFile(filepath.Join(workDir, adminKubeconfigRelativePath), []byte("kubeconfig"), 0600))
+					require.NoError(t, os.WriteFile(filepath.Join(workDir, adminPasswordRelativePath), []byte("password\n"), 0600))
+					for _, f := range test.installerOutput {
+						require.NoError(t, os.WriteFile(filepath.Join(workDir, f), []byte("iso"), 0600))
+					}
+					return nil
+				},
+				s3ClientFn: func(_ client.Client, secretName, namespace, _, _ string) (awsclient.Client, error) {
+					assert.Equal(t, "s3-creds", secretName, "unexpected credentials secret")
+					assert.Equal(t, testNamespace, namespace, "unexpected credentials namespace")
+					return mockAWSClient, nil
+				},
+				presignFn: func(_ awsclient.Client, bucket, key string, expiration time.Duration) (string, error) {
+					return fmt.Sprintf("https://%s.s3.amazonaws.com/%s?expires=%s", bucket, key, expiration), nil
+				},
+			}
+
+			err := o.Run()
+			if test.expectedErr {
+				assert.Error(t, err, "expected error")
+				return
+			}
+			require.NoError(t, err, "unexpected error")
+
+			if outputDir != "" {
+				entries, err := os.ReadDir(outputDir)
+				require.NoError(t, err)
+				inOutput := []string{}
+				for _, entry := range entries {
+					inOutput = append(inOutput, entry.Name())
+				}
+				assert.Equal(t, test.expectedInOutput, inOutput, "unexpected files in output dir")
+				iso, err := os.ReadFile(filepath.Join(outputDir, testISO))
+				require.NoError(t, err)
+				assert.Equal(t, "iso", string(iso), "unexpected agent image in output dir")
+			}
+
+			aci = &hiveint.AgentImageClusterInstall{}
+			require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: testName}, aci))
+			assert.Equal(t, test.expectedURL, aci.Status.ImageURL, "unexpected image URL")
+			assert.NotNil(t, aci.Status.ImageCreatedTime, "expected image created time")
+			if assert.NotNil(t, aci.Spec.ClusterMetadata, "expected cluster metadata") {
+				assert.Equal(t, "test-aci-admin-kubeconfig", aci.Spec.ClusterMetadata.AdminKubeconfigSecretRef.Name, "unexpected admin kubeconfig secret")
+				assert.Equal(t, "test-aci-admin-password", aci.Spec.ClusterMetadata.AdminPasswordSecretRef.Name, "unexpected admin password secret")
+			}
+
+			password := &corev1.Secret{}
+			require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: "test-aci-admin-password"}, password))
+			assert.Equal(t, "kubeadmin", string(password.Data[constants.UsernameSecretKey]), "unexpected admin username")
+			assert.Equal(t, "password", string(password.Data[constants.PasswordSecretKey]), "unexpected admin password")
+			if assert.Len(t, password.OwnerReferences, 1, "expected owner reference") {
+				assert.Equal(t, "AgentImageClusterInstall", password.OwnerReferences[0].Kind, "unexpected owner kind")
+			}
+		})
+	}
+}
+
+func testAgentImageClusterInstall() *hiveint.AgentImageClusterInstall {
+	return &hiveint.AgentImageClusterInstall{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testName,
+			UID:       types.UID("test-uid"),
+		},
+		Spec: hiveint.AgentImageClusterInstallSpec{
+			ImageSetRef:            hivev1.ClusterImageSetReference{Name: "test-imageset"},
+			ClusterDeploymentRef:   corev1.LocalObjectReference{Name: testCDName},
+			InstallConfigSecretRef: corev1.LocalObjectReference{Name: "install-config"},
+			AgentConfigSecretRef:   &corev1.LocalObjectReference{Name: "agent-config"},
+		},
+	}
+}
+
+func testClusterDeployment() *hivev1.ClusterDeployment {
+	return &hivev1.ClusterDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testCDName,
+		},
+	}
+}
+
+func testSecret(name, key, value string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      name,
+		},
+		Data: map[string][]byte{
+			key: []byte(value),
+		},
+	}
+}
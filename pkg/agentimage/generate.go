@@ -0,0 +1,173 @@
+package agentimage
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	apihelpers "github.com/openshift/hive/apis/helpers"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveint "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	"github.com/openshift/hive/pkg/constants"
+	"github.com/openshift/hive/pkg/controller/images"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+)
+
+const (
+	// AgentImageJobLabel is the label used for counting the number of agent image jobs in Hive
+	AgentImageJobLabel = "hive.openshift.io/agent-image"
+
+	installerDir = "/installer"
+	workDir      = "/work"
+	outputDir    = "/output"
+
+	// agentImageJobDeadline is how long the creation of an agent ISO may take. It includes downloading the base
+	// RHCOS ISO of the release.
+	agentImageJobDeadline = time.Hour
+)
+
+// GenerateAgentImageJob creates a job to create the agent ISO of an AgentImageClusterInstall with the given installer
+// image, and store it where the AgentImageClusterInstall asks.
+func GenerateAgentImageJob(aci *hiveint.AgentImageClusterInstall, cd *hivev1.ClusterDeployment, installerImage, releaseImage, serviceAccountName, httpProxy, httpsProxy, noProxy string) *batchv1.Job {
+	logger := log.WithFields(log.Fields{
+		"agentimageclusterinstall": types.NamespacedName{Namespace: aci.Namespace, Name: aci.Name}.String(),
+	})
+
+	logger.Debug("generating agent image job")
+
+	installerMount := corev1.VolumeMount{
+		Name:      "installer",
+		MountPath: installerDir,
+	}
+	// The installer runs in a scratch directory, as it leaves the admin credentials of the cluster behind.
+	workMount := corev1.VolumeMount{
+		Name:      "work",
+		MountPath: workDir,
+	}
+	volumes := []corev1.Volume{
+		{
+			Name: "installer",
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+		{
+			Name: "work",
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+	}
+	args := []string{
+		"create-agent-image",
+		"--work-dir", workDir,
+		"--installer-dir", installerDir,
+		"--log-level", "debug",
+	}
+	mounts := []corev1.VolumeMount{installerMount, workMount}
+	// Only the agent ISO is copied to the volume, in the directory named after the AgentImageClusterInstall.
+	if pvc := aci.Spec.ImageStorage.PersistentVolumeClaim; pvc != nil {
+		volumes = append(volumes, corev1.Volume{
+			Name: "output",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: pvc.ClaimName,
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      "output",
+			MountPath: outputDir,
+			SubPath:   aci.Name,
+		})
+		args = append(args, "--output-dir", outputDir)
+	}
+	args = append(args, aci.Namespace, aci.Name)
+
+	env := []corev1.EnvVar{
+		{
+			Name:  "OPENSHIFT_INSTALL_RELEASE_IMAGE_OVERRIDE",
+			Value: releaseImage,
+		},
+	}
+
+	podSpec := corev1.PodSpec{
+		DNSPolicy:     corev1.DNSClusterFirst,
+		RestartPolicy: corev1.RestartPolicyNever,
+		InitContainers: []corev1.Container{
+			{
+				Name:            "installer",
+				Image:           installerImage,
+				ImagePullPolicy: corev1.PullIfNotPresent,
+				Command:         []string{"/bin/sh", "-c"},
+				// Copy then rename, so that a partially copied binary is never run.
+				Args:         []string{"cp -v /bin/openshift-install " + installerDir + "/openshift-install.tmp && mv -v " + installerDir + "/openshift-install.tmp " + installerDir + "/openshift-install"},
+				VolumeMounts: []corev1.VolumeMount{installerMount},
+			},
+		},
+		Containers: []corev1.Container{
+			{
+				Name:            "hive",
+				Image:           images.GetHiveImage(),
+				ImagePullPolicy: images.GetHiveClusterProvisionImagePullPolicy(),
+				Env:             env,
+				Command:         []string{"/usr/bin/hiveutil"},
+				Args:            args,
+				VolumeMounts:    mounts,
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("800Mi"),
+					},
+				},
+			},
+		},
+		Volumes:            volumes,
+		ServiceAccountName: serviceAccountName,
+		ImagePullSecrets:   []corev1.LocalObjectReference{{Name: constants.GetMergedPullSecretName(cd)}},
+	}
+	controllerutils.SetProxyEnvVars(&podSpec, httpProxy, httpsProxy, noProxy)
+
+	labels := map[string]string{
+		AgentImageJobLabel:                   "true",
+		constants.ClusterDeploymentNameLabel: cd.Name,
+		constants.JobTypeLabel:               constants.JobTypeAgentImage,
+	}
+	if typeStr, ok := cd.Labels[hivev1.HiveClusterTypeLabel]; ok {
+		labels[hivev1.HiveClusterTypeLabel] = typeStr
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GetAgentImageJobName(aci.Name),
+			Namespace: aci.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			// Retry a couple of times, for the download of the base ISO.
+			BackoffLimit:          pointer.Int32Ptr(2),
+			Completions:           pointer.Int32Ptr(1),
+			ActiveDeadlineSeconds: pointer.Int64Ptr(int64(agentImageJobDeadline.Seconds())),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: podSpec,
+			},
+		},
+	}
+	controllerutils.AddLogFieldsEnvVar(aci, job)
+
+	return job
+}
+
+// GetAgentImageJobName returns the expected name of the agent image job for an AgentImageClusterInstall.
+func GetAgentImageJobName(aciName string) string {
+	return apihelpers.GetResourceName(aciName, "agent-image")
+}
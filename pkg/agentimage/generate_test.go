@@ -0,0 +1,84 @@
+package agentimage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+
+	hiveint "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	"github.com/openshift/hive/pkg/constants"
+)
+
+func TestGenerateAgentImageJob(t *testing.T) {
+	tests := []struct {
+		name            string
+		storage         hiveint.AgentImageStorage
+		expectedArgs    []string
+		expectedClaim   string
+		expectedSubPath string
+	}{
+		{
+			name: "persistent volume claim",
+			storage: hiveint.AgentImageStorage{
+				PersistentVolumeClaim: &hiveint.AgentImagePersistentVolumeClaimStorage{
+					ClaimName: "images",
+					BaseURL:   "http://images.example.com",
+				},
+			},
+			expectedArgs: []string{"create-agent-image", "--work-dir", "/work", "--installer-dir", "/installer", "--log-level", "debug",
+				"--output-dir", "/output", testNamespace, testName},
+			expectedClaim:   "images",
+			expectedSubPath: testName,
+		},
+		{
+			name: "s3",
+			storage: hiveint.AgentImageStorage{
+				S3: &hiveint.AgentImageS3Storage{Bucket: "images"},
+			},
+			expectedArgs: []string{"create-agent-image", "--work-dir", "/work", "--installer-dir", "/installer", "--log-level", "debug",
+				testNamespace, testName},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			aci := testAgentImageClusterInstall()
+			aci.Spec.ImageStorage = test.storage
+			cd := testClusterDeployment()
+
+			job := GenerateAgentImageJob(aci, cd, "installer-image", "release-image", "cluster-installer", "", "", "")
+
+			assert.Equal(t, "test-aci-agent-image", job.Name, "unexpected job name")
+			assert.Equal(t, constants.JobTypeAgentImage, job.Labels[constants.JobTypeLabel], "unexpected job type")
+			assert.Equal(t, testCDName, job.Labels[constants.ClusterDeploymentNameLabel], "unexpected cluster deployment label")
+			podSpec := job.Spec.Template.Spec
+			assert.Equal(t, "cluster-installer", podSpec.ServiceAccountName, "unexpected service account")
+			require.Len(t, podSpec.InitContainers, 1, "expected installer init container")
+			assert.Equal(t, "installer-image", podSpec.InitContainers[0].Image, "unexpected installer image")
+			require.Len(t, podSpec.Containers, 1, "expected hive container")
+			container := podSpec.Containers[0]
+			assert.Equal(t, test.expectedArgs, container.Args, "unexpected args")
+			assert.Contains(t, container.Env, corev1.EnvVar{Name: "OPENSHIFT_INSTALL_RELEASE_IMAGE_OVERRIDE", Value: "release-image"}, "expected release image override")
+
+			claim := ""
+			for _, volume := range podSpec.Volumes {
+				switch volume.Name {
+				case "work":
+					assert.NotNil(t, volume.EmptyDir, "expected work dir to be an empty dir")
+				case "output":
+					if assert.NotNil(t, volume.PersistentVolumeClaim, "expected persistent volume claim") {
+						claim = volume.PersistentVolumeClaim.ClaimName
+					}
+				}
+			}
+			assert.Equal(t, test.expectedClaim, claim, "unexpected claim")
+			for _, mount := range container.VolumeMounts {
+				if mount.Name == "output" {
+					assert.Equal(t, test.expectedSubPath, mount.SubPath, "unexpected output sub path")
+				}
+			}
+		})
+	}
+}
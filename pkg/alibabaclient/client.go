@@ -12,6 +12,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/endpoints"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/pkg/errors"
 )
@@ -25,6 +26,13 @@ type API interface {
 	DescribeInstances(request *ecs.DescribeInstancesRequest) (response *ecs.DescribeInstancesResponse, err error)
 	StartInstances(request *ecs.StartInstancesRequest) (response *ecs.StartInstancesResponse, err error)
 	StopInstances(request *ecs.StopInstancesRequest) (response *ecs.StopInstancesResponse, err error)
+	AddDomain(request *alidns.AddDomainRequest) (response *alidns.AddDomainResponse, err error)
+	DeleteDomain(request *alidns.DeleteDomainRequest) (response *alidns.DeleteDomainResponse, err error)
+	DescribeDomainInfo(request *alidns.DescribeDomainInfoRequest) (response *alidns.DescribeDomainInfoResponse, err error)
+	AddDomainRecord(request *alidns.AddDomainRecordRequest) (response *alidns.AddDomainRecordResponse, err error)
+	DeleteDomainRecord(request *alidns.DeleteDomainRecordRequest) (response *alidns.DeleteDomainRecordResponse, err error)
+	DeleteSubDomainRecords(request *alidns.DeleteSubDomainRecordsRequest) (response *alidns.DeleteSubDomainRecordsResponse, err error)
+	DescribeDomainRecords(request *alidns.DescribeDomainRecordsRequest) (response *alidns.DescribeDomainRecordsResponse, err error)
 }
 
 // Client makes calls to the Alibaba Cloud API.
@@ -123,6 +131,7 @@ func (client *Client) getEndpoint(request requests.AcsRequest) string {
 
 func defaultEndpoint() map[string]string {
 	return map[string]string{
+		"alidns":          "alidns.aliyuncs.com",
 		"pvtz":            "pvtz.aliyuncs.com",
 		"resourcemanager": "resourcemanager.aliyuncs.com",
 		"ecs":             "ecs.aliyuncs.com",
@@ -173,3 +182,66 @@ func (client *Client) StopInstances(request *ecs.StopInstancesRequest) (response
 	err = client.doActionWithSetDomain(request, response)
 	return
 }
+
+// AddDomain adds a domain to Alibaba Cloud DNS
+func (client *Client) AddDomain(request *alidns.AddDomainRequest) (response *alidns.AddDomainResponse, err error) {
+	response = &alidns.AddDomainResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	err = client.doActionWithSetDomain(request, response)
+	return
+}
+
+// DeleteDomain deletes a domain, along with its records, from Alibaba Cloud DNS
+func (client *Client) DeleteDomain(request *alidns.DeleteDomainRequest) (response *alidns.DeleteDomainResponse, err error) {
+	response = &alidns.DeleteDomainResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	err = client.doActionWithSetDomain(request, response)
+	return
+}
+
+// DescribeDomainInfo queries the details of a domain in Alibaba Cloud DNS
+func (client *Client) DescribeDomainInfo(request *alidns.DescribeDomainInfoRequest) (response *alidns.DescribeDomainInfoResponse, err error) {
+	response = &alidns.DescribeDomainInfoResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	err = client.doActionWithSetDomain(request, response)
+	return
+}
+
+// AddDomainRecord adds a record to a domain in Alibaba Cloud DNS
+func (client *Client) AddDomainRecord(request *alidns.AddDomainRecordRequest) (response *alidns.AddDomainRecordResponse, err error) {
+	response = &alidns.AddDomainRecordResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	err = client.doActionWithSetDomain(request, response)
+	return
+}
+
+// DeleteDomainRecord deletes a record by its ID from Alibaba Cloud DNS
+func (client *Client) DeleteDomainRecord(request *alidns.DeleteDomainRecordRequest) (response *alidns.DeleteDomainRecordResponse, err error) {
+	response = &alidns.DeleteDomainRecordResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	err = client.doActionWithSetDomain(request, response)
+	return
+}
+
+// DeleteSubDomainRecords deletes the records of a subdomain of a domain in Alibaba Cloud DNS
+func (client *Client) DeleteSubDomainRecords(request *alidns.DeleteSubDomainRecordsRequest) (response *alidns.DeleteSubDomainRecordsResponse, err error) {
+	response = &alidns.DeleteSubDomainRecordsResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	err = client.doActionWithSetDomain(request, response)
+	return
+}
+
+// DescribeDomainRecords queries the records of a domain in Alibaba Cloud DNS
+func (client *Client) DescribeDomainRecords(request *alidns.DescribeDomainRecordsRequest) (response *alidns.DescribeDomainRecordsResponse, err error) {
+	response = &alidns.DescribeDomainRecordsResponse{
+		BaseResponse: &responses.BaseResponse{},
+	}
+	err = client.doActionWithSetDomain(request, response)
+	return
+}
@@ -7,6 +7,7 @@ package mock
 import (
 	reflect "reflect"
 
+	alidns "github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
 	ecs "github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -34,6 +35,81 @@ func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
 	return m.recorder
 }
 
+// AddDomain mocks base method.
+func (m *MockAPI) AddDomain(request *alidns.AddDomainRequest) (*alidns.AddDomainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDomain", request)
+	ret0, _ := ret[0].(*alidns.AddDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDomain indicates an expected call of AddDomain.
+func (mr *MockAPIMockRecorder) AddDomain(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDomain", reflect.TypeOf((*MockAPI)(nil).AddDomain), request)
+}
+
+// AddDomainRecord mocks base method.
+func (m *MockAPI) AddDomainRecord(request *alidns.AddDomainRecordRequest) (*alidns.AddDomainRecordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDomainRecord", request)
+	ret0, _ := ret[0].(*alidns.AddDomainRecordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDomainRecord indicates an expected call of AddDomainRecord.
+func (mr *MockAPIMockRecorder) AddDomainRecord(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDomainRecord", reflect.TypeOf((*MockAPI)(nil).AddDomainRecord), request)
+}
+
+// DeleteDomain mocks base method.
+func (m *MockAPI) DeleteDomain(request *alidns.DeleteDomainRequest) (*alidns.DeleteDomainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDomain", request)
+	ret0, _ := ret[0].(*alidns.DeleteDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDomain indicates an expected call of DeleteDomain.
+func (mr *MockAPIMockRecorder) DeleteDomain(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomain", reflect.TypeOf((*MockAPI)(nil).DeleteDomain), request)
+}
+
+// DeleteDomainRecord mocks base method.
+func (m *MockAPI) DeleteDomainRecord(request *alidns.DeleteDomainRecordRequest) (*alidns.DeleteDomainRecordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDomainRecord", request)
+	ret0, _ := ret[0].(*alidns.DeleteDomainRecordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDomainRecord indicates an expected call of DeleteDomainRecord.
+func (mr *MockAPIMockRecorder) DeleteDomainRecord(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomainRecord", reflect.TypeOf((*MockAPI)(nil).DeleteDomainRecord), request)
+}
+
+// DeleteSubDomainRecords mocks base method.
+func (m *MockAPI) DeleteSubDomainRecords(request *alidns.DeleteSubDomainRecordsRequest) (*alidns.DeleteSubDomainRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubDomainRecords", request)
+	ret0, _ := ret[0].(*alidns.DeleteSubDomainRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSubDomainRecords indicates an expected call of DeleteSubDomainRecords.
+func (mr *MockAPIMockRecorder) DeleteSubDomainRecords(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubDomainRecords", reflect.TypeOf((*MockAPI)(nil).DeleteSubDomainRecords), request)
+}
+
 // DescribeAvailableZoneByInstanceType mocks base method.
 func (m *MockAPI) DescribeAvailableZoneByInstanceType(arg0 string) (*ecs.DescribeAvailableResourceResponse, error) {
 	m.ctrl.T.Helper()
@@ -49,6 +125,36 @@ func (mr *MockAPIMockRecorder) DescribeAvailableZoneByInstanceType(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAvailableZoneByInstanceType", reflect.TypeOf((*MockAPI)(nil).DescribeAvailableZoneByInstanceType), arg0)
 }
 
+// DescribeDomainInfo mocks base method.
+func (m *MockAPI) DescribeDomainInfo(request *alidns.DescribeDomainInfoRequest) (*alidns.DescribeDomainInfoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDomainInfo", request)
+	ret0, _ := ret[0].(*alidns.DescribeDomainInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDomainInfo indicates an expected call of DescribeDomainInfo.
+func (mr *MockAPIMockRecorder) DescribeDomainInfo(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDomainInfo", reflect.TypeOf((*MockAPI)(nil).DescribeDomainInfo), request)
+}
+
+// DescribeDomainRecords mocks base method.
+func (m *MockAPI) DescribeDomainRecords(request *alidns.DescribeDomainRecordsRequest) (*alidns.DescribeDomainRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDomainRecords", request)
+	ret0, _ := ret[0].(*alidns.DescribeDomainRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDomainRecords indicates an expected call of DescribeDomainRecords.
+func (mr *MockAPIMockRecorder) DescribeDomainRecords(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDomainRecords", reflect.TypeOf((*MockAPI)(nil).DescribeDomainRecords), request)
+}
+
 // DescribeInstances mocks base method.
 func (m *MockAPI) DescribeInstances(request *ecs.DescribeInstancesRequest) (*ecs.DescribeInstancesResponse, error) {
 	m.ctrl.T.Helper()
@@ -97,6 +97,11 @@ type Client interface {
 	// ELBV2
 	DescribeLoadBalancers(*elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error)
 
+	// S3
+	ListObjectsV2Pages(*s3.ListObjectsV2Input, func(*s3.ListObjectsV2Output, bool) bool) error
+	GetObject(*s3.GetObjectInput) (*s3.GetObjectOutput, error)
+	DeleteObject(*s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
+
 	// S3 Manager
 	Upload(*s3manager.UploadInput) (*s3manager.UploadOutput, error)
 
@@ -117,6 +122,13 @@ type Client interface {
 	DeleteVPCAssociationAuthorization(*route53.DeleteVPCAssociationAuthorizationInput) (*route53.DeleteVPCAssociationAuthorizationOutput, error)
 	AssociateVPCWithHostedZone(*route53.AssociateVPCWithHostedZoneInput) (*route53.AssociateVPCWithHostedZoneOutput, error)
 	DisassociateVPCFromHostedZone(input *route53.DisassociateVPCFromHostedZoneInput) (*route53.DisassociateVPCFromHostedZoneOutput, error)
+	GetDNSSEC(input *route53.GetDNSSECInput) (*route53.GetDNSSECOutput, error)
+	CreateKeySigningKey(input *route53.CreateKeySigningKeyInput) (*route53.CreateKeySigningKeyOutput, error)
+	ActivateKeySigningKey(input *route53.ActivateKeySigningKeyInput) (*route53.ActivateKeySigningKeyOutput, error)
+	DeactivateKeySigningKey(input *route53.DeactivateKeySigningKeyInput) (*route53.DeactivateKeySigningKeyOutput, error)
+	DeleteKeySigningKey(input *route53.DeleteKeySigningKeyInput) (*route53.DeleteKeySigningKeyOutput, error)
+	EnableHostedZoneDNSSEC(input *route53.EnableHostedZoneDNSSECInput) (*route53.EnableHostedZoneDNSSECOutput, error)
+	DisableHostedZoneDNSSEC(input *route53.DisableHostedZoneDNSSECInput) (*route53.DisableHostedZoneDNSSECOutput, error)
 	// ResourceTagging
 	GetResourcesPages(input *resourcegroupstaggingapi.GetResourcesInput, fn func(*resourcegroupstaggingapi.GetResourcesOutput, bool) bool) error
 
@@ -309,6 +321,21 @@ func (c *awsClient) GetS3API() s3iface.S3API {
 	return c.s3Client
 }
 
+func (c *awsClient) ListObjectsV2Pages(input *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool) error {
+	metricAWSAPICalls.WithLabelValues("ListObjectsV2").Inc()
+	return c.s3Client.ListObjectsV2Pages(input, fn)
+}
+
+func (c *awsClient) GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	metricAWSAPICalls.WithLabelValues("GetObject").Inc()
+	return c.s3Client.GetObject(input)
+}
+
+func (c *awsClient) DeleteObject(input *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error) {
+	metricAWSAPICalls.WithLabelValues("DeleteObject").Inc()
+	return c.s3Client.DeleteObject(input)
+}
+
 func (c *awsClient) Upload(input *s3manager.UploadInput) (*s3manager.UploadOutput, error) {
 	return c.s3Uploader.Upload(input)
 }
@@ -383,6 +410,41 @@ func (c *awsClient) DisassociateVPCFromHostedZone(input *route53.DisassociateVPC
 	return c.route53Client.DisassociateVPCFromHostedZone(input)
 }
 
+func (c *awsClient) GetDNSSEC(input *route53.GetDNSSECInput) (*route53.GetDNSSECOutput, error) {
+	metricAWSAPICalls.WithLabelValues("GetDNSSEC").Inc()
+	return c.route53Client.GetDNSSEC(input)
+}
+
+func (c *awsClient) CreateKeySigningKey(input *route53.CreateKeySigningKeyInput) (*route53.CreateKeySigningKeyOutput, error) {
+	metricAWSAPICalls.WithLabelValues("CreateKeySigningKey").Inc()
+	return c.route53Client.CreateKeySigningKey(input)
+}
+
+func (c *awsClient) ActivateKeySigningKey(input *route53.ActivateKeySigningKeyInput) (*route53.ActivateKeySigningKeyOutput, error) {
+	metricAWSAPICalls.WithLabelValues("ActivateKeySigningKey").Inc()
+	return c.route53Client.ActivateKeySigningKey(input)
+}
+
+func (c *awsClient) DeactivateKeySigningKey(input *route53.DeactivateKeySigningKeyInput) (*route53.DeactivateKeySigningKeyOutput, error) {
+	metricAWSAPICalls.WithLabelValues("DeactivateKeySigningKey").Inc()
+	return c.route53Client.DeactivateKeySigningKey(input)
+}
+
+func (c *awsClient) DeleteKeySigningKey(input *route53.DeleteKeySigningKeyInput) (*route53.DeleteKeySigningKeyOutput, error) {
+	metricAWSAPICalls.WithLabelValues("DeleteKeySigningKey").Inc()
+	return c.route53Client.DeleteKeySigningKey(input)
+}
+
+func (c *awsClient) EnableHostedZoneDNSSEC(input *route53.EnableHostedZoneDNSSECInput) (*route53.EnableHostedZoneDNSSECOutput, error) {
+	metricAWSAPICalls.WithLabelValues("EnableHostedZoneDNSSEC").Inc()
+	return c.route53Client.EnableHostedZoneDNSSEC(input)
+}
+
+func (c *awsClient) DisableHostedZoneDNSSEC(input *route53.DisableHostedZoneDNSSECInput) (*route53.DisableHostedZoneDNSSECOutput, error) {
+	metricAWSAPICalls.WithLabelValues("DisableHostedZoneDNSSEC").Inc()
+	return c.route53Client.DisableHostedZoneDNSSEC(input)
+}
+
 func (c *awsClient) GetCallerIdentity(input *sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error) {
 	metricAWSAPICalls.WithLabelValues("GetCallerIdentity").Inc()
 	return c.stsClient.GetCallerIdentity(input)
@@ -557,6 +619,34 @@ func NewClient(kubeClient client.Client, secretName, namespace, region string) (
 	return NewClientFromSecret(secret, region)
 }
 
+// NewS3CompatibleClient creates our client wrapper object for talking to an S3 compatible object store, such as
+// MinIO, at the given endpoint. Requests use path-style addressing, as virtual-hosted-style addressing of buckets
+// generally requires additional DNS configuration of such stores. Only the S3 operations of the client are usable.
+// Credentials are loaded as for NewClient.
+func NewS3CompatibleClient(kubeClient client.Client, secretName, namespace, region, endpoint string) (Client, error) {
+	var secret *corev1.Secret
+	if secretName != "" {
+		secret = &corev1.Secret{}
+		if err := kubeClient.Get(context.TODO(),
+			types.NamespacedName{
+				Name:      secretName,
+				Namespace: namespace,
+			},
+			secret); err != nil {
+			return nil, err
+		}
+	}
+
+	s, err := NewSessionFromSecret(secret, region)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create AWS session")
+	}
+	return newClientFromSession(s.Copy(&aws.Config{
+		Endpoint:         aws.String(endpoint),
+		S3ForcePathStyle: aws.Bool(true),
+	}))
+}
+
 // NewClientFromSecret creates our client wrapper object for the actual AWS clients we use.
 // For authentication the underlying clients will use either the cluster AWS credentials
 // secret if defined (i.e. in the root cluster),
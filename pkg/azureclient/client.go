@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -47,6 +49,19 @@ type Client interface {
 
 	// Images
 	ListImagesByResourceGroup(ctx context.Context, resourceGroupName string) (ImageListResultPage, error)
+
+	// Blobs
+	UploadBlob(ctx context.Context, storageAccountName, containerName, blobName string, body io.Reader, size int64) (string, error)
+	ListBlobs(ctx context.Context, storageAccountName, containerName, prefix string) ([]Blob, error)
+	GetBlob(ctx context.Context, storageAccountName, containerName, blobName string) (io.ReadCloser, error)
+	DeleteBlob(ctx context.Context, storageAccountName, containerName, blobName string) error
+}
+
+// Blob describes a blob in a blob container.
+type Blob struct {
+	Name         string
+	LastModified time.Time
+	Size         int64
 }
 
 // ResourceSKUsPage is a page of results from listing resource SKUs.
@@ -75,8 +90,16 @@ type azureClient struct {
 	zonesClient           *dns.ZonesClient
 	virtualMachinesClient *compute.VirtualMachinesClient
 	imagesClient          *compute.ImagesClient
+
+	// blobAuthorizer authorizes requests against the blob storage data plane, which requires tokens for a
+	// different resource than the resource manager clients above.
+	blobAuthorizer        autorest.Authorizer
+	storageEndpointSuffix string
 }
 
+// blobServiceVersion is the version of the blob service REST API used when uploading blobs.
+const blobServiceVersion = "2020-10-02"
+
 func (c *azureClient) ListResourceSKUs(ctx context.Context, filter string) (ResourceSKUsPage, error) {
 	page, err := c.resourceSKUsClient.List(ctx, filter)
 	return &page, err
@@ -182,24 +205,173 @@ func (c *azureClient) ListImagesByResourceGroup(ctx context.Context, resourceGro
 	return &page, err
 }
 
+// UploadBlob uploads the size bytes of the body as a block blob into the container of the storage account, replacing
+// any existing blob with the same name, and returns the URL of the blob.
+func (c *azureClient) UploadBlob(ctx context.Context, storageAccountName, containerName, blobName string, body io.Reader, size int64) (string, error) {
+	blobURL := c.blobURL(storageAccountName, containerName, blobName)
+	req, err := c.prepareBlobRequest(ctx, blobURL,
+		autorest.AsPut(),
+		autorest.WithHeader("x-ms-blob-type", "BlockBlob"))
+	if err != nil {
+		return "", err
+	}
+	// Stream the body rather than buffering it in memory, as logs such as must-gather archives can be large.
+	req.Body = io.NopCloser(body)
+	req.ContentLength = size
+	resp, err := autorest.SendWithSender(autorest.CreateSender(), req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to send blob upload request")
+	}
+	if err := autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated),
+		autorest.ByClosing()); err != nil {
+		return "", err
+	}
+	return blobURL, nil
+}
+
+// blobList is the response body of the List Blobs operation of the blob service.
+type blobList struct {
+	Blobs []struct {
+		Name       string `xml:"Name"`
+		Properties struct {
+			LastModified  string `xml:"Last-Modified"`
+			ContentLength int64  `xml:"Content-Length"`
+		} `xml:"Properties"`
+	} `xml:"Blobs>Blob"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+// ListBlobs lists the blobs in the container of the storage account whose names start with the prefix.
+func (c *azureClient) ListBlobs(ctx context.Context, storageAccountName, containerName, prefix string) ([]Blob, error) {
+	var blobs []Blob
+	marker := ""
+	for {
+		params := map[string]interface{}{
+			"restype": "container",
+			"comp":    "list",
+		}
+		if prefix != "" {
+			params["prefix"] = prefix
+		}
+		if marker != "" {
+			params["marker"] = marker
+		}
+		req, err := c.prepareBlobRequest(ctx, c.blobURL(storageAccountName, containerName, ""),
+			autorest.AsGet(),
+			autorest.WithQueryParameters(params))
+		if err != nil {
+			return nil, err
+		}
+		resp, err := autorest.SendWithSender(autorest.CreateSender(), req)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to send blob list request")
+		}
+		page := blobList{}
+		if err := autorest.Respond(resp,
+			azure.WithErrorUnlessStatusCode(http.StatusOK),
+			autorest.ByUnmarshallingXML(&page),
+			autorest.ByClosing()); err != nil {
+			return nil, err
+		}
+		for _, b := range page.Blobs {
+			lastModified, err := time.Parse(time.RFC1123, b.Properties.LastModified)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse last modified time of blob %s", b.Name)
+			}
+			blobs = append(blobs, Blob{
+				Name:         b.Name,
+				LastModified: lastModified,
+				Size:         b.Properties.ContentLength,
+			})
+		}
+		if page.NextMarker == "" {
+			return blobs, nil
+		}
+		marker = page.NextMarker
+	}
+}
+
+// GetBlob returns the contents of the blob. The caller is responsible for closing them.
+func (c *azureClient) GetBlob(ctx context.Context, storageAccountName, containerName, blobName string) (io.ReadCloser, error) {
+	req, err := c.prepareBlobRequest(ctx, c.blobURL(storageAccountName, containerName, blobName), autorest.AsGet())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := autorest.SendWithSender(autorest.CreateSender(), req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send blob get request")
+	}
+	if err := autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosingIfError()); err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// DeleteBlob deletes the blob.
+func (c *azureClient) DeleteBlob(ctx context.Context, storageAccountName, containerName, blobName string) error {
+	req, err := c.prepareBlobRequest(ctx, c.blobURL(storageAccountName, containerName, blobName), autorest.AsDelete())
+	if err != nil {
+		return err
+	}
+	resp, err := autorest.SendWithSender(autorest.CreateSender(), req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send blob delete request")
+	}
+	return autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusAccepted),
+		autorest.ByClosing())
+}
+
+func (c *azureClient) blobURL(storageAccountName, containerName, blobName string) string {
+	u := fmt.Sprintf("https://%s.blob.%s/%s", storageAccountName, c.storageEndpointSuffix, containerName)
+	if blobName != "" {
+		u += "/" + blobName
+	}
+	return u
+}
+
+func (c *azureClient) prepareBlobRequest(ctx context.Context, url string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	// The URL must be set before decorators such as query parameters can be applied.
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.WithBaseURL(url),
+		autorest.WithHeader("x-ms-version", blobServiceVersion),
+	}, decorators...)
+	decorators = append(decorators, c.blobAuthorizer.WithAuthorization())
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx), decorators...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to prepare blob request")
+	}
+	return req, nil
+}
+
 // NewClientFromSecret creates our client wrapper object for interacting with Azure. The Azure creds are read from the
 // specified secret.
 func NewClientFromSecret(secret *corev1.Secret, environmentName string) (Client, error) {
-	return newClient(authJSONFromSecretSource(secret), environmentName)
+	return newClient(authJSONFromSecretSource(secret), environmentName, "")
+}
+
+// NewClientFromSecretForSubscription creates our client wrapper object for interacting with Azure resources in the
+// specified subscription rather than in the subscription of the Azure creds. The Azure creds are read from the
+// specified secret. If the subscription is empty, the subscription of the Azure creds is used.
+func NewClientFromSecretForSubscription(secret *corev1.Secret, environmentName, subscriptionID string) (Client, error) {
+	return newClient(authJSONFromSecretSource(secret), environmentName, subscriptionID)
 }
 
 // NewClientFromFile creates our client wrapper object for interacting with Azure. The Azure creds are read from the
 // specified file.
 func NewClientFromFile(filename string, environmentName string) (Client, error) {
-	return newClient(authJSONFromFileSource(filename), environmentName)
+	return newClient(authJSONFromFileSource(filename), environmentName, "")
 }
 
 // NewClient creates our client wrapper object for interacting with Azure using the Azure creds provided.
 func NewClient(creds []byte, environmentName string) (Client, error) {
-	return newClient(authJSONFromBytes(creds), environmentName)
+	return newClient(authJSONFromBytes(creds), environmentName, "")
 }
 
-func newClient(authJSONSource func() ([]byte, error), environmentName string) (*azureClient, error) {
+func newClient(authJSONSource func() ([]byte, error), environmentName, subscriptionIDOverride string) (*azureClient, error) {
 	authJSON, err := authJSONSource()
 	if err != nil {
 		return nil, err
@@ -224,6 +396,9 @@ func newClient(authJSONSource func() ([]byte, error), environmentName string) (*
 	if !ok {
 		return nil, errors.New("missing subscriptionId in auth")
 	}
+	if subscriptionIDOverride != "" {
+		subscriptionID = subscriptionIDOverride
+	}
 
 	if environmentName == "" {
 		environmentName = azure.PublicCloud.Name
@@ -234,7 +409,12 @@ func newClient(authJSONSource func() ([]byte, error), environmentName string) (*
 		return nil, err
 	}
 
-	authorizer, err := getAuthorizer(clientID, clientSecret, tenantID, env)
+	authorizer, err := getAuthorizer(clientID, clientSecret, tenantID, env.ResourceManagerEndpoint, env)
+	if err != nil {
+		return nil, err
+	}
+
+	blobAuthorizer, err := getAuthorizer(clientID, clientSecret, tenantID, env.ResourceIdentifiers.Storage, env)
 	if err != nil {
 		return nil, err
 	}
@@ -260,6 +440,8 @@ func newClient(authJSONSource func() ([]byte, error), environmentName string) (*
 		zonesClient:           &zonesClient,
 		virtualMachinesClient: &virtualMachinesClient,
 		imagesClient:          &imagesClient,
+		blobAuthorizer:        blobAuthorizer,
+		storageEndpointSuffix: env.StorageEndpointSuffix,
 	}, nil
 }
 
@@ -285,9 +467,9 @@ func authJSONFromFileSource(filename string) func() ([]byte, error) {
 	}
 }
 
-func getAuthorizer(clientID, clientSecret, tenantID string, env azure.Environment) (autorest.Authorizer, error) {
+func getAuthorizer(clientID, clientSecret, tenantID, resource string, env azure.Environment) (autorest.Authorizer, error) {
 	config := auth.NewClientCredentialsConfig(clientID, clientSecret, tenantID)
-	config.Resource = env.ResourceManagerEndpoint
+	config.Resource = resource
 	config.AADEndpoint = env.ActiveDirectoryEndpoint
 	return config.Authorizer()
 }
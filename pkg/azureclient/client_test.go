@@ -0,0 +1,60 @@
+package azureclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openshift/hive/pkg/constants"
+)
+
+const testAuthJSON = `{
+	"clientId": "test-client-id",
+	"clientSecret": "test-client-secret",
+	"tenantId": "test-tenant-id",
+	"subscriptionId": "creds-subscription"
+}`
+
+func TestNewClientFromSecretForSubscription(t *testing.T) {
+	cases := []struct {
+		name                 string
+		secretData           map[string][]byte
+		subscriptionID       string
+		expectedSubscription string
+		expectErr            bool
+	}{
+		{
+			name:                 "subscription of the creds",
+			secretData:           map[string][]byte{constants.AzureCredentialsName: []byte(testAuthJSON)},
+			expectedSubscription: "creds-subscription",
+		},
+		{
+			name:                 "other subscription",
+			secretData:           map[string][]byte{constants.AzureCredentialsName: []byte(testAuthJSON)},
+			subscriptionID:       "other-subscription",
+			expectedSubscription: "other-subscription",
+		},
+		{
+			name:           "missing creds",
+			secretData:     map[string][]byte{"other": []byte(testAuthJSON)},
+			subscriptionID: "other-subscription",
+			expectErr:      true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			secret := &corev1.Secret{Data: tc.secretData}
+			client, err := NewClientFromSecretForSubscription(secret, "", tc.subscriptionID)
+			if tc.expectErr {
+				assert.Error(t, err, "expected error creating client")
+				return
+			}
+			require.NoError(t, err, "unexpected error creating client")
+			assert.Equal(t, tc.expectedSubscription, client.(*azureClient).zonesClient.SubscriptionID, "unexpected subscription for DNS zones")
+			assert.Equal(t, tc.expectedSubscription, client.(*azureClient).recordSetsClient.SubscriptionID, "unexpected subscription for DNS records")
+		})
+	}
+}
@@ -6,6 +6,7 @@ package mock
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	compute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-12-01/compute"
@@ -82,6 +83,20 @@ func (mr *MockClientMockRecorder) DeallocateVirtualMachine(ctx, resourceGroup, n
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeallocateVirtualMachine", reflect.TypeOf((*MockClient)(nil).DeallocateVirtualMachine), ctx, resourceGroup, name)
 }
 
+// DeleteBlob mocks base method.
+func (m *MockClient) DeleteBlob(ctx context.Context, storageAccountName, containerName, blobName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBlob", ctx, storageAccountName, containerName, blobName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBlob indicates an expected call of DeleteBlob.
+func (mr *MockClientMockRecorder) DeleteBlob(ctx, storageAccountName, containerName, blobName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlob", reflect.TypeOf((*MockClient)(nil).DeleteBlob), ctx, storageAccountName, containerName, blobName)
+}
+
 // DeleteRecordSet mocks base method.
 func (m *MockClient) DeleteRecordSet(ctx context.Context, resourceGroupName, zone, recordSetName string, recordType dns.RecordType) error {
 	m.ctrl.T.Helper()
@@ -110,6 +125,21 @@ func (mr *MockClientMockRecorder) DeleteZone(ctx, resourceGroupName, zone interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteZone", reflect.TypeOf((*MockClient)(nil).DeleteZone), ctx, resourceGroupName, zone)
 }
 
+// GetBlob mocks base method.
+func (m *MockClient) GetBlob(ctx context.Context, storageAccountName, containerName, blobName string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlob", ctx, storageAccountName, containerName, blobName)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlob indicates an expected call of GetBlob.
+func (mr *MockClientMockRecorder) GetBlob(ctx, storageAccountName, containerName, blobName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlob", reflect.TypeOf((*MockClient)(nil).GetBlob), ctx, storageAccountName, containerName, blobName)
+}
+
 // GetVMCapabilities mocks base method.
 func (m *MockClient) GetVMCapabilities(ctx context.Context, instanceType, region string) (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -170,6 +200,21 @@ func (mr *MockClientMockRecorder) ListAllVirtualMachines(ctx, statusOnly interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllVirtualMachines", reflect.TypeOf((*MockClient)(nil).ListAllVirtualMachines), ctx, statusOnly)
 }
 
+// ListBlobs mocks base method.
+func (m *MockClient) ListBlobs(ctx context.Context, storageAccountName, containerName, prefix string) ([]azureclient.Blob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlobs", ctx, storageAccountName, containerName, prefix)
+	ret0, _ := ret[0].([]azureclient.Blob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlobs indicates an expected call of ListBlobs.
+func (mr *MockClientMockRecorder) ListBlobs(ctx, storageAccountName, containerName, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlobs", reflect.TypeOf((*MockClient)(nil).ListBlobs), ctx, storageAccountName, containerName, prefix)
+}
+
 // ListImagesByResourceGroup mocks base method.
 func (m *MockClient) ListImagesByResourceGroup(ctx context.Context, resourceGroupName string) (azureclient.ImageListResultPage, error) {
 	m.ctrl.T.Helper()
@@ -230,6 +275,21 @@ func (mr *MockClientMockRecorder) StartVirtualMachine(ctx, resourceGroup, name i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVirtualMachine", reflect.TypeOf((*MockClient)(nil).StartVirtualMachine), ctx, resourceGroup, name)
 }
 
+// UploadBlob mocks base method.
+func (m *MockClient) UploadBlob(ctx context.Context, storageAccountName, containerName, blobName string, body io.Reader, size int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadBlob", ctx, storageAccountName, containerName, blobName, body, size)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadBlob indicates an expected call of UploadBlob.
+func (mr *MockClientMockRecorder) UploadBlob(ctx, storageAccountName, containerName, blobName, body, size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadBlob", reflect.TypeOf((*MockClient)(nil).UploadBlob), ctx, storageAccountName, containerName, blobName, body, size)
+}
+
 // MockResourceSKUsPage is a mock of ResourceSKUsPage interface.
 type MockResourceSKUsPage struct {
 	ctrl     *gomock.Controller
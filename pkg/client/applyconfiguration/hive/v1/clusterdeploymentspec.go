@@ -29,6 +29,7 @@ type ClusterDeploymentSpecApplyConfiguration struct {
 	HibernateAfter                          *metav1.Duration                                `json:"hibernateAfter,omitempty"`
 	InstallAttemptsLimit                    *int32                                          `json:"installAttemptsLimit,omitempty"`
 	BoundServiceAccountSignkingKeySecretRef *corev1.LocalObjectReference                    `json:"boundServiceAccountSigningKeySecretRef,omitempty"`
+	Upgrade                                 *ClusterUpgradeApplyConfiguration               `json:"upgrade,omitempty"`
 }
 
 // ClusterDeploymentSpecApplyConfiguration constructs an declarative configuration of the ClusterDeploymentSpec type for use with
@@ -190,3 +191,11 @@ func (b *ClusterDeploymentSpecApplyConfiguration) WithBoundServiceAccountSignkin
 	b.BoundServiceAccountSignkingKeySecretRef = &value
 	return b
 }
+
+// WithUpgrade sets the Upgrade field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Upgrade field is set to the value of the last call.
+func (b *ClusterDeploymentSpecApplyConfiguration) WithUpgrade(value *ClusterUpgradeApplyConfiguration) *ClusterDeploymentSpecApplyConfiguration {
+	b.Upgrade = value
+	return b
+}
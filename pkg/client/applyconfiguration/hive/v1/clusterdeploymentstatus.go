@@ -24,6 +24,7 @@ type ClusterDeploymentStatusApplyConfiguration struct {
 	PowerState              *hivev1.ClusterPowerState                      `json:"powerState,omitempty"`
 	ProvisionRef            *corev1.LocalObjectReference                   `json:"provisionRef,omitempty"`
 	Platform                *PlatformStatusApplyConfiguration              `json:"platformStatus,omitempty"`
+	Upgrade                 *ClusterUpgradeStatusApplyConfiguration        `json:"upgrade,omitempty"`
 }
 
 // ClusterDeploymentStatusApplyConfiguration constructs an declarative configuration of the ClusterDeploymentStatus type for use with
@@ -145,3 +146,11 @@ func (b *ClusterDeploymentStatusApplyConfiguration) WithPlatform(value *Platform
 	b.Platform = value
 	return b
 }
+
+// WithUpgrade sets the Upgrade field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Upgrade field is set to the value of the last call.
+func (b *ClusterDeploymentStatusApplyConfiguration) WithUpgrade(value *ClusterUpgradeStatusApplyConfiguration) *ClusterDeploymentStatusApplyConfiguration {
+	b.Upgrade = value
+	return b
+}
@@ -23,6 +23,7 @@ type ClusterPoolSpecApplyConfiguration struct {
 	InstallConfigSecretTemplateRef *corev1.LocalObjectReference                `json:"installConfigSecretTemplateRef,omitempty"`
 	HibernateAfter                 *metav1.Duration                            `json:"hibernateAfter,omitempty"`
 	InstallAttemptsLimit           *int32                                      `json:"installAttemptsLimit,omitempty"`
+	PostInstallValidation          *PostInstallValidationApplyConfiguration    `json:"postInstallValidation,omitempty"`
 	SkipMachinePools               *bool                                       `json:"skipMachinePools,omitempty"`
 	ClaimLifetime                  *ClusterPoolClaimLifetimeApplyConfiguration `json:"claimLifetime,omitempty"`
 	HibernationConfig              *HibernationConfigApplyConfiguration        `json:"hibernationConfig,omitempty"`
@@ -151,6 +152,14 @@ func (b *ClusterPoolSpecApplyConfiguration) WithInstallAttemptsLimit(value int32
 	return b
 }
 
+// WithPostInstallValidation sets the PostInstallValidation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PostInstallValidation field is set to the value of the last call.
+func (b *ClusterPoolSpecApplyConfiguration) WithPostInstallValidation(value *PostInstallValidationApplyConfiguration) *ClusterPoolSpecApplyConfiguration {
+	b.PostInstallValidation = value
+	return b
+}
+
 // WithSkipMachinePools sets the SkipMachinePools field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the SkipMachinePools field is set to the value of the last call.
@@ -25,6 +25,7 @@ type ClusterProvisionSpecApplyConfiguration struct {
 	PrevClusterID            *string                       `json:"prevClusterID,omitempty"`
 	PrevInfraID              *string                       `json:"prevInfraID,omitempty"`
 	PrevProvisionName        *string                       `json:"prevProvisionName,omitempty"`
+	ExcludedZones            []string                      `json:"excludedZones,omitempty"`
 }
 
 // ClusterProvisionSpecApplyConfiguration constructs an declarative configuration of the ClusterProvisionSpec type for use with
@@ -146,3 +147,13 @@ func (b *ClusterProvisionSpecApplyConfiguration) WithPrevProvisionName(value str
 	b.PrevProvisionName = &value
 	return b
 }
+
+// WithExcludedZones adds the given value to the ExcludedZones field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExcludedZones field.
+func (b *ClusterProvisionSpecApplyConfiguration) WithExcludedZones(values ...string) *ClusterProvisionSpecApplyConfiguration {
+	for i := range values {
+		b.ExcludedZones = append(b.ExcludedZones, values[i])
+	}
+	return b
+}
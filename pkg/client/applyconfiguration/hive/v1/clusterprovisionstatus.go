@@ -9,8 +9,11 @@ import (
 // ClusterProvisionStatusApplyConfiguration represents an declarative configuration of the ClusterProvisionStatus type for use
 // with apply.
 type ClusterProvisionStatusApplyConfiguration struct {
-	JobRef     *v1.LocalObjectReference                      `json:"jobRef,omitempty"`
-	Conditions []ClusterProvisionConditionApplyConfiguration `json:"conditions,omitempty"`
+	JobRef                *v1.LocalObjectReference                          `json:"jobRef,omitempty"`
+	Conditions            []ClusterProvisionConditionApplyConfiguration     `json:"conditions,omitempty"`
+	LogURLs               []string                                          `json:"logURLs,omitempty"`
+	FailureClassification *ProvisionFailureClassificationApplyConfiguration `json:"failureClassification,omitempty"`
+	Milestones            []ProvisionMilestoneApplyConfiguration            `json:"milestones,omitempty"`
 }
 
 // ClusterProvisionStatusApplyConfiguration constructs an declarative configuration of the ClusterProvisionStatus type for use with
@@ -39,3 +42,34 @@ func (b *ClusterProvisionStatusApplyConfiguration) WithConditions(values ...*Clu
 	}
 	return b
 }
+
+// WithLogURLs adds the given value to the LogURLs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the LogURLs field.
+func (b *ClusterProvisionStatusApplyConfiguration) WithLogURLs(values ...string) *ClusterProvisionStatusApplyConfiguration {
+	for i := range values {
+		b.LogURLs = append(b.LogURLs, values[i])
+	}
+	return b
+}
+
+// WithFailureClassification sets the FailureClassification field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailureClassification field is set to the value of the last call.
+func (b *ClusterProvisionStatusApplyConfiguration) WithFailureClassification(value *ProvisionFailureClassificationApplyConfiguration) *ClusterProvisionStatusApplyConfiguration {
+	b.FailureClassification = value
+	return b
+}
+
+// WithMilestones adds the given value to the Milestones field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Milestones field.
+func (b *ClusterProvisionStatusApplyConfiguration) WithMilestones(values ...*ProvisionMilestoneApplyConfiguration) *ClusterProvisionStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMilestones")
+		}
+		b.Milestones = append(b.Milestones, *values[i])
+	}
+	return b
+}
@@ -5,12 +5,17 @@ package v1
 // DNSZoneSpecApplyConfiguration represents an declarative configuration of the DNSZoneSpec type for use
 // with apply.
 type DNSZoneSpecApplyConfiguration struct {
-	Zone               *string                             `json:"zone,omitempty"`
-	LinkToParentDomain *bool                               `json:"linkToParentDomain,omitempty"`
-	PreserveOnDelete   *bool                               `json:"preserveOnDelete,omitempty"`
-	AWS                *AWSDNSZoneSpecApplyConfiguration   `json:"aws,omitempty"`
-	GCP                *GCPDNSZoneSpecApplyConfiguration   `json:"gcp,omitempty"`
-	Azure              *AzureDNSZoneSpecApplyConfiguration `json:"azure,omitempty"`
+	Zone               *string                                    `json:"zone,omitempty"`
+	LinkToParentDomain *bool                                      `json:"linkToParentDomain,omitempty"`
+	PreserveOnDelete   *bool                                      `json:"preserveOnDelete,omitempty"`
+	AWS                *AWSDNSZoneSpecApplyConfiguration          `json:"aws,omitempty"`
+	GCP                *GCPDNSZoneSpecApplyConfiguration          `json:"gcp,omitempty"`
+	Azure              *AzureDNSZoneSpecApplyConfiguration        `json:"azure,omitempty"`
+	IBMCloud           *IBMCloudDNSZoneSpecApplyConfiguration     `json:"ibmcloud,omitempty"`
+	AlibabaCloud       *AlibabaCloudDNSZoneSpecApplyConfiguration `json:"alibabacloud,omitempty"`
+	RFC2136            *RFC2136DNSZoneSpecApplyConfiguration      `json:"rfc2136,omitempty"`
+	DNSSEC             *DNSSECSpecApplyConfiguration              `json:"dnssec,omitempty"`
+	Records            []DNSRecordApplyConfiguration              `json:"records,omitempty"`
 }
 
 // DNSZoneSpecApplyConfiguration constructs an declarative configuration of the DNSZoneSpec type for use with
@@ -66,3 +71,48 @@ func (b *DNSZoneSpecApplyConfiguration) WithAzure(value *AzureDNSZoneSpecApplyCo
 	b.Azure = value
 	return b
 }
+
+// WithIBMCloud sets the IBMCloud field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IBMCloud field is set to the value of the last call.
+func (b *DNSZoneSpecApplyConfiguration) WithIBMCloud(value *IBMCloudDNSZoneSpecApplyConfiguration) *DNSZoneSpecApplyConfiguration {
+	b.IBMCloud = value
+	return b
+}
+
+// WithAlibabaCloud sets the AlibabaCloud field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AlibabaCloud field is set to the value of the last call.
+func (b *DNSZoneSpecApplyConfiguration) WithAlibabaCloud(value *AlibabaCloudDNSZoneSpecApplyConfiguration) *DNSZoneSpecApplyConfiguration {
+	b.AlibabaCloud = value
+	return b
+}
+
+// WithRFC2136 sets the RFC2136 field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RFC2136 field is set to the value of the last call.
+func (b *DNSZoneSpecApplyConfiguration) WithRFC2136(value *RFC2136DNSZoneSpecApplyConfiguration) *DNSZoneSpecApplyConfiguration {
+	b.RFC2136 = value
+	return b
+}
+
+// WithDNSSEC sets the DNSSEC field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DNSSEC field is set to the value of the last call.
+func (b *DNSZoneSpecApplyConfiguration) WithDNSSEC(value *DNSSECSpecApplyConfiguration) *DNSZoneSpecApplyConfiguration {
+	b.DNSSEC = value
+	return b
+}
+
+// WithRecords adds the given value to the Records field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Records field.
+func (b *DNSZoneSpecApplyConfiguration) WithRecords(values ...*DNSRecordApplyConfiguration) *DNSZoneSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRecords")
+		}
+		b.Records = append(b.Records, *values[i])
+	}
+	return b
+}
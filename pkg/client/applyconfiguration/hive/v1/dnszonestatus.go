@@ -10,13 +10,17 @@ import (
 // DNSZoneStatusApplyConfiguration represents an declarative configuration of the DNSZoneStatus type for use
 // with apply.
 type DNSZoneStatusApplyConfiguration struct {
-	LastSyncTimestamp  *v1.Time                             `json:"lastSyncTimestamp,omitempty"`
-	LastSyncGeneration *int64                               `json:"lastSyncGeneration,omitempty"`
-	NameServers        []string                             `json:"nameServers,omitempty"`
-	AWS                *AWSDNSZoneStatusApplyConfiguration  `json:"aws,omitempty"`
-	GCP                *GCPDNSZoneStatusApplyConfiguration  `json:"gcp,omitempty"`
-	Azure              *apishivev1.AzureDNSZoneStatus       `json:"azure,omitempty"`
-	Conditions         []DNSZoneConditionApplyConfiguration `json:"conditions,omitempty"`
+	LastSyncTimestamp  *v1.Time                                 `json:"lastSyncTimestamp,omitempty"`
+	LastSyncGeneration *int64                                   `json:"lastSyncGeneration,omitempty"`
+	NameServers        []string                                 `json:"nameServers,omitempty"`
+	AWS                *AWSDNSZoneStatusApplyConfiguration      `json:"aws,omitempty"`
+	GCP                *GCPDNSZoneStatusApplyConfiguration      `json:"gcp,omitempty"`
+	Azure              *apishivev1.AzureDNSZoneStatus           `json:"azure,omitempty"`
+	IBMCloud           *IBMCloudDNSZoneStatusApplyConfiguration `json:"ibmcloud,omitempty"`
+	DNSSEC             *DNSSECStatusApplyConfiguration          `json:"dnssec,omitempty"`
+	PublishedDSRecords []string                                 `json:"publishedDSRecords,omitempty"`
+	Records            []DNSRecordStatusApplyConfiguration      `json:"records,omitempty"`
+	Conditions         []DNSZoneConditionApplyConfiguration     `json:"conditions,omitempty"`
 }
 
 // DNSZoneStatusApplyConfiguration constructs an declarative configuration of the DNSZoneStatus type for use with
@@ -75,6 +79,45 @@ func (b *DNSZoneStatusApplyConfiguration) WithAzure(value apishivev1.AzureDNSZon
 	return b
 }
 
+// WithIBMCloud sets the IBMCloud field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IBMCloud field is set to the value of the last call.
+func (b *DNSZoneStatusApplyConfiguration) WithIBMCloud(value *IBMCloudDNSZoneStatusApplyConfiguration) *DNSZoneStatusApplyConfiguration {
+	b.IBMCloud = value
+	return b
+}
+
+// WithDNSSEC sets the DNSSEC field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DNSSEC field is set to the value of the last call.
+func (b *DNSZoneStatusApplyConfiguration) WithDNSSEC(value *DNSSECStatusApplyConfiguration) *DNSZoneStatusApplyConfiguration {
+	b.DNSSEC = value
+	return b
+}
+
+// WithPublishedDSRecords adds the given value to the PublishedDSRecords field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PublishedDSRecords field.
+func (b *DNSZoneStatusApplyConfiguration) WithPublishedDSRecords(values ...string) *DNSZoneStatusApplyConfiguration {
+	for i := range values {
+		b.PublishedDSRecords = append(b.PublishedDSRecords, values[i])
+	}
+	return b
+}
+
+// WithRecords adds the given value to the Records field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Records field.
+func (b *DNSZoneStatusApplyConfiguration) WithRecords(values ...*DNSRecordStatusApplyConfiguration) *DNSZoneStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRecords")
+		}
+		b.Records = append(b.Records, *values[i])
+	}
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
@@ -5,9 +5,12 @@ package v1
 // FailedProvisionConfigApplyConfiguration represents an declarative configuration of the FailedProvisionConfig type for use
 // with apply.
 type FailedProvisionConfigApplyConfiguration struct {
-	SkipGatherLogs *bool                                       `json:"skipGatherLogs,omitempty"`
-	AWS            *FailedProvisionAWSConfigApplyConfiguration `json:"aws,omitempty"`
-	RetryReasons   *[]string                                   `json:"retryReasons,omitempty"`
+	SkipGatherLogs *bool                                         `json:"skipGatherLogs,omitempty"`
+	AWS            *FailedProvisionAWSConfigApplyConfiguration   `json:"aws,omitempty"`
+	GCP            *FailedProvisionGCPConfigApplyConfiguration   `json:"gcp,omitempty"`
+	Azure          *FailedProvisionAzureConfigApplyConfiguration `json:"azure,omitempty"`
+	Archive        *InstallLogArchiveConfigApplyConfiguration    `json:"archive,omitempty"`
+	RetryReasons   *[]string                                     `json:"retryReasons,omitempty"`
 }
 
 // FailedProvisionConfigApplyConfiguration constructs an declarative configuration of the FailedProvisionConfig type for use with
@@ -32,6 +35,30 @@ func (b *FailedProvisionConfigApplyConfiguration) WithAWS(value *FailedProvision
 	return b
 }
 
+// WithGCP sets the GCP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GCP field is set to the value of the last call.
+func (b *FailedProvisionConfigApplyConfiguration) WithGCP(value *FailedProvisionGCPConfigApplyConfiguration) *FailedProvisionConfigApplyConfiguration {
+	b.GCP = value
+	return b
+}
+
+// WithAzure sets the Azure field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Azure field is set to the value of the last call.
+func (b *FailedProvisionConfigApplyConfiguration) WithAzure(value *FailedProvisionAzureConfigApplyConfiguration) *FailedProvisionConfigApplyConfiguration {
+	b.Azure = value
+	return b
+}
+
+// WithArchive sets the Archive field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Archive field is set to the value of the last call.
+func (b *FailedProvisionConfigApplyConfiguration) WithArchive(value *InstallLogArchiveConfigApplyConfiguration) *FailedProvisionConfigApplyConfiguration {
+	b.Archive = value
+	return b
+}
+
 // WithRetryReasons sets the RetryReasons field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the RetryReasons field is set to the value of the last call.
@@ -20,6 +20,10 @@ type HiveConfigSpecApplyConfiguration struct {
 	ServiceProviderCredentialsConfig          *ServiceProviderCredentialsApplyConfiguration                 `json:"serviceProviderCredentialsConfig,omitempty"`
 	LogLevel                                  *string                                                       `json:"logLevel,omitempty"`
 	SyncSetReapplyInterval                    *string                                                       `json:"syncSetReapplyInterval,omitempty"`
+	SyncSetSecretsVolume                      *corev1.CSIVolumeSource                                       `json:"syncSetSecretsVolume,omitempty"`
+	SyncSetInsecureRegistries                 []string                                                      `json:"syncSetInsecureRegistries,omitempty"`
+	SyncSetHelmChartRepoHosts                 []string                                                      `json:"syncSetHelmChartRepoHosts,omitempty"`
+	SyncSetVaultAddresses                     []string                                                      `json:"syncSetVaultAddresses,omitempty"`
 	MaintenanceMode                           *bool                                                         `json:"maintenanceMode,omitempty"`
 	DeprovisionsDisabled                      *bool                                                         `json:"deprovisionsDisabled,omitempty"`
 	DeleteProtection                          *hivev1.DeleteProtectionType                                  `json:"deleteProtection,omitempty"`
@@ -119,6 +123,44 @@ func (b *HiveConfigSpecApplyConfiguration) WithSyncSetReapplyInterval(value stri
 	return b
 }
 
+// WithSyncSetSecretsVolume sets the SyncSetSecretsVolume field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SyncSetSecretsVolume field is set to the value of the last call.
+func (b *HiveConfigSpecApplyConfiguration) WithSyncSetSecretsVolume(value corev1.CSIVolumeSource) *HiveConfigSpecApplyConfiguration {
+	b.SyncSetSecretsVolume = &value
+	return b
+}
+
+// WithSyncSetInsecureRegistries adds the given value to the SyncSetInsecureRegistries field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SyncSetInsecureRegistries field.
+func (b *HiveConfigSpecApplyConfiguration) WithSyncSetInsecureRegistries(values ...string) *HiveConfigSpecApplyConfiguration {
+	for i := range values {
+		b.SyncSetInsecureRegistries = append(b.SyncSetInsecureRegistries, values[i])
+	}
+	return b
+}
+
+// WithSyncSetHelmChartRepoHosts adds the given value to the SyncSetHelmChartRepoHosts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SyncSetHelmChartRepoHosts field.
+func (b *HiveConfigSpecApplyConfiguration) WithSyncSetHelmChartRepoHosts(values ...string) *HiveConfigSpecApplyConfiguration {
+	for i := range values {
+		b.SyncSetHelmChartRepoHosts = append(b.SyncSetHelmChartRepoHosts, values[i])
+	}
+	return b
+}
+
+// WithSyncSetVaultAddresses adds the given value to the SyncSetVaultAddresses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SyncSetVaultAddresses field.
+func (b *HiveConfigSpecApplyConfiguration) WithSyncSetVaultAddresses(values ...string) *HiveConfigSpecApplyConfiguration {
+	for i := range values {
+		b.SyncSetVaultAddresses = append(b.SyncSetVaultAddresses, values[i])
+	}
+	return b
+}
+
 // WithMaintenanceMode sets the MaintenanceMode field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the MaintenanceMode field is set to the value of the last call.
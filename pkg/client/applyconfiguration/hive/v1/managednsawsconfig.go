@@ -3,14 +3,16 @@
 package v1
 
 import (
+	aws "github.com/openshift/hive/apis/hive/v1/aws"
 	v1 "k8s.io/api/core/v1"
 )
 
 // ManageDNSAWSConfigApplyConfiguration represents an declarative configuration of the ManageDNSAWSConfig type for use
 // with apply.
 type ManageDNSAWSConfigApplyConfiguration struct {
-	CredentialsSecretRef *v1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
-	Region               *string                  `json:"region,omitempty"`
+	CredentialsSecretRef  *v1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
+	CredentialsAssumeRole *aws.AssumeRole          `json:"credentialsAssumeRole,omitempty"`
+	Region                *string                  `json:"region,omitempty"`
 }
 
 // ManageDNSAWSConfigApplyConfiguration constructs an declarative configuration of the ManageDNSAWSConfig type for use with
@@ -27,6 +29,14 @@ func (b *ManageDNSAWSConfigApplyConfiguration) WithCredentialsSecretRef(value v1
 	return b
 }
 
+// WithCredentialsAssumeRole sets the CredentialsAssumeRole field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CredentialsAssumeRole field is set to the value of the last call.
+func (b *ManageDNSAWSConfigApplyConfiguration) WithCredentialsAssumeRole(value aws.AssumeRole) *ManageDNSAWSConfigApplyConfiguration {
+	b.CredentialsAssumeRole = &value
+	return b
+}
+
 // WithRegion sets the Region field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Region field is set to the value of the last call.
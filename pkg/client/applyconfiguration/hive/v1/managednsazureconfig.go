@@ -12,6 +12,7 @@ import (
 type ManageDNSAzureConfigApplyConfiguration struct {
 	CredentialsSecretRef *v1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
 	ResourceGroupName    *string                  `json:"resourceGroupName,omitempty"`
+	SubscriptionID       *string                  `json:"subscriptionID,omitempty"`
 	CloudName            *azure.CloudEnvironment  `json:"cloudName,omitempty"`
 }
 
@@ -37,6 +38,14 @@ func (b *ManageDNSAzureConfigApplyConfiguration) WithResourceGroupName(value str
 	return b
 }
 
+// WithSubscriptionID sets the SubscriptionID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SubscriptionID field is set to the value of the last call.
+func (b *ManageDNSAzureConfigApplyConfiguration) WithSubscriptionID(value string) *ManageDNSAzureConfigApplyConfiguration {
+	b.SubscriptionID = &value
+	return b
+}
+
 // WithCloudName sets the CloudName field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the CloudName field is set to the value of the last call.
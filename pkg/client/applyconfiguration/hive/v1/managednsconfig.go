@@ -5,10 +5,13 @@ package v1
 // ManageDNSConfigApplyConfiguration represents an declarative configuration of the ManageDNSConfig type for use
 // with apply.
 type ManageDNSConfigApplyConfiguration struct {
-	Domains []string                                `json:"domains,omitempty"`
-	AWS     *ManageDNSAWSConfigApplyConfiguration   `json:"aws,omitempty"`
-	GCP     *ManageDNSGCPConfigApplyConfiguration   `json:"gcp,omitempty"`
-	Azure   *ManageDNSAzureConfigApplyConfiguration `json:"azure,omitempty"`
+	Domains      []string                                       `json:"domains,omitempty"`
+	AWS          *ManageDNSAWSConfigApplyConfiguration          `json:"aws,omitempty"`
+	GCP          *ManageDNSGCPConfigApplyConfiguration          `json:"gcp,omitempty"`
+	Azure        *ManageDNSAzureConfigApplyConfiguration        `json:"azure,omitempty"`
+	IBMCloud     *ManageDNSIBMCloudConfigApplyConfiguration     `json:"ibmcloud,omitempty"`
+	AlibabaCloud *ManageDNSAlibabaCloudConfigApplyConfiguration `json:"alibabacloud,omitempty"`
+	RFC2136      *ManageDNSRFC2136ConfigApplyConfiguration      `json:"rfc2136,omitempty"`
 }
 
 // ManageDNSConfigApplyConfiguration constructs an declarative configuration of the ManageDNSConfig type for use with
@@ -50,3 +53,27 @@ func (b *ManageDNSConfigApplyConfiguration) WithAzure(value *ManageDNSAzureConfi
 	b.Azure = value
 	return b
 }
+
+// WithIBMCloud sets the IBMCloud field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IBMCloud field is set to the value of the last call.
+func (b *ManageDNSConfigApplyConfiguration) WithIBMCloud(value *ManageDNSIBMCloudConfigApplyConfiguration) *ManageDNSConfigApplyConfiguration {
+	b.IBMCloud = value
+	return b
+}
+
+// WithAlibabaCloud sets the AlibabaCloud field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AlibabaCloud field is set to the value of the last call.
+func (b *ManageDNSConfigApplyConfiguration) WithAlibabaCloud(value *ManageDNSAlibabaCloudConfigApplyConfiguration) *ManageDNSConfigApplyConfiguration {
+	b.AlibabaCloud = value
+	return b
+}
+
+// WithRFC2136 sets the RFC2136 field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RFC2136 field is set to the value of the last call.
+func (b *ManageDNSConfigApplyConfiguration) WithRFC2136(value *ManageDNSRFC2136ConfigApplyConfiguration) *ManageDNSConfigApplyConfiguration {
+	b.RFC2136 = value
+	return b
+}
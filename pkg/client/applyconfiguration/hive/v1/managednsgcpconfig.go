@@ -10,6 +10,7 @@ import (
 // with apply.
 type ManageDNSGCPConfigApplyConfiguration struct {
 	CredentialsSecretRef *v1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
+	ProjectID            *string                  `json:"projectID,omitempty"`
 }
 
 // ManageDNSGCPConfigApplyConfiguration constructs an declarative configuration of the ManageDNSGCPConfig type for use with
@@ -25,3 +26,11 @@ func (b *ManageDNSGCPConfigApplyConfiguration) WithCredentialsSecretRef(value v1
 	b.CredentialsSecretRef = &value
 	return b
 }
+
+// WithProjectID sets the ProjectID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProjectID field is set to the value of the last call.
+func (b *ManageDNSGCPConfigApplyConfiguration) WithProjectID(value string) *ManageDNSGCPConfigApplyConfiguration {
+	b.ProjectID = &value
+	return b
+}
@@ -9,15 +9,17 @@ import (
 // ProvisioningApplyConfiguration represents an declarative configuration of the Provisioning type for use
 // with apply.
 type ProvisioningApplyConfiguration struct {
-	InstallConfigSecretRef *v1.LocalObjectReference                    `json:"installConfigSecretRef,omitempty"`
-	ReleaseImage           *string                                     `json:"releaseImage,omitempty"`
-	InstallerImageOverride *string                                     `json:"installerImageOverride,omitempty"`
-	ImageSetRef            *ClusterImageSetReferenceApplyConfiguration `json:"imageSetRef,omitempty"`
-	ManifestsConfigMapRef  *v1.LocalObjectReference                    `json:"manifestsConfigMapRef,omitempty"`
-	ManifestsSecretRef     *v1.LocalObjectReference                    `json:"manifestsSecretRef,omitempty"`
-	SSHPrivateKeySecretRef *v1.LocalObjectReference                    `json:"sshPrivateKeySecretRef,omitempty"`
-	SSHKnownHosts          []string                                    `json:"sshKnownHosts,omitempty"`
-	InstallerEnv           []v1.EnvVar                                 `json:"installerEnv,omitempty"`
+	InstallConfigSecretRef   *v1.LocalObjectReference                    `json:"installConfigSecretRef,omitempty"`
+	ReleaseImage             *string                                     `json:"releaseImage,omitempty"`
+	InstallerImageOverride   *string                                     `json:"installerImageOverride,omitempty"`
+	ImageSetRef              *ClusterImageSetReferenceApplyConfiguration `json:"imageSetRef,omitempty"`
+	ManifestsConfigMapRef    *v1.LocalObjectReference                    `json:"manifestsConfigMapRef,omitempty"`
+	ManifestsSecretRef       *v1.LocalObjectReference                    `json:"manifestsSecretRef,omitempty"`
+	SSHPrivateKeySecretRef   *v1.LocalObjectReference                    `json:"sshPrivateKeySecretRef,omitempty"`
+	SSHKnownHosts            []string                                    `json:"sshKnownHosts,omitempty"`
+	InstallerEnv             []v1.EnvVar                                 `json:"installerEnv,omitempty"`
+	ResumeInterruptedInstall *bool                                       `json:"resumeInterruptedInstall,omitempty"`
+	PostInstallValidation    *PostInstallValidationApplyConfiguration    `json:"postInstallValidation,omitempty"`
 }
 
 // ProvisioningApplyConfiguration constructs an declarative configuration of the Provisioning type for use with
@@ -101,3 +103,19 @@ func (b *ProvisioningApplyConfiguration) WithInstallerEnv(values ...v1.EnvVar) *
 	}
 	return b
 }
+
+// WithResumeInterruptedInstall sets the ResumeInterruptedInstall field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResumeInterruptedInstall field is set to the value of the last call.
+func (b *ProvisioningApplyConfiguration) WithResumeInterruptedInstall(value bool) *ProvisioningApplyConfiguration {
+	b.ResumeInterruptedInstall = &value
+	return b
+}
+
+// WithPostInstallValidation sets the PostInstallValidation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PostInstallValidation field is set to the value of the last call.
+func (b *ProvisioningApplyConfiguration) WithPostInstallValidation(value *PostInstallValidationApplyConfiguration) *ProvisioningApplyConfiguration {
+	b.PostInstallValidation = value
+	return b
+}
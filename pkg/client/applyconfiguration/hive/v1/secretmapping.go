@@ -5,8 +5,9 @@ package v1
 // SecretMappingApplyConfiguration represents an declarative configuration of the SecretMapping type for use
 // with apply.
 type SecretMappingApplyConfiguration struct {
-	SourceRef *SecretReferenceApplyConfiguration `json:"sourceRef,omitempty"`
-	TargetRef *SecretReferenceApplyConfiguration `json:"targetRef,omitempty"`
+	SourceRef      *SecretReferenceApplyConfiguration      `json:"sourceRef,omitempty"`
+	ExternalSource *ExternalSecretSourceApplyConfiguration `json:"externalSource,omitempty"`
+	TargetRef      *SecretReferenceApplyConfiguration      `json:"targetRef,omitempty"`
 }
 
 // SecretMappingApplyConfiguration constructs an declarative configuration of the SecretMapping type for use with
@@ -23,6 +24,14 @@ func (b *SecretMappingApplyConfiguration) WithSourceRef(value *SecretReferenceAp
 	return b
 }
 
+// WithExternalSource sets the ExternalSource field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExternalSource field is set to the value of the last call.
+func (b *SecretMappingApplyConfiguration) WithExternalSource(value *ExternalSecretSourceApplyConfiguration) *SecretMappingApplyConfiguration {
+	b.ExternalSource = value
+	return b
+}
+
 // WithTargetRef sets the TargetRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the TargetRef field is set to the value of the last call.
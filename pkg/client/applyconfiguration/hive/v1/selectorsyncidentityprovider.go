@@ -3,7 +3,6 @@
 package v1
 
 import (
-	apishivev1 "github.com/openshift/hive/apis/hive/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
@@ -15,7 +14,7 @@ type SelectorSyncIdentityProviderApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
 	Spec                             *SelectorSyncIdentityProviderSpecApplyConfiguration `json:"spec,omitempty"`
-	Status                           *apishivev1.IdentityProviderStatus                  `json:"status,omitempty"`
+	Status                           *IdentityProviderStatusApplyConfiguration           `json:"status,omitempty"`
 }
 
 // SelectorSyncIdentityProvider constructs an declarative configuration of the SelectorSyncIdentityProvider type for use with
@@ -197,7 +196,7 @@ func (b *SelectorSyncIdentityProviderApplyConfiguration) WithSpec(value *Selecto
 // WithStatus sets the Status field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Status field is set to the value of the last call.
-func (b *SelectorSyncIdentityProviderApplyConfiguration) WithStatus(value apishivev1.IdentityProviderStatus) *SelectorSyncIdentityProviderApplyConfiguration {
-	b.Status = &value
+func (b *SelectorSyncIdentityProviderApplyConfiguration) WithStatus(value *IdentityProviderStatusApplyConfiguration) *SelectorSyncIdentityProviderApplyConfiguration {
+	b.Status = value
 	return b
 }
@@ -30,6 +30,22 @@ func (b *SelectorSyncIdentityProviderSpecApplyConfiguration) WithIdentityProvide
 	return b
 }
 
+// WithTokenConfig sets the TokenConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenConfig field is set to the value of the last call.
+func (b *SelectorSyncIdentityProviderSpecApplyConfiguration) WithTokenConfig(value configv1.TokenConfig) *SelectorSyncIdentityProviderSpecApplyConfiguration {
+	b.TokenConfig = &value
+	return b
+}
+
+// WithReferencedObjects sets the ReferencedObjects field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReferencedObjects field is set to the value of the last call.
+func (b *SelectorSyncIdentityProviderSpecApplyConfiguration) WithReferencedObjects(value *IdentityProviderReferencedObjectsApplyConfiguration) *SelectorSyncIdentityProviderSpecApplyConfiguration {
+	b.ReferencedObjects = value
+	return b
+}
+
 // WithClusterDeploymentSelector sets the ClusterDeploymentSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ClusterDeploymentSelector field is set to the value of the last call.
@@ -3,7 +3,6 @@
 package v1
 
 import (
-	apishivev1 "github.com/openshift/hive/apis/hive/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
@@ -14,8 +13,8 @@ import (
 type SelectorSyncSetApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *SelectorSyncSetSpecApplyConfiguration `json:"spec,omitempty"`
-	Status                           *apishivev1.SelectorSyncSetStatus      `json:"status,omitempty"`
+	Spec                             *SelectorSyncSetSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *SelectorSyncSetStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // SelectorSyncSet constructs an declarative configuration of the SelectorSyncSet type for use with
@@ -197,7 +196,7 @@ func (b *SelectorSyncSetApplyConfiguration) WithSpec(value *SelectorSyncSetSpecA
 // WithStatus sets the Status field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Status field is set to the value of the last call.
-func (b *SelectorSyncSetApplyConfiguration) WithStatus(value apishivev1.SelectorSyncSetStatus) *SelectorSyncSetApplyConfiguration {
-	b.Status = &value
+func (b *SelectorSyncSetApplyConfiguration) WithStatus(value *SelectorSyncSetStatusApplyConfiguration) *SelectorSyncSetApplyConfiguration {
+	b.Status = value
 	return b
 }
@@ -12,7 +12,8 @@ import (
 // with apply.
 type SelectorSyncSetSpecApplyConfiguration struct {
 	SyncSetCommonSpecApplyConfiguration `json:",inline"`
-	ClusterDeploymentSelector           *metav1.LabelSelector `json:"clusterDeploymentSelector,omitempty"`
+	ClusterDeploymentSelector           *metav1.LabelSelector                             `json:"clusterDeploymentSelector,omitempty"`
+	RolloutStrategy                     *SelectorSyncSetRolloutStrategyApplyConfiguration `json:"rolloutStrategy,omitempty"`
 }
 
 // SelectorSyncSetSpecApplyConfiguration constructs an declarative configuration of the SelectorSyncSetSpec type for use with
@@ -73,6 +74,38 @@ func (b *SelectorSyncSetSpecApplyConfiguration) WithApplyBehavior(value hivev1.S
 	return b
 }
 
+// WithSource sets the Source field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Source field is set to the value of the last call.
+func (b *SelectorSyncSetSpecApplyConfiguration) WithSource(value *SyncSetSourceApplyConfiguration) *SelectorSyncSetSpecApplyConfiguration {
+	b.Source = value
+	return b
+}
+
+// WithKustomize sets the Kustomize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kustomize field is set to the value of the last call.
+func (b *SelectorSyncSetSpecApplyConfiguration) WithKustomize(value *KustomizeSyncSetRenderApplyConfiguration) *SelectorSyncSetSpecApplyConfiguration {
+	b.Kustomize = value
+	return b
+}
+
+// WithHelmChart sets the HelmChart field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HelmChart field is set to the value of the last call.
+func (b *SelectorSyncSetSpecApplyConfiguration) WithHelmChart(value *HelmChartSyncSetRenderApplyConfiguration) *SelectorSyncSetSpecApplyConfiguration {
+	b.HelmChart = value
+	return b
+}
+
+// WithHealthCheck sets the HealthCheck field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HealthCheck field is set to the value of the last call.
+func (b *SelectorSyncSetSpecApplyConfiguration) WithHealthCheck(value *SyncSetHealthCheckApplyConfiguration) *SelectorSyncSetSpecApplyConfiguration {
+	b.HealthCheck = value
+	return b
+}
+
 // WithClusterDeploymentSelector sets the ClusterDeploymentSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ClusterDeploymentSelector field is set to the value of the last call.
@@ -80,3 +113,11 @@ func (b *SelectorSyncSetSpecApplyConfiguration) WithClusterDeploymentSelector(va
 	b.ClusterDeploymentSelector = &value
 	return b
 }
+
+// WithRolloutStrategy sets the RolloutStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RolloutStrategy field is set to the value of the last call.
+func (b *SelectorSyncSetSpecApplyConfiguration) WithRolloutStrategy(value *SelectorSyncSetRolloutStrategyApplyConfiguration) *SelectorSyncSetSpecApplyConfiguration {
+	b.RolloutStrategy = value
+	return b
+}
@@ -9,7 +9,9 @@ import (
 // SyncIdentityProviderCommonSpecApplyConfiguration represents an declarative configuration of the SyncIdentityProviderCommonSpec type for use
 // with apply.
 type SyncIdentityProviderCommonSpecApplyConfiguration struct {
-	IdentityProviders []v1.IdentityProvider `json:"identityProviders,omitempty"`
+	IdentityProviders []v1.IdentityProvider                                `json:"identityProviders,omitempty"`
+	TokenConfig       *v1.TokenConfig                                      `json:"tokenConfig,omitempty"`
+	ReferencedObjects *IdentityProviderReferencedObjectsApplyConfiguration `json:"referencedObjects,omitempty"`
 }
 
 // SyncIdentityProviderCommonSpecApplyConfiguration constructs an declarative configuration of the SyncIdentityProviderCommonSpec type for use with
@@ -27,3 +29,19 @@ func (b *SyncIdentityProviderCommonSpecApplyConfiguration) WithIdentityProviders
 	}
 	return b
 }
+
+// WithTokenConfig sets the TokenConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenConfig field is set to the value of the last call.
+func (b *SyncIdentityProviderCommonSpecApplyConfiguration) WithTokenConfig(value v1.TokenConfig) *SyncIdentityProviderCommonSpecApplyConfiguration {
+	b.TokenConfig = &value
+	return b
+}
+
+// WithReferencedObjects sets the ReferencedObjects field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReferencedObjects field is set to the value of the last call.
+func (b *SyncIdentityProviderCommonSpecApplyConfiguration) WithReferencedObjects(value *IdentityProviderReferencedObjectsApplyConfiguration) *SyncIdentityProviderCommonSpecApplyConfiguration {
+	b.ReferencedObjects = value
+	return b
+}
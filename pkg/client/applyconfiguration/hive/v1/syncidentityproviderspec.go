@@ -30,6 +30,22 @@ func (b *SyncIdentityProviderSpecApplyConfiguration) WithIdentityProviders(value
 	return b
 }
 
+// WithTokenConfig sets the TokenConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenConfig field is set to the value of the last call.
+func (b *SyncIdentityProviderSpecApplyConfiguration) WithTokenConfig(value configv1.TokenConfig) *SyncIdentityProviderSpecApplyConfiguration {
+	b.TokenConfig = &value
+	return b
+}
+
+// WithReferencedObjects sets the ReferencedObjects field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReferencedObjects field is set to the value of the last call.
+func (b *SyncIdentityProviderSpecApplyConfiguration) WithReferencedObjects(value *IdentityProviderReferencedObjectsApplyConfiguration) *SyncIdentityProviderSpecApplyConfiguration {
+	b.ReferencedObjects = value
+	return b
+}
+
 // WithClusterDeploymentRefs adds the given value to the ClusterDeploymentRefs field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the ClusterDeploymentRefs field.
@@ -3,7 +3,6 @@
 package v1
 
 import (
-	apishivev1 "github.com/openshift/hive/apis/hive/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
@@ -14,8 +13,8 @@ import (
 type SyncSetApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *SyncSetSpecApplyConfiguration `json:"spec,omitempty"`
-	Status                           *apishivev1.SyncSetStatus      `json:"status,omitempty"`
+	Spec                             *SyncSetSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *SyncSetStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // SyncSet constructs an declarative configuration of the SyncSet type for use with
@@ -198,7 +197,7 @@ func (b *SyncSetApplyConfiguration) WithSpec(value *SyncSetSpecApplyConfiguratio
 // WithStatus sets the Status field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Status field is set to the value of the last call.
-func (b *SyncSetApplyConfiguration) WithStatus(value apishivev1.SyncSetStatus) *SyncSetApplyConfiguration {
-	b.Status = &value
+func (b *SyncSetApplyConfiguration) WithStatus(value *SyncSetStatusApplyConfiguration) *SyncSetApplyConfiguration {
+	b.Status = value
 	return b
 }
@@ -10,11 +10,15 @@ import (
 // SyncSetCommonSpecApplyConfiguration represents an declarative configuration of the SyncSetCommonSpec type for use
 // with apply.
 type SyncSetCommonSpecApplyConfiguration struct {
-	Resources         []runtime.RawExtension              `json:"resources,omitempty"`
-	ResourceApplyMode *v1.SyncSetResourceApplyMode        `json:"resourceApplyMode,omitempty"`
-	Patches           []SyncObjectPatchApplyConfiguration `json:"patches,omitempty"`
-	Secrets           []SecretMappingApplyConfiguration   `json:"secretMappings,omitempty"`
-	ApplyBehavior     *v1.SyncSetApplyBehavior            `json:"applyBehavior,omitempty"`
+	Resources         []runtime.RawExtension                    `json:"resources,omitempty"`
+	ResourceApplyMode *v1.SyncSetResourceApplyMode              `json:"resourceApplyMode,omitempty"`
+	Patches           []SyncObjectPatchApplyConfiguration       `json:"patches,omitempty"`
+	Secrets           []SecretMappingApplyConfiguration         `json:"secretMappings,omitempty"`
+	ApplyBehavior     *v1.SyncSetApplyBehavior                  `json:"applyBehavior,omitempty"`
+	Source            *SyncSetSourceApplyConfiguration          `json:"source,omitempty"`
+	Kustomize         *KustomizeSyncSetRenderApplyConfiguration `json:"kustomize,omitempty"`
+	HelmChart         *HelmChartSyncSetRenderApplyConfiguration `json:"helmChart,omitempty"`
+	HealthCheck       *SyncSetHealthCheckApplyConfiguration     `json:"healthCheck,omitempty"`
 }
 
 // SyncSetCommonSpecApplyConfiguration constructs an declarative configuration of the SyncSetCommonSpec type for use with
@@ -74,3 +78,35 @@ func (b *SyncSetCommonSpecApplyConfiguration) WithApplyBehavior(value v1.SyncSet
 	b.ApplyBehavior = &value
 	return b
 }
+
+// WithSource sets the Source field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Source field is set to the value of the last call.
+func (b *SyncSetCommonSpecApplyConfiguration) WithSource(value *SyncSetSourceApplyConfiguration) *SyncSetCommonSpecApplyConfiguration {
+	b.Source = value
+	return b
+}
+
+// WithKustomize sets the Kustomize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kustomize field is set to the value of the last call.
+func (b *SyncSetCommonSpecApplyConfiguration) WithKustomize(value *KustomizeSyncSetRenderApplyConfiguration) *SyncSetCommonSpecApplyConfiguration {
+	b.Kustomize = value
+	return b
+}
+
+// WithHelmChart sets the HelmChart field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HelmChart field is set to the value of the last call.
+func (b *SyncSetCommonSpecApplyConfiguration) WithHelmChart(value *HelmChartSyncSetRenderApplyConfiguration) *SyncSetCommonSpecApplyConfiguration {
+	b.HelmChart = value
+	return b
+}
+
+// WithHealthCheck sets the HealthCheck field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HealthCheck field is set to the value of the last call.
+func (b *SyncSetCommonSpecApplyConfiguration) WithHealthCheck(value *SyncSetHealthCheckApplyConfiguration) *SyncSetCommonSpecApplyConfiguration {
+	b.HealthCheck = value
+	return b
+}
@@ -73,6 +73,38 @@ func (b *SyncSetSpecApplyConfiguration) WithApplyBehavior(value hivev1.SyncSetAp
 	return b
 }
 
+// WithSource sets the Source field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Source field is set to the value of the last call.
+func (b *SyncSetSpecApplyConfiguration) WithSource(value *SyncSetSourceApplyConfiguration) *SyncSetSpecApplyConfiguration {
+	b.Source = value
+	return b
+}
+
+// WithKustomize sets the Kustomize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kustomize field is set to the value of the last call.
+func (b *SyncSetSpecApplyConfiguration) WithKustomize(value *KustomizeSyncSetRenderApplyConfiguration) *SyncSetSpecApplyConfiguration {
+	b.Kustomize = value
+	return b
+}
+
+// WithHelmChart sets the HelmChart field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HelmChart field is set to the value of the last call.
+func (b *SyncSetSpecApplyConfiguration) WithHelmChart(value *HelmChartSyncSetRenderApplyConfiguration) *SyncSetSpecApplyConfiguration {
+	b.HelmChart = value
+	return b
+}
+
+// WithHealthCheck sets the HealthCheck field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HealthCheck field is set to the value of the last call.
+func (b *SyncSetSpecApplyConfiguration) WithHealthCheck(value *SyncSetHealthCheckApplyConfiguration) *SyncSetSpecApplyConfiguration {
+	b.HealthCheck = value
+	return b
+}
+
 // WithClusterDeploymentRefs adds the given value to the ClusterDeploymentRefs field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the ClusterDeploymentRefs field.
@@ -3,7 +3,6 @@
 package v1alpha1
 
 import (
-	v1alpha1 "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
@@ -14,7 +13,7 @@ import (
 type ClusterSyncApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *v1alpha1.ClusterSyncSpec            `json:"spec,omitempty"`
+	Spec                             *ClusterSyncSpecApplyConfiguration   `json:"spec,omitempty"`
 	Status                           *ClusterSyncStatusApplyConfiguration `json:"status,omitempty"`
 }
 
@@ -190,8 +189,8 @@ func (b *ClusterSyncApplyConfiguration) ensureObjectMetaApplyConfigurationExists
 // WithSpec sets the Spec field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Spec field is set to the value of the last call.
-func (b *ClusterSyncApplyConfiguration) WithSpec(value v1alpha1.ClusterSyncSpec) *ClusterSyncApplyConfiguration {
-	b.Spec = &value
+func (b *ClusterSyncApplyConfiguration) WithSpec(value *ClusterSyncSpecApplyConfiguration) *ClusterSyncApplyConfiguration {
+	b.Spec = value
 	return b
 }
 
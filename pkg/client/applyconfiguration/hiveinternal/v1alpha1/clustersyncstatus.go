@@ -9,11 +9,14 @@ import (
 // ClusterSyncStatusApplyConfiguration represents an declarative configuration of the ClusterSyncStatus type for use
 // with apply.
 type ClusterSyncStatusApplyConfiguration struct {
-	SyncSets            []SyncStatusApplyConfiguration           `json:"syncSets,omitempty"`
-	SelectorSyncSets    []SyncStatusApplyConfiguration           `json:"selectorSyncSets,omitempty"`
-	Conditions          []ClusterSyncConditionApplyConfiguration `json:"conditions,omitempty"`
-	FirstSuccessTime    *v1.Time                                 `json:"firstSuccessTime,omitempty"`
-	ControlledByReplica *int64                                   `json:"controlledByReplica,omitempty"`
+	SyncSets               []SyncStatusApplyConfiguration           `json:"syncSets,omitempty"`
+	SelectorSyncSets       []SyncStatusApplyConfiguration           `json:"selectorSyncSets,omitempty"`
+	Conditions             []ClusterSyncConditionApplyConfiguration `json:"conditions,omitempty"`
+	FirstSuccessTime       *v1.Time                                 `json:"firstSuccessTime,omitempty"`
+	ControlledByReplica    *int64                                   `json:"controlledByReplica,omitempty"`
+	LastResyncRequestID    *string                                  `json:"lastResyncRequestID,omitempty"`
+	SyncSetHistory         []SyncHistoryApplyConfiguration          `json:"syncSetHistory,omitempty"`
+	SelectorSyncSetHistory []SyncHistoryApplyConfiguration          `json:"selectorSyncSetHistory,omitempty"`
 }
 
 // ClusterSyncStatusApplyConfiguration constructs an declarative configuration of the ClusterSyncStatus type for use with
@@ -76,3 +79,37 @@ func (b *ClusterSyncStatusApplyConfiguration) WithControlledByReplica(value int6
 	b.ControlledByReplica = &value
 	return b
 }
+
+// WithLastResyncRequestID sets the LastResyncRequestID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastResyncRequestID field is set to the value of the last call.
+func (b *ClusterSyncStatusApplyConfiguration) WithLastResyncRequestID(value string) *ClusterSyncStatusApplyConfiguration {
+	b.LastResyncRequestID = &value
+	return b
+}
+
+// WithSyncSetHistory adds the given value to the SyncSetHistory field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SyncSetHistory field.
+func (b *ClusterSyncStatusApplyConfiguration) WithSyncSetHistory(values ...*SyncHistoryApplyConfiguration) *ClusterSyncStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSyncSetHistory")
+		}
+		b.SyncSetHistory = append(b.SyncSetHistory, *values[i])
+	}
+	return b
+}
+
+// WithSelectorSyncSetHistory adds the given value to the SelectorSyncSetHistory field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SelectorSyncSetHistory field.
+func (b *ClusterSyncStatusApplyConfiguration) WithSelectorSyncSetHistory(values ...*SyncHistoryApplyConfiguration) *ClusterSyncStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSelectorSyncSetHistory")
+		}
+		b.SelectorSyncSetHistory = append(b.SelectorSyncSetHistory, *values[i])
+	}
+	return b
+}
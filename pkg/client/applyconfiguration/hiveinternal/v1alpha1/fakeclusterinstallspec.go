@@ -5,6 +5,7 @@ package v1alpha1
 import (
 	v1 "github.com/openshift/hive/pkg/client/applyconfiguration/hive/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // FakeClusterInstallSpecApplyConfiguration represents an declarative configuration of the FakeClusterInstallSpec type for use
@@ -13,6 +14,12 @@ type FakeClusterInstallSpecApplyConfiguration struct {
 	ImageSetRef          *v1.ClusterImageSetReferenceApplyConfiguration `json:"imageSetRef,omitempty"`
 	ClusterDeploymentRef *corev1.LocalObjectReference                   `json:"clusterDeploymentRef,omitempty"`
 	ClusterMetadata      *v1.ClusterMetadataApplyConfiguration          `json:"clusterMetadata,omitempty"`
+	InstallDuration      *metav1.Duration                               `json:"installDuration,omitempty"`
+	FailedAttempts       *int                                           `json:"failedAttempts,omitempty"`
+	FailurePercent       *int                                           `json:"failurePercent,omitempty"`
+	FailureReason        *string                                        `json:"failureReason,omitempty"`
+	HibernateDuration    *metav1.Duration                               `json:"hibernateDuration,omitempty"`
+	ResumeDuration       *metav1.Duration                               `json:"resumeDuration,omitempty"`
 }
 
 // FakeClusterInstallSpecApplyConfiguration constructs an declarative configuration of the FakeClusterInstallSpec type for use with
@@ -44,3 +51,51 @@ func (b *FakeClusterInstallSpecApplyConfiguration) WithClusterMetadata(value *v1
 	b.ClusterMetadata = value
 	return b
 }
+
+// WithInstallDuration sets the InstallDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InstallDuration field is set to the value of the last call.
+func (b *FakeClusterInstallSpecApplyConfiguration) WithInstallDuration(value metav1.Duration) *FakeClusterInstallSpecApplyConfiguration {
+	b.InstallDuration = &value
+	return b
+}
+
+// WithFailedAttempts sets the FailedAttempts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailedAttempts field is set to the value of the last call.
+func (b *FakeClusterInstallSpecApplyConfiguration) WithFailedAttempts(value int) *FakeClusterInstallSpecApplyConfiguration {
+	b.FailedAttempts = &value
+	return b
+}
+
+// WithFailurePercent sets the FailurePercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailurePercent field is set to the value of the last call.
+func (b *FakeClusterInstallSpecApplyConfiguration) WithFailurePercent(value int) *FakeClusterInstallSpecApplyConfiguration {
+	b.FailurePercent = &value
+	return b
+}
+
+// WithFailureReason sets the FailureReason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailureReason field is set to the value of the last call.
+func (b *FakeClusterInstallSpecApplyConfiguration) WithFailureReason(value string) *FakeClusterInstallSpecApplyConfiguration {
+	b.FailureReason = &value
+	return b
+}
+
+// WithHibernateDuration sets the HibernateDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HibernateDuration field is set to the value of the last call.
+func (b *FakeClusterInstallSpecApplyConfiguration) WithHibernateDuration(value metav1.Duration) *FakeClusterInstallSpecApplyConfiguration {
+	b.HibernateDuration = &value
+	return b
+}
+
+// WithResumeDuration sets the ResumeDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResumeDuration field is set to the value of the last call.
+func (b *FakeClusterInstallSpecApplyConfiguration) WithResumeDuration(value metav1.Duration) *FakeClusterInstallSpecApplyConfiguration {
+	b.ResumeDuration = &value
+	return b
+}
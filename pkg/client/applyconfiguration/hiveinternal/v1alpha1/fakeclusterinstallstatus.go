@@ -4,12 +4,15 @@ package v1alpha1
 
 import (
 	v1 "github.com/openshift/hive/pkg/client/applyconfiguration/hive/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // FakeClusterInstallStatusApplyConfiguration represents an declarative configuration of the FakeClusterInstallStatus type for use
 // with apply.
 type FakeClusterInstallStatusApplyConfiguration struct {
-	Conditions []v1.ClusterInstallConditionApplyConfiguration `json:"conditions,omitempty"`
+	Conditions         []v1.ClusterInstallConditionApplyConfiguration `json:"conditions,omitempty"`
+	InstallRestarts    *int                                           `json:"installRestarts,omitempty"`
+	AttemptStartedTime *metav1.Time                                   `json:"attemptStartedTime,omitempty"`
 }
 
 // FakeClusterInstallStatusApplyConfiguration constructs an declarative configuration of the FakeClusterInstallStatus type for use with
@@ -30,3 +33,19 @@ func (b *FakeClusterInstallStatusApplyConfiguration) WithConditions(values ...*v
 	}
 	return b
 }
+
+// WithInstallRestarts sets the InstallRestarts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InstallRestarts field is set to the value of the last call.
+func (b *FakeClusterInstallStatusApplyConfiguration) WithInstallRestarts(value int) *FakeClusterInstallStatusApplyConfiguration {
+	b.InstallRestarts = &value
+	return b
+}
+
+// WithAttemptStartedTime sets the AttemptStartedTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AttemptStartedTime field is set to the value of the last call.
+func (b *FakeClusterInstallStatusApplyConfiguration) WithAttemptStartedTime(value metav1.Time) *FakeClusterInstallStatusApplyConfiguration {
+	b.AttemptStartedTime = &value
+	return b
+}
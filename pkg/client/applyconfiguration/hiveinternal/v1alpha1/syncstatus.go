@@ -10,13 +10,17 @@ import (
 // SyncStatusApplyConfiguration represents an declarative configuration of the SyncStatus type for use
 // with apply.
 type SyncStatusApplyConfiguration struct {
-	Name               *string                                   `json:"name,omitempty"`
-	ObservedGeneration *int64                                    `json:"observedGeneration,omitempty"`
-	ResourcesToDelete  []SyncResourceReferenceApplyConfiguration `json:"resourcesToDelete,omitempty"`
-	Result             *hiveinternalv1alpha1.SyncSetResult       `json:"result,omitempty"`
-	FailureMessage     *string                                   `json:"failureMessage,omitempty"`
-	LastTransitionTime *v1.Time                                  `json:"lastTransitionTime,omitempty"`
-	FirstSuccessTime   *v1.Time                                  `json:"firstSuccessTime,omitempty"`
+	Name                *string                                   `json:"name,omitempty"`
+	ObservedGeneration  *int64                                    `json:"observedGeneration,omitempty"`
+	SourceRevision      *string                                   `json:"sourceRevision,omitempty"`
+	ExternalSecretsHash *string                                   `json:"externalSecretsHash,omitempty"`
+	ResourcesToDelete   []SyncResourceReferenceApplyConfiguration `json:"resourcesToDelete,omitempty"`
+	Result              *hiveinternalv1alpha1.SyncSetResult       `json:"result,omitempty"`
+	FailureMessage      *string                                   `json:"failureMessage,omitempty"`
+	LastTransitionTime  *v1.Time                                  `json:"lastTransitionTime,omitempty"`
+	FirstSuccessTime    *v1.Time                                  `json:"firstSuccessTime,omitempty"`
+	Health              *hiveinternalv1alpha1.SyncSetHealth       `json:"health,omitempty"`
+	UnhealthyResources  []ResourceHealthApplyConfiguration        `json:"unhealthyResources,omitempty"`
 }
 
 // SyncStatusApplyConfiguration constructs an declarative configuration of the SyncStatus type for use with
@@ -41,6 +45,22 @@ func (b *SyncStatusApplyConfiguration) WithObservedGeneration(value int64) *Sync
 	return b
 }
 
+// WithSourceRevision sets the SourceRevision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SourceRevision field is set to the value of the last call.
+func (b *SyncStatusApplyConfiguration) WithSourceRevision(value string) *SyncStatusApplyConfiguration {
+	b.SourceRevision = &value
+	return b
+}
+
+// WithExternalSecretsHash sets the ExternalSecretsHash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExternalSecretsHash field is set to the value of the last call.
+func (b *SyncStatusApplyConfiguration) WithExternalSecretsHash(value string) *SyncStatusApplyConfiguration {
+	b.ExternalSecretsHash = &value
+	return b
+}
+
 // WithResourcesToDelete adds the given value to the ResourcesToDelete field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the ResourcesToDelete field.
@@ -85,3 +105,24 @@ func (b *SyncStatusApplyConfiguration) WithFirstSuccessTime(value v1.Time) *Sync
 	b.FirstSuccessTime = &value
 	return b
 }
+
+// WithHealth sets the Health field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Health field is set to the value of the last call.
+func (b *SyncStatusApplyConfiguration) WithHealth(value hiveinternalv1alpha1.SyncSetHealth) *SyncStatusApplyConfiguration {
+	b.Health = &value
+	return b
+}
+
+// WithUnhealthyResources adds the given value to the UnhealthyResources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the UnhealthyResources field.
+func (b *SyncStatusApplyConfiguration) WithUnhealthyResources(values ...*ResourceHealthApplyConfiguration) *SyncStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithUnhealthyResources")
+		}
+		b.UnhealthyResources = append(b.UnhealthyResources, *values[i])
+	}
+	return b
+}
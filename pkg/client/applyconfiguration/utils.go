@@ -17,12 +17,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 	// Group=hive.openshift.io, Version=v1
 	case v1.SchemeGroupVersion.WithKind("AlibabaCloudClusterDeprovision"):
 		return &hivev1.AlibabaCloudClusterDeprovisionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("AlibabaCloudDNSZoneSpec"):
+		return &hivev1.AlibabaCloudDNSZoneSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ArgoCDConfig"):
 		return &hivev1.ArgoCDConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AWSAssociatedVPC"):
 		return &hivev1.AWSAssociatedVPCApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AWSClusterDeprovision"):
 		return &hivev1.AWSClusterDeprovisionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("AWSDNSSECSpec"):
+		return &hivev1.AWSDNSSECSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AWSDNSZoneSpec"):
 		return &hivev1.AWSDNSZoneSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AWSDNSZoneStatus"):
@@ -133,6 +137,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &hivev1.ClusterStateApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ClusterStateStatus"):
 		return &hivev1.ClusterStateStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ClusterUpgrade"):
+		return &hivev1.ClusterUpgradeApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ClusterUpgradeRollout"):
+		return &hivev1.ClusterUpgradeRolloutApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ClusterUpgradeRolloutSpec"):
+		return &hivev1.ClusterUpgradeRolloutSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ClusterUpgradeRolloutStatus"):
+		return &hivev1.ClusterUpgradeRolloutStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ClusterUpgradeStatus"):
+		return &hivev1.ClusterUpgradeStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ClusterUpgradeWave"):
+		return &hivev1.ClusterUpgradeWaveApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ClusterUpgradeWaveStatus"):
+		return &hivev1.ClusterUpgradeWaveStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ControllerConfig"):
 		return &hivev1.ControllerConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ControllersConfig"):
@@ -143,8 +161,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &hivev1.ControlPlaneConfigSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ControlPlaneServingCertificateSpec"):
 		return &hivev1.ControlPlaneServingCertificateSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("CustomHealthRule"):
+		return &hivev1.CustomHealthRuleApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("DeploymentConfig"):
 		return &hivev1.DeploymentConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DNSRecord"):
+		return &hivev1.DNSRecordApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DNSRecordStatus"):
+		return &hivev1.DNSRecordStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DNSSECSpec"):
+		return &hivev1.DNSSECSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DNSSECStatus"):
+		return &hivev1.DNSSECStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("DNSZone"):
 		return &hivev1.DNSZoneApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("DNSZoneCondition"):
@@ -153,20 +181,32 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &hivev1.DNSZoneSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("DNSZoneStatus"):
 		return &hivev1.DNSZoneStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ExternalSecretSource"):
+		return &hivev1.ExternalSecretSourceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("FailedProvisionAWSConfig"):
 		return &hivev1.FailedProvisionAWSConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("FailedProvisionAzureConfig"):
+		return &hivev1.FailedProvisionAzureConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("FailedProvisionConfig"):
 		return &hivev1.FailedProvisionConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("FailedProvisionGCPConfig"):
+		return &hivev1.FailedProvisionGCPConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("FeatureGateSelection"):
 		return &hivev1.FeatureGateSelectionApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("FeatureGatesEnabled"):
 		return &hivev1.FeatureGatesEnabledApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("FileSecretSource"):
+		return &hivev1.FileSecretSourceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GCPClusterDeprovision"):
 		return &hivev1.GCPClusterDeprovisionApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GCPDNSZoneSpec"):
 		return &hivev1.GCPDNSZoneSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GCPDNSZoneStatus"):
 		return &hivev1.GCPDNSZoneStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GitSyncSetSource"):
+		return &hivev1.GitSyncSetSourceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("HelmChartSyncSetRender"):
+		return &hivev1.HelmChartSyncSetRenderApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HibernationConfig"):
 		return &hivev1.HibernationConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HiveConfig"):
@@ -177,12 +217,28 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &hivev1.HiveConfigSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HiveConfigStatus"):
 		return &hivev1.HiveConfigStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IBMCloudDNSZoneSpec"):
+		return &hivev1.IBMCloudDNSZoneSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IBMCloudDNSZoneStatus"):
+		return &hivev1.IBMCloudDNSZoneStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IBMClusterDeprovision"):
 		return &hivev1.IBMClusterDeprovisionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IdentityProviderClusterStatus"):
+		return &hivev1.IdentityProviderClusterStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IdentityProviderObjectMapping"):
+		return &hivev1.IdentityProviderObjectMappingApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IdentityProviderReferencedObjects"):
+		return &hivev1.IdentityProviderReferencedObjectsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IdentityProviderStatus"):
+		return &hivev1.IdentityProviderStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("InstallLogArchiveConfig"):
+		return &hivev1.InstallLogArchiveConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("InventoryEntry"):
 		return &hivev1.InventoryEntryApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("KubeconfigSecretReference"):
 		return &hivev1.KubeconfigSecretReferenceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("KustomizeSyncSetRender"):
+		return &hivev1.KustomizeSyncSetRenderApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MachinePool"):
 		return &hivev1.MachinePoolApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MachinePoolAutoscaling"):
@@ -199,6 +255,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &hivev1.MachinePoolStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MachineSetStatus"):
 		return &hivev1.MachineSetStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("MaintenanceWindow"):
+		return &hivev1.MaintenanceWindowApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ManageDNSAlibabaCloudConfig"):
+		return &hivev1.ManageDNSAlibabaCloudConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ManageDNSAWSConfig"):
 		return &hivev1.ManageDNSAWSConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ManageDNSAzureConfig"):
@@ -207,6 +267,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &hivev1.ManageDNSConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ManageDNSGCPConfig"):
 		return &hivev1.ManageDNSGCPConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ManageDNSIBMCloudConfig"):
+		return &hivev1.ManageDNSIBMCloudConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ManageDNSRFC2136Config"):
+		return &hivev1.ManageDNSRFC2136ConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("OCISyncSetSource"):
+		return &hivev1.OCISyncSetSourceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("OpenStackClusterDeprovision"):
 		return &hivev1.OpenStackClusterDeprovisionApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("OvirtClusterDeprovision"):
@@ -217,10 +283,22 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &hivev1.PlatformApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PlatformStatus"):
 		return &hivev1.PlatformStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PostInstallValidation"):
+		return &hivev1.PostInstallValidationApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PostInstallValidationJob"):
+		return &hivev1.PostInstallValidationJobApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ProvisionFailureClassification"):
+		return &hivev1.ProvisionFailureClassificationApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Provisioning"):
 		return &hivev1.ProvisioningApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ProvisionMilestone"):
+		return &hivev1.ProvisionMilestoneApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ProvisionRetryPolicy"):
+		return &hivev1.ProvisionRetryPolicyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ReleaseImageVerificationConfigMapReference"):
 		return &hivev1.ReleaseImageVerificationConfigMapReferenceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RFC2136DNSZoneSpec"):
+		return &hivev1.RFC2136DNSZoneSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SecretMapping"):
 		return &hivev1.SecretMappingApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SecretReference"):
@@ -229,10 +307,24 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &hivev1.SelectorSyncIdentityProviderApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SelectorSyncIdentityProviderSpec"):
 		return &hivev1.SelectorSyncIdentityProviderSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SelectorSyncRBAC"):
+		return &hivev1.SelectorSyncRBACApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SelectorSyncRBACSpec"):
+		return &hivev1.SelectorSyncRBACSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SelectorSyncRBACStatus"):
+		return &hivev1.SelectorSyncRBACStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SelectorSyncSet"):
 		return &hivev1.SelectorSyncSetApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SelectorSyncSetCanary"):
+		return &hivev1.SelectorSyncSetCanaryApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SelectorSyncSetRolloutStatus"):
+		return &hivev1.SelectorSyncSetRolloutStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SelectorSyncSetRolloutStrategy"):
+		return &hivev1.SelectorSyncSetRolloutStrategyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SelectorSyncSetSpec"):
 		return &hivev1.SelectorSyncSetSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SelectorSyncSetStatus"):
+		return &hivev1.SelectorSyncSetStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ServiceProviderCredentials"):
 		return &hivev1.ServiceProviderCredentialsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SpecificControllerConfig"):
@@ -245,20 +337,50 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &hivev1.SyncIdentityProviderSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SyncObjectPatch"):
 		return &hivev1.SyncObjectPatchApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SyncRBACClusterRoleBinding"):
+		return &hivev1.SyncRBACClusterRoleBindingApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SyncRBACClusterStatus"):
+		return &hivev1.SyncRBACClusterStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SyncRBACGroup"):
+		return &hivev1.SyncRBACGroupApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SyncRBACRoleBinding"):
+		return &hivev1.SyncRBACRoleBindingApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SyncSet"):
 		return &hivev1.SyncSetApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SyncSetCommonSpec"):
 		return &hivev1.SyncSetCommonSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SyncSetHealthCheck"):
+		return &hivev1.SyncSetHealthCheckApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SyncSetSource"):
+		return &hivev1.SyncSetSourceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SyncSetSourceStatus"):
+		return &hivev1.SyncSetSourceStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SyncSetSpec"):
 		return &hivev1.SyncSetSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SyncSetStatus"):
+		return &hivev1.SyncSetStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("TaintIdentifier"):
 		return &hivev1.TaintIdentifierApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("VaultSecretSource"):
+		return &hivev1.VaultSecretSourceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("VeleroBackupConfig"):
 		return &hivev1.VeleroBackupConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("VSphereClusterDeprovision"):
 		return &hivev1.VSphereClusterDeprovisionApplyConfiguration{}
 
 		// Group=hiveinternal.openshift.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("AgentImageClusterInstall"):
+		return &hiveinternalv1alpha1.AgentImageClusterInstallApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AgentImageClusterInstallSpec"):
+		return &hiveinternalv1alpha1.AgentImageClusterInstallSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AgentImageClusterInstallStatus"):
+		return &hiveinternalv1alpha1.AgentImageClusterInstallStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AgentImagePersistentVolumeClaimStorage"):
+		return &hiveinternalv1alpha1.AgentImagePersistentVolumeClaimStorageApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AgentImageS3Storage"):
+		return &hiveinternalv1alpha1.AgentImageS3StorageApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AgentImageStorage"):
+		return &hiveinternalv1alpha1.AgentImageStorageApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ClusterSync"):
 		return &hiveinternalv1alpha1.ClusterSyncApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ClusterSyncCondition"):
@@ -267,6 +389,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &hiveinternalv1alpha1.ClusterSyncLeaseApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ClusterSyncLeaseSpec"):
 		return &hiveinternalv1alpha1.ClusterSyncLeaseSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ClusterSyncResync"):
+		return &hiveinternalv1alpha1.ClusterSyncResyncApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ClusterSyncSpec"):
+		return &hiveinternalv1alpha1.ClusterSyncSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ClusterSyncStatus"):
 		return &hiveinternalv1alpha1.ClusterSyncStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("FakeClusterInstall"):
@@ -275,6 +401,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &hiveinternalv1alpha1.FakeClusterInstallSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("FakeClusterInstallStatus"):
 		return &hiveinternalv1alpha1.FakeClusterInstallStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ResourceHealth"):
+		return &hiveinternalv1alpha1.ResourceHealthApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SyncAttempt"):
+		return &hiveinternalv1alpha1.SyncAttemptApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SyncHistory"):
+		return &hiveinternalv1alpha1.SyncHistoryApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SyncResourceReference"):
 		return &hiveinternalv1alpha1.SyncResourceReferenceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SyncStatus"):
@@ -52,6 +52,10 @@ func (c *FakeHiveV1) ClusterStates(namespace string) v1.ClusterStateInterface {
 	return &FakeClusterStates{c, namespace}
 }
 
+func (c *FakeHiveV1) ClusterUpgradeRollouts() v1.ClusterUpgradeRolloutInterface {
+	return &FakeClusterUpgradeRollouts{c}
+}
+
 func (c *FakeHiveV1) DNSZones(namespace string) v1.DNSZoneInterface {
 	return &FakeDNSZones{c, namespace}
 }
@@ -72,6 +76,10 @@ func (c *FakeHiveV1) SelectorSyncIdentityProviders() v1.SelectorSyncIdentityProv
 	return &FakeSelectorSyncIdentityProviders{c}
 }
 
+func (c *FakeHiveV1) SelectorSyncRBACs() v1.SelectorSyncRBACInterface {
+	return &FakeSelectorSyncRBACs{c}
+}
+
 func (c *FakeHiveV1) SelectorSyncSets() v1.SelectorSyncSetInterface {
 	return &FakeSelectorSyncSets{c}
 }
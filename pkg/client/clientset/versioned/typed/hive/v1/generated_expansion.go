@@ -22,6 +22,8 @@ type ClusterRelocateExpansion interface{}
 
 type ClusterStateExpansion interface{}
 
+type ClusterUpgradeRolloutExpansion interface{}
+
 type DNSZoneExpansion interface{}
 
 type HiveConfigExpansion interface{}
@@ -32,6 +34,8 @@ type MachinePoolNameLeaseExpansion interface{}
 
 type SelectorSyncIdentityProviderExpansion interface{}
 
+type SelectorSyncRBACExpansion interface{}
+
 type SelectorSyncSetExpansion interface{}
 
 type SyncIdentityProviderExpansion interface{}
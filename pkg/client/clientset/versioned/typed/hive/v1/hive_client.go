@@ -22,11 +22,13 @@ type HiveV1Interface interface {
 	ClusterProvisionsGetter
 	ClusterRelocatesGetter
 	ClusterStatesGetter
+	ClusterUpgradeRolloutsGetter
 	DNSZonesGetter
 	HiveConfigsGetter
 	MachinePoolsGetter
 	MachinePoolNameLeasesGetter
 	SelectorSyncIdentityProvidersGetter
+	SelectorSyncRBACsGetter
 	SelectorSyncSetsGetter
 	SyncIdentityProvidersGetter
 	SyncSetsGetter
@@ -77,6 +79,10 @@ func (c *HiveV1Client) ClusterStates(namespace string) ClusterStateInterface {
 	return newClusterStates(c, namespace)
 }
 
+func (c *HiveV1Client) ClusterUpgradeRollouts() ClusterUpgradeRolloutInterface {
+	return newClusterUpgradeRollouts(c)
+}
+
 func (c *HiveV1Client) DNSZones(namespace string) DNSZoneInterface {
 	return newDNSZones(c, namespace)
 }
@@ -97,6 +103,10 @@ func (c *HiveV1Client) SelectorSyncIdentityProviders() SelectorSyncIdentityProvi
 	return newSelectorSyncIdentityProviders(c)
 }
 
+func (c *HiveV1Client) SelectorSyncRBACs() SelectorSyncRBACInterface {
+	return newSelectorSyncRBACs(c)
+}
+
 func (c *HiveV1Client) SelectorSyncSets() SelectorSyncSetInterface {
 	return newSelectorSyncSets(c)
 }
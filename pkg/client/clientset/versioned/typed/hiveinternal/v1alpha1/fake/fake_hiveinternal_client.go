@@ -12,6 +12,10 @@ type FakeHiveinternalV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeHiveinternalV1alpha1) AgentImageClusterInstalls(namespace string) v1alpha1.AgentImageClusterInstallInterface {
+	return &FakeAgentImageClusterInstalls{c, namespace}
+}
+
 func (c *FakeHiveinternalV1alpha1) ClusterSyncs(namespace string) v1alpha1.ClusterSyncInterface {
 	return &FakeClusterSyncs{c, namespace}
 }
@@ -2,6 +2,8 @@
 
 package v1alpha1
 
+type AgentImageClusterInstallExpansion interface{}
+
 type ClusterSyncExpansion interface{}
 
 type ClusterSyncLeaseExpansion interface{}
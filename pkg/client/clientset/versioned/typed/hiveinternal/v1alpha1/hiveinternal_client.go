@@ -12,6 +12,7 @@ import (
 
 type HiveinternalV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	AgentImageClusterInstallsGetter
 	ClusterSyncsGetter
 	ClusterSyncLeasesGetter
 	FakeClusterInstallsGetter
@@ -22,6 +23,10 @@ type HiveinternalV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *HiveinternalV1alpha1Client) AgentImageClusterInstalls(namespace string) AgentImageClusterInstallInterface {
+	return newAgentImageClusterInstalls(c, namespace)
+}
+
 func (c *HiveinternalV1alpha1Client) ClusterSyncs(namespace string) ClusterSyncInterface {
 	return newClusterSyncs(c, namespace)
 }
@@ -58,6 +58,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Hive().V1().ClusterRelocates().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("clusterstates"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Hive().V1().ClusterStates().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("clusterupgraderollouts"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hive().V1().ClusterUpgradeRollouts().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("dnszones"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Hive().V1().DNSZones().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("hiveconfigs"):
@@ -68,6 +70,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Hive().V1().MachinePoolNameLeases().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("selectorsyncidentityproviders"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Hive().V1().SelectorSyncIdentityProviders().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("selectorsyncrbacs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hive().V1().SelectorSyncRBACs().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("selectorsyncsets"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Hive().V1().SelectorSyncSets().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("syncidentityproviders"):
@@ -76,6 +80,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Hive().V1().SyncSets().Informer()}, nil
 
 		// Group=hiveinternal.openshift.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("agentimageclusterinstalls"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hiveinternal().V1alpha1().AgentImageClusterInstalls().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("clustersyncs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Hiveinternal().V1alpha1().ClusterSyncs().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("clustersyncleases"):
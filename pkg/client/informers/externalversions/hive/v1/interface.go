@@ -28,6 +28,8 @@ type Interface interface {
 	ClusterRelocates() ClusterRelocateInformer
 	// ClusterStates returns a ClusterStateInformer.
 	ClusterStates() ClusterStateInformer
+	// ClusterUpgradeRollouts returns a ClusterUpgradeRolloutInformer.
+	ClusterUpgradeRollouts() ClusterUpgradeRolloutInformer
 	// DNSZones returns a DNSZoneInformer.
 	DNSZones() DNSZoneInformer
 	// HiveConfigs returns a HiveConfigInformer.
@@ -38,6 +40,8 @@ type Interface interface {
 	MachinePoolNameLeases() MachinePoolNameLeaseInformer
 	// SelectorSyncIdentityProviders returns a SelectorSyncIdentityProviderInformer.
 	SelectorSyncIdentityProviders() SelectorSyncIdentityProviderInformer
+	// SelectorSyncRBACs returns a SelectorSyncRBACInformer.
+	SelectorSyncRBACs() SelectorSyncRBACInformer
 	// SelectorSyncSets returns a SelectorSyncSetInformer.
 	SelectorSyncSets() SelectorSyncSetInformer
 	// SyncIdentityProviders returns a SyncIdentityProviderInformer.
@@ -107,6 +111,11 @@ func (v *version) ClusterStates() ClusterStateInformer {
 	return &clusterStateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ClusterUpgradeRollouts returns a ClusterUpgradeRolloutInformer.
+func (v *version) ClusterUpgradeRollouts() ClusterUpgradeRolloutInformer {
+	return &clusterUpgradeRolloutInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // DNSZones returns a DNSZoneInformer.
 func (v *version) DNSZones() DNSZoneInformer {
 	return &dNSZoneInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -132,6 +141,11 @@ func (v *version) SelectorSyncIdentityProviders() SelectorSyncIdentityProviderIn
 	return &selectorSyncIdentityProviderInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// SelectorSyncRBACs returns a SelectorSyncRBACInformer.
+func (v *version) SelectorSyncRBACs() SelectorSyncRBACInformer {
+	return &selectorSyncRBACInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // SelectorSyncSets returns a SelectorSyncSetInformer.
 func (v *version) SelectorSyncSets() SelectorSyncSetInformer {
 	return &selectorSyncSetInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -8,6 +8,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// AgentImageClusterInstalls returns a AgentImageClusterInstallInformer.
+	AgentImageClusterInstalls() AgentImageClusterInstallInformer
 	// ClusterSyncs returns a ClusterSyncInformer.
 	ClusterSyncs() ClusterSyncInformer
 	// ClusterSyncLeases returns a ClusterSyncLeaseInformer.
@@ -27,6 +29,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// AgentImageClusterInstalls returns a AgentImageClusterInstallInformer.
+func (v *version) AgentImageClusterInstalls() AgentImageClusterInstallInformer {
+	return &agentImageClusterInstallInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ClusterSyncs returns a ClusterSyncInformer.
 func (v *version) ClusterSyncs() ClusterSyncInformer {
 	return &clusterSyncInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
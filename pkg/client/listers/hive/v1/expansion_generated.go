@@ -74,6 +74,10 @@ type ClusterStateListerExpansion interface{}
 // ClusterStateNamespaceLister.
 type ClusterStateNamespaceListerExpansion interface{}
 
+// ClusterUpgradeRolloutListerExpansion allows custom methods to be added to
+// ClusterUpgradeRolloutLister.
+type ClusterUpgradeRolloutListerExpansion interface{}
+
 // DNSZoneListerExpansion allows custom methods to be added to
 // DNSZoneLister.
 type DNSZoneListerExpansion interface{}
@@ -106,6 +110,10 @@ type MachinePoolNameLeaseNamespaceListerExpansion interface{}
 // SelectorSyncIdentityProviderLister.
 type SelectorSyncIdentityProviderListerExpansion interface{}
 
+// SelectorSyncRBACListerExpansion allows custom methods to be added to
+// SelectorSyncRBACLister.
+type SelectorSyncRBACListerExpansion interface{}
+
 // SelectorSyncSetListerExpansion allows custom methods to be added to
 // SelectorSyncSetLister.
 type SelectorSyncSetListerExpansion interface{}
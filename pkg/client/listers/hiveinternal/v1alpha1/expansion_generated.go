@@ -2,6 +2,14 @@
 
 package v1alpha1
 
+// AgentImageClusterInstallListerExpansion allows custom methods to be added to
+// AgentImageClusterInstallLister.
+type AgentImageClusterInstallListerExpansion interface{}
+
+// AgentImageClusterInstallNamespaceListerExpansion allows custom methods to be added to
+// AgentImageClusterInstallNamespaceLister.
+type AgentImageClusterInstallNamespaceListerExpansion interface{}
+
 // ClusterSyncListerExpansion allows custom methods to be added to
 // ClusterSyncLister.
 type ClusterSyncListerExpansion interface{}
@@ -0,0 +1,589 @@
+package clusterresource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/ghodss/yaml"
+	configv1 "github.com/openshift/api/config/v1"
+	machineapi "github.com/openshift/api/machine/v1beta1"
+	autoscalingv1beta1 "github.com/openshift/cluster-autoscaler-operator/pkg/apis/autoscaling/v1beta1"
+	installertypes "github.com/openshift/installer/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hivev1aws "github.com/openshift/hive/apis/hive/v1/aws"
+	hivev1azure "github.com/openshift/hive/apis/hive/v1/azure"
+	hivev1gcp "github.com/openshift/hive/apis/hive/v1/gcp"
+	"github.com/openshift/hive/pkg/awsclient"
+	"github.com/openshift/hive/pkg/azureclient"
+	"github.com/openshift/hive/pkg/gcpclient"
+)
+
+const (
+	machineAPINamespace = "openshift-machine-api"
+
+	// hiveMachinePoolLabel is set by Hive on the MachineSets it manages, naming the MachinePool they belong to.
+	hiveMachinePoolLabel = "hive.openshift.io/machine-pool"
+
+	machineRoleLabel = "machine.openshift.io/cluster-api-machine-role"
+
+	// awsDNSZoneTag is the tag by which the DNSZone controller finds the hosted zone it manages.
+	awsDNSZoneTag = "hive.openshift.io/dnszone"
+)
+
+// AdoptedCluster describes a running OpenShift cluster in the terms Hive needs to adopt it. It is
+// populated from the cluster itself by DiscoverAdoptedCluster.
+type AdoptedCluster struct {
+	// Name is the name the cluster was installed with.
+	Name string
+
+	// BaseDomain is the DNS base domain of the cluster.
+	BaseDomain string
+
+	// InfraID is the infrastructure ID the cluster's cloud resources are named and tagged with.
+	InfraID string
+
+	// ClusterID is the cluster UUID used for telemetry.
+	ClusterID string
+
+	// Platform is the platform the cluster runs on.
+	Platform configv1.PlatformType
+
+	// Region is the cloud region the cluster runs in. Empty for platforms without regions.
+	Region string
+
+	// BaseDomainResourceGroupName is the Azure resource group holding the base domain's DNS zone.
+	BaseDomainResourceGroupName string
+
+	// PlatformMetadata holds the platform-specific cluster metadata Hive would otherwise have recorded at install
+	// time: the AWS hosted zone role, the GCP network project or the Azure resource group.
+	PlatformMetadata *hivev1.ClusterPlatformMetadata
+
+	// MachinePools reflect the cluster's compute MachineSets, such that the MachinePool controller will adopt the
+	// existing MachineSets rather than create new ones. Their ClusterDeploymentRef is left empty.
+	MachinePools []hivev1.MachinePoolSpec
+}
+
+// DiscoverAdoptedCluster reads everything Hive needs to adopt a cluster from the cluster itself, using a client
+// built from its admin kubeconfig.
+func DiscoverAdoptedCluster(c client.Client, logger log.FieldLogger) (*AdoptedCluster, error) {
+	infra := &configv1.Infrastructure{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Name: "cluster"}, infra); err != nil {
+		return nil, errors.Wrap(err, "could not get the cluster's Infrastructure")
+	}
+	if infra.Status.InfrastructureName == "" {
+		return nil, errors.New("the cluster's Infrastructure has no infrastructure name")
+	}
+	cv := &configv1.ClusterVersion{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Name: "version"}, cv); err != nil {
+		return nil, errors.Wrap(err, "could not get the cluster's ClusterVersion")
+	}
+	a := &AdoptedCluster{
+		InfraID:   infra.Status.InfrastructureName,
+		ClusterID: string(cv.Spec.ClusterID),
+		Platform:  infra.Status.Platform,
+	}
+	if infra.Status.PlatformStatus != nil {
+		a.Platform = infra.Status.PlatformStatus.Type
+	}
+	logger = logger.WithField("infraID", a.InfraID).WithField("platform", a.Platform)
+
+	ic, err := readInstallConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	if ic != nil {
+		a.Name = ic.ObjectMeta.Name
+		a.BaseDomain = ic.BaseDomain
+	} else {
+		// Fall back on the cluster domain, which is <name>.<base domain>.
+		logger.Warn("cluster has no install-config, falling back on the DNS config for its name and base domain")
+		dns := &configv1.DNS{}
+		if err := c.Get(context.TODO(), types.NamespacedName{Name: "cluster"}, dns); err != nil {
+			return nil, errors.Wrap(err, "could not get the cluster's DNS config")
+		}
+		parts := strings.SplitN(dns.Spec.BaseDomain, ".", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("could not determine the cluster name from cluster domain %q", dns.Spec.BaseDomain)
+		}
+		a.Name, a.BaseDomain = parts[0], parts[1]
+	}
+
+	platformStatus := infra.Status.PlatformStatus
+	switch a.Platform {
+	case configv1.AWSPlatformType:
+		if platformStatus != nil && platformStatus.AWS != nil {
+			a.Region = platformStatus.AWS.Region
+		}
+		if ic != nil && ic.Platform.AWS != nil {
+			a.PlatformMetadata = &hivev1.ClusterPlatformMetadata{
+				AWS: &hivev1aws.Metadata{HostedZoneRole: pointer.String(ic.Platform.AWS.HostedZoneRole)},
+			}
+		}
+	case configv1.GCPPlatformType:
+		if platformStatus != nil && platformStatus.GCP != nil {
+			a.Region = platformStatus.GCP.Region
+		}
+		if ic != nil && ic.Platform.GCP != nil {
+			a.PlatformMetadata = &hivev1.ClusterPlatformMetadata{
+				GCP: &hivev1gcp.Metadata{NetworkProjectID: pointer.String(ic.Platform.GCP.NetworkProjectID)},
+			}
+		}
+	case configv1.AzurePlatformType:
+		// The Azure platform status does not record the region.
+		if ic != nil && ic.Platform.Azure != nil {
+			a.Region = ic.Platform.Azure.Region
+			a.BaseDomainResourceGroupName = ic.Platform.Azure.BaseDomainResourceGroupName
+		}
+		if platformStatus != nil && platformStatus.Azure != nil {
+			a.PlatformMetadata = &hivev1.ClusterPlatformMetadata{
+				Azure: &hivev1azure.Metadata{ResourceGroupName: pointer.String(platformStatus.Azure.ResourceGroupName)},
+			}
+		}
+	}
+
+	if a.MachinePools, err = a.discoverMachinePools(c, logger); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// readInstallConfig returns the install-config the cluster was installed with, or nil if the cluster does not have one.
+func readInstallConfig(c client.Client) (*installertypes.InstallConfig, error) {
+	cm := &corev1.ConfigMap{}
+	switch err := c.Get(context.TODO(), types.NamespacedName{Namespace: "kube-system", Name: "cluster-config-v1"}, cm); {
+	case apierrors.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, errors.Wrap(err, "could not get the cluster's install-config")
+	}
+	data, ok := cm.Data["install-config"]
+	if !ok {
+		return nil, nil
+	}
+	ic := &installertypes.InstallConfig{}
+	if err := yaml.Unmarshal([]byte(data), ic); err != nil {
+		return nil, errors.Wrap(err, "could not parse the cluster's install-config")
+	}
+	return ic, nil
+}
+
+// discoverMachinePools groups the cluster's compute MachineSets into the MachinePools that would generate them.
+// MachineSets Hive could not have generated are skipped with a warning, as are pools whose MachineSets differ in
+// more than their zone.
+func (a *AdoptedCluster) discoverMachinePools(c client.Client, logger log.FieldLogger) ([]hivev1.MachinePoolSpec, error) {
+	switch a.Platform {
+	case configv1.AWSPlatformType, configv1.GCPPlatformType, configv1.AzurePlatformType:
+	default:
+		logger.Info("not discovering MachinePools on a platform without MachinePool support")
+		return nil, nil
+	}
+
+	machineSets := &machineapi.MachineSetList{}
+	if err := c.List(context.TODO(), machineSets, client.InNamespace(machineAPINamespace)); err != nil {
+		return nil, errors.Wrap(err, "could not list the cluster's MachineSets")
+	}
+	autoscalers := &autoscalingv1beta1.MachineAutoscalerList{}
+	if err := c.List(context.TODO(), autoscalers, client.InNamespace(machineAPINamespace)); err != nil && !meta.IsNoMatchError(err) {
+		return nil, errors.Wrap(err, "could not list the cluster's MachineAutoscalers")
+	}
+	autoscalerFor := map[string]*autoscalingv1beta1.MachineAutoscaler{}
+	for i, ma := range autoscalers.Items {
+		if ma.Spec.ScaleTargetRef.Kind == "MachineSet" {
+			autoscalerFor[ma.Spec.ScaleTargetRef.Name] = &autoscalers.Items[i]
+		}
+	}
+
+	sort.Slice(machineSets.Items, func(i, j int) bool { return machineSets.Items[i].Name < machineSets.Items[j].Name })
+	pools := map[string]*hivev1.MachinePoolSpec{}
+	var poolNames []string
+	skipped := map[string]bool{}
+	for _, ms := range machineSets.Items {
+		msLog := logger.WithField("machineSet", ms.Name)
+		if ms.Spec.Template.Labels[machineRoleLabel] == "master" {
+			continue
+		}
+		platform, zone, err := a.machinePoolPlatform(&ms)
+		if err != nil {
+			msLog.WithError(err).Warn("skipping MachineSet with unexpected provider spec")
+			continue
+		}
+		poolName, ok := a.machinePoolName(&ms, zone)
+		if !ok {
+			msLog.Warn("skipping MachineSet not named like a MachinePool's MachineSet")
+			continue
+		}
+		if skipped[poolName] {
+			continue
+		}
+
+		var replicas int32
+		if ms.Spec.Replicas != nil {
+			replicas = *ms.Spec.Replicas
+		}
+		minReplicas, maxReplicas := replicas, replicas
+		ma, autoscaled := autoscalerFor[ms.Name]
+		if autoscaled {
+			minReplicas, maxReplicas = ma.Spec.MinReplicas, ma.Spec.MaxReplicas
+		}
+		var taints []corev1.Taint
+		for _, taint := range ms.Spec.Template.Spec.Taints {
+			taints = append(taints, corev1.Taint{Key: taint.Key, Value: taint.Value, Effect: taint.Effect})
+		}
+		msPool := hivev1.MachinePoolSpec{
+			Name:     poolName,
+			Platform: platform,
+			Labels:   ms.Spec.Template.Spec.ObjectMeta.Labels,
+			Taints:   taints,
+		}
+
+		pool, ok := pools[poolName]
+		if !ok {
+			pool = msPool.DeepCopy()
+			pool.Replicas = pointer.Int64(0)
+			pools[poolName] = pool
+			poolNames = append(poolNames, poolName)
+		} else if !reflect.DeepEqual(withoutZones(pool), withoutZones(&msPool)) {
+			msLog.WithField("machinePool", poolName).Warn("skipping MachinePool whose MachineSets differ in more than their zone")
+			delete(pools, poolName)
+			skipped[poolName] = true
+			continue
+		} else {
+			addZones(pool, &msPool)
+		}
+		if autoscaled || pool.Autoscaling != nil {
+			if pool.Autoscaling == nil {
+				pool.Autoscaling = &hivev1.MachinePoolAutoscaling{
+					MinReplicas: int32(*pool.Replicas),
+					MaxReplicas: int32(*pool.Replicas),
+				}
+				pool.Replicas = nil
+			}
+			pool.Autoscaling.MinReplicas += minReplicas
+			pool.Autoscaling.MaxReplicas += maxReplicas
+		} else {
+			*pool.Replicas += int64(replicas)
+		}
+	}
+
+	var result []hivev1.MachinePoolSpec
+	for _, name := range poolNames {
+		if pool, ok := pools[name]; ok {
+			if awsPlatform := pool.Platform.AWS; awsPlatform != nil && len(awsPlatform.Subnets) != len(awsPlatform.Zones) {
+				// Only some MachineSets pin a subnet; let the MachinePool controller look them all up by tag.
+				awsPlatform.Subnets = nil
+			}
+			result = append(result, *pool)
+		}
+	}
+	return result, nil
+}
+
+// machinePoolPlatform returns the MachinePool platform that would generate the given MachineSet, with a single
+// zone, along with that zone.
+func (a *AdoptedCluster) machinePoolPlatform(ms *machineapi.MachineSet) (hivev1.MachinePoolPlatform, string, error) {
+	var platform hivev1.MachinePoolPlatform
+	providerSpec := ms.Spec.Template.Spec.ProviderSpec.Value
+	if providerSpec == nil {
+		return platform, "", errors.New("MachineSet has no provider spec")
+	}
+	switch a.Platform {
+	case configv1.AWSPlatformType:
+		spec := &machineapi.AWSMachineProviderConfig{}
+		if err := json.Unmarshal(providerSpec.Raw, spec); err != nil {
+			return platform, "", err
+		}
+		zone := spec.Placement.AvailabilityZone
+		p := &hivev1aws.MachinePoolPlatform{
+			Zones:        []string{zone},
+			InstanceType: spec.InstanceType,
+		}
+		for _, bd := range spec.BlockDevices {
+			// The root volume is the one without a device name.
+			if bd.DeviceName != nil || bd.EBS == nil {
+				continue
+			}
+			p.EC2RootVolume = hivev1aws.EC2RootVolume{
+				IOPS:      int(pointer.Int64Deref(bd.EBS.Iops, 0)),
+				Size:      int(pointer.Int64Deref(bd.EBS.VolumeSize, 0)),
+				Type:      pointer.StringDeref(bd.EBS.VolumeType, ""),
+				KMSKeyARN: pointer.StringDeref(bd.EBS.KMSKey.ARN, ""),
+			}
+		}
+		if spec.Subnet.ID != nil {
+			p.Subnets = []string{*spec.Subnet.ID}
+		}
+		if spec.SpotMarketOptions != nil {
+			p.SpotMarketOptions = &hivev1aws.SpotMarketOptions{MaxPrice: spec.SpotMarketOptions.MaxPrice}
+		}
+		if auth := spec.MetadataServiceOptions.Authentication; auth != "" {
+			p.EC2Metadata = &hivev1aws.EC2Metadata{Authentication: string(auth)}
+		}
+		platform.AWS = p
+		return platform, zone, nil
+	case configv1.GCPPlatformType:
+		spec := &machineapi.GCPMachineProviderSpec{}
+		if err := json.Unmarshal(providerSpec.Raw, spec); err != nil {
+			return platform, "", err
+		}
+		p := &hivev1gcp.MachinePool{
+			Zones:        []string{spec.Zone},
+			InstanceType: spec.MachineType,
+		}
+		for _, disk := range spec.Disks {
+			if disk != nil && disk.Boot {
+				p.OSDisk = hivev1gcp.OSDisk{DiskType: disk.Type, DiskSizeGB: disk.SizeGB}
+			}
+		}
+		if a.PlatformMetadata != nil && a.PlatformMetadata.GCP != nil {
+			p.NetworkProjectID = pointer.StringDeref(a.PlatformMetadata.GCP.NetworkProjectID, "")
+		}
+		platform.GCP = p
+		return platform, spec.Zone, nil
+	case configv1.AzurePlatformType:
+		spec := &machineapi.AzureMachineProviderSpec{}
+		if err := json.Unmarshal(providerSpec.Raw, spec); err != nil {
+			return platform, "", err
+		}
+		zone := pointer.StringDeref(spec.Zone, "")
+		p := &hivev1azure.MachinePool{
+			InstanceType: spec.VMSize,
+			OSDisk: hivev1azure.OSDisk{
+				DiskSizeGB: spec.OSDisk.DiskSizeGB,
+				DiskType:   spec.OSDisk.ManagedDisk.StorageAccountType,
+			},
+		}
+		if zone != "" {
+			p.Zones = []string{zone}
+		}
+		platform.Azure = p
+		return platform, zone, nil
+	}
+	return platform, "", fmt.Errorf("unsupported platform %s", a.Platform)
+}
+
+// machinePoolName returns the name of the MachinePool the given MachineSet belongs to: the name Hive labelled it
+// with, or else the pool name embedded in the name the installer gave it.
+func (a *AdoptedCluster) machinePoolName(ms *machineapi.MachineSet, zone string) (string, bool) {
+	if name := ms.Labels[hiveMachinePoolLabel]; name != "" {
+		return name, true
+	}
+	var suffix string
+	switch a.Platform {
+	case configv1.AWSPlatformType:
+		suffix = "-" + zone
+	case configv1.GCPPlatformType:
+		suffix = "-" + strings.TrimPrefix(zone, a.Region+"-")
+	case configv1.AzurePlatformType:
+		suffix = "-" + a.Region + zone
+	}
+	prefix := a.InfraID + "-"
+	if !strings.HasPrefix(ms.Name, prefix) || !strings.HasSuffix(ms.Name, suffix) || len(ms.Name) <= len(prefix)+len(suffix) {
+		return "", false
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(ms.Name, prefix), suffix), true
+}
+
+func withoutZones(pool *hivev1.MachinePoolSpec) *hivev1.MachinePoolSpec {
+	p := pool.DeepCopy()
+	p.Replicas, p.Autoscaling = nil, nil
+	switch {
+	case p.Platform.AWS != nil:
+		p.Platform.AWS.Zones, p.Platform.AWS.Subnets = nil, nil
+	case p.Platform.GCP != nil:
+		p.Platform.GCP.Zones = nil
+	case p.Platform.Azure != nil:
+		p.Platform.Azure.Zones = nil
+	}
+	return p
+}
+
+func addZones(pool, from *hivev1.MachinePoolSpec) {
+	switch {
+	case pool.Platform.AWS != nil:
+		pool.Platform.AWS.Zones = append(pool.Platform.AWS.Zones, from.Platform.AWS.Zones...)
+		pool.Platform.AWS.Subnets = append(pool.Platform.AWS.Subnets, from.Platform.AWS.Subnets...)
+	case pool.Platform.GCP != nil:
+		pool.Platform.GCP.Zones = append(pool.Platform.GCP.Zones, from.Platform.GCP.Zones...)
+	case pool.Platform.Azure != nil:
+		pool.Platform.Azure.Zones = append(pool.Platform.Azure.Zones, from.Platform.Azure.Zones...)
+	}
+}
+
+// ValidateAWSCredentials checks that the given client can see the adopted cluster's instances and is permitted to
+// terminate them, i.e. that its credentials are for the account the cluster runs in and can deprovision it.
+func (a *AdoptedCluster) ValidateAWSCredentials(c awsclient.Client) error {
+	out, err := c.DescribeInstances(&ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{{
+			Name:   aws.String(fmt.Sprintf("tag:kubernetes.io/cluster/%s", a.InfraID)),
+			Values: []*string{aws.String("owned")},
+		}},
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not list the cluster's instances")
+	}
+	var instanceIDs []*string
+	for _, r := range out.Reservations {
+		for _, i := range r.Instances {
+			instanceIDs = append(instanceIDs, i.InstanceId)
+		}
+	}
+	if len(instanceIDs) == 0 {
+		return fmt.Errorf("no instances owned by %s are visible with these credentials", a.InfraID)
+	}
+	_, err = c.TerminateInstances(&ec2.TerminateInstancesInput{
+		DryRun:      aws.Bool(true),
+		InstanceIds: instanceIDs[:1],
+	})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "DryRunOperation" {
+		return nil
+	}
+	return errors.Wrap(err, "credentials are not permitted to terminate the cluster's instances")
+}
+
+// ValidateGCPCredentials checks that the given client can see the adopted cluster's instances, i.e. that its
+// credentials are for the project the cluster runs in.
+func (a *AdoptedCluster) ValidateGCPCredentials(c gcpclient.Client) error {
+	found := false
+	err := c.ListComputeInstances(gcpclient.ListComputeInstancesOptions{
+		Filter: fmt.Sprintf("name eq \"%s-.*\"", a.InfraID),
+		Fields: "items/*/instances(name),nextPageToken",
+	}, func(list *compute.InstanceAggregatedList) error {
+		for _, scopedList := range list.Items {
+			if len(scopedList.Instances) > 0 {
+				found = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not list the cluster's instances")
+	}
+	if !found {
+		return fmt.Errorf("no instances of %s are visible with these credentials", a.InfraID)
+	}
+	return nil
+}
+
+// ValidateAzureCredentials checks that the given client can see the virtual machines in the adopted cluster's
+// resource group, i.e. that its credentials are for the subscription the cluster runs in.
+func (a *AdoptedCluster) ValidateAzureCredentials(c azureclient.Client) error {
+	if a.PlatformMetadata == nil || a.PlatformMetadata.Azure == nil {
+		return errors.New("cluster resource group is unknown")
+	}
+	resourceGroup := pointer.StringDeref(a.PlatformMetadata.Azure.ResourceGroupName, "")
+	page, err := c.ListAllVirtualMachines(context.TODO(), "false")
+	if err != nil {
+		return errors.Wrap(err, "could not list virtual machines")
+	}
+	for page.NotDone() {
+		for _, vm := range page.Values() {
+			resource, err := azure.ParseResourceID(to.String(vm.ID))
+			if err == nil && strings.EqualFold(resource.ResourceGroup, resourceGroup) {
+				return nil
+			}
+		}
+		if err := page.Next(); err != nil {
+			return errors.Wrap(err, "could not list virtual machines")
+		}
+	}
+	return fmt.Errorf("no virtual machines in resource group %s are visible with these credentials", resourceGroup)
+}
+
+// InManagedDomain reports whether the adopted cluster's base domain is a direct child of one of the given managed
+// domains configured for its platform, as is required of ClusterDeployments with ManageDNS.
+func (a *AdoptedCluster) InManagedDomain(managedDomains []hivev1.ManageDNSConfig) bool {
+	for _, md := range managedDomains {
+		switch {
+		case a.Platform == configv1.AWSPlatformType && md.AWS != nil:
+		case a.Platform == configv1.GCPPlatformType && md.GCP != nil:
+		case a.Platform == configv1.AzurePlatformType && md.Azure != nil:
+		default:
+			continue
+		}
+		for _, domain := range md.Domains {
+			child := strings.TrimSuffix(a.BaseDomain, "."+domain)
+			if child != a.BaseDomain && !strings.Contains(child, ".") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AWSDNSZoneAdoptable reports whether the public hosted zone for the adopted cluster's base domain carries the tag
+// by which the DNSZone controller would find it for the named DNSZone. Otherwise the DNSZone controller would
+// create a new, empty zone and delegate the base domain to it.
+func (a *AdoptedCluster) AWSDNSZoneAdoptable(c awsclient.Client, dnsZoneNamespace, dnsZoneName string) (bool, error) {
+	zoneName := a.BaseDomain + "."
+	out, err := c.ListHostedZonesByName(&route53.ListHostedZonesByNameInput{DNSName: aws.String(zoneName)})
+	if err != nil {
+		return false, errors.Wrap(err, "could not list hosted zones")
+	}
+	for _, zone := range out.HostedZones {
+		if aws.StringValue(zone.Name) != zoneName || (zone.Config != nil && aws.BoolValue(zone.Config.PrivateZone)) {
+			continue
+		}
+		tags, err := c.ListTagsForResource(&route53.ListTagsForResourceInput{
+			ResourceId:   aws.String(strings.TrimPrefix(aws.StringValue(zone.Id), "/hostedzone/")),
+			ResourceType: aws.String("hostedzone"),
+		})
+		if err != nil {
+			return false, errors.Wrap(err, "could not list hosted zone tags")
+		}
+		for _, tag := range tags.ResourceTagSet.Tags {
+			if aws.StringValue(tag.Key) == awsDNSZoneTag && aws.StringValue(tag.Value) == dnsZoneNamespace+"/"+dnsZoneName {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// GCPDNSZoneAdoptable reports whether the adopted cluster's base domain is served by a managed zone named the way
+// the DNSZone controller names the zones it creates, so that it would take that zone over.
+func (a *AdoptedCluster) GCPDNSZoneAdoptable(c gcpclient.Client) (bool, error) {
+	zone, err := c.ListManagedZones(gcpclient.ListManagedZonesOptions{DNSName: a.BaseDomain + "."})
+	if err != nil {
+		return false, errors.Wrap(err, "could not list managed zones")
+	}
+	for _, z := range zone.ManagedZones {
+		if z.Name == gcpclient.ManagedZoneName(a.BaseDomain) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// AzureDNSZoneAdoptable reports whether the adopted cluster's base domain zone is in its base domain resource group,
+// where the DNSZone controller looks for it by name.
+func (a *AdoptedCluster) AzureDNSZoneAdoptable(c azureclient.Client) (bool, error) {
+	zone, err := c.GetZone(context.TODO(), a.BaseDomainResourceGroupName, a.BaseDomain)
+	if err != nil {
+		if zone.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "could not get the base domain zone")
+	}
+	return true, nil
+}
@@ -0,0 +1,418 @@
+package clusterresource
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	configv1 "github.com/openshift/api/config/v1"
+	machineapi "github.com/openshift/api/machine/v1beta1"
+	autoscalingv1beta1 "github.com/openshift/cluster-autoscaler-operator/pkg/apis/autoscaling/v1beta1"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hivev1aws "github.com/openshift/hive/apis/hive/v1/aws"
+	hivev1azure "github.com/openshift/hive/apis/hive/v1/azure"
+	hivev1gcp "github.com/openshift/hive/apis/hive/v1/gcp"
+	mockaws "github.com/openshift/hive/pkg/awsclient/mock"
+	testfake "github.com/openshift/hive/pkg/test/fake"
+)
+
+const (
+	adoptedInfraID          = "mycluster-x7k2p"
+	adoptedAWSInstallConfig = `apiVersion: v1
+baseDomain: example.com
+metadata:
+  name: mycluster
+platform:
+  aws:
+    region: us-east-1
+    hostedZoneRole: arn:aws:iam::123456789012:role/dns
+`
+)
+
+func TestDiscoverAdoptedCluster(t *testing.T) {
+	tests := []struct {
+		name                 string
+		platformStatus       *configv1.PlatformStatus
+		installConfig        string
+		existing             []runtime.Object
+		expectedName         string
+		expectedBaseDomain   string
+		expectedRegion       string
+		expectedMetadata     *hivev1.ClusterPlatformMetadata
+		expectedMachinePools []hivev1.MachinePoolSpec
+	}{
+		{
+			name:           "aws",
+			platformStatus: awsPlatformStatus(),
+			installConfig:  adoptedAWSInstallConfig,
+			existing: []runtime.Object{
+				testAWSMachineSet(adoptedInfraID+"-worker-us-east-1a", "us-east-1a", "m5.xlarge", 2),
+				testAWSMachineSet(adoptedInfraID+"-worker-us-east-1b", "us-east-1b", "m5.xlarge", 1),
+			},
+			expectedName:       "mycluster",
+			expectedBaseDomain: "example.com",
+			expectedRegion:     "us-east-1",
+			expectedMetadata: &hivev1.ClusterPlatformMetadata{
+				AWS: &hivev1aws.Metadata{HostedZoneRole: pointer.String("arn:aws:iam::123456789012:role/dns")},
+			},
+			expectedMachinePools: []hivev1.MachinePoolSpec{{
+				Name:     "worker",
+				Replicas: pointer.Int64(3),
+				Platform: hivev1.MachinePoolPlatform{AWS: &hivev1aws.MachinePoolPlatform{
+					Zones:         []string{"us-east-1a", "us-east-1b"},
+					InstanceType:  "m5.xlarge",
+					EC2RootVolume: hivev1aws.EC2RootVolume{Size: 120, Type: "gp3"},
+				}},
+			}},
+		},
+		{
+			name:           "autoscaled pool",
+			platformStatus: awsPlatformStatus(),
+			installConfig:  adoptedAWSInstallConfig,
+			existing: []runtime.Object{
+				testAWSMachineSet(adoptedInfraID+"-worker-us-east-1a", "us-east-1a", "m5.xlarge", 2),
+				testAWSMachineSet(adoptedInfraID+"-worker-us-east-1b", "us-east-1b", "m5.xlarge", 1),
+				testMachineAutoscaler(adoptedInfraID+"-worker-us-east-1a", 1, 4),
+			},
+			expectedName:       "mycluster",
+			expectedBaseDomain: "example.com",
+			expectedRegion:     "us-east-1",
+			expectedMetadata: &hivev1.ClusterPlatformMetadata{
+				AWS: &hivev1aws.Metadata{HostedZoneRole: pointer.String("arn:aws:iam::123456789012:role/dns")},
+			},
+			expectedMachinePools: []hivev1.MachinePoolSpec{{
+				Name:        "worker",
+				Autoscaling: &hivev1.MachinePoolAutoscaling{MinReplicas: 2, MaxReplicas: 5},
+				Platform: hivev1.MachinePoolPlatform{AWS: &hivev1aws.MachinePoolPlatform{
+					Zones:         []string{"us-east-1a", "us-east-1b"},
+					InstanceType:  "m5.xlarge",
+					EC2RootVolume: hivev1aws.EC2RootVolume{Size: 120, Type: "gp3"},
+				}},
+			}},
+		},
+		{
+			name:           "pools named by hive label and machine set name",
+			platformStatus: awsPlatformStatus(),
+			installConfig:  adoptedAWSInstallConfig,
+			existing: []runtime.Object{
+				func() runtime.Object {
+					ms := testAWSMachineSet("renamed", "us-east-1a", "m5.2xlarge", 1)
+					ms.Labels = map[string]string{hiveMachinePoolLabel: "infra"}
+					return ms
+				}(),
+				testAWSMachineSet(adoptedInfraID+"-worker-us-east-1a", "us-east-1a", "m5.xlarge", 3),
+				func() runtime.Object {
+					ms := testAWSMachineSet(adoptedInfraID+"-master-us-east-1a", "us-east-1a", "m5.xlarge", 3)
+					ms.Spec.Template.Labels = map[string]string{machineRoleLabel: "master"}
+					return ms
+				}(),
+				testAWSMachineSet("custom-machineset", "us-east-1a", "m5.xlarge", 1),
+			},
+			expectedName:       "mycluster",
+			expectedBaseDomain: "example.com",
+			expectedRegion:     "us-east-1",
+			expectedMetadata: &hivev1.ClusterPlatformMetadata{
+				AWS: &hivev1aws.Metadata{HostedZoneRole: pointer.String("arn:aws:iam::123456789012:role/dns")},
+			},
+			expectedMachinePools: []hivev1.MachinePoolSpec{
+				{
+					Name:     "worker",
+					Replicas: pointer.Int64(3),
+					Platform: hivev1.MachinePoolPlatform{AWS: &hivev1aws.MachinePoolPlatform{
+						Zones:         []string{"us-east-1a"},
+						InstanceType:  "m5.xlarge",
+						EC2RootVolume: hivev1aws.EC2RootVolume{Size: 120, Type: "gp3"},
+					}},
+				},
+				{
+					Name:     "infra",
+					Replicas: pointer.Int64(1),
+					Platform: hivev1.MachinePoolPlatform{AWS: &hivev1aws.MachinePoolPlatform{
+						Zones:         []string{"us-east-1a"},
+						InstanceType:  "m5.2xlarge",
+						EC2RootVolume: hivev1aws.EC2RootVolume{Size: 120, Type: "gp3"},
+					}},
+				},
+			},
+		},
+		{
+			name:           "pool with differing machine sets",
+			platformStatus: awsPlatformStatus(),
+			installConfig:  adoptedAWSInstallConfig,
+			existing: []runtime.Object{
+				testAWSMachineSet(adoptedInfraID+"-worker-us-east-1a", "us-east-1a", "m5.xlarge", 2),
+				testAWSMachineSet(adoptedInfraID+"-worker-us-east-1b", "us-east-1b", "m5.2xlarge", 1),
+			},
+			expectedName:       "mycluster",
+			expectedBaseDomain: "example.com",
+			expectedRegion:     "us-east-1",
+			expectedMetadata: &hivev1.ClusterPlatformMetadata{
+				AWS: &hivev1aws.Metadata{HostedZoneRole: pointer.String("arn:aws:iam::123456789012:role/dns")},
+			},
+		},
+		{
+			name:           "no install-config",
+			platformStatus: awsPlatformStatus(),
+			existing: []runtime.Object{
+				&configv1.DNS{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+					Spec:       configv1.DNSSpec{BaseDomain: "mycluster.example.com"},
+				},
+			},
+			expectedName:       "mycluster",
+			expectedBaseDomain: "example.com",
+			expectedRegion:     "us-east-1",
+		},
+		{
+			name: "gcp",
+			platformStatus: &configv1.PlatformStatus{
+				Type: configv1.GCPPlatformType,
+				GCP:  &configv1.GCPPlatformStatus{ProjectID: "my-project", Region: "us-east1"},
+			},
+			installConfig: `apiVersion: v1
+baseDomain: example.com
+metadata:
+  name: mycluster
+platform:
+  gcp:
+    projectID: my-project
+    region: us-east1
+    networkProjectID: host-project
+`,
+			existing: []runtime.Object{
+				testMachineSet(adoptedInfraID+"-worker-b", &machineapi.GCPMachineProviderSpec{
+					MachineType: "n1-standard-4",
+					Zone:        "us-east1-b",
+					Disks:       []*machineapi.GCPDisk{{Boot: true, SizeGB: 128, Type: "pd-ssd"}},
+				}, 2),
+			},
+			expectedName:       "mycluster",
+			expectedBaseDomain: "example.com",
+			expectedRegion:     "us-east1",
+			expectedMetadata: &hivev1.ClusterPlatformMetadata{
+				GCP: &hivev1gcp.Metadata{NetworkProjectID: pointer.String("host-project")},
+			},
+			expectedMachinePools: []hivev1.MachinePoolSpec{{
+				Name:     "worker",
+				Replicas: pointer.Int64(2),
+				Platform: hivev1.MachinePoolPlatform{GCP: &hivev1gcp.MachinePool{
+					Zones:            []string{"us-east1-b"},
+					InstanceType:     "n1-standard-4",
+					OSDisk:           hivev1gcp.OSDisk{DiskType: "pd-ssd", DiskSizeGB: 128},
+					NetworkProjectID: "host-project",
+				}},
+			}},
+		},
+		{
+			name: "azure",
+			platformStatus: &configv1.PlatformStatus{
+				Type:  configv1.AzurePlatformType,
+				Azure: &configv1.AzurePlatformStatus{ResourceGroupName: "mycluster-x7k2p-rg"},
+			},
+			installConfig: `apiVersion: v1
+baseDomain: example.com
+metadata:
+  name: mycluster
+platform:
+  azure:
+    region: centralus
+    baseDomainResourceGroupName: os4-common
+`,
+			existing: []runtime.Object{
+				testMachineSet(adoptedInfraID+"-worker-centralus1", &machineapi.AzureMachineProviderSpec{
+					VMSize: "Standard_D4s_v3",
+					Zone:   pointer.String("1"),
+					OSDisk: machineapi.OSDisk{
+						DiskSizeGB:  128,
+						ManagedDisk: machineapi.OSDiskManagedDiskParameters{StorageAccountType: "Premium_LRS"},
+					},
+				}, 1),
+			},
+			expectedName:       "mycluster",
+			expectedBaseDomain: "example.com",
+			expectedRegion:     "centralus",
+			expectedMetadata: &hivev1.ClusterPlatformMetadata{
+				Azure: &hivev1azure.Metadata{ResourceGroupName: pointer.String("mycluster-x7k2p-rg")},
+			},
+			expectedMachinePools: []hivev1.MachinePoolSpec{{
+				Name:     "worker",
+				Replicas: pointer.Int64(1),
+				Platform: hivev1.MachinePoolPlatform{Azure: &hivev1azure.MachinePool{
+					Zones:        []string{"1"},
+					InstanceType: "Standard_D4s_v3",
+					OSDisk:       hivev1azure.OSDisk{DiskSizeGB: 128, DiskType: "Premium_LRS"},
+				}},
+			}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			existing := append([]runtime.Object{
+				&configv1.Infrastructure{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+					Status: configv1.InfrastructureStatus{
+						InfrastructureName: adoptedInfraID,
+						PlatformStatus:     test.platformStatus,
+					},
+				},
+				&configv1.ClusterVersion{
+					ObjectMeta: metav1.ObjectMeta{Name: "version"},
+					Spec:       configv1.ClusterVersionSpec{ClusterID: "0e6f3b8c-5a0d-4c83-9c56-2b6e1a3f6f6e"},
+				},
+			}, test.existing...)
+			if test.installConfig != "" {
+				existing = append(existing, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "cluster-config-v1"},
+					Data:       map[string]string{"install-config": test.installConfig},
+				})
+			}
+			c := testfake.NewFakeClientBuilder().WithRuntimeObjects(existing...).Build()
+
+			adopted, err := DiscoverAdoptedCluster(c, log.WithField("test", test.name))
+			require.NoError(t, err, "unexpected error discovering cluster")
+
+			assert.Equal(t, adoptedInfraID, adopted.InfraID, "unexpected infra ID")
+			assert.Equal(t, "0e6f3b8c-5a0d-4c83-9c56-2b6e1a3f6f6e", adopted.ClusterID, "unexpected cluster ID")
+			assert.Equal(t, test.platformStatus.Type, adopted.Platform, "unexpected platform")
+			assert.Equal(t, test.expectedName, adopted.Name, "unexpected name")
+			assert.Equal(t, test.expectedBaseDomain, adopted.BaseDomain, "unexpected base domain")
+			assert.Equal(t, test.expectedRegion, adopted.Region, "unexpected region")
+			assert.Equal(t, test.expectedMetadata, adopted.PlatformMetadata, "unexpected platform metadata")
+			assert.Equal(t, test.expectedMachinePools, adopted.MachinePools, "unexpected machine pools")
+		})
+	}
+}
+
+func TestValidateAWSCredentials(t *testing.T) {
+	tests := []struct {
+		name          string
+		instances     []*ec2.Instance
+		terminateErr  error
+		expectedError bool
+	}{
+		{
+			name:         "permitted",
+			instances:    []*ec2.Instance{{InstanceId: aws.String("i-1")}},
+			terminateErr: awserr.New("DryRunOperation", "Request would have succeeded", nil),
+		},
+		{
+			name:          "not permitted",
+			instances:     []*ec2.Instance{{InstanceId: aws.String("i-1")}},
+			terminateErr:  awserr.New("UnauthorizedOperation", "You are not authorized", nil),
+			expectedError: true,
+		},
+		{
+			name:          "no instances",
+			expectedError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockAWSClient := mockaws.NewMockClient(mockCtrl)
+			mockAWSClient.EXPECT().DescribeInstances(gomock.Any()).Return(&ec2.DescribeInstancesOutput{
+				Reservations: []*ec2.Reservation{{Instances: test.instances}},
+			}, nil)
+			if len(test.instances) > 0 {
+				mockAWSClient.EXPECT().TerminateInstances(gomock.Any()).DoAndReturn(func(input *ec2.TerminateInstancesInput) (*ec2.TerminateInstancesOutput, error) {
+					assert.True(t, aws.BoolValue(input.DryRun), "expected dry run")
+					return nil, test.terminateErr
+				})
+			}
+
+			adopted := &AdoptedCluster{InfraID: adoptedInfraID, Platform: configv1.AWSPlatformType}
+			err := adopted.ValidateAWSCredentials(mockAWSClient)
+			if test.expectedError {
+				assert.Error(t, err, "expected error")
+			} else {
+				assert.NoError(t, err, "unexpected error")
+			}
+		})
+	}
+}
+
+func TestInManagedDomain(t *testing.T) {
+	managedDomains := []hivev1.ManageDNSConfig{{
+		Domains: []string{"hive.example.com"},
+		AWS:     &hivev1.ManageDNSAWSConfig{},
+	}}
+	tests := []struct {
+		name       string
+		platform   configv1.PlatformType
+		baseDomain string
+		expected   bool
+	}{
+		{name: "child of managed domain", platform: configv1.AWSPlatformType, baseDomain: "mycluster.hive.example.com", expected: true},
+		{name: "managed domain itself", platform: configv1.AWSPlatformType, baseDomain: "hive.example.com"},
+		{name: "grandchild of managed domain", platform: configv1.AWSPlatformType, baseDomain: "a.mycluster.hive.example.com"},
+		{name: "other platform", platform: configv1.GCPPlatformType, baseDomain: "mycluster.hive.example.com"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			adopted := &AdoptedCluster{Platform: test.platform, BaseDomain: test.baseDomain}
+			assert.Equal(t, test.expected, adopted.InManagedDomain(managedDomains))
+		})
+	}
+}
+
+func awsPlatformStatus() *configv1.PlatformStatus {
+	return &configv1.PlatformStatus{
+		Type: configv1.AWSPlatformType,
+		AWS:  &configv1.AWSPlatformStatus{Region: "us-east-1"},
+	}
+}
+
+func testAWSMachineSet(name, zone, instanceType string, replicas int32) *machineapi.MachineSet {
+	return testMachineSet(name, &machineapi.AWSMachineProviderConfig{
+		InstanceType: instanceType,
+		Placement:    machineapi.Placement{Region: "us-east-1", AvailabilityZone: zone},
+		BlockDevices: []machineapi.BlockDeviceMappingSpec{{
+			EBS: &machineapi.EBSBlockDeviceSpec{VolumeSize: pointer.Int64(120), VolumeType: pointer.String("gp3")},
+		}},
+	}, replicas)
+}
+
+func testMachineSet(name string, providerSpec interface{}, replicas int32) *machineapi.MachineSet {
+	raw, err := json.Marshal(providerSpec)
+	if err != nil {
+		panic(err)
+	}
+	return &machineapi.MachineSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: machineAPINamespace, Name: name},
+		Spec: machineapi.MachineSetSpec{
+			Replicas: pointer.Int32(replicas),
+			Template: machineapi.MachineTemplateSpec{
+				Spec: machineapi.MachineSpec{
+					ProviderSpec: machineapi.ProviderSpec{Value: &runtime.RawExtension{Raw: raw}},
+				},
+			},
+		},
+	}
+}
+
+func testMachineAutoscaler(machineSetName string, minReplicas, maxReplicas int32) *autoscalingv1beta1.MachineAutoscaler {
+	return &autoscalingv1beta1.MachineAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Namespace: machineAPINamespace, Name: machineSetName},
+		Spec: autoscalingv1beta1.MachineAutoscalerSpec{
+			MinReplicas: minReplicas,
+			MaxReplicas: maxReplicas,
+			ScaleTargetRef: autoscalingv1beta1.CrossVersionObjectReference{
+				APIVersion: machineapi.SchemeGroupVersion.String(),
+				Kind:       "MachineSet",
+				Name:       machineSetName,
+			},
+		},
+	}
+}
@@ -78,6 +78,9 @@ type Builder struct {
 	// InstallAttemptsLimit is the maximum number of times Hive will attempt to install the cluster.
 	InstallAttemptsLimit *int32
 
+	// PostInstallValidation configures checks of the installed cluster that must pass before it is provisioned.
+	PostInstallValidation *hivev1.PostInstallValidation
+
 	// ServingCert is the contents of a serving certificate to be used for the cluster.
 	ServingCert string
 
@@ -111,6 +114,14 @@ type Builder struct {
 	// after openshift-install create-cluster. This field is optional when adopting.
 	AdoptAdminPassword string
 
+	// AdoptPlatformMetadata is the platform-specific metadata for an adopted cluster, such as the AWS hosted zone
+	// role. This field is optional when adopting.
+	AdoptPlatformMetadata *hivev1.ClusterPlatformMetadata
+
+	// AdoptMachinePools are MachinePools reflecting the existing MachineSets of an adopted cluster. When set, they
+	// are generated instead of the default worker pool. This field is optional when adopting.
+	AdoptMachinePools []hivev1.MachinePoolSpec
+
 	// FeatureSet defines the featureSet for the install-config.
 	FeatureSet string
 
@@ -200,8 +211,14 @@ func (o *Builder) Build() ([]runtime.Object, error) {
 	var allObjects []runtime.Object
 	allObjects = append(allObjects, o.generateClusterDeployment())
 
-	if mp := o.generateMachinePool(); mp != nil && !o.SkipMachinePools {
-		allObjects = append(allObjects, o.generateMachinePool())
+	if !o.SkipMachinePools {
+		if o.Adopt && len(o.AdoptMachinePools) > 0 {
+			for _, spec := range o.AdoptMachinePools {
+				allObjects = append(allObjects, o.generateAdoptedMachinePool(spec))
+			}
+		} else if mp := o.generateMachinePool(); mp != nil {
+			allObjects = append(allObjects, mp)
+		}
 	}
 
 	if o.InstallConfigTemplate != "" {
@@ -333,6 +350,7 @@ func (o *Builder) generateClusterDeployment() *hivev1.ClusterDeployment {
 			ClusterID:                o.AdoptClusterID,
 			InfraID:                  o.AdoptInfraID,
 			AdminKubeconfigSecretRef: corev1.LocalObjectReference{Name: o.getAdoptAdminKubeconfigSecretName()},
+			Platform:                 o.AdoptPlatformMetadata,
 		}
 		cd.Spec.Installed = true
 		if o.AdoptAdminUsername != "" {
@@ -361,6 +379,7 @@ func (o *Builder) generateClusterDeployment() *hivev1.ClusterDeployment {
 	}
 
 	cd.Spec.Provisioning.InstallConfigSecretRef = &corev1.LocalObjectReference{Name: o.getInstallConfigSecretName()}
+	cd.Spec.Provisioning.PostInstallValidation = o.PostInstallValidation
 	cd.Spec.Platform = o.CloudBuilder.GetCloudPlatform(o)
 
 	return cd
@@ -526,6 +545,22 @@ func (o *Builder) generateMachinePool() *hivev1.MachinePool {
 	return mp
 }
 
+func (o *Builder) generateAdoptedMachinePool(spec hivev1.MachinePoolSpec) *hivev1.MachinePool {
+	mp := &hivev1.MachinePool{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "MachinePool",
+			APIVersion: hivev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", o.Name, spec.Name),
+			Namespace: o.Namespace,
+		},
+		Spec: *spec.DeepCopy(),
+	}
+	mp.Spec.ClusterDeploymentRef = corev1.LocalObjectReference{Name: o.Name}
+	return mp
+}
+
 func (o *Builder) getInstallConfigSecretName() string {
 	return fmt.Sprintf("%s-install-config", o.Name)
 }
@@ -7,11 +7,13 @@ import (
 
 	"github.com/ghodss/yaml"
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hivev1aws "github.com/openshift/hive/apis/hive/v1/aws"
 	hivev1azure "github.com/openshift/hive/apis/hive/v1/azure"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
 )
 
 const (
@@ -198,6 +200,49 @@ func TestBuildClusterResources(t *testing.T) {
 				assert.Equal(t, adminKubeconfig.Name, cd.Spec.ClusterMetadata.AdminKubeconfigSecretRef.Name)
 			},
 		},
+		{
+			name: "adopt AWS cluster with discovered metadata and machine pools",
+			builder: func() *Builder {
+				awsBuilder := createAWSClusterBuilder()
+				awsBuilder.Adopt = true
+				awsBuilder.AdoptInfraID = adoptInfraID
+				awsBuilder.AdoptClusterID = adoptClusterID
+				awsBuilder.AdoptAdminKubeconfig = []byte(adoptAdminKubeconfig)
+				awsBuilder.AdoptPlatformMetadata = &hivev1.ClusterPlatformMetadata{
+					AWS: &hivev1aws.Metadata{HostedZoneRole: pointer.String("hosted-zone-role")},
+				}
+				awsBuilder.AdoptMachinePools = []hivev1.MachinePoolSpec{
+					{
+						Name:     "worker",
+						Replicas: pointer.Int64(3),
+						Platform: hivev1.MachinePoolPlatform{AWS: &hivev1aws.MachinePoolPlatform{InstanceType: "m6i.xlarge"}},
+					},
+					{
+						Name:     "infra",
+						Replicas: pointer.Int64(2),
+						Platform: hivev1.MachinePoolPlatform{AWS: &hivev1aws.MachinePoolPlatform{InstanceType: "m6i.2xlarge"}},
+					},
+				}
+				return awsBuilder
+			}(),
+			validate: func(t *testing.T, allObjects []runtime.Object) {
+				cd := findClusterDeployment(allObjects, clusterName)
+				require.NotNil(t, cd.Spec.ClusterMetadata.Platform)
+				require.NotNil(t, cd.Spec.ClusterMetadata.Platform.AWS)
+				assert.Equal(t, "hosted-zone-role", *cd.Spec.ClusterMetadata.Platform.AWS.HostedZoneRole)
+
+				workerPool := findMachinePool(allObjects, fmt.Sprintf("%s-%s", clusterName, "worker"))
+				require.NotNil(t, workerPool)
+				assert.Equal(t, clusterName, workerPool.Spec.ClusterDeploymentRef.Name)
+				assert.Equal(t, "m6i.xlarge", workerPool.Spec.Platform.AWS.InstanceType)
+				assert.Equal(t, int64(3), *workerPool.Spec.Replicas)
+
+				infraPool := findMachinePool(allObjects, fmt.Sprintf("%s-%s", clusterName, "infra"))
+				require.NotNil(t, infraPool)
+				assert.Equal(t, clusterName, infraPool.Spec.ClusterDeploymentRef.Name)
+				assert.Equal(t, "m6i.2xlarge", infraPool.Spec.Platform.AWS.InstanceType)
+			},
+		},
 		{
 			name:    "Azure cluster",
 			builder: createAzureClusterBuilder(),
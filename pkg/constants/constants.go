@@ -33,6 +33,18 @@ const (
 	// processing of any ClusterDeprovisions.
 	DeprovisionsDisabledEnvVar = "DEPROVISIONS_DISABLED"
 
+	// SyncSetInsecureRegistriesEnvVar is the name of the environment variable used to tell the controller manager the
+	// comma-separated registries from which the OCI sources of syncsets may be fetched over plain HTTP.
+	SyncSetInsecureRegistriesEnvVar = "SYNCSET_INSECURE_REGISTRIES"
+
+	// SyncSetHelmChartRepoHostsEnvVar is the name of the environment variable used to tell the controller manager the
+	// comma-separated hosts from which the Helm charts of syncsets may be pulled.
+	SyncSetHelmChartRepoHostsEnvVar = "SYNCSET_HELM_CHART_REPO_HOSTS"
+
+	// SyncSetVaultAddressesEnvVar is the name of the environment variable used to tell the clustersync controller the
+	// comma-separated URLs of the Vault servers from which the external secrets of syncsets may be read.
+	SyncSetVaultAddressesEnvVar = "SYNCSET_VAULT_ADDRESSES"
+
 	// MinBackupPeriodSecondsEnvVar is the name of the environment variable used to tell the controller manager the minimum period of time between backups.
 	MinBackupPeriodSecondsEnvVar = "HIVE_MIN_BACKUP_PERIOD_SECONDS"
 
@@ -83,6 +95,9 @@ const (
 	// JobTypeProvision is used as a value of JobTypeLabel that says the Job is specifically running the provisioner.
 	JobTypeProvision = "provision"
 
+	// JobTypeAgentImage is used as a value of JobTypeLabel that says the Job is specifically running to create the agent ISO of an AgentImageClusterInstall.
+	JobTypeAgentImage = "agent-image"
+
 	// DNSZoneTypeLabel is the label that is used to identify what a DNSZone is being used for.
 	DNSZoneTypeLabel = "hive.openshift.io/dnszone-type"
 
@@ -101,6 +116,16 @@ const (
 	// SecretTypeKubeAdminCreds is used as a value of SecretTypeLabel that says the secret is specifically used for storing kubeadmin credentials.
 	SecretTypeKubeAdminCreds = "kubeadmincreds"
 
+	// SecretTypeInstallState is used as a value of SecretTypeLabel that says the secret is specifically used for storing the state of an install, so that it can be resumed.
+	SecretTypeInstallState = "install-state"
+
+	// SecretTypeSyncSetSource is used as a value of SecretTypeLabel that says the secret is specifically used for storing the manifests fetched from the source of a syncset.
+	SecretTypeSyncSetSource = "syncset-source"
+
+	// SyncSetSecretsDir is the directory in the hive-clustersync pods in which the SyncSetSecretsVolume of the
+	// HiveConfig is mounted.
+	SyncSetSecretsDir = "/etc/hive/syncset-secrets"
+
 	// SyncSetTypeLabel is the label that is used to identify what a SyncSet is being used for.
 	SyncSetTypeLabel = "hive.openshift.io/syncset-type"
 
@@ -113,6 +138,13 @@ const (
 	// SyncSetTypeIdentityProvider is used as a value of SyncSetTypeLabel that says the syncset is specifically used to distribute identity provider information.
 	SyncSetTypeIdentityProvider = "identityprovider"
 
+	// SyncSetTypeRBAC is used as a value of SyncSetTypeLabel that says the syncset is specifically used to distribute groups and role bindings.
+	SyncSetTypeRBAC = "rbac"
+
+	// SyncRBACDriftedResourcesAnnotation is set on the syncset of groups and role bindings of a cluster to the
+	// comma-separated groups and role bindings that differed in the cluster when it was last checked for drift.
+	SyncRBACDriftedResourcesAnnotation = "hive.openshift.io/syncrbac-drifted-resources"
+
 	// GlobalPullSecret is the environment variable for controllers to get the global pull secret
 	GlobalPullSecret = "GLOBAL_PULL_SECRET"
 
@@ -188,6 +220,11 @@ const (
 	// An incoming status indicates that the resource is on the destination side of an in-progress relocate.
 	RelocateAnnotation = "hive.openshift.io/relocate"
 
+	// ClusterUpgradeRolloutAnnotation is used on a ClusterDeployment to name the ClusterUpgradeRollout that set the
+	// upgrade of the ClusterDeployment. Other ClusterUpgradeRollouts leave the ClusterDeployment alone until the
+	// annotation is removed when the rollout completes or is deleted.
+	ClusterUpgradeRolloutAnnotation = "hive.openshift.io/cluster-upgrade-rollout"
+
 	// ManagedDomainsFileEnvVar if present, points to a simple text
 	// file that includes a valid managed domain per line. Cluster deployments
 	// requesting that their domains be managed must have a base domain
@@ -265,6 +302,9 @@ const (
 	// IdentityProviderSuffix is the suffix used when naming objects having to do with identity provider
 	IdentityProviderSuffix = "idp"
 
+	// RBACSuffix is the suffix used when naming objects having to do with groups and role bindings
+	RBACSuffix = "rbac"
+
 	// KubeconfigSecretKey is the key used inside of a secret containing a kubeconfig
 	KubeconfigSecretKey = "kubeconfig"
 
@@ -274,6 +314,10 @@ const (
 	// PasswordSecretKey is a key used to store a password inside of a secret containing username / password credentials
 	PasswordSecretKey = "password"
 
+	// TSIGSecretKey is the key used to store the base64-encoded secret of a TSIG key inside of a secret used to sign
+	// RFC 2136 dynamic updates
+	TSIGSecretKey = "tsigSecret"
+
 	// AWSRoute53Region is the region to use for route53 operations.
 	AWSRoute53Region = "us-east-1"
 
@@ -370,6 +414,12 @@ const (
 	// InstallLogsUploadProviderAWS is used to specify that AWS is the cloud provider to upload logs to.
 	InstallLogsUploadProviderAWS = "aws"
 
+	// InstallLogsUploadProviderGCP is used to specify that GCP is the cloud provider to upload logs to.
+	InstallLogsUploadProviderGCP = "gcp"
+
+	// InstallLogsUploadProviderAzure is used to specify that Azure is the cloud provider to upload logs to.
+	InstallLogsUploadProviderAzure = "azure"
+
 	// InstallLogsCredentialsSecretRefEnvVar is the environment variable specifying what secret to use for storing logs.
 	InstallLogsCredentialsSecretRefEnvVar = "HIVE_INSTALL_LOGS_CREDENTIALS_SECRET"
 
@@ -382,6 +432,22 @@ const (
 	// InstallLogsAWSS3BucketEnvVar is the environment variable specifying the S3 bucket to use.
 	InstallLogsAWSS3BucketEnvVar = "HIVE_INSTALL_LOGS_AWS_S3_BUCKET"
 
+	// InstallLogsGCPBucketEnvVar is the environment variable specifying the GCS bucket to use.
+	InstallLogsGCPBucketEnvVar = "HIVE_INSTALL_LOGS_GCP_BUCKET"
+
+	// InstallLogsAzureStorageAccountEnvVar is the environment variable specifying the Azure storage account to use.
+	InstallLogsAzureStorageAccountEnvVar = "HIVE_INSTALL_LOGS_AZURE_STORAGE_ACCOUNT"
+
+	// InstallLogsAzureContainerEnvVar is the environment variable specifying the Azure blob container to use.
+	InstallLogsAzureContainerEnvVar = "HIVE_INSTALL_LOGS_AZURE_CONTAINER"
+
+	// InstallLogsAzureCloudNameEnvVar is the environment variable specifying the Azure cloud environment to use.
+	InstallLogsAzureCloudNameEnvVar = "HIVE_INSTALL_LOGS_AZURE_CLOUD_NAME"
+
+	// InstallLogsArchiveEnvVar is the environment variable specifying whether the logs, install config and manifests
+	// of every provision attempt are to be archived.
+	InstallLogsArchiveEnvVar = "HIVE_INSTALL_LOGS_ARCHIVE"
+
 	// HiveFakeClusterAnnotation can be set to true on a cluster deployment to create a fake cluster that never
 	// provisions resources, and all communication with the cluster will be faked.
 	HiveFakeClusterAnnotation = "hive.openshift.io/fake-cluster"
@@ -0,0 +1,501 @@
+package agentimageclusterinstall
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	configv1 "github.com/openshift/api/config/v1"
+	librarygocontroller "github.com/openshift/library-go/pkg/controller"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveint "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	"github.com/openshift/hive/pkg/agentimage"
+	hivemetrics "github.com/openshift/hive/pkg/controller/metrics"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+	"github.com/openshift/hive/pkg/remoteclient"
+)
+
+const (
+	ControllerName = hivev1.AgentImageClusterInstallControllerName
+
+	// requirementsRequeueTime is how often unmet requirements are checked again, as the secrets and volumes they are
+	// about are not watched.
+	requirementsRequeueTime = time.Minute
+
+	// clusterRequeueTime is how often the cluster is checked while it installs.
+	clusterRequeueTime = time.Minute
+)
+
+// Add creates a new AgentImageClusterInstall controller and adds it to the manager with default RBAC.
+func Add(mgr manager.Manager) error {
+	logger := log.WithField("controller", ControllerName)
+	concurrentReconciles, clientRateLimiter, queueRateLimiter, err := controllerutils.GetControllerConfig(mgr.GetClient(), ControllerName)
+	if err != nil {
+		logger.WithError(err).Error("could not get controller configurations")
+		return err
+	}
+	return AddToManager(mgr, NewReconciler(mgr, clientRateLimiter), concurrentReconciles, queueRateLimiter)
+}
+
+// NewReconciler returns a new reconcile.Reconciler
+func NewReconciler(mgr manager.Manager, rateLimiter flowcontrol.RateLimiter) reconcile.Reconciler {
+	r := &ReconcileAgentImageClusterInstall{
+		Client: controllerutils.NewClientWithMetricsOrDie(mgr, ControllerName, &rateLimiter),
+		scheme: mgr.GetScheme(),
+		logger: log.WithField("controller", ControllerName),
+	}
+	r.remoteClusterAPIClientBuilder = func(secret *corev1.Secret) remoteclient.Builder {
+		return remoteclient.NewBuilderFromKubeconfig(r.Client, secret)
+	}
+	return r
+}
+
+// AddToManager adds a new Controller to mgr with r as the reconcile.Reconciler
+func AddToManager(mgr manager.Manager, r reconcile.Reconciler, concurrentReconciles int, rateLimiter workqueue.RateLimiter) error {
+	c, err := controller.New("agentimageclusterinstall-controller", mgr, controller.Options{
+		Reconciler:              controllerutils.NewDelayingReconciler(r, log.WithField("controller", ControllerName)),
+		MaxConcurrentReconciles: concurrentReconciles,
+		RateLimiter:             rateLimiter,
+	})
+	if err != nil {
+		log.WithField("controller", ControllerName).WithError(err).Error("Error creating new agentimageclusterinstall controller")
+		return err
+	}
+
+	// Watch for changes to AgentImageClusterInstall
+	err = c.Watch(source.Kind(mgr.GetCache(), &hiveint.AgentImageClusterInstall{}), &handler.EnqueueRequestForObject{})
+	if err != nil {
+		log.WithField("controller", ControllerName).WithError(err).Error("Error watching AgentImageClusterInstall")
+		return err
+	}
+
+	// Watch for the agent image jobs of AgentImageClusterInstalls
+	err = c.Watch(source.Kind(mgr.GetCache(), &batchv1.Job{}),
+		handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &hiveint.AgentImageClusterInstall{}, handler.OnlyControllerOwner()))
+	if err != nil {
+		log.WithField("controller", ControllerName).WithError(err).Error("Error watching agent image jobs")
+		return err
+	}
+
+	// Watch for ClusterDeployments installed by AgentImageClusterInstalls, to pick up when their installer image is
+	// resolved.
+	err = c.Watch(source.Kind(mgr.GetCache(), &hivev1.ClusterDeployment{}),
+		handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, o client.Object) []reconcile.Request {
+			cd, ok := o.(*hivev1.ClusterDeployment)
+			if !ok || cd.Spec.ClusterInstallRef == nil || cd.Spec.ClusterInstallRef.Kind != "AgentImageClusterInstall" {
+				return nil
+			}
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: cd.Namespace, Name: cd.Spec.ClusterInstallRef.Name}}}
+		}))
+	if err != nil {
+		log.WithField("controller", ControllerName).WithError(err).Error("Error watching ClusterDeployment")
+		return err
+	}
+
+	return nil
+}
+
+// ReconcileAgentImageClusterInstall is the reconciler for AgentImageClusterInstall.
+type ReconcileAgentImageClusterInstall struct {
+	client.Client
+	scheme *runtime.Scheme
+	logger log.FieldLogger
+
+	// remoteClusterAPIClientBuilder is a function pointer to the function that gets a builder for building a client
+	// for the remote cluster's API server
+	remoteClusterAPIClientBuilder func(secret *corev1.Secret) remoteclient.Builder
+}
+
+// Reconcile creates the agent ISO of an AgentImageClusterInstall, and then watches the cluster installed by the hosts
+// booted from it until the install completes.
+func (r *ReconcileAgentImageClusterInstall) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := controllerutils.BuildControllerLogger(ControllerName, "agentImageClusterInstall", request.NamespacedName)
+	logger.Info("reconciling AgentImageClusterInstall")
+	recobsrv := hivemetrics.NewReconcileObserver(ControllerName, logger)
+	defer recobsrv.ObserveControllerReconcileTime()
+
+	// Fetch the AgentImageClusterInstall instance
+	aci := &hiveint.AgentImageClusterInstall{}
+	err := r.Get(context.TODO(), request.NamespacedName, aci)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// Object not found, return.  Created objects are automatically garbage collected.
+			logger.Debug("AgentImageClusterInstall not found")
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		logger.WithError(err).Error("Error getting AgentImageClusterInstall")
+		return reconcile.Result{}, err
+	}
+	logger = controllerutils.AddLogFields(controllerutils.MetaObjectLogTagger{Object: aci}, logger)
+
+	if !aci.DeletionTimestamp.IsZero() {
+		logger.Info("AgentImageClusterInstall resource has been deleted")
+		return reconcile.Result{}, nil
+	}
+
+	// Ensure our conditions are present, default state should be Unknown per Kube guidelines:
+	conditionTypes := []hivev1.ClusterInstallConditionType{
+		// These conditions are required by Hive:
+		hivev1.ClusterInstallCompleted,
+		hivev1.ClusterInstallFailed,
+		hivev1.ClusterInstallStopped,
+		hivev1.ClusterInstallRequirementsMet,
+	}
+
+	var anyChanged bool
+	for _, condType := range conditionTypes {
+		if controllerutils.FindCondition(aci.Status.Conditions, condType) == nil {
+			logger.WithField("condition", condType).Info("initializing condition with Unknown status")
+			anyChanged = setCondition(aci, condType, corev1.ConditionUnknown, "", "") || anyChanged
+		}
+	}
+	if anyChanged {
+		return reconcile.Result{}, updateClusterInstallStatus(r.Client, aci, logger)
+	}
+
+	// Fetch corresponding ClusterDeployment instance
+	cd := &hivev1.ClusterDeployment{}
+	switch err = r.Get(context.TODO(), types.NamespacedName{Namespace: aci.Namespace, Name: aci.Spec.ClusterDeploymentRef.Name}, cd); {
+	case apierrors.IsNotFound(err):
+		logger.WithField("clusterDeployment", aci.Spec.ClusterDeploymentRef.Name).Info("ClusterDeployment not found")
+		return reconcile.Result{}, nil
+	case err != nil:
+		logger.WithError(err).Error("Error getting ClusterDeployment")
+		return reconcile.Result{}, err
+	}
+	if !cd.DeletionTimestamp.IsZero() {
+		logger.Debug("ClusterDeployment has been deleted")
+		return reconcile.Result{}, nil
+	}
+
+	// Ensure the AgentImageClusterInstall has an OwnerReference to the ClusterDeployment, so it is
+	// automatically cleaned up if the owner is deleted.
+	cdRef := metav1.OwnerReference{
+		APIVersion:         hivev1.SchemeGroupVersion.String(),
+		Kind:               "ClusterDeployment",
+		Name:               cd.Name,
+		UID:                cd.UID,
+		BlockOwnerDeletion: pointer.BoolPtr(true),
+	}
+	if librarygocontroller.EnsureOwnerRef(aci, cdRef) {
+		logger.Info("added owner reference to ClusterDeployment")
+		return reconcile.Result{}, r.Update(context.TODO(), aci)
+	}
+
+	// Check if we're Completed and can exit reconcile early.
+	if controllerutils.FindCondition(aci.Status.Conditions, hivev1.ClusterInstallCompleted).Status == corev1.ConditionTrue {
+		changedStopped := setCondition(aci, hivev1.ClusterInstallStopped, corev1.ConditionTrue, "ClusterInstalled", "Cluster install completed successfully")
+		changedFailed := setCondition(aci, hivev1.ClusterInstallFailed, corev1.ConditionFalse, "ClusterInstalled", "Cluster install completed successfully")
+		if changedStopped || changedFailed {
+			return reconcile.Result{}, updateClusterInstallStatus(r.Client, aci, logger)
+		}
+		logger.Info("cluster install completed, no work left to be done")
+		return reconcile.Result{}, nil
+	}
+
+	// Check if we gave up.
+	if controllerutils.FindCondition(aci.Status.Conditions, hivev1.ClusterInstallStopped).Status == corev1.ConditionTrue {
+		logger.Info("cluster install stopped, no work left to be done")
+		return reconcile.Result{}, nil
+	}
+
+	// Ensure Stopped=False as we are actively working to reconcile:
+	if setCondition(aci, hivev1.ClusterInstallStopped, corev1.ConditionFalse, "InProgress", "Cluster install in progress") {
+		return reconcile.Result{}, updateClusterInstallStatus(r.Client, aci, logger)
+	}
+
+	releaseImage, reason, message, err := r.checkRequirements(aci, cd)
+	if err != nil {
+		logger.WithError(err).Error("error checking requirements")
+		return reconcile.Result{}, err
+	}
+	if reason != "" {
+		logger.WithField("reason", reason).Info(message)
+		if setCondition(aci, hivev1.ClusterInstallRequirementsMet, corev1.ConditionFalse, reason, message) {
+			if err := updateClusterInstallStatus(r.Client, aci, logger); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+		return reconcile.Result{RequeueAfter: requirementsRequeueTime}, nil
+	}
+	if setCondition(aci, hivev1.ClusterInstallRequirementsMet, corev1.ConditionTrue, "AllRequirementsMet", "All requirements met") {
+		logger.Info("all requirements met")
+		return reconcile.Result{}, updateClusterInstallStatus(r.Client, aci, logger)
+	}
+
+	if aci.Status.ImageURL == "" {
+		return r.reconcileImage(aci, cd, releaseImage, logger)
+	}
+	return r.reconcileCluster(aci, logger)
+}
+
+// checkRequirements checks that everything the install needs is in place, returning the release image to install.
+// The reason and message of the first requirement found not met are returned.
+func (r *ReconcileAgentImageClusterInstall) checkRequirements(aci *hiveint.AgentImageClusterInstall, cd *hivev1.ClusterDeployment) (releaseImage, reason, message string, returnErr error) {
+	imageSet := &hivev1.ClusterImageSet{}
+	switch err := r.Get(context.TODO(), types.NamespacedName{Name: aci.Spec.ImageSetRef.Name}, imageSet); {
+	case apierrors.IsNotFound(err):
+		return "", "ClusterImageSetNotFound", fmt.Sprintf("ClusterImageSet %s is not available", aci.Spec.ImageSetRef.Name), nil
+	case err != nil:
+		return "", "", "", err
+	}
+
+	if cd.Status.InstallerImage == nil {
+		return "", "WaitingForInstallerImage", "Waiting for the installer image of the ClusterDeployment to be resolved", nil
+	}
+
+	installConfig := &corev1.Secret{}
+	switch err := r.Get(context.TODO(), types.NamespacedName{Namespace: aci.Namespace, Name: aci.Spec.InstallConfigSecretRef.Name}, installConfig); {
+	case apierrors.IsNotFound(err):
+		return "", "InstallConfigNotFound", fmt.Sprintf("Install config secret %s not found", aci.Spec.InstallConfigSecretRef.Name), nil
+	case err != nil:
+		return "", "", "", err
+	}
+	if reason, message := validateInstallConfig(installConfig.Data[agentimage.InstallConfigKey]); reason != "" {
+		return "", reason, message, nil
+	}
+
+	if ref := aci.Spec.AgentConfigSecretRef; ref != nil {
+		agentConfig := &corev1.Secret{}
+		switch err := r.Get(context.TODO(), types.NamespacedName{Namespace: aci.Namespace, Name: ref.Name}, agentConfig); {
+		case apierrors.IsNotFound(err):
+			return "", "AgentConfigNotFound", fmt.Sprintf("Agent config secret %s not found", ref.Name), nil
+		case err != nil:
+			return "", "", "", err
+		}
+		if _, ok := agentConfig.Data[agentimage.AgentConfigKey]; !ok {
+			return "", "InvalidAgentConfig", fmt.Sprintf("Agent config secret %s has no %s key", ref.Name, agentimage.AgentConfigKey), nil
+		}
+	}
+
+	storage := aci.Spec.ImageStorage
+	switch {
+	case storage.PersistentVolumeClaim != nil && storage.S3 != nil, storage.PersistentVolumeClaim == nil && storage.S3 == nil:
+		return "", "InvalidImageStorage", "Exactly one of persistentVolumeClaim and s3 image storage must be set", nil
+	case storage.PersistentVolumeClaim != nil:
+		pvc := &corev1.PersistentVolumeClaim{}
+		switch err := r.Get(context.TODO(), types.NamespacedName{Namespace: aci.Namespace, Name: storage.PersistentVolumeClaim.ClaimName}, pvc); {
+		case apierrors.IsNotFound(err):
+			return "", "PersistentVolumeClaimNotFound", fmt.Sprintf("PersistentVolumeClaim %s not found", storage.PersistentVolumeClaim.ClaimName), nil
+		case err != nil:
+			return "", "", "", err
+		}
+		if _, err := agentimage.PersistentVolumeClaimImageURL(storage.PersistentVolumeClaim.BaseURL, aci.Name, "agent.iso"); err != nil || storage.PersistentVolumeClaim.BaseURL == "" {
+			return "", "InvalidImageStorage", "The base URL of the persistentVolumeClaim image storage must be a valid URL", nil
+		}
+	case storage.S3 != nil:
+		if storage.S3.Bucket == "" {
+			return "", "InvalidImageStorage", "The bucket of the s3 image storage must be set", nil
+		}
+		switch err := r.Get(context.TODO(), types.NamespacedName{Namespace: aci.Namespace, Name: storage.S3.CredentialsSecretRef.Name}, &corev1.Secret{}); {
+		case apierrors.IsNotFound(err):
+			return "", "S3CredentialsNotFound", fmt.Sprintf("S3 credentials secret %s not found", storage.S3.CredentialsSecretRef.Name), nil
+		case err != nil:
+			return "", "", "", err
+		}
+	}
+
+	return imageSet.Spec.ReleaseImage, "", "", nil
+}
+
+// validateInstallConfig checks that the install config is one openshift-install can create an agent ISO for.
+func validateInstallConfig(data []byte) (reason, message string) {
+	if len(data) == 0 {
+		return "InvalidInstallConfig", fmt.Sprintf("Install config secret has no %s key", agentimage.InstallConfigKey)
+	}
+	ic := struct {
+		Platform map[string]interface{} `json:"platform"`
+	}{}
+	if err := yaml.Unmarshal(data, &ic); err != nil {
+		return "InvalidInstallConfig", fmt.Sprintf("Install config does not parse: %v", err)
+	}
+	for platform := range ic.Platform {
+		if platform != "none" && platform != "baremetal" {
+			return "InvalidInstallConfig", fmt.Sprintf("Install config platform %s is not supported, must be none or baremetal", platform)
+		}
+	}
+	if len(ic.Platform) != 1 {
+		return "InvalidInstallConfig", "Install config must set the none or baremetal platform"
+	}
+	return "", ""
+}
+
+// reconcileImage runs the job creating the agent ISO, until the job records the download URL of the agent ISO.
+func (r *ReconcileAgentImageClusterInstall) reconcileImage(aci *hiveint.AgentImageClusterInstall, cd *hivev1.ClusterDeployment, releaseImage string, logger log.FieldLogger) (reconcile.Result, error) {
+	job := &batchv1.Job{}
+	jobName := types.NamespacedName{Namespace: aci.Namespace, Name: agentimage.GetAgentImageJobName(aci.Name)}
+	jobLog := logger.WithField("job", jobName.Name)
+	switch err := r.Get(context.TODO(), jobName, job); {
+	case apierrors.IsNotFound(err):
+		job = agentimage.GenerateAgentImageJob(aci, cd, *cd.Status.InstallerImage, releaseImage, controllerutils.InstallServiceAccountName,
+			os.Getenv("HTTP_PROXY"),
+			os.Getenv("HTTPS_PROXY"),
+			os.Getenv("NO_PROXY"))
+		if err := controllerutil.SetControllerReference(aci, job, r.scheme); err != nil {
+			jobLog.WithError(err).Error("error setting controller reference on job")
+			return reconcile.Result{}, err
+		}
+		if err := controllerutils.SetupClusterInstallServiceAccount(r, aci.Namespace, logger); err != nil {
+			logger.WithError(err).Log(controllerutils.LogLevel(err), "error setting up service account and role")
+			return reconcile.Result{}, err
+		}
+		jobLog.WithField("releaseImage", releaseImage).Info("creating agent image job")
+		if err := r.Create(context.TODO(), job); err != nil {
+			jobLog.WithError(err).Log(controllerutils.LogLevel(err), "error creating job")
+			return reconcile.Result{}, err
+		}
+	case err != nil:
+		jobLog.WithError(err).Error("cannot get job")
+		return reconcile.Result{}, err
+	}
+
+	if controllerutils.IsFailed(job) {
+		message := "Creating the agent image failed"
+		for _, cond := range job.Status.Conditions {
+			if cond.Type == batchv1.JobFailed && cond.Message != "" {
+				message = fmt.Sprintf("%s: %s", message, cond.Message)
+			}
+		}
+		jobLog.Info("agent image job failed")
+		setCondition(aci, hivev1.ClusterInstallCompleted, corev1.ConditionFalse, "ImageCreationFailed", message)
+		setCondition(aci, hivev1.ClusterInstallFailed, corev1.ConditionTrue, "ImageCreationFailed", message)
+		setCondition(aci, hivev1.ClusterInstallStopped, corev1.ConditionTrue, "ImageCreationFailed", message)
+		return reconcile.Result{}, updateClusterInstallStatus(r.Client, aci, logger)
+	}
+
+	changed := setCondition(aci, hivev1.ClusterInstallCompleted, corev1.ConditionFalse, "CreatingImage", "Creating the agent image")
+	changed = setCondition(aci, hivev1.ClusterInstallFailed, corev1.ConditionFalse, "CreatingImage", "Creating the agent image") || changed
+	if changed {
+		return reconcile.Result{}, updateClusterInstallStatus(r.Client, aci, logger)
+	}
+	if controllerutils.IsSuccessful(job) {
+		// The job records the image URL as it completes, which we may not have observed yet.
+		jobLog.Debug("agent image job succeeded, waiting for the image URL")
+		return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+	jobLog.Debug("agent image job is running")
+	return reconcile.Result{}, nil
+}
+
+// reconcileCluster waits for the hosts booted from the agent ISO to install the cluster, recording its metadata once
+// its API is up.
+func (r *ReconcileAgentImageClusterInstall) reconcileCluster(aci *hiveint.AgentImageClusterInstall, logger log.FieldLogger) (reconcile.Result, error) {
+	if timeout := aci.Spec.InstallTimeout; timeout != nil && aci.Status.ImageCreatedTime != nil &&
+		time.Since(aci.Status.ImageCreatedTime.Time) > timeout.Duration {
+		message := fmt.Sprintf("Cluster did not install within %s of creating the agent image", timeout.Duration)
+		logger.Info(message)
+		setCondition(aci, hivev1.ClusterInstallCompleted, corev1.ConditionFalse, "InstallTimedOut", message)
+		setCondition(aci, hivev1.ClusterInstallFailed, corev1.ConditionTrue, "InstallTimedOut", message)
+		setCondition(aci, hivev1.ClusterInstallStopped, corev1.ConditionTrue, "InstallTimedOut", message)
+		return reconcile.Result{}, updateClusterInstallStatus(r.Client, aci, logger)
+	}
+
+	waitingMessage := "Waiting for the hosts booted from the agent image to install the cluster"
+	changed := setCondition(aci, hivev1.ClusterInstallCompleted, corev1.ConditionFalse, "WaitingForCluster", waitingMessage)
+	changed = setCondition(aci, hivev1.ClusterInstallFailed, corev1.ConditionFalse, "WaitingForCluster", waitingMessage) || changed
+	if changed {
+		return reconcile.Result{}, updateClusterInstallStatus(r.Client, aci, logger)
+	}
+
+	if aci.Spec.ClusterMetadata == nil {
+		logger.Warn("agent image created without cluster metadata")
+		return reconcile.Result{RequeueAfter: clusterRequeueTime}, nil
+	}
+	kubeconfig := &corev1.Secret{}
+	if err := r.Get(context.TODO(), types.NamespacedName{Namespace: aci.Namespace, Name: aci.Spec.ClusterMetadata.AdminKubeconfigSecretRef.Name}, kubeconfig); err != nil {
+		logger.WithError(err).Error("error getting admin kubeconfig secret")
+		return reconcile.Result{}, err
+	}
+	remoteClient, err := r.remoteClusterAPIClientBuilder(kubeconfig).Build()
+	if err != nil {
+		// The API of the cluster is not up until the hosts have bootstrapped the control plane.
+		logger.WithError(err).Debug("cluster API is not reachable yet")
+		return reconcile.Result{RequeueAfter: clusterRequeueTime}, nil
+	}
+
+	clusterVersion := &configv1.ClusterVersion{}
+	if err := remoteClient.Get(context.TODO(), types.NamespacedName{Name: "version"}, clusterVersion); err != nil {
+		logger.WithError(err).Debug("error getting cluster version")
+		return reconcile.Result{RequeueAfter: clusterRequeueTime}, nil
+	}
+
+	// Record the IDs of the cluster, which the agent installer does not tell us until the cluster is up.
+	if aci.Spec.ClusterMetadata.ClusterID == "" || aci.Spec.ClusterMetadata.InfraID == "" {
+		infrastructure := &configv1.Infrastructure{}
+		if err := remoteClient.Get(context.TODO(), types.NamespacedName{Name: "cluster"}, infrastructure); err != nil {
+			logger.WithError(err).Debug("error getting cluster infrastructure")
+			return reconcile.Result{RequeueAfter: clusterRequeueTime}, nil
+		}
+		aci.Spec.ClusterMetadata.ClusterID = string(clusterVersion.Spec.ClusterID)
+		aci.Spec.ClusterMetadata.InfraID = infrastructure.Status.InfrastructureName
+		logger.WithField("clusterID", aci.Spec.ClusterMetadata.ClusterID).WithField("infraID", aci.Spec.ClusterMetadata.InfraID).
+			Info("recording cluster metadata")
+		return reconcile.Result{}, r.Update(context.TODO(), aci)
+	}
+
+	available := controllerutils.FindClusterVersionCondition(clusterVersion, configv1.OperatorAvailable)
+	if available == nil || available.Status != configv1.ConditionTrue {
+		message := waitingMessage
+		if progressing := controllerutils.FindClusterVersionCondition(clusterVersion, configv1.OperatorProgressing); progressing != nil && progressing.Message != "" {
+			message = progressing.Message
+		}
+		if setCondition(aci, hivev1.ClusterInstallCompleted, corev1.ConditionFalse, "InstallInProgress", message) {
+			if err := updateClusterInstallStatus(r.Client, aci, logger); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+		return reconcile.Result{RequeueAfter: clusterRequeueTime}, nil
+	}
+
+	logger.Info("cluster install completed")
+	setCondition(aci, hivev1.ClusterInstallCompleted, corev1.ConditionTrue, "ClusterInstalled", "Cluster install completed successfully")
+	setCondition(aci, hivev1.ClusterInstallFailed, corev1.ConditionFalse, "ClusterInstalled", "Cluster install completed successfully")
+	setCondition(aci, hivev1.ClusterInstallStopped, corev1.ConditionTrue, "ClusterInstalled", "Cluster install completed successfully")
+	return reconcile.Result{}, updateClusterInstallStatus(r.Client, aci, logger)
+}
+
+// setCondition sets the condition of the AgentImageClusterInstall, returning true if it changed.
+func setCondition(aci *hiveint.AgentImageClusterInstall, condType hivev1.ClusterInstallConditionType, status corev1.ConditionStatus, reason, message string) bool {
+	updateCheck := controllerutils.UpdateConditionIfReasonOrMessageChange
+	if status == corev1.ConditionUnknown {
+		updateCheck = controllerutils.UpdateConditionAlways
+	}
+	conditions, changed := controllerutils.SetClusterInstallConditionWithChangeCheck(
+		aci.Status.Conditions,
+		condType,
+		status,
+		reason,
+		message,
+		updateCheck)
+	aci.Status.Conditions = conditions
+	return changed
+}
+
+func updateClusterInstallStatus(c client.Client, aci *hiveint.AgentImageClusterInstall, logger log.FieldLogger) error {
+	logger.Info("updating status")
+	return c.Status().Update(context.Background(), aci)
+}
@@ -0,0 +1,500 @@
+package agentimageclusterinstall
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveint "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	"github.com/openshift/hive/pkg/agentimage"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+	"github.com/openshift/hive/pkg/remoteclient"
+	remoteclientmock "github.com/openshift/hive/pkg/remoteclient/mock"
+	testfake "github.com/openshift/hive/pkg/test/fake"
+	"github.com/openshift/hive/pkg/util/scheme"
+)
+
+const (
+	testNamespace     = "test-namespace"
+	testName          = "test-aci"
+	testCDName        = "test-cd"
+	testImageSetName  = "test-imageset"
+	testImageURL      = "http://images.example.com/test-aci/agent.x86_64.iso"
+	testClusterID     = "test-cluster-id"
+	testInfraID       = "test-infra-id"
+	testInstallConfig = `apiVersion: v1
+metadata:
+  name: test-cluster
+platform:
+  none: {}
+`
+)
+
+func init() {
+	log.SetLevel(log.DebugLevel)
+}
+
+type conditionExpectation struct {
+	status corev1.ConditionStatus
+	reason string
+}
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		name               string
+		aci                *hiveint.AgentImageClusterInstall
+		cd                 *hivev1.ClusterDeployment
+		existing           []runtime.Object
+		remote             []runtime.Object
+		remoteErr          error
+		expectRemote       bool
+		expectedConditions map[hivev1.ClusterInstallConditionType]conditionExpectation
+		expectJob          bool
+		expectRequeueAfter bool
+		validate           func(*testing.T, *hiveint.AgentImageClusterInstall)
+	}{
+		{
+			name: "initialize conditions",
+			aci:  testAgentImageClusterInstall(),
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallCompleted:       {status: corev1.ConditionUnknown},
+				hivev1.ClusterInstallFailed:          {status: corev1.ConditionUnknown},
+				hivev1.ClusterInstallStopped:         {status: corev1.ConditionUnknown},
+				hivev1.ClusterInstallRequirementsMet: {status: corev1.ConditionUnknown},
+			},
+		},
+		{
+			name: "set in progress",
+			aci:  withConditions(testAgentImageClusterInstall(), unknownConditions()...),
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallStopped: {status: corev1.ConditionFalse, reason: "InProgress"},
+			},
+		},
+		{
+			name: "waiting for installer image",
+			aci:  inProgress(testAgentImageClusterInstall()),
+			cd: func() *hivev1.ClusterDeployment {
+				cd := testClusterDeployment()
+				cd.Status.InstallerImage = nil
+				return cd
+			}(),
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallRequirementsMet: {status: corev1.ConditionFalse, reason: "WaitingForInstallerImage"},
+			},
+			expectRequeueAfter: true,
+		},
+		{
+			name: "unsupported platform",
+			aci:  inProgress(testAgentImageClusterInstall()),
+			existing: []runtime.Object{
+				testSecret("install-config", agentimage.InstallConfigKey, "platform:\n  aws:\n    region: us-east-1\n"),
+			},
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallRequirementsMet: {status: corev1.ConditionFalse, reason: "InvalidInstallConfig"},
+			},
+			expectRequeueAfter: true,
+		},
+		{
+			name: "agent config not found",
+			aci: func() *hiveint.AgentImageClusterInstall {
+				aci := inProgress(testAgentImageClusterInstall())
+				aci.Spec.AgentConfigSecretRef = &corev1.LocalObjectReference{Name: "agent-config"}
+				return aci
+			}(),
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallRequirementsMet: {status: corev1.ConditionFalse, reason: "AgentConfigNotFound"},
+			},
+			expectRequeueAfter: true,
+		},
+		{
+			name: "both image storages",
+			aci: func() *hiveint.AgentImageClusterInstall {
+				aci := inProgress(testAgentImageClusterInstall())
+				aci.Spec.ImageStorage.S3 = &hiveint.AgentImageS3Storage{Bucket: "images"}
+				return aci
+			}(),
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallRequirementsMet: {status: corev1.ConditionFalse, reason: "InvalidImageStorage"},
+			},
+			expectRequeueAfter: true,
+		},
+		{
+			name: "persistent volume claim not found",
+			aci: func() *hiveint.AgentImageClusterInstall {
+				aci := inProgress(testAgentImageClusterInstall())
+				aci.Spec.ImageStorage.PersistentVolumeClaim.ClaimName = "missing"
+				return aci
+			}(),
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallRequirementsMet: {status: corev1.ConditionFalse, reason: "PersistentVolumeClaimNotFound"},
+			},
+			expectRequeueAfter: true,
+		},
+		{
+			name: "requirements met",
+			aci:  inProgress(testAgentImageClusterInstall()),
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallRequirementsMet: {status: corev1.ConditionTrue, reason: "AllRequirementsMet"},
+			},
+		},
+		{
+			name: "create agent image job",
+			aci:  requirementsMet(testAgentImageClusterInstall()),
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallCompleted: {status: corev1.ConditionFalse, reason: "CreatingImage"},
+				hivev1.ClusterInstallFailed:    {status: corev1.ConditionFalse, reason: "CreatingImage"},
+			},
+			expectJob: true,
+		},
+		{
+			name: "agent image job failed",
+			aci:  requirementsMet(testAgentImageClusterInstall()),
+			existing: []runtime.Object{
+				testJob(batchv1.JobFailed),
+			},
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallFailed:  {status: corev1.ConditionTrue, reason: "ImageCreationFailed"},
+				hivev1.ClusterInstallStopped: {status: corev1.ConditionTrue, reason: "ImageCreationFailed"},
+			},
+			expectJob: true,
+		},
+		{
+			name: "agent image job succeeded without image URL",
+			aci: withConditions(requirementsMet(testAgentImageClusterInstall()),
+				hivev1.ClusterInstallCondition{Type: hivev1.ClusterInstallCompleted, Status: corev1.ConditionFalse, Reason: "CreatingImage", Message: "Creating the agent image"},
+				hivev1.ClusterInstallCondition{Type: hivev1.ClusterInstallFailed, Status: corev1.ConditionFalse, Reason: "CreatingImage", Message: "Creating the agent image"},
+			),
+			existing: []runtime.Object{
+				testJob(batchv1.JobComplete),
+			},
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallCompleted: {status: corev1.ConditionFalse, reason: "CreatingImage"},
+			},
+			expectJob:          true,
+			expectRequeueAfter: true,
+		},
+		{
+			name: "wait for cluster",
+			aci:  imageCreated(testAgentImageClusterInstall()),
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallCompleted: {status: corev1.ConditionFalse, reason: "WaitingForCluster"},
+			},
+		},
+		{
+			name:         "cluster unreachable",
+			aci:          waitingForCluster(imageCreated(testAgentImageClusterInstall())),
+			remoteErr:    errors.New("connection refused"),
+			expectRemote: true,
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallCompleted: {status: corev1.ConditionFalse, reason: "WaitingForCluster"},
+			},
+			expectRequeueAfter: true,
+		},
+		{
+			name: "install timed out",
+			aci: func() *hiveint.AgentImageClusterInstall {
+				aci := imageCreated(testAgentImageClusterInstall())
+				aci.Spec.InstallTimeout = &metav1.Duration{Duration: time.Hour}
+				aci.Status.ImageCreatedTime = &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}
+				return aci
+			}(),
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallFailed:  {status: corev1.ConditionTrue, reason: "InstallTimedOut"},
+				hivev1.ClusterInstallStopped: {status: corev1.ConditionTrue, reason: "InstallTimedOut"},
+			},
+		},
+		{
+			name:         "record cluster metadata",
+			aci:          waitingForCluster(imageCreated(testAgentImageClusterInstall())),
+			remote:       []runtime.Object{testClusterVersion(configv1.ConditionFalse), testInfrastructure()},
+			expectRemote: true,
+			validate: func(t *testing.T, aci *hiveint.AgentImageClusterInstall) {
+				assert.Equal(t, testClusterID, aci.Spec.ClusterMetadata.ClusterID, "unexpected cluster ID")
+				assert.Equal(t, testInfraID, aci.Spec.ClusterMetadata.InfraID, "unexpected infra ID")
+			},
+		},
+		{
+			name:         "cluster installing",
+			aci:          withClusterIDs(waitingForCluster(imageCreated(testAgentImageClusterInstall()))),
+			remote:       []runtime.Object{testClusterVersion(configv1.ConditionFalse), testInfrastructure()},
+			expectRemote: true,
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallCompleted: {status: corev1.ConditionFalse, reason: "InstallInProgress"},
+			},
+			expectRequeueAfter: true,
+		},
+		{
+			name:         "cluster installed",
+			aci:          withClusterIDs(waitingForCluster(imageCreated(testAgentImageClusterInstall()))),
+			remote:       []runtime.Object{testClusterVersion(configv1.ConditionTrue), testInfrastructure()},
+			expectRemote: true,
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallCompleted: {status: corev1.ConditionTrue, reason: "ClusterInstalled"},
+				hivev1.ClusterInstallFailed:    {status: corev1.ConditionFalse, reason: "ClusterInstalled"},
+				hivev1.ClusterInstallStopped:   {status: corev1.ConditionTrue, reason: "ClusterInstalled"},
+			},
+		},
+		{
+			name: "completed sets stopped",
+			aci: withConditions(testAgentImageClusterInstall(),
+				hivev1.ClusterInstallCondition{Type: hivev1.ClusterInstallCompleted, Status: corev1.ConditionTrue},
+				hivev1.ClusterInstallCondition{Type: hivev1.ClusterInstallFailed, Status: corev1.ConditionUnknown},
+				hivev1.ClusterInstallCondition{Type: hivev1.ClusterInstallStopped, Status: corev1.ConditionUnknown},
+				hivev1.ClusterInstallCondition{Type: hivev1.ClusterInstallRequirementsMet, Status: corev1.ConditionTrue},
+			),
+			expectedConditions: map[hivev1.ClusterInstallConditionType]conditionExpectation{
+				hivev1.ClusterInstallFailed:  {status: corev1.ConditionFalse, reason: "ClusterInstalled"},
+				hivev1.ClusterInstallStopped: {status: corev1.ConditionTrue, reason: "ClusterInstalled"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cd := test.cd
+			if cd == nil {
+				cd = testClusterDeployment()
+			}
+			existing := []runtime.Object{
+				test.aci,
+				cd,
+				&hivev1.ClusterImageSet{
+					ObjectMeta: metav1.ObjectMeta{Name: testImageSetName},
+					Spec:       hivev1.ClusterImageSetSpec{ReleaseImage: "test-release-image"},
+				},
+				&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "images"}},
+				testSecret("test-aci-admin-kubeconfig", "kubeconfig", "kubeconfig"),
+			}
+			hasInstallConfig := false
+			for _, o := range test.existing {
+				if s, ok := o.(*corev1.Secret); ok && s.Name == "install-config" {
+					hasInstallConfig = true
+				}
+			}
+			if !hasInstallConfig {
+				existing = append(existing, testSecret("install-config", agentimage.InstallConfigKey, testInstallConfig))
+			}
+			existing = append(existing, test.existing...)
+			c := testfake.NewFakeClientBuilder().WithRuntimeObjects(existing...).Build()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockRemoteClientBuilder := remoteclientmock.NewMockBuilder(mockCtrl)
+			if test.expectRemote {
+				if test.remoteErr != nil {
+					mockRemoteClientBuilder.EXPECT().Build().Return(nil, test.remoteErr)
+				} else {
+					remoteClient := testfake.NewFakeClientBuilder().WithRuntimeObjects(test.remote...).Build()
+					mockRemoteClientBuilder.EXPECT().Build().Return(remoteClient, nil)
+				}
+			}
+
+			r := &ReconcileAgentImageClusterInstall{
+				Client:                        c,
+				scheme:                        scheme.GetScheme(),
+				logger:                        log.WithField("controller", ControllerName),
+				remoteClusterAPIClientBuilder: func(*corev1.Secret) remoteclient.Builder { return mockRemoteClientBuilder },
+			}
+
+			result, err := r.Reconcile(context.TODO(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: testName},
+			})
+			require.NoError(t, err, "unexpected error from reconcile")
+			if test.expectRequeueAfter {
+				assert.NotZero(t, result.RequeueAfter, "expected requeue after")
+			}
+
+			aci := &hiveint.AgentImageClusterInstall{}
+			require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: testName}, aci))
+			for condType, expected := range test.expectedConditions {
+				cond := controllerutils.FindCondition(aci.Status.Conditions, condType)
+				if assert.NotNil(t, cond, "missing condition %s", condType) {
+					assert.Equal(t, expected.status, cond.Status, "unexpected status of condition %s", condType)
+					if expected.reason != "" {
+						assert.Equal(t, expected.reason, cond.Reason, "unexpected reason of condition %s", condType)
+					}
+				}
+			}
+
+			job := &batchv1.Job{}
+			err = c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: agentimage.GetAgentImageJobName(testName)}, job)
+			if test.expectJob {
+				assert.NoError(t, err, "expected agent image job")
+			} else {
+				assert.Error(t, err, "unexpected agent image job")
+			}
+
+			if test.validate != nil {
+				test.validate(t, aci)
+			}
+		})
+	}
+}
+
+func testAgentImageClusterInstall() *hiveint.AgentImageClusterInstall {
+	return &hiveint.AgentImageClusterInstall{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       testNamespace,
+			Name:            testName,
+			ResourceVersion: "1",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion:         hivev1.SchemeGroupVersion.String(),
+				Kind:               "ClusterDeployment",
+				Name:               testCDName,
+				UID:                types.UID("test-cd-uid"),
+				BlockOwnerDeletion: pointer.BoolPtr(true),
+			}},
+		},
+		Spec: hiveint.AgentImageClusterInstallSpec{
+			ImageSetRef:            hivev1.ClusterImageSetReference{Name: testImageSetName},
+			ClusterDeploymentRef:   corev1.LocalObjectReference{Name: testCDName},
+			InstallConfigSecretRef: corev1.LocalObjectReference{Name: "install-config"},
+			ImageStorage: hiveint.AgentImageStorage{
+				PersistentVolumeClaim: &hiveint.AgentImagePersistentVolumeClaimStorage{
+					ClaimName: "images",
+					BaseURL:   "http://images.example.com",
+				},
+			},
+		},
+	}
+}
+
+func withConditions(aci *hiveint.AgentImageClusterInstall, conditions ...hivev1.ClusterInstallCondition) *hiveint.AgentImageClusterInstall {
+	for _, cond := range conditions {
+		aci.Status.Conditions, _ = controllerutils.SetClusterInstallConditionWithChangeCheck(aci.Status.Conditions,
+			cond.Type, cond.Status, cond.Reason, cond.Message, controllerutils.UpdateConditionAlways)
+	}
+	return aci
+}
+
+func unknownConditions() []hivev1.ClusterInstallCondition {
+	return []hivev1.ClusterInstallCondition{
+		{Type: hivev1.ClusterInstallCompleted, Status: corev1.ConditionUnknown},
+		{Type: hivev1.ClusterInstallFailed, Status: corev1.ConditionUnknown},
+		{Type: hivev1.ClusterInstallStopped, Status: corev1.ConditionUnknown},
+		{Type: hivev1.ClusterInstallRequirementsMet, Status: corev1.ConditionUnknown},
+	}
+}
+
+func inProgress(aci *hiveint.AgentImageClusterInstall) *hiveint.AgentImageClusterInstall {
+	withConditions(aci, unknownConditions()...)
+	return withConditions(aci, hivev1.ClusterInstallCondition{
+		Type: hivev1.ClusterInstallStopped, Status: corev1.ConditionFalse, Reason: "InProgress", Message: "Cluster install in progress",
+	})
+}
+
+func requirementsMet(aci *hiveint.AgentImageClusterInstall) *hiveint.AgentImageClusterInstall {
+	return withConditions(inProgress(aci), hivev1.ClusterInstallCondition{
+		Type: hivev1.ClusterInstallRequirementsMet, Status: corev1.ConditionTrue, Reason: "AllRequirementsMet", Message: "All requirements met",
+	})
+}
+
+func imageCreated(aci *hiveint.AgentImageClusterInstall) *hiveint.AgentImageClusterInstall {
+	aci = requirementsMet(aci)
+	aci.Spec.ClusterMetadata = &hivev1.ClusterMetadata{
+		AdminKubeconfigSecretRef: corev1.LocalObjectReference{Name: "test-aci-admin-kubeconfig"},
+		AdminPasswordSecretRef:   &corev1.LocalObjectReference{Name: "test-aci-admin-password"},
+	}
+	aci.Status.ImageURL = testImageURL
+	aci.Status.ImageCreatedTime = &metav1.Time{Time: time.Now()}
+	return aci
+}
+
+func waitingForCluster(aci *hiveint.AgentImageClusterInstall) *hiveint.AgentImageClusterInstall {
+	message := "Waiting for the hosts booted from the agent image to install the cluster"
+	return withConditions(aci,
+		hivev1.ClusterInstallCondition{Type: hivev1.ClusterInstallCompleted, Status: corev1.ConditionFalse, Reason: "WaitingForCluster", Message: message},
+		hivev1.ClusterInstallCondition{Type: hivev1.ClusterInstallFailed, Status: corev1.ConditionFalse, Reason: "WaitingForCluster", Message: message},
+	)
+}
+
+func withClusterIDs(aci *hiveint.AgentImageClusterInstall) *hiveint.AgentImageClusterInstall {
+	aci.Spec.ClusterMetadata.ClusterID = testClusterID
+	aci.Spec.ClusterMetadata.InfraID = testInfraID
+	return aci
+}
+
+func testClusterDeployment() *hivev1.ClusterDeployment {
+	return &hivev1.ClusterDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testCDName,
+			UID:       types.UID("test-cd-uid"),
+		},
+		Spec: hivev1.ClusterDeploymentSpec{
+			ClusterInstallRef: &hivev1.ClusterInstallLocalReference{
+				Group:   hiveint.SchemeGroupVersion.Group,
+				Version: hiveint.SchemeGroupVersion.Version,
+				Kind:    "AgentImageClusterInstall",
+				Name:    testName,
+			},
+		},
+		Status: hivev1.ClusterDeploymentStatus{
+			InstallerImage: pointer.String("test-installer-image"),
+		},
+	}
+}
+
+func testSecret(name, key, value string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      name,
+		},
+		Data: map[string][]byte{
+			key: []byte(value),
+		},
+	}
+}
+
+func testJob(condType batchv1.JobConditionType) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      agentimage.GetAgentImageJobName(testName),
+		},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{
+				Type:    condType,
+				Status:  corev1.ConditionTrue,
+				Message: "test message",
+			}},
+		},
+	}
+}
+
+func testClusterVersion(available configv1.ConditionStatus) *configv1.ClusterVersion {
+	return &configv1.ClusterVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "version"},
+		Spec:       configv1.ClusterVersionSpec{ClusterID: testClusterID},
+		Status: configv1.ClusterVersionStatus{
+			Conditions: []configv1.ClusterOperatorStatusCondition{
+				{Type: configv1.OperatorAvailable, Status: available},
+				{Type: configv1.OperatorProgressing, Status: configv1.ConditionTrue, Message: "Working towards 4.12.0"},
+			},
+		},
+	}
+}
+
+func testInfrastructure() *configv1.Infrastructure {
+	return &configv1.Infrastructure{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+		Status:     configv1.InfrastructureStatus{InfrastructureName: testInfraID},
+	}
+}
@@ -51,6 +51,7 @@ import (
 	hivemetrics "github.com/openshift/hive/pkg/controller/metrics"
 	controllerutils "github.com/openshift/hive/pkg/controller/utils"
 	"github.com/openshift/hive/pkg/imageset"
+	"github.com/openshift/hive/pkg/manageddns"
 	"github.com/openshift/hive/pkg/remoteclient"
 	k8slabels "github.com/openshift/hive/pkg/util/labels"
 )
@@ -72,6 +73,7 @@ var (
 		hivev1.AuthenticationFailureClusterDeploymentCondition,
 		hivev1.RequirementsMetCondition,
 		hivev1.ProvisionedCondition,
+		hivev1.PostInstallValidationFailedCondition,
 
 		// ClusterInstall conditions copied over to cluster deployment
 		hivev1.ClusterInstallFailedClusterDeploymentCondition,
@@ -106,6 +108,7 @@ const (
 	installAttemptsLimitReachedReason = "InstallAttemptsLimitReached"
 	installOnlyOnceSetReason          = "InstallOnlyOnceSet"
 	failureReasonNotListed            = "FailureReasonNotRetryable"
+	failureReasonRetriesExhausted     = "FailureReasonRetriesExhausted"
 	provisionNotStoppedReason         = "ProvisionNotStopped"
 
 	deleteAfterAnnotation    = "hive.openshift.io/delete-after" // contains a duration after which the cluster should be cleaned up.
@@ -156,6 +159,12 @@ func NewReconciler(mgr manager.Manager, logger log.FieldLogger, rateLimiter flow
 		r.protectedDelete = true
 	}
 
+	managedDomains, err := manageddns.ReadManagedDomainsFile()
+	if err != nil {
+		logger.WithError(err).Error("Unable to read managed domains file")
+	}
+	r.managedDomains = managedDomains
+
 	verifier, err := LoadReleaseImageVerifier(mgr.GetConfig())
 	if err == nil {
 		logger.Info("Release Image verification enabled")
@@ -279,6 +288,10 @@ type ReconcileClusterDeployment struct {
 	// Any error will prevent a release image from being accessed.
 	releaseImageVerifier verify.Interface
 
+	// managedDomains are the managed domains from the HiveConfig. Clusters on any platform can use the managed domains
+	// on DNS servers managed with RFC 2136 dynamic updates.
+	managedDomains []hivev1.ManageDNSConfig
+
 	protectedDelete bool
 }
 
@@ -1576,6 +1589,11 @@ func (r *ReconcileClusterDeployment) ensureManagedDNSZone(cd *hivev1.ClusterDepl
 	case p.AWS != nil:
 	case p.GCP != nil:
 	case p.Azure != nil:
+	case p.IBMCloud != nil:
+	case p.AlibabaCloud != nil:
+	case r.rfc2136ManagedDomain(cd.Spec.BaseDomain) != nil:
+		// The dnszone controller uses the TSIG key of the managed domain from the Hive namespace, so the key is not
+		// copied to the namespace of the cluster.
 	default:
 		cdLog.Error("cluster deployment platform does not support managed DNS")
 		if err := r.updateCondition(cd, hivev1.DNSNotReadyCondition, corev1.ConditionTrue, dnsUnsupportedPlatformReason, "Managed DNS is not supported on specified platform", cdLog); err != nil {
@@ -1749,6 +1767,24 @@ func (r *ReconcileClusterDeployment) createManagedDNSZone(cd *hivev1.ClusterDepl
 			ResourceGroupName:    cd.Spec.Platform.Azure.BaseDomainResourceGroupName,
 			CloudName:            cd.Spec.Platform.Azure.CloudName,
 		}
+	case cd.Spec.Platform.IBMCloud != nil:
+		dnsZone.Spec.IBMCloud = &hivev1.IBMCloudDNSZoneSpec{
+			CredentialsSecretRef: cd.Spec.Platform.IBMCloud.CredentialsSecretRef,
+			CISInstanceCRN:       cd.Spec.Platform.IBMCloud.CISInstanceCRN,
+		}
+	case cd.Spec.Platform.AlibabaCloud != nil:
+		dnsZone.Spec.AlibabaCloud = &hivev1.AlibabaCloudDNSZoneSpec{
+			CredentialsSecretRef: cd.Spec.Platform.AlibabaCloud.CredentialsSecretRef,
+			Region:               cd.Spec.Platform.AlibabaCloud.Region,
+		}
+	default:
+		if rfc2136 := r.rfc2136ManagedDomain(cd.Spec.BaseDomain); rfc2136 != nil {
+			dnsZone.Spec.RFC2136 = &hivev1.RFC2136DNSZoneSpec{
+				Server:        rfc2136.Server,
+				TSIGKeyName:   rfc2136.TSIGKeyName,
+				TSIGAlgorithm: rfc2136.TSIGAlgorithm,
+			}
+		}
 	}
 
 	logger.WithField("derivedObject", dnsZone.Name).Debug("Setting labels on derived object")
@@ -1768,6 +1804,22 @@ func (r *ReconcileClusterDeployment) createManagedDNSZone(cd *hivev1.ClusterDepl
 	return nil
 }
 
+// rfc2136ManagedDomain returns the settings of the managed domain on a DNS server managed with RFC 2136 dynamic updates
+// of which the base domain is a child, or nil if there is no such managed domain.
+func (r *ReconcileClusterDeployment) rfc2136ManagedDomain(baseDomain string) *hivev1.ManageDNSRFC2136Config {
+	for _, md := range r.managedDomains {
+		if md.RFC2136 == nil {
+			continue
+		}
+		for _, domain := range md.Domains {
+			if strings.HasSuffix(baseDomain, "."+domain) {
+				return md.RFC2136
+			}
+		}
+	}
+	return nil
+}
+
 func selectorPodWatchHandler(ctx context.Context, a client.Object) []reconcile.Request {
 	retval := []reconcile.Request{}
 
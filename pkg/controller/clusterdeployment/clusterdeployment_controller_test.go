@@ -15,6 +15,7 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/apis/hive/v1/alibabacloud"
 	hivev1aws "github.com/openshift/hive/apis/hive/v1/aws"
 	"github.com/openshift/hive/apis/hive/v1/azure"
 	"github.com/openshift/hive/apis/hive/v1/baremetal"
@@ -987,6 +988,84 @@ platform:
 				assert.Equal(t, azure.CloudEnvironment(""), zone.Spec.Azure.CloudName, "CloudName incorrectly set for DNSZone")
 			},
 		},
+		{
+			name: "Create DNSZone for Alibaba Cloud",
+			existing: []runtime.Object{
+				func() *hivev1.ClusterDeployment {
+					cd := testClusterDeploymentWithInitializedConditions(testClusterDeployment())
+					cd.Spec.Platform.AWS = nil
+					cd.Spec.Platform.AlibabaCloud = &alibabacloud.Platform{
+						CredentialsSecretRef: corev1.LocalObjectReference{Name: "alibaba-credentials"},
+						Region:               "cn-hangzhou",
+					}
+					cd.Labels[hivev1.HiveClusterPlatformLabel] = "alibabacloud"
+					cd.Labels[hivev1.HiveClusterRegionLabel] = "cn-hangzhou"
+					cd.Spec.ManageDNS = true
+					return cd
+				}(),
+				testSecret(corev1.SecretTypeDockerConfigJson, pullSecretSecret, corev1.DockerConfigJsonKey, "{}"),
+				testSecret(corev1.SecretTypeDockerConfigJson, constants.GetMergedPullSecretName(testClusterDeployment()), corev1.DockerConfigJsonKey, "{}"),
+			},
+			validate: func(c client.Client, t *testing.T) {
+				zone := getDNSZone(c)
+				require.NotNil(t, zone, "dns zone should exist")
+				assert.Equal(t, &hivev1.AlibabaCloudDNSZoneSpec{
+					CredentialsSecretRef: corev1.LocalObjectReference{Name: "alibaba-credentials"},
+					Region:               "cn-hangzhou",
+				}, zone.Spec.AlibabaCloud, "unexpected Alibaba Cloud settings of DNSZone")
+			},
+		},
+		{
+			name: "Create DNSZone in RFC 2136 managed domain",
+			existing: []runtime.Object{
+				func() *hivev1.ClusterDeployment {
+					cd := testClusterDeploymentWithInitializedConditions(testClusterDeployment())
+					cd.Spec.Platform.AWS = nil
+					cd.Spec.Platform.BareMetal = &baremetal.Platform{}
+					cd.Labels[hivev1.HiveClusterPlatformLabel] = "baremetal"
+					cd.Spec.ManageDNS = true
+					cd.Spec.BaseDomain = "mycluster.onprem.example.com"
+					return cd
+				}(),
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: controllerutils.GetHiveNamespace(), Name: "tsig-key"},
+					Data:       map[string][]byte{constants.TSIGSecretKey: []byte("c2VjcmV0")},
+				},
+				testSecret(corev1.SecretTypeDockerConfigJson, pullSecretSecret, corev1.DockerConfigJsonKey, "{}"),
+				testSecret(corev1.SecretTypeDockerConfigJson, constants.GetMergedPullSecretName(testClusterDeployment()), corev1.DockerConfigJsonKey, "{}"),
+			},
+			reconcilerSetup: func(r *ReconcileClusterDeployment) {
+				r.managedDomains = []hivev1.ManageDNSConfig{
+					{
+						AWS:     &hivev1.ManageDNSAWSConfig{},
+						Domains: []string{"example.com"},
+					},
+					{
+						RFC2136: &hivev1.ManageDNSRFC2136Config{
+							Server:        "ns1.example.com",
+							TSIGKeyName:   "hive",
+							TSIGAlgorithm: hivev1.TSIGAlgorithmHMACSHA512,
+							TSIGSecretRef: corev1.LocalObjectReference{Name: "tsig-key"},
+						},
+						Domains: []string{"onprem.example.com"},
+					},
+				}
+			},
+			validate: func(c client.Client, t *testing.T) {
+				zone := getDNSZone(c)
+				require.NotNil(t, zone, "dns zone should exist")
+				assert.Equal(t, &hivev1.RFC2136DNSZoneSpec{
+					Server:        "ns1.example.com",
+					TSIGKeyName:   "hive",
+					TSIGAlgorithm: hivev1.TSIGAlgorithmHMACSHA512,
+				}, zone.Spec.RFC2136, "unexpected RFC 2136 settings of DNSZone")
+				secrets := &corev1.SecretList{}
+				require.NoError(t, c.List(context.TODO(), secrets, client.InNamespace(testNamespace)))
+				for _, secret := range secrets.Items {
+					assert.NotContains(t, secret.Data, constants.TSIGSecretKey, "TSIG key should not be copied to the namespace of the cluster")
+				}
+			},
+		},
 		{
 			name: "Update DNSZone when PreserveOnDelete changes",
 			existing: []runtime.Object{
@@ -3059,6 +3138,100 @@ platform:
 				assert.Len(t, getProvisions(c), 1, "expected 1 ClusterProvision to exist")
 			},
 		},
+		{
+			name: "RetryPolicy: max retries exceeded: no retry",
+			existing: []runtime.Object{
+				testClusterDeploymentWithDefaultConditions(testClusterDeploymentWithInitializedConditions(testClusterDeployment())),
+				testProvision(tcp.WithFailureReason("aReason"), tcp.WithFailureClassification(&hivev1.ProvisionFailureClassification{
+					Matcher:             "aReason",
+					Category:            hivev1.ProvisionFailureCategoryQuota,
+					ConsecutiveFailures: 3,
+					RetryPolicy:         &hivev1.ProvisionRetryPolicy{MaxRetries: pointer.Int32(2)},
+				})),
+				testInstallConfigSecretAWS(),
+				testSecret(corev1.SecretTypeDockerConfigJson, pullSecretSecret, corev1.DockerConfigJsonKey, "{}"),
+				testSecret(corev1.SecretTypeDockerConfigJson, constants.GetMergedPullSecretName(testClusterDeployment()), corev1.DockerConfigJsonKey, "{}"),
+			},
+			validate: func(c client.Client, t *testing.T) {
+				if cd := getCD(c); assert.NotNil(t, cd, "no clusterdeployment found") {
+					if cond := controllerutils.FindCondition(cd.Status.Conditions, hivev1.ProvisionStoppedCondition); assert.NotNil(t, cond, "no ProvisionStopped condition") {
+						assert.Equal(t, corev1.ConditionTrue, cond.Status, "expected ProvisionStopped to be True")
+						assert.Equal(t, failureReasonRetriesExhausted, cond.Reason, "expected ProvisionStopped Reason to be FailureReasonRetriesExhausted")
+					}
+				}
+				assert.Len(t, getProvisions(c), 1, "expected 1 ClusterProvision to exist")
+			},
+		},
+		{
+			name: "RetryPolicy: max retries not exceeded: retry",
+			existing: []runtime.Object{
+				testClusterDeploymentWithDefaultConditions(testClusterDeploymentWithInitializedConditions(testClusterDeployment())),
+				testProvision(tcp.WithFailureReason("aReason"), tcp.WithFailureClassification(&hivev1.ProvisionFailureClassification{
+					Matcher:             "aReason",
+					Category:            hivev1.ProvisionFailureCategoryQuota,
+					ConsecutiveFailures: 2,
+					RetryPolicy:         &hivev1.ProvisionRetryPolicy{MaxRetries: pointer.Int32(2)},
+				})),
+				testInstallConfigSecretAWS(),
+				testSecret(corev1.SecretTypeDockerConfigJson, pullSecretSecret, corev1.DockerConfigJsonKey, "{}"),
+				testSecret(corev1.SecretTypeDockerConfigJson, constants.GetMergedPullSecretName(testClusterDeployment()), corev1.DockerConfigJsonKey, "{}"),
+			},
+			expectPendingCreation: true,
+			validate: func(c client.Client, t *testing.T) {
+				if cd := getCD(c); assert.NotNil(t, cd, "no clusterdeployment found") {
+					if cond := controllerutils.FindCondition(cd.Status.Conditions, hivev1.ProvisionStoppedCondition); assert.NotNil(t, cond, "no ProvisionStopped condition") {
+						assert.Equal(t, corev1.ConditionFalse, cond.Status, "expected ProvisionStopped to be False")
+					}
+				}
+				assert.Len(t, getProvisions(c), 2, "expected 2 ClusterProvisions to exist")
+			},
+		},
+		{
+			name: "RetryPolicy: backoff not elapsed: wait",
+			existing: []runtime.Object{
+				testClusterDeploymentWithDefaultConditions(testClusterDeploymentWithInitializedConditions(testClusterDeployment())),
+				testProvision(tcp.WithFailureTime(time.Now().Add(-10*time.Minute)), tcp.WithFailureClassification(&hivev1.ProvisionFailureClassification{
+					Category:            hivev1.ProvisionFailureCategoryInfrastructure,
+					ConsecutiveFailures: 1,
+					RetryPolicy:         &hivev1.ProvisionRetryPolicy{Backoff: &metav1.Duration{Duration: 30 * time.Minute}},
+				})),
+				testInstallConfigSecretAWS(),
+				testSecret(corev1.SecretTypeDockerConfigJson, pullSecretSecret, corev1.DockerConfigJsonKey, "{}"),
+				testSecret(corev1.SecretTypeDockerConfigJson, constants.GetMergedPullSecretName(testClusterDeployment()), corev1.DockerConfigJsonKey, "{}"),
+			},
+			expectedRequeueAfter: 20 * time.Minute,
+			validate: func(c client.Client, t *testing.T) {
+				assert.Len(t, getProvisions(c), 1, "expected 1 ClusterProvision to exist")
+			},
+		},
+		{
+			name: "RetryPolicy: change zone on retry: zone excluded from next provision",
+			existing: []runtime.Object{
+				testClusterDeploymentWithDefaultConditions(testClusterDeploymentWithInitializedConditions(testClusterDeployment())),
+				testProvision(tcp.WithFailureReason("AWSInsufficientCapacity"), tcp.WithExcludedZones("us-east-1a"), tcp.WithFailureClassification(&hivev1.ProvisionFailureClassification{
+					Matcher:             "AWSInsufficientCapacity",
+					Category:            hivev1.ProvisionFailureCategoryInfrastructure,
+					Zone:                "us-east-1b",
+					ConsecutiveFailures: 2,
+					RetryPolicy:         &hivev1.ProvisionRetryPolicy{ChangeZoneOnRetry: true},
+				})),
+				testInstallConfigSecretAWS(),
+				testSecret(corev1.SecretTypeDockerConfigJson, pullSecretSecret, corev1.DockerConfigJsonKey, "{}"),
+				testSecret(corev1.SecretTypeDockerConfigJson, constants.GetMergedPullSecretName(testClusterDeployment()), corev1.DockerConfigJsonKey, "{}"),
+			},
+			expectPendingCreation: true,
+			validate: func(c client.Client, t *testing.T) {
+				provisions := getProvisions(c)
+				if assert.Len(t, provisions, 2, "expected 2 ClusterProvisions to exist") {
+					for _, provision := range provisions {
+						if provision.Name == provisionName {
+							continue
+						}
+						assert.Equal(t, []string{"us-east-1a", "us-east-1b"}, provision.Spec.ExcludedZones, "unexpected excluded zones")
+					}
+				}
+			},
+		},
 		{
 			name: "RetryReasons: no provision yet: list ignored, provision created",
 			existing: []runtime.Object{
@@ -4167,6 +4340,87 @@ func TestCopyInstallLogSecret(t *testing.T) {
 	}
 }
 
+func TestGetInstallLogEnvVars(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          string
+		expectedEnvVars map[string]string
+	}{
+		{
+			name:            "not configured",
+			config:          "",
+			expectedEnvVars: map[string]string{},
+		},
+		{
+			name:   "s3 compatible",
+			config: `{"aws": {"credentialsSecretRef": {"name": "creds"}, "serviceEndpoint": "https://minio.example.com", "bucket": "logs"}}`,
+			expectedEnvVars: map[string]string{
+				constants.InstallLogsUploadProviderEnvVar:       constants.InstallLogsUploadProviderAWS,
+				constants.InstallLogsCredentialsSecretRefEnvVar: "prefix-creds",
+				constants.InstallLogsAWSRegionEnvVar:            "",
+				constants.InstallLogsAWSServiceEndpointEnvVar:   "https://minio.example.com",
+				constants.InstallLogsAWSS3BucketEnvVar:          "logs",
+			},
+		},
+		{
+			name:   "gcs",
+			config: `{"gcp": {"credentialsSecretRef": {"name": "creds"}, "bucket": "logs"}}`,
+			expectedEnvVars: map[string]string{
+				constants.InstallLogsUploadProviderEnvVar:       constants.InstallLogsUploadProviderGCP,
+				constants.InstallLogsCredentialsSecretRefEnvVar: "prefix-creds",
+				constants.InstallLogsGCPBucketEnvVar:            "logs",
+			},
+		},
+		{
+			name:   "azure blob",
+			config: `{"azure": {"credentialsSecretRef": {"name": "creds"}, "storageAccountName": "account", "container": "logs", "cloudName": "AzureUSGovernmentCloud"}}`,
+			expectedEnvVars: map[string]string{
+				constants.InstallLogsUploadProviderEnvVar:       constants.InstallLogsUploadProviderAzure,
+				constants.InstallLogsCredentialsSecretRefEnvVar: "prefix-creds",
+				constants.InstallLogsAzureStorageAccountEnvVar:  "account",
+				constants.InstallLogsAzureContainerEnvVar:       "logs",
+				constants.InstallLogsAzureCloudNameEnvVar:       "AzureUSGovernmentCloud",
+			},
+		},
+		{
+			name:   "archive",
+			config: `{"gcp": {"credentialsSecretRef": {"name": "creds"}, "bucket": "logs"}, "archive": {}}`,
+			expectedEnvVars: map[string]string{
+				constants.InstallLogsUploadProviderEnvVar:       constants.InstallLogsUploadProviderGCP,
+				constants.InstallLogsCredentialsSecretRefEnvVar: "prefix-creds",
+				constants.InstallLogsGCPBucketEnvVar:            "logs",
+				constants.InstallLogsArchiveEnvVar:              "true",
+			},
+		},
+		{
+			name:            "archive without store",
+			config:          `{"archive": {}}`,
+			expectedEnvVars: map[string]string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if prev, found := os.LookupEnv(constants.FailedProvisionConfigFileEnvVar); found {
+				defer os.Setenv(constants.FailedProvisionConfigFileEnvVar, prev)
+			} else {
+				defer os.Unsetenv(constants.FailedProvisionConfigFileEnvVar)
+			}
+			os.Setenv(constants.FailedProvisionConfigFileEnvVar, "fake")
+			defer func(prev func(string) ([]byte, error)) { readFile = prev }(readFile)
+			readFile = fakeReadFile(test.config)
+
+			envVars, err := getInstallLogEnvVars("prefix")
+			require.NoError(t, err, "unexpected error getting install log env vars")
+
+			actual := map[string]string{}
+			for _, envVar := range envVars {
+				actual[envVar.Name] = envVar.Value
+			}
+			assert.Equal(t, test.expectedEnvVars, actual, "unexpected install log env vars")
+		})
+	}
+}
+
 func TestEnsureManagedDNSZone(t *testing.T) {
 
 	goodDNSZone := func() *hivev1.DNSZone {
@@ -116,6 +116,13 @@ func (r *ReconcileClusterDeployment) startNewProvision(
 	if !shouldRetry {
 		return setProvisionStoppedTrue(failureReasonNotListed, "Provision failure reason not retryable")
 	}
+	retryPolicy := failureRetryPolicy(lastFailedProvision)
+	if retryPolicy != nil && retryPolicy.MaxRetries != nil {
+		if failures := lastFailedProvision.Status.FailureClassification.ConsecutiveFailures; failures > *retryPolicy.MaxRetries {
+			return setProvisionStoppedTrue(failureReasonRetriesExhausted,
+				fmt.Sprintf("Provision failed %d consecutive times with a reason that is retried at most %d times", failures, *retryPolicy.MaxRetries))
+		}
+	}
 
 	conditions, changed := controllerutils.SetClusterDeploymentConditionWithChangeCheck(
 		cd.Status.Conditions,
@@ -134,6 +141,15 @@ func (r *ReconcileClusterDeployment) startNewProvision(
 		return reconcile.Result{}, nil
 	}
 
+	if retryPolicy != nil && retryPolicy.Backoff != nil {
+		if cond := controllerutils.FindCondition(lastFailedProvision.Status.Conditions, hivev1.ClusterProvisionFailedCondition); cond != nil {
+			if wait := time.Until(cond.LastTransitionTime.Add(retryPolicy.Backoff.Duration)); wait > 0 {
+				logger.WithField("backoff", retryPolicy.Backoff.Duration).Infof("waiting %v before retrying failed provision", wait)
+				return reconcile.Result{RequeueAfter: wait}, nil
+			}
+		}
+	}
+
 	if err := controllerutils.SetupClusterInstallServiceAccount(r, cd.Namespace, logger); err != nil {
 		logger.WithError(err).Log(controllerutils.LogLevel(err), "error setting up service account and role")
 		return reconcile.Result{}, err
@@ -191,6 +207,7 @@ func (r *ReconcileClusterDeployment) startNewProvision(
 		provision.Spec.PrevProvisionName = &lastFailedProvision.Name
 		provision.Spec.PrevClusterID = lastFailedProvision.Spec.ClusterID
 		provision.Spec.PrevInfraID = lastFailedProvision.Spec.InfraID
+		provision.Spec.ExcludedZones = excludedZones(lastFailedProvision)
 	}
 
 	logger.WithField("derivedObject", provision.Name).Debug("Setting label on derived object")
@@ -285,6 +302,32 @@ func (r *ReconcileClusterDeployment) shouldRetryBasedOnFailureReason(prov *hivev
 	return false, nil
 }
 
+// failureRetryPolicy returns the retry policy for the failure of the provision, if any.
+func failureRetryPolicy(prov *hivev1.ClusterProvision) *hivev1.ProvisionRetryPolicy {
+	if prov == nil || prov.Status.FailureClassification == nil {
+		return nil
+	}
+	return prov.Status.FailureClassification.RetryPolicy
+}
+
+// excludedZones returns the zones the provision following the failed provision must not use: those excluded from the
+// failed provision, plus the zone it failed in if its retry policy changes zones on retry.
+func excludedZones(prov *hivev1.ClusterProvision) []string {
+	var zones []string
+	zones = append(zones, prov.Spec.ExcludedZones...)
+	classification := prov.Status.FailureClassification
+	if classification == nil || classification.Zone == "" ||
+		classification.RetryPolicy == nil || !classification.RetryPolicy.ChangeZoneOnRetry {
+		return zones
+	}
+	for _, z := range zones {
+		if z == classification.Zone {
+			return zones
+		}
+	}
+	return append(zones, classification.Zone)
+}
+
 // setAWSHostedZoneRoleFromMetadata unmarshals `pm.Raw`, a representation of the installer ClusterMetadata type,
 // and looks for the AWS HostedZoneRole therein. If found, the value is copied into the AWS platform-specific
 // section of `cm`, hive's representation of the cluster metadata. The `cd` is only used to validate that we're
@@ -565,6 +608,15 @@ func (r *ReconcileClusterDeployment) reconcileFailedProvision(cd *hivev1.Cluster
 }
 
 func (r *ReconcileClusterDeployment) reconcileCompletedProvision(cd *hivev1.ClusterDeployment, provision *hivev1.ClusterProvision, cdLog log.FieldLogger) (reconcile.Result, error) {
+	// Only ClusterDeployments provisioned by Hive have post install validation: it is configured in spec.provisioning,
+	// which the webhook forbids along with a ClusterInstallRef. Fake clusters have nothing to validate.
+	if !cd.Spec.Installed && cd.Spec.Provisioning != nil && cd.Spec.Provisioning.PostInstallValidation != nil &&
+		!controllerutils.IsFakeCluster(cd) {
+		if passed, result, err := r.validatePostInstall(cd, provision, cdLog); !passed {
+			return result, err
+		}
+	}
+
 	cdLog.Info("provision completed successfully")
 
 	statusChange := false
@@ -743,6 +795,51 @@ func getInstallLogEnvVars(secretPrefix string) ([]corev1.EnvVar, error) {
 				Value: awsSpec.Bucket,
 			},
 		}
+	} else if gcpSpec := fpConfig.GCP; gcpSpec != nil {
+		extraEnvVars = []corev1.EnvVar{
+			{
+				Name:  constants.InstallLogsUploadProviderEnvVar,
+				Value: constants.InstallLogsUploadProviderGCP,
+			},
+			{
+				Name:  constants.InstallLogsCredentialsSecretRefEnvVar,
+				Value: secretPrefix + "-" + gcpSpec.CredentialsSecretRef.Name,
+			},
+			{
+				Name:  constants.InstallLogsGCPBucketEnvVar,
+				Value: gcpSpec.Bucket,
+			},
+		}
+	} else if azureSpec := fpConfig.Azure; azureSpec != nil {
+		extraEnvVars = []corev1.EnvVar{
+			{
+				Name:  constants.InstallLogsUploadProviderEnvVar,
+				Value: constants.InstallLogsUploadProviderAzure,
+			},
+			{
+				Name:  constants.InstallLogsCredentialsSecretRefEnvVar,
+				Value: secretPrefix + "-" + azureSpec.CredentialsSecretRef.Name,
+			},
+			{
+				Name:  constants.InstallLogsAzureStorageAccountEnvVar,
+				Value: azureSpec.StorageAccountName,
+			},
+			{
+				Name:  constants.InstallLogsAzureContainerEnvVar,
+				Value: azureSpec.Container,
+			},
+			{
+				Name:  constants.InstallLogsAzureCloudNameEnvVar,
+				Value: azureSpec.CloudName.Name(),
+			},
+		}
+	}
+
+	if fpConfig.Archive != nil && len(extraEnvVars) > 0 {
+		extraEnvVars = append(extraEnvVars, corev1.EnvVar{
+			Name:  constants.InstallLogsArchiveEnvVar,
+			Value: "true",
+		})
 	}
 
 	return extraEnvVars, nil
@@ -0,0 +1,288 @@
+package clusterdeployment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+)
+
+const (
+	defaultPostInstallValidationTimeout = 30 * time.Minute
+	postInstallValidationRecheckTime    = 30 * time.Second
+
+	postInstallValidationJobName             = "hive-post-install-validation"
+	postInstallValidationJobDefaultNamespace = "default"
+
+	postInstallValidationPendingReason   = "Validating"
+	postInstallValidationSucceededReason = "ValidationSucceeded"
+	postInstallValidationTimedOutReason  = "ValidationTimedOut"
+	postInstallValidationJobFailedReason = "ValidationJobFailed"
+
+	// postInstallValidationFailedReason is the reason of the ClusterProvisionFailed condition of provisions failed by
+	// their post install validation.
+	postInstallValidationFailedReason = "PostInstallValidationFailed"
+)
+
+// validatePostInstall runs the checks of the post install validation of the cluster installed by the completed
+// provision, and returns true once they all pass. Until then, the Provisioned condition lists the checks that are
+// still pending. If the checks do not pass within the timeout, or the validation Job fails, the provision is failed
+// so that it is retried like any other failed provision.
+func (r *ReconcileClusterDeployment) validatePostInstall(cd *hivev1.ClusterDeployment, provision *hivev1.ClusterProvision, cdLog log.FieldLogger) (bool, reconcile.Result, error) {
+	validation := cd.Spec.Provisioning.PostInstallValidation
+	pending, jobFailure, err := r.pendingPostInstallChecks(cd, validation, cdLog)
+	if err != nil {
+		return false, reconcile.Result{}, err
+	}
+
+	if jobFailure != "" {
+		return false, reconcile.Result{}, r.failPostInstallValidation(cd, provision, postInstallValidationJobFailedReason, jobFailure, cdLog)
+	}
+
+	if len(pending) == 0 {
+		cdLog.Info("post install validation passed")
+		if err := r.updateCondition(
+			cd,
+			hivev1.PostInstallValidationFailedCondition,
+			corev1.ConditionFalse,
+			postInstallValidationSucceededReason,
+			"Post install validation passed",
+			cdLog,
+		); err != nil {
+			cdLog.WithError(err).Log(controllerutils.LogLevel(err), "could not update PostInstallValidationFailedCondition")
+			return false, reconcile.Result{}, err
+		}
+		return true, reconcile.Result{}, nil
+	}
+
+	message := fmt.Sprintf("Waiting for post install validation: %s", strings.Join(pending, "; "))
+	timeout := defaultPostInstallValidationTimeout
+	if validation.Timeout != nil {
+		timeout = validation.Timeout.Duration
+	}
+	startTime := provision.CreationTimestamp.Time
+	if completedCond := controllerutils.FindCondition(provision.Status.Conditions, hivev1.ClusterProvisionCompletedCondition); completedCond != nil {
+		startTime = completedCond.LastTransitionTime.Time
+	}
+	remaining := time.Until(startTime.Add(timeout))
+	if remaining <= 0 {
+		return false, reconcile.Result{}, r.failPostInstallValidation(cd, provision, postInstallValidationTimedOutReason,
+			fmt.Sprintf("Post install validation did not pass within %s: %s", timeout, strings.Join(pending, "; ")), cdLog)
+	}
+
+	cdLog.WithField("pending", pending).Info("waiting for post install validation to pass")
+	conds, changed1 := controllerutils.SetClusterDeploymentConditionWithChangeCheck(
+		cd.Status.Conditions,
+		hivev1.PostInstallValidationFailedCondition,
+		corev1.ConditionFalse,
+		postInstallValidationPendingReason,
+		message,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+	)
+	conds, changed2 := controllerutils.SetClusterDeploymentConditionWithChangeCheck(
+		conds,
+		hivev1.ProvisionedCondition,
+		corev1.ConditionFalse,
+		hivev1.ProvisionedReasonValidating,
+		message,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+	)
+	if changed1 || changed2 {
+		cd.Status.Conditions = conds
+		if err := r.Status().Update(context.TODO(), cd); err != nil {
+			cdLog.WithError(err).Log(controllerutils.LogLevel(err), "failed to update cluster deployment status")
+			return false, reconcile.Result{}, err
+		}
+	}
+
+	if remaining > postInstallValidationRecheckTime {
+		remaining = postInstallValidationRecheckTime
+	}
+	return false, reconcile.Result{RequeueAfter: remaining}, nil
+}
+
+// pendingPostInstallChecks returns a message for each check of the post install validation that has not passed
+// yet, and the failure of the validation Job, if it failed.
+func (r *ReconcileClusterDeployment) pendingPostInstallChecks(cd *hivev1.ClusterDeployment, validation *hivev1.PostInstallValidation, cdLog log.FieldLogger) ([]string, string, error) {
+	remoteClient, err := r.remoteClusterAPIClientBuilder(cd).Build()
+	if err != nil {
+		cdLog.WithError(err).Info("cluster is not reachable for post install validation")
+		return []string{fmt.Sprintf("cluster is not reachable: %v", err)}, "", nil
+	}
+
+	var pending []string
+	if validation.ClusterOperatorsAvailable {
+		if msg, err := controllerutils.ClusterOperatorsNotAvailable(remoteClient); err != nil {
+			cdLog.WithError(err).Log(controllerutils.LogLevel(err), "could not check ClusterOperators")
+			pending = append(pending, err.Error())
+		} else if msg != "" {
+			pending = append(pending, msg)
+		}
+	}
+	if validation.MachinePoolNodes {
+		expected, err := r.expectedComputeNodes(cd)
+		if err != nil {
+			cdLog.WithError(err).Log(controllerutils.LogLevel(err), "could not list MachinePools")
+			return nil, "", err
+		}
+		if ready, err := readyComputeNodes(remoteClient); err != nil {
+			cdLog.WithError(err).Log(controllerutils.LogLevel(err), "could not check nodes")
+			pending = append(pending, err.Error())
+		} else if ready < expected {
+			pending = append(pending, fmt.Sprintf("%d of %d compute nodes are ready", ready, expected))
+		}
+	}
+	if validation.Job != nil {
+		msg, failure, err := ensurePostInstallValidationJob(remoteClient, validation.Job, cdLog)
+		if err != nil {
+			cdLog.WithError(err).Log(controllerutils.LogLevel(err), "could not check validation Job")
+			pending = append(pending, err.Error())
+		} else if failure != "" {
+			return nil, failure, nil
+		} else if msg != "" {
+			pending = append(pending, msg)
+		}
+	}
+	return pending, "", nil
+}
+
+// failPostInstallValidation fails the provision whose post install validation did not pass.
+func (r *ReconcileClusterDeployment) failPostInstallValidation(cd *hivev1.ClusterDeployment, provision *hivev1.ClusterProvision, reason, message string, cdLog log.FieldLogger) error {
+	cdLog.WithField("reason", reason).Warn("post install validation failed, failing provision")
+	if err := r.updateCondition(cd, hivev1.PostInstallValidationFailedCondition, corev1.ConditionTrue, reason, message, cdLog); err != nil {
+		cdLog.WithError(err).Log(controllerutils.LogLevel(err), "could not update PostInstallValidationFailedCondition")
+		return err
+	}
+	provision.Status.Conditions = controllerutils.SetClusterProvisionCondition(
+		provision.Status.Conditions,
+		hivev1.ClusterProvisionFailedCondition,
+		corev1.ConditionTrue,
+		postInstallValidationFailedReason,
+		message,
+		controllerutils.UpdateConditionAlways,
+	)
+	if err := r.Status().Update(context.TODO(), provision); err != nil {
+		cdLog.WithError(err).Log(controllerutils.LogLevel(err), "could not update provision status")
+		return err
+	}
+	provision.Spec.Stage = hivev1.ClusterProvisionStageFailed
+	if err := r.Update(context.TODO(), provision); err != nil {
+		cdLog.WithError(err).Log(controllerutils.LogLevel(err), "could not fail provision")
+		return err
+	}
+	return nil
+}
+
+// expectedComputeNodes returns the number of compute nodes the MachinePools of the ClusterDeployment ask for.
+func (r *ReconcileClusterDeployment) expectedComputeNodes(cd *hivev1.ClusterDeployment) (int, error) {
+	mpList := &hivev1.MachinePoolList{}
+	if err := r.List(context.TODO(), mpList, client.InNamespace(cd.Namespace)); err != nil {
+		return 0, err
+	}
+	expected := 0
+	for _, mp := range mpList.Items {
+		if mp.Spec.ClusterDeploymentRef.Name != cd.Name {
+			continue
+		}
+		switch {
+		case mp.Spec.Autoscaling != nil:
+			expected += int(mp.Spec.Autoscaling.MinReplicas)
+		case mp.Spec.Replicas != nil:
+			expected += int(*mp.Spec.Replicas)
+		}
+	}
+	return expected, nil
+}
+
+// readyComputeNodes returns the number of Ready nodes of the cluster that are not control plane nodes.
+func readyComputeNodes(remoteClient client.Client) (int, error) {
+	nodeList := &corev1.NodeList{}
+	if err := remoteClient.List(context.TODO(), nodeList); err != nil {
+		return 0, errors.Wrap(err, "failed to list nodes")
+	}
+	ready := 0
+	for _, node := range nodeList.Items {
+		if _, ok := node.Labels["node-role.kubernetes.io/master"]; ok {
+			continue
+		}
+		if _, ok := node.Labels["node-role.kubernetes.io/control-plane"]; ok {
+			continue
+		}
+		for _, cond := range node.Status.Conditions {
+			if cond.Type == corev1.NodeReady && cond.Status == corev1.ConditionTrue {
+				ready++
+			}
+		}
+	}
+	return ready, nil
+}
+
+// ensurePostInstallValidationJob creates the validation Job on the cluster if it does not exist yet. It returns a
+// message if the Job has not completed yet, and the failure of the Job if it failed.
+func ensurePostInstallValidationJob(remoteClient client.Client, jobSpec *hivev1.PostInstallValidationJob, cdLog log.FieldLogger) (string, string, error) {
+	namespace := jobSpec.Namespace
+	if namespace == "" {
+		namespace = postInstallValidationJobDefaultNamespace
+	}
+	job := &batchv1.Job{}
+	switch err := remoteClient.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: postInstallValidationJobName}, job); {
+	case apierrors.IsNotFound(err):
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+		if err := remoteClient.Create(context.TODO(), ns); err != nil && !apierrors.IsAlreadyExists(err) {
+			return "", "", errors.Wrap(err, "failed to create namespace of validation Job")
+		}
+		job = &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      postInstallValidationJobName,
+			},
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						RestartPolicy:      corev1.RestartPolicyNever,
+						ServiceAccountName: jobSpec.ServiceAccountName,
+						Containers: []corev1.Container{{
+							Name:    "validation",
+							Image:   jobSpec.Image,
+							Command: jobSpec.Command,
+							Args:    jobSpec.Args,
+						}},
+					},
+				},
+			},
+		}
+		if err := remoteClient.Create(context.TODO(), job); err != nil {
+			return "", "", errors.Wrap(err, "failed to create validation Job")
+		}
+		cdLog.WithField("namespace", namespace).Info("created post install validation Job")
+		return "validation Job has not completed", "", nil
+	case err != nil:
+		return "", "", errors.Wrap(err, "failed to get validation Job")
+	}
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return "", "", nil
+		case batchv1.JobFailed:
+			return "", fmt.Sprintf("Validation Job %s/%s failed: %s", namespace, postInstallValidationJobName, cond.Message), nil
+		}
+	}
+	return "validation Job has not completed", "", nil
+}
@@ -0,0 +1,306 @@
+package clusterdeployment
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	configv1 "github.com/openshift/api/config/v1"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/constants"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+	"github.com/openshift/hive/pkg/remoteclient"
+	remoteclientmock "github.com/openshift/hive/pkg/remoteclient/mock"
+	tcp "github.com/openshift/hive/pkg/test/clusterprovision"
+	testfake "github.com/openshift/hive/pkg/test/fake"
+	"github.com/openshift/hive/pkg/util/scheme"
+)
+
+func TestPostInstallValidation(t *testing.T) {
+	tests := []struct {
+		name                  string
+		validation            hivev1.PostInstallValidation
+		completedAgo          time.Duration
+		remoteObjects         []runtime.Object
+		unreachable           bool
+		expectInstalled       bool
+		expectProvisionFailed bool
+		expectReason          string
+		expectMessage         string
+		expectRequeueAfter    time.Duration
+		validateRemote        func(*testing.T, client.Client)
+	}{
+		{
+			name:       "operators available",
+			validation: hivev1.PostInstallValidation{ClusterOperatorsAvailable: true},
+			remoteObjects: []runtime.Object{
+				testClusterOperator("authentication", true, false),
+				testClusterOperator("ingress", true, false),
+			},
+			expectInstalled: true,
+			expectReason:    postInstallValidationSucceededReason,
+		},
+		{
+			name:       "operators degraded",
+			validation: hivev1.PostInstallValidation{ClusterOperatorsAvailable: true},
+			remoteObjects: []runtime.Object{
+				testClusterOperator("authentication", true, false),
+				testClusterOperator("ingress", true, true),
+				testClusterOperator("monitoring", false, false),
+			},
+			expectReason:       postInstallValidationPendingReason,
+			expectMessage:      "Waiting for post install validation: ClusterOperators not available or degraded: ingress, monitoring",
+			expectRequeueAfter: postInstallValidationRecheckTime,
+		},
+		{
+			name:               "cluster unreachable",
+			validation:         hivev1.PostInstallValidation{ClusterOperatorsAvailable: true},
+			unreachable:        true,
+			expectReason:       postInstallValidationPendingReason,
+			expectMessage:      "Waiting for post install validation: cluster is not reachable: connection refused",
+			expectRequeueAfter: postInstallValidationRecheckTime,
+		},
+		{
+			name:       "machine pool nodes ready",
+			validation: hivev1.PostInstallValidation{MachinePoolNodes: true},
+			remoteObjects: []runtime.Object{
+				testNode("master-0", "master", true),
+				testNode("worker-0", "worker", true),
+				testNode("worker-1", "worker", true),
+				testNode("worker-2", "worker", true),
+			},
+			expectInstalled: true,
+			expectReason:    postInstallValidationSucceededReason,
+		},
+		{
+			name:       "machine pool nodes not ready",
+			validation: hivev1.PostInstallValidation{MachinePoolNodes: true},
+			remoteObjects: []runtime.Object{
+				testNode("master-0", "master", true),
+				testNode("master-1", "master", true),
+				testNode("worker-0", "worker", true),
+				testNode("worker-1", "worker", false),
+			},
+			expectReason:       postInstallValidationPendingReason,
+			expectMessage:      "Waiting for post install validation: 1 of 3 compute nodes are ready",
+			expectRequeueAfter: postInstallValidationRecheckTime,
+		},
+		{
+			name: "validation job created",
+			validation: hivev1.PostInstallValidation{Job: &hivev1.PostInstallValidationJob{
+				Namespace: "validation",
+				Image:     "validator:latest",
+				Args:      []string{"--all"},
+			}},
+			expectReason:       postInstallValidationPendingReason,
+			expectMessage:      "Waiting for post install validation: validation Job has not completed",
+			expectRequeueAfter: postInstallValidationRecheckTime,
+			validateRemote: func(t *testing.T, c client.Client) {
+				require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: "validation"}, &corev1.Namespace{}), "expected validation namespace")
+				job := &batchv1.Job{}
+				require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: "validation", Name: postInstallValidationJobName}, job), "expected validation job")
+				require.Len(t, job.Spec.Template.Spec.Containers, 1, "unexpected containers")
+				assert.Equal(t, "validator:latest", job.Spec.Template.Spec.Containers[0].Image, "unexpected image")
+				assert.Equal(t, []string{"--all"}, job.Spec.Template.Spec.Containers[0].Args, "unexpected args")
+			},
+		},
+		{
+			name:            "validation job completed",
+			validation:      hivev1.PostInstallValidation{Job: &hivev1.PostInstallValidationJob{Image: "validator:latest"}},
+			remoteObjects:   []runtime.Object{testValidationJob(batchv1.JobComplete, "")},
+			expectInstalled: true,
+			expectReason:    postInstallValidationSucceededReason,
+		},
+		{
+			name:                  "validation job failed",
+			validation:            hivev1.PostInstallValidation{Job: &hivev1.PostInstallValidationJob{Image: "validator:latest"}},
+			remoteObjects:         []runtime.Object{testValidationJob(batchv1.JobFailed, "BackoffLimitExceeded")},
+			expectProvisionFailed: true,
+			expectReason:          postInstallValidationJobFailedReason,
+			expectMessage:         "Validation Job default/hive-post-install-validation failed: BackoffLimitExceeded",
+		},
+		{
+			name:                  "timed out",
+			validation:            hivev1.PostInstallValidation{ClusterOperatorsAvailable: true},
+			completedAgo:          time.Hour,
+			remoteObjects:         []runtime.Object{testClusterOperator("ingress", false, false)},
+			expectProvisionFailed: true,
+			expectReason:          postInstallValidationTimedOutReason,
+			expectMessage:         "Post install validation did not pass within 30m0s: ClusterOperators not available or degraded: ingress",
+		},
+		{
+			name: "custom timeout",
+			validation: hivev1.PostInstallValidation{
+				ClusterOperatorsAvailable: true,
+				Timeout:                   &metav1.Duration{Duration: 2 * time.Hour},
+			},
+			completedAgo:       time.Hour + 59*time.Minute + 50*time.Second,
+			remoteObjects:      []runtime.Object{testClusterOperator("ingress", false, false)},
+			expectReason:       postInstallValidationPendingReason,
+			expectRequeueAfter: 10 * time.Second,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			readFile = fakeReadFile("")
+			cd := testClusterDeploymentWithInitializedConditions(testClusterDeploymentWithProvision())
+			cd.Spec.Provisioning.PostInstallValidation = &test.validation
+			completedAt := metav1.NewTime(time.Now().Add(-test.completedAgo))
+			provision := testSuccessfulProvision(
+				tcp.WithMetadata(`{"aws": {"hostedZoneRole": "account-b-role"}}`),
+				func(p *hivev1.ClusterProvision) {
+					p.Status.Conditions = []hivev1.ClusterProvisionCondition{{
+						Type:               hivev1.ClusterProvisionCompletedCondition,
+						Status:             corev1.ConditionTrue,
+						Reason:             "InstallComplete",
+						LastTransitionTime: completedAt,
+					}}
+				},
+			)
+			workerPool := &hivev1.MachinePool{
+				ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testName + "-worker"},
+				Spec: hivev1.MachinePoolSpec{
+					ClusterDeploymentRef: corev1.LocalObjectReference{Name: testName},
+					Name:                 "worker",
+					Replicas:             pointer.Int64(3),
+				},
+			}
+			c := testfake.NewFakeClientBuilder().WithRuntimeObjects(
+				cd,
+				provision,
+				workerPool,
+				testInstallConfigSecretAWS(),
+				testMetadataConfigMap(),
+				testSecret(corev1.SecretTypeOpaque, adminKubeconfigSecret, "kubeconfig", adminKubeconfig),
+				testSecret(corev1.SecretTypeDockerConfigJson, pullSecretSecret, corev1.DockerConfigJsonKey, "{}"),
+				testSecret(corev1.SecretTypeDockerConfigJson, constants.GetMergedPullSecretName(testClusterDeployment()), corev1.DockerConfigJsonKey, "{}"),
+			).Build()
+			remoteClient := testfake.NewFakeClientBuilder().WithRuntimeObjects(test.remoteObjects...).Build()
+
+			mockCtrl := gomock.NewController(t)
+			mockRemoteClientBuilder := remoteclientmock.NewMockBuilder(mockCtrl)
+			if test.unreachable {
+				mockRemoteClientBuilder.EXPECT().Build().Return(nil, fmt.Errorf("connection refused"))
+			} else {
+				mockRemoteClientBuilder.EXPECT().Build().Return(remoteClient, nil)
+			}
+			logger := log.WithField("controller", "clusterDeployment")
+			r := &ReconcileClusterDeployment{
+				Client:                        c,
+				scheme:                        scheme.GetScheme(),
+				logger:                        logger,
+				expectations:                  controllerutils.NewExpectations(logger),
+				remoteClusterAPIClientBuilder: func(*hivev1.ClusterDeployment) remoteclient.Builder { return mockRemoteClientBuilder },
+				validateCredentialsForClusterDeployment: func(client.Client, *hivev1.ClusterDeployment, log.FieldLogger) (bool, error) {
+					return true, nil
+				},
+			}
+
+			result, err := r.Reconcile(context.TODO(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: testName},
+			})
+			require.NoError(t, err, "unexpected error from reconcile")
+			assert.InDelta(t, test.expectRequeueAfter, result.RequeueAfter, float64(5*time.Second), "unexpected requeue after")
+
+			cd = &hivev1.ClusterDeployment{}
+			require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: testName}, cd))
+			assert.Equal(t, test.expectInstalled, cd.Spec.Installed, "unexpected installed")
+			cond := controllerutils.FindCondition(cd.Status.Conditions, hivev1.PostInstallValidationFailedCondition)
+			if assert.NotNil(t, cond, "expected PostInstallValidationFailed condition") {
+				expectStatus := corev1.ConditionFalse
+				if test.expectProvisionFailed {
+					expectStatus = corev1.ConditionTrue
+				}
+				assert.Equal(t, expectStatus, cond.Status, "unexpected PostInstallValidationFailed status")
+				assert.Equal(t, test.expectReason, cond.Reason, "unexpected PostInstallValidationFailed reason")
+				if test.expectMessage != "" {
+					assert.Equal(t, test.expectMessage, cond.Message, "unexpected PostInstallValidationFailed message")
+				}
+			}
+			provisioned := controllerutils.FindCondition(cd.Status.Conditions, hivev1.ProvisionedCondition)
+			switch {
+			case test.expectInstalled:
+				assert.Equal(t, hivev1.ProvisionedReasonProvisioned, provisioned.Reason, "unexpected Provisioned reason")
+			case !test.expectProvisionFailed:
+				assert.Equal(t, hivev1.ProvisionedReasonValidating, provisioned.Reason, "unexpected Provisioned reason")
+			}
+
+			provision = &hivev1.ClusterProvision{}
+			require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: provisionName}, provision))
+			if test.expectProvisionFailed {
+				assert.Equal(t, hivev1.ClusterProvisionStageFailed, provision.Spec.Stage, "expected provision to be failed")
+				failedCond := controllerutils.FindCondition(provision.Status.Conditions, hivev1.ClusterProvisionFailedCondition)
+				if assert.NotNil(t, failedCond, "expected ClusterProvisionFailed condition") {
+					assert.Equal(t, corev1.ConditionTrue, failedCond.Status, "unexpected ClusterProvisionFailed status")
+					assert.Equal(t, postInstallValidationFailedReason, failedCond.Reason, "unexpected ClusterProvisionFailed reason")
+				}
+			} else {
+				assert.Equal(t, hivev1.ClusterProvisionStageComplete, provision.Spec.Stage, "unexpected provision stage")
+			}
+
+			if test.validateRemote != nil {
+				test.validateRemote(t, remoteClient)
+			}
+		})
+	}
+}
+
+func testClusterOperator(name string, available, degraded bool) *configv1.ClusterOperator {
+	status := func(b bool) configv1.ConditionStatus {
+		if b {
+			return configv1.ConditionTrue
+		}
+		return configv1.ConditionFalse
+	}
+	return &configv1.ClusterOperator{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: configv1.ClusterOperatorStatus{
+			Conditions: []configv1.ClusterOperatorStatusCondition{
+				{Type: configv1.OperatorAvailable, Status: status(available)},
+				{Type: configv1.OperatorDegraded, Status: status(degraded)},
+			},
+		},
+	}
+}
+
+func testNode(name, role string, ready bool) *corev1.Node {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"node-role.kubernetes.io/" + role: ""},
+		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}},
+		},
+	}
+}
+
+func testValidationJob(conditionType batchv1.JobConditionType, message string) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: postInstallValidationJobDefaultNamespace,
+			Name:      postInstallValidationJobName,
+		},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{Type: conditionType, Status: corev1.ConditionTrue, Message: message}},
+		},
+	}
+}
@@ -761,6 +761,7 @@ func (r *ReconcileClusterPool) createCluster(
 		InstallConfigTemplate: installConfigTemplate,
 		InstallAttemptsLimit:  clp.Spec.InstallAttemptsLimit,
 		SkipMachinePools:      clp.Spec.SkipMachinePools,
+		PostInstallValidation: clp.Spec.PostInstallValidation,
 	}
 
 	if clp.Spec.HibernateAfter != nil {
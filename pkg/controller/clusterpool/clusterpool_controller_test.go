@@ -167,7 +167,11 @@ func TestReconcileClusterPool(t *testing.T) {
 				// needs to a) set those fields in the pool spec, and b) have a nonzero size so the
 				// pool actually creates CDs to compare.
 				// TODO: Add coverage for more "copyover fields".
-				initializedPoolBuilder.Build(testcp.WithSize(2), testcp.WithInstallAttemptsLimit(5)),
+				initializedPoolBuilder.Build(
+					testcp.WithSize(2),
+					testcp.WithInstallAttemptsLimit(5),
+					testcp.WithPostInstallValidation(&hivev1.PostInstallValidation{ClusterOperatorsAvailable: true}),
+				),
 			},
 			expectedTotalClusters: 2,
 		},
@@ -2122,6 +2126,11 @@ func TestReconcileClusterPool(t *testing.T) {
 							assert.Equal(t, *pool.Spec.InstallAttemptsLimit, *cd.Spec.InstallAttemptsLimit, "expected InstallAttemptsLimit to match")
 						}
 					}
+					if pool.Spec.PostInstallValidation != nil {
+						if assert.NotNil(t, cd.Spec.Provisioning, "expected Provisioning to be set") {
+							assert.Equal(t, pool.Spec.PostInstallValidation, cd.Spec.Provisioning.PostInstallValidation, "expected PostInstallValidation to match")
+						}
+					}
 				}
 				switch powerState := cd.Spec.PowerState; powerState {
 				case hivev1.ClusterPowerStateRunning:
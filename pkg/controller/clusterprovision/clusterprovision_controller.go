@@ -376,19 +376,45 @@ func (r *ReconcileClusterProvision) reconcileSuccessfulJob(instance *hivev1.Clus
 
 func (r *ReconcileClusterProvision) reconcileFailedJob(instance *hivev1.ClusterProvision, job *batchv1.Job, pLog log.FieldLogger) (reconcile.Result, error) {
 	pLog.Info("install job failed")
-	reason, message := r.parseInstallLog(instance.Spec.InstallLog, pLog)
+	reason, message, classification := r.parseInstallLog(instance.Spec.InstallLog, instance.Labels[hivev1.HiveClusterPlatformLabel], pLog)
 	if controllerutils.IsDeadlineExceeded(job) && reason == unknownReason {
 		reason, message = "AttemptDeadlineExceeded", "Install job failed due to deadline being exceeded for the attempt"
 	}
+	classification.ConsecutiveFailures = r.consecutiveFailures(instance, reason, pLog)
+	// Saved along with the Failed condition by transitionStage.
+	instance.Status.FailureClassification = classification
 	result, err := r.transitionStage(instance, hivev1.ClusterProvisionStageFailed, reason, message, pLog)
 	if err == nil {
 		// Increment a counter metric for this cluster type and error reason:
 		metricInstallErrors.Observe(instance, map[string]string{"reason": reason}, 1)
+		metricInstallFailureCategories.Observe(instance, map[string]string{"reason": reason, "category": string(classification.Category)}, 1)
 		metricClusterProvisionsTotal.Observe(instance, map[string]string{"result": resultFailure}, 1)
 	}
 	return result, err
 }
 
+// consecutiveFailures returns the number of consecutive provisions of the ClusterDeployment, ending with this one,
+// that failed with the reason. Earlier provisions are found through the previous provision of each provision.
+func (r *ReconcileClusterProvision) consecutiveFailures(instance *hivev1.ClusterProvision, reason string, pLog log.FieldLogger) int32 {
+	if instance.Spec.PrevProvisionName == nil {
+		return 1
+	}
+	prev := &hivev1.ClusterProvision{}
+	if err := r.Get(context.TODO(), types.NamespacedName{Namespace: instance.Namespace, Name: *instance.Spec.PrevProvisionName}, prev); err != nil {
+		// Not fatal; the previous provision may have been deleted.
+		pLog.WithError(err).WithField("prevProvision", *instance.Spec.PrevProvisionName).Debug("could not get previous provision")
+		return 1
+	}
+	cond := controllerutils.FindCondition(prev.Status.Conditions, hivev1.ClusterProvisionFailedCondition)
+	if cond == nil || cond.Status != corev1.ConditionTrue || cond.Reason != reason {
+		return 1
+	}
+	if prev.Status.FailureClassification == nil {
+		return 2
+	}
+	return prev.Status.FailureClassification.ConsecutiveFailures + 1
+}
+
 func (r *ReconcileClusterProvision) startProvisioning(instance *hivev1.ClusterProvision, pLog log.FieldLogger) (reconcile.Result, error) {
 	pLog.Info("provision initialization complete")
 	return r.transitionStage(instance, hivev1.ClusterProvisionStageProvisioning, "InitializationComplete", "Install job has completed its initialization. Provisioning started.", pLog)
@@ -625,4 +651,14 @@ func (r *ReconcileClusterProvision) logProvisionSuccessFailureMetric(
 		"install_attempt": strconv.Itoa(instance.Spec.Attempt),
 	}
 	timeMetric.Observe(cd, fixedLabels, time.Since(instance.CreationTimestamp.Time).Seconds())
+
+	start := instance.CreationTimestamp.Time
+	for _, milestone := range instance.Status.Milestones {
+		metricInstallMilestoneSeconds.Observe(cd, map[string]string{
+			"platform":        fixedLabels["platform"],
+			"cluster_version": fixedLabels["cluster_version"],
+			"milestone":       string(milestone.Name),
+		}, milestone.Time.Sub(start).Seconds())
+		start = milestone.Time.Time
+	}
 }
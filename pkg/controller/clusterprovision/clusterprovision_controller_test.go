@@ -136,6 +136,53 @@ func TestClusterProvisionReconcile(t *testing.T) {
 			expectedStage:      hivev1.ClusterProvisionStageFailed,
 			expectedFailReason: unknownReason,
 		},
+		{
+			name: "failed job with same reason as previous provision",
+			existing: []runtime.Object{
+				testProvision(tcp.WithJob(installJobName), tcp.WithPrevProvisionName("prev-provision")),
+				tcp.FullBuilder(testNamespace, "prev-provision").Build(
+					tcp.WithFailureReason(unknownReason),
+					tcp.WithFailureClassification(&hivev1.ProvisionFailureClassification{
+						Category:            hivev1.ProvisionFailureCategoryUnknown,
+						ConsecutiveFailures: 2,
+					}),
+				),
+				testJob(failedJob()),
+				testPod("foo"),
+			},
+			expectedStage:      hivev1.ClusterProvisionStageFailed,
+			expectedFailReason: unknownReason,
+			validate: func(c client.Client, t *testing.T) {
+				provision := getProvision(c)
+				if assert.NotNil(t, provision.Status.FailureClassification, "expected failure classification") {
+					assert.Equal(t, hivev1.ProvisionFailureCategoryUnknown, provision.Status.FailureClassification.Category, "unexpected failure category")
+					assert.Equal(t, int32(3), provision.Status.FailureClassification.ConsecutiveFailures, "unexpected consecutive failures")
+				}
+			},
+		},
+		{
+			name: "failed job with different reason than previous provision",
+			existing: []runtime.Object{
+				testProvision(tcp.WithJob(installJobName), tcp.WithPrevProvisionName("prev-provision")),
+				tcp.FullBuilder(testNamespace, "prev-provision").Build(
+					tcp.WithFailureReason("AWSInsufficientCapacity"),
+					tcp.WithFailureClassification(&hivev1.ProvisionFailureClassification{
+						Category:            hivev1.ProvisionFailureCategoryInfrastructure,
+						ConsecutiveFailures: 2,
+					}),
+				),
+				testJob(failedJob()),
+				testPod("foo"),
+			},
+			expectedStage:      hivev1.ClusterProvisionStageFailed,
+			expectedFailReason: unknownReason,
+			validate: func(c client.Client, t *testing.T) {
+				provision := getProvision(c)
+				if assert.NotNil(t, provision.Status.FailureClassification, "expected failure classification") {
+					assert.Equal(t, int32(1), provision.Status.FailureClassification.ConsecutiveFailures, "unexpected consecutive failures")
+				}
+			},
+		},
 		{
 			name: "deadline exceeded job",
 			existing: []runtime.Object{
@@ -3,6 +3,7 @@ package clusterprovision
 import (
 	"context"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/ghodss/yaml"
@@ -11,60 +12,70 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
 	controllerutils "github.com/openshift/hive/pkg/controller/utils"
 )
 
 const (
-	regexConfigMapName           = "install-log-regexes"
-	additionalRegexConfigMapName = "additional-install-log-regexes"
-	regexDataEntryName           = "regexes"
-	unknownReason                = "UnknownError"
-	logMissingMessage            = "Cluster install failed but installer log was not captured"
-	regexBadMessage              = "Cluster install failed but regex configmap to parse for known reasons could not be used"
-	unknownMessage               = "Cluster install failed but no known errors found in logs"
+	// RegexConfigMapName is the name of the ConfigMap, in the Hive namespace, of the install log regexes shipped with
+	// Hive.
+	RegexConfigMapName = "install-log-regexes"
+	// AdditionalRegexConfigMapName is the name of the ConfigMap, in the Hive namespace, of install log regexes added by
+	// the administrator.
+	AdditionalRegexConfigMapName = "additional-install-log-regexes"
+	// RegexDataEntryName is the key of the install log regexes, a YAML list of InstallLogRegex, in both ConfigMaps.
+	RegexDataEntryName = "regexes"
+
+	unknownReason     = "UnknownError"
+	logMissingMessage = "Cluster install failed but installer log was not captured"
+	regexBadMessage   = "Cluster install failed but regex configmap to parse for known reasons could not be used"
+	unknownMessage    = "Cluster install failed but no known errors found in logs"
 )
 
-// parseInstallLog parses install log to monitor for known issues.
-func (r *ReconcileClusterProvision) parseInstallLog(log *string, pLog log.FieldLogger) (string, string) {
+// parseInstallLog parses install log to monitor for known issues. The platform is the platform of the cluster, as in
+// the hive.openshift.io/cluster-platform label, which scopes the regexes. Besides the reason and message of the
+// failure, it returns its classification, without ConsecutiveFailures.
+func (r *ReconcileClusterProvision) parseInstallLog(log *string, platform string, pLog log.FieldLogger) (string, string, *hivev1.ProvisionFailureClassification) {
+	unknown := &hivev1.ProvisionFailureClassification{Category: hivev1.ProvisionFailureCategoryUnknown}
 	if log == nil {
-		return unknownReason, logMissingMessage
+		return unknownReason, logMissingMessage, unknown
 	}
 
 	// Load the regex configmap, if we don't have one, there's not much point proceeding here.
 	regexCM := &corev1.ConfigMap{}
-	if err := r.Get(context.TODO(), types.NamespacedName{Name: regexConfigMapName, Namespace: controllerutils.GetHiveNamespace()}, regexCM); err != nil {
-		pLog.WithError(err).Errorf("error loading %s configmap", regexConfigMapName)
+	if err := r.Get(context.TODO(), types.NamespacedName{Name: RegexConfigMapName, Namespace: controllerutils.GetHiveNamespace()}, regexCM); err != nil {
+		pLog.WithError(err).Errorf("error loading %s configmap", RegexConfigMapName)
 		// Even if the error was a transient error in fetching the configmap, we should not block
 		// the continuation of deploying the cluster just so that we can potentially get a
 		// better failure message.
-		return unknownReason, regexBadMessage
+		return unknownReason, regexBadMessage, unknown
 	}
 
-	regexesRaw, ok := regexCM.Data[regexDataEntryName]
+	regexesRaw, ok := regexCM.Data[RegexDataEntryName]
 	if !ok {
-		pLog.Errorf("%s configmap does not have a %q data entry", regexConfigMapName, regexDataEntryName)
-		return unknownReason, regexBadMessage
+		pLog.Errorf("%s configmap does not have a %q data entry", RegexConfigMapName, RegexDataEntryName)
+		return unknownReason, regexBadMessage, unknown
 	}
 
-	regexes := []installLogRegex{}
+	regexes := []InstallLogRegex{}
 	if err := yaml.Unmarshal([]byte(regexesRaw), &regexes); err != nil {
-		pLog.WithError(err).Errorf("cannot unmarshal data from %s configmap", regexConfigMapName)
-		return unknownReason, regexBadMessage
+		pLog.WithError(err).Errorf("cannot unmarshal data from %s configmap", RegexConfigMapName)
+		return unknownReason, regexBadMessage, unknown
 	}
 
 	// Load additional regex configmap, continue anyway if configmap isn't present
-	additionalRegexes := []installLogRegex{}
+	additionalRegexes := []InstallLogRegex{}
 	additionalRegexCM := &corev1.ConfigMap{}
-	if additionalRegexCMErr := r.Get(context.TODO(), types.NamespacedName{Name: additionalRegexConfigMapName, Namespace: controllerutils.GetHiveNamespace()}, additionalRegexCM); additionalRegexCMErr != nil {
-		pLog.WithError(additionalRegexCMErr).Errorf("error loading %s configmap", additionalRegexConfigMapName)
+	if additionalRegexCMErr := r.Get(context.TODO(), types.NamespacedName{Name: AdditionalRegexConfigMapName, Namespace: controllerutils.GetHiveNamespace()}, additionalRegexCM); additionalRegexCMErr != nil {
+		pLog.WithError(additionalRegexCMErr).Errorf("error loading %s configmap", AdditionalRegexConfigMapName)
 	} else {
-		additionalRegexesRaw, ok := additionalRegexCM.Data[regexDataEntryName]
+		additionalRegexesRaw, ok := additionalRegexCM.Data[RegexDataEntryName]
 		if !ok {
-			pLog.Errorf("%s configmap does not have a %q data entry", additionalRegexConfigMapName, regexDataEntryName)
+			pLog.Errorf("%s configmap does not have a %q data entry", AdditionalRegexConfigMapName, RegexDataEntryName)
 		} else {
 			if additionalRegexesRaw != "" {
 				if err := yaml.Unmarshal([]byte(additionalRegexesRaw), &additionalRegexes); err != nil {
-					pLog.WithError(err).Errorf("cannot unmarshal data from %s configmap", regexConfigMapName)
+					pLog.WithError(err).Errorf("cannot unmarshal data from %s configmap", RegexConfigMapName)
 				}
 			}
 		}
@@ -78,27 +89,79 @@ func (r *ReconcileClusterProvision) parseInstallLog(log *string, pLog log.FieldL
 		pLog.WithField("line", l).Info("install log line")
 	}
 
-	// Scan log contents for known errors
+	// Scan log contents for known errors, highest priority first
 	combinedRegexes := append(regexes, additionalRegexes...)
+	sort.SliceStable(combinedRegexes, func(i, j int) bool {
+		return combinedRegexes[i].Priority > combinedRegexes[j].Priority
+	})
 	for _, ilr := range combinedRegexes {
 		ilrLog := pLog.WithField("regexName", ilr.Name)
+		if !ilr.appliesTo(platform) {
+			ilrLog.WithField("platform", platform).Debug("skipping regex entry for other platforms")
+			continue
+		}
 		ilrLog.Debug("parsing regex entry")
-		for _, ss := range ilr.SearchRegexStrings {
-			// Make the expression case insensitive.
-			// NOTE: This works correctly on a regex that already has flaggage. E.g. "(?i)(?s)..."
-			// is equivalent to "(?is)..."
-			ss = "(?i)" + ss
-			ssLog := ilrLog.WithField("searchString", ss)
-			ssLog.Debug("matching search string")
-			switch match, err := regexp.Match(ss, []byte(*log)); {
-			case err != nil:
-				ssLog.WithError(err).Error("unable to compile regex")
-			case match:
-				pLog.WithField("reason", ilr.InstallFailingReason).Info("found known install failure string")
-				return ilr.InstallFailingReason, ilr.InstallFailingMessage
+		if match, zone := ilr.match([]byte(*log), ilrLog); match {
+			pLog.WithField("reason", ilr.InstallFailingReason).Info("found known install failure string")
+			category := ilr.Category
+			if category == "" {
+				category = hivev1.ProvisionFailureCategoryUnknown
+			}
+			return ilr.InstallFailingReason, ilr.InstallFailingMessage, &hivev1.ProvisionFailureClassification{
+				Matcher:     ilr.Name,
+				Category:    category,
+				Zone:        zone,
+				RetryPolicy: ilr.RetryPolicy,
 			}
 		}
 	}
 
-	return unknownReason, *log
+	return unknownReason, *log, unknown
+}
+
+// match returns true if the install log matches the regex entry, along with the zone captured by the "zone" named
+// group of the search strings that matched, if any.
+func (ilr *InstallLogRegex) match(installLog []byte, ilrLog log.FieldLogger) (bool, string) {
+	// Make the expression case insensitive.
+	// NOTE: This works correctly on a regex that already has flaggage. E.g. "(?i)(?s)..."
+	// is equivalent to "(?is)..."
+	flags := "(?i)"
+	if ilr.MultiLine {
+		flags = "(?ims)"
+	}
+	if ilr.Ordered && len(ilr.SearchRegexStrings) == 0 {
+		return false, ""
+	}
+
+	zone := ""
+	for _, ss := range ilr.SearchRegexStrings {
+		ss = flags + ss
+		ssLog := ilrLog.WithField("searchString", ss)
+		ssLog.Debug("matching search string")
+		re, err := regexp.Compile(ss)
+		if err != nil {
+			ssLog.WithError(err).Error("unable to compile regex")
+			if ilr.Ordered {
+				// All search strings of an ordered entry must match, which a bad one never does.
+				return false, ""
+			}
+			continue
+		}
+		loc := re.FindSubmatchIndex(installLog)
+		if loc == nil {
+			if ilr.Ordered {
+				return false, ""
+			}
+			continue
+		}
+		if i := re.SubexpIndex("zone"); i > 0 && loc[2*i] >= 0 {
+			zone = string(installLog[loc[2*i]:loc[2*i+1]])
+		}
+		if !ilr.Ordered {
+			return true, zone
+		}
+		// The next search string must match after this one.
+		installLog = installLog[loc[1]:]
+	}
+	return ilr.Ordered, zone
 }
@@ -3,6 +3,7 @@ package clusterprovision
 import (
 	"os"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -13,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/utils/pointer"
 
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
 	"github.com/openshift/hive/pkg/constants"
 	testfake "github.com/openshift/hive/pkg/test/fake"
 	"github.com/openshift/hive/pkg/util/scheme"
@@ -73,8 +75,10 @@ func TestParseInstallLog(t *testing.T) {
 		name            string
 		log             *string
 		existing        []runtime.Object
+		platform        string
 		expectedReason  string
 		expectedMessage *string
+		validate        func(*testing.T, *hivev1.ProvisionFailureClassification)
 	}{
 		{
 			name:           "AWSSubnetInsufficientIPSpace",
@@ -110,6 +114,14 @@ func TestParseInstallLog(t *testing.T) {
 			name:           "AWSInsufficientCapacity",
 			log:            pointer.String(awsInsufficientCapacity),
 			expectedReason: "AWSInsufficientCapacity",
+			validate: func(t *testing.T, classification *hivev1.ProvisionFailureClassification) {
+				assert.Equal(t, "AWSInsufficientCapacity", classification.Matcher, "unexpected matcher")
+				assert.Equal(t, hivev1.ProvisionFailureCategoryInfrastructure, classification.Category, "unexpected category")
+				assert.Equal(t, "eu-south-1b", classification.Zone, "unexpected zone")
+				if assert.NotNil(t, classification.RetryPolicy, "expected retry policy") {
+					assert.True(t, classification.RetryPolicy.ChangeZoneOnRetry, "expected zone change on retry")
+				}
+			},
 		},
 		{
 			name:           "load balancer service linked role prereq",
@@ -197,7 +209,7 @@ func TestParseInstallLog(t *testing.T) {
 			existing: []runtime.Object{
 				&corev1.ConfigMap{
 					ObjectMeta: metav1.ObjectMeta{
-						Name:      regexConfigMapName,
+						Name:      RegexConfigMapName,
 						Namespace: constants.DefaultHiveNamespace,
 					},
 					Data: map[string]string{
@@ -212,7 +224,7 @@ func TestParseInstallLog(t *testing.T) {
 				},
 				&corev1.ConfigMap{
 					ObjectMeta: metav1.ObjectMeta{
-						Name:      additionalRegexConfigMapName,
+						Name:      AdditionalRegexConfigMapName,
 						Namespace: constants.DefaultHiveNamespace,
 					},
 					Data: map[string]string{
@@ -234,7 +246,7 @@ func TestParseInstallLog(t *testing.T) {
 			existing: []runtime.Object{
 				&corev1.ConfigMap{
 					ObjectMeta: metav1.ObjectMeta{
-						Name:      regexConfigMapName,
+						Name:      RegexConfigMapName,
 						Namespace: constants.DefaultHiveNamespace,
 					},
 					Data: map[string]string{
@@ -249,7 +261,7 @@ func TestParseInstallLog(t *testing.T) {
 				},
 				&corev1.ConfigMap{
 					ObjectMeta: metav1.ObjectMeta{
-						Name:      additionalRegexConfigMapName,
+						Name:      AdditionalRegexConfigMapName,
 						Namespace: constants.DefaultHiveNamespace,
 					},
 					Data: map[string]string{
@@ -265,6 +277,118 @@ func TestParseInstallLog(t *testing.T) {
 			},
 			expectedReason: "KubeAPIWaitTimeoutRegexes",
 		},
+		{
+			name: "higher priority regex wins",
+			log:  pointer.String(kubeAPIWaitTimeoutLog),
+			existing: []runtime.Object{testRegexConfigMap(`
+- name: Generic
+  searchRegexStrings:
+  - "waiting for Kubernetes API"
+  installFailingReason: Generic
+  installFailingMessage: Generic failure
+  priority: -1
+- name: KubeAPIWaitTimeout
+  searchRegexStrings:
+  - "waiting for Kubernetes API: context deadline exceeded"
+  installFailingReason: KubeAPIWaitTimeout
+  installFailingMessage: Timeout waiting for the Kubernetes API to begin responding
+`)},
+			expectedReason: "KubeAPIWaitTimeout",
+		},
+		{
+			name: "ordered search strings",
+			log:  pointer.String("first line\nsecond line\nthird line"),
+			existing: []runtime.Object{testRegexConfigMap(`
+- name: Ordered
+  searchRegexStrings:
+  - "first"
+  - "third"
+  installFailingReason: Ordered
+  installFailingMessage: Ordered failure
+  ordered: true
+  category: UserError
+  retryPolicy:
+    maxRetries: 2
+    backoff: 5m
+`)},
+			expectedReason: "Ordered",
+			validate: func(t *testing.T, classification *hivev1.ProvisionFailureClassification) {
+				assert.Equal(t, hivev1.ProvisionFailureCategoryUserError, classification.Category, "unexpected category")
+				if assert.NotNil(t, classification.RetryPolicy, "expected retry policy") {
+					assert.Equal(t, int32(2), *classification.RetryPolicy.MaxRetries, "unexpected max retries")
+					assert.Equal(t, 5*time.Minute, classification.RetryPolicy.Backoff.Duration, "unexpected backoff")
+				}
+			},
+		},
+		{
+			name: "ordered search strings out of order",
+			log:  pointer.String("third line\nsecond line\nfirst line"),
+			existing: []runtime.Object{testRegexConfigMap(`
+- name: Ordered
+  searchRegexStrings:
+  - "first"
+  - "third"
+  installFailingReason: Ordered
+  installFailingMessage: Ordered failure
+  ordered: true
+`)},
+			expectedReason: unknownReason,
+		},
+		{
+			name: "multi-line search string",
+			log:  pointer.String("level=error msg=failed\nconnection refused"),
+			existing: []runtime.Object{testRegexConfigMap(`
+- name: MultiLine
+  searchRegexStrings:
+  - "failed.connection refused$"
+  installFailingReason: MultiLine
+  installFailingMessage: Multi-line failure
+  multiLine: true
+`)},
+			expectedReason: "MultiLine",
+		},
+		{
+			name: "search string spanning lines without multiLine",
+			log:  pointer.String("level=error msg=failed\nconnection refused"),
+			existing: []runtime.Object{testRegexConfigMap(`
+- name: SingleLine
+  searchRegexStrings:
+  - "failed.connection refused"
+  installFailingReason: SingleLine
+  installFailingMessage: Single line failure
+`)},
+			expectedReason: unknownReason,
+		},
+		{
+			name:     "regex scoped to another platform",
+			log:      pointer.String(kubeAPIWaitTimeoutLog),
+			platform: "gcp",
+			existing: []runtime.Object{testRegexConfigMap(`
+- name: KubeAPIWaitTimeout
+  searchRegexStrings:
+  - "waiting for Kubernetes API: context deadline exceeded"
+  installFailingReason: KubeAPIWaitTimeout
+  installFailingMessage: Timeout waiting for the Kubernetes API to begin responding
+  platforms:
+  - aws
+`)},
+			expectedReason: unknownReason,
+		},
+		{
+			name:     "regex scoped to platform of cluster",
+			log:      pointer.String(kubeAPIWaitTimeoutLog),
+			platform: "aws",
+			existing: []runtime.Object{testRegexConfigMap(`
+- name: KubeAPIWaitTimeout
+  searchRegexStrings:
+  - "waiting for Kubernetes API: context deadline exceeded"
+  installFailingReason: KubeAPIWaitTimeout
+  installFailingMessage: Timeout waiting for the Kubernetes API to begin responding
+  platforms:
+  - aws
+`)},
+			expectedReason: "KubeAPIWaitTimeout",
+		},
 		{
 			name:           "no log",
 			expectedReason: unknownReason,
@@ -286,7 +410,7 @@ func TestParseInstallLog(t *testing.T) {
 			log:  pointer.String(dnsAlreadyExistsLog),
 			existing: []runtime.Object{&corev1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      regexConfigMapName,
+					Name:      RegexConfigMapName,
 					Namespace: constants.DefaultHiveNamespace,
 				},
 			}},
@@ -297,7 +421,7 @@ func TestParseInstallLog(t *testing.T) {
 			log:  pointer.String(dnsAlreadyExistsLog),
 			existing: []runtime.Object{&corev1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      regexConfigMapName,
+					Name:      RegexConfigMapName,
 					Namespace: constants.DefaultHiveNamespace,
 				},
 				BinaryData: map[string][]byte{
@@ -311,7 +435,7 @@ func TestParseInstallLog(t *testing.T) {
 			log:  pointer.String(dnsAlreadyExistsLog),
 			existing: []runtime.Object{&corev1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      regexConfigMapName,
+					Name:      RegexConfigMapName,
 					Namespace: constants.DefaultHiveNamespace,
 				},
 				Data: map[string]string{
@@ -336,7 +460,7 @@ func TestParseInstallLog(t *testing.T) {
 			log:  pointer.String(dnsAlreadyExistsLog),
 			existing: []runtime.Object{&corev1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      regexConfigMapName,
+					Name:      RegexConfigMapName,
 					Namespace: constants.DefaultHiveNamespace,
 				},
 				Data: map[string]string{
@@ -460,8 +584,16 @@ func TestParseInstallLog(t *testing.T) {
 				Client: fakeClient,
 				scheme: scheme.GetScheme(),
 			}
-			reason, message := r.parseInstallLog(test.log, log.WithFields(log.Fields{}))
+			reason, message, classification := r.parseInstallLog(test.log, test.platform, log.WithFields(log.Fields{}))
 			assert.Equal(t, test.expectedReason, reason, "unexpected reason")
+			if assert.NotNil(t, classification, "expected failure classification") {
+				if reason == unknownReason {
+					assert.Equal(t, hivev1.ProvisionFailureCategoryUnknown, classification.Category, "unexpected category")
+				}
+				if test.validate != nil {
+					test.validate(t, classification)
+				}
+			}
 			if test.expectedMessage != nil {
 				assert.Equal(t, *test.expectedMessage, message)
 			} else {
@@ -471,6 +603,19 @@ func TestParseInstallLog(t *testing.T) {
 	}
 }
 
+// testRegexConfigMap builds an install log regexes configmap with the given regexes.
+func testRegexConfigMap(regexes string) runtime.Object {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RegexConfigMapName,
+			Namespace: constants.DefaultHiveNamespace,
+		},
+		Data: map[string]string{
+			"regexes": regexes,
+		},
+	}
+}
+
 // buildRegexConfigMap reads the install log regexes configmap from within config/configmaps/install-log-regexes-configmap.yaml
 func buildRegexConfigMap() runtime.Object {
 	scheme := scheme.GetScheme()
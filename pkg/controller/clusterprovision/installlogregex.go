@@ -1,13 +1,18 @@
 package clusterprovision
 
-// installLogRegex is a struct that represents all the data we use to scan for certain
+import (
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+)
+
+// InstallLogRegex is a struct that represents all the data we use to scan for certain
 // search strings in install logs. These structs are serialized as yaml and stored/read from
 // the install-log-regexes ConfigMap.
-type installLogRegex struct {
+type InstallLogRegex struct {
 	// Name is the name of the regex.
 	Name string `json:"name"`
 
-	// SearchRegexStrings are the regex strings we will search for.
+	// SearchRegexStrings are the regex strings we will search for. Any one of them matching is a match, unless
+	// Ordered is set.
 	SearchRegexStrings []string `json:"searchRegexStrings"`
 
 	// InstallFailingReason is the single word CamelCase reason we report for this failure in conditions, metrics and logs.
@@ -15,4 +20,39 @@ type installLogRegex struct {
 
 	// InstallFailingMessage is the user friendly sentence we report for this failure and conditions, metrics and logs.
 	InstallFailingMessage string `json:"installFailingMessage"`
+
+	// Priority orders the regexes. Regexes with a higher priority are tried first. Regexes with the same priority
+	// are tried in the order they are listed, those of the install-log-regexes ConfigMap before those of the
+	// additional-install-log-regexes ConfigMap.
+	Priority int `json:"priority,omitempty"`
+
+	// MultiLine makes `.` match newlines so that a search string can span lines, and `^` and `$` match at the start
+	// and end of each line.
+	MultiLine bool `json:"multiLine,omitempty"`
+
+	// Ordered requires all of the SearchRegexStrings to match, each after the end of the match of the previous one.
+	Ordered bool `json:"ordered,omitempty"`
+
+	// Platforms limits the regex to clusters on these platforms, as in the hive.openshift.io/cluster-platform
+	// label, e.g. "aws". If empty, the regex applies to all platforms.
+	Platforms []string `json:"platforms,omitempty"`
+
+	// Category is the broad category of the failure. Defaults to Unknown.
+	Category hivev1.ProvisionFailureCategory `json:"category,omitempty"`
+
+	// RetryPolicy controls how provisions that failed for this reason are retried.
+	RetryPolicy *hivev1.ProvisionRetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// appliesTo returns true if the regex applies to clusters on the platform.
+func (ilr *InstallLogRegex) appliesTo(platform string) bool {
+	if len(ilr.Platforms) == 0 {
+		return true
+	}
+	for _, p := range ilr.Platforms {
+		if p == platform {
+			return true
+		}
+	}
+	return false
 }
@@ -13,9 +13,13 @@ var (
 	// They are defined later once the hive config has been read.
 	metricClusterProvisionsTotal hivemetrics.CounterVecWithDynamicLabels
 	metricInstallErrors          hivemetrics.CounterVecWithDynamicLabels
+	// metricInstallFailureCategories counts failed provisions by reason and category.
+	metricInstallFailureCategories hivemetrics.CounterVecWithDynamicLabels
 
 	metricInstallFailureSeconds hivemetrics.HistogramVecWithDynamicLabels
 	metricInstallSuccessSeconds hivemetrics.HistogramVecWithDynamicLabels
+	// metricInstallMilestoneSeconds observes the time taken by provisions to reach each milestone of the install.
+	metricInstallMilestoneSeconds hivemetrics.HistogramVecWithDynamicLabels
 )
 
 func registerMetrics(mConfig *metricsconfig.MetricsConfig, log log.FieldLogger) {
@@ -37,6 +41,14 @@ func registerMetrics(mConfig *metricsconfig.MetricsConfig, log log.FieldLogger)
 		[]string{"reason"},
 		mapClusterTypeLabelToValue,
 	)
+	metricInstallFailureCategories = *hivemetrics.NewCounterVecWithDynamicLabels(
+		&prometheus.CounterOpts{
+			Name: "hive_install_failure_categories_total",
+			Help: "Counter incremented for every failed cluster provision, by failure reason and category.",
+		},
+		[]string{"reason", "category"},
+		mapClusterTypeLabelToValue,
+	)
 
 	metricInstallFailureSeconds = *hivemetrics.NewHistogramVecWithDynamicLabels(
 		&prometheus.HistogramOpts{
@@ -57,8 +69,20 @@ func registerMetrics(mConfig *metricsconfig.MetricsConfig, log log.FieldLogger)
 		mapClusterTypeLabelToValue,
 	)
 
+	metricInstallMilestoneSeconds = *hivemetrics.NewHistogramVecWithDynamicLabels(
+		&prometheus.HistogramOpts{
+			Name:    "hive_cluster_provision_milestone_seconds",
+			Help:    "Time taken by a cluster provision to reach a milestone of the install, from the previous milestone or, for the first one, from the start of the provision",
+			Buckets: []float64{60, 300, 600, 900, 1200, 1800, 2700},
+		},
+		[]string{"platform", "cluster_version", "milestone"},
+		mapClusterTypeLabelToValue,
+	)
+
 	metricInstallErrors.Register()
+	metricInstallFailureCategories.Register()
 	metricClusterProvisionsTotal.Register()
 	metricInstallFailureSeconds.Register()
 	metricInstallSuccessSeconds.Register()
+	metricInstallMilestoneSeconds.Register()
 }
@@ -0,0 +1,28 @@
+package clustersync
+
+import (
+	"hash/fnv"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// assignReplica determines the ordinal of the replica of the hive-clustersync StatefulSet that is responsible for
+// syncing the cluster with the specified ClusterDeployment UID.
+//
+// Clusters are assigned with jump consistent hashing (Lamping and Veach, https://arxiv.org/abs/1406.2294), which
+// suits the ordinals of a StatefulSet since replicas are only ever added or removed at the end. When the StatefulSet is
+// scaled from N to N+1 replicas, only about 1/(N+1) of the clusters move, all of them to the new replica. Likewise,
+// scaling down only moves the clusters of the removed replicas.
+func assignReplica(uid types.UID, replicas int64) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(uid))
+	key := h.Sum64()
+
+	var b, j int64 = -1, 0
+	for j < replicas {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return b
+}
@@ -0,0 +1,39 @@
+package clustersync
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestAssignReplica(t *testing.T) {
+	const clusters = 10000
+	uids := make([]types.UID, clusters)
+	for i := range uids {
+		uids[i] = types.UID(fmt.Sprintf("00000000-0000-0000-0000-%012x", i))
+	}
+	for replicas := int64(1); replicas < 8; replicas++ {
+		t.Run(fmt.Sprintf("%d to %d replicas", replicas, replicas+1), func(t *testing.T) {
+			counts := make([]int, replicas+1)
+			moved := 0
+			for _, uid := range uids {
+				before := assignReplica(uid, replicas)
+				after := assignReplica(uid, replicas+1)
+				assert.GreaterOrEqual(t, before, int64(0), "unexpected replica before scaling")
+				assert.Less(t, before, replicas, "unexpected replica before scaling")
+				counts[after]++
+				if before != after {
+					moved++
+					assert.Equal(t, replicas, after, "expected cluster to move only to the new replica")
+				}
+			}
+			expected := clusters / (replicas + 1)
+			assert.InDelta(t, expected, moved, float64(expected)*0.1, "unexpected number of clusters moved")
+			for i, count := range counts {
+				assert.InDelta(t, expected, count, float64(expected)*0.1, "unexpected number of clusters assigned to replica %d", i)
+			}
+		})
+	}
+}
@@ -3,7 +3,6 @@ package clustersync
 import (
 	"context"
 	"fmt"
-	"math/big"
 	"math/rand"
 	"os"
 	"reflect"
@@ -21,16 +20,21 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/lru"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 	"sigs.k8s.io/yaml"
@@ -42,6 +46,8 @@ import (
 	controllerutils "github.com/openshift/hive/pkg/controller/utils"
 	"github.com/openshift/hive/pkg/remoteclient"
 	"github.com/openshift/hive/pkg/resource"
+	"github.com/openshift/hive/pkg/secretsource"
+	"github.com/openshift/hive/pkg/syncsetsource"
 )
 
 const (
@@ -57,6 +63,14 @@ const (
 	metricResultSuccess    = "success"
 	metricResultError      = "error"
 	stsName                = "hive-clustersync"
+	renderTimeout          = 2 * time.Minute
+	// syncHistoryLimit is the number of attempts to apply each syncset that are kept in the ClusterSync status.
+	syncHistoryLimit = 10
+	// syncHistoryTotalLimit is the number of attempts to apply syncsets that are kept in the ClusterSync status across
+	// all of the syncsets and selectorsyncsets for the cluster, so that the size of the ClusterSync stays bounded.
+	syncHistoryTotalLimit = 200
+	// maxResourcesChangedPerAttempt is the number of resources changed by an attempt that are listed in its record.
+	maxResourcesChangedPerAttempt = 5
 )
 
 var (
@@ -93,6 +107,11 @@ var (
 		[]string{"type", "result"},
 	)
 
+	metricClusterSyncReassignments = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "hive_clustersync_reassignments_total",
+		Help: "Counter incremented each time a cluster is synced by a different replica of the clustersync controller than the replica that last synced it.",
+	})
+
 	metricTimeToApplySyncSets = prometheus.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "hive_clustersync_first_success_duration_seconds",
@@ -108,6 +127,7 @@ func init() {
 	metrics.Registry.MustRegister(metricResourcesApplied)
 	metrics.Registry.MustRegister(metricTimeToApplySyncSetResource)
 	metrics.Registry.MustRegister(metricTimeToApplySyncSets)
+	metrics.Registry.MustRegister(metricClusterSyncReassignments)
 }
 
 // Add creates a new clustersync Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
@@ -159,6 +179,10 @@ func NewReconciler(mgr manager.Manager, rateLimiter flowcontrol.RateLimiter) (*R
 		}
 	}
 	log.WithField("reapplyInterval", reapplyInterval).Info("Reapply interval set")
+	var vaultAddresses []string
+	if addresses := os.Getenv(constants.SyncSetVaultAddressesEnvVar); addresses != "" {
+		vaultAddresses = strings.Split(addresses, ",")
+	}
 	c := controllerutils.NewClientWithMetricsOrDie(mgr, ControllerName, &rateLimiter)
 	return &ReconcileClusterSync{
 		Client:                c,
@@ -168,6 +192,9 @@ func NewReconciler(mgr manager.Manager, rateLimiter flowcontrol.RateLimiter) (*R
 		remoteClusterAPIClientBuilder: func(cd *hivev1.ClusterDeployment) remoteclient.Builder {
 			return remoteclient.NewBuilder(c, cd, ControllerName)
 		},
+		secretResolver:       secretsource.NewResolver(c, vaultAddresses, logger),
+		healthResources:      lru.New(healthResourcesCacheSize),
+		healthRecheckBackoff: flowcontrol.NewBackOff(healthRecheckInterval, maxHealthRecheckInterval),
 	}, nil
 }
 
@@ -212,21 +239,27 @@ func AddToManager(mgr manager.Manager, r *ReconcileClusterSync, concurrentReconc
 	// Watch for changes to SyncSets
 	if err := c.Watch(
 		source.Kind(mgr.GetCache(), &hivev1.SyncSet{}),
-		handler.EnqueueRequestsFromMapFunc(requestsForSyncSet)); err != nil {
+		handler.EnqueueRequestsFromMapFunc(requestsForSyncSet),
+		ignoreSourceFetchTimeUpdates()); err != nil {
 		return err
 	}
 
 	// Watch for changes to SelectorSyncSets
 	if err := c.Watch(
 		source.Kind(mgr.GetCache(), &hivev1.SelectorSyncSet{}),
-		handler.EnqueueRequestsFromMapFunc(requestsForSelectorSyncSet(r.Client, r.logger))); err != nil {
+		handler.EnqueueRequestsFromMapFunc(requestsForSelectorSyncSet(r.Client, r.logger)),
+		ignoreSourceFetchTimeUpdates()); err != nil {
 		return err
 	}
 
 	// Watch for changes to ClusterSync. These have the same name/namespace as the relevant
 	// ClusterDeployment, so when a ClusterSync watch triggers, the CD of the same name will be reconciled.
 	// When the CD reconciles, it will look up the related ClusterSync.
-	if err := c.Watch(source.Kind(mgr.GetCache(), &hiveintv1alpha1.ClusterSync{}), &handler.EnqueueRequestForObject{}); err != nil {
+	// Only changes to the spec are of interest, since this controller is the one that writes the status.
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &hiveintv1alpha1.ClusterSync{}),
+		&handler.EnqueueRequestForObject{},
+		predicate.GenerationChangedPredicate{}); err != nil {
 		return err
 	}
 
@@ -240,6 +273,38 @@ func AddToManager(mgr manager.Manager, r *ReconcileClusterSync, concurrentReconc
 	return nil
 }
 
+// ignoreSourceFetchTimeUpdates filters out updates to SyncSets and SelectorSyncSets that only change the time their
+// source was last fetched, which the syncsetsource controller updates each time it checks the source.
+func ignoreSourceFetchTimeUpdates() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldObj, newObj := withoutSourceFetchTime(e.ObjectOld), withoutSourceFetchTime(e.ObjectNew)
+			return oldObj == nil || newObj == nil || !reflect.DeepEqual(oldObj, newObj)
+		},
+	}
+}
+
+// withoutSourceFetchTime returns a copy of a SyncSet or SelectorSyncSet without the fields that change when only the
+// fetch time of its source changes.
+func withoutSourceFetchTime(obj client.Object) client.Object {
+	var sourceStatus **hivev1.SyncSetSourceStatus
+	obj = obj.DeepCopyObject().(client.Object)
+	switch ss := obj.(type) {
+	case *hivev1.SyncSet:
+		sourceStatus = &ss.Status.Source
+	case *hivev1.SelectorSyncSet:
+		sourceStatus = &ss.Status.Source
+	default:
+		return nil
+	}
+	if *sourceStatus != nil {
+		(*sourceStatus).LastFetchTime = nil
+	}
+	obj.SetResourceVersion("")
+	obj.SetManagedFields(nil)
+	return obj
+}
+
 func requestsForSyncSet(ctx context.Context, o client.Object) []reconcile.Request {
 	ss, ok := o.(*hivev1.SyncSet)
 	if !ok {
@@ -293,6 +358,15 @@ type ReconcileClusterSync struct {
 	// for the remote cluster's API server
 	remoteClusterAPIClientBuilder func(cd *hivev1.ClusterDeployment) remoteclient.Builder
 
+	// secretResolver reads the secrets of secret mappings from external secret stores.
+	secretResolver *secretsource.Resolver
+
+	// healthResources caches the resources of syncsets with health checks that are assessed.
+	healthResources *lru.Cache
+
+	// healthRecheckBackoff backs off the re-assessment of the resources of clusters that are not healthy.
+	healthRecheckBackoff *flowcontrol.Backoff
+
 	ordinalID int64
 }
 
@@ -324,19 +398,6 @@ func (r *ReconcileClusterSync) getAndCheckClusterSyncStatefulSet(logger log.Fiel
 
 // isSyncAssignedToMe determines if this instance of the controller is assigned to the resource being sync'd
 func (r *ReconcileClusterSync) isSyncAssignedToMe(sts *appsv1.StatefulSet, cd *hivev1.ClusterDeployment, logger log.FieldLogger) (bool, int64, error) {
-	logger.Debug("Getting uid for hashing")
-	var uidAsBigInt big.Int
-
-	// There are a couple of assumptions here:
-	// * the clusterdeployment uid has 4 sections separated by hyphens
-	// * the 4 sections are hex numbers
-	// These assumptions are based on the fact that Kubernetes says UIDs are actually
-	// ISO/IEC 9834-8 UUIDs. If this changes, this code may fail and may need to be updated.
-	// https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#uids
-	hexUID := strings.Replace(string(cd.UID), "-", "", 4)
-	logger.Debugf("hexUID: %+v", hexUID)
-	uidAsBigInt.SetString(hexUID, 16)
-
 	logger.Debug("calculating replicas")
 	replicas := int64(*sts.Spec.Replicas)
 	// For test purposes, if we've scaled down clustersync so we can run locally, this will be zero; spoof it to one:
@@ -346,7 +407,7 @@ func (r *ReconcileClusterSync) isSyncAssignedToMe(sts *appsv1.StatefulSet, cd *h
 	}
 
 	logger.Debug("determining who is assigned to sync this cluster")
-	ordinalIDOfAssignee := uidAsBigInt.Mod(&uidAsBigInt, big.NewInt(replicas)).Int64()
+	ordinalIDOfAssignee := assignReplica(cd.UID, replicas)
 	assignedToMe := ordinalIDOfAssignee == r.ordinalID
 
 	logger.WithFields(log.Fields{
@@ -452,6 +513,10 @@ func (r *ReconcileClusterSync) Reconcile(ctx context.Context, request reconcile.
 		return reconcile.Result{}, err
 	}
 
+	if prev := clusterSync.Status.ControlledByReplica; prev != nil && *prev != ordinal {
+		logger.WithField("previousReplica", *prev).Info("ClusterSync has been reassigned to this replica")
+		metricClusterSyncReassignments.Inc()
+	}
 	clusterSync.Status.ControlledByReplica = &ordinal
 
 	needToCreateLease := false
@@ -485,33 +550,65 @@ func (r *ReconcileClusterSync) Reconcile(ctx context.Context, request reconcile.
 	}
 
 	needToDoFullReapply := needToCreateLease || needToRenew
+
+	// A resync request forces the syncsets named in the request, or all syncsets if none are named, to be re-applied.
+	resyncSyncSets, resyncSelectorSyncSets := sets.NewString(), sets.NewString()
+	if resync := clusterSync.Spec.Resync; resync != nil && resync.RequestID != clusterSync.Status.LastResyncRequestID {
+		logger := logger.WithField("resyncRequestID", resync.RequestID)
+		if len(resync.SyncSets) == 0 && len(resync.SelectorSyncSets) == 0 {
+			logger.Info("need to reapply all syncsets (resync requested)")
+			needToDoFullReapply = true
+		} else {
+			logger.WithField("syncSets", resync.SyncSets).
+				WithField("selectorSyncSets", resync.SelectorSyncSets).
+				Info("need to reapply requested syncsets (resync requested)")
+			resyncSyncSets.Insert(resync.SyncSets...)
+			resyncSelectorSyncSets.Insert(resync.SelectorSyncSets...)
+		}
+		clusterSync.Status.LastResyncRequestID = resync.RequestID
+	}
 	recobsrv.SetOutcome(hivemetrics.ReconcileOutcomeFullSync)
 
 	// Apply SyncSets
-	syncStatusesForSyncSets, syncSetsNeedRequeue := r.applySyncSets(
+	syncStatusesForSyncSets, syncSetAttempts, syncSetsNeedRequeue := r.applySyncSets(
+		ctx,
 		cd,
 		"SyncSet",
 		syncSets,
 		clusterSync.Status.SyncSets,
 		needToDoFullReapply,
+		resyncSyncSets,
 		false, // no need to report SelectorSyncSet metrics if we're reconciling non-selector SyncSets
 		resourceHelper,
 		logger,
 	)
 	clusterSync.Status.SyncSets = syncStatusesForSyncSets
+	historyLimit := syncHistoryLimitFor(len(syncStatusesForSyncSets) + len(clusterSync.Status.SelectorSyncSets))
+	clusterSync.Status.SyncSetHistory = updateSyncHistory(
+		clusterSync.Status.SyncSetHistory, syncStatusesForSyncSets, syncSetAttempts, historyLimit)
 
 	// Apply SelectorSyncSets
-	syncStatusesForSelectorSyncSets, selectorSyncSetsNeedRequeue := r.applySyncSets(
+	syncStatusesForSelectorSyncSets, selectorSyncSetAttempts, selectorSyncSetsNeedRequeue := r.applySyncSets(
+		ctx,
 		cd,
 		"SelectorSyncSet",
 		selectorSyncSets,
 		clusterSync.Status.SelectorSyncSets,
 		needToDoFullReapply,
+		resyncSelectorSyncSets,
 		clusterSync.Status.FirstSuccessTime == nil, // only report SelectorSyncSet metrics if we haven't reached first success
 		resourceHelper,
 		logger,
 	)
 	clusterSync.Status.SelectorSyncSets = syncStatusesForSelectorSyncSets
+	historyLimit = syncHistoryLimitFor(len(syncStatusesForSyncSets) + len(syncStatusesForSelectorSyncSets))
+	clusterSync.Status.SelectorSyncSetHistory = updateSyncHistory(
+		clusterSync.Status.SelectorSyncSetHistory, syncStatusesForSelectorSyncSets, selectorSyncSetAttempts, historyLimit)
+
+	// Assess the health of the resources of syncsets with health checks
+	health := &healthAssessor{r: r, cd: cd, logger: logger}
+	syncSetsUnhealthy := health.assess("SyncSet", syncSets, clusterSync.Status.SyncSets)
+	selectorSyncSetsUnhealthy := health.assess("SelectorSyncSet", selectorSyncSets, clusterSync.Status.SelectorSyncSets)
 
 	setFailedCondition(clusterSync)
 
@@ -554,6 +651,19 @@ func (r *ReconcileClusterSync) Reconcile(ctx context.Context, request reconcile.
 	}
 
 	result := reconcile.Result{Requeue: true, RequeueAfter: r.timeUntilRenew(lease)}
+	// Secrets from external secret stores are checked for changes each time they are refreshed.
+	if refreshInterval, ok := externalSecretsRefreshInterval(append(syncSets, selectorSyncSets...)); ok && refreshInterval < result.RequeueAfter {
+		result.RequeueAfter = refreshInterval
+	}
+	// Resources that are not yet healthy are re-assessed without waiting for the next full re-apply.
+	if syncSetsUnhealthy || selectorSyncSetsUnhealthy {
+		applied := len(syncSetAttempts)+len(selectorSyncSetAttempts) > 0
+		if recheckInterval := r.nextHealthRecheck(cd, applied); recheckInterval < result.RequeueAfter {
+			result.RequeueAfter = recheckInterval
+		}
+	} else {
+		r.healthRecheckBackoff.Reset(cd.Namespace + "/" + cd.Name)
+	}
 	if syncSetsNeedRequeue || selectorSyncSetsNeedRequeue {
 		result.RequeueAfter = 0
 	}
@@ -561,21 +671,25 @@ func (r *ReconcileClusterSync) Reconcile(ctx context.Context, request reconcile.
 }
 
 func (r *ReconcileClusterSync) applySyncSets(
+	ctx context.Context,
 	cd *hivev1.ClusterDeployment,
 	syncSetType string,
 	syncSets []CommonSyncSet,
 	syncStatuses []hiveintv1alpha1.SyncStatus,
 	needToDoFullReapply bool,
+	resync sets.String,
 	reportSelectorSyncSetMetrics bool,
 	resourceHelper resource.Helper,
 	logger log.FieldLogger,
-) (newSyncStatuses []hiveintv1alpha1.SyncStatus, requeue bool) {
+) (newSyncStatuses []hiveintv1alpha1.SyncStatus, attempts map[string]hiveintv1alpha1.SyncAttempt, requeue bool) {
 	// Sort the syncsets to a consistent ordering. This prevents thrashing in the ClusterSync status due to the order
 	// of the syncset status changing from one reconcile to the next.
 	sort.Slice(syncSets, func(i, j int) bool {
 		return syncSets[i].AsMetaObject().GetName() < syncSets[j].AsMetaObject().GetName()
 	})
 
+	attempts = map[string]hiveintv1alpha1.SyncAttempt{}
+
 	deletionList := make([]hiveintv1alpha1.SyncStatus, len(syncStatuses))
 	copy(deletionList, syncStatuses)
 
@@ -595,7 +709,7 @@ func (r *ReconcileClusterSync) applySyncSets(
 	// We delete old resources before applying new in order to allow resources to be moved from one syncset to
 	// another, ex: in the case of a syncset being renamed
 	for _, oldSyncStatus := range deletionList {
-		remainingResources, err := deleteFromTargetCluster(oldSyncStatus.ResourcesToDelete, nil, resourceHelper, logger)
+		_, remainingResources, err := deleteFromTargetCluster(oldSyncStatus.ResourcesToDelete, nil, resourceHelper, logger)
 		if err != nil {
 			requeue = true
 			newSyncStatus := hiveintv1alpha1.SyncStatus{
@@ -617,16 +731,44 @@ func (r *ReconcileClusterSync) applySyncSets(
 		logger := logger.WithField(syncSetType, syncSet.AsMetaObject().GetName())
 		oldSyncStatus, indexOfOldStatus := getOldSyncStatus(syncSet, syncStatuses)
 
+		// Clusters that have applied a previous generation of a syncset being rolled out progressively keep that
+		// generation until the rollout reaches them.
+		if indexOfOldStatus >= 0 &&
+			oldSyncStatus.ObservedGeneration != syncSet.AsMetaObject().GetGeneration() &&
+			!isRolledOutToCluster(syncSet, cd, logger) {
+			logger.Debug("skipping apply of syncset since the rollout of its current generation has not reached the cluster")
+			newSyncStatuses = append(newSyncStatuses, oldSyncStatus)
+			continue
+		}
+
+		startTime := time.Now()
+		// Secrets from external secret stores are resolved before determining whether the syncset needs to be
+		// applied so that changes to the secrets are applied without waiting for the next full re-apply.
+		externalSecrets, externalSecretsHash, err := r.resolveExternalSecrets(ctx, syncSet, logger)
+		if err != nil {
+			requeue = true
+			newSyncStatus := failedSyncStatus(syncSet, oldSyncStatus, err)
+			newSyncStatuses = append(newSyncStatuses, newSyncStatus)
+			attempts[newSyncStatus.Name] = newSyncAttempt(startTime, newSyncStatus, nil)
+			continue
+		}
+
 		// Determine if the syncset needs to be applied
 		switch {
 		case needToDoFullReapply:
 			logger.Debug("applying syncset because it is time to do a full re-apply")
+		case resync.Has(syncSet.AsMetaObject().GetName()):
+			logger.Debug("applying syncset because a resync was requested")
 		case indexOfOldStatus < 0:
 			logger.Debug("applying syncset because the syncset is new")
 		case oldSyncStatus.Result != hiveintv1alpha1.SuccessSyncSetResult:
 			logger.Debug("applying syncset because the last attempt to apply failed")
 		case oldSyncStatus.ObservedGeneration != syncSet.AsMetaObject().GetGeneration():
 			logger.Debug("applying syncset because the syncset generation has changed")
+		case oldSyncStatus.SourceRevision != sourceRevision(syncSet):
+			logger.Debug("applying syncset because the revision of the syncset source has changed")
+		case oldSyncStatus.ExternalSecretsHash != externalSecretsHash:
+			logger.Debug("applying syncset because a secret from an external secret store has changed")
 		default:
 			logger.Debug("skipping apply of syncset since it is up-to-date and it is not time to do a full re-apply")
 			newSyncStatuses = append(newSyncStatuses, oldSyncStatus)
@@ -634,11 +776,25 @@ func (r *ReconcileClusterSync) applySyncSets(
 		}
 
 		// Apply the syncset
-		resourcesApplied, resourcesInSyncSet, syncSetNeedsRequeue, err := r.applySyncSet(syncSet, resourceHelper, logger)
+		// Resources that were applied from a previous revision of the source are left in place until the current
+		// revision can be read and rendered, rather than being deleted as though they had been removed from the
+		// syncset.
+		sourceResources, err := r.getSourceResources(syncSet, cd, logger)
+		if err != nil {
+			requeue = true
+			newSyncStatus := failedSyncStatus(syncSet, oldSyncStatus, err)
+			newSyncStatuses = append(newSyncStatuses, newSyncStatus)
+			attempts[newSyncStatus.Name] = newSyncAttempt(startTime, newSyncStatus, nil)
+			continue
+		}
+
+		resourcesApplied, resourcesInSyncSet, resourcesChanged, syncSetNeedsRequeue, err := r.applySyncSet(syncSet, sourceResources, externalSecrets, resourceHelper, logger)
 		newSyncStatus := hiveintv1alpha1.SyncStatus{
-			Name:               syncSet.AsMetaObject().GetName(),
-			ObservedGeneration: syncSet.AsMetaObject().GetGeneration(),
-			Result:             hiveintv1alpha1.SuccessSyncSetResult,
+			Name:                syncSet.AsMetaObject().GetName(),
+			ObservedGeneration:  syncSet.AsMetaObject().GetGeneration(),
+			SourceRevision:      sourceRevision(syncSet),
+			ExternalSecretsHash: externalSecretsHash,
+			Result:              hiveintv1alpha1.SuccessSyncSetResult,
 		}
 		applyMode := syncSet.GetSpec().ResourceApplyMode
 		if applyMode == hivev1.SyncResourceApplyMode {
@@ -659,7 +815,7 @@ func (r *ReconcileClusterSync) applySyncSets(
 
 		if indexOfOldStatus >= 0 {
 			// Delete any resources that were included in the syncset previously but are no longer included now.
-			remainingResources, err := deleteFromTargetCluster(
+			deletedResources, remainingResources, err := deleteFromTargetCluster(
 				oldSyncStatus.ResourcesToDelete,
 				func(r hiveintv1alpha1.SyncResourceReference) bool {
 					return !containsResource(resourcesInSyncSet, r)
@@ -676,9 +832,13 @@ func (r *ReconcileClusterSync) applySyncSets(
 				newSyncStatus.FailureMessage += err.Error()
 			}
 			newSyncStatus.ResourcesToDelete = mergeResources(newSyncStatus.ResourcesToDelete, remainingResources)
+			resourcesChanged = append(resourcesChanged, deletedResources...)
 
 			newSyncStatus.LastTransitionTime = oldSyncStatus.LastTransitionTime
 			newSyncStatus.FirstSuccessTime = oldSyncStatus.FirstSuccessTime
+			// The health is re-assessed after all syncsets have been applied.
+			newSyncStatus.Health = oldSyncStatus.Health
+			newSyncStatus.UnhealthyResources = oldSyncStatus.UnhealthyResources
 		}
 
 		// Update the last transition time if there were any changes to the sync status.
@@ -728,11 +888,113 @@ func (r *ReconcileClusterSync) applySyncSets(
 			return orderResources(newSyncStatus.ResourcesToDelete[i], newSyncStatus.ResourcesToDelete[j])
 		})
 		newSyncStatuses = append(newSyncStatuses, newSyncStatus)
+		attempts[newSyncStatus.Name] = newSyncAttempt(startTime, newSyncStatus, resourcesChanged)
 	}
 
 	return
 }
 
+// failedSyncStatus returns the sync status for a syncset which could not be applied because the resources to apply
+// could not be determined. Resources applied previously are left in place.
+func failedSyncStatus(syncSet CommonSyncSet, oldSyncStatus hiveintv1alpha1.SyncStatus, err error) hiveintv1alpha1.SyncStatus {
+	newSyncStatus := oldSyncStatus
+	newSyncStatus.Name = syncSet.AsMetaObject().GetName()
+	newSyncStatus.Result = hiveintv1alpha1.FailureSyncSetResult
+	newSyncStatus.FailureMessage = err.Error()
+	if !reflect.DeepEqual(oldSyncStatus, newSyncStatus) {
+		newSyncStatus.LastTransitionTime = metav1.Now()
+	}
+	return newSyncStatus
+}
+
+func newSyncAttempt(startTime time.Time, syncStatus hiveintv1alpha1.SyncStatus, resourcesChanged []hiveintv1alpha1.SyncResourceReference) hiveintv1alpha1.SyncAttempt {
+	attempt := hiveintv1alpha1.SyncAttempt{
+		Time:                  metav1.NewTime(startTime),
+		Result:                syncStatus.Result,
+		Duration:              metav1.Duration{Duration: time.Since(startTime).Round(time.Millisecond)},
+		ResourcesChanged:      resourcesChanged,
+		ResourcesChangedCount: int32(len(resourcesChanged)),
+	}
+	if len(resourcesChanged) > maxResourcesChangedPerAttempt {
+		attempt.ResourcesChanged = resourcesChanged[:maxResourcesChangedPerAttempt]
+	}
+	if syncStatus.Result == hiveintv1alpha1.FailureSyncSetResult {
+		attempt.FailureMessage = syncStatus.FailureMessage
+	}
+	return attempt
+}
+
+// syncHistoryLimitFor returns the number of attempts kept for each syncset when the cluster has the given number of
+// syncsets and selectorsyncsets, so that the history of all of them stays within syncHistoryTotalLimit.
+func syncHistoryLimitFor(syncSetCount int) int {
+	if syncSetCount <= syncHistoryTotalLimit/syncHistoryLimit {
+		return syncHistoryLimit
+	}
+	if syncSetCount >= syncHistoryTotalLimit {
+		return 1
+	}
+	return syncHistoryTotalLimit / syncSetCount
+}
+
+// updateSyncHistory adds the attempts to apply syncsets to the history of the syncsets, keeping only the limit most
+// recent attempts for each syncset. An attempt that fails in the same way as the previous attempt, without changing any
+// resources, is counted in the record of the previous attempt rather than being added on its own. The history of
+// syncsets that no longer have a sync status is dropped.
+func updateSyncHistory(
+	history []hiveintv1alpha1.SyncHistory,
+	syncStatuses []hiveintv1alpha1.SyncStatus,
+	attempts map[string]hiveintv1alpha1.SyncAttempt,
+	limit int,
+) []hiveintv1alpha1.SyncHistory {
+	var newHistory []hiveintv1alpha1.SyncHistory
+	for _, syncStatus := range syncStatuses {
+		var syncSetAttempts []hiveintv1alpha1.SyncAttempt
+		for _, h := range history {
+			if h.Name == syncStatus.Name {
+				syncSetAttempts = append(syncSetAttempts, h.Attempts...)
+				break
+			}
+		}
+		if attempt, ok := attempts[syncStatus.Name]; ok {
+			if n := len(syncSetAttempts); n > 0 && isRepeatedFailure(syncSetAttempts[n-1], attempt) {
+				syncSetAttempts[n-1] = collapseAttempt(syncSetAttempts[n-1], attempt)
+			} else {
+				syncSetAttempts = append(syncSetAttempts, attempt)
+			}
+		}
+		if excess := len(syncSetAttempts) - limit; excess > 0 {
+			syncSetAttempts = syncSetAttempts[excess:]
+		}
+		if len(syncSetAttempts) > 0 {
+			newHistory = append(newHistory, hiveintv1alpha1.SyncHistory{Name: syncStatus.Name, Attempts: syncSetAttempts})
+		}
+	}
+	return newHistory
+}
+
+// isRepeatedFailure determines whether an attempt failed in the same way as the previous attempt, with neither of them
+// having changed any resources in the cluster.
+func isRepeatedFailure(previous, attempt hiveintv1alpha1.SyncAttempt) bool {
+	return previous.Result == hiveintv1alpha1.FailureSyncSetResult &&
+		attempt.Result == hiveintv1alpha1.FailureSyncSetResult &&
+		previous.FailureMessage == attempt.FailureMessage &&
+		previous.ResourcesChangedCount == 0 &&
+		attempt.ResourcesChangedCount == 0
+}
+
+// collapseAttempt counts a repeated failure in the record of the previous attempt. The record keeps the start time of
+// the first attempt and takes the start time and duration of the most recent one.
+func collapseAttempt(previous, attempt hiveintv1alpha1.SyncAttempt) hiveintv1alpha1.SyncAttempt {
+	collapsed := *previous.DeepCopy()
+	if collapsed.Count == 0 {
+		collapsed.Count = 1
+	}
+	collapsed.Count++
+	collapsed.LastTime = attempt.Time.DeepCopy()
+	collapsed.Duration = attempt.Duration
+	return collapsed
+}
+
 func getOldSyncStatus(syncSet CommonSyncSet, syncSetStatuses []hiveintv1alpha1.SyncStatus) (hiveintv1alpha1.SyncStatus, int) {
 	for i, status := range syncSetStatuses {
 		if status.Name == syncSet.AsMetaObject().GetName() {
@@ -742,17 +1004,32 @@ func getOldSyncStatus(syncSet CommonSyncSet, syncSetStatuses []hiveintv1alpha1.S
 	return hiveintv1alpha1.SyncStatus{}, -1
 }
 
+// isRolledOutToCluster determines whether the current generation of the syncset may be applied to the cluster.
+// Only SelectorSyncSets support progressive rollouts.
+func isRolledOutToCluster(syncSet CommonSyncSet, cd *hivev1.ClusterDeployment, logger log.FieldLogger) bool {
+	sss, ok := syncSet.(*SelectorSyncSetAsCommon)
+	if !ok {
+		return true
+	}
+	return controllerutils.IsClusterInSelectorSyncSetRollout((*hivev1.SelectorSyncSet)(sss), cd, logger)
+}
+
 func (r *ReconcileClusterSync) applySyncSet(
 	syncSet CommonSyncSet,
+	sourceResources []runtime.RawExtension,
+	externalSecrets map[int]map[string][]byte,
 	resourceHelper resource.Helper,
 	logger log.FieldLogger,
 ) (
 	resourcesApplied []hiveintv1alpha1.SyncResourceReference,
 	resourcesInSyncSet []hiveintv1alpha1.SyncResourceReference,
+	resourcesChanged []hiveintv1alpha1.SyncResourceReference,
 	requeue bool,
 	returnErr error,
 ) {
-	resources, referencesToResources, decodeErr := decodeResources(syncSet, logger)
+	rawResources := make([]runtime.RawExtension, 0, len(syncSet.GetSpec().Resources)+len(sourceResources))
+	rawResources = append(append(rawResources, syncSet.GetSpec().Resources...), sourceResources...)
+	resources, referencesToResources, decodeErr := decodeResources(rawResources, logger)
 	referencesToSecrets := referencesToSecrets(syncSet)
 	resourcesInSyncSet = append(referencesToResources, referencesToSecrets...)
 	if decodeErr != nil {
@@ -773,7 +1050,11 @@ func (r *ReconcileClusterSync) applySyncSet(
 
 	// Apply Resources
 	for i, resource := range resources {
-		returnErr, requeue = r.applyResource(i, resource, referencesToResources[i], applyFn, applyFnMetricsLabel, logger)
+		var changed bool
+		changed, returnErr, requeue = r.applyResource(i, resource, referencesToResources[i], applyFn, applyFnMetricsLabel, logger)
+		if changed {
+			resourcesChanged = append(resourcesChanged, referencesToResources[i])
+		}
 		if returnErr != nil {
 			resourcesApplied = referencesToResources[:i]
 			return
@@ -783,7 +1064,11 @@ func (r *ReconcileClusterSync) applySyncSet(
 
 	// Apply Secrets
 	for i, secretMapping := range syncSet.GetSpec().Secrets {
-		returnErr, requeue = r.applySecret(syncSet, i, secretMapping, referencesToSecrets[i], applyFn, applyFnMetricsLabel, logger)
+		var changed bool
+		changed, returnErr, requeue = r.applySecret(syncSet, i, secretMapping, externalSecrets[i], referencesToSecrets[i], applyFn, applyFnMetricsLabel, logger)
+		if changed {
+			resourcesChanged = append(resourcesChanged, referencesToSecrets[i])
+		}
 		if returnErr != nil {
 			resourcesApplied = append(resourcesApplied, referencesToSecrets[:i]...)
 			return
@@ -803,11 +1088,11 @@ func (r *ReconcileClusterSync) applySyncSet(
 	return
 }
 
-func decodeResources(syncSet CommonSyncSet, logger log.FieldLogger) (
+func decodeResources(rawResources []runtime.RawExtension, logger log.FieldLogger) (
 	resources []*unstructured.Unstructured, references []hiveintv1alpha1.SyncResourceReference, returnErr error,
 ) {
 	var decodeErrors []error
-	for i, resource := range syncSet.GetSpec().Resources {
+	for i, resource := range rawResources {
 		u := &unstructured.Unstructured{}
 		if err := yaml.Unmarshal(resource.Raw, u); err != nil {
 			logger.WithField("resourceIndex", i).WithError(err).Warn("error decoding unstructured object")
@@ -826,6 +1111,130 @@ func decodeResources(syncSet CommonSyncSet, logger log.FieldLogger) (
 	return
 }
 
+// sourceRevision is the revision of the source, or of the Helm chart pulled from a chart repository, that has been
+// fetched for the syncset, if the syncset is fetched.
+func sourceRevision(syncSet CommonSyncSet) string {
+	if !syncsetsource.IsFetched(syncSet.GetSpec()) || syncSet.GetSourceStatus() == nil {
+		return ""
+	}
+	return syncSet.GetSourceStatus().ResolvedRevision
+}
+
+// getSourceResources reads the resources fetched from the source of the syncset by the syncsetsource controller from
+// the cache secret, and renders the resources of the syncset with Kustomize or Helm. Helm charts in chart repositories
+// are pulled into the cache secret by the syncsetsource controller too, so nothing is fetched here.
+func (r *ReconcileClusterSync) getSourceResources(syncSet CommonSyncSet, cd *hivev1.ClusterDeployment, logger log.FieldLogger) ([]runtime.RawExtension, error) {
+	spec := syncSet.GetSpec()
+	rendered := syncsetsource.IsRendered(spec)
+	var (
+		resources []runtime.RawExtension
+		files     map[string][]byte
+	)
+	if syncsetsource.IsFetched(spec) {
+		secret, err := r.getSourceCache(syncSet, logger)
+		if err != nil {
+			return nil, err
+		}
+		if rendered {
+			files, err = syncsetsource.DecodeFiles(secret.Data[syncsetsource.FilesSecretKey])
+		} else {
+			resources, err = syncsetsource.DecodeManifests(secret.Data[syncsetsource.ManifestsSecretKey])
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode source cache")
+		}
+	}
+	if !rendered {
+		return resources, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), renderTimeout)
+	defer cancel()
+	resources, err := syncsetsource.Render(ctx, spec, syncSet.AsMetaObject().GetName(), files, cd)
+	if err != nil {
+		logger.WithError(err).Info("failed to render syncset")
+		return nil, errors.Wrap(err, "failed to render")
+	}
+	return resources, nil
+}
+
+// getSourceCache gets the secret in which the syncsetsource controller caches the source of the syncset, ensuring
+// that it holds the revision of the source for the current generation of the syncset.
+func (r *ReconcileClusterSync) getSourceCache(syncSet CommonSyncSet, logger log.FieldLogger) (*corev1.Secret, error) {
+	status := syncSet.GetSourceStatus()
+	if status == nil || status.ResolvedRevision == "" {
+		logger.Debug("source of syncset has not been fetched yet")
+		return nil, errors.New("source has not been fetched yet")
+	}
+	if status.ObservedGeneration != syncSet.AsMetaObject().GetGeneration() {
+		logger.Debug("source of syncset has not been fetched for the current generation")
+		if status.FailureMessage != "" {
+			return nil, fmt.Errorf("failed to fetch source: %s", status.FailureMessage)
+		}
+		return nil, errors.New("source has not been fetched for the current generation yet")
+	}
+	namespace := syncSet.AsMetaObject().GetNamespace()
+	if namespace == "" {
+		namespace = controllerutils.GetHiveNamespace()
+	}
+	secret := &corev1.Secret{}
+	if err := r.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: syncsetsource.CacheSecretName(syncSet.AsMetaObject().GetName())}, secret); err != nil {
+		logger.WithError(err).Log(controllerutils.LogLevel(err), "cannot read source cache secret")
+		return nil, errors.Wrap(err, "failed to read source cache")
+	}
+	if revision := string(secret.Data[syncsetsource.RevisionSecretKey]); revision != status.ResolvedRevision {
+		logger.WithField("cachedRevision", revision).WithField("resolvedRevision", status.ResolvedRevision).
+			Debug("source cache does not hold the resolved revision")
+		return nil, fmt.Errorf("source cache holds revision %q instead of %q", revision, status.ResolvedRevision)
+	}
+	return secret, nil
+}
+
+// resolveExternalSecrets reads the secrets of the secret mappings of the syncset that have an external source,
+// keyed by the index of the secret mapping, along with a hash of the secrets. The hash is empty if the syncset has no
+// secrets from external secret stores.
+func (r *ReconcileClusterSync) resolveExternalSecrets(ctx context.Context, syncSet CommonSyncSet, logger log.FieldLogger) (map[int]map[string][]byte, string, error) {
+	var externalSecrets map[int]map[string][]byte
+	var data []map[string][]byte
+	for i, secretMapping := range syncSet.GetSpec().Secrets {
+		if secretMapping.ExternalSource == nil {
+			continue
+		}
+		secret, err := r.secretResolver.Resolve(ctx, secretMapping.ExternalSource, syncSet.AsMetaObject().GetNamespace())
+		if err != nil {
+			logger.WithError(err).WithField("secretIndex", i).Warn("cannot read secret from external secret store")
+			return nil, "", errors.Wrapf(err, "failed to read secret %d from external secret store", i)
+		}
+		if externalSecrets == nil {
+			externalSecrets = map[int]map[string][]byte{}
+		}
+		externalSecrets[i] = secret
+		data = append(data, secret)
+	}
+	if externalSecrets == nil {
+		return nil, "", nil
+	}
+	return externalSecrets, secretsource.Hash(data...), nil
+}
+
+// externalSecretsRefreshInterval returns the shortest refresh interval of the secrets from external secret stores in
+// the syncsets, or false if none of the syncsets have secrets from external secret stores.
+func externalSecretsRefreshInterval(syncSets []CommonSyncSet) (time.Duration, bool) {
+	var refreshInterval time.Duration
+	found := false
+	for _, syncSet := range syncSets {
+		for _, secretMapping := range syncSet.GetSpec().Secrets {
+			if secretMapping.ExternalSource == nil {
+				continue
+			}
+			if interval := secretsource.RefreshInterval(secretMapping.ExternalSource); !found || interval < refreshInterval {
+				refreshInterval = interval
+			}
+			found = true
+		}
+	}
+	return refreshInterval, found
+}
+
 func referencesToSecrets(syncSet CommonSyncSet) []hiveintv1alpha1.SyncResourceReference {
 	var references []hiveintv1alpha1.SyncResourceReference
 	for _, secretMapping := range syncSet.GetSpec().Secrets {
@@ -846,38 +1255,72 @@ func (r *ReconcileClusterSync) applyResource(
 	applyFn func(obj []byte) (resource.ApplyResult, error),
 	applyFnMetricsLabel string,
 	logger log.FieldLogger,
-) (returnErr error, requeue bool) {
+) (changed bool, returnErr error, requeue bool) {
 	logger = logger.WithField("resourceIndex", resourceIndex).
 		WithField("resourceNamespace", reference.Namespace).
 		WithField("resourceName", reference.Name).
 		WithField("resourceAPIVersion", reference.APIVersion).
 		WithField("resourceKind", reference.Kind)
 	logger.Debug("applying resource")
-	if err := applyToTargetCluster(resource, applyFnMetricsLabel, applyFn, logger); err != nil {
-		return errors.Wrapf(err, "failed to apply resource %d", resourceIndex), true
+	changed, err := applyToTargetCluster(resource, applyFnMetricsLabel, applyFn, logger)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to apply resource %d", resourceIndex), true
 	}
-	return nil, false
+	return changed, nil, false
 }
 
 func (r *ReconcileClusterSync) applySecret(
 	syncSet CommonSyncSet,
 	secretIndex int,
 	secretMapping hivev1.SecretMapping,
+	externalSecret map[string][]byte,
 	reference hiveintv1alpha1.SyncResourceReference,
 	applyFn func(obj []byte) (resource.ApplyResult, error),
 	applyFnMetricsLabel string,
 	logger log.FieldLogger,
-) (returnErr error, requeue bool) {
+) (changed bool, returnErr error, requeue bool) {
 	logger = logger.WithField("secretIndex", secretIndex).
 		WithField("secretNamespace", reference.Namespace).
 		WithField("secretName", reference.Name)
+	secret, returnErr, requeue := r.buildSecret(syncSet, secretIndex, secretMapping, externalSecret, logger)
+	if returnErr != nil {
+		return false, returnErr, requeue
+	}
+	logger.Debug("applying secret")
+	changed, err := applyToTargetCluster(secret, applyFnMetricsLabel, applyFn, logger)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to apply secret %d", secretIndex), true
+	}
+	return changed, nil, false
+}
+
+// buildSecret builds the secret to apply to the target cluster for a secret mapping, either from the data read from
+// an external secret store or by copying the source secret.
+func (r *ReconcileClusterSync) buildSecret(
+	syncSet CommonSyncSet,
+	secretIndex int,
+	secretMapping hivev1.SecretMapping,
+	externalSecret map[string][]byte,
+	logger log.FieldLogger,
+) (secret *corev1.Secret, returnErr error, requeue bool) {
+	if secretMapping.ExternalSource != nil {
+		return &corev1.Secret{
+			TypeMeta: metav1.TypeMeta{APIVersion: secretAPIVersion, Kind: secretKind},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: secretMapping.TargetRef.Namespace,
+				Name:      secretMapping.TargetRef.Name,
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: externalSecret,
+		}, nil, false
+	}
 	syncSetNamespace := syncSet.AsMetaObject().GetNamespace()
 	srcNamespace := secretMapping.SourceRef.Namespace
 	if srcNamespace == "" {
 		// The namespace of the source secret is required for SelectorSyncSets.
 		if syncSetNamespace == "" {
 			logger.Warn("namespace must be specified for source secret")
-			return fmt.Errorf("source namespace missing for secret %d", secretIndex), false
+			return nil, fmt.Errorf("source namespace missing for secret %d", secretIndex), false
 		}
 		// Use the namespace of the SyncSet if the namespace of the source secret is omitted.
 		srcNamespace = syncSetNamespace
@@ -885,13 +1328,13 @@ func (r *ReconcileClusterSync) applySecret(
 		// If the namespace of the source secret is specified, then it must match the namespace of the SyncSet.
 		if syncSetNamespace != "" && syncSetNamespace != srcNamespace {
 			logger.Warn("source secret must be in same namespace as SyncSet")
-			return fmt.Errorf("source in wrong namespace for secret %d", secretIndex), false
+			return nil, fmt.Errorf("source in wrong namespace for secret %d", secretIndex), false
 		}
 	}
-	secret := &corev1.Secret{}
+	secret = &corev1.Secret{}
 	if err := r.Get(context.Background(), types.NamespacedName{Namespace: srcNamespace, Name: secretMapping.SourceRef.Name}, secret); err != nil {
 		logger.WithError(err).Log(controllerutils.LogLevel(err), "cannot read secret")
-		return errors.Wrapf(err, "failed to read secret %d", secretIndex), true
+		return nil, errors.Wrapf(err, "failed to read secret %d", secretIndex), true
 	}
 	// Clear out the fields of the metadata which are specific to the cluster to which the secret belongs.
 	secret.ObjectMeta = metav1.ObjectMeta{
@@ -900,11 +1343,7 @@ func (r *ReconcileClusterSync) applySecret(
 		Annotations: secret.Annotations,
 		Labels:      secret.Labels,
 	}
-	logger.Debug("applying secret")
-	if err := applyToTargetCluster(secret, applyFnMetricsLabel, applyFn, logger); err != nil {
-		return errors.Wrapf(err, "failed to apply secret %d", secretIndex), true
-	}
-	return nil, false
+	return secret, nil, false
 }
 
 func (r *ReconcileClusterSync) applyPatch(
@@ -931,25 +1370,29 @@ func (r *ReconcileClusterSync) applyPatch(
 	return nil, false
 }
 
-func applyToTargetCluster(
-	obj hivev1.MetaRuntimeObject,
-	applyFnMetricLabel string,
-	applyFn func(obj []byte) (resource.ApplyResult, error),
-	logger log.FieldLogger,
-) error {
-	startTime := time.Now()
+// setHiveManagedLabel injects the hive managed label to help end-users see that a resource is managed by hive.
+func setHiveManagedLabel(obj metav1.Object) {
 	labels := obj.GetLabels()
 	if labels == nil {
 		labels = make(map[string]string, 1)
 	}
-	// Inject the hive managed annotation to help end-users see that a resource is managed by hive:
 	labels[constants.HiveManagedLabel] = "true"
 	obj.SetLabels(labels)
+}
+
+func applyToTargetCluster(
+	obj hivev1.MetaRuntimeObject,
+	applyFnMetricLabel string,
+	applyFn func(obj []byte) (resource.ApplyResult, error),
+	logger log.FieldLogger,
+) (changed bool, returnErr error) {
+	startTime := time.Now()
+	setHiveManagedLabel(obj)
 
 	bytes, err := json.Marshal(obj)
 	if err != nil {
 		logger.WithError(err).Error("error marshalling unstructured object to json bytes")
-		return err
+		return false, err
 	}
 
 	applyResult, err := applyFn(bytes)
@@ -965,7 +1408,8 @@ func applyToTargetCluster(
 		metricResourcesApplied.WithLabelValues(applyFnMetricLabel, metricResultSuccess).Inc()
 		metricTimeToApplySyncSetResource.WithLabelValues(applyFnMetricLabel, metricResultSuccess).Observe(applyTime)
 	}
-	return err
+	// A resource for which the result could not be determined may have been changed.
+	return err == nil && applyResult != resource.UnchangedApplyResult, err
 }
 
 func deleteFromTargetCluster(
@@ -973,7 +1417,7 @@ func deleteFromTargetCluster(
 	shouldDelete func(hiveintv1alpha1.SyncResourceReference) bool,
 	resourceHelper resource.Helper,
 	logger log.FieldLogger,
-) (remainingResources []hiveintv1alpha1.SyncResourceReference, returnErr error) {
+) (deletedResources, remainingResources []hiveintv1alpha1.SyncResourceReference, returnErr error) {
 	var allErrs []error
 	for _, r := range resources {
 		if shouldDelete != nil && !shouldDelete(r) {
@@ -989,9 +1433,11 @@ func deleteFromTargetCluster(
 			logger.WithError(err).Warn("could not delete resource")
 			allErrs = append(allErrs, fmt.Errorf("failed to delete %s, Kind=%s %s/%s: %w", r.APIVersion, r.Kind, r.Namespace, r.Name, err))
 			remainingResources = append(remainingResources, r)
+			continue
 		}
+		deletedResources = append(deletedResources, r)
 	}
-	return remainingResources, utilerrors.NewAggregate(allErrs)
+	return deletedResources, remainingResources, utilerrors.NewAggregate(allErrs)
 }
 
 func (r *ReconcileClusterSync) getSyncSetsForClusterDeployment(cd *hivev1.ClusterDeployment, logger log.FieldLogger) ([]CommonSyncSet, error) {
@@ -3,15 +3,20 @@ package clustersync
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/utils/lru"
 	"k8s.io/utils/pointer"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -23,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/json"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
@@ -32,6 +38,9 @@ import (
 	remoteclientmock "github.com/openshift/hive/pkg/remoteclient/mock"
 	"github.com/openshift/hive/pkg/resource"
 	resourcemock "github.com/openshift/hive/pkg/resource/mock"
+	"github.com/openshift/hive/pkg/secretsource"
+	"github.com/openshift/hive/pkg/syncsethealth"
+	"github.com/openshift/hive/pkg/syncsetsource"
 	hiveassert "github.com/openshift/hive/pkg/test/assert"
 	testcd "github.com/openshift/hive/pkg/test/clusterdeployment"
 	testcs "github.com/openshift/hive/pkg/test/clustersync"
@@ -47,7 +56,7 @@ import (
 const (
 	testNamespace       = "test-namespace"
 	testCDName          = "test-cluster-deployment"
-	testCDUID           = "test-cluster-deployment-uid"
+	testCDUID           = "1138528c-c36e-11e9-a1a7-42010a800194"
 	testClusterSyncName = testCDName
 	testClusterSyncUID  = "test-cluster-sync-uid"
 	testLeaseName       = testCDName
@@ -74,6 +83,8 @@ type reconcileTest struct {
 	expectUnchangedLeaseRenewTime bool
 	expectRequeue                 bool
 	expectNoWorkDone              bool
+	// A non-zero expectedRequeueAfter is the expected RequeueAfter rather than the time until the next full re-apply.
+	expectedRequeueAfter time.Duration
 }
 
 func newReconcileTest(t *testing.T, mockCtrl *gomock.Controller, scheme *runtime.Scheme, existing ...runtime.Object) *reconcileTest {
@@ -100,6 +111,9 @@ func newReconcileTest(t *testing.T, mockCtrl *gomock.Controller, scheme *runtime
 		remoteClusterAPIClientBuilder: func(*hivev1.ClusterDeployment) remoteclient.Builder {
 			return mockRemoteClientBuilder
 		},
+		secretResolver:       secretsource.NewResolver(c, nil, logger),
+		healthResources:      lru.New(healthResourcesCacheSize),
+		healthRecheckBackoff: flowcontrol.NewBackOff(healthRecheckInterval, maxHealthRecheckInterval),
 	}
 
 	return &reconcileTest{
@@ -148,6 +162,8 @@ func (rt *reconcileTest) run(t *testing.T) {
 	assert.True(t, result.Requeue, "expected requeue to be true")
 	if rt.expectRequeue {
 		assert.Zero(t, result.RequeueAfter, "unexpected requeue after")
+	} else if rt.expectedRequeueAfter != 0 {
+		assert.Equal(t, rt.expectedRequeueAfter, result.RequeueAfter, "unexpected requeue after")
 	} else {
 		var minRequeueAfter, maxRequeueAfter float64
 		if rt.expectUnchangedLeaseRenewTime {
@@ -452,7 +468,7 @@ func TestIsSyncAssignedToMe(t *testing.T) {
 				teststatefulset.WithReplicas(3),
 			),
 			clusterDeployment: testcd.FullBuilder(testNamespace, testCDName, scheme).Build(
-				testcd.Generic(testgeneric.WithUID("1138528c-c36e-11e9-a1a7-42010a800195")),
+				testcd.Generic(testgeneric.WithUID("1138528c-c36e-11e9-a1a7-42010a800194")),
 			),
 			expectedOrdinalID: 0,
 			expectedErr:       false,
@@ -466,7 +482,7 @@ func TestIsSyncAssignedToMe(t *testing.T) {
 				teststatefulset.WithReplicas(3),
 			),
 			clusterDeployment: testcd.FullBuilder(testNamespace, testCDName, scheme).Build(
-				testcd.Generic(testgeneric.WithUID("1138528c-c36e-11e9-a1a7-42010a800196")),
+				testcd.Generic(testgeneric.WithUID("1138528c-c36e-11e9-a1a7-42010a800195")),
 			),
 			expectedErr: false,
 		},
@@ -479,7 +495,7 @@ func TestIsSyncAssignedToMe(t *testing.T) {
 				teststatefulset.WithReplicas(3),
 			),
 			clusterDeployment: testcd.FullBuilder(testNamespace, testCDName, scheme).Build(
-				testcd.Generic(testgeneric.WithUID("1138528c-c36e-11e9-a1a7-42010a800196")),
+				testcd.Generic(testgeneric.WithUID("1138528c-c36e-11e9-a1a7-42010a800195")),
 			),
 			expectedErr: false,
 		},
@@ -492,7 +508,7 @@ func TestIsSyncAssignedToMe(t *testing.T) {
 				teststatefulset.WithReplicas(3),
 			),
 			clusterDeployment: testcd.FullBuilder(testNamespace, testCDName, scheme).Build(
-				testcd.Generic(testgeneric.WithUID("1138528c-c36e-11e9-a1a7-42010a800197")),
+				testcd.Generic(testgeneric.WithUID("1138528c-c36e-11e9-a1a7-42010a800190")),
 			),
 			expectedErr: false,
 		},
@@ -505,7 +521,7 @@ func TestIsSyncAssignedToMe(t *testing.T) {
 				teststatefulset.WithReplicas(3),
 			),
 			clusterDeployment: testcd.FullBuilder(testNamespace, testCDName, scheme).Build(
-				testcd.Generic(testgeneric.WithUID("1138528c-c36e-11e9-a1a7-42010a800197")),
+				testcd.Generic(testgeneric.WithUID("1138528c-c36e-11e9-a1a7-42010a800190")),
 			),
 			expectedErr: false,
 		},
@@ -518,7 +534,7 @@ func TestIsSyncAssignedToMe(t *testing.T) {
 				teststatefulset.WithReplicas(3),
 			),
 			clusterDeployment: testcd.FullBuilder(testNamespace, testCDName, scheme).Build(
-				testcd.Generic(testgeneric.WithUID("1138528c-c36e-11e9-a1a7-42010a800198")),
+				testcd.Generic(testgeneric.WithUID("1138528c-c36e-11e9-a1a7-42010a800194")),
 			),
 			expectedErr: false,
 		},
@@ -2419,100 +2435,1196 @@ func TestReconcileClusterSync_UpsertToSyncResourceApplyMode(t *testing.T) {
 	}
 }
 
-func cdBuilder(scheme *runtime.Scheme) testcd.Builder {
-	return testcd.FullBuilder(testNamespace, testCDName, scheme).
-		GenericOptions(
-			testgeneric.WithUID(testCDUID),
-		).
-		Options(
-			testcd.Installed(),
-			testcd.WithCondition(hivev1.ClusterDeploymentCondition{
-				Type:   hivev1.UnreachableCondition,
-				Status: corev1.ConditionFalse,
-			}),
-			testcd.InstalledTimestamp(time.Now()),
-		)
+func TestReconcileClusterSync_SelectorSyncSetRollout(t *testing.T) {
+	cases := []struct {
+		name        string
+		canary      bool
+		phase       hivev1.SelectorSyncSetRolloutPhase
+		rolloutGen  int64
+		expectApply bool
+	}{
+		{
+			name:        "rollout not started",
+			phase:       hivev1.ProgressingSelectorSyncSetRolloutPhase,
+			rolloutGen:  1,
+			expectApply: false,
+		},
+		{
+			name:        "rollout has not reached cluster",
+			phase:       hivev1.ProgressingSelectorSyncSetRolloutPhase,
+			rolloutGen:  2,
+			expectApply: false,
+		},
+		{
+			name:        "canary cluster",
+			canary:      true,
+			phase:       hivev1.ProgressingSelectorSyncSetRolloutPhase,
+			rolloutGen:  2,
+			expectApply: true,
+		},
+		{
+			name:        "rollout halted",
+			phase:       hivev1.HaltedSelectorSyncSetRolloutPhase,
+			rolloutGen:  2,
+			expectApply: false,
+		},
+		{
+			name:        "rollout complete",
+			phase:       hivev1.CompleteSelectorSyncSetRolloutPhase,
+			rolloutGen:  2,
+			expectApply: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			scheme := scheme.GetScheme()
+			cdOpts := []testcd.Option{testcd.WithLabel("test-label-key", "test-label-value")}
+			if tc.canary {
+				cdOpts = append(cdOpts, testcd.WithLabel("canary", "true"))
+			}
+			resourceToApply := testConfigMap("dest-namespace", "dest-name")
+			selectorSyncSet := testselectorsyncset.FullBuilder("test-selectorsyncset", scheme).Build(
+				testselectorsyncset.WithLabelSelector("test-label-key", "test-label-value"),
+				testselectorsyncset.WithGeneration(2),
+				testselectorsyncset.WithResources(resourceToApply),
+				testselectorsyncset.WithRolloutStrategy(&hivev1.SelectorSyncSetRolloutStrategy{
+					Canary: &hivev1.SelectorSyncSetCanary{
+						Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+					},
+				}),
+				testselectorsyncset.WithRolloutStatus(&hivev1.SelectorSyncSetRolloutStatus{
+					ObservedGeneration: tc.rolloutGen,
+					Phase:              tc.phase,
+				}),
+			)
+			existingSyncStatus := buildSyncStatus("test-selectorsyncset",
+				withTransitionInThePast(),
+				withFirstSuccessTimeInThePast(),
+			)
+			rt := newReconcileTest(t, mockCtrl, scheme,
+				cdBuilder(scheme).Build(cdOpts...),
+				clusterSyncBuilder(scheme).Build(testcs.WithSelectorSyncSetStatus(existingSyncStatus)),
+				teststatefulset.FullBuilder("hive", stsName, scheme).Build(
+					teststatefulset.WithCurrentReplicas(3),
+					teststatefulset.WithReplicas(3),
+				),
+				selectorSyncSet,
+				buildSyncLease(time.Now().Add(-time.Hour)),
+			)
+			if tc.expectApply {
+				rt.mockResourceHelper.EXPECT().Apply(newApplyMatcher(resourceToApply)).Return(resource.CreatedApplyResult, nil)
+				rt.expectedSelectorSyncSetStatuses = []hiveintv1alpha1.SyncStatus{
+					buildSyncStatus("test-selectorsyncset",
+						withFirstSuccessTimeInThePast(),
+						withObservedGeneration(2),
+					),
+				}
+			} else {
+				rt.expectedSelectorSyncSetStatuses = []hiveintv1alpha1.SyncStatus{existingSyncStatus}
+			}
+			rt.expectUnchangedLeaseRenewTime = true
+			rt.run(t)
+		})
+	}
 }
 
-func clusterSyncBuilder(scheme *runtime.Scheme) testcs.Builder {
-	return testcs.FullBuilder(testNamespace, testClusterSyncName, scheme).GenericOptions(
-		testgeneric.WithUID(testClusterSyncUID),
-		testgeneric.WithOwnerReference(cdBuilder(scheme).Build()),
+func TestReconcileClusterSync_SyncSetSource(t *testing.T) {
+	const (
+		oldRevision = "1111111111111111111111111111111111111111"
+		newRevision = "2222222222222222222222222222222222222222"
 	)
-}
-
-func buildSyncLease(t time.Time) *hiveintv1alpha1.ClusterSyncLease {
-	return &hiveintv1alpha1.ClusterSyncLease{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: testNamespace,
-			Name:      testLeaseName,
-			OwnerReferences: []metav1.OwnerReference{{
-				APIVersion:         "hiveinternal.openshift.io/v1alpha1",
-				Kind:               "ClusterSync",
-				Name:               testClusterSyncName,
-				UID:                testClusterSyncUID,
-				BlockOwnerDeletion: pointer.BoolPtr(true),
-			}},
+	cases := []struct {
+		name                   string
+		sourceStatus           *hivev1.SyncSetSourceStatus
+		cachedRevision         string
+		existingSyncStatus     *hiveintv1alpha1.SyncStatus
+		expectApply            bool
+		expectedFailure        string
+		expectedSourceRevision string
+	}{
+		{
+			name:                   "new syncset",
+			sourceStatus:           &hivev1.SyncSetSourceStatus{ObservedGeneration: 1, ResolvedRevision: newRevision},
+			cachedRevision:         newRevision,
+			expectApply:            true,
+			expectedSourceRevision: newRevision,
 		},
-		Spec: hiveintv1alpha1.ClusterSyncLeaseSpec{
-			RenewTime: metav1.NewMicroTime(t),
+		{
+			name:           "source revision changed",
+			sourceStatus:   &hivev1.SyncSetSourceStatus{ObservedGeneration: 1, ResolvedRevision: newRevision},
+			cachedRevision: newRevision,
+			existingSyncStatus: func() *hiveintv1alpha1.SyncStatus {
+				s := buildSyncStatus("test-syncset", withSourceRevision(oldRevision), withTransitionInThePast(), withFirstSuccessTimeInThePast())
+				return &s
+			}(),
+			expectApply:            true,
+			expectedSourceRevision: newRevision,
+		},
+		{
+			name:           "source revision unchanged",
+			sourceStatus:   &hivev1.SyncSetSourceStatus{ObservedGeneration: 1, ResolvedRevision: newRevision},
+			cachedRevision: newRevision,
+			existingSyncStatus: func() *hiveintv1alpha1.SyncStatus {
+				s := buildSyncStatus("test-syncset", withSourceRevision(newRevision), withTransitionInThePast(), withFirstSuccessTimeInThePast())
+				return &s
+			}(),
+			expectedSourceRevision: newRevision,
+		},
+		{
+			name: "source not fetched",
+			existingSyncStatus: func() *hiveintv1alpha1.SyncStatus {
+				s := buildSyncStatus("test-syncset", withSourceRevision(oldRevision), withTransitionInThePast(), withFirstSuccessTimeInThePast())
+				return &s
+			}(),
+			expectedFailure:        "source has not been fetched yet",
+			expectedSourceRevision: oldRevision,
+		},
+		{
+			name:                   "source not fetched for current generation",
+			sourceStatus:           &hivev1.SyncSetSourceStatus{ObservedGeneration: 0, ResolvedRevision: newRevision},
+			cachedRevision:         newRevision,
+			expectedFailure:        "source has not been fetched for the current generation yet",
+			expectedSourceRevision: "",
+		},
+		{
+			name:                   "cache holds other revision",
+			sourceStatus:           &hivev1.SyncSetSourceStatus{ObservedGeneration: 1, ResolvedRevision: newRevision},
+			cachedRevision:         oldRevision,
+			expectedFailure:        fmt.Sprintf("source cache holds revision %q instead of %q", oldRevision, newRevision),
+			expectedSourceRevision: "",
 		},
 	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			scheme := scheme.GetScheme()
+			resourceToApply := testConfigMap("dest-namespace", "dest-name")
+			syncSet := testsyncset.FullBuilder(testNamespace, "test-syncset", scheme).Build(
+				testsyncset.ForClusterDeployments(testCDName),
+				testsyncset.WithGeneration(1),
+				testsyncset.WithSource(&hivev1.SyncSetSource{
+					Git: &hivev1.GitSyncSetSource{URL: "https://example.com/manifests.git"},
+				}),
+				testsyncset.WithSourceStatus(tc.sourceStatus),
+			)
+			manifest, err := json.Marshal(resourceToApply)
+			require.NoError(t, err)
+			manifests, err := syncsetsource.EncodeManifests([]runtime.RawExtension{{Raw: manifest}})
+			require.NoError(t, err)
+			clusterSyncOpts := []testcs.Option{}
+			if tc.existingSyncStatus != nil {
+				clusterSyncOpts = append(clusterSyncOpts, testcs.WithSyncSetStatus(*tc.existingSyncStatus))
+			}
+			rt := newReconcileTest(t, mockCtrl, scheme,
+				cdBuilder(scheme).Build(),
+				clusterSyncBuilder(scheme).Build(clusterSyncOpts...),
+				teststatefulset.FullBuilder("hive", stsName, scheme).Build(
+					teststatefulset.WithCurrentReplicas(3),
+					teststatefulset.WithReplicas(3),
+				),
+				syncSet,
+				testsecret.FullBuilder(testNamespace, syncsetsource.CacheSecretName("test-syncset"), scheme).Build(
+					testsecret.WithDataKeyValue(syncsetsource.RevisionSecretKey, []byte(tc.cachedRevision)),
+					testsecret.WithDataKeyValue(syncsetsource.ManifestsSecretKey, manifests),
+				),
+				buildSyncLease(time.Now().Add(-time.Hour)),
+			)
+			rt.expectUnchangedLeaseRenewTime = true
+			switch {
+			case tc.expectApply:
+				rt.mockResourceHelper.EXPECT().Apply(newApplyMatcher(resourceToApply)).Return(resource.CreatedApplyResult, nil)
+				opts := []syncStatusOption{withSourceRevision(tc.expectedSourceRevision)}
+				if tc.existingSyncStatus != nil {
+					opts = append(opts, withFirstSuccessTimeInThePast())
+				}
+				rt.expectedSyncSetStatuses = []hiveintv1alpha1.SyncStatus{buildSyncStatus("test-syncset", opts...)}
+			case tc.expectedFailure != "":
+				expected := hiveintv1alpha1.SyncStatus{Name: "test-syncset"}
+				if tc.existingSyncStatus != nil {
+					expected = *tc.existingSyncStatus
+					expected.LastTransitionTime = metav1.Time{}
+				}
+				withFailureResult(tc.expectedFailure)(&expected)
+				rt.expectedSyncSetStatuses = []hiveintv1alpha1.SyncStatus{expected}
+				rt.expectedFailedMessage = "SyncSet test-syncset is failing"
+				rt.expectRequeue = true
+			default:
+				rt.expectedSyncSetStatuses = []hiveintv1alpha1.SyncStatus{*tc.existingSyncStatus}
+			}
+			rt.run(t)
+		})
+	}
 }
 
-func testConfigMap(namespace, name string) *corev1.ConfigMap {
-	return &corev1.ConfigMap{
-		TypeMeta: metav1.TypeMeta{
-			APIVersion: "v1",
-			Kind:       "ConfigMap",
+func TestReconcileClusterSync_SyncSetRender(t *testing.T) {
+	const revision = "1111111111111111111111111111111111111111"
+	cmYAML := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: dest-name\n"
+	sourceFiles := map[string][]byte{
+		"base/kustomization.yaml": []byte("resources:\n- cm.yaml\n"),
+		"base/cm.yaml":            []byte(cmYAML),
+	}
+	cases := []struct {
+		name        string
+		source      bool
+		kustomize   *hivev1.KustomizeSyncSetRender
+		expectApply bool
+	}{
+		{
+			name: "kustomize without source",
+			kustomize: &hivev1.KustomizeSyncSetRender{
+				Files: map[string]string{
+					"kustomization.yaml": "resources:\n- cm.yaml\nnamespace: dest-namespace\n",
+					"cm.yaml":            cmYAML,
+				},
+			},
+			expectApply: true,
 		},
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: namespace,
-			Name:      name,
+		{
+			name:   "kustomize overlay on source",
+			source: true,
+			kustomize: &hivev1.KustomizeSyncSetRender{
+				Files: map[string]string{
+					"kustomization.yaml": "resources:\n- base\nnamespace: dest-namespace\n",
+				},
+			},
+			expectApply: true,
+		},
+		{
+			name:      "render failure",
+			source:    true,
+			kustomize: &hivev1.KustomizeSyncSetRender{Path: "missing"},
 		},
 	}
-}
-
-func testConfigMapRef(namespace, name string) hiveintv1alpha1.SyncResourceReference {
-	return hiveintv1alpha1.SyncResourceReference{
-		APIVersion: "v1",
-		Kind:       "ConfigMap",
-		Namespace:  namespace,
-		Name:       name,
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			scheme := scheme.GetScheme()
+			resourceToApply := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]interface{}{
+					"namespace": "dest-namespace",
+					"name":      "dest-name",
+				},
+			}}
+			syncSetOpts := []testsyncset.Option{
+				testsyncset.ForClusterDeployments(testCDName),
+				testsyncset.WithGeneration(1),
+				testsyncset.WithApplyMode(hivev1.SyncResourceApplyMode),
+				testsyncset.WithKustomize(tc.kustomize),
+			}
+			existing := []runtime.Object{
+				cdBuilder(scheme).Build(),
+				teststatefulset.FullBuilder("hive", stsName, scheme).Build(
+					teststatefulset.WithCurrentReplicas(3),
+					teststatefulset.WithReplicas(3),
+				),
+				buildSyncLease(time.Now().Add(-time.Hour)),
+			}
+			existingSyncStatus := buildSyncStatus("test-syncset",
+				withResourcesToDelete(testConfigMapRef("dest-namespace", "old-name")),
+				withTransitionInThePast(),
+				withFirstSuccessTimeInThePast(),
+			)
+			if tc.source {
+				syncSetOpts = append(syncSetOpts,
+					testsyncset.WithSource(&hivev1.SyncSetSource{
+						Git: &hivev1.GitSyncSetSource{URL: "https://example.com/manifests.git"},
+					}),
+					testsyncset.WithSourceStatus(&hivev1.SyncSetSourceStatus{ObservedGeneration: 1, ResolvedRevision: revision}),
+				)
+				files, err := syncsetsource.EncodeFiles(sourceFiles)
+				require.NoError(t, err)
+				existing = append(existing, testsecret.FullBuilder(testNamespace, syncsetsource.CacheSecretName("test-syncset"), scheme).Build(
+					testsecret.WithDataKeyValue(syncsetsource.RevisionSecretKey, []byte(revision)),
+					testsecret.WithDataKeyValue(syncsetsource.FilesSecretKey, files),
+				))
+				existingSyncStatus.SourceRevision = "0000000000000000000000000000000000000000"
+			} else {
+				existingSyncStatus.ObservedGeneration = 0
+			}
+			existing = append(existing,
+				testsyncset.FullBuilder(testNamespace, "test-syncset", scheme).Build(syncSetOpts...),
+				clusterSyncBuilder(scheme).Build(testcs.WithSyncSetStatus(existingSyncStatus)),
+			)
+			rt := newReconcileTest(t, mockCtrl, scheme, existing...)
+			rt.expectUnchangedLeaseRenewTime = true
+			if tc.expectApply {
+				rt.mockResourceHelper.EXPECT().Apply(newApplyMatcher(resourceToApply)).Return(resource.CreatedApplyResult, nil)
+				rt.mockResourceHelper.EXPECT().Delete("v1", "ConfigMap", "dest-namespace", "old-name").Return(nil)
+				opts := []syncStatusOption{
+					withResourcesToDelete(testConfigMapRef("dest-namespace", "dest-name")),
+					withFirstSuccessTimeInThePast(),
+				}
+				if tc.source {
+					opts = append(opts, withSourceRevision(revision))
+				}
+				rt.expectedSyncSetStatuses = []hiveintv1alpha1.SyncStatus{buildSyncStatus("test-syncset", opts...)}
+			} else {
+				_, renderErr := syncsetsource.Render(context.Background(), &hivev1.SyncSetCommonSpec{Kustomize: tc.kustomize}, "test-syncset", sourceFiles, nil)
+				require.Error(t, renderErr, "expected render to fail")
+				expected := existingSyncStatus
+				expected.LastTransitionTime = metav1.Time{}
+				withFailureResult("failed to render: " + renderErr.Error())(&expected)
+				rt.expectedSyncSetStatuses = []hiveintv1alpha1.SyncStatus{expected}
+				rt.expectedFailedMessage = "SyncSet test-syncset is failing"
+				rt.expectRequeue = true
+			}
+			rt.run(t)
+		})
 	}
 }
 
-func testSecretMapping(srcName, destNamespace, destName string) hivev1.SecretMapping {
-	return hivev1.SecretMapping{
-		SourceRef: hivev1.SecretReference{
-			Name: srcName,
+func TestReconcileClusterSync_Reassignment(t *testing.T) {
+	cases := []struct {
+		name                string
+		controlledByReplica *int64
+		expectReassignment  bool
+	}{
+		{
+			name: "new clustersync",
 		},
-		TargetRef: hivev1.SecretReference{
-			Namespace: destNamespace,
-			Name:      destName,
+		{
+			name:                "same replica",
+			controlledByReplica: pointer.Int64(0),
+		},
+		{
+			name:                "different replica",
+			controlledByReplica: pointer.Int64(2),
+			expectReassignment:  true,
 		},
 	}
-}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			scheme := scheme.GetScheme()
+			clusterSync := clusterSyncBuilder(scheme).Build()
+			clusterSync.Status.ControlledByReplica = tc.controlledByReplica
+			rt := newReconcileTest(t, mockCtrl, scheme,
+				cdBuilder(scheme).Build(),
+				clusterSync,
+				teststatefulset.FullBuilder("hive", stsName, scheme).Build(
+					teststatefulset.WithCurrentReplicas(3),
+					teststatefulset.WithReplicas(3),
+				),
+				buildSyncLease(time.Now().Add(-time.Hour)),
+			)
+			rt.expectUnchangedLeaseRenewTime = true
+			reassignments := testutil.ToFloat64(metricClusterSyncReassignments)
+			rt.run(t)
 
-func testSecretRef(namespace, name string) hiveintv1alpha1.SyncResourceReference {
-	return hiveintv1alpha1.SyncResourceReference{
-		APIVersion: "v1",
-		Kind:       "Secret",
-		Namespace:  namespace,
-		Name:       name,
+			expectedReassignments := reassignments
+			if tc.expectReassignment {
+				expectedReassignments++
+			}
+			assert.Equal(t, expectedReassignments, testutil.ToFloat64(metricClusterSyncReassignments), "unexpected reassignments")
+			actual := &hiveintv1alpha1.ClusterSync{}
+			err := rt.c.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: testClusterSyncName}, actual)
+			require.NoError(t, err, "unexpected error getting ClusterSync")
+			assert.Equal(t, pointer.Int64(0), actual.Status.ControlledByReplica, "unexpected controlling replica")
+		})
 	}
 }
 
-type applyMatcher struct {
-	resource *unstructured.Unstructured
-}
-
-func newApplyMatcher(resource hivev1.MetaRuntimeObject) gomock.Matcher {
-	resourceAsJSON, err := json.Marshal(resource)
-	if err != nil {
-		panic(errors.Wrap(err, "could not marshal resource to JSON"))
+func TestReconcileClusterSync_Resync(t *testing.T) {
+	cases := []struct {
+		name                    string
+		resync                  *hiveintv1alpha1.ClusterSyncResync
+		lastResyncRequestID     string
+		expectApply             bool
+		expectFullReapply       bool
+		expectedResyncRequestID string
+	}{
+		{
+			name:                    "no resync",
+			expectedResyncRequestID: "",
+		},
+		{
+			name:                    "resync all",
+			resync:                  &hiveintv1alpha1.ClusterSyncResync{RequestID: "request-1"},
+			expectApply:             true,
+			expectFullReapply:       true,
+			expectedResyncRequestID: "request-1",
+		},
+		{
+			name: "resync syncset",
+			resync: &hiveintv1alpha1.ClusterSyncResync{
+				RequestID: "request-1",
+				SyncSets:  []string{"test-syncset"},
+			},
+			expectApply:             true,
+			expectedResyncRequestID: "request-1",
+		},
+		{
+			name: "resync other syncset",
+			resync: &hiveintv1alpha1.ClusterSyncResync{
+				RequestID:        "request-1",
+				SyncSets:         []string{"other-syncset"},
+				SelectorSyncSets: []string{"test-syncset"},
+			},
+			expectedResyncRequestID: "request-1",
+		},
+		{
+			name:                    "resync already performed",
+			resync:                  &hiveintv1alpha1.ClusterSyncResync{RequestID: "request-1"},
+			lastResyncRequestID:     "request-1",
+			expectedResyncRequestID: "request-1",
+		},
+		{
+			name:                    "new resync request",
+			resync:                  &hiveintv1alpha1.ClusterSyncResync{RequestID: "request-2"},
+			lastResyncRequestID:     "request-1",
+			expectApply:             true,
+			expectFullReapply:       true,
+			expectedResyncRequestID: "request-2",
+		},
 	}
-	u := &unstructured.Unstructured{}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			scheme := scheme.GetScheme()
+			resourceToApply := testConfigMap("dest-namespace", "dest-name")
+			syncSet := testsyncset.FullBuilder(testNamespace, "test-syncset", scheme).Build(
+				testsyncset.ForClusterDeployments(testCDName),
+				testsyncset.WithGeneration(1),
+				testsyncset.WithResources(resourceToApply),
+			)
+			existingSyncStatus := buildSyncStatus("test-syncset", withTransitionInThePast(), withFirstSuccessTimeInThePast())
+			rt := newReconcileTest(t, mockCtrl, scheme,
+				cdBuilder(scheme).Build(),
+				clusterSyncBuilder(scheme).Build(
+					testcs.WithSyncSetStatus(existingSyncStatus),
+					testcs.WithResync(tc.resync),
+					testcs.WithLastResyncRequestID(tc.lastResyncRequestID),
+				),
+				teststatefulset.FullBuilder("hive", stsName, scheme).Build(
+					teststatefulset.WithCurrentReplicas(3),
+					teststatefulset.WithReplicas(3),
+				),
+				syncSet,
+				buildSyncLease(time.Now().Add(-time.Hour)),
+			)
+			if tc.expectApply {
+				rt.mockResourceHelper.EXPECT().Apply(newApplyMatcher(resourceToApply)).Return(resource.UnchangedApplyResult, nil)
+			}
+			rt.expectedSyncSetStatuses = []hiveintv1alpha1.SyncStatus{existingSyncStatus}
+			rt.expectUnchangedLeaseRenewTime = !tc.expectFullReapply
+			rt.run(t)
+
+			clusterSync := &hiveintv1alpha1.ClusterSync{}
+			err := rt.c.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: testClusterSyncName}, clusterSync)
+			require.NoError(t, err, "unexpected error getting ClusterSync")
+			assert.Equal(t, tc.expectedResyncRequestID, clusterSync.Status.LastResyncRequestID, "unexpected last resync request ID")
+			if tc.expectApply {
+				if assert.Len(t, clusterSync.Status.SyncSetHistory, 1, "expected history for syncset") {
+					assert.Len(t, clusterSync.Status.SyncSetHistory[0].Attempts, 1, "expected one attempt in history")
+				}
+			} else {
+				assert.Empty(t, clusterSync.Status.SyncSetHistory, "expected no history")
+			}
+		})
+	}
+}
+
+func TestReconcileClusterSync_SyncHistory(t *testing.T) {
+	oldAttempts := make([]hiveintv1alpha1.SyncAttempt, syncHistoryLimit)
+	for i := range oldAttempts {
+		oldAttempts[i] = hiveintv1alpha1.SyncAttempt{
+			Time:     metav1.NewTime(timeInThePast.Add(time.Duration(i) * time.Minute)),
+			Result:   hiveintv1alpha1.SuccessSyncSetResult,
+			Duration: metav1.Duration{Duration: time.Second},
+		}
+	}
+	cases := []struct {
+		name                     string
+		applyMode                hivev1.SyncSetResourceApplyMode
+		applyResult              resource.ApplyResult
+		applyErr                 error
+		existingResourceToDelete *hiveintv1alpha1.SyncResourceReference
+		expectedSyncStatus       hiveintv1alpha1.SyncStatus
+		expectedResult           hiveintv1alpha1.SyncSetResult
+		expectedResourcesChanged []hiveintv1alpha1.SyncResourceReference
+	}{
+		{
+			name:                     "created",
+			applyResult:              resource.CreatedApplyResult,
+			expectedSyncStatus:       buildSyncStatus("test-syncset", withFirstSuccessTimeInThePast()),
+			expectedResult:           hiveintv1alpha1.SuccessSyncSetResult,
+			expectedResourcesChanged: []hiveintv1alpha1.SyncResourceReference{testConfigMapRef("dest-namespace", "dest-name")},
+		},
+		{
+			name:               "unchanged",
+			applyResult:        resource.UnchangedApplyResult,
+			expectedSyncStatus: buildSyncStatus("test-syncset", withFirstSuccessTimeInThePast()),
+			expectedResult:     hiveintv1alpha1.SuccessSyncSetResult,
+		},
+		{
+			name:     "failed",
+			applyErr: errors.New("test apply error"),
+			expectedSyncStatus: buildSyncStatus("test-syncset",
+				withFailureResult("failed to apply resource 0: test apply error"),
+				withFirstSuccessTimeInThePast(),
+			),
+			expectedResult: hiveintv1alpha1.FailureSyncSetResult,
+		},
+		{
+			name:        "deleted",
+			applyMode:   hivev1.SyncResourceApplyMode,
+			applyResult: resource.UnchangedApplyResult,
+			existingResourceToDelete: func() *hiveintv1alpha1.SyncResourceReference {
+				r := testConfigMapRef("dest-namespace", "old-name")
+				return &r
+			}(),
+			expectedSyncStatus: buildSyncStatus("test-syncset",
+				withResourcesToDelete(testConfigMapRef("dest-namespace", "dest-name")),
+				withFirstSuccessTimeInThePast(),
+			),
+			expectedResult:           hiveintv1alpha1.SuccessSyncSetResult,
+			expectedResourcesChanged: []hiveintv1alpha1.SyncResourceReference{testConfigMapRef("dest-namespace", "old-name")},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			scheme := scheme.GetScheme()
+			resourceToApply := testConfigMap("dest-namespace", "dest-name")
+			syncSet := testsyncset.FullBuilder(testNamespace, "test-syncset", scheme).Build(
+				testsyncset.ForClusterDeployments(testCDName),
+				testsyncset.WithGeneration(1),
+				testsyncset.WithApplyMode(tc.applyMode),
+				testsyncset.WithResources(resourceToApply),
+			)
+			existingSyncStatus := buildSyncStatus("test-syncset",
+				withObservedGeneration(0),
+				withTransitionInThePast(),
+				withFirstSuccessTimeInThePast(),
+			)
+			if tc.existingResourceToDelete != nil {
+				existingSyncStatus.ResourcesToDelete = []hiveintv1alpha1.SyncResourceReference{*tc.existingResourceToDelete}
+			}
+			rt := newReconcileTest(t, mockCtrl, scheme,
+				cdBuilder(scheme).Build(),
+				clusterSyncBuilder(scheme).Build(
+					testcs.WithSyncSetStatus(existingSyncStatus),
+					testcs.WithSyncSetHistory(hiveintv1alpha1.SyncHistory{Name: "removed-syncset", Attempts: oldAttempts[:1]}),
+					testcs.WithSyncSetHistory(hiveintv1alpha1.SyncHistory{Name: "test-syncset", Attempts: oldAttempts}),
+				),
+				teststatefulset.FullBuilder("hive", stsName, scheme).Build(
+					teststatefulset.WithCurrentReplicas(3),
+					teststatefulset.WithReplicas(3),
+				),
+				syncSet,
+				buildSyncLease(time.Now().Add(-time.Hour)),
+			)
+			rt.mockResourceHelper.EXPECT().Apply(newApplyMatcher(resourceToApply)).Return(tc.applyResult, tc.applyErr)
+			if r := tc.existingResourceToDelete; r != nil {
+				rt.mockResourceHelper.EXPECT().Delete(r.APIVersion, r.Kind, r.Namespace, r.Name).Return(nil)
+			}
+			if tc.applyErr != nil {
+				rt.expectedFailedMessage = "SyncSet test-syncset is failing"
+				rt.expectRequeue = true
+			}
+			rt.expectedSyncSetStatuses = []hiveintv1alpha1.SyncStatus{tc.expectedSyncStatus}
+			rt.expectUnchangedLeaseRenewTime = true
+			startTime := time.Now().Truncate(time.Second)
+			rt.run(t)
+
+			clusterSync := &hiveintv1alpha1.ClusterSync{}
+			err := rt.c.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: testClusterSyncName}, clusterSync)
+			require.NoError(t, err, "unexpected error getting ClusterSync")
+			require.Len(t, clusterSync.Status.SyncSetHistory, 1, "expected history for only the current syncset")
+			history := clusterSync.Status.SyncSetHistory[0]
+			assert.Equal(t, "test-syncset", history.Name, "unexpected syncset in history")
+			require.Len(t, history.Attempts, syncHistoryLimit, "unexpected number of attempts in history")
+			assert.Equal(t, oldAttempts[1:], history.Attempts[:syncHistoryLimit-1], "expected oldest attempt to be dropped")
+			attempt := history.Attempts[syncHistoryLimit-1]
+			hiveassert.BetweenTimes(t, attempt.Time.Time, startTime, time.Now().Add(time.Second), "unexpected attempt time")
+			assert.Equal(t, tc.expectedResult, attempt.Result, "unexpected attempt result")
+			assert.Equal(t, tc.expectedResourcesChanged, attempt.ResourcesChanged, "unexpected resources changed")
+			assert.Equal(t, int32(len(tc.expectedResourcesChanged)), attempt.ResourcesChangedCount, "unexpected count of resources changed")
+			assert.Equal(t, tc.expectedSyncStatus.FailureMessage, attempt.FailureMessage, "unexpected attempt failure message")
+		})
+	}
+}
+
+func TestUpdateSyncHistory(t *testing.T) {
+	attemptAt := func(minute int, result hiveintv1alpha1.SyncSetResult, failureMessage string, resourcesChanged ...hiveintv1alpha1.SyncResourceReference) hiveintv1alpha1.SyncAttempt {
+		return hiveintv1alpha1.SyncAttempt{
+			Time:                  metav1.NewTime(timeInThePast.Add(time.Duration(minute) * time.Minute)),
+			Result:                result,
+			Duration:              metav1.Duration{Duration: time.Duration(minute) * time.Second},
+			FailureMessage:        failureMessage,
+			ResourcesChanged:      resourcesChanged,
+			ResourcesChangedCount: int32(len(resourcesChanged)),
+		}
+	}
+	collapsed := func(first hiveintv1alpha1.SyncAttempt, count int32, last hiveintv1alpha1.SyncAttempt) hiveintv1alpha1.SyncAttempt {
+		first.Count = count
+		first.LastTime = &last.Time
+		first.Duration = last.Duration
+		return first
+	}
+	changed := testConfigMapRef("dest-namespace", "dest-name")
+	cases := []struct {
+		name             string
+		existingAttempts []hiveintv1alpha1.SyncAttempt
+		attempt          hiveintv1alpha1.SyncAttempt
+		limit            int
+		expectedAttempts []hiveintv1alpha1.SyncAttempt
+	}{
+		{
+			name:             "first attempt",
+			attempt:          attemptAt(1, hiveintv1alpha1.FailureSyncSetResult, "boom"),
+			expectedAttempts: []hiveintv1alpha1.SyncAttempt{attemptAt(1, hiveintv1alpha1.FailureSyncSetResult, "boom")},
+		},
+		{
+			name:             "repeated failure",
+			existingAttempts: []hiveintv1alpha1.SyncAttempt{attemptAt(1, hiveintv1alpha1.FailureSyncSetResult, "boom")},
+			attempt:          attemptAt(2, hiveintv1alpha1.FailureSyncSetResult, "boom"),
+			expectedAttempts: []hiveintv1alpha1.SyncAttempt{
+				collapsed(attemptAt(1, hiveintv1alpha1.FailureSyncSetResult, "boom"), 2, attemptAt(2, hiveintv1alpha1.FailureSyncSetResult, "boom")),
+			},
+		},
+		{
+			name: "failure repeated again",
+			existingAttempts: []hiveintv1alpha1.SyncAttempt{
+				collapsed(attemptAt(1, hiveintv1alpha1.FailureSyncSetResult, "boom"), 2, attemptAt(2, hiveintv1alpha1.FailureSyncSetResult, "boom")),
+			},
+			attempt: attemptAt(3, hiveintv1alpha1.FailureSyncSetResult, "boom"),
+			expectedAttempts: []hiveintv1alpha1.SyncAttempt{
+				collapsed(attemptAt(1, hiveintv1alpha1.FailureSyncSetResult, "boom"), 3, attemptAt(3, hiveintv1alpha1.FailureSyncSetResult, "boom")),
+			},
+		},
+		{
+			name:             "different failure",
+			existingAttempts: []hiveintv1alpha1.SyncAttempt{attemptAt(1, hiveintv1alpha1.FailureSyncSetResult, "boom")},
+			attempt:          attemptAt(2, hiveintv1alpha1.FailureSyncSetResult, "bang"),
+			expectedAttempts: []hiveintv1alpha1.SyncAttempt{
+				attemptAt(1, hiveintv1alpha1.FailureSyncSetResult, "boom"),
+				attemptAt(2, hiveintv1alpha1.FailureSyncSetResult, "bang"),
+			},
+		},
+		{
+			name:             "failure that changed resources",
+			existingAttempts: []hiveintv1alpha1.SyncAttempt{attemptAt(1, hiveintv1alpha1.FailureSyncSetResult, "boom")},
+			attempt:          attemptAt(2, hiveintv1alpha1.FailureSyncSetResult, "boom", changed),
+			expectedAttempts: []hiveintv1alpha1.SyncAttempt{
+				attemptAt(1, hiveintv1alpha1.FailureSyncSetResult, "boom"),
+				attemptAt(2, hiveintv1alpha1.FailureSyncSetResult, "boom", changed),
+			},
+		},
+		{
+			name:             "repeated success",
+			existingAttempts: []hiveintv1alpha1.SyncAttempt{attemptAt(1, hiveintv1alpha1.SuccessSyncSetResult, "")},
+			attempt:          attemptAt(2, hiveintv1alpha1.SuccessSyncSetResult, ""),
+			expectedAttempts: []hiveintv1alpha1.SyncAttempt{
+				attemptAt(1, hiveintv1alpha1.SuccessSyncSetResult, ""),
+				attemptAt(2, hiveintv1alpha1.SuccessSyncSetResult, ""),
+			},
+		},
+		{
+			name: "lower limit",
+			existingAttempts: []hiveintv1alpha1.SyncAttempt{
+				attemptAt(1, hiveintv1alpha1.SuccessSyncSetResult, ""),
+				attemptAt(2, hiveintv1alpha1.SuccessSyncSetResult, ""),
+				attemptAt(3, hiveintv1alpha1.SuccessSyncSetResult, ""),
+			},
+			attempt: attemptAt(4, hiveintv1alpha1.SuccessSyncSetResult, ""),
+			limit:   2,
+			expectedAttempts: []hiveintv1alpha1.SyncAttempt{
+				attemptAt(3, hiveintv1alpha1.SuccessSyncSetResult, ""),
+				attemptAt(4, hiveintv1alpha1.SuccessSyncSetResult, ""),
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			limit := tc.limit
+			if limit == 0 {
+				limit = syncHistoryLimit
+			}
+			var history []hiveintv1alpha1.SyncHistory
+			if tc.existingAttempts != nil {
+				history = []hiveintv1alpha1.SyncHistory{{Name: "test-syncset", Attempts: tc.existingAttempts}}
+			}
+			newHistory := updateSyncHistory(
+				history,
+				[]hiveintv1alpha1.SyncStatus{buildSyncStatus("test-syncset")},
+				map[string]hiveintv1alpha1.SyncAttempt{"test-syncset": tc.attempt},
+				limit,
+			)
+			require.Len(t, newHistory, 1, "unexpected number of syncsets in history")
+			assert.Equal(t, tc.expectedAttempts, newHistory[0].Attempts, "unexpected attempts")
+		})
+	}
+}
+
+func TestSyncHistoryLimitFor(t *testing.T) {
+	cases := []struct {
+		syncSetCount  int
+		expectedLimit int
+	}{
+		{syncSetCount: 0, expectedLimit: syncHistoryLimit},
+		{syncSetCount: 20, expectedLimit: syncHistoryLimit},
+		{syncSetCount: 21, expectedLimit: 9},
+		{syncSetCount: 100, expectedLimit: 2},
+		{syncSetCount: 200, expectedLimit: 1},
+		{syncSetCount: 1000, expectedLimit: 1},
+	}
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%d syncsets", tc.syncSetCount), func(t *testing.T) {
+			assert.Equal(t, tc.expectedLimit, syncHistoryLimitFor(tc.syncSetCount), "unexpected limit")
+		})
+	}
+}
+
+func TestNewSyncAttemptLimitsResourcesChanged(t *testing.T) {
+	resourcesChanged := make([]hiveintv1alpha1.SyncResourceReference, maxResourcesChangedPerAttempt+3)
+	for i := range resourcesChanged {
+		resourcesChanged[i] = testConfigMapRef("dest-namespace", fmt.Sprintf("dest-name-%d", i))
+	}
+	attempt := newSyncAttempt(time.Now(), buildSyncStatus("test-syncset"), resourcesChanged)
+	assert.Equal(t, resourcesChanged[:maxResourcesChangedPerAttempt], attempt.ResourcesChanged, "unexpected resources changed")
+	assert.Equal(t, int32(len(resourcesChanged)), attempt.ResourcesChangedCount, "unexpected count of resources changed")
+}
+
+func TestReconcileClusterSync_ApplyExternalSecret(t *testing.T) {
+	currentData := map[string][]byte{"password": []byte("current")}
+	cases := []struct {
+		name                 string
+		existingSyncStatus   *hiveintv1alpha1.SyncStatus
+		vaultStatus          int
+		expectApply          bool
+		expectedSyncStatus   hiveintv1alpha1.SyncStatus
+		expectedFailedStatus bool
+	}{
+		{
+			name:        "new syncset",
+			expectApply: true,
+			expectedSyncStatus: buildSyncStatus("test-syncset",
+				withExternalSecretsHash(secretsource.Hash(currentData)),
+			),
+		},
+		{
+			name: "secret unchanged",
+			existingSyncStatus: func() *hiveintv1alpha1.SyncStatus {
+				s := buildSyncStatus("test-syncset",
+					withExternalSecretsHash(secretsource.Hash(currentData)),
+					withTransitionInThePast(),
+					withFirstSuccessTimeInThePast(),
+				)
+				return &s
+			}(),
+			expectedSyncStatus: buildSyncStatus("test-syncset",
+				withExternalSecretsHash(secretsource.Hash(currentData)),
+				withTransitionInThePast(),
+				withFirstSuccessTimeInThePast(),
+			),
+		},
+		{
+			name: "secret rotated",
+			existingSyncStatus: func() *hiveintv1alpha1.SyncStatus {
+				s := buildSyncStatus("test-syncset",
+					withExternalSecretsHash(secretsource.Hash(map[string][]byte{"password": []byte("previous")})),
+					withTransitionInThePast(),
+					withFirstSuccessTimeInThePast(),
+				)
+				return &s
+			}(),
+			expectApply: true,
+			expectedSyncStatus: buildSyncStatus("test-syncset",
+				withExternalSecretsHash(secretsource.Hash(currentData)),
+				withFirstSuccessTimeInThePast(),
+			),
+		},
+		{
+			name: "secret store unavailable",
+			existingSyncStatus: func() *hiveintv1alpha1.SyncStatus {
+				s := buildSyncStatus("test-syncset",
+					withExternalSecretsHash(secretsource.Hash(currentData)),
+					withTransitionInThePast(),
+					withFirstSuccessTimeInThePast(),
+				)
+				return &s
+			}(),
+			vaultStatus: http.StatusServiceUnavailable,
+			expectedSyncStatus: buildSyncStatus("test-syncset",
+				withExternalSecretsHash(secretsource.Hash(currentData)),
+				withFailureResult("failed to read secret 0 from external secret store: failed to read secret from Vault: 503 Service Unavailable"),
+				withFirstSuccessTimeInThePast(),
+			),
+			expectedFailedStatus: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tc.vaultStatus != 0 {
+					w.WriteHeader(tc.vaultStatus)
+					return
+				}
+				if r.URL.Path != "/v1/secret/data/app/config" || r.Header.Get("X-Vault-Token") != "test-token" {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				fmt.Fprint(w, `{"data":{"data":{"password":"current"}}}`)
+			}))
+			defer vault.Close()
+
+			mockCtrl := gomock.NewController(t)
+			scheme := scheme.GetScheme()
+			syncSet := testsyncset.FullBuilder(testNamespace, "test-syncset", scheme).Build(
+				testsyncset.ForClusterDeployments(testCDName),
+				testsyncset.WithGeneration(1),
+				testsyncset.WithSecrets(hivev1.SecretMapping{
+					ExternalSource: &hivev1.ExternalSecretSource{
+						Vault: &hivev1.VaultSecretSource{
+							Address:        vault.URL,
+							Path:           "app/config",
+							TokenSecretRef: hivev1.SecretReference{Name: "vault-token"},
+						},
+					},
+					TargetRef: hivev1.SecretReference{Namespace: "dest-namespace", Name: "dest-name"},
+				}),
+			)
+			tokenSecret := testsecret.FullBuilder(testNamespace, "vault-token", scheme).Build(
+				testsecret.WithDataKeyValue("token", []byte("test-token")),
+			)
+			var clusterSyncOpts []testcs.Option
+			if tc.existingSyncStatus != nil {
+				clusterSyncOpts = append(clusterSyncOpts, testcs.WithSyncSetStatus(*tc.existingSyncStatus))
+			}
+			rt := newReconcileTest(t, mockCtrl, scheme,
+				cdBuilder(scheme).Build(),
+				clusterSyncBuilder(scheme).Build(clusterSyncOpts...),
+				teststatefulset.FullBuilder("hive", stsName, scheme).Build(
+					teststatefulset.WithCurrentReplicas(3),
+					teststatefulset.WithReplicas(3),
+				),
+				syncSet,
+				tokenSecret,
+				buildSyncLease(time.Now().Add(-time.Hour)),
+			)
+			rt.r.secretResolver = secretsource.NewResolver(rt.c, []string{vault.URL}, rt.logger)
+			if tc.expectApply {
+				secretToApply := testsecret.BasicBuilder().GenericOptions(
+					testgeneric.WithNamespace("dest-namespace"),
+					testgeneric.WithName("dest-name"),
+					testgeneric.WithTypeMeta(scheme),
+				).Build(
+					testsecret.WithType(corev1.SecretTypeOpaque),
+					testsecret.WithDataKeyValue("password", []byte("current")),
+				)
+				rt.mockResourceHelper.EXPECT().Apply(newApplyMatcher(secretToApply)).Return(resource.ConfiguredApplyResult, nil)
+			}
+			if tc.expectedFailedStatus {
+				rt.expectedFailedMessage = "SyncSet test-syncset is failing"
+				rt.expectRequeue = true
+			}
+			rt.expectedSyncSetStatuses = []hiveintv1alpha1.SyncStatus{tc.expectedSyncStatus}
+			rt.expectUnchangedLeaseRenewTime = true
+			rt.run(t)
+		})
+	}
+}
+
+func TestReconcileClusterSync_HealthCheck(t *testing.T) {
+	deploymentRef := hiveintv1alpha1.SyncResourceReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Namespace:  "dest-namespace",
+		Name:       "dest-name",
+	}
+	deployment := func(updatedReplicas int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "dest-namespace", Name: "dest-name", Generation: 1},
+			Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(2)},
+			Status: appsv1.DeploymentStatus{
+				ObservedGeneration: 1,
+				Replicas:           2,
+				UpdatedReplicas:    updatedReplicas,
+				AvailableReplicas:  2,
+			},
+		}
+	}
+	cases := []struct {
+		name                 string
+		healthCheck          *hivev1.SyncSetHealthCheck
+		existingHealth       []syncStatusOption
+		remoteObjects        []runtime.Object
+		expectedHealth       []syncStatusOption
+		expectedRequeueAfter time.Duration
+	}{
+		{
+			name:           "healthy",
+			healthCheck:    &hivev1.SyncSetHealthCheck{},
+			remoteObjects:  []runtime.Object{deployment(2)},
+			expectedHealth: []syncStatusOption{withHealth(hiveintv1alpha1.HealthySyncSetHealth)},
+		},
+		{
+			name:          "progressing",
+			healthCheck:   &hivev1.SyncSetHealthCheck{},
+			remoteObjects: []runtime.Object{deployment(1)},
+			expectedHealth: []syncStatusOption{withHealth(hiveintv1alpha1.ProgressingSyncSetHealth, hiveintv1alpha1.ResourceHealth{
+				SyncResourceReference: deploymentRef,
+				Health:                hiveintv1alpha1.ProgressingSyncSetHealth,
+				Message:               "1 of 2 replicas updated",
+			})},
+			expectedRequeueAfter: healthRecheckInterval,
+		},
+		{
+			name:        "missing resource",
+			healthCheck: &hivev1.SyncSetHealthCheck{},
+			expectedHealth: []syncStatusOption{withHealth(hiveintv1alpha1.ProgressingSyncSetHealth, hiveintv1alpha1.ResourceHealth{
+				SyncResourceReference: deploymentRef,
+				Health:                hiveintv1alpha1.ProgressingSyncSetHealth,
+				Message:               "resource does not exist",
+			})},
+			expectedRequeueAfter: healthRecheckInterval,
+		},
+		{
+			name: "degraded by custom rule",
+			healthCheck: &hivev1.SyncSetHealthCheck{
+				CustomRules: []hivev1.CustomHealthRule{{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Healthy:    "true",
+					Degraded:   "object.spec.replicas > 1",
+				}},
+			},
+			remoteObjects: []runtime.Object{deployment(2)},
+			expectedHealth: []syncStatusOption{withHealth(hiveintv1alpha1.DegradedSyncSetHealth, hiveintv1alpha1.ResourceHealth{
+				SyncResourceReference: deploymentRef,
+				Health:                hiveintv1alpha1.DegradedSyncSetHealth,
+				Message:               "object.spec.replicas > 1 is true",
+			})},
+			expectedRequeueAfter: healthRecheckInterval,
+		},
+		{
+			name:           "health check removed",
+			existingHealth: []syncStatusOption{withHealth(hiveintv1alpha1.HealthySyncSetHealth)},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			scheme := scheme.GetScheme()
+			syncSet := testsyncset.FullBuilder(testNamespace, "test-syncset", scheme).Build(
+				testsyncset.ForClusterDeployments(testCDName),
+				testsyncset.WithGeneration(1),
+				testsyncset.WithResources(deployment(2)),
+				testsyncset.WithHealthCheck(tc.healthCheck),
+			)
+			existingSyncStatus := buildSyncStatus("test-syncset",
+				append([]syncStatusOption{withTransitionInThePast(), withFirstSuccessTimeInThePast()}, tc.existingHealth...)...,
+			)
+			rt := newReconcileTest(t, mockCtrl, scheme,
+				cdBuilder(scheme).Build(),
+				clusterSyncBuilder(scheme).Build(testcs.WithSyncSetStatus(existingSyncStatus)),
+				teststatefulset.FullBuilder("hive", stsName, scheme).Build(
+					teststatefulset.WithCurrentReplicas(3),
+					teststatefulset.WithReplicas(3),
+				),
+				syncSet,
+				buildSyncLease(time.Now().Add(-time.Hour)),
+			)
+			if tc.healthCheck != nil {
+				remoteClient := testfake.NewFakeClientBuilder().WithRuntimeObjects(tc.remoteObjects...).Build()
+				rt.mockRemoteClientBuilder.EXPECT().Build().Return(remoteClient, nil)
+			}
+			rt.expectedSyncSetStatuses = []hiveintv1alpha1.SyncStatus{buildSyncStatus("test-syncset",
+				append([]syncStatusOption{withTransitionInThePast(), withFirstSuccessTimeInThePast()}, tc.expectedHealth...)...,
+			)}
+			rt.expectUnchangedLeaseRenewTime = true
+			rt.expectedRequeueAfter = tc.expectedRequeueAfter
+			rt.run(t)
+		})
+	}
+}
+
+func TestHealthResourcesToAssess(t *testing.T) {
+	scheme := scheme.GetScheme()
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "dest-namespace", Name: "dest-name"},
+	}
+	configMap := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "dest-namespace", Name: "dest-name"},
+	}
+	syncSet := testsyncset.FullBuilder(testNamespace, "test-syncset", scheme).Build(
+		testsyncset.ForClusterDeployments(testCDName),
+		testsyncset.WithGeneration(1),
+	)
+	for _, obj := range []runtime.Object{deployment, configMap} {
+		raw, err := json.Marshal(obj)
+		require.NoError(t, err, "unexpected error marshaling resource")
+		syncSet.Spec.Resources = append(syncSet.Spec.Resources, runtime.RawExtension{Raw: raw})
+	}
+	r := &ReconcileClusterSync{
+		Client:          testfake.NewFakeClientBuilder().Build(),
+		logger:          log.StandardLogger(),
+		healthResources: lru.New(healthResourcesCacheSize),
+	}
+	a := &healthAssessor{r: r, cd: cdBuilder(scheme).Build(), logger: r.logger}
+	assessor := syncsethealth.NewAssessor(&hivev1.SyncSetHealthCheck{})
+	deploymentRef := hiveintv1alpha1.SyncResourceReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Namespace:  "dest-namespace",
+		Name:       "dest-name",
+	}
+
+	references, err := a.resourcesToAssess((*SyncSetAsCommon)(syncSet), assessor, r.logger)
+	require.NoError(t, err, "unexpected error getting resources to assess")
+	assert.Equal(t, []hiveintv1alpha1.SyncResourceReference{deploymentRef}, references,
+		"expected only resources with a health rule")
+
+	syncSet.Spec.Resources = syncSet.Spec.Resources[1:]
+	references, err = a.resourcesToAssess((*SyncSetAsCommon)(syncSet), assessor, r.logger)
+	require.NoError(t, err, "unexpected error getting resources to assess")
+	assert.Equal(t, []hiveintv1alpha1.SyncResourceReference{deploymentRef}, references,
+		"expected cached resources for the same generation")
+
+	syncSet.Generation = 2
+	references, err = a.resourcesToAssess((*SyncSetAsCommon)(syncSet), assessor, r.logger)
+	require.NoError(t, err, "unexpected error getting resources to assess")
+	assert.Empty(t, references, "expected resources of the new generation")
+}
+
+func TestNextHealthRecheck(t *testing.T) {
+	r := &ReconcileClusterSync{
+		healthRecheckBackoff: flowcontrol.NewBackOff(healthRecheckInterval, maxHealthRecheckInterval),
+	}
+	cd := cdBuilder(scheme.GetScheme()).Build()
+	for _, expected := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 16 * time.Minute, 16 * time.Minute} {
+		assert.Equal(t, expected, r.nextHealthRecheck(cd, false), "unexpected recheck interval")
+	}
+	assert.Equal(t, healthRecheckInterval, r.nextHealthRecheck(cd, true), "expected backoff to start over after apply")
+	r.healthRecheckBackoff.Reset(cd.Namespace + "/" + cd.Name)
+	assert.Equal(t, healthRecheckInterval, r.nextHealthRecheck(cd, false), "expected backoff to start over after reset")
+}
+
+func TestExternalSecretsRefreshInterval(t *testing.T) {
+	syncSet := func(refreshIntervals ...*metav1.Duration) CommonSyncSet {
+		ss := &hivev1.SyncSet{}
+		for _, refreshInterval := range refreshIntervals {
+			ss.Spec.Secrets = append(ss.Spec.Secrets, hivev1.SecretMapping{
+				ExternalSource: &hivev1.ExternalSecretSource{
+					File:            &hivev1.FileSecretSource{Path: "secret"},
+					RefreshInterval: refreshInterval,
+				},
+			})
+		}
+		ss.Spec.Secrets = append(ss.Spec.Secrets, hivev1.SecretMapping{SourceRef: hivev1.SecretReference{Name: "secret"}})
+		return (*SyncSetAsCommon)(ss)
+	}
+	_, ok := externalSecretsRefreshInterval([]CommonSyncSet{syncSet()})
+	assert.False(t, ok, "expected no refresh interval without external secrets")
+	refreshInterval, ok := externalSecretsRefreshInterval([]CommonSyncSet{
+		syncSet(nil),
+		syncSet(&metav1.Duration{Duration: 10 * time.Minute}, &metav1.Duration{Duration: 2 * time.Minute}),
+	})
+	assert.True(t, ok, "expected refresh interval with external secrets")
+	assert.Equal(t, 2*time.Minute, refreshInterval, "unexpected refresh interval")
+}
+
+func cdBuilder(scheme *runtime.Scheme) testcd.Builder {
+	return testcd.FullBuilder(testNamespace, testCDName, scheme).
+		GenericOptions(
+			testgeneric.WithUID(testCDUID),
+		).
+		Options(
+			testcd.Installed(),
+			testcd.WithCondition(hivev1.ClusterDeploymentCondition{
+				Type:   hivev1.UnreachableCondition,
+				Status: corev1.ConditionFalse,
+			}),
+			testcd.InstalledTimestamp(time.Now()),
+		)
+}
+
+func clusterSyncBuilder(scheme *runtime.Scheme) testcs.Builder {
+	return testcs.FullBuilder(testNamespace, testClusterSyncName, scheme).GenericOptions(
+		testgeneric.WithUID(testClusterSyncUID),
+		testgeneric.WithOwnerReference(cdBuilder(scheme).Build()),
+	)
+}
+
+func buildSyncLease(t time.Time) *hiveintv1alpha1.ClusterSyncLease {
+	return &hiveintv1alpha1.ClusterSyncLease{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testLeaseName,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion:         "hiveinternal.openshift.io/v1alpha1",
+				Kind:               "ClusterSync",
+				Name:               testClusterSyncName,
+				UID:                testClusterSyncUID,
+				BlockOwnerDeletion: pointer.BoolPtr(true),
+			}},
+		},
+		Spec: hiveintv1alpha1.ClusterSyncLeaseSpec{
+			RenewTime: metav1.NewMicroTime(t),
+		},
+	}
+}
+
+func testConfigMap(namespace, name string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}
+}
+
+func testConfigMapRef(namespace, name string) hiveintv1alpha1.SyncResourceReference {
+	return hiveintv1alpha1.SyncResourceReference{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Namespace:  namespace,
+		Name:       name,
+	}
+}
+
+func testSecretMapping(srcName, destNamespace, destName string) hivev1.SecretMapping {
+	return hivev1.SecretMapping{
+		SourceRef: hivev1.SecretReference{
+			Name: srcName,
+		},
+		TargetRef: hivev1.SecretReference{
+			Namespace: destNamespace,
+			Name:      destName,
+		},
+	}
+}
+
+func testSecretRef(namespace, name string) hiveintv1alpha1.SyncResourceReference {
+	return hiveintv1alpha1.SyncResourceReference{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Namespace:  namespace,
+		Name:       name,
+	}
+}
+
+type applyMatcher struct {
+	resource *unstructured.Unstructured
+}
+
+func newApplyMatcher(resource hivev1.MetaRuntimeObject) gomock.Matcher {
+	resourceAsJSON, err := json.Marshal(resource)
+	if err != nil {
+		panic(errors.Wrap(err, "could not marshal resource to JSON"))
+	}
+	u := &unstructured.Unstructured{}
 	if err := json.Unmarshal(resourceAsJSON, u); err != nil {
 		panic(errors.Wrap(err, "could not unmarshal as unstructured"))
 	}
@@ -2612,6 +3724,18 @@ func withObservedGeneration(observedGeneration int64) syncStatusOption {
 	}
 }
 
+func withSourceRevision(sourceRevision string) syncStatusOption {
+	return func(syncStatus *hiveintv1alpha1.SyncStatus) {
+		syncStatus.SourceRevision = sourceRevision
+	}
+}
+
+func withExternalSecretsHash(hash string) syncStatusOption {
+	return func(syncStatus *hiveintv1alpha1.SyncStatus) {
+		syncStatus.ExternalSecretsHash = hash
+	}
+}
+
 func withFailureResult(message string) syncStatusOption {
 	return func(syncStatus *hiveintv1alpha1.SyncStatus) {
 		syncStatus.Result = hiveintv1alpha1.FailureSyncSetResult
@@ -2625,6 +3749,13 @@ func withResourcesToDelete(resourcesToDelete ...hiveintv1alpha1.SyncResourceRefe
 	}
 }
 
+func withHealth(health hiveintv1alpha1.SyncSetHealth, unhealthyResources ...hiveintv1alpha1.ResourceHealth) syncStatusOption {
+	return func(syncStatus *hiveintv1alpha1.SyncStatus) {
+		syncStatus.Health = health
+		syncStatus.UnhealthyResources = unhealthyResources
+	}
+}
+
 func withTransitionInThePast() syncStatusOption {
 	return func(syncStatus *hiveintv1alpha1.SyncStatus) {
 		syncStatus.LastTransitionTime = timeInThePast
@@ -2648,3 +3779,68 @@ func withFirstSuccessTime(firstSuccessTime metav1.Time) syncStatusOption {
 		syncStatus.FirstSuccessTime = &firstSuccessTime
 	}
 }
+
+func TestIgnoreSourceFetchTimeUpdates(t *testing.T) {
+	fetched := metav1.NewTime(time.Now().Add(-time.Hour))
+	refetched := metav1.NewTime(time.Now())
+	sourceStatus := func(revision string, fetchTime metav1.Time) *hivev1.SyncSetSourceStatus {
+		return &hivev1.SyncSetSourceStatus{ObservedGeneration: 1, ResolvedRevision: revision, LastFetchTime: &fetchTime}
+	}
+	syncSet := func(status *hivev1.SyncSetSourceStatus, resourceVersion string) *hivev1.SyncSet {
+		ss := testsyncset.FullBuilder(testNamespace, "test-syncset", scheme.GetScheme()).Build()
+		ss.ResourceVersion = resourceVersion
+		ss.Status.Source = status
+		return ss
+	}
+	selectorSyncSet := func(status *hivev1.SyncSetSourceStatus, resourceVersion string) *hivev1.SelectorSyncSet {
+		sss := testselectorsyncset.FullBuilder("test-selectorsyncset", scheme.GetScheme()).Build()
+		sss.ResourceVersion = resourceVersion
+		sss.Status.Source = status
+		return sss
+	}
+	cases := []struct {
+		name           string
+		oldObj         client.Object
+		newObj         client.Object
+		expectedResult bool
+	}{
+		{
+			name:   "syncset fetch time changed",
+			oldObj: syncSet(sourceStatus("a", fetched), "1"),
+			newObj: syncSet(sourceStatus("a", refetched), "2"),
+		},
+		{
+			name:           "syncset revision changed",
+			oldObj:         syncSet(sourceStatus("a", fetched), "1"),
+			newObj:         syncSet(sourceStatus("b", refetched), "2"),
+			expectedResult: true,
+		},
+		{
+			name:           "syncset source status added",
+			oldObj:         syncSet(nil, "1"),
+			newObj:         syncSet(sourceStatus("a", refetched), "2"),
+			expectedResult: true,
+		},
+		{
+			name:   "selectorsyncset fetch time changed",
+			oldObj: selectorSyncSet(sourceStatus("a", fetched), "1"),
+			newObj: selectorSyncSet(sourceStatus("a", refetched), "2"),
+		},
+		{
+			name:   "selectorsyncset rollout changed",
+			oldObj: selectorSyncSet(sourceStatus("a", fetched), "1"),
+			newObj: func() client.Object {
+				sss := selectorSyncSet(sourceStatus("a", fetched), "2")
+				sss.Status.Rollout = &hivev1.SelectorSyncSetRolloutStatus{ObservedGeneration: 1}
+				return sss
+			}(),
+			expectedResult: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := ignoreSourceFetchTimeUpdates().Update(event.UpdateEvent{ObjectOld: tc.oldObj, ObjectNew: tc.newObj})
+			assert.Equal(t, tc.expectedResult, result, "unexpected predicate result")
+		})
+	}
+}
@@ -17,6 +17,9 @@ type CommonSyncSet interface {
 
 	// GetSpec gets the common spec of the syncset
 	GetSpec() *hivev1.SyncSetCommonSpec
+
+	// GetSourceStatus gets the status of fetching the source of the syncset
+	GetSourceStatus() *hivev1.SyncSetSourceStatus
 }
 
 // SyncSetAsCommon is a SyncSet typed as a CommonSyncSet
@@ -34,6 +37,10 @@ func (s *SyncSetAsCommon) GetSpec() *hivev1.SyncSetCommonSpec {
 	return &s.Spec.SyncSetCommonSpec
 }
 
+func (s *SyncSetAsCommon) GetSourceStatus() *hivev1.SyncSetSourceStatus {
+	return s.Status.Source
+}
+
 // SelectorSyncSetAsCommon is a SelectorSyncSet typed as a CommonSyncSet
 type SelectorSyncSetAsCommon hivev1.SelectorSyncSet
 
@@ -48,3 +55,7 @@ func (s *SelectorSyncSetAsCommon) AsMetaObject() metav1.Object {
 func (s *SelectorSyncSetAsCommon) GetSpec() *hivev1.SyncSetCommonSpec {
 	return &s.Spec.SyncSetCommonSpec
 }
+
+func (s *SelectorSyncSetAsCommon) GetSourceStatus() *hivev1.SyncSetSourceStatus {
+	return s.Status.Source
+}
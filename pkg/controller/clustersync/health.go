@@ -0,0 +1,178 @@
+package clustersync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveintv1alpha1 "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	"github.com/openshift/hive/pkg/syncsethealth"
+)
+
+const (
+	// healthRecheckInterval is how long after the resources of syncsets with health checks are found not to be healthy
+	// that they are first re-assessed. The interval doubles each time they are still not healthy, up to
+	// maxHealthRecheckInterval.
+	healthRecheckInterval    = time.Minute
+	maxHealthRecheckInterval = 16 * time.Minute
+
+	// healthResourcesCacheSize is the number of syncsets for which the resources to assess are cached.
+	healthResourcesCacheSize = 4096
+)
+
+// healthAssessor assesses the health of the resources of syncsets in a cluster. The client for the cluster is only
+// built if there is a syncset with a health check.
+type healthAssessor struct {
+	r            *ReconcileClusterSync
+	cd           *hivev1.ClusterDeployment
+	remoteClient client.Client
+	logger       log.FieldLogger
+}
+
+// assess sets the health in the sync statuses of the syncsets. It returns true if the health of any syncset with a
+// health check is not known to be healthy. The health in a sync status is left unchanged if it cannot be assessed.
+func (a *healthAssessor) assess(syncSetType string, syncSets []CommonSyncSet, syncStatuses []hiveintv1alpha1.SyncStatus) (unhealthy bool) {
+	for _, syncSet := range syncSets {
+		syncStatus, i := getOldSyncStatus(syncSet, syncStatuses)
+		if i < 0 {
+			continue
+		}
+		healthCheck := syncSet.GetSpec().HealthCheck
+		if healthCheck == nil {
+			syncStatuses[i].Health = ""
+			syncStatuses[i].UnhealthyResources = nil
+			continue
+		}
+		logger := a.logger.WithField(syncSetType, syncSet.AsMetaObject().GetName())
+		resources, err := a.assessSyncSet(syncSet, healthCheck, logger)
+		if err != nil {
+			logger.WithError(err).Warn("could not assess health of syncset")
+			unhealthy = true
+			continue
+		}
+		health := syncsethealth.Rollup(resources)
+		if health != hiveintv1alpha1.HealthySyncSetHealth {
+			unhealthy = true
+		}
+		if health != syncStatus.Health {
+			logger.WithField("health", health).Info("health of syncset has changed")
+		}
+		syncStatuses[i].Health = health
+		syncStatuses[i].UnhealthyResources = nil
+		for _, resource := range resources {
+			if resource.Health != hiveintv1alpha1.HealthySyncSetHealth {
+				syncStatuses[i].UnhealthyResources = append(syncStatuses[i].UnhealthyResources, resource)
+			}
+		}
+		syncsethealth.SortResources(syncStatuses[i].UnhealthyResources)
+	}
+	return
+}
+
+func (a *healthAssessor) assessSyncSet(syncSet CommonSyncSet, healthCheck *hivev1.SyncSetHealthCheck, logger log.FieldLogger) ([]hiveintv1alpha1.ResourceHealth, error) {
+	assessor := syncsethealth.NewAssessor(healthCheck)
+	references, err := a.resourcesToAssess(syncSet, assessor, logger)
+	if err != nil {
+		return nil, err
+	}
+	var resources []hiveintv1alpha1.ResourceHealth
+	for _, ref := range references {
+		resource := hiveintv1alpha1.ResourceHealth{SyncResourceReference: ref}
+		obj, err := a.getResource(ref)
+		switch {
+		case apierrors.IsNotFound(err):
+			resource.Health = hiveintv1alpha1.ProgressingSyncSetHealth
+			resource.Message = "resource does not exist"
+		case err != nil:
+			return nil, errors.Wrapf(err, "failed to get %s, Kind=%s %s/%s", ref.APIVersion, ref.Kind, ref.Namespace, ref.Name)
+		default:
+			resource.Health, resource.Message, err = assessor.Assess(obj)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to assess %s, Kind=%s %s/%s", ref.APIVersion, ref.Kind, ref.Namespace, ref.Name)
+			}
+		}
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+// resourcesToAssess returns the resources of the syncset which have a health rule. They are cached for each generation
+// of the syncset and revision of its source, so that the syncset is only rendered again to assess its health when the
+// syncset, its source, or the labels of the cluster that its values may be templated with have changed.
+func (a *healthAssessor) resourcesToAssess(syncSet CommonSyncSet, assessor *syncsethealth.Assessor, logger log.FieldLogger) ([]hiveintv1alpha1.SyncResourceReference, error) {
+	key, err := json.Marshal(struct {
+		UID        types.UID         `json:"uid"`
+		Generation int64             `json:"generation"`
+		Revision   string            `json:"revision"`
+		Cluster    string            `json:"cluster"`
+		Labels     map[string]string `json:"labels"`
+	}{
+		UID:        syncSet.AsMetaObject().GetUID(),
+		Generation: syncSet.AsMetaObject().GetGeneration(),
+		Revision:   sourceRevision(syncSet),
+		Cluster:    a.cd.Namespace + "/" + a.cd.Name,
+		Labels:     a.cd.Labels,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if references, ok := a.r.healthResources.Get(string(key)); ok {
+		return references.([]hiveintv1alpha1.SyncResourceReference), nil
+	}
+	sourceResources, err := a.r.getSourceResources(syncSet, a.cd, logger)
+	if err != nil {
+		return nil, err
+	}
+	rawResources := append(append([]runtime.RawExtension{}, syncSet.GetSpec().Resources...), sourceResources...)
+	_, allReferences, err := decodeResources(rawResources, logger)
+	if err != nil {
+		return nil, err
+	}
+	var references []hiveintv1alpha1.SyncResourceReference
+	for _, ref := range allReferences {
+		if assessor.Assesses(ref.APIVersion, ref.Kind) {
+			references = append(references, ref)
+		}
+	}
+	a.r.healthResources.Add(string(key), references)
+	return references, nil
+}
+
+// nextHealthRecheck returns how long until the resources of the cluster are re-assessed while they are not all
+// healthy, backing off while they stay that way. The backoff starts over when syncsets have been applied, since their
+// resources are expected to change.
+func (r *ReconcileClusterSync) nextHealthRecheck(cd *hivev1.ClusterDeployment, applied bool) time.Duration {
+	id := cd.Namespace + "/" + cd.Name
+	if applied {
+		r.healthRecheckBackoff.Reset(id)
+	}
+	r.healthRecheckBackoff.GC()
+	r.healthRecheckBackoff.Next(id, time.Now())
+	return r.healthRecheckBackoff.Get(id)
+}
+
+func (a *healthAssessor) getResource(ref hiveintv1alpha1.SyncResourceReference) (*unstructured.Unstructured, error) {
+	if a.remoteClient == nil {
+		remoteClient, err := a.r.remoteClusterAPIClientBuilder(a.cd).Build()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build client for cluster: %w", err)
+		}
+		a.remoteClient = remoteClient
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(ref.APIVersion)
+	obj.SetKind(ref.Kind)
+	err := a.remoteClient.Get(context.Background(), client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, obj)
+	return obj, err
+}
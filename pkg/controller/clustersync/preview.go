@@ -0,0 +1,330 @@
+package clustersync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	log "github.com/sirupsen/logrus"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveintv1alpha1 "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	"github.com/openshift/hive/pkg/resource"
+	"github.com/openshift/hive/pkg/secretsource"
+)
+
+// PreviewAction is the change that applying a syncset would make to an object in the target cluster.
+type PreviewAction string
+
+const (
+	// PreviewCreate means that the object does not exist and would be created.
+	PreviewCreate PreviewAction = "Create"
+	// PreviewUpdate means that the object exists and would be changed.
+	PreviewUpdate PreviewAction = "Update"
+	// PreviewUnchanged means that the object exists and would not be changed.
+	PreviewUnchanged PreviewAction = "Unchanged"
+	// PreviewDelete means that the object was applied by a previous generation of a syncset with the Sync resource
+	// apply mode, and would be deleted.
+	PreviewDelete PreviewAction = "Delete"
+	// PreviewError means that the change to the object could not be determined.
+	PreviewError PreviewAction = "Error"
+)
+
+// ObjectPreview is the change that applying a syncset would make to an object in the target cluster.
+type ObjectPreview struct {
+	// Resource is the object.
+	Resource hiveintv1alpha1.SyncResourceReference
+	// Source describes where the object comes from in the syncset, e.g. "resource 0", "secret 1" or "patch 2".
+	Source string
+	// Action is the change to the object.
+	Action PreviewAction
+	// Diff is a unified diff of the object in the target cluster and the object after the change. The data of
+	// secrets is replaced by a hash of the data.
+	Diff string
+	// Error is the reason the change to the object could not be determined when the Action is PreviewError.
+	Error string
+}
+
+// Previewer determines the changes that applying a syncset would make to a cluster without making them.
+type Previewer struct {
+	r *ReconcileClusterSync
+}
+
+// NewPreviewer returns a Previewer which reads the source caches and source secrets of syncsets with the client for
+// the management cluster, and reads external secrets from Vault only from the vaultAddresses. The source caches of
+// SelectorSyncSets are read from the namespace given by the HIVE_NS environment variable.
+func NewPreviewer(c client.Client, vaultAddresses []string, logger log.FieldLogger) *Previewer {
+	return &Previewer{
+		r: &ReconcileClusterSync{
+			Client:         c,
+			logger:         logger,
+			secretResolver: secretsource.NewResolver(c, vaultAddresses, logger),
+		},
+	}
+}
+
+// Preview renders the objects of the syncset for the cluster and applies them to the target cluster in the same way as
+// the clustersync controller, with the dryRunHelper, returning the change to each object. The remoteClient reads the
+// objects in the target cluster that the changes are compared with. The clusterSync is used to find the objects that
+// would be deleted because they are no longer in a syncset with the Sync resource apply mode, and may be nil.
+func (p *Previewer) Preview(
+	ctx context.Context,
+	syncSet CommonSyncSet,
+	cd *hivev1.ClusterDeployment,
+	clusterSync *hiveintv1alpha1.ClusterSync,
+	remoteClient client.Client,
+	dryRunHelper resource.DryRunHelper,
+) ([]ObjectPreview, error) {
+	logger := p.r.logger.WithField("syncSet", syncSet.AsMetaObject().GetName())
+	spec := syncSet.GetSpec()
+
+	sourceResources, err := p.r.getSourceResources(syncSet, cd, logger)
+	if err != nil {
+		return nil, err
+	}
+	rawResources := append(append([]runtime.RawExtension{}, spec.Resources...), sourceResources...)
+	resources, referencesToResources, err := decodeResources(rawResources, logger)
+	if err != nil {
+		return nil, err
+	}
+	externalSecrets, _, err := p.r.resolveExternalSecrets(ctx, syncSet, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	var previews []ObjectPreview
+	for i, obj := range resources {
+		previews = append(previews, previewApply(ctx, remoteClient, dryRunHelper, obj, referencesToResources[i], fmt.Sprintf("resource %d", i), spec.ApplyBehavior))
+	}
+	referencesToSecrets := referencesToSecrets(syncSet)
+	for i, secretMapping := range spec.Secrets {
+		source := fmt.Sprintf("secret %d", i)
+		secret, err, _ := p.r.buildSecret(syncSet, i, secretMapping, externalSecrets[i], logger)
+		if err != nil {
+			previews = append(previews, ObjectPreview{Resource: referencesToSecrets[i], Source: source, Action: PreviewError, Error: err.Error()})
+			continue
+		}
+		previews = append(previews, previewApply(ctx, remoteClient, dryRunHelper, secret, referencesToSecrets[i], source, spec.ApplyBehavior))
+	}
+	for i, patch := range spec.Patches {
+		previews = append(previews, previewPatch(ctx, remoteClient, dryRunHelper, patch, fmt.Sprintf("patch %d", i)))
+	}
+
+	// Objects applied by the syncset which are no longer in the syncset are deleted if the syncset has the Sync
+	// resource apply mode.
+	if clusterSync != nil {
+		syncStatuses := clusterSync.Status.SyncSets
+		if syncSet.AsMetaObject().GetNamespace() == "" {
+			syncStatuses = clusterSync.Status.SelectorSyncSets
+		}
+		resourcesInSyncSet := append(referencesToResources, referencesToSecrets...)
+		oldSyncStatus, _ := getOldSyncStatus(syncSet, syncStatuses)
+		for _, ref := range oldSyncStatus.ResourcesToDelete {
+			if containsResource(resourcesInSyncSet, ref) {
+				continue
+			}
+			preview := ObjectPreview{Resource: ref, Source: "deleted", Action: PreviewDelete}
+			live, err := getLive(ctx, remoteClient, ref)
+			switch {
+			case err != nil:
+				preview.Action, preview.Error = PreviewError, err.Error()
+			case live == nil:
+				// The object has already been deleted.
+				continue
+			default:
+				preview.Diff, err = diffObjects(ref, live, nil)
+				if err != nil {
+					return nil, err
+				}
+			}
+			previews = append(previews, preview)
+		}
+	}
+	return previews, nil
+}
+
+// previewApply applies an object to the target cluster with a dry run of the apply that the syncset would make, and
+// compares the result with the object in the target cluster.
+func previewApply(
+	ctx context.Context,
+	remoteClient client.Client,
+	dryRunHelper resource.DryRunHelper,
+	obj hivev1.MetaRuntimeObject,
+	ref hiveintv1alpha1.SyncResourceReference,
+	source string,
+	applyBehavior hivev1.SyncSetApplyBehavior,
+) ObjectPreview {
+	preview := ObjectPreview{Resource: ref, Source: source}
+	fail := func(err error) ObjectPreview {
+		preview.Action, preview.Error = PreviewError, err.Error()
+		return preview
+	}
+	live, err := getLive(ctx, remoteClient, ref)
+	if err != nil {
+		return fail(err)
+	}
+	applyFn := dryRunHelper.Apply
+	switch applyBehavior {
+	case hivev1.CreateOrUpdateSyncSetApplyBehavior:
+		applyFn = dryRunHelper.CreateOrUpdate
+	case hivev1.CreateOnlySyncSetApplyBehavior:
+		applyFn = dryRunHelper.Create
+	}
+	// The object is applied as the syncset would apply it, with the hive managed label.
+	obj = obj.DeepCopyObject().(hivev1.MetaRuntimeObject)
+	setHiveManagedLabel(obj)
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return fail(err)
+	}
+	_, applied, err := applyFn(data)
+	if err != nil {
+		return fail(errors.Wrap(err, "dry-run apply failed"))
+	}
+	return finishPreview(preview, live, applied)
+}
+
+// previewPatch patches an object in the target cluster with a dry run of the patch that the syncset would make, and
+// compares the result with the object in the target cluster.
+func previewPatch(ctx context.Context, remoteClient client.Client, dryRunHelper resource.DryRunHelper, patch hivev1.SyncObjectPatch, source string) ObjectPreview {
+	ref := hiveintv1alpha1.SyncResourceReference{
+		APIVersion: patch.APIVersion,
+		Kind:       patch.Kind,
+		Namespace:  patch.Namespace,
+		Name:       patch.Name,
+	}
+	preview := ObjectPreview{Resource: ref, Source: source}
+	fail := func(err error) ObjectPreview {
+		preview.Action, preview.Error = PreviewError, err.Error()
+		return preview
+	}
+	live, err := getLive(ctx, remoteClient, ref)
+	if err != nil {
+		return fail(err)
+	}
+	if live == nil {
+		return fail(errors.New("object to patch does not exist"))
+	}
+	patched, err := dryRunHelper.Patch(
+		types.NamespacedName{Namespace: patch.Namespace, Name: patch.Name},
+		patch.Kind,
+		patch.APIVersion,
+		[]byte(patch.Patch),
+		patch.PatchType,
+	)
+	if err != nil {
+		return fail(errors.Wrap(err, "dry-run patch failed"))
+	}
+	return finishPreview(preview, live, patched)
+}
+
+func finishPreview(preview ObjectPreview, live, applied *unstructured.Unstructured) ObjectPreview {
+	diff, err := diffObjects(preview.Resource, live, applied)
+	if err != nil {
+		preview.Action, preview.Error = PreviewError, err.Error()
+		return preview
+	}
+	preview.Diff = diff
+	switch {
+	case live == nil:
+		preview.Action = PreviewCreate
+	case diff != "":
+		preview.Action = PreviewUpdate
+	default:
+		preview.Action = PreviewUnchanged
+	}
+	return preview
+}
+
+// getLive gets an object from the target cluster, returning nil if it does not exist.
+func getLive(ctx context.Context, remoteClient client.Client, ref hiveintv1alpha1.SyncResourceReference) (*unstructured.Unstructured, error) {
+	live := &unstructured.Unstructured{}
+	live.SetAPIVersion(ref.APIVersion)
+	live.SetKind(ref.Kind)
+	err := remoteClient.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, live)
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, errors.Wrap(err, "failed to get object")
+	}
+	return live, nil
+}
+
+// diffObjects returns a unified diff of the YAML of two versions of an object, either of which may be nil. Fields
+// that are maintained by the API server are left out of the diff, and the data of secrets is replaced by hashes.
+func diffObjects(ref hiveintv1alpha1.SyncResourceReference, before, after *unstructured.Unstructured) (string, error) {
+	a, err := previewYAML(before)
+	if err != nil {
+		return "", err
+	}
+	b, err := previewYAML(after)
+	if err != nil {
+		return "", err
+	}
+	if a == b {
+		return "", nil
+	}
+	name := ref.Name
+	if ref.Namespace != "" {
+		name = ref.Namespace + "/" + name
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(a),
+		B:        difflib.SplitLines(b),
+		FromFile: fmt.Sprintf("live %s %s", ref.Kind, name),
+		ToFile:   fmt.Sprintf("applied %s %s", ref.Kind, name),
+		Context:  3,
+	})
+}
+
+func previewYAML(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	obj = obj.DeepCopy()
+	for _, field := range []string{"managedFields", "resourceVersion", "uid", "generation", "creationTimestamp", "selfLink"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(obj.Object, "metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration")
+	if len(obj.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+	}
+	unstructured.RemoveNestedField(obj.Object, "status")
+	if obj.GetAPIVersion() == secretAPIVersion && obj.GetKind() == secretKind {
+		redactSecret(obj)
+	}
+	b, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// redactSecret replaces the values of the data of a secret with hashes of the values, so that changes to the values
+// are shown without showing the values.
+func redactSecret(obj *unstructured.Unstructured) {
+	for _, field := range []string{"data", "stringData"} {
+		data, ok, _ := unstructured.NestedMap(obj.Object, field)
+		if !ok {
+			continue
+		}
+		for k, v := range data {
+			s, _ := v.(string)
+			sum := sha256.Sum256([]byte(s))
+			data[k] = "<redacted sha256:" + hex.EncodeToString(sum[:])[:12] + ">"
+		}
+		unstructured.SetNestedMap(obj.Object, data, field)
+	}
+}
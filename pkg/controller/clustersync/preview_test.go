@@ -0,0 +1,213 @@
+package clustersync
+
+import (
+	"context"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveintv1alpha1 "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	"github.com/openshift/hive/pkg/constants"
+	"github.com/openshift/hive/pkg/resource"
+	testcs "github.com/openshift/hive/pkg/test/clustersync"
+	testfake "github.com/openshift/hive/pkg/test/fake"
+	testsecret "github.com/openshift/hive/pkg/test/secret"
+	testsyncset "github.com/openshift/hive/pkg/test/syncset"
+	"github.com/openshift/hive/pkg/util/scheme"
+)
+
+// fakeDryRunHelper simulates the dry runs of the applies and patches of an API server, which the fake client does not
+// support, by patching a copy of the object from the client in a scratch client. Applies are simulated with merge
+// patches.
+type fakeDryRunHelper struct {
+	client.Client
+}
+
+func (h *fakeDryRunHelper) Apply(obj []byte) (resource.ApplyResult, *unstructured.Unstructured, error) {
+	return h.createOrPatch(obj, true)
+}
+
+func (h *fakeDryRunHelper) CreateOrUpdate(obj []byte) (resource.ApplyResult, *unstructured.Unstructured, error) {
+	return h.createOrPatch(obj, true)
+}
+
+func (h *fakeDryRunHelper) Create(obj []byte) (resource.ApplyResult, *unstructured.Unstructured, error) {
+	return h.createOrPatch(obj, false)
+}
+
+func (h *fakeDryRunHelper) createOrPatch(data []byte, update bool) (resource.ApplyResult, *unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(data); err != nil {
+		return "", nil, err
+	}
+	live := obj.DeepCopy()
+	switch err := h.Get(context.Background(), client.ObjectKeyFromObject(obj), live); {
+	case apierrors.IsNotFound(err):
+		return resource.CreatedApplyResult, obj, nil
+	case err != nil:
+		return "", nil, err
+	case !update:
+		return resource.UnchangedApplyResult, live, nil
+	}
+	patched, err := h.patch(live, client.RawPatch(types.MergePatchType, data))
+	return resource.ConfiguredApplyResult, patched, err
+}
+
+func (h *fakeDryRunHelper) Patch(name types.NamespacedName, kind, apiVersion string, patch []byte, patchType string) (*unstructured.Unstructured, error) {
+	live := &unstructured.Unstructured{}
+	live.SetAPIVersion(apiVersion)
+	live.SetKind(kind)
+	if err := h.Get(context.Background(), name, live); err != nil {
+		return nil, err
+	}
+	patchTypes := map[string]types.PatchType{"json": types.JSONPatchType, "merge": types.MergePatchType}
+	pt, ok := patchTypes[patchType]
+	if !ok {
+		pt = types.StrategicMergePatchType
+	}
+	return h.patch(live, client.RawPatch(pt, patch))
+}
+
+func (h *fakeDryRunHelper) patch(live *unstructured.Unstructured, patch client.Patch) (*unstructured.Unstructured, error) {
+	scratch := testfake.NewFakeClientBuilder().Build()
+	live = live.DeepCopy()
+	live.SetResourceVersion("")
+	if err := scratch.Create(context.Background(), live); err != nil {
+		return nil, err
+	}
+	if err := scratch.Patch(context.Background(), live, patch); err != nil {
+		return nil, err
+	}
+	return live, nil
+}
+
+// previewSyncSet previews a syncset after reading it back from the hub client, which encodes its resources.
+func previewSyncSet(t *testing.T, hubClient client.Client, syncSet *hivev1.SyncSet, clusterSync *hiveintv1alpha1.ClusterSync, remoteClient client.Client) []ObjectPreview {
+	syncSet.ResourceVersion = ""
+	require.NoError(t, hubClient.Create(context.Background(), syncSet), "unexpected error creating syncset")
+	ss := &hivev1.SyncSet{}
+	require.NoError(t, hubClient.Get(context.Background(), client.ObjectKeyFromObject(syncSet), ss), "unexpected error getting syncset")
+	previews, err := NewPreviewer(hubClient, nil, log.StandardLogger()).Preview(
+		context.Background(), (*SyncSetAsCommon)(ss), cdBuilder(scheme.GetScheme()).Build(), clusterSync, remoteClient,
+		&fakeDryRunHelper{remoteClient})
+	require.NoError(t, err, "unexpected error previewing syncset")
+	return previews
+}
+
+func TestPreview(t *testing.T) {
+	scheme := scheme.GetScheme()
+	configMap := func(name, value string) *corev1.ConfigMap {
+		cm := testConfigMap("dest-namespace", name)
+		cm.Data = map[string]string{"key": value}
+		return cm
+	}
+	syncSet := testsyncset.FullBuilder(testNamespace, "test-syncset", scheme).Build(
+		testsyncset.ForClusterDeployments(testCDName),
+		testsyncset.WithApplyMode(hivev1.SyncResourceApplyMode),
+		testsyncset.WithResources(
+			configMap("unchanged", "value"),
+			configMap("updated", "new-value"),
+			configMap("created", "value"),
+		),
+		testsyncset.WithSecrets(testSecretMapping("test-secret", "dest-namespace", "dest-secret")),
+		testsyncset.WithPatches(hivev1.SyncObjectPatch{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Namespace:  "dest-namespace",
+			Name:       "patched",
+			Patch:      `{"data":{"key":"patched-value"}}`,
+			PatchType:  "merge",
+		}),
+	)
+	hubClient := testfake.NewFakeClientBuilder().WithRuntimeObjects(
+		testsecret.FullBuilder(testNamespace, "test-secret", scheme).Build(
+			testsecret.WithDataKeyValue("password", []byte("hunter2")),
+		),
+	).Build()
+	// Objects applied by the syncset before have the hive managed label.
+	applied := func(cm *corev1.ConfigMap) *corev1.ConfigMap {
+		cm.Labels = map[string]string{constants.HiveManagedLabel: "true"}
+		return cm
+	}
+	remoteClient := testfake.NewFakeClientBuilder().WithRuntimeObjects(
+		applied(configMap("unchanged", "value")),
+		applied(configMap("updated", "old-value")),
+		configMap("patched", "value"),
+		applied(configMap("removed", "value")),
+	).Build()
+	clusterSync := clusterSyncBuilder(scheme).Build(testcs.WithSyncSetStatus(buildSyncStatus("test-syncset",
+		withResourcesToDelete(
+			testConfigMapRef("dest-namespace", "unchanged"),
+			testConfigMapRef("dest-namespace", "removed"),
+			testConfigMapRef("dest-namespace", "already-removed"),
+		),
+	)))
+
+	previews := previewSyncSet(t, hubClient, syncSet, clusterSync, remoteClient)
+
+	actions := map[string]PreviewAction{}
+	diffs := map[string]string{}
+	for _, p := range previews {
+		assert.Empty(t, p.Error, "unexpected error previewing %s", p.Source)
+		actions[p.Resource.Name] = p.Action
+		diffs[p.Resource.Name] = p.Diff
+	}
+	assert.Equal(t, map[string]PreviewAction{
+		"unchanged":   PreviewUnchanged,
+		"updated":     PreviewUpdate,
+		"created":     PreviewCreate,
+		"dest-secret": PreviewCreate,
+		"patched":     PreviewUpdate,
+		"removed":     PreviewDelete,
+	}, actions, "unexpected preview actions")
+	assert.Empty(t, diffs["unchanged"], "expected no diff for unchanged object")
+	assert.Contains(t, diffs["updated"], "-  key: old-value\n+  key: new-value\n", "unexpected diff for updated object")
+	assert.Contains(t, diffs["created"], "+  key: value\n", "unexpected diff for created object")
+	assert.Contains(t, diffs["created"], "+    hive.openshift.io/managed: \"true\"\n", "expected hive managed label on created object")
+	assert.Contains(t, diffs["patched"], "-  key: value\n+  key: patched-value\n", "unexpected diff for patched object")
+	assert.Contains(t, diffs["removed"], "-  key: value\n", "unexpected diff for deleted object")
+	assert.Contains(t, diffs["dest-secret"], "password: <redacted sha256:", "expected secret data to be redacted")
+	assert.NotContains(t, diffs["dest-secret"], "aHVudGVyMg==", "expected secret data to be redacted")
+}
+
+func TestPreviewCreateOnly(t *testing.T) {
+	scheme := scheme.GetScheme()
+	cm := testConfigMap("dest-namespace", "existing")
+	cm.Data = map[string]string{"key": "new-value"}
+	syncSet := testsyncset.FullBuilder(testNamespace, "test-syncset", scheme).Build(
+		testsyncset.ForClusterDeployments(testCDName),
+		testsyncset.WithApplyBehavior(hivev1.CreateOnlySyncSetApplyBehavior),
+		testsyncset.WithResources(cm),
+	)
+	existing := testConfigMap("dest-namespace", "existing")
+	existing.Data = map[string]string{"key": "old-value"}
+	remoteClient := testfake.NewFakeClientBuilder().WithRuntimeObjects(existing).Build()
+
+	previews := previewSyncSet(t, testfake.NewFakeClientBuilder().Build(), syncSet, nil, remoteClient)
+	require.Len(t, previews, 1, "unexpected number of previews")
+	assert.Equal(t, PreviewUnchanged, previews[0].Action, "expected existing object to be left unchanged")
+}
+
+func TestPreviewMissingSourceSecret(t *testing.T) {
+	scheme := scheme.GetScheme()
+	syncSet := testsyncset.FullBuilder(testNamespace, "test-syncset", scheme).Build(
+		testsyncset.ForClusterDeployments(testCDName),
+		testsyncset.WithSecrets(testSecretMapping("missing-secret", "dest-namespace", "dest-secret")),
+	)
+	remoteClient := testfake.NewFakeClientBuilder().Build()
+
+	previews := previewSyncSet(t, testfake.NewFakeClientBuilder().Build(), syncSet, nil, remoteClient)
+	require.Len(t, previews, 1, "unexpected number of previews")
+	assert.Equal(t, PreviewError, previews[0].Action, "expected error previewing secret")
+	assert.Equal(t, hiveintv1alpha1.SyncResourceReference{APIVersion: "v1", Kind: "Secret", Namespace: "dest-namespace", Name: "dest-secret"},
+		previews[0].Resource, "unexpected resource")
+}
@@ -0,0 +1,395 @@
+package clusterupgraderollout
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/client-go/util/workqueue"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/constants"
+	hivemetrics "github.com/openshift/hive/pkg/controller/metrics"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+)
+
+const (
+	ControllerName = hivev1.ClusterUpgradeRolloutControllerName
+)
+
+// Add creates a new ClusterUpgradeRollout Controller and adds it to the Manager with default RBAC. The Manager will set
+// fields on the Controller and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	logger := log.WithField("controller", ControllerName)
+	concurrentReconciles, clientRateLimiter, queueRateLimiter, err := controllerutils.GetControllerConfig(mgr.GetClient(), ControllerName)
+	if err != nil {
+		logger.WithError(err).Error("could not get controller configurations")
+		return err
+	}
+	return AddToManager(mgr, NewReconciler(mgr, clientRateLimiter), concurrentReconciles, queueRateLimiter)
+}
+
+// NewReconciler returns a new reconcile.Reconciler
+func NewReconciler(mgr manager.Manager, rateLimiter flowcontrol.RateLimiter) *ReconcileClusterUpgradeRollout {
+	return &ReconcileClusterUpgradeRollout{
+		Client: controllerutils.NewClientWithMetricsOrDie(mgr, ControllerName, &rateLimiter),
+		logger: log.WithField("controller", ControllerName),
+	}
+}
+
+// AddToManager adds a new Controller to mgr with r as the reconcile.Reconciler
+func AddToManager(mgr manager.Manager, r *ReconcileClusterUpgradeRollout, concurrentReconciles int, rateLimiter workqueue.RateLimiter) error {
+	// Create a new controller
+	c, err := controller.New(ControllerName.String()+"-controller", mgr, controller.Options{
+		Reconciler:              controllerutils.NewDelayingReconciler(r, r.logger),
+		MaxConcurrentReconciles: concurrentReconciles,
+		RateLimiter:             rateLimiter,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to ClusterUpgradeRollouts
+	if err := c.Watch(source.Kind(mgr.GetCache(), &hivev1.ClusterUpgradeRollout{}), &handler.EnqueueRequestForObject{}); err != nil {
+		return err
+	}
+
+	// Watch for changes to ClusterDeployments, which report the progress of their upgrade and may join or leave a wave.
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &hivev1.ClusterDeployment{}),
+		handler.EnqueueRequestsFromMapFunc(requestsForClusterDeployment(r.Client, r.logger))); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func requestsForClusterDeployment(c client.Client, logger log.FieldLogger) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		if _, ok := o.(*hivev1.ClusterDeployment); !ok {
+			return nil
+		}
+		// Any rollout may select the ClusterDeployment, so all of them are checked.
+		rolloutList := &hivev1.ClusterUpgradeRolloutList{}
+		if err := c.List(context.Background(), rolloutList); err != nil {
+			logger.WithError(err).Log(controllerutils.LogLevel(err), "failed to list ClusterUpgradeRollouts for ClusterDeployment")
+			return nil
+		}
+		requests := make([]reconcile.Request, len(rolloutList.Items))
+		for i, rollout := range rolloutList.Items {
+			requests[i].Name = rollout.Name
+		}
+		return requests
+	}
+}
+
+var _ reconcile.Reconciler = &ReconcileClusterUpgradeRollout{}
+
+// ReconcileClusterUpgradeRollout reconciles a ClusterUpgradeRollout to upgrade the clusters in its waves, one wave
+// after another.
+type ReconcileClusterUpgradeRollout struct {
+	client.Client
+	logger log.FieldLogger
+}
+
+// Reconcile sets the upgrade of a ClusterUpgradeRollout on the ClusterDeployments of the clusters in the waves that
+// have been started, tallies which of them have completed the upgrade, and starts the next wave once every cluster
+// in the current one has completed it and the wave has soaked. The ClusterDeployments are released to other rollouts
+// once the rollout is complete or deleted.
+func (r *ReconcileClusterUpgradeRollout) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := controllerutils.BuildControllerLogger(ControllerName, "clusterUpgradeRollout", request.NamespacedName)
+	logger.Info("reconciling ClusterUpgradeRollout")
+	recobsrv := hivemetrics.NewReconcileObserver(ControllerName, logger)
+	defer recobsrv.ObserveControllerReconcileTime()
+
+	rollout := &hivev1.ClusterUpgradeRollout{}
+	if err := r.Get(context.TODO(), request.NamespacedName, rollout); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Debug("ClusterUpgradeRollout not found")
+			return reconcile.Result{}, nil
+		}
+		logger.WithError(err).Error("failed to get ClusterUpgradeRollout")
+		return reconcile.Result{}, err
+	}
+
+	if rollout.DeletionTimestamp != nil {
+		if !controllerutils.HasFinalizer(rollout, hivev1.FinalizerClusterUpgradeRollout) {
+			logger.Debug("ClusterUpgradeRollout is being deleted")
+			return reconcile.Result{}, nil
+		}
+		logger.Info("ClusterUpgradeRollout is being deleted, releasing its ClusterDeployments")
+		if err := r.releaseClusterDeployments(rollout, logger); err != nil {
+			return reconcile.Result{}, err
+		}
+		controllerutils.DeleteFinalizer(rollout, hivev1.FinalizerClusterUpgradeRollout)
+		if err := r.Update(context.TODO(), rollout); err != nil {
+			logger.WithError(err).Log(controllerutils.LogLevel(err), "could not remove finalizer from ClusterUpgradeRollout")
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+	if !controllerutils.HasFinalizer(rollout, hivev1.FinalizerClusterUpgradeRollout) {
+		logger.Debug("adding finalizer to ClusterUpgradeRollout")
+		controllerutils.AddFinalizer(rollout, hivev1.FinalizerClusterUpgradeRollout)
+		if err := r.Update(context.TODO(), rollout); err != nil {
+			logger.WithError(err).Log(controllerutils.LogLevel(err), "could not add finalizer to ClusterUpgradeRollout")
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
+	origStatus := rollout.Status.DeepCopy()
+	if rollout.Status.ObservedGeneration != rollout.Generation {
+		logger.WithField("generation", rollout.Generation).Info("starting rollout of new generation")
+		rollout.Status = hivev1.ClusterUpgradeRolloutStatus{
+			ObservedGeneration: rollout.Generation,
+			Phase:              hivev1.ProgressingClusterUpgradeRolloutPhase,
+			LastTransitionTime: metav1.Now(),
+		}
+	}
+
+	// Once this generation has been rolled out, its clusters are left for other rollouts to upgrade.
+	if rollout.Status.Phase == hivev1.CompleteClusterUpgradeRolloutPhase {
+		return reconcile.Result{}, r.releaseClusterDeployments(rollout, logger)
+	}
+
+	waveCDs, err := r.getClusterDeploymentsByWave(rollout, logger)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	requeueAfter, err := r.progressRollout(rollout, waveCDs, logger)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !reflect.DeepEqual(origStatus, &rollout.Status) {
+		if err := r.updateStatus(rollout, logger); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	if rollout.Status.Phase == hivev1.CompleteClusterUpgradeRolloutPhase {
+		return reconcile.Result{}, r.releaseClusterDeployments(rollout, logger)
+	}
+	if requeueAfter > 0 {
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+// progressRollout upgrades the clusters in the waves that have been started, and starts the next wave once the
+// current one is complete. It returns how long to wait before the rollout should be checked again, or zero if there
+// is no need to check again until something changes.
+func (r *ReconcileClusterUpgradeRollout) progressRollout(rollout *hivev1.ClusterUpgradeRollout, waveCDs [][]*hivev1.ClusterDeployment, logger log.FieldLogger) (time.Duration, error) {
+	status := &rollout.Status
+	now := metav1.Now()
+	setPhase := func(phase hivev1.ClusterUpgradeRolloutPhase, message string) {
+		if status.Phase != phase {
+			logger.WithField("phase", phase).Info(message)
+			status.LastTransitionTime = now
+		}
+		status.Phase = phase
+		status.Message = message
+	}
+
+	for i := 0; i < len(rollout.Spec.Waves) && i <= int(status.CurrentWave); i++ {
+		wave := &rollout.Spec.Waves[i]
+		if len(status.Waves) <= i {
+			status.Waves = append(status.Waves, hivev1.ClusterUpgradeWaveStatus{StartTime: &now})
+		}
+		waveStatus := &status.Waves[i]
+		waveStatus.Name = wave.Name
+		waveLogger := logger.WithField("wave", wave.Name)
+
+		if err := r.upgradeWave(rollout, wave, waveStatus, waveCDs[i], waveLogger); err != nil {
+			return 0, err
+		}
+
+		// Waves before the current one have completed, but clusters that join them later are still upgraded.
+		if i < int(status.CurrentWave) {
+			continue
+		}
+
+		if waveStatus.CompletedClusters < waveStatus.TotalClusters {
+			waveStatus.CompletionTime = nil
+			setPhase(hivev1.ProgressingClusterUpgradeRolloutPhase,
+				fmt.Sprintf("Waiting for %d of %d clusters in wave %s to complete the upgrade",
+					waveStatus.TotalClusters-waveStatus.CompletedClusters, waveStatus.TotalClusters, wave.Name))
+			return 0, nil
+		}
+
+		// Every cluster in the current wave has completed the upgrade. If the wave contains no clusters, there is
+		// nothing to soak.
+		if waveStatus.TotalClusters > 0 {
+			if waveStatus.CompletionTime == nil {
+				waveStatus.CompletionTime = &now
+			}
+			if wave.SoakTime != nil {
+				if wait := wave.SoakTime.Duration - now.Sub(waveStatus.CompletionTime.Time); wait > 0 {
+					setPhase(hivev1.ProgressingClusterUpgradeRolloutPhase,
+						fmt.Sprintf("Wave %s completed; soaking before starting the next wave", wave.Name))
+					return wait, nil
+				}
+			}
+		} else if waveStatus.CompletionTime == nil {
+			waveStatus.CompletionTime = &now
+		}
+
+		if i == len(rollout.Spec.Waves)-1 {
+			setPhase(hivev1.CompleteClusterUpgradeRolloutPhase, "All clusters in all waves have completed the upgrade")
+			return 0, nil
+		}
+
+		status.CurrentWave++
+		status.LastTransitionTime = now
+		waveLogger.WithField("nextWave", rollout.Spec.Waves[status.CurrentWave].Name).Info("starting next wave")
+	}
+	return 0, nil
+}
+
+// upgradeWave sets the upgrade of the rollout on the ClusterDeployments of the clusters in a wave, as long as no more
+// than the maximum number of clusters in the wave are upgrading, and tallies the progress of the wave.
+func (r *ReconcileClusterUpgradeRollout) upgradeWave(rollout *hivev1.ClusterUpgradeRollout, wave *hivev1.ClusterUpgradeWave, waveStatus *hivev1.ClusterUpgradeWaveStatus, cds []*hivev1.ClusterDeployment, logger log.FieldLogger) error {
+	var total, upgrading, blocked, completed int32
+	var pending []*hivev1.ClusterDeployment
+	for _, cd := range cds {
+		total++
+		if cd.Annotations[constants.ClusterUpgradeRolloutAnnotation] != rollout.Name || !reflect.DeepEqual(cd.Spec.Upgrade, &rollout.Spec.Upgrade) {
+			pending = append(pending, cd)
+			continue
+		}
+		switch upgradeStatus := cd.Status.Upgrade; {
+		case upgradeCompleted(&rollout.Spec.Upgrade, upgradeStatus):
+			completed++
+		case upgradeStatus != nil && (upgradeStatus.Phase == hivev1.BlockedClusterUpgradePhase || upgradeStatus.Phase == hivev1.FailingClusterUpgradePhase):
+			blocked++
+			upgrading++
+		default:
+			upgrading++
+		}
+	}
+
+	for _, cd := range pending {
+		if wave.MaxConcurrent != nil && upgrading >= *wave.MaxConcurrent {
+			break
+		}
+		cdLog := logger.WithField("clusterDeployment", cd.Namespace+"/"+cd.Name)
+		cdLog.Info("setting upgrade on ClusterDeployment")
+		if cd.Annotations == nil {
+			cd.Annotations = map[string]string{}
+		}
+		cd.Annotations[constants.ClusterUpgradeRolloutAnnotation] = rollout.Name
+		cd.Spec.Upgrade = rollout.Spec.Upgrade.DeepCopy()
+		if err := r.Update(context.TODO(), cd); err != nil {
+			cdLog.WithError(err).Log(controllerutils.LogLevel(err), "could not set upgrade on ClusterDeployment")
+			return err
+		}
+		upgrading++
+	}
+
+	waveStatus.TotalClusters = total
+	waveStatus.UpgradingClusters = upgrading
+	waveStatus.BlockedClusters = blocked
+	waveStatus.CompletedClusters = completed
+	return nil
+}
+
+// upgradeCompleted returns whether the upgrade status of a ClusterDeployment reports that the given upgrade has
+// completed.
+func upgradeCompleted(upgrade *hivev1.ClusterUpgrade, status *hivev1.ClusterUpgradeStatus) bool {
+	return status != nil && status.Phase == hivev1.CompletedClusterUpgradePhase &&
+		status.Version == upgrade.Version && status.Image == upgrade.Image
+}
+
+// getClusterDeploymentsByWave returns the installed clusters in each wave of the rollout, sorted by namespace and
+// name. A cluster selected by more than one wave is only in the first of them, and clusters being upgraded by another
+// rollout are left out.
+func (r *ReconcileClusterUpgradeRollout) getClusterDeploymentsByWave(rollout *hivev1.ClusterUpgradeRollout, logger log.FieldLogger) ([][]*hivev1.ClusterDeployment, error) {
+	selectors := make([]labels.Selector, len(rollout.Spec.Waves))
+	for i := range rollout.Spec.Waves {
+		selector, err := metav1.LabelSelectorAsSelector(&rollout.Spec.Waves[i].ClusterDeploymentSelector)
+		if err != nil {
+			logger.WithError(err).WithField("wave", rollout.Spec.Waves[i].Name).Error("unable to convert selector")
+			return nil, err
+		}
+		selectors[i] = selector
+	}
+
+	cdList := &hivev1.ClusterDeploymentList{}
+	if err := r.List(context.TODO(), cdList); err != nil {
+		logger.WithError(err).Log(controllerutils.LogLevel(err), "could not list ClusterDeployments")
+		return nil, err
+	}
+	sort.Slice(cdList.Items, func(i, j int) bool {
+		if cdList.Items[i].Namespace != cdList.Items[j].Namespace {
+			return cdList.Items[i].Namespace < cdList.Items[j].Namespace
+		}
+		return cdList.Items[i].Name < cdList.Items[j].Name
+	})
+
+	waveCDs := make([][]*hivev1.ClusterDeployment, len(rollout.Spec.Waves))
+	for i := range cdList.Items {
+		cd := &cdList.Items[i]
+		// Only installed clusters are upgraded.
+		if cd.DeletionTimestamp != nil || !cd.Spec.Installed {
+			continue
+		}
+		if owner, ok := cd.Annotations[constants.ClusterUpgradeRolloutAnnotation]; ok && owner != rollout.Name {
+			continue
+		}
+		for j, selector := range selectors {
+			if selector.Matches(labels.Set(cd.Labels)) {
+				waveCDs[j] = append(waveCDs[j], cd)
+				break
+			}
+		}
+	}
+	return waveCDs, nil
+}
+
+// releaseClusterDeployments removes the annotation naming the rollout from the ClusterDeployments it has upgraded, so
+// that other rollouts can upgrade them. The upgrade set on the ClusterDeployments is left as it is.
+func (r *ReconcileClusterUpgradeRollout) releaseClusterDeployments(rollout *hivev1.ClusterUpgradeRollout, logger log.FieldLogger) error {
+	cdList := &hivev1.ClusterDeploymentList{}
+	if err := r.List(context.TODO(), cdList); err != nil {
+		logger.WithError(err).Log(controllerutils.LogLevel(err), "could not list ClusterDeployments")
+		return err
+	}
+	for i := range cdList.Items {
+		cd := &cdList.Items[i]
+		if cd.Annotations[constants.ClusterUpgradeRolloutAnnotation] != rollout.Name {
+			continue
+		}
+		cdLog := logger.WithField("clusterDeployment", cd.Namespace+"/"+cd.Name)
+		cdLog.Info("releasing ClusterDeployment from rollout")
+		delete(cd.Annotations, constants.ClusterUpgradeRolloutAnnotation)
+		if err := r.Update(context.TODO(), cd); err != nil {
+			cdLog.WithError(err).Log(controllerutils.LogLevel(err), "could not release ClusterDeployment from rollout")
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ReconcileClusterUpgradeRollout) updateStatus(rollout *hivev1.ClusterUpgradeRollout, logger log.FieldLogger) error {
+	if err := r.Status().Update(context.TODO(), rollout); err != nil {
+		logger.WithError(err).Log(controllerutils.LogLevel(err), "could not update ClusterUpgradeRollout status")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,480 @@
+package clusterupgraderollout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/constants"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+	testcd "github.com/openshift/hive/pkg/test/clusterdeployment"
+	testfake "github.com/openshift/hive/pkg/test/fake"
+	"github.com/openshift/hive/pkg/util/scheme"
+)
+
+const (
+	testNamespace   = "test-namespace"
+	testRolloutName = "test-rollout"
+	testGeneration  = 2
+	testVersion     = "4.14.12"
+)
+
+func TestReconcileClusterUpgradeRollout(t *testing.T) {
+	scheme := scheme.GetScheme()
+
+	upgrade := hivev1.ClusterUpgrade{Channel: "stable-4.14", Version: testVersion}
+
+	buildRollout := func(status *hivev1.ClusterUpgradeRolloutStatus) *hivev1.ClusterUpgradeRollout {
+		rollout := &hivev1.ClusterUpgradeRollout{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       testRolloutName,
+				Generation: testGeneration,
+				Finalizers: []string{hivev1.FinalizerClusterUpgradeRollout},
+			},
+			Spec: hivev1.ClusterUpgradeRolloutSpec{
+				Upgrade: upgrade,
+				Waves: []hivev1.ClusterUpgradeWave{
+					{
+						Name:                      "canary",
+						ClusterDeploymentSelector: metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+						SoakTime:                  &metav1.Duration{Duration: time.Hour},
+					},
+					{
+						Name:                      "fleet",
+						ClusterDeploymentSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+						MaxConcurrent:             pointer.Int32(1),
+					},
+				},
+			},
+		}
+		if status != nil {
+			rollout.Status = *status
+		}
+		return rollout
+	}
+
+	rolloutStatus := func(wave int32, waveCompleted ...*time.Time) *hivev1.ClusterUpgradeRolloutStatus {
+		status := &hivev1.ClusterUpgradeRolloutStatus{
+			ObservedGeneration: testGeneration,
+			Phase:              hivev1.ProgressingClusterUpgradeRolloutPhase,
+			CurrentWave:        wave,
+		}
+		for i, completed := range waveCompleted {
+			waveStatus := hivev1.ClusterUpgradeWaveStatus{Name: []string{"canary", "fleet"}[i]}
+			if completed != nil {
+				waveStatus.CompletionTime = &metav1.Time{Time: *completed}
+			}
+			status.Waves = append(status.Waves, waveStatus)
+		}
+		return status
+	}
+
+	withUpgrade := func(owner string) testcd.Option {
+		return func(cd *hivev1.ClusterDeployment) {
+			if cd.Annotations == nil {
+				cd.Annotations = map[string]string{}
+			}
+			cd.Annotations[constants.ClusterUpgradeRolloutAnnotation] = owner
+			cd.Spec.Upgrade = upgrade.DeepCopy()
+		}
+	}
+	withUpgradePhase := func(phase hivev1.ClusterUpgradePhase) testcd.Option {
+		return func(cd *hivev1.ClusterDeployment) {
+			cd.Status.Upgrade = &hivev1.ClusterUpgradeStatus{Phase: phase, Version: testVersion}
+		}
+	}
+	cdBuilder := func(name string, canary bool) testcd.Builder {
+		b := testcd.FullBuilder(testNamespace, name, scheme).Options(
+			testcd.Installed(),
+			testcd.WithLabel("env", "prod"),
+		)
+		if canary {
+			b = b.Options(testcd.WithLabel("canary", "true"))
+		}
+		return b
+	}
+
+	recently := time.Now().Add(-time.Minute)
+	longAgo := time.Now().Add(-2 * time.Hour)
+
+	cases := []struct {
+		name               string
+		rollout            *hivev1.ClusterUpgradeRollout
+		existing           []runtime.Object
+		expectedStatus     *hivev1.ClusterUpgradeRolloutStatus
+		expectRequeue      bool
+		expectUpgraded     []string
+		expectReleased     []string
+		expectNotUpgraded  []string
+		expectWaveComplete bool
+	}{
+		{
+			name:    "start rollout",
+			rollout: buildRollout(nil),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(),
+				cdBuilder("fleet-1", false).Build(),
+			},
+			expectedStatus: &hivev1.ClusterUpgradeRolloutStatus{
+				Phase: hivev1.ProgressingClusterUpgradeRolloutPhase,
+				Waves: []hivev1.ClusterUpgradeWaveStatus{
+					{Name: "canary", TotalClusters: 1, UpgradingClusters: 1},
+				},
+			},
+			expectUpgraded:    []string{"canary"},
+			expectNotUpgraded: []string{"fleet-1"},
+		},
+		{
+			name: "start rollout of new generation",
+			rollout: buildRollout(&hivev1.ClusterUpgradeRolloutStatus{
+				ObservedGeneration: testGeneration - 1,
+				Phase:              hivev1.CompleteClusterUpgradeRolloutPhase,
+				CurrentWave:        1,
+			}),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(),
+				cdBuilder("fleet-1", false).Build(),
+			},
+			expectedStatus: &hivev1.ClusterUpgradeRolloutStatus{
+				Phase: hivev1.ProgressingClusterUpgradeRolloutPhase,
+				Waves: []hivev1.ClusterUpgradeWaveStatus{
+					{Name: "canary", TotalClusters: 1, UpgradingClusters: 1},
+				},
+			},
+			expectUpgraded:    []string{"canary"},
+			expectNotUpgraded: []string{"fleet-1"},
+		},
+		{
+			name:    "canary blocked",
+			rollout: buildRollout(rolloutStatus(0, nil)),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(withUpgrade(testRolloutName), withUpgradePhase(hivev1.BlockedClusterUpgradePhase)),
+				cdBuilder("fleet-1", false).Build(),
+			},
+			expectedStatus: &hivev1.ClusterUpgradeRolloutStatus{
+				Phase: hivev1.ProgressingClusterUpgradeRolloutPhase,
+				Waves: []hivev1.ClusterUpgradeWaveStatus{
+					{Name: "canary", TotalClusters: 1, UpgradingClusters: 1, BlockedClusters: 1},
+				},
+			},
+			expectUpgraded:    []string{"canary"},
+			expectNotUpgraded: []string{"fleet-1"},
+		},
+		{
+			name:    "canary completed, soaking",
+			rollout: buildRollout(rolloutStatus(0, &recently)),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(withUpgrade(testRolloutName), withUpgradePhase(hivev1.CompletedClusterUpgradePhase)),
+				cdBuilder("fleet-1", false).Build(),
+			},
+			expectedStatus: &hivev1.ClusterUpgradeRolloutStatus{
+				Phase: hivev1.ProgressingClusterUpgradeRolloutPhase,
+				Waves: []hivev1.ClusterUpgradeWaveStatus{
+					{Name: "canary", TotalClusters: 1, CompletedClusters: 1},
+				},
+			},
+			expectRequeue:      true,
+			expectUpgraded:     []string{"canary"},
+			expectNotUpgraded:  []string{"fleet-1"},
+			expectWaveComplete: true,
+		},
+		{
+			name:    "canary soaked, start next wave with max concurrent",
+			rollout: buildRollout(rolloutStatus(0, &longAgo)),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(withUpgrade(testRolloutName), withUpgradePhase(hivev1.CompletedClusterUpgradePhase)),
+				cdBuilder("fleet-1", false).Build(),
+				cdBuilder("fleet-2", false).Build(),
+			},
+			expectedStatus: &hivev1.ClusterUpgradeRolloutStatus{
+				Phase:       hivev1.ProgressingClusterUpgradeRolloutPhase,
+				CurrentWave: 1,
+				Waves: []hivev1.ClusterUpgradeWaveStatus{
+					{Name: "canary", TotalClusters: 1, CompletedClusters: 1},
+					{Name: "fleet", TotalClusters: 2, UpgradingClusters: 1},
+				},
+			},
+			expectUpgraded:    []string{"canary", "fleet-1"},
+			expectNotUpgraded: []string{"fleet-2"},
+		},
+		{
+			name:    "next cluster upgraded once previous completes",
+			rollout: buildRollout(rolloutStatus(1, &longAgo, nil)),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(withUpgrade(testRolloutName), withUpgradePhase(hivev1.CompletedClusterUpgradePhase)),
+				cdBuilder("fleet-1", false).Build(withUpgrade(testRolloutName), withUpgradePhase(hivev1.CompletedClusterUpgradePhase)),
+				cdBuilder("fleet-2", false).Build(),
+			},
+			expectedStatus: &hivev1.ClusterUpgradeRolloutStatus{
+				Phase:       hivev1.ProgressingClusterUpgradeRolloutPhase,
+				CurrentWave: 1,
+				Waves: []hivev1.ClusterUpgradeWaveStatus{
+					{Name: "canary", TotalClusters: 1, CompletedClusters: 1},
+					{Name: "fleet", TotalClusters: 2, UpgradingClusters: 1, CompletedClusters: 1},
+				},
+			},
+			expectUpgraded: []string{"canary", "fleet-1", "fleet-2"},
+		},
+		{
+			name:    "rollout complete",
+			rollout: buildRollout(rolloutStatus(1, &longAgo, nil)),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(withUpgrade(testRolloutName), withUpgradePhase(hivev1.CompletedClusterUpgradePhase)),
+				cdBuilder("fleet-1", false).Build(withUpgrade(testRolloutName), withUpgradePhase(hivev1.CompletedClusterUpgradePhase)),
+			},
+			expectedStatus: &hivev1.ClusterUpgradeRolloutStatus{
+				Phase:       hivev1.CompleteClusterUpgradeRolloutPhase,
+				CurrentWave: 1,
+				Waves: []hivev1.ClusterUpgradeWaveStatus{
+					{Name: "canary", TotalClusters: 1, CompletedClusters: 1},
+					{Name: "fleet", TotalClusters: 1, CompletedClusters: 1},
+				},
+			},
+			expectReleased:     []string{"canary", "fleet-1"},
+			expectWaveComplete: true,
+		},
+		{
+			name: "completed rollout releases clusters",
+			rollout: buildRollout(&hivev1.ClusterUpgradeRolloutStatus{
+				ObservedGeneration: testGeneration,
+				Phase:              hivev1.CompleteClusterUpgradeRolloutPhase,
+				CurrentWave:        1,
+				Waves: []hivev1.ClusterUpgradeWaveStatus{
+					{Name: "canary", TotalClusters: 1, CompletedClusters: 1},
+					{Name: "fleet", TotalClusters: 1, CompletedClusters: 1},
+				},
+			}),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(withUpgrade(testRolloutName), withUpgradePhase(hivev1.CompletedClusterUpgradePhase)),
+				cdBuilder("fleet-1", false).Build(withUpgrade(testRolloutName), withUpgradePhase(hivev1.CompletedClusterUpgradePhase)),
+				cdBuilder("fleet-2", false).Build(),
+			},
+			expectedStatus: &hivev1.ClusterUpgradeRolloutStatus{
+				Phase:       hivev1.CompleteClusterUpgradeRolloutPhase,
+				CurrentWave: 1,
+				Waves: []hivev1.ClusterUpgradeWaveStatus{
+					{Name: "canary", TotalClusters: 1, CompletedClusters: 1},
+					{Name: "fleet", TotalClusters: 1, CompletedClusters: 1},
+				},
+			},
+			expectReleased:    []string{"canary", "fleet-1"},
+			expectNotUpgraded: []string{"fleet-2"},
+		},
+		{
+			name:    "empty wave is skipped",
+			rollout: buildRollout(nil),
+			existing: []runtime.Object{
+				cdBuilder("fleet-1", false).Build(),
+			},
+			expectedStatus: &hivev1.ClusterUpgradeRolloutStatus{
+				Phase:       hivev1.ProgressingClusterUpgradeRolloutPhase,
+				CurrentWave: 1,
+				Waves: []hivev1.ClusterUpgradeWaveStatus{
+					{Name: "canary"},
+					{Name: "fleet", TotalClusters: 1, UpgradingClusters: 1},
+				},
+			},
+			expectUpgraded: []string{"fleet-1"},
+		},
+		{
+			name:    "uninstalled and other rollouts' clusters ignored",
+			rollout: buildRollout(rolloutStatus(0, nil)),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(withUpgrade(testRolloutName), withUpgradePhase(hivev1.ProgressingClusterUpgradePhase)),
+				testcd.FullBuilder(testNamespace, "uninstalled", scheme).Build(testcd.WithLabel("canary", "true")),
+				cdBuilder("other", true).Build(testcd.WithAnnotation(constants.ClusterUpgradeRolloutAnnotation, "other-rollout")),
+			},
+			expectedStatus: &hivev1.ClusterUpgradeRolloutStatus{
+				Phase: hivev1.ProgressingClusterUpgradeRolloutPhase,
+				Waves: []hivev1.ClusterUpgradeWaveStatus{
+					{Name: "canary", TotalClusters: 1, UpgradingClusters: 1},
+				},
+			},
+			expectUpgraded:    []string{"canary"},
+			expectNotUpgraded: []string{"uninstalled", "other"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := log.New()
+			logger.SetLevel(log.DebugLevel)
+			c := testfake.NewFakeClientBuilder().WithRuntimeObjects(append(tc.existing, tc.rollout)...).Build()
+			r := &ReconcileClusterUpgradeRollout{
+				Client: c,
+				logger: logger,
+			}
+			result, err := r.Reconcile(context.TODO(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRolloutName},
+			})
+			require.NoError(t, err, "unexpected error from Reconcile")
+			if tc.expectRequeue {
+				assert.Positive(t, result.RequeueAfter, "expected requeue")
+			} else {
+				assert.Zero(t, result.RequeueAfter, "unexpected requeue")
+			}
+
+			rollout := &hivev1.ClusterUpgradeRollout{}
+			require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: testRolloutName}, rollout), "could not get ClusterUpgradeRollout")
+			status := rollout.Status
+			assert.Equal(t, rollout.Generation, status.ObservedGeneration, "unexpected observed generation")
+			assert.Equal(t, tc.expectedStatus.Phase, status.Phase, "unexpected phase")
+			assert.Equal(t, tc.expectedStatus.CurrentWave, status.CurrentWave, "unexpected current wave")
+			require.Len(t, status.Waves, len(tc.expectedStatus.Waves), "unexpected number of wave statuses")
+			for i, expected := range tc.expectedStatus.Waves {
+				actual := status.Waves[i]
+				assert.Equal(t, expected.Name, actual.Name, "unexpected wave name")
+				assert.Equal(t, expected.TotalClusters, actual.TotalClusters, "unexpected total clusters in wave %s", expected.Name)
+				assert.Equal(t, expected.UpgradingClusters, actual.UpgradingClusters, "unexpected upgrading clusters in wave %s", expected.Name)
+				assert.Equal(t, expected.BlockedClusters, actual.BlockedClusters, "unexpected blocked clusters in wave %s", expected.Name)
+				assert.Equal(t, expected.CompletedClusters, actual.CompletedClusters, "unexpected completed clusters in wave %s", expected.Name)
+			}
+			current := status.Waves[status.CurrentWave]
+			if tc.expectWaveComplete {
+				assert.NotNil(t, current.CompletionTime, "expected wave completion time")
+			} else {
+				assert.Nil(t, current.CompletionTime, "unexpected wave completion time")
+			}
+
+			for _, name := range tc.expectUpgraded {
+				cd := &hivev1.ClusterDeployment{}
+				require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: name}, cd), "could not get ClusterDeployment")
+				assert.Equal(t, &upgrade, cd.Spec.Upgrade, "unexpected upgrade on ClusterDeployment %s", name)
+				assert.Equal(t, testRolloutName, cd.Annotations[constants.ClusterUpgradeRolloutAnnotation], "unexpected rollout annotation on ClusterDeployment %s", name)
+			}
+			for _, name := range tc.expectReleased {
+				cd := &hivev1.ClusterDeployment{}
+				require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: name}, cd), "could not get ClusterDeployment")
+				assert.Equal(t, &upgrade, cd.Spec.Upgrade, "unexpected upgrade on ClusterDeployment %s", name)
+				assert.NotContains(t, cd.Annotations, constants.ClusterUpgradeRolloutAnnotation, "unexpected rollout annotation on ClusterDeployment %s", name)
+			}
+			for _, name := range tc.expectNotUpgraded {
+				cd := &hivev1.ClusterDeployment{}
+				require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: name}, cd), "could not get ClusterDeployment")
+				assert.Nil(t, cd.Spec.Upgrade, "unexpected upgrade on ClusterDeployment %s", name)
+			}
+		})
+	}
+}
+
+func TestReconcileClusterUpgradeRolloutFinalizer(t *testing.T) {
+	scheme := scheme.GetScheme()
+
+	upgrade := hivev1.ClusterUpgrade{Channel: "stable-4.14", Version: testVersion}
+
+	buildRollout := func(finalizers []string, deleted bool) *hivev1.ClusterUpgradeRollout {
+		rollout := &hivev1.ClusterUpgradeRollout{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       testRolloutName,
+				Generation: testGeneration,
+				Finalizers: finalizers,
+			},
+			Spec: hivev1.ClusterUpgradeRolloutSpec{
+				Upgrade: upgrade,
+				Waves: []hivev1.ClusterUpgradeWave{{
+					Name:                      "fleet",
+					ClusterDeploymentSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+				}},
+			},
+		}
+		if deleted {
+			now := metav1.Now()
+			rollout.DeletionTimestamp = &now
+		}
+		return rollout
+	}
+	cdBuilder := func(name, owner string) testcd.Builder {
+		b := testcd.FullBuilder(testNamespace, name, scheme).Options(
+			testcd.Installed(),
+			testcd.WithLabel("env", "prod"),
+		)
+		if owner != "" {
+			b = b.Options(
+				testcd.WithAnnotation(constants.ClusterUpgradeRolloutAnnotation, owner),
+				func(cd *hivev1.ClusterDeployment) { cd.Spec.Upgrade = upgrade.DeepCopy() },
+			)
+		}
+		return b
+	}
+
+	cases := []struct {
+		name             string
+		rollout          *hivev1.ClusterUpgradeRollout
+		existing         []runtime.Object
+		expectFinalizer  bool
+		expectDeleted    bool
+		expectOwnerByCD  map[string]string
+		expectUpgradeSet map[string]bool
+	}{
+		{
+			name:    "finalizer added",
+			rollout: buildRollout(nil, false),
+			existing: []runtime.Object{
+				cdBuilder("fleet-1", "").Build(),
+			},
+			expectFinalizer: true,
+			expectOwnerByCD: map[string]string{"fleet-1": ""},
+		},
+		{
+			name:    "deleted rollout releases clusters",
+			rollout: buildRollout([]string{hivev1.FinalizerClusterUpgradeRollout}, true),
+			existing: []runtime.Object{
+				cdBuilder("fleet-1", testRolloutName).Build(),
+				cdBuilder("fleet-2", "other-rollout").Build(),
+				cdBuilder("fleet-3", "").Build(),
+			},
+			expectDeleted: true,
+			expectOwnerByCD: map[string]string{
+				"fleet-1": "",
+				"fleet-2": "other-rollout",
+				"fleet-3": "",
+			},
+			expectUpgradeSet: map[string]bool{"fleet-1": true, "fleet-2": true},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := log.New()
+			logger.SetLevel(log.DebugLevel)
+			c := testfake.NewFakeClientBuilder().WithRuntimeObjects(append(tc.existing, tc.rollout)...).Build()
+			r := &ReconcileClusterUpgradeRollout{
+				Client: c,
+				logger: logger,
+			}
+			_, err := r.Reconcile(context.TODO(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testRolloutName},
+			})
+			require.NoError(t, err, "unexpected error from Reconcile")
+
+			rollout := &hivev1.ClusterUpgradeRollout{}
+			err = c.Get(context.TODO(), types.NamespacedName{Name: testRolloutName}, rollout)
+			if tc.expectDeleted {
+				assert.True(t, apierrors.IsNotFound(err), "expected ClusterUpgradeRollout to be deleted")
+			} else {
+				require.NoError(t, err, "could not get ClusterUpgradeRollout")
+				assert.Equal(t, tc.expectFinalizer, controllerutils.HasFinalizer(rollout, hivev1.FinalizerClusterUpgradeRollout), "unexpected finalizer")
+			}
+
+			for name, owner := range tc.expectOwnerByCD {
+				cd := &hivev1.ClusterDeployment{}
+				require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: name}, cd), "could not get ClusterDeployment")
+				assert.Equal(t, owner, cd.Annotations[constants.ClusterUpgradeRolloutAnnotation], "unexpected rollout annotation on ClusterDeployment %s", name)
+				if tc.expectUpgradeSet[name] {
+					assert.Equal(t, &upgrade, cd.Spec.Upgrade, "unexpected upgrade on ClusterDeployment %s", name)
+				} else {
+					assert.Nil(t, cd.Spec.Upgrade, "unexpected upgrade on ClusterDeployment %s", name)
+				}
+			}
+		})
+	}
+}
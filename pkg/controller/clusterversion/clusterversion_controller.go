@@ -92,7 +92,7 @@ type ReconcileClusterVersion struct {
 }
 
 // Reconcile reads that state of the cluster for a ClusterDeployment object and syncs the remote ClusterVersion status
-// if the remote cluster is available.
+// if the remote cluster is available, and drives the upgrade of the cluster requested by the ClusterDeployment.
 func (r *ReconcileClusterVersion) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	cdLog := controllerutils.BuildControllerLogger(ControllerName, "clusterDeployment", request.NamespacedName)
 	cdLog.Info("reconciling cluster deployment")
@@ -155,8 +155,13 @@ func (r *ReconcileClusterVersion) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{}, err
 	}
 
+	requeueAfter, err := r.reconcileUpgrade(cd, clusterVersion, remoteClient, cdLog)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	cdLog.Debug("reconcile complete")
-	return reconcile.Result{}, nil
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *ReconcileClusterVersion) updateClusterVersionLabels(cd *hivev1.ClusterDeployment, clusterVersion *openshiftapiv1.ClusterVersion, cdLog log.FieldLogger) error {
@@ -0,0 +1,299 @@
+package clusterversion
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+)
+
+const (
+	// upgradeCheckInterval is how often the progress of an upgrade, or the pre-flight checks of a blocked upgrade,
+	// are checked.
+	upgradeCheckInterval = 2 * time.Minute
+
+	// clusterVersionFailing is the ClusterVersion condition with which the cluster version operator reports that it
+	// is failing to apply the desired release.
+	clusterVersionFailing configv1.ClusterStatusConditionType = "Failing"
+)
+
+// reconcileUpgrade drives the upgrade requested in the spec of the ClusterDeployment and reflects its progress in the
+// status of the ClusterDeployment. It returns how long to wait before checking the upgrade again, or zero if there is
+// no need to check again until something changes.
+func (r *ReconcileClusterVersion) reconcileUpgrade(cd *hivev1.ClusterDeployment, clusterVersion *configv1.ClusterVersion, remoteClient client.Client, cdLog log.FieldLogger) (time.Duration, error) {
+	upgrade := cd.Spec.Upgrade
+	if upgrade == nil {
+		if cd.Status.Upgrade == nil {
+			return 0, nil
+		}
+		cdLog.Info("clearing upgrade status since the ClusterDeployment no longer requests an upgrade")
+		cd.Status.Upgrade = nil
+		return 0, r.updateUpgradeStatus(cd, cdLog)
+	}
+
+	origStatus := cd.Status.Upgrade.DeepCopy()
+	status := cd.Status.Upgrade
+	if status == nil || status.Version != upgrade.Version || status.Image != upgrade.Image {
+		status = &hivev1.ClusterUpgradeStatus{
+			Version: upgrade.Version,
+			Image:   upgrade.Image,
+		}
+		cd.Status.Upgrade = status
+	}
+	cdLog = cdLog.WithField("upgradeVersion", upgrade.Version).WithField("upgradeImage", upgrade.Image)
+
+	requeueAfter, err := progressUpgrade(upgrade, status, clusterVersion, remoteClient, time.Now(), cdLog)
+	if err != nil {
+		return 0, err
+	}
+
+	if !reflect.DeepEqual(origStatus, status) {
+		if err := r.updateUpgradeStatus(cd, cdLog); err != nil {
+			return 0, err
+		}
+	}
+	return requeueAfter, nil
+}
+
+// progressUpgrade starts the upgrade once the cluster passes the pre-flight checks within a maintenance window, and
+// updates the status of the upgrade from the ClusterVersion of the cluster.
+func progressUpgrade(upgrade *hivev1.ClusterUpgrade, status *hivev1.ClusterUpgradeStatus, clusterVersion *configv1.ClusterVersion, remoteClient client.Client, now time.Time, cdLog log.FieldLogger) (time.Duration, error) {
+	setPhase := func(phase hivev1.ClusterUpgradePhase, message string) {
+		if status.Phase != phase {
+			cdLog.WithField("phase", phase).Info(message)
+			status.LastTransitionTime = metav1.NewTime(now)
+		}
+		status.Phase = phase
+		status.Message = message
+	}
+
+	// The channel determines the updates available to the cluster, so it is set before the upgrade is considered.
+	if upgrade.Channel != "" && clusterVersion.Spec.Channel != upgrade.Channel {
+		cdLog.WithField("channel", upgrade.Channel).Info("setting the channel of the cluster")
+		patch := client.MergeFrom(clusterVersion.DeepCopy())
+		clusterVersion.Spec.Channel = upgrade.Channel
+		if err := remoteClient.Patch(context.TODO(), clusterVersion, patch); err != nil {
+			cdLog.WithError(err).Log(controllerutils.LogLevel(err), "could not set the channel of the cluster")
+			return 0, errors.Wrap(err, "could not set the channel of the cluster")
+		}
+	}
+
+	if upgrade.Version == "" && upgrade.Image == "" {
+		status.NextMaintenanceWindow = nil
+		setPhase(hivev1.CompletedClusterUpgradePhase, fmt.Sprintf("Cluster is on channel %s", clusterVersion.Spec.Channel))
+		return 0, nil
+	}
+
+	if completed := completedUpdate(upgrade, clusterVersion); completed != nil {
+		if status.CompletionTime == nil {
+			status.CompletionTime = completed.CompletionTime
+			if status.CompletionTime == nil {
+				status.CompletionTime = &metav1.Time{Time: now}
+			}
+		}
+		status.NextMaintenanceWindow = nil
+		setPhase(hivev1.CompletedClusterUpgradePhase, fmt.Sprintf("Cluster has been upgraded to %s", completed.Version))
+		return 0, nil
+	}
+	status.CompletionTime = nil
+
+	if updateRequested(upgrade, clusterVersion) {
+		if status.StartedTime == nil {
+			status.StartedTime = &metav1.Time{Time: now}
+		}
+		status.NextMaintenanceWindow = nil
+		if failing := controllerutils.FindClusterVersionCondition(clusterVersion, clusterVersionFailing); failing != nil && failing.Status == configv1.ConditionTrue {
+			setPhase(hivev1.FailingClusterUpgradePhase, fmt.Sprintf("Upgrade is failing: %s", failing.Message))
+		} else {
+			message := "Cluster is upgrading"
+			if progressing := controllerutils.FindClusterVersionCondition(clusterVersion, configv1.OperatorProgressing); progressing != nil && progressing.Message != "" {
+				message = progressing.Message
+			}
+			setPhase(hivev1.ProgressingClusterUpgradePhase, message)
+		}
+		return upgradeCheckInterval, nil
+	}
+
+	open, next, err := maintenanceWindowOpen(upgrade.MaintenanceWindows, now)
+	if err != nil {
+		setPhase(hivev1.BlockedClusterUpgradePhase, fmt.Sprintf("Invalid maintenance window: %v", err))
+		return 0, nil
+	}
+	if !open {
+		status.NextMaintenanceWindow = &metav1.Time{Time: next}
+		setPhase(hivev1.PendingClusterUpgradePhase, fmt.Sprintf("Waiting for the maintenance window opening at %s", next.Format(time.RFC3339)))
+		return next.Sub(now), nil
+	}
+	status.NextMaintenanceWindow = nil
+
+	if !upgrade.Force {
+		blocked, err := preflightCheck(upgrade, clusterVersion, remoteClient)
+		if err != nil {
+			cdLog.WithError(err).Log(controllerutils.LogLevel(err), "could not run the pre-flight checks of the upgrade")
+			return 0, err
+		}
+		if blocked != "" {
+			setPhase(hivev1.BlockedClusterUpgradePhase, blocked)
+			return upgradeCheckInterval, nil
+		}
+	}
+
+	patch := client.MergeFrom(clusterVersion.DeepCopy())
+	clusterVersion.Spec.DesiredUpdate = &configv1.Update{
+		Version: upgrade.Version,
+		Image:   upgrade.Image,
+		Force:   upgrade.Force,
+	}
+	if err := remoteClient.Patch(context.TODO(), clusterVersion, patch); err != nil {
+		cdLog.WithError(err).Log(controllerutils.LogLevel(err), "could not set the desired update of the cluster")
+		return 0, errors.Wrap(err, "could not set the desired update of the cluster")
+	}
+	status.StartedTime = &metav1.Time{Time: now}
+	setPhase(hivev1.ProgressingClusterUpgradePhase, fmt.Sprintf("Started upgrade to %s", upgradeTarget(upgrade)))
+	return upgradeCheckInterval, nil
+}
+
+// preflightCheck returns why the cluster must not be upgraded yet, or an empty string if it may be.
+func preflightCheck(upgrade *hivev1.ClusterUpgrade, clusterVersion *configv1.ClusterVersion, remoteClient client.Client) (string, error) {
+	if progressing := controllerutils.FindClusterVersionCondition(clusterVersion, configv1.OperatorProgressing); progressing != nil && progressing.Status == configv1.ConditionTrue {
+		return fmt.Sprintf("Cluster is already updating: %s", progressing.Message), nil
+	}
+
+	// The cluster version operator only honors Upgradeable=False for upgrades to another minor version.
+	if upgradeable := controllerutils.FindClusterVersionCondition(clusterVersion, configv1.OperatorUpgradeable); upgradeable != nil && upgradeable.Status == configv1.ConditionFalse &&
+		!samePatchStream(clusterVersion.Status.Desired.Version, upgrade.Version) {
+		return fmt.Sprintf("Cluster is not upgradeable: %s", upgradeable.Message), nil
+	}
+
+	if upgrade.Image == "" {
+		available := false
+		for _, update := range clusterVersion.Status.AvailableUpdates {
+			if update.Version == upgrade.Version {
+				available = true
+				break
+			}
+		}
+		if !available {
+			return fmt.Sprintf("Version %s is not an available update of the cluster in channel %s", upgrade.Version, clusterVersion.Spec.Channel), nil
+		}
+	}
+
+	return controllerutils.ClusterOperatorsNotAvailable(remoteClient)
+}
+
+// completedUpdate returns the entry of the ClusterVersion history recording the completed upgrade, or nil if the
+// cluster has not completed the upgrade.
+func completedUpdate(upgrade *hivev1.ClusterUpgrade, clusterVersion *configv1.ClusterVersion) *configv1.UpdateHistory {
+	if len(clusterVersion.Status.History) == 0 {
+		return nil
+	}
+	latest := &clusterVersion.Status.History[0]
+	if latest.State != configv1.CompletedUpdate || !releaseMatches(upgrade, latest.Version, latest.Image) {
+		return nil
+	}
+	return latest
+}
+
+// updateRequested returns whether the cluster has been asked to upgrade to the target of the upgrade.
+func updateRequested(upgrade *hivev1.ClusterUpgrade, clusterVersion *configv1.ClusterVersion) bool {
+	if desired := clusterVersion.Spec.DesiredUpdate; desired != nil && releaseMatches(upgrade, desired.Version, desired.Image) {
+		return true
+	}
+	return releaseMatches(upgrade, clusterVersion.Status.Desired.Version, clusterVersion.Status.Desired.Image)
+}
+
+// releaseMatches returns whether the release with the given version and image is the target of the upgrade. Versions
+// are compared when the upgrade names one, as an image may be given by tag but is reported by digest.
+func releaseMatches(upgrade *hivev1.ClusterUpgrade, version, image string) bool {
+	if upgrade.Version != "" {
+		return version == upgrade.Version
+	}
+	return image == upgrade.Image
+}
+
+func upgradeTarget(upgrade *hivev1.ClusterUpgrade) string {
+	if upgrade.Version != "" {
+		return upgrade.Version
+	}
+	return upgrade.Image
+}
+
+// samePatchStream returns whether both versions have the same major and minor version. Unparsable versions are never
+// in the same patch stream.
+func samePatchStream(current, target string) bool {
+	currentVersion, err := semver.ParseTolerant(current)
+	if err != nil {
+		return false
+	}
+	targetVersion, err := semver.ParseTolerant(target)
+	if err != nil {
+		return false
+	}
+	return currentVersion.Major == targetVersion.Major && currentVersion.Minor == targetVersion.Minor
+}
+
+// maintenanceWindowOpen returns whether one of the maintenance windows is open at the given time and, if not, when the
+// next one opens. A window is always open if there are none.
+func maintenanceWindowOpen(windows []hivev1.MaintenanceWindow, now time.Time) (bool, time.Time, error) {
+	if len(windows) == 0 {
+		return true, time.Time{}, nil
+	}
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	var next time.Time
+	for _, window := range windows {
+		startTime, err := time.Parse("15:04", window.StartTime)
+		if err != nil {
+			return false, time.Time{}, errors.Wrapf(err, "could not parse start time %q", window.StartTime)
+		}
+		// A window that opened on one of the last days may still be open, and the next window opens within a week.
+		for day := -7; day <= 7; day++ {
+			start := today.AddDate(0, 0, day).Add(time.Duration(startTime.Hour())*time.Hour + time.Duration(startTime.Minute())*time.Minute)
+			if !windowOpensOn(window, start.Weekday()) {
+				continue
+			}
+			if !start.After(now) && now.Before(start.Add(window.Duration.Duration)) {
+				return true, time.Time{}, nil
+			}
+			if start.After(now) && (next.IsZero() || start.Before(next)) {
+				next = start
+			}
+		}
+	}
+	if next.IsZero() {
+		return false, time.Time{}, errors.New("no maintenance window opens within a week")
+	}
+	return false, next, nil
+}
+
+func windowOpensOn(window hivev1.MaintenanceWindow, weekday time.Weekday) bool {
+	if len(window.Days) == 0 {
+		return true
+	}
+	for _, day := range window.Days {
+		if string(day) == weekday.String() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *ReconcileClusterVersion) updateUpgradeStatus(cd *hivev1.ClusterDeployment, cdLog log.FieldLogger) error {
+	if err := r.Status().Update(context.TODO(), cd); err != nil {
+		cdLog.WithError(err).Log(controllerutils.LogLevel(err), "could not update the upgrade status of the ClusterDeployment")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,417 @@
+package clusterversion
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/remoteclient"
+	remoteclientmock "github.com/openshift/hive/pkg/remoteclient/mock"
+	testfake "github.com/openshift/hive/pkg/test/fake"
+	"github.com/openshift/hive/pkg/util/scheme"
+)
+
+const (
+	testCurrentVersion = "4.14.10"
+	testTargetVersion  = "4.14.12"
+	testChannel        = "stable-4.14"
+)
+
+func TestProgressUpgrade(t *testing.T) {
+	now := time.Date(2024, time.March, 6, 12, 0, 0, 0, time.UTC) // a Wednesday
+	earlier := metav1.NewTime(now.Add(-time.Hour))
+
+	tests := []struct {
+		name                string
+		upgrade             hivev1.ClusterUpgrade
+		status              hivev1.ClusterUpgradeStatus
+		clusterVersion      func(*configv1.ClusterVersion)
+		clusterOperators    []runtime.Object
+		expectPhase         hivev1.ClusterUpgradePhase
+		expectMessage       string
+		expectRequeue       time.Duration
+		expectDesiredUpdate *configv1.Update
+		expectChannel       string
+		expectStarted       bool
+		expectCompleted     bool
+	}{
+		{
+			name:                "starts upgrade",
+			upgrade:             hivev1.ClusterUpgrade{Version: testTargetVersion},
+			expectPhase:         hivev1.ProgressingClusterUpgradePhase,
+			expectRequeue:       upgradeCheckInterval,
+			expectDesiredUpdate: &configv1.Update{Version: testTargetVersion},
+			expectStarted:       true,
+		},
+		{
+			name:                "starts upgrade to image",
+			upgrade:             hivev1.ClusterUpgrade{Image: "quay.io/openshift-release-dev/ocp-release@sha256:abc", Force: true},
+			expectPhase:         hivev1.ProgressingClusterUpgradePhase,
+			expectRequeue:       upgradeCheckInterval,
+			expectDesiredUpdate: &configv1.Update{Image: "quay.io/openshift-release-dev/ocp-release@sha256:abc", Force: true},
+			expectStarted:       true,
+		},
+		{
+			name:          "sets channel",
+			upgrade:       hivev1.ClusterUpgrade{Channel: "fast-4.14"},
+			expectPhase:   hivev1.CompletedClusterUpgradePhase,
+			expectChannel: "fast-4.14",
+		},
+		{
+			name:    "version not available",
+			upgrade: hivev1.ClusterUpgrade{Version: "4.14.99"},
+			clusterVersion: func(cv *configv1.ClusterVersion) {
+				cv.Status.AvailableUpdates = nil
+			},
+			expectPhase:   hivev1.BlockedClusterUpgradePhase,
+			expectMessage: "Version 4.14.99 is not an available update of the cluster in channel stable-4.14",
+			expectRequeue: upgradeCheckInterval,
+		},
+		{
+			name:    "not upgradeable to next minor",
+			upgrade: hivev1.ClusterUpgrade{Version: "4.15.2"},
+			clusterVersion: func(cv *configv1.ClusterVersion) {
+				cv.Status.AvailableUpdates = append(cv.Status.AvailableUpdates, configv1.Release{Version: "4.15.2"})
+				setClusterVersionCondition(cv, configv1.ClusterOperatorStatusCondition{
+					Type: configv1.OperatorUpgradeable, Status: configv1.ConditionFalse, Message: "admin ack required",
+				})
+			},
+			expectPhase:   hivev1.BlockedClusterUpgradePhase,
+			expectMessage: "Cluster is not upgradeable: admin ack required",
+			expectRequeue: upgradeCheckInterval,
+		},
+		{
+			name:    "not upgradeable does not block patch upgrade",
+			upgrade: hivev1.ClusterUpgrade{Version: testTargetVersion},
+			clusterVersion: func(cv *configv1.ClusterVersion) {
+				setClusterVersionCondition(cv, configv1.ClusterOperatorStatusCondition{
+					Type: configv1.OperatorUpgradeable, Status: configv1.ConditionFalse, Message: "admin ack required",
+				})
+			},
+			expectPhase:         hivev1.ProgressingClusterUpgradePhase,
+			expectRequeue:       upgradeCheckInterval,
+			expectDesiredUpdate: &configv1.Update{Version: testTargetVersion},
+			expectStarted:       true,
+		},
+		{
+			name:             "degraded operator",
+			upgrade:          hivev1.ClusterUpgrade{Version: testTargetVersion},
+			clusterOperators: []runtime.Object{testClusterOperator("etcd", true, true)},
+			expectPhase:      hivev1.BlockedClusterUpgradePhase,
+			expectMessage:    "ClusterOperators not available or degraded: etcd",
+			expectRequeue:    upgradeCheckInterval,
+		},
+		{
+			name:                "force skips pre-flight checks",
+			upgrade:             hivev1.ClusterUpgrade{Version: testTargetVersion, Force: true},
+			clusterOperators:    []runtime.Object{testClusterOperator("etcd", false, false)},
+			expectPhase:         hivev1.ProgressingClusterUpgradePhase,
+			expectRequeue:       upgradeCheckInterval,
+			expectDesiredUpdate: &configv1.Update{Version: testTargetVersion, Force: true},
+			expectStarted:       true,
+		},
+		{
+			name:    "another update in progress",
+			upgrade: hivev1.ClusterUpgrade{Version: testTargetVersion},
+			clusterVersion: func(cv *configv1.ClusterVersion) {
+				setClusterVersionCondition(cv, configv1.ClusterOperatorStatusCondition{
+					Type: configv1.OperatorProgressing, Status: configv1.ConditionTrue, Message: "Working towards 4.14.11",
+				})
+			},
+			expectPhase:   hivev1.BlockedClusterUpgradePhase,
+			expectMessage: "Cluster is already updating: Working towards 4.14.11",
+			expectRequeue: upgradeCheckInterval,
+		},
+		{
+			name: "waits for maintenance window",
+			upgrade: hivev1.ClusterUpgrade{
+				Version: testTargetVersion,
+				MaintenanceWindows: []hivev1.MaintenanceWindow{{
+					Days:      []hivev1.Weekday{"Saturday"},
+					StartTime: "02:00",
+					Duration:  metav1.Duration{Duration: 4 * time.Hour},
+				}},
+			},
+			expectPhase:   hivev1.PendingClusterUpgradePhase,
+			expectMessage: "Waiting for the maintenance window opening at 2024-03-09T02:00:00Z",
+			expectRequeue: 2*24*time.Hour + 14*time.Hour,
+		},
+		{
+			name: "starts upgrade in maintenance window",
+			upgrade: hivev1.ClusterUpgrade{
+				Version: testTargetVersion,
+				MaintenanceWindows: []hivev1.MaintenanceWindow{{
+					StartTime: "10:00",
+					Duration:  metav1.Duration{Duration: 4 * time.Hour},
+				}},
+			},
+			expectPhase:         hivev1.ProgressingClusterUpgradePhase,
+			expectRequeue:       upgradeCheckInterval,
+			expectDesiredUpdate: &configv1.Update{Version: testTargetVersion},
+			expectStarted:       true,
+		},
+		{
+			name:    "upgrade in progress",
+			upgrade: hivev1.ClusterUpgrade{Version: testTargetVersion},
+			status:  hivev1.ClusterUpgradeStatus{Phase: hivev1.ProgressingClusterUpgradePhase, StartedTime: &earlier},
+			clusterVersion: func(cv *configv1.ClusterVersion) {
+				cv.Spec.DesiredUpdate = &configv1.Update{Version: testTargetVersion}
+				cv.Status.History = append([]configv1.UpdateHistory{{State: configv1.PartialUpdate, Version: testTargetVersion}}, cv.Status.History...)
+				setClusterVersionCondition(cv, configv1.ClusterOperatorStatusCondition{
+					Type: configv1.OperatorProgressing, Status: configv1.ConditionTrue, Message: "Working towards 4.14.12: 300 of 800 done",
+				})
+			},
+			expectPhase:         hivev1.ProgressingClusterUpgradePhase,
+			expectMessage:       "Working towards 4.14.12: 300 of 800 done",
+			expectRequeue:       upgradeCheckInterval,
+			expectDesiredUpdate: &configv1.Update{Version: testTargetVersion},
+			expectStarted:       true,
+		},
+		{
+			name:    "upgrade failing",
+			upgrade: hivev1.ClusterUpgrade{Version: testTargetVersion},
+			status:  hivev1.ClusterUpgradeStatus{Phase: hivev1.ProgressingClusterUpgradePhase, StartedTime: &earlier},
+			clusterVersion: func(cv *configv1.ClusterVersion) {
+				cv.Spec.DesiredUpdate = &configv1.Update{Version: testTargetVersion}
+				setClusterVersionCondition(cv, configv1.ClusterOperatorStatusCondition{
+					Type: clusterVersionFailing, Status: configv1.ConditionTrue, Message: "Cluster operator etcd is degraded",
+				})
+			},
+			expectPhase:         hivev1.FailingClusterUpgradePhase,
+			expectMessage:       "Upgrade is failing: Cluster operator etcd is degraded",
+			expectRequeue:       upgradeCheckInterval,
+			expectDesiredUpdate: &configv1.Update{Version: testTargetVersion},
+			expectStarted:       true,
+		},
+		{
+			name:    "upgrade completed",
+			upgrade: hivev1.ClusterUpgrade{Version: testTargetVersion},
+			status:  hivev1.ClusterUpgradeStatus{Phase: hivev1.ProgressingClusterUpgradePhase, StartedTime: &earlier},
+			clusterVersion: func(cv *configv1.ClusterVersion) {
+				cv.Spec.DesiredUpdate = &configv1.Update{Version: testTargetVersion}
+				cv.Status.History = append([]configv1.UpdateHistory{{State: configv1.CompletedUpdate, Version: testTargetVersion, CompletionTime: &earlier}}, cv.Status.History...)
+			},
+			expectPhase:         hivev1.CompletedClusterUpgradePhase,
+			expectMessage:       "Cluster has been upgraded to 4.14.12",
+			expectDesiredUpdate: &configv1.Update{Version: testTargetVersion},
+			expectStarted:       true,
+			expectCompleted:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cv := testUpgradeClusterVersion()
+			if test.clusterVersion != nil {
+				test.clusterVersion(cv)
+			}
+			clusterOperators := test.clusterOperators
+			if clusterOperators == nil {
+				clusterOperators = []runtime.Object{testClusterOperator("etcd", true, false)}
+			}
+			remoteClient := testfake.NewFakeClientBuilder().WithRuntimeObjects(append(clusterOperators, cv)...).Build()
+			status := test.status.DeepCopy()
+
+			requeueAfter, err := progressUpgrade(&test.upgrade, status, cv, remoteClient, now, log.WithField("test", test.name))
+			require.NoError(t, err, "unexpected error")
+
+			assert.Equal(t, test.expectPhase, status.Phase, "unexpected phase")
+			if test.expectMessage != "" {
+				assert.Equal(t, test.expectMessage, status.Message, "unexpected message")
+			}
+			assert.Equal(t, test.expectRequeue, requeueAfter, "unexpected requeue")
+			assert.Equal(t, test.expectStarted, status.StartedTime != nil, "unexpected started time")
+			assert.Equal(t, test.expectCompleted, status.CompletionTime != nil, "unexpected completion time")
+
+			remoteCV := &configv1.ClusterVersion{}
+			require.NoError(t, remoteClient.Get(context.TODO(), types.NamespacedName{Name: clusterVersionObjectName}, remoteCV))
+			assert.Equal(t, test.expectDesiredUpdate, remoteCV.Spec.DesiredUpdate, "unexpected desired update")
+			expectChannel := test.expectChannel
+			if expectChannel == "" {
+				expectChannel = testChannel
+			}
+			assert.Equal(t, expectChannel, string(remoteCV.Spec.Channel), "unexpected channel")
+		})
+	}
+}
+
+func TestMaintenanceWindowOpen(t *testing.T) {
+	now := time.Date(2024, time.March, 6, 12, 0, 0, 0, time.UTC) // a Wednesday
+	tests := []struct {
+		name       string
+		windows    []hivev1.MaintenanceWindow
+		expectOpen bool
+		expectNext time.Time
+		expectErr  bool
+	}{
+		{
+			name:       "no windows",
+			expectOpen: true,
+		},
+		{
+			name: "daily window open",
+			windows: []hivev1.MaintenanceWindow{{
+				StartTime: "11:30", Duration: metav1.Duration{Duration: time.Hour},
+			}},
+			expectOpen: true,
+		},
+		{
+			name: "daily window later today",
+			windows: []hivev1.MaintenanceWindow{{
+				StartTime: "22:00", Duration: metav1.Duration{Duration: time.Hour},
+			}},
+			expectNext: time.Date(2024, time.March, 6, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "daily window closed today",
+			windows: []hivev1.MaintenanceWindow{{
+				StartTime: "02:00", Duration: metav1.Duration{Duration: time.Hour},
+			}},
+			expectNext: time.Date(2024, time.March, 7, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "window opened yesterday still open",
+			windows: []hivev1.MaintenanceWindow{{
+				Days: []hivev1.Weekday{"Tuesday"}, StartTime: "20:00", Duration: metav1.Duration{Duration: 18 * time.Hour},
+			}},
+			expectOpen: true,
+		},
+		{
+			name: "earliest of several windows",
+			windows: []hivev1.MaintenanceWindow{
+				{Days: []hivev1.Weekday{"Sunday"}, StartTime: "00:00", Duration: metav1.Duration{Duration: time.Hour}},
+				{Days: []hivev1.Weekday{"Friday"}, StartTime: "23:00", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			expectNext: time.Date(2024, time.March, 8, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "window later today on a weekly day",
+			windows: []hivev1.MaintenanceWindow{{
+				Days: []hivev1.Weekday{"Wednesday"}, StartTime: "13:00", Duration: metav1.Duration{Duration: time.Hour},
+			}},
+			expectNext: time.Date(2024, time.March, 6, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "invalid start time",
+			windows: []hivev1.MaintenanceWindow{{
+				StartTime: "25:00", Duration: metav1.Duration{Duration: time.Hour},
+			}},
+			expectErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			open, next, err := maintenanceWindowOpen(test.windows, now)
+			if test.expectErr {
+				assert.Error(t, err, "expected error")
+				return
+			}
+			require.NoError(t, err, "unexpected error")
+			assert.Equal(t, test.expectOpen, open, "unexpected open")
+			assert.True(t, test.expectNext.Equal(next), "unexpected next window: %s", next)
+		})
+	}
+}
+
+func TestClusterVersionReconcileUpgrade(t *testing.T) {
+	cd := testClusterDeployment()
+	cd.Spec.Upgrade = &hivev1.ClusterUpgrade{Version: testTargetVersion}
+	fakeClient := testfake.NewFakeClientBuilder().WithRuntimeObjects(cd, testKubeconfigSecret()).Build()
+	remoteClient := testfake.NewFakeClientBuilder().WithRuntimeObjects(testUpgradeClusterVersion(), testClusterOperator("etcd", true, false)).Build()
+	mockCtrl := gomock.NewController(t)
+	mockRemoteClientBuilder := remoteclientmock.NewMockBuilder(mockCtrl)
+	mockRemoteClientBuilder.EXPECT().Build().Return(remoteClient, nil)
+	rcd := &ReconcileClusterVersion{
+		Client:                        fakeClient,
+		scheme:                        scheme.GetScheme(),
+		remoteClusterAPIClientBuilder: func(*hivev1.ClusterDeployment) remoteclient.Builder { return mockRemoteClientBuilder },
+	}
+
+	namespacedName := types.NamespacedName{Name: testName, Namespace: testNamespace}
+	result, err := rcd.Reconcile(context.TODO(), reconcile.Request{NamespacedName: namespacedName})
+	require.NoError(t, err, "unexpected error")
+	assert.Equal(t, upgradeCheckInterval, result.RequeueAfter, "unexpected requeue")
+
+	cd = &hivev1.ClusterDeployment{}
+	require.NoError(t, fakeClient.Get(context.TODO(), namespacedName, cd))
+	require.NotNil(t, cd.Status.Upgrade, "expected upgrade status")
+	assert.Equal(t, hivev1.ProgressingClusterUpgradePhase, cd.Status.Upgrade.Phase, "unexpected phase")
+	assert.Equal(t, testTargetVersion, cd.Status.Upgrade.Version, "unexpected version")
+	assert.Equal(t, testCurrentVersion, cd.Labels["hive.openshift.io/version"], "unexpected version label")
+
+	cv := &configv1.ClusterVersion{}
+	require.NoError(t, remoteClient.Get(context.TODO(), types.NamespacedName{Name: clusterVersionObjectName}, cv))
+	require.NotNil(t, cv.Spec.DesiredUpdate, "expected desired update")
+	assert.Equal(t, testTargetVersion, cv.Spec.DesiredUpdate.Version, "unexpected desired version")
+}
+
+func testUpgradeClusterVersion() *configv1.ClusterVersion {
+	completed := metav1.NewTime(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+	return &configv1.ClusterVersion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterVersionObjectName,
+		},
+		Spec: configv1.ClusterVersionSpec{
+			Channel: testChannel,
+		},
+		Status: configv1.ClusterVersionStatus{
+			Desired: configv1.Release{
+				Version: testCurrentVersion,
+			},
+			History: []configv1.UpdateHistory{{
+				State:          configv1.CompletedUpdate,
+				Version:        testCurrentVersion,
+				CompletionTime: &completed,
+			}},
+			AvailableUpdates: []configv1.Release{
+				{Version: "4.14.11"},
+				{Version: testTargetVersion},
+			},
+			Conditions: []configv1.ClusterOperatorStatusCondition{{
+				Type: configv1.OperatorProgressing, Status: configv1.ConditionFalse, Message: "Cluster version is 4.14.10",
+			}},
+		},
+	}
+}
+
+func setClusterVersionCondition(cv *configv1.ClusterVersion, condition configv1.ClusterOperatorStatusCondition) {
+	for i, cond := range cv.Status.Conditions {
+		if cond.Type == condition.Type {
+			cv.Status.Conditions[i] = condition
+			return
+		}
+	}
+	cv.Status.Conditions = append(cv.Status.Conditions, condition)
+}
+
+func testClusterOperator(name string, available, degraded bool) *configv1.ClusterOperator {
+	conditionStatus := func(b bool) configv1.ConditionStatus {
+		if b {
+			return configv1.ConditionTrue
+		}
+		return configv1.ConditionFalse
+	}
+	return &configv1.ClusterOperator{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: configv1.ClusterOperatorStatus{
+			Conditions: []configv1.ClusterOperatorStatusCondition{
+				{Type: configv1.OperatorAvailable, Status: conditionStatus(available)},
+				{Type: configv1.OperatorDegraded, Status: conditionStatus(degraded)},
+			},
+		},
+	}
+}
@@ -271,6 +271,10 @@ func (r *ReconcileDNSEndpoint) Reconcile(ctx context.Context, request reconcile.
 		nsTool.scraper.RemoveEndpoint(fullDomain)
 	}
 
+	if err := r.reconcileDSRecords(nsTool, rootDomain, instance, isDeleted, dnsLog); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	parentLinkCreated := false
 	if !isDeleted && len(desiredNameServers) > 0 {
 		parentLinkCreated = true
@@ -291,25 +295,120 @@ func (r *ReconcileDNSEndpoint) Reconcile(ctx context.Context, request reconcile.
 	return reconcile.Result{}, nil
 }
 
+// reconcileDSRecords publishes the DS records of a DNSSEC-signed DNSZone in the root domain's hosted zone, and
+// removes them when the DNSZone is no longer signed or has been deleted.
+func (r *ReconcileDNSEndpoint) reconcileDSRecords(nsTool nameServerTool, rootDomain string, dnsZone *hivev1.DNSZone, isDeleted bool, logger log.FieldLogger) error {
+	if dnsZone.Status.DNSSEC == nil && len(dnsZone.Status.PublishedDSRecords) == 0 {
+		return nil
+	}
+	fullDomain := dnsZone.Spec.Zone
+
+	desiredDSRecords := sets.NewString()
+	if !isDeleted && dnsZone.Status.DNSSEC != nil {
+		desiredDSRecords.Insert(dnsZone.Status.DNSSEC.DSRecords...)
+	}
+	publishedDSRecords := sets.NewString(dnsZone.Status.PublishedDSRecords...)
+
+	statusChanged := false
+	if !desiredDSRecords.Equal(publishedDSRecords) {
+		dsQuery, ok := nsTool.queryClient.(nameserver.DSQuery)
+		if !ok {
+			logger.Warn("publishing DS records is not supported for the root domain")
+			_, err := updateCondition(r.Client, logger, dnsZone, hivev1.DSRecordsPublishedCondition, corev1.ConditionFalse,
+				"DSRecordsNotSupported", "Publishing DS records is not supported for the parent domain")
+			return err
+		}
+		if len(desiredDSRecords) > 0 {
+			logger.Info("creating/updating DS records for subdomain")
+			if err := dsQuery.CreateOrUpdateDS(rootDomain, fullDomain, desiredDSRecords); err != nil {
+				logger.WithError(err).Error("error creating DS records")
+				return err
+			}
+			dnsZone.Status.PublishedDSRecords = desiredDSRecords.List()
+		} else {
+			logger.Info("deleting DS records for subdomain")
+			if err := dsQuery.DeleteDS(rootDomain, fullDomain); err != nil {
+				logger.WithError(err).Error("error deleting DS records")
+				return err
+			}
+			dnsZone.Status.PublishedDSRecords = nil
+		}
+		statusChanged = true
+	}
+
+	var conditionChanged bool
+	if len(desiredDSRecords) > 0 {
+		dnsZone.Status.Conditions, conditionChanged = controllerutils.SetDNSZoneConditionWithChangeCheck(
+			dnsZone.Status.Conditions,
+			hivev1.DSRecordsPublishedCondition,
+			corev1.ConditionTrue,
+			"DSRecordsPublished",
+			fmt.Sprintf("DS records published in parent domain %s", rootDomain),
+			controllerutils.UpdateConditionIfReasonOrMessageChange,
+		)
+	} else {
+		dnsZone.Status.Conditions, conditionChanged = controllerutils.SetDNSZoneConditionWithChangeCheck(
+			dnsZone.Status.Conditions,
+			hivev1.DSRecordsPublishedCondition,
+			corev1.ConditionFalse,
+			"DSRecordsNotPublished",
+			"No DS records are published in the parent domain",
+			controllerutils.UpdateConditionIfReasonOrMessageChange,
+		)
+	}
+	if !statusChanged && !conditionChanged {
+		return nil
+	}
+	if err := r.Status().Update(context.Background(), dnsZone); err != nil {
+		logger.WithError(err).Log(controllerutils.LogLevel(err), "could not update published DS records")
+		return err
+	}
+	return nil
+}
+
 func createNameServerQuery(c client.Client, logger log.FieldLogger, managedDomain hivev1.ManageDNSConfig) nameserver.Query {
 	if managedDomain.AWS != nil {
 		secretName := managedDomain.AWS.CredentialsSecretRef.Name
 		logger.Infof("using aws creds for managed domains stored in %q secret", secretName)
+		if role := managedDomain.AWS.CredentialsAssumeRole; role != nil && role.RoleARN != "" {
+			logger.Infof("assuming role %q for managed domains", role.RoleARN)
+		}
 		region := managedDomain.AWS.Region
 		if region == "" {
 			region = constants.AWSRoute53Region
 		}
-		return nameserver.NewAWSQuery(c, secretName, region)
+		return nameserver.NewAWSQuery(c, secretName, region, managedDomain.AWS.CredentialsAssumeRole)
 	}
 	if managedDomain.GCP != nil {
 		secretName := managedDomain.GCP.CredentialsSecretRef.Name
 		logger.Infof("using gcp creds for managed domain stored in %q secret", secretName)
-		return nameserver.NewGCPQuery(c, secretName)
+		if managedDomain.GCP.ProjectID != "" {
+			logger.Infof("using project %q for managed domain", managedDomain.GCP.ProjectID)
+		}
+		return nameserver.NewGCPQuery(c, secretName, managedDomain.GCP.ProjectID)
 	}
 	if managedDomain.Azure != nil {
 		secretName := managedDomain.Azure.CredentialsSecretRef.Name
 		logger.Infof("using azure creds for managed domain stored in %q secret", secretName)
-		return nameserver.NewAzureQuery(c, secretName, managedDomain.Azure.ResourceGroupName, managedDomain.Azure.CloudName.Name())
+		if managedDomain.Azure.SubscriptionID != "" {
+			logger.Infof("using subscription %q for managed domain", managedDomain.Azure.SubscriptionID)
+		}
+		return nameserver.NewAzureQuery(c, secretName, managedDomain.Azure.ResourceGroupName, managedDomain.Azure.CloudName.Name(), managedDomain.Azure.SubscriptionID)
+	}
+	if managedDomain.IBMCloud != nil {
+		secretName := managedDomain.IBMCloud.CredentialsSecretRef.Name
+		logger.Infof("using ibmcloud creds for managed domain stored in %q secret", secretName)
+		return nameserver.NewIBMCloudQuery(c, secretName)
+	}
+	if managedDomain.AlibabaCloud != nil {
+		secretName := managedDomain.AlibabaCloud.CredentialsSecretRef.Name
+		logger.Infof("using alibabacloud creds for managed domain stored in %q secret", secretName)
+		return nameserver.NewAlibabaCloudQuery(c, secretName, managedDomain.AlibabaCloud.Region)
+	}
+	if managedDomain.RFC2136 != nil {
+		secretName := managedDomain.RFC2136.TSIGSecretRef.Name
+		logger.Infof("using rfc2136 tsig key for managed domain stored in %q secret", secretName)
+		return nameserver.NewRFC2136Query(c, managedDomain.RFC2136.Server, managedDomain.RFC2136.TSIGKeyName, managedDomain.RFC2136.TSIGAlgorithm, secretName)
 	}
 	logger.Error("unsupported cloud for managing DNS")
 	return nil
@@ -33,6 +33,7 @@ import (
 
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
 	"github.com/openshift/hive/pkg/constants"
+	"github.com/openshift/hive/pkg/controller/dnsendpoint/nameserver"
 	"github.com/openshift/hive/pkg/controller/dnsendpoint/nameserver/mock"
 	controllerutils "github.com/openshift/hive/pkg/controller/utils"
 	testfake "github.com/openshift/hive/pkg/test/fake"
@@ -451,6 +452,125 @@ func TestDNSEndpointReconcile(t *testing.T) {
 	}
 }
 
+// dsQuery is a name server query that can also publish DS records
+type dsQuery struct {
+	*mock.MockQuery
+	*mock.MockDSQuery
+}
+
+func TestDNSEndpointReconcileDSRecords(t *testing.T) {
+
+	objectKey := client.ObjectKey{Namespace: testNamespace, Name: testName}
+
+	cases := []struct {
+		name                       string
+		dnsZone                    *hivev1.DNSZone
+		supportsDS                 bool
+		configureQuery             func(*mock.MockDSQuery)
+		expectedPublishedDSRecords []string
+		expectedConditions         []conditionExpectations
+	}{
+		{
+			name:       "publish DS records",
+			dnsZone:    testDNSZoneWithDSRecords([]string{"12345 13 2 ABCDEF"}, nil),
+			supportsDS: true,
+			configureQuery: func(mockQuery *mock.MockDSQuery) {
+				mockQuery.EXPECT().CreateOrUpdateDS(rootDomain, dnsName, sets.NewString("12345 13 2 ABCDEF")).Return(nil)
+			},
+			expectedPublishedDSRecords: []string{"12345 13 2 ABCDEF"},
+			expectedConditions: []conditionExpectations{
+				{
+					conditionType: hivev1.DSRecordsPublishedCondition,
+					status:        corev1.ConditionTrue,
+				},
+			},
+		},
+		{
+			name:                       "up-to-date DS records",
+			dnsZone:                    testDNSZoneWithDSRecords([]string{"12345 13 2 ABCDEF"}, []string{"12345 13 2 ABCDEF"}),
+			supportsDS:                 true,
+			expectedPublishedDSRecords: []string{"12345 13 2 ABCDEF"},
+		},
+		{
+			name:       "remove DS records",
+			dnsZone:    testDNSZoneWithDSRecords(nil, []string{"12345 13 2 ABCDEF"}),
+			supportsDS: true,
+			configureQuery: func(mockQuery *mock.MockDSQuery) {
+				mockQuery.EXPECT().DeleteDS(rootDomain, dnsName).Return(nil)
+			},
+		},
+		{
+			name: "remove DS records of unsigned zone",
+			dnsZone: func() *hivev1.DNSZone {
+				zone := testDNSZoneWithDSRecords(nil, []string{"12345 13 2 ABCDEF"})
+				zone.Status.DNSSEC = nil
+				return zone
+			}(),
+			supportsDS: true,
+			configureQuery: func(mockQuery *mock.MockDSQuery) {
+				mockQuery.EXPECT().DeleteDS(rootDomain, dnsName).Return(nil)
+			},
+		},
+		{
+			name:    "DS records not supported",
+			dnsZone: testDNSZoneWithDSRecords([]string{"12345 13 2 ABCDEF"}, nil),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			logger := log.WithField("controller", ControllerName)
+			fakeClient := testfake.NewFakeClientBuilder().WithRuntimeObjects(tc.dnsZone).Build()
+			mockQuery := mock.NewMockQuery(mockCtrl)
+			mockDSQuery := mock.NewMockDSQuery(mockCtrl)
+			if tc.configureQuery != nil {
+				tc.configureQuery(mockDSQuery)
+			}
+			var queryClient nameserver.Query = mockQuery
+			if tc.supportsDS {
+				queryClient = &dsQuery{MockQuery: mockQuery, MockDSQuery: mockDSQuery}
+			}
+			scraper := newNameServerScraper(logger, queryClient, []string{rootDomain}, nil)
+			scraper.rootDomainsMap = rootDomainsMap{
+				rootDomain: &rootDomainsInfo{
+					scraped: true,
+					endpointsBySubdomain: endpointsBySubdomain{
+						dnsName: endpointState{
+							dnsZone:  tc.dnsZone,
+							nsValues: sets.NewString(tc.dnsZone.Status.NameServers...),
+						},
+					},
+				},
+			}
+
+			cut := &ReconcileDNSEndpoint{
+				Client: fakeClient,
+				scheme: scheme.GetScheme(),
+				logger: logger,
+				nameServerTools: []nameServerTool{
+					{
+						scraper:     scraper,
+						queryClient: queryClient,
+					},
+				},
+			}
+			_, err := cut.Reconcile(context.TODO(), reconcile.Request{NamespacedName: objectKey})
+			assert.NoError(t, err, "expected no error from reconcile")
+
+			dnsZone := &hivev1.DNSZone{}
+			err = fakeClient.Get(context.Background(), objectKey, dnsZone)
+			require.NoError(t, err, "unexpected error getting DNSZone")
+			assert.Equal(t, tc.dnsZone.Status.DNSSEC, dnsZone.Status.DNSSEC, "DNSSEC status should not be changed")
+			assert.Equal(t, tc.expectedPublishedDSRecords, dnsZone.Status.PublishedDSRecords, "unexpected published DS records")
+			validateConditions(t, dnsZone, tc.expectedConditions)
+			if !tc.supportsDS {
+				condition := controllerutils.FindCondition(dnsZone.Status.Conditions, hivev1.DSRecordsPublishedCondition)
+				assert.Nil(t, condition, "DS records published condition should not be set")
+			}
+		})
+	}
+}
+
 func assertRootDomainsMapEqual(t *testing.T, expected rootDomainsMap, actual rootDomainsMap) {
 	require.Equal(t, len(expected), len(actual), "unexpected number of root domain map keys")
 	for rootDomainKey, expectedDomainMap := range expected {
@@ -744,6 +864,16 @@ func testDNSZone() *hivev1.DNSZone {
 	}
 }
 
+func testDNSZoneWithDSRecords(dsRecords, publishedDSRecords []string) *hivev1.DNSZone {
+	e := testDNSZone()
+	e.Status.DNSSEC = &hivev1.DNSSECStatus{
+		KeyState:  "ACTIVE",
+		DSRecords: dsRecords,
+	}
+	e.Status.PublishedDSRecords = publishedDSRecords
+	return e
+}
+
 func testDeletedDNSZone() *hivev1.DNSZone {
 	e := testDNSZone()
 	now := metav1.Now()
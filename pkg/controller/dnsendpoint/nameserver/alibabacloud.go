@@ -0,0 +1,149 @@
+package nameserver
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/pkg/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openshift/hive/pkg/alibabaclient"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+)
+
+const (
+	alibabaCloudNameServerTTL = 600
+
+	// alibabaCloudRecordsPageSize is the number of records requested per page, which is the maximum allowed
+	alibabaCloudRecordsPageSize = 500
+)
+
+// NewAlibabaCloudQuery creates a new name server query for Alibaba Cloud DNS.
+func NewAlibabaCloudQuery(c client.Client, credsSecretName, region string) Query {
+	return &alibabaCloudQuery{
+		getAlibabaClient: func() (alibabaclient.API, error) {
+			credsSecret := &corev1.Secret{}
+			if err := c.Get(
+				context.Background(),
+				client.ObjectKey{Namespace: controllerutils.GetHiveNamespace(), Name: credsSecretName},
+				credsSecret,
+			); err != nil {
+				return nil, errors.Wrap(err, "could not get the creds secret")
+			}
+			alibabaClient, err := alibabaclient.NewClientFromSecret(credsSecret, region)
+			return alibabaClient, errors.Wrap(err, "error creating Alibaba Cloud client")
+		},
+	}
+}
+
+type alibabaCloudQuery struct {
+	getAlibabaClient func() (alibabaclient.API, error)
+}
+
+var _ Query = (*alibabaCloudQuery)(nil)
+
+// Get implements Query.Get.
+func (q *alibabaCloudQuery) Get(domain string) (map[string]sets.String, error) {
+	alibabaClient, err := q.getAlibabaClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get Alibaba Cloud client")
+	}
+	currentNameServers, err := q.queryNameServers(alibabaClient, domain)
+	return currentNameServers, errors.Wrap(err, "error querying name servers")
+}
+
+// CreateOrUpdate implements Query.CreateOrUpdate.
+func (q *alibabaCloudQuery) CreateOrUpdate(rootDomain string, domain string, values sets.String) error {
+	alibabaClient, err := q.getAlibabaClient()
+	if err != nil {
+		return errors.Wrap(err, "failed to get Alibaba Cloud client")
+	}
+	return errors.Wrap(q.createNameServers(alibabaClient, rootDomain, domain, values), "error creating the name server")
+}
+
+// Delete implements Query.Delete.
+func (q *alibabaCloudQuery) Delete(rootDomain string, domain string, values sets.String) error {
+	alibabaClient, err := q.getAlibabaClient()
+	if err != nil {
+		return errors.Wrap(err, "failed to get Alibaba Cloud client")
+	}
+	return errors.Wrap(q.deleteNameServers(alibabaClient, rootDomain, domain), "error deleting the name servers")
+}
+
+// queryNameServers queries Alibaba Cloud for the name servers in the specified domain.
+func (q *alibabaCloudQuery) queryNameServers(alibabaClient alibabaclient.API, rootDomain string) (map[string]sets.String, error) {
+	nameServers := map[string]sets.String{}
+	total := 0
+	for page := 1; ; page++ {
+		request := alidns.CreateDescribeDomainRecordsRequest()
+		request.DomainName = rootDomain
+		request.Type = "NS"
+		request.PageNumber = requests.NewInteger(page)
+		request.PageSize = requests.NewInteger(alibabaCloudRecordsPageSize)
+		response, err := alibabaClient.DescribeDomainRecords(request)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range response.DomainRecords.Record {
+			name := q.getFQDN(record.RR, rootDomain)
+			values, ok := nameServers[name]
+			if !ok {
+				values = sets.NewString()
+				nameServers[name] = values
+			}
+			values.Insert(controllerutils.Undotted(record.Value))
+		}
+		total += len(response.DomainRecords.Record)
+		if len(response.DomainRecords.Record) == 0 || int64(total) >= response.TotalCount {
+			return nameServers, nil
+		}
+	}
+}
+
+// createNameServers replaces the name servers for the specified domain in the specified root domain.
+func (q *alibabaCloudQuery) createNameServers(alibabaClient alibabaclient.API, rootDomain string, domain string, values sets.String) error {
+	if err := q.deleteNameServers(alibabaClient, rootDomain, domain); err != nil {
+		return err
+	}
+	for _, value := range values.List() {
+		request := alidns.CreateAddDomainRecordRequest()
+		request.DomainName = rootDomain
+		request.RR = q.getRelativeDomain(rootDomain, domain)
+		request.Type = "NS"
+		request.Value = value
+		request.TTL = requests.NewInteger(alibabaCloudNameServerTTL)
+		if _, err := alibabaClient.AddDomainRecord(request); err != nil {
+			return errors.Wrapf(err, "could not create name server %s", value)
+		}
+	}
+	return nil
+}
+
+// deleteNameServers deletes the name servers for the specified domain in the specified root domain.
+func (q *alibabaCloudQuery) deleteNameServers(alibabaClient alibabaclient.API, rootDomain string, domain string) error {
+	request := alidns.CreateDeleteSubDomainRecordsRequest()
+	request.DomainName = rootDomain
+	request.RR = q.getRelativeDomain(rootDomain, domain)
+	request.Type = "NS"
+	_, err := alibabaClient.DeleteSubDomainRecords(request)
+	return err
+}
+
+func (q *alibabaCloudQuery) getRelativeDomain(rootDomain string, domain string) string {
+	if domain == rootDomain {
+		return "@"
+	}
+	return controllerutils.Undotted(strings.TrimSuffix(domain, rootDomain))
+}
+
+func (q *alibabaCloudQuery) getFQDN(relativeDomain string, rootDomain string) string {
+	if relativeDomain == "@" {
+		return rootDomain
+	}
+	return relativeDomain + "." + rootDomain
+}
@@ -0,0 +1,117 @@
+package nameserver
+
+import (
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/openshift/hive/pkg/alibabaclient"
+	"github.com/openshift/hive/pkg/alibabaclient/mock"
+)
+
+func TestAlibabaCloudGet(t *testing.T) {
+	cases := []struct {
+		name                string
+		records             []alidns.Record
+		expectedNameServers map[string]sets.String
+	}{
+		{
+			name:                "no records",
+			expectedNameServers: map[string]sets.String{},
+		},
+		{
+			name: "name servers for multiple domains",
+			records: []alidns.Record{
+				{RR: "@", Type: "NS", Value: "test-ns"},
+				{RR: "test-subdomain-1", Type: "NS", Value: "test-ns-1"},
+				{RR: "test-subdomain-1", Type: "NS", Value: "test-ns-2."},
+				{RR: "test-subdomain-2", Type: "NS", Value: "test-ns-3"},
+			},
+			expectedNameServers: map[string]sets.String{
+				"test-domain":                  sets.NewString("test-ns"),
+				"test-subdomain-1.test-domain": sets.NewString("test-ns-1", "test-ns-2"),
+				"test-subdomain-2.test-domain": sets.NewString("test-ns-3"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockAlibabaClient := mock.NewMockAPI(mockCtrl)
+			alibabaCloudQuery := &alibabaCloudQuery{
+				getAlibabaClient: func() (alibabaclient.API, error) {
+					return mockAlibabaClient, nil
+				},
+			}
+
+			response := &alidns.DescribeDomainRecordsResponse{TotalCount: int64(len(tc.records))}
+			response.DomainRecords.Record = tc.records
+			mockAlibabaClient.EXPECT().DescribeDomainRecords(gomock.Any()).
+				DoAndReturn(func(request *alidns.DescribeDomainRecordsRequest) (*alidns.DescribeDomainRecordsResponse, error) {
+					assert.Equal(t, "test-domain", request.DomainName, "unexpected domain name")
+					assert.Equal(t, "NS", request.Type, "unexpected record type")
+					return response, nil
+				})
+
+			actualNameservers, err := alibabaCloudQuery.Get("test-domain")
+			assert.NoError(t, err, "expected no error from querying")
+			assert.Equal(t, tc.expectedNameServers, actualNameservers, "unexpected name servers")
+		})
+	}
+}
+
+func TestAlibabaCloudCreateOrUpdate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockAlibabaClient := mock.NewMockAPI(mockCtrl)
+	alibabaCloudQuery := &alibabaCloudQuery{
+		getAlibabaClient: func() (alibabaclient.API, error) {
+			return mockAlibabaClient, nil
+		},
+	}
+
+	mockAlibabaClient.EXPECT().DeleteSubDomainRecords(gomock.Any()).
+		DoAndReturn(func(request *alidns.DeleteSubDomainRecordsRequest) (*alidns.DeleteSubDomainRecordsResponse, error) {
+			assert.Equal(t, "test-domain", request.DomainName, "unexpected domain name")
+			assert.Equal(t, "test-subdomain", request.RR, "unexpected host record")
+			assert.Equal(t, "NS", request.Type, "unexpected record type")
+			return &alidns.DeleteSubDomainRecordsResponse{}, nil
+		})
+	var values []string
+	mockAlibabaClient.EXPECT().AddDomainRecord(gomock.Any()).
+		DoAndReturn(func(request *alidns.AddDomainRecordRequest) (*alidns.AddDomainRecordResponse, error) {
+			assert.Equal(t, "test-domain", request.DomainName, "unexpected domain name")
+			assert.Equal(t, "test-subdomain", request.RR, "unexpected host record")
+			assert.Equal(t, "NS", request.Type, "unexpected record type")
+			values = append(values, request.Value)
+			return &alidns.AddDomainRecordResponse{}, nil
+		}).Times(2)
+
+	err := alibabaCloudQuery.CreateOrUpdate("test-domain", "test-subdomain.test-domain", sets.NewString("test-ns-2", "test-ns-1"))
+	assert.NoError(t, err, "expected no error from create")
+	assert.Equal(t, []string{"test-ns-1", "test-ns-2"}, values, "unexpected name servers created")
+}
+
+func TestAlibabaCloudDelete(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockAlibabaClient := mock.NewMockAPI(mockCtrl)
+	alibabaCloudQuery := &alibabaCloudQuery{
+		getAlibabaClient: func() (alibabaclient.API, error) {
+			return mockAlibabaClient, nil
+		},
+	}
+
+	mockAlibabaClient.EXPECT().DeleteSubDomainRecords(gomock.Any()).
+		DoAndReturn(func(request *alidns.DeleteSubDomainRecordsRequest) (*alidns.DeleteSubDomainRecordsResponse, error) {
+			assert.Equal(t, "test-subdomain", request.RR, "unexpected host record")
+			assert.Equal(t, "NS", request.Type, "unexpected record type")
+			return &alidns.DeleteSubDomainRecordsResponse{}, nil
+		})
+
+	err := alibabaCloudQuery.Delete("test-domain", "test-subdomain.test-domain", nil)
+	assert.NoError(t, err, "expected no error from delete")
+}
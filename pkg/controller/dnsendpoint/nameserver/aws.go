@@ -7,28 +7,60 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/pkg/errors"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	hivev1aws "github.com/openshift/hive/apis/hive/v1/aws"
 	awsclient "github.com/openshift/hive/pkg/awsclient"
 	controllerutils "github.com/openshift/hive/pkg/controller/utils"
 )
 
-// NewAWSQuery creates a new name server query for AWS.
-func NewAWSQuery(c client.Client, credsSecretName string, region string) Query {
+// NewAWSQuery creates a new name server query for AWS. When assumeRole is set, the role is assumed with the
+// credentials in the creds secret, and the hosted zones are queried in the account of the role.
+func NewAWSQuery(c client.Client, credsSecretName string, region string, assumeRole *hivev1aws.AssumeRole) Query {
 	return &awsQuery{
 		getAWSClient: func() (awsclient.Client, error) {
-			awsClient, err := awsclient.NewClient(c, credsSecretName, controllerutils.GetHiveNamespace(), region)
+			awsClient, err := awsclient.New(c, awsClientOptions(credsSecretName, region, assumeRole))
 			return awsClient, errors.Wrap(err, "error creating AWS client")
 		},
 	}
 }
 
+// awsClientOptions returns the options of the AWS client used to query the hosted zones. The creds secret is read
+// from the hive namespace.
+func awsClientOptions(credsSecretName string, region string, assumeRole *hivev1aws.AssumeRole) awsclient.Options {
+	if assumeRole != nil && assumeRole.RoleARN != "" {
+		return awsclient.Options{
+			Region: region,
+			CredentialsSource: awsclient.CredentialsSource{
+				AssumeRole: &awsclient.AssumeRoleCredentialsSource{
+					SecretRef: corev1.SecretReference{
+						Name:      credsSecretName,
+						Namespace: controllerutils.GetHiveNamespace(),
+					},
+					Role: assumeRole,
+				},
+			},
+		}
+	}
+	return awsclient.Options{
+		Region: region,
+		CredentialsSource: awsclient.CredentialsSource{
+			Secret: &awsclient.SecretCredentialsSource{
+				Ref:       &corev1.LocalObjectReference{Name: credsSecretName},
+				Namespace: controllerutils.GetHiveNamespace(),
+			},
+		},
+	}
+}
+
 type awsQuery struct {
 	getAWSClient func() (awsclient.Client, error)
 }
 
 var _ Query = (*awsQuery)(nil)
+var _ DSQuery = (*awsQuery)(nil)
 
 // Get implements Query.Get.
 func (q *awsQuery) Get(domain string) (map[string]sets.String, error) {
@@ -109,6 +141,52 @@ func (q *awsQuery) Delete(rootDomain string, domain string, values sets.String)
 	)
 }
 
+// CreateOrUpdateDS implements DSQuery.CreateOrUpdateDS.
+func (q *awsQuery) CreateOrUpdateDS(rootDomain string, domain string, values sets.String) error {
+	awsClient, err := q.getAWSClient()
+	if err != nil {
+		return errors.Wrap(err, "failed to get AWS client")
+	}
+	zoneID, err := q.queryZoneID(awsClient, rootDomain)
+	if err != nil {
+		return errors.Wrap(err, "error querying zone ID")
+	}
+	if zoneID == nil {
+		return errors.New("no public hosted zone found for domain")
+	}
+	return errors.Wrap(
+		q.changeRecords(awsClient, *zoneID, domain, route53.RRTypeDs, values, route53.ChangeActionUpsert),
+		"error creating the DS records",
+	)
+}
+
+// DeleteDS implements DSQuery.DeleteDS.
+func (q *awsQuery) DeleteDS(rootDomain string, domain string) error {
+	awsClient, err := q.getAWSClient()
+	if err != nil {
+		return errors.Wrap(err, "failed to get AWS client")
+	}
+	zoneID, err := q.queryZoneID(awsClient, rootDomain)
+	if err != nil {
+		return errors.Wrap(err, "error querying zone ID")
+	}
+	if zoneID == nil {
+		return nil
+	}
+	// A delete in route53 must match the current values of the record set exactly.
+	values, err := q.queryRecords(awsClient, *zoneID, domain, route53.RRTypeDs)
+	if err != nil {
+		return errors.Wrap(err, "error querying the current values of the DS records")
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return errors.Wrap(
+		q.changeRecords(awsClient, *zoneID, domain, route53.RRTypeDs, values, route53.ChangeActionDelete),
+		"error deleting the DS records",
+	)
+}
+
 // queryZoneID queries AWS for the public hosted zone for the specified domain.
 func (q *awsQuery) queryZoneID(awsClient awsclient.Client, domain string) (*string, error) {
 	maxItems := "5"
@@ -176,8 +254,13 @@ func (q *awsQuery) queryNameServers(awsClient awsclient.Client, hostedZoneID str
 
 // queryNameServer queries AWS for the name servers in the specified hosted zone for the specified domain.
 func (q *awsQuery) queryNameServer(awsClient awsclient.Client, hostedZoneID string, domain string) (sets.String, error) {
+	return q.queryRecords(awsClient, hostedZoneID, domain, route53.RRTypeNs)
+}
+
+// queryRecords queries AWS for the values of the records of the specified type in the specified hosted zone for
+// the specified domain.
+func (q *awsQuery) queryRecords(awsClient awsclient.Client, hostedZoneID string, domain string, recordType string) (sets.String, error) {
 	maxItems := "1"
-	recordType := route53.RRTypeNs
 	listOutput, err := awsClient.ListResourceRecordSets(&route53.ListResourceRecordSetsInput{
 		HostedZoneId:    &hostedZoneID,
 		MaxItems:        &maxItems,
@@ -197,7 +280,7 @@ func (q *awsQuery) queryNameServer(awsClient awsclient.Client, hostedZoneID stri
 	if controllerutils.Undotted(*recordSet.Name) != domain {
 		return nil, nil
 	}
-	if recordSet.Type == nil || *recordSet.Type != route53.RRTypeNs {
+	if recordSet.Type == nil || *recordSet.Type != recordType {
 		return nil, nil
 	}
 	values := sets.NewString()
@@ -209,7 +292,11 @@ func (q *awsQuery) queryNameServer(awsClient awsclient.Client, hostedZoneID stri
 
 // changeNameServers changes the name servers for the specified domain in the specified hosted zone.
 func (q *awsQuery) changeNameServers(awsClient awsclient.Client, hostedZoneID string, domain string, values sets.String, action string) error {
-	recordType := route53.RRTypeNs
+	return q.changeRecords(awsClient, hostedZoneID, domain, route53.RRTypeNs, values, action)
+}
+
+// changeRecords changes the records of the specified type for the specified domain in the specified hosted zone.
+func (q *awsQuery) changeRecords(awsClient awsclient.Client, hostedZoneID string, domain string, recordType string, values sets.String, action string) error {
 	ttl := int64(60)
 	records := make([]*route53.ResourceRecord, 0, len(values))
 	for v := range values {
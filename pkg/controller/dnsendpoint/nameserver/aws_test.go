@@ -7,11 +7,14 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/pointer"
 
+	hivev1aws "github.com/openshift/hive/apis/hive/v1/aws"
 	"github.com/openshift/hive/pkg/awsclient"
 	"github.com/openshift/hive/pkg/awsclient/mock"
+	"github.com/openshift/hive/pkg/constants"
 )
 
 func TestAWSGet(t *testing.T) {
@@ -217,6 +220,136 @@ func TestAWSGet(t *testing.T) {
 	}
 }
 
+func TestAWSCreateOrUpdateDS(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockAWSClient := mock.NewMockClient(mockCtrl)
+	awsQuery := &awsQuery{
+		getAWSClient: func() (awsclient.Client, error) {
+			return mockAWSClient, nil
+		},
+	}
+	mockAWSClient.EXPECT().ListHostedZonesByName(gomock.Any()).
+		Return(testListHostedZonesOutput(withHostedZones(testHostedZone("test-domain.", "test-zone-id"))), nil)
+	mockAWSClient.EXPECT().ChangeResourceRecordSets(gomock.Any()).
+		DoAndReturn(func(input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+			assert.Equal(t, "test-zone-id", *input.HostedZoneId, "unexpected hosted zone")
+			if assert.Len(t, input.ChangeBatch.Changes, 1, "expected a single change") {
+				change := input.ChangeBatch.Changes[0]
+				assert.Equal(t, route53.ChangeActionUpsert, *change.Action, "unexpected change action")
+				assert.Equal(t, "test-subdomain.test-domain", *change.ResourceRecordSet.Name, "unexpected record name")
+				assert.Equal(t, route53.RRTypeDs, *change.ResourceRecordSet.Type, "unexpected record type")
+				assert.Equal(t, "12345 13 2 ABCDEF", *change.ResourceRecordSet.ResourceRecords[0].Value, "unexpected record value")
+			}
+			return &route53.ChangeResourceRecordSetsOutput{}, nil
+		})
+
+	err := awsQuery.CreateOrUpdateDS("test-domain", "test-subdomain.test-domain", sets.NewString("12345 13 2 ABCDEF"))
+	assert.NoError(t, err, "expected no error from create")
+}
+
+func TestAWSDeleteDS(t *testing.T) {
+	cases := []struct {
+		name         string
+		recordSets   []*route53.ResourceRecordSet
+		expectDelete bool
+	}{
+		{
+			name:         "DS records exist",
+			recordSets:   []*route53.ResourceRecordSet{testRecordSet("test-subdomain.test-domain.", "DS", "12345 13 2 ABCDEF")},
+			expectDelete: true,
+		},
+		{
+			name:       "no DS records",
+			recordSets: []*route53.ResourceRecordSet{testRecordSet("test-subdomain.test-domain.", "NS", "test-ns")},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockAWSClient := mock.NewMockClient(mockCtrl)
+			awsQuery := &awsQuery{
+				getAWSClient: func() (awsclient.Client, error) {
+					return mockAWSClient, nil
+				},
+			}
+			mockAWSClient.EXPECT().ListHostedZonesByName(gomock.Any()).
+				Return(testListHostedZonesOutput(withHostedZones(testHostedZone("test-domain.", "test-zone-id"))), nil)
+			mockAWSClient.EXPECT().ListResourceRecordSets(gomock.Eq(&route53.ListResourceRecordSetsInput{
+				HostedZoneId:    pointer.String("test-zone-id"),
+				MaxItems:        pointer.String("1"),
+				StartRecordName: pointer.String("test-subdomain.test-domain"),
+				StartRecordType: pointer.String("DS"),
+			})).Return(testListResourceRecordSetsOutput(withRecordSets(tc.recordSets...)), nil)
+			if tc.expectDelete {
+				mockAWSClient.EXPECT().ChangeResourceRecordSets(gomock.Any()).
+					DoAndReturn(func(input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+						change := input.ChangeBatch.Changes[0]
+						assert.Equal(t, route53.ChangeActionDelete, *change.Action, "unexpected change action")
+						assert.Equal(t, route53.RRTypeDs, *change.ResourceRecordSet.Type, "unexpected record type")
+						return &route53.ChangeResourceRecordSetsOutput{}, nil
+					})
+			}
+
+			err := awsQuery.DeleteDS("test-domain", "test-subdomain.test-domain")
+			assert.NoError(t, err, "expected no error from delete")
+		})
+	}
+}
+
+func TestAWSClientOptions(t *testing.T) {
+	role := &hivev1aws.AssumeRole{RoleARN: "arn:aws:iam::123456789012:role/dns", ExternalID: "test-external-id"}
+	cases := []struct {
+		name       string
+		assumeRole *hivev1aws.AssumeRole
+		expected   awsclient.Options
+	}{
+		{
+			name: "creds secret",
+			expected: awsclient.Options{
+				Region: "us-east-1",
+				CredentialsSource: awsclient.CredentialsSource{
+					Secret: &awsclient.SecretCredentialsSource{
+						Ref:       &corev1.LocalObjectReference{Name: "test-creds"},
+						Namespace: constants.DefaultHiveNamespace,
+					},
+				},
+			},
+		},
+		{
+			name:       "assume role without role ARN",
+			assumeRole: &hivev1aws.AssumeRole{ExternalID: "test-external-id"},
+			expected: awsclient.Options{
+				Region: "us-east-1",
+				CredentialsSource: awsclient.CredentialsSource{
+					Secret: &awsclient.SecretCredentialsSource{
+						Ref:       &corev1.LocalObjectReference{Name: "test-creds"},
+						Namespace: constants.DefaultHiveNamespace,
+					},
+				},
+			},
+		},
+		{
+			name:       "assume role",
+			assumeRole: role,
+			expected: awsclient.Options{
+				Region: "us-east-1",
+				CredentialsSource: awsclient.CredentialsSource{
+					AssumeRole: &awsclient.AssumeRoleCredentialsSource{
+						SecretRef: corev1.SecretReference{Name: "test-creds", Namespace: constants.DefaultHiveNamespace},
+						Role:      role,
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			options := awsClientOptions("test-creds", "us-east-1", tc.assumeRole)
+			assert.Equal(t, tc.expected, options, "unexpected AWS client options")
+		})
+	}
+}
+
 type listHostedZonesOutputOption func(*route53.ListHostedZonesByNameOutput)
 
 func testListHostedZonesOutput(opts ...listHostedZonesOutputOption) *route53.ListHostedZonesByNameOutput {
@@ -25,8 +25,9 @@ func contextWithTimeout(ctx context.Context) (context.Context, context.CancelFun
 	return context.WithTimeout(ctx, defaultCallTimeout)
 }
 
-// NewAzureQuery creates a new name server query for Azure.
-func NewAzureQuery(c client.Client, credsSecretName, resourceGroupName, cloudName string) Query {
+// NewAzureQuery creates a new name server query for Azure. When subscriptionID is set, the DNS zones are queried in
+// that subscription rather than in the subscription of the credentials.
+func NewAzureQuery(c client.Client, credsSecretName, resourceGroupName, cloudName, subscriptionID string) Query {
 	return &azureQuery{
 		getAzureClient: func() (azureclient.Client, error) {
 			credsSecret := &corev1.Secret{}
@@ -37,7 +38,7 @@ func NewAzureQuery(c client.Client, credsSecretName, resourceGroupName, cloudNam
 			); err != nil {
 				return nil, errors.Wrap(err, "could not get the creds secret")
 			}
-			azureClient, err := azureclient.NewClientFromSecret(credsSecret, cloudName)
+			azureClient, err := azureclient.NewClientFromSecretForSubscription(credsSecret, cloudName, subscriptionID)
 			return azureClient, errors.Wrap(err, "error creating Azure client")
 		},
 		resourceGroupName: resourceGroupName,
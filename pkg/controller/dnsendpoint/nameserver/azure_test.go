@@ -8,10 +8,14 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/openshift/hive/pkg/azureclient"
 	"github.com/openshift/hive/pkg/azureclient/mock"
+	"github.com/openshift/hive/pkg/constants"
+	testfake "github.com/openshift/hive/pkg/test/fake"
 )
 
 type RecordSetPage struct {
@@ -98,6 +102,55 @@ func TestAzureGet(t *testing.T) {
 	}
 }
 
+func TestNewAzureQuery(t *testing.T) {
+	authJSON := []byte(`{
+		"clientId": "test-client-id",
+		"clientSecret": "test-client-secret",
+		"tenantId": "test-tenant-id",
+		"subscriptionId": "creds-subscription"
+	}`)
+	cases := []struct {
+		name            string
+		secretNamespace string
+		subscriptionID  string
+		expectErr       bool
+	}{
+		{
+			name:            "subscription of the creds",
+			secretNamespace: constants.DefaultHiveNamespace,
+		},
+		{
+			name:            "other subscription",
+			secretNamespace: constants.DefaultHiveNamespace,
+			subscriptionID:  "other-subscription",
+		},
+		{
+			name:            "creds secret not in hive namespace",
+			secretNamespace: "other-namespace",
+			subscriptionID:  "other-subscription",
+			expectErr:       true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tc.secretNamespace, Name: "test-creds"},
+				Data:       map[string][]byte{constants.AzureCredentialsName: authJSON},
+			}
+			c := testfake.NewFakeClientBuilder().WithRuntimeObjects(secret).Build()
+			query := NewAzureQuery(c, "test-creds", "test-resource-group", "", tc.subscriptionID).(*azureQuery)
+			azureClient, err := query.getAzureClient()
+			if tc.expectErr {
+				assert.Error(t, err, "expected error getting Azure client")
+				return
+			}
+			assert.NoError(t, err, "unexpected error getting Azure client")
+			assert.NotNil(t, azureClient, "expected an Azure client")
+			assert.Equal(t, "test-resource-group", query.resourceGroupName, "unexpected resource group")
+		})
+	}
+}
+
 func mockListRecordSets(mockCtrl *gomock.Controller, client *mock.MockClient, recordSetPage *RecordSetPage) {
 	page := mock.NewMockRecordSetPage(mockCtrl)
 	client.EXPECT().ListRecordSetsByZone(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(page, nil)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	dns "google.golang.org/api/dns/v1"
@@ -17,8 +18,9 @@ import (
 	gcpclient "github.com/openshift/hive/pkg/gcpclient"
 )
 
-// NewGCPQuery creates a new name server query for GCP.
-func NewGCPQuery(c client.Client, credsSecretName string) Query {
+// NewGCPQuery creates a new name server query for GCP. When projectID is set, the managed zones are queried in
+// that project rather than in the project of the credentials.
+func NewGCPQuery(c client.Client, credsSecretName string, projectID string) Query {
 	return &gcpQuery{
 		getGCPClient: func() (gcpclient.Client, error) {
 			credsSecret := &corev1.Secret{}
@@ -29,7 +31,7 @@ func NewGCPQuery(c client.Client, credsSecretName string) Query {
 			); err != nil {
 				return nil, errors.Wrap(err, "could not get the creds secret")
 			}
-			gcpClient, err := gcpclient.NewClientFromSecret(credsSecret)
+			gcpClient, err := gcpclient.NewClientFromSecretForProject(credsSecret, projectID)
 			return gcpClient, errors.Wrap(err, "error creating GCP client")
 		},
 	}
@@ -40,6 +42,7 @@ type gcpQuery struct {
 }
 
 var _ Query = (*gcpQuery)(nil)
+var _ DSQuery = (*gcpQuery)(nil)
 
 // Get implements Query.Get.
 func (q *gcpQuery) Get(domain string) (map[string]sets.String, error) {
@@ -120,6 +123,80 @@ func (q *gcpQuery) Delete(rootDomain string, domain string, values sets.String)
 	)
 }
 
+// CreateOrUpdateDS implements DSQuery.CreateOrUpdateDS.
+func (q *gcpQuery) CreateOrUpdateDS(rootDomain string, domain string, values sets.String) error {
+	gcpClient, err := q.getGCPClient()
+	if err != nil {
+		return errors.Wrap(err, "failed to get GCP client")
+	}
+	zoneName, err := q.queryZoneName(gcpClient, rootDomain)
+	if err != nil {
+		return errors.Wrap(err, "error querying zone name")
+	}
+	if zoneName == "" {
+		return errors.New("no public managed zone found for domain")
+	}
+	current, err := q.queryDSRecordSet(gcpClient, zoneName, domain)
+	if err != nil {
+		return errors.Wrap(err, "error querying the current DS records")
+	}
+	desired := &dns.ResourceRecordSet{
+		Name:    controllerutils.Dotted(domain),
+		Rrdatas: values.List(),
+		Ttl:     int64(DSRecordTTL / time.Second),
+		Type:    "DS",
+	}
+	if current == nil {
+		return errors.Wrap(gcpClient.AddResourceRecordSet(zoneName, desired), "error creating the DS records")
+	}
+	if sets.NewString(current.Rrdatas...).Equal(values) {
+		return nil
+	}
+	return errors.Wrap(gcpClient.UpdateResourceRecordSet(zoneName, desired, current), "error updating the DS records")
+}
+
+// DeleteDS implements DSQuery.DeleteDS.
+func (q *gcpQuery) DeleteDS(rootDomain string, domain string) error {
+	gcpClient, err := q.getGCPClient()
+	if err != nil {
+		return errors.Wrap(err, "failed to get GCP client")
+	}
+	zoneName, err := q.queryZoneName(gcpClient, rootDomain)
+	if err != nil {
+		return errors.Wrap(err, "error querying zone name")
+	}
+	if zoneName == "" {
+		return nil
+	}
+	current, err := q.queryDSRecordSet(gcpClient, zoneName, domain)
+	if err != nil {
+		return errors.Wrap(err, "error querying the current DS records")
+	}
+	if current == nil {
+		return nil
+	}
+	return errors.Wrap(gcpClient.DeleteResourceRecordSet(zoneName, current), "error deleting the DS records")
+}
+
+// queryDSRecordSet queries GCP for the DS record set for the specified domain in the specified managed zone.
+func (q *gcpQuery) queryDSRecordSet(gcpClient gcpclient.Client, managedZone string, domain string) (*dns.ResourceRecordSet, error) {
+	listOutput, err := gcpClient.ListResourceRecordSets(
+		managedZone,
+		gcpclient.ListResourceRecordSetsOptions{
+			MaxResults: 1,
+			Name:       controllerutils.Dotted(domain),
+			Type:       "DS",
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(listOutput.Rrsets) == 0 {
+		return nil, nil
+	}
+	return listOutput.Rrsets[0], nil
+}
+
 // queryZoneName queries GCP for the public managed zone for the specified domain.
 func (q *gcpQuery) queryZoneName(gcpClient gcpclient.Client, domain string) (string, error) {
 	listOpts := gcpclient.ListManagedZonesOptions{
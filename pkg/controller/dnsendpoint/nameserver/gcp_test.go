@@ -7,10 +7,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	dns "google.golang.org/api/dns/v1"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/openshift/hive/pkg/constants"
 	"github.com/openshift/hive/pkg/gcpclient"
 	"github.com/openshift/hive/pkg/gcpclient/mock"
+	testfake "github.com/openshift/hive/pkg/test/fake"
 )
 
 func TestGCPGet(t *testing.T) {
@@ -204,12 +208,136 @@ func TestGCPGet(t *testing.T) {
 	}
 }
 
+func TestGCPCreateOrUpdateDS(t *testing.T) {
+	cases := []struct {
+		name         string
+		current      []*dns.ResourceRecordSet
+		expectAdd    bool
+		expectUpdate bool
+	}{
+		{
+			name:      "no DS records",
+			expectAdd: true,
+		},
+		{
+			name:         "outdated DS records",
+			current:      []*dns.ResourceRecordSet{gcp.recordSet("test-subdomain.test-domain.", "DS", "11111 13 2 OLD")},
+			expectUpdate: true,
+		},
+		{
+			name:    "up-to-date DS records",
+			current: []*dns.ResourceRecordSet{gcp.recordSet("test-subdomain.test-domain.", "DS", "12345 13 2 ABCDEF")},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockGCPClient := mock.NewMockClient(mockCtrl)
+			gcpQuery := &gcpQuery{
+				getGCPClient: func() (gcpclient.Client, error) {
+					return mockGCPClient, nil
+				},
+			}
+			mockGCPClient.EXPECT().ListManagedZones(gomock.Any()).
+				Return(gcp.listManagedZonesResponse(gcp.withManagedZones(gcp.managedZone("test-domain.", "test-zone-name"))), nil)
+			mockGCPClient.EXPECT().ListResourceRecordSets("test-zone-name", gomock.Eq(gcpclient.ListResourceRecordSetsOptions{
+				MaxResults: 1,
+				Name:       "test-subdomain.test-domain.",
+				Type:       "DS",
+			})).Return(gcp.listResourceRecordSetsResponse(gcp.withRecordSets(tc.current...)), nil)
+			if tc.expectAdd {
+				mockGCPClient.EXPECT().AddResourceRecordSet("test-zone-name", gomock.Any()).
+					DoAndReturn(func(_ string, recordSet *dns.ResourceRecordSet) error {
+						assert.Equal(t, "DS", recordSet.Type, "unexpected record type")
+						assert.Equal(t, []string{"12345 13 2 ABCDEF"}, recordSet.Rrdatas, "unexpected record values")
+						return nil
+					})
+			}
+			if tc.expectUpdate {
+				mockGCPClient.EXPECT().UpdateResourceRecordSet("test-zone-name", gomock.Any(), tc.current[0]).Return(nil)
+			}
+
+			err := gcpQuery.CreateOrUpdateDS("test-domain", "test-subdomain.test-domain", sets.NewString("12345 13 2 ABCDEF"))
+			assert.NoError(t, err, "expected no error from create")
+		})
+	}
+}
+
+func TestGCPDeleteDS(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockGCPClient := mock.NewMockClient(mockCtrl)
+	gcpQuery := &gcpQuery{
+		getGCPClient: func() (gcpclient.Client, error) {
+			return mockGCPClient, nil
+		},
+	}
+	current := gcp.recordSet("test-subdomain.test-domain.", "DS", "12345 13 2 ABCDEF")
+	mockGCPClient.EXPECT().ListManagedZones(gomock.Any()).
+		Return(gcp.listManagedZonesResponse(gcp.withManagedZones(gcp.managedZone("test-domain.", "test-zone-name"))), nil)
+	mockGCPClient.EXPECT().ListResourceRecordSets("test-zone-name", gomock.Any()).
+		Return(gcp.listResourceRecordSetsResponse(gcp.withRecordSets(current)), nil)
+	mockGCPClient.EXPECT().DeleteResourceRecordSet("test-zone-name", current).Return(nil)
+
+	err := gcpQuery.DeleteDS("test-domain", "test-subdomain.test-domain")
+	assert.NoError(t, err, "expected no error from delete")
+}
+
 type gcpTestFuncs struct{}
 
 var gcp gcpTestFuncs
 
 type gcpListManagedZonesResponseOption func(*dns.ManagedZonesListResponse)
 
+func TestNewGCPQuery(t *testing.T) {
+	authJSON := []byte(`{
+		"type": "service_account",
+		"project_id": "creds-project",
+		"private_key_id": "test-key-id",
+		"private_key": "test-key",
+		"client_email": "test@creds-project.iam.gserviceaccount.com",
+		"token_uri": "https://oauth2.googleapis.com/token"
+	}`)
+	cases := []struct {
+		name            string
+		secretNamespace string
+		projectID       string
+		expectErr       bool
+	}{
+		{
+			name:            "project of the creds",
+			secretNamespace: constants.DefaultHiveNamespace,
+		},
+		{
+			name:            "other project",
+			secretNamespace: constants.DefaultHiveNamespace,
+			projectID:       "other-project",
+		},
+		{
+			name:            "creds secret not in hive namespace",
+			secretNamespace: "other-namespace",
+			projectID:       "other-project",
+			expectErr:       true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tc.secretNamespace, Name: "test-creds"},
+				Data:       map[string][]byte{constants.GCPCredentialsName: authJSON},
+			}
+			c := testfake.NewFakeClientBuilder().WithRuntimeObjects(secret).Build()
+			query := NewGCPQuery(c, "test-creds", tc.projectID).(*gcpQuery)
+			gcpClient, err := query.getGCPClient()
+			if tc.expectErr {
+				assert.Error(t, err, "expected error getting GCP client")
+				return
+			}
+			assert.NoError(t, err, "unexpected error getting GCP client")
+			assert.NotNil(t, gcpClient, "expected a GCP client")
+		})
+	}
+}
+
 func (*gcpTestFuncs) listManagedZonesResponse(opts ...gcpListManagedZonesResponseOption) *dns.ManagedZonesListResponse {
 	out := &dns.ManagedZonesListResponse{}
 	for _, o := range opts {
@@ -0,0 +1,182 @@
+package nameserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+	"github.com/openshift/hive/pkg/ibmclient"
+)
+
+const (
+	ibmCloudNameServerTTL = 60
+)
+
+// NewIBMCloudQuery creates a new name server query for IBM Cloud Internet Services.
+func NewIBMCloudQuery(c client.Client, credsSecretName string) Query {
+	return &ibmCloudQuery{
+		getIBMClient: func() (ibmclient.API, error) {
+			credsSecret := &corev1.Secret{}
+			if err := c.Get(
+				context.Background(),
+				client.ObjectKey{Namespace: controllerutils.GetHiveNamespace(), Name: credsSecretName},
+				credsSecret,
+			); err != nil {
+				return nil, errors.Wrap(err, "could not get the creds secret")
+			}
+			ibmClient, err := ibmclient.NewClientFromSecret(credsSecret)
+			if err != nil {
+				return nil, errors.Wrap(err, "error creating IBM Cloud client")
+			}
+			return ibmClient, nil
+		},
+	}
+}
+
+type ibmCloudQuery struct {
+	getIBMClient func() (ibmclient.API, error)
+}
+
+var _ Query = (*ibmCloudQuery)(nil)
+
+// Get implements Query.Get.
+func (q *ibmCloudQuery) Get(domain string) (map[string]sets.String, error) {
+	ibmClient, err := q.getIBMClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get IBM Cloud client")
+	}
+	currentNameServers, err := q.queryNameServers(ibmClient, domain)
+	return currentNameServers, errors.Wrap(err, "error querying name servers")
+}
+
+// CreateOrUpdate implements Query.CreateOrUpdate.
+func (q *ibmCloudQuery) CreateOrUpdate(rootDomain string, domain string, values sets.String) error {
+	ibmClient, err := q.getIBMClient()
+	if err != nil {
+		return errors.Wrap(err, "failed to get IBM Cloud client")
+	}
+	return errors.Wrap(q.createNameServers(ibmClient, rootDomain, domain, values), "error creating the name server")
+}
+
+// Delete implements Query.Delete.
+func (q *ibmCloudQuery) Delete(rootDomain string, domain string, values sets.String) error {
+	ibmClient, err := q.getIBMClient()
+	if err != nil {
+		return errors.Wrap(err, "failed to get IBM Cloud client")
+	}
+	return errors.Wrap(q.deleteNameServers(ibmClient, rootDomain, domain), "error deleting the name servers")
+}
+
+// getZone returns the CRN of the CIS instance hosting the specified zone along with the ID of the zone.
+func (q *ibmCloudQuery) getZone(ibmClient ibmclient.API, rootDomain string) (string, string, error) {
+	ctx, cancel := contextWithTimeout(context.TODO())
+	defer cancel()
+
+	zones, err := ibmClient.GetDNSZones(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	for _, z := range zones {
+		if z.Name == rootDomain {
+			return z.CISInstanceCRN, z.ID, nil
+		}
+	}
+	return "", "", fmt.Errorf("no zone found for %s", rootDomain)
+}
+
+// queryNameServers queries IBM Cloud for the name servers in the specified zone.
+func (q *ibmCloudQuery) queryNameServers(ibmClient ibmclient.API, rootDomain string) (map[string]sets.String, error) {
+	crn, zoneID, err := q.getZone(ibmClient, rootDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := contextWithTimeout(context.TODO())
+	defer cancel()
+
+	records, err := ibmClient.GetDNSRecords(ctx, crn, zoneID)
+	if err != nil {
+		return nil, err
+	}
+	nameServers := map[string]sets.String{}
+	for _, record := range records {
+		if record.Type == nil || *record.Type != "NS" || record.Name == nil || record.Content == nil {
+			continue
+		}
+		values, ok := nameServers[*record.Name]
+		if !ok {
+			values = sets.NewString()
+			nameServers[*record.Name] = values
+		}
+		values.Insert(controllerutils.Undotted(*record.Content))
+	}
+	return nameServers, nil
+}
+
+// createNameServers creates the name servers for the specified domain in the specified zone, removing any existing
+// name servers that are not in the specified values.
+func (q *ibmCloudQuery) createNameServers(ibmClient ibmclient.API, rootDomain string, domain string, values sets.String) error {
+	crn, zoneID, err := q.getZone(ibmClient, rootDomain)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := contextWithTimeout(context.TODO())
+	defer cancel()
+
+	records, err := ibmClient.GetDNSRecordsByName(ctx, crn, zoneID, domain)
+	if err != nil {
+		return err
+	}
+	existing := sets.NewString()
+	for _, record := range records {
+		if record.Type == nil || *record.Type != "NS" || record.ID == nil || record.Content == nil {
+			continue
+		}
+		value := controllerutils.Undotted(*record.Content)
+		if values.Has(value) {
+			existing.Insert(value)
+			continue
+		}
+		if err := ibmClient.DeleteDNSRecord(ctx, crn, zoneID, *record.ID); err != nil {
+			return errors.Wrapf(err, "could not delete stale name server %s", value)
+		}
+	}
+	for _, value := range values.Difference(existing).List() {
+		if err := ibmClient.CreateDNSRecord(ctx, crn, zoneID, "NS", domain, value, ibmCloudNameServerTTL); err != nil {
+			return errors.Wrapf(err, "could not create name server %s", value)
+		}
+	}
+	return nil
+}
+
+// deleteNameServers deletes the name servers for the specified domain in the specified zone.
+func (q *ibmCloudQuery) deleteNameServers(ibmClient ibmclient.API, rootDomain string, domain string) error {
+	crn, zoneID, err := q.getZone(ibmClient, rootDomain)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := contextWithTimeout(context.TODO())
+	defer cancel()
+
+	records, err := ibmClient.GetDNSRecordsByName(ctx, crn, zoneID, domain)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if record.Type == nil || *record.Type != "NS" || record.ID == nil {
+			continue
+		}
+		if err := ibmClient.DeleteDNSRecord(ctx, crn, zoneID, *record.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package nameserver
+
+import (
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/openshift/hive/pkg/ibmclient"
+	"github.com/openshift/hive/pkg/ibmclient/mock"
+)
+
+const (
+	testIBMCloudCRN = "test-crn"
+)
+
+func TestIBMCloudGet(t *testing.T) {
+	cases := []struct {
+		name                string
+		records             []dnsrecordsv1.DnsrecordDetails
+		expectedNameServers map[string]sets.String
+	}{
+		{
+			name:                "no records",
+			expectedNameServers: map[string]sets.String{},
+		},
+		{
+			name: "name servers for multiple domains",
+			records: []dnsrecordsv1.DnsrecordDetails{
+				ibmCloudRecord("1", "test-domain", "NS", "test-ns"),
+				ibmCloudRecord("2", "test-subdomain-1.test-domain", "NS", "test-ns-1"),
+				ibmCloudRecord("3", "test-subdomain-1.test-domain", "NS", "test-ns-2."),
+				ibmCloudRecord("4", "test-subdomain-2.test-domain", "A", "10.0.0.1"),
+			},
+			expectedNameServers: map[string]sets.String{
+				"test-domain":                  sets.NewString("test-ns"),
+				"test-subdomain-1.test-domain": sets.NewString("test-ns-1", "test-ns-2"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockIBMClient := mock.NewMockAPI(mockCtrl)
+			ibmCloudQuery := &ibmCloudQuery{
+				getIBMClient: func() (ibmclient.API, error) {
+					return mockIBMClient, nil
+				},
+			}
+
+			mockIBMCloudGetZone(mockIBMClient)
+			mockIBMClient.EXPECT().GetDNSRecords(gomock.Any(), testIBMCloudCRN, "test-zone-id").Return(tc.records, nil)
+
+			actualNameservers, err := ibmCloudQuery.Get("test-domain")
+			assert.NoError(t, err, "expected no error from querying")
+			assert.Equal(t, tc.expectedNameServers, actualNameservers, "unexpected name servers")
+		})
+	}
+}
+
+func TestIBMCloudGetZoneNotFound(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockIBMClient := mock.NewMockAPI(mockCtrl)
+	ibmCloudQuery := &ibmCloudQuery{
+		getIBMClient: func() (ibmclient.API, error) {
+			return mockIBMClient, nil
+		},
+	}
+
+	mockIBMClient.EXPECT().GetDNSZones(gomock.Any()).Return([]ibmclient.DNSZoneResponse{
+		{Name: "other-domain", ID: "other-zone-id", CISInstanceCRN: testIBMCloudCRN},
+	}, nil)
+
+	_, err := ibmCloudQuery.Get("test-domain")
+	assert.Error(t, err, "expected error for missing zone")
+}
+
+func TestIBMCloudCreateOrUpdate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockIBMClient := mock.NewMockAPI(mockCtrl)
+	ibmCloudQuery := &ibmCloudQuery{
+		getIBMClient: func() (ibmclient.API, error) {
+			return mockIBMClient, nil
+		},
+	}
+
+	mockIBMCloudGetZone(mockIBMClient)
+	mockIBMClient.EXPECT().GetDNSRecordsByName(gomock.Any(), testIBMCloudCRN, "test-zone-id", "test-subdomain.test-domain").
+		Return([]dnsrecordsv1.DnsrecordDetails{
+			ibmCloudRecord("1", "test-subdomain.test-domain", "NS", "test-ns-1"),
+			ibmCloudRecord("2", "test-subdomain.test-domain", "NS", "stale-ns"),
+		}, nil)
+	mockIBMClient.EXPECT().DeleteDNSRecord(gomock.Any(), testIBMCloudCRN, "test-zone-id", "2").Return(nil)
+	mockIBMClient.EXPECT().CreateDNSRecord(gomock.Any(), testIBMCloudCRN, "test-zone-id", "NS", "test-subdomain.test-domain", "test-ns-2", int64(ibmCloudNameServerTTL)).Return(nil)
+
+	err := ibmCloudQuery.CreateOrUpdate("test-domain", "test-subdomain.test-domain", sets.NewString("test-ns-1", "test-ns-2"))
+	assert.NoError(t, err, "expected no error from create")
+}
+
+func TestIBMCloudDelete(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockIBMClient := mock.NewMockAPI(mockCtrl)
+	ibmCloudQuery := &ibmCloudQuery{
+		getIBMClient: func() (ibmclient.API, error) {
+			return mockIBMClient, nil
+		},
+	}
+
+	mockIBMCloudGetZone(mockIBMClient)
+	mockIBMClient.EXPECT().GetDNSRecordsByName(gomock.Any(), testIBMCloudCRN, "test-zone-id", "test-subdomain.test-domain").
+		Return([]dnsrecordsv1.DnsrecordDetails{
+			ibmCloudRecord("1", "test-subdomain.test-domain", "NS", "test-ns-1"),
+			ibmCloudRecord("2", "test-subdomain.test-domain", "TXT", "owner"),
+		}, nil)
+	mockIBMClient.EXPECT().DeleteDNSRecord(gomock.Any(), testIBMCloudCRN, "test-zone-id", "1").Return(nil)
+
+	err := ibmCloudQuery.Delete("test-domain", "test-subdomain.test-domain", nil)
+	assert.NoError(t, err, "expected no error from delete")
+}
+
+func mockIBMCloudGetZone(mockIBMClient *mock.MockAPI) {
+	mockIBMClient.EXPECT().GetDNSZones(gomock.Any()).Return([]ibmclient.DNSZoneResponse{
+		{Name: "other-domain", ID: "other-zone-id", CISInstanceCRN: "other-crn"},
+		{Name: "test-domain", ID: "test-zone-id", CISInstanceCRN: testIBMCloudCRN},
+	}, nil)
+}
+
+func ibmCloudRecord(id, name, recordType, content string) dnsrecordsv1.DnsrecordDetails {
+	return dnsrecordsv1.DnsrecordDetails{
+		ID:      core.StringPtr(id),
+		Name:    core.StringPtr(name),
+		Type:    core.StringPtr(recordType),
+		Content: core.StringPtr(content),
+	}
+}
@@ -76,3 +76,54 @@ func (mr *MockQueryMockRecorder) Get(rootDomain interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockQuery)(nil).Get), rootDomain)
 }
+
+// MockDSQuery is a mock of DSQuery interface.
+type MockDSQuery struct {
+	ctrl     *gomock.Controller
+	recorder *MockDSQueryMockRecorder
+}
+
+// MockDSQueryMockRecorder is the mock recorder for MockDSQuery.
+type MockDSQueryMockRecorder struct {
+	mock *MockDSQuery
+}
+
+// NewMockDSQuery creates a new mock instance.
+func NewMockDSQuery(ctrl *gomock.Controller) *MockDSQuery {
+	mock := &MockDSQuery{ctrl: ctrl}
+	mock.recorder = &MockDSQueryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDSQuery) EXPECT() *MockDSQueryMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdateDS mocks base method.
+func (m *MockDSQuery) CreateOrUpdateDS(rootDomain, domain string, values sets.String) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateDS", rootDomain, domain, values)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdateDS indicates an expected call of CreateOrUpdateDS.
+func (mr *MockDSQueryMockRecorder) CreateOrUpdateDS(rootDomain, domain, values interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateDS", reflect.TypeOf((*MockDSQuery)(nil).CreateOrUpdateDS), rootDomain, domain, values)
+}
+
+// DeleteDS mocks base method.
+func (m *MockDSQuery) DeleteDS(rootDomain, domain string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDS", rootDomain, domain)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDS indicates an expected call of DeleteDS.
+func (mr *MockDSQueryMockRecorder) DeleteDS(rootDomain, domain interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDS", reflect.TypeOf((*MockDSQuery)(nil).DeleteDS), rootDomain, domain)
+}
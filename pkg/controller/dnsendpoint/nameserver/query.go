@@ -1,6 +1,8 @@
 package nameserver
 
 import (
+	"time"
+
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -25,3 +27,18 @@ type Query interface {
 	// deleted as well.
 	Delete(rootDomain string, domain string, values sets.String) error
 }
+
+// DSRecordTTL is the TTL of the DS records published in the root domain. Resolvers may keep validating a
+// subdomain against its DS records for this long after they are deleted.
+const DSRecordTTL = 60 * time.Second
+
+// DSQuery is implemented by name server queries that can also publish the DS records of DNSSEC-signed
+// subdomains in the root domain.
+type DSQuery interface {
+	// CreateOrUpdateDS creates or replaces the DS records for the specified subdomain under the
+	// specified root domain. The values are the DS records in presentation format.
+	CreateOrUpdateDS(rootDomain string, domain string, values sets.String) error
+
+	// DeleteDS deletes the DS records for the specified subdomain under the specified root domain.
+	DeleteDS(rootDomain string, domain string) error
+}
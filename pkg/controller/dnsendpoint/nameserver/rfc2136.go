@@ -0,0 +1,123 @@
+package nameserver
+
+import (
+	"context"
+
+	"github.com/miekg/dns"
+	"github.com/pkg/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+	"github.com/openshift/hive/pkg/rfc2136client"
+)
+
+const (
+	rfc2136NameServerTTL = 60
+)
+
+// NewRFC2136Query creates a new name server query for a DNS server managed with RFC 2136 dynamic updates.
+func NewRFC2136Query(c client.Client, server, keyName string, algorithm hivev1.TSIGAlgorithm, tsigSecretName string) Query {
+	return &rfc2136Query{
+		getRFC2136Client: func() (rfc2136client.Client, error) {
+			tsigSecret := &corev1.Secret{}
+			if err := c.Get(
+				context.Background(),
+				client.ObjectKey{Namespace: controllerutils.GetHiveNamespace(), Name: tsigSecretName},
+				tsigSecret,
+			); err != nil {
+				return nil, errors.Wrap(err, "could not get the TSIG secret")
+			}
+			rfc2136Client, err := rfc2136client.NewClientFromSecret(server, keyName, algorithm, tsigSecret)
+			return rfc2136Client, errors.Wrap(err, "error creating RFC 2136 client")
+		},
+	}
+}
+
+type rfc2136Query struct {
+	getRFC2136Client func() (rfc2136client.Client, error)
+}
+
+var _ Query = (*rfc2136Query)(nil)
+
+// Get implements Query.Get.
+func (q *rfc2136Query) Get(domain string) (map[string]sets.String, error) {
+	rfc2136Client, err := q.getRFC2136Client()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RFC 2136 client")
+	}
+	currentNameServers, err := q.queryNameServers(rfc2136Client, domain)
+	return currentNameServers, errors.Wrap(err, "error querying name servers")
+}
+
+// CreateOrUpdate implements Query.CreateOrUpdate.
+func (q *rfc2136Query) CreateOrUpdate(rootDomain string, domain string, values sets.String) error {
+	rfc2136Client, err := q.getRFC2136Client()
+	if err != nil {
+		return errors.Wrap(err, "failed to get RFC 2136 client")
+	}
+	// Replace the current name servers, if any, in the same update so that the domain is never left without them.
+	return errors.Wrap(
+		rfc2136Client.Update(controllerutils.Dotted(rootDomain), q.nameServerRRset(domain), q.nameServerRecords(domain, values)),
+		"error creating the name server",
+	)
+}
+
+// Delete implements Query.Delete.
+func (q *rfc2136Query) Delete(rootDomain string, domain string, values sets.String) error {
+	rfc2136Client, err := q.getRFC2136Client()
+	if err != nil {
+		return errors.Wrap(err, "failed to get RFC 2136 client")
+	}
+	// Removing an RRset that does not exist is not an error, so there is no need to know the current values.
+	return errors.Wrap(
+		rfc2136Client.Update(controllerutils.Dotted(rootDomain), q.nameServerRRset(domain), nil),
+		"error deleting the name servers",
+	)
+}
+
+// queryNameServers queries the DNS server for the name servers in the zone of the specified domain.
+func (q *rfc2136Query) queryNameServers(rfc2136Client rfc2136client.Client, rootDomain string) (map[string]sets.String, error) {
+	records, err := rfc2136Client.ListRecords(controllerutils.Dotted(rootDomain))
+	if err != nil {
+		return nil, err
+	}
+	nameServers := map[string]sets.String{}
+	for _, rr := range records {
+		ns, ok := rr.(*dns.NS)
+		if !ok {
+			continue
+		}
+		name := controllerutils.Undotted(dns.CanonicalName(ns.Hdr.Name))
+		if nameServers[name] == nil {
+			nameServers[name] = sets.NewString()
+		}
+		nameServers[name].Insert(controllerutils.Undotted(ns.Ns))
+	}
+	return nameServers, nil
+}
+
+// nameServerRRset returns a record that identifies the NS RRset of the specified domain for removal.
+func (q *rfc2136Query) nameServerRRset(domain string) []dns.RR {
+	return []dns.RR{&dns.NS{Hdr: dns.RR_Header{Name: controllerutils.Dotted(domain), Rrtype: dns.TypeNS, Class: dns.ClassINET}}}
+}
+
+// nameServerRecords returns the NS records for the specified domain with the specified name servers.
+func (q *rfc2136Query) nameServerRecords(domain string, values sets.String) []dns.RR {
+	records := make([]dns.RR, len(values))
+	for i, v := range values.List() {
+		records[i] = &dns.NS{
+			Hdr: dns.RR_Header{
+				Name:   controllerutils.Dotted(domain),
+				Rrtype: dns.TypeNS,
+				Class:  dns.ClassINET,
+				Ttl:    rfc2136NameServerTTL,
+			},
+			Ns: controllerutils.Dotted(v),
+		}
+	}
+	return records
+}
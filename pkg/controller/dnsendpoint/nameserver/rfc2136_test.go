@@ -0,0 +1,122 @@
+package nameserver
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/openshift/hive/pkg/rfc2136client"
+	"github.com/openshift/hive/pkg/rfc2136client/mock"
+)
+
+func TestRFC2136Get(t *testing.T) {
+	cases := []struct {
+		name                string
+		records             []string
+		expectedNameServers map[string]sets.String
+	}{
+		{
+			name: "no delegations",
+			records: []string{
+				"test-domain. 3600 IN SOA test-ns. admin.test-domain. 1 3600 600 86400 60",
+				"test-domain. 3600 IN NS test-ns.",
+			},
+			expectedNameServers: map[string]sets.String{
+				"test-domain": sets.NewString("test-ns"),
+			},
+		},
+		{
+			name: "name servers for multiple domains",
+			records: []string{
+				"test-domain. 3600 IN SOA test-ns. admin.test-domain. 1 3600 600 86400 60",
+				"test-domain. 3600 IN NS test-ns.",
+				"test-subdomain-1.test-domain. 60 IN NS test-ns-1.",
+				"test-subdomain-1.test-domain. 60 IN NS test-ns-2.",
+				"Test-Subdomain-2.test-domain. 60 IN NS test-ns-3.",
+				"test-subdomain-3.test-domain. 60 IN A 10.0.0.1",
+				"test-domain. 3600 IN SOA test-ns. admin.test-domain. 1 3600 600 86400 60",
+			},
+			expectedNameServers: map[string]sets.String{
+				"test-domain":                  sets.NewString("test-ns"),
+				"test-subdomain-1.test-domain": sets.NewString("test-ns-1", "test-ns-2"),
+				"test-subdomain-2.test-domain": sets.NewString("test-ns-3"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockRFC2136Client := mock.NewMockClient(mockCtrl)
+
+			rfc2136Query := &rfc2136Query{
+				getRFC2136Client: func() (rfc2136client.Client, error) {
+					return mockRFC2136Client, nil
+				},
+			}
+
+			var records []dns.RR
+			for _, r := range tc.records {
+				rr, err := dns.NewRR(r)
+				if !assert.NoError(t, err, "unexpected error parsing record") {
+					return
+				}
+				records = append(records, rr)
+			}
+			mockRFC2136Client.EXPECT().ListRecords("test-domain.").Return(records, nil)
+
+			actualNameservers, err := rfc2136Query.Get("test-domain")
+			assert.NoError(t, err, "expected no error from querying")
+			assert.Equal(t, tc.expectedNameServers, actualNameservers, "unexpected name servers")
+		})
+	}
+}
+
+func TestRFC2136CreateOrUpdate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockRFC2136Client := mock.NewMockClient(mockCtrl)
+
+	rfc2136Query := &rfc2136Query{
+		getRFC2136Client: func() (rfc2136client.Client, error) {
+			return mockRFC2136Client, nil
+		},
+	}
+
+	mockRFC2136Client.EXPECT().Update("test-domain.", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ string, remove []dns.RR, insert []dns.RR) error {
+			if assert.Len(t, remove, 1, "expected a single RRset to be removed") {
+				assert.Equal(t, "test-subdomain.test-domain.", remove[0].Header().Name, "unexpected name of removed RRset")
+				assert.Equal(t, dns.TypeNS, remove[0].Header().Rrtype, "unexpected type of removed RRset")
+			}
+			var values []string
+			for _, rr := range insert {
+				assert.Equal(t, "test-subdomain.test-domain.", rr.Header().Name, "unexpected name of inserted record")
+				values = append(values, rr.(*dns.NS).Ns)
+			}
+			assert.Equal(t, []string{"test-ns-1.", "test-ns-2."}, values, "unexpected inserted name servers")
+			return nil
+		})
+
+	err := rfc2136Query.CreateOrUpdate("test-domain", "test-subdomain.test-domain", sets.NewString("test-ns-2", "test-ns-1"))
+	assert.NoError(t, err, "expected no error from create")
+}
+
+func TestRFC2136Delete(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockRFC2136Client := mock.NewMockClient(mockCtrl)
+
+	rfc2136Query := &rfc2136Query{
+		getRFC2136Client: func() (rfc2136client.Client, error) {
+			return mockRFC2136Client, nil
+		},
+	}
+
+	mockRFC2136Client.EXPECT().Update("test-domain.", gomock.Len(1), gomock.Nil()).Return(nil)
+
+	err := rfc2136Query.Delete("test-domain", "test-subdomain.test-domain", nil)
+	assert.NoError(t, err, "expected no error from delete")
+}
@@ -1,5 +1,9 @@
 package dnszone
 
+import (
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+)
+
 // Actuator interface is the interface that is used to add dns provider support to the dnszone controller.
 type Actuator interface {
 	// Create tells the actuator to make a zone in the dns provider.
@@ -26,3 +30,27 @@ type Actuator interface {
 	// SetConditionsForError sets conditions on the dnszone given a specific error
 	SetConditionsForError(err error) bool
 }
+
+// DNSSECActuator is implemented by actuators whose dns provider supports DNSSEC signing of the zone.
+type DNSSECActuator interface {
+	// EnableDNSSEC tells the actuator to sign the zone in the dns provider. It returns the state of the
+	// key-signing key and, once the zone is signed, the DS records to publish in the parent domain.
+	EnableDNSSEC() (keyState string, dsRecords []string, err error)
+
+	// DisableDNSSEC tells the actuator to stop signing the zone and to remove its key-signing key.
+	DisableDNSSEC() error
+}
+
+// RecordActuator is implemented by actuators whose dns provider supports managing individual records in the zone.
+type RecordActuator interface {
+	// GetRecord returns the record with the specified fully qualified name and type from the zone in the dns
+	// provider, or nil if the zone has no such record.
+	GetRecord(name string, recordType hivev1.DNSRecordType) (*hivev1.DNSRecord, error)
+
+	// UpsertRecord creates the record in the zone, or replaces the values and TTL of the existing record with the
+	// same name and type. The name of the record is fully qualified.
+	UpsertRecord(record *hivev1.DNSRecord) error
+
+	// DeleteRecord removes the record, as returned by GetRecord, from the zone.
+	DeleteRecord(record *hivev1.DNSRecord) error
+}
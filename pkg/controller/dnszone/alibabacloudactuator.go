@@ -0,0 +1,233 @@
+package dnszone
+
+import (
+	"strings"
+
+	alibabaerrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/alibabaclient"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+)
+
+const (
+	// alibabaDomainNotFoundErrorCode is the error code returned by Alibaba Cloud DNS for a domain that does not exist
+	alibabaDomainNotFoundErrorCode = "InvalidDomainName.NoExist"
+
+	// alibabaDomainRecordsPageSize is the number of records requested per page, which is the maximum allowed
+	alibabaDomainRecordsPageSize = 500
+)
+
+// AlibabaCloudActuator attempts to make the current state reflect the given desired state.
+type AlibabaCloudActuator struct {
+	// logger is the logger used for this controller
+	logger log.FieldLogger
+
+	// alibabaClient is a utility for making it easy for controllers to interface with Alibaba Cloud
+	alibabaClient alibabaclient.API
+
+	// dnsZone is the DNSZone that represents the desired state.
+	dnsZone *hivev1.DNSZone
+
+	// nameServers are the name servers of the Alibaba Cloud DNS domain, or nil if the domain does not exist.
+	nameServers []string
+}
+
+type alibabaCloudClientBuilderType func(secret *corev1.Secret, regionID string) (alibabaclient.API, error)
+
+// NewAlibabaCloudActuator creates a new AlibabaCloudActuator object. A new AlibabaCloudActuator is expected to be created for each controller sync.
+func NewAlibabaCloudActuator(
+	logger log.FieldLogger,
+	secret *corev1.Secret,
+	dnsZone *hivev1.DNSZone,
+	alibabaCloudClientBuilder alibabaCloudClientBuilderType,
+) (*AlibabaCloudActuator, error) {
+	alibabaClient, err := alibabaCloudClientBuilder(secret, dnsZone.Spec.AlibabaCloud.Region)
+	if err != nil {
+		logger.WithError(err).Error("Error creating AlibabaCloudClient")
+		return nil, err
+	}
+
+	alibabaCloudActuator := &AlibabaCloudActuator{
+		logger:        logger,
+		alibabaClient: alibabaClient,
+		dnsZone:       dnsZone,
+	}
+
+	return alibabaCloudActuator, nil
+}
+
+// Ensure AlibabaCloudActuator implements the Actuator interface. This will fail at compile time when false.
+var _ Actuator = &AlibabaCloudActuator{}
+
+// Create implements the Create call of the actuator interface
+func (a *AlibabaCloudActuator) Create() error {
+	logger := a.logger.WithField("zone", a.dnsZone.Spec.Zone)
+	logger.Info("Creating domain")
+
+	request := alidns.CreateAddDomainRequest()
+	request.DomainName = a.dnsZone.Spec.Zone
+	response, err := a.alibabaClient.AddDomain(request)
+	if err != nil {
+		logger.WithError(err).Error("Error creating domain")
+		return err
+	}
+
+	logger.Debug("Domain successfully created")
+	a.nameServers = response.DnsServers.DnsServer
+	return nil
+}
+
+// Delete implements the Delete call of the actuator interface
+func (a *AlibabaCloudActuator) Delete() error {
+	if a.nameServers == nil {
+		return errors.New("domain is unpopulated")
+	}
+
+	logger := a.logger.WithField("zone", a.dnsZone.Spec.Zone)
+
+	logger.Info("Deleting records in domain")
+	if err := DeleteAlibabaCloudRecords(a.alibabaClient, a.dnsZone, logger); err != nil {
+		return err
+	}
+
+	logger.Info("Deleting domain")
+	request := alidns.CreateDeleteDomainRequest()
+	request.DomainName = a.dnsZone.Spec.Zone
+	_, err := a.alibabaClient.DeleteDomain(request)
+	if err != nil {
+		log.WithError(err).Error("Cannot delete domain")
+	}
+
+	return err
+}
+
+// DeleteAlibabaCloudRecords will remove all non-essential records from the DNSZone provided.
+func DeleteAlibabaCloudRecords(alibabaClient alibabaclient.API, dnsZone *hivev1.DNSZone, logger log.FieldLogger) error {
+	records, err := listAlibabaCloudRecords(alibabaClient, dnsZone.Spec.Zone)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		// Ignore the name server records of the domain itself
+		if record.RR == "@" && record.Type == "NS" {
+			continue
+		}
+		logger.WithField("name", record.RR).WithField("type", record.Type).Info("deleting record")
+		request := alidns.CreateDeleteDomainRecordRequest()
+		request.RecordId = record.RecordId
+		if _, err := alibabaClient.DeleteDomainRecord(request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listAlibabaCloudRecords returns all of the records of the specified domain.
+func listAlibabaCloudRecords(alibabaClient alibabaclient.API, domain string) ([]alidns.Record, error) {
+	var records []alidns.Record
+	for page := 1; ; page++ {
+		request := alidns.CreateDescribeDomainRecordsRequest()
+		request.DomainName = domain
+		request.PageNumber = requests.NewInteger(page)
+		request.PageSize = requests.NewInteger(alibabaDomainRecordsPageSize)
+		response, err := alibabaClient.DescribeDomainRecords(request)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, response.DomainRecords.Record...)
+		if len(response.DomainRecords.Record) == 0 || int64(len(records)) >= response.TotalCount {
+			return records, nil
+		}
+	}
+}
+
+// isAlibabaCloudDomainNotFound returns true if the error is returned by Alibaba Cloud DNS for a domain that does
+// not exist.
+func isAlibabaCloudDomainNotFound(err error) bool {
+	serverErr, ok := err.(*alibabaerrors.ServerError)
+	return ok && strings.EqualFold(serverErr.ErrorCode(), alibabaDomainNotFoundErrorCode)
+}
+
+// Exists implements the Exists call of the actuator interface
+func (a *AlibabaCloudActuator) Exists() (bool, error) {
+	return a.nameServers != nil, nil
+}
+
+// GetNameServers implements the GetNameServers call of the actuator interface
+func (a *AlibabaCloudActuator) GetNameServers() ([]string, error) {
+	if a.nameServers == nil {
+		return nil, errors.New("domain is unpopulated")
+	}
+
+	logger := a.logger.WithField("zone", a.dnsZone.Spec.Zone)
+	logger.WithField("nameservers", a.nameServers).Debug("found domain name servers")
+	return a.nameServers, nil
+}
+
+// Refresh implements the Refresh call of the actuator interface
+func (a *AlibabaCloudActuator) Refresh() error {
+	logger := a.logger.WithField("zone", a.dnsZone.Spec.Zone)
+	logger.Debug("Fetching domain by name")
+	request := alidns.CreateDescribeDomainInfoRequest()
+	request.DomainName = a.dnsZone.Spec.Zone
+	response, err := a.alibabaClient.DescribeDomainInfo(request)
+	if err != nil {
+		if isAlibabaCloudDomainNotFound(err) {
+			logger.Debug("Domain not found, clearing out the cached object")
+			a.nameServers = nil
+			return nil
+		}
+
+		logger.WithError(err).Error("Cannot get domain")
+		return err
+	}
+
+	logger.Debug("Found domain")
+	a.nameServers = response.DnsServers.DnsServer
+	if a.nameServers == nil {
+		a.nameServers = []string{}
+	}
+	return nil
+}
+
+// UpdateMetadata implements the UpdateMetadata call of the actuator interface
+func (a *AlibabaCloudActuator) UpdateMetadata() error {
+	return nil
+}
+
+// SetConditionsForError sets conditions on the dnszone given a specific error. Returns true if conditions changed.
+func (a *AlibabaCloudActuator) SetConditionsForError(err error) bool {
+	// other conditions not implemented for Alibaba Cloud yet, so set generic condition
+	var cloudErrorsConds []hivev1.DNSZoneCondition
+	var cloudErrorsCondsChanged bool
+	if err == nil {
+		cloudErrorsConds, cloudErrorsCondsChanged = controllerutils.SetDNSZoneConditionWithChangeCheck(
+			a.dnsZone.Status.Conditions,
+			hivev1.GenericDNSErrorsCondition,
+			corev1.ConditionFalse,
+			dnsNoErrorReason,
+			"No cloud errors occurred",
+			controllerutils.UpdateConditionIfReasonOrMessageChange,
+		)
+	} else {
+		cloudErrorsConds, cloudErrorsCondsChanged = controllerutils.SetDNSZoneConditionWithChangeCheck(
+			a.dnsZone.Status.Conditions,
+			hivev1.GenericDNSErrorsCondition,
+			corev1.ConditionTrue,
+			dnsCloudErrorReason,
+			controllerutils.ErrorScrub(err),
+			controllerutils.UpdateConditionIfReasonOrMessageChange,
+		)
+	}
+	if cloudErrorsCondsChanged {
+		a.dnsZone.Status.Conditions = cloudErrorsConds
+	}
+	return cloudErrorsCondsChanged
+}
@@ -0,0 +1,102 @@
+package dnszone
+
+import (
+	"net/http"
+	"testing"
+
+	alibabaerrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/golang/mock/gomock"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/alibabaclient/mock"
+)
+
+// TestNewAlibabaCloudActuator tests that a new AlibabaCloudActuator object can be created.
+func TestNewAlibabaCloudActuator(t *testing.T) {
+	cases := []struct {
+		name    string
+		dnsZone *hivev1.DNSZone
+		secret  *corev1.Secret
+	}{
+		{
+			name:    "Successfully create new zone",
+			dnsZone: validAlibabaCloudDNSZone(),
+			secret:  validAlibabaCloudSecret(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mocks := setupDefaultMocks(t)
+			expectedAlibabaCloudActuator := &AlibabaCloudActuator{
+				logger:  log.WithField("controller", ControllerName),
+				dnsZone: tc.dnsZone,
+			}
+
+			// Act
+			zr, err := NewAlibabaCloudActuator(
+				expectedAlibabaCloudActuator.logger,
+				tc.secret,
+				tc.dnsZone,
+				fakeAlibabaCloudClientBuilder(mocks.mockAlibabaCloudClient),
+			)
+			expectedAlibabaCloudActuator.alibabaClient = zr.alibabaClient // Function pointers can't be compared reliably. Don't compare.
+
+			// Assert
+			assert.Nil(t, err)
+			assert.NotNil(t, zr.alibabaClient)
+			assert.Equal(t, expectedAlibabaCloudActuator, zr)
+		})
+	}
+}
+
+// TestDeleteAlibabaCloudRecords tests that all records other than the NS records at the apex of the domain are deleted.
+func TestDeleteAlibabaCloudRecords(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	response := &alidns.DescribeDomainRecordsResponse{TotalCount: 4}
+	response.DomainRecords.Record = []alidns.Record{
+		{RecordId: "1", RR: "@", Type: "NS", Value: "ns1.example.com"},
+		{RecordId: "2", RR: "@", Type: "TXT", Value: "owner"},
+		{RecordId: "3", RR: "api", Type: "A", Value: "10.0.0.1"},
+		{RecordId: "4", RR: "sub", Type: "NS", Value: "ns3.example.com"},
+	}
+	mocks.mockAlibabaCloudClient.EXPECT().DescribeDomainRecords(gomock.Any()).Return(response, nil)
+	var deleted []string
+	mocks.mockAlibabaCloudClient.EXPECT().DeleteDomainRecord(gomock.Any()).
+		DoAndReturn(func(request *alidns.DeleteDomainRecordRequest) (*alidns.DeleteDomainRecordResponse, error) {
+			deleted = append(deleted, request.RecordId)
+			return &alidns.DeleteDomainRecordResponse{}, nil
+		}).Times(3)
+
+	err := DeleteAlibabaCloudRecords(mocks.mockAlibabaCloudClient, validAlibabaCloudDNSZone(), log.WithField("controller", ControllerName))
+	assert.NoError(t, err, "unexpected error deleting records")
+	assert.Equal(t, []string{"2", "3", "4"}, deleted, "unexpected records deleted")
+}
+
+func mockAlibabaCloudZoneExists(expect *mock.MockAPIMockRecorder) {
+	response := &alidns.DescribeDomainInfoResponse{DomainName: "blah.example.com"}
+	response.DnsServers.DnsServer = []string{"ns1.example.com", "ns2.example.com"}
+	expect.DescribeDomainInfo(gomock.Any()).Return(response, nil).Times(1)
+}
+
+func mockAlibabaCloudZoneDoesntExist(expect *mock.MockAPIMockRecorder) {
+	expect.DescribeDomainInfo(gomock.Any()).
+		Return(nil, alibabaerrors.NewServerError(http.StatusBadRequest, `{"Code":"InvalidDomainName.NoExist"}`, "")).Times(1)
+}
+
+func mockCreateAlibabaCloudZone(expect *mock.MockAPIMockRecorder) {
+	response := &alidns.AddDomainResponse{DomainName: "blah.example.com"}
+	response.DnsServers.DnsServer = []string{"ns1.example.com", "ns2.example.com"}
+	expect.AddDomain(gomock.Any()).Return(response, nil).Times(1)
+}
+
+func mockDeleteAlibabaCloudZone(expect *mock.MockAPIMockRecorder) {
+	expect.DescribeDomainRecords(gomock.Any()).Return(&alidns.DescribeDomainRecordsResponse{}, nil).Times(1)
+	expect.DeleteDomain(gomock.Any()).Return(&alidns.DeleteDomainResponse{}, nil).Times(1)
+}
@@ -24,11 +24,24 @@ import (
 
 const (
 	hiveDNSZoneAWSTag = "hive.openshift.io/dnszone"
+
+	// awsKeySigningKeyName is the name of the key-signing key that is created for DNSSEC signing of the hosted zone
+	awsKeySigningKeyName = "hive"
+
+	awsKeySigningKeyStatusActive   = "ACTIVE"
+	awsKeySigningKeyStatusInactive = "INACTIVE"
+	awsServeSignatureSigning       = "SIGNING"
 )
 
 // Ensure AWSActuator implements the Actuator interface. This will fail at compile time when false.
 var _ Actuator = &AWSActuator{}
 
+// Ensure AWSActuator implements the DNSSECActuator interface. This will fail at compile time when false.
+var _ DNSSECActuator = &AWSActuator{}
+
+// Ensure AWSActuator implements the RecordActuator interface. This will fail at compile time when false.
+var _ RecordActuator = &AWSActuator{}
+
 // AWSActuator manages getting the desired state, getting the current state and reconciling the two.
 type AWSActuator struct {
 	// logger is the logger used for this controller
@@ -428,6 +441,14 @@ func (a *AWSActuator) Delete() error {
 
 	logger := a.logger.WithField("zone", a.dnsZone.Spec.Zone).WithField("id", aws.StringValue(a.hostedZone.Id))
 
+	// A hosted zone cannot be deleted while it has a key-signing key.
+	if a.dnsZone.Spec.DNSSEC != nil || a.dnsZone.Status.DNSSEC != nil {
+		logger.Info("Disabling DNSSEC signing of route53 hostedzone")
+		if err := a.DisableDNSSEC(); err != nil {
+			return err
+		}
+	}
+
 	logger.Info("Deleting route53 recordsets in hostedzone")
 	if err := DeleteAWSRecordSets(a.awsClient, a.dnsZone, logger); err != nil {
 		return err
@@ -493,6 +514,223 @@ func DeleteAWSRecordSets(awsClient awsclient.Client, dnsZone *hivev1.DNSZone, lo
 
 }
 
+// EnableDNSSEC signs the route53 hosted zone with a key-signing key that uses the KMS key from the DNSZone spec.
+func (a *AWSActuator) EnableDNSSEC() (string, []string, error) {
+	if a.hostedZone == nil {
+		return "", nil, errors.New("hostedZone is unpopulated")
+	}
+	if a.dnsZone.Spec.DNSSEC == nil || a.dnsZone.Spec.DNSSEC.AWS == nil {
+		return "", nil, errors.New("AWS DNSSEC configuration is missing from DNSZone")
+	}
+	kmsKeyARN := a.dnsZone.Spec.DNSSEC.AWS.KMSKeyARN
+
+	logger := a.logger.WithField("id", aws.StringValue(a.hostedZone.Id))
+	logger.Debug("Fetching DNSSEC status of hosted zone")
+	resp, err := a.awsClient.GetDNSSEC(&route53.GetDNSSECInput{HostedZoneId: a.hostedZone.Id})
+	if err != nil {
+		logger.WithError(err).Error("Cannot get DNSSEC status of hosted zone")
+		return "", nil, err
+	}
+
+	ksk := findAWSKeySigningKey(resp.KeySigningKeys)
+	switch {
+	case ksk == nil:
+		logger.WithField("kmsKeyARN", kmsKeyARN).Info("Creating key-signing key")
+		createResp, err := a.awsClient.CreateKeySigningKey(&route53.CreateKeySigningKeyInput{
+			// The generation is included so that signing can be enabled again after it has been disabled.
+			CallerReference:         aws.String(fmt.Sprintf("%s-%d", a.dnsZone.UID, a.dnsZone.Generation)),
+			HostedZoneId:            a.hostedZone.Id,
+			KeyManagementServiceArn: aws.String(kmsKeyARN),
+			Name:                    aws.String(awsKeySigningKeyName),
+			Status:                  aws.String(awsKeySigningKeyStatusActive),
+		})
+		if err != nil {
+			logger.WithError(err).Error("Cannot create key-signing key")
+			return "", nil, err
+		}
+		ksk = createResp.KeySigningKey
+	case aws.StringValue(ksk.KmsArn) != kmsKeyARN:
+		return "", nil, fmt.Errorf("key-signing key uses KMS key %s, changing the KMS key of a signed zone is not supported", aws.StringValue(ksk.KmsArn))
+	case aws.StringValue(ksk.Status) == awsKeySigningKeyStatusInactive:
+		logger.Info("Activating key-signing key")
+		if _, err := a.awsClient.ActivateKeySigningKey(&route53.ActivateKeySigningKeyInput{
+			HostedZoneId: a.hostedZone.Id,
+			Name:         aws.String(awsKeySigningKeyName),
+		}); err != nil {
+			logger.WithError(err).Error("Cannot activate key-signing key")
+			return "", nil, err
+		}
+		ksk.Status = aws.String(awsKeySigningKeyStatusActive)
+	}
+
+	keyState := aws.StringValue(ksk.Status)
+	if keyState != awsKeySigningKeyStatusActive {
+		logger.WithField("keyState", keyState).Info("Key-signing key is not active yet")
+		return keyState, nil, nil
+	}
+
+	if resp.Status == nil || aws.StringValue(resp.Status.ServeSignature) != awsServeSignatureSigning {
+		logger.Info("Enabling DNSSEC signing of hosted zone")
+		if _, err := a.awsClient.EnableHostedZoneDNSSEC(&route53.EnableHostedZoneDNSSECInput{
+			HostedZoneId: a.hostedZone.Id,
+		}); err != nil {
+			logger.WithError(err).Error("Cannot enable DNSSEC signing of hosted zone")
+			return "", nil, err
+		}
+	}
+
+	if aws.StringValue(ksk.DSRecord) == "" {
+		return keyState, nil, nil
+	}
+	return keyState, []string{aws.StringValue(ksk.DSRecord)}, nil
+}
+
+// DisableDNSSEC stops the DNSSEC signing of the route53 hosted zone and deletes its key-signing key.
+func (a *AWSActuator) DisableDNSSEC() error {
+	if a.hostedZone == nil {
+		return errors.New("hostedZone is unpopulated")
+	}
+
+	logger := a.logger.WithField("id", aws.StringValue(a.hostedZone.Id))
+	logger.Debug("Fetching DNSSEC status of hosted zone")
+	resp, err := a.awsClient.GetDNSSEC(&route53.GetDNSSECInput{HostedZoneId: a.hostedZone.Id})
+	if err != nil {
+		logger.WithError(err).Error("Cannot get DNSSEC status of hosted zone")
+		return err
+	}
+
+	if resp.Status != nil && aws.StringValue(resp.Status.ServeSignature) == awsServeSignatureSigning {
+		logger.Info("Disabling DNSSEC signing of hosted zone")
+		if _, err := a.awsClient.DisableHostedZoneDNSSEC(&route53.DisableHostedZoneDNSSECInput{
+			HostedZoneId: a.hostedZone.Id,
+		}); err != nil {
+			logger.WithError(err).Error("Cannot disable DNSSEC signing of hosted zone")
+			return err
+		}
+	}
+
+	ksk := findAWSKeySigningKey(resp.KeySigningKeys)
+	if ksk == nil {
+		return nil
+	}
+	if aws.StringValue(ksk.Status) == awsKeySigningKeyStatusActive {
+		logger.Info("Deactivating key-signing key")
+		if _, err := a.awsClient.DeactivateKeySigningKey(&route53.DeactivateKeySigningKeyInput{
+			HostedZoneId: a.hostedZone.Id,
+			Name:         aws.String(awsKeySigningKeyName),
+		}); err != nil {
+			logger.WithError(err).Error("Cannot deactivate key-signing key")
+			return err
+		}
+	}
+	logger.Info("Deleting key-signing key")
+	if _, err := a.awsClient.DeleteKeySigningKey(&route53.DeleteKeySigningKeyInput{
+		HostedZoneId: a.hostedZone.Id,
+		Name:         aws.String(awsKeySigningKeyName),
+	}); err != nil {
+		logger.WithError(err).Error("Cannot delete key-signing key")
+		return err
+	}
+	return nil
+}
+
+func findAWSKeySigningKey(keys []*route53.KeySigningKey) *route53.KeySigningKey {
+	for _, key := range keys {
+		if aws.StringValue(key.Name) == awsKeySigningKeyName {
+			return key
+		}
+	}
+	return nil
+}
+
+// GetRecord returns the record with the specified name and type from the route53 hosted zone.
+func (a *AWSActuator) GetRecord(name string, recordType hivev1.DNSRecordType) (*hivev1.DNSRecord, error) {
+	if a.hostedZone == nil {
+		return nil, errors.New("hostedZone is unpopulated")
+	}
+
+	logger := a.logger.WithField("id", aws.StringValue(a.hostedZone.Id)).WithField("record", name).WithField("type", recordType)
+	logger.Debug("Listing hosted zone records")
+	resp, err := a.awsClient.ListResourceRecordSets(&route53.ListResourceRecordSetsInput{
+		HostedZoneId:    a.hostedZone.Id,
+		StartRecordName: aws.String(name),
+		StartRecordType: aws.String(string(recordType)),
+		MaxItems:        aws.String("1"),
+	})
+	if err != nil {
+		logger.WithError(err).Error("Error listing recordsets for zone")
+		return nil, err
+	}
+	if len(resp.ResourceRecordSets) == 0 {
+		return nil, nil
+	}
+	recordSet := resp.ResourceRecordSets[0]
+	// route53 returns the record sets that follow the start name and type when there is no such record.
+	// Asterisks in the names of wildcard records are escaped.
+	recordSetName := strings.ReplaceAll(controllerutils.Undotted(aws.StringValue(recordSet.Name)), `\052`, "*")
+	if aws.StringValue(recordSet.Type) != string(recordType) || !strings.EqualFold(recordSetName, name) {
+		return nil, nil
+	}
+
+	record := &hivev1.DNSRecord{
+		Name: name,
+		Type: recordType,
+		TTL:  aws.Int64Value(recordSet.TTL),
+	}
+	for _, resourceRecord := range recordSet.ResourceRecords {
+		value := aws.StringValue(resourceRecord.Value)
+		if recordType == hivev1.DNSRecordTypeTXT {
+			value = unquoteTXT(value)
+		}
+		record.Values = append(record.Values, value)
+	}
+	return record, nil
+}
+
+// UpsertRecord creates or replaces the record in the route53 hosted zone.
+func (a *AWSActuator) UpsertRecord(record *hivev1.DNSRecord) error {
+	return a.changeRecord(record, route53.ChangeActionUpsert)
+}
+
+// DeleteRecord deletes the record from the route53 hosted zone.
+func (a *AWSActuator) DeleteRecord(record *hivev1.DNSRecord) error {
+	return a.changeRecord(record, route53.ChangeActionDelete)
+}
+
+func (a *AWSActuator) changeRecord(record *hivev1.DNSRecord, action string) error {
+	if a.hostedZone == nil {
+		return errors.New("hostedZone is unpopulated")
+	}
+
+	recordSet := &route53.ResourceRecordSet{
+		Name: aws.String(record.Name),
+		Type: aws.String(string(record.Type)),
+		TTL:  aws.Int64(record.TTL),
+	}
+	for _, value := range record.Values {
+		if record.Type == hivev1.DNSRecordTypeTXT {
+			value = quoteTXT(value)
+		}
+		recordSet.ResourceRecords = append(recordSet.ResourceRecords, &route53.ResourceRecord{Value: aws.String(value)})
+	}
+
+	logger := a.logger.WithField("id", aws.StringValue(a.hostedZone.Id)).WithField("record", record.Name).WithField("type", record.Type)
+	logger.WithField("action", action).Debug("Changing hosted zone record")
+	if _, err := a.awsClient.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: a.hostedZone.Id,
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{{
+				Action:            aws.String(action),
+				ResourceRecordSet: recordSet,
+			}},
+		},
+	}); err != nil {
+		logger.WithError(err).WithField("action", action).Error("Cannot change hosted zone record")
+		return err
+	}
+	return nil
+}
+
 // GetNameServers returns the nameservers listed in the route53 hosted zone NS record.
 func (a *AWSActuator) GetNameServers() ([]string, error) {
 	if a.hostedZone == nil {
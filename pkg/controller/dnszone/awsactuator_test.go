@@ -56,6 +56,205 @@ func TestNewAWSActuator(t *testing.T) {
 	}
 }
 
+// TestAWSEnableDNSSEC tests that the hosted zone is signed with a key-signing key using the KMS key from the spec.
+func TestAWSEnableDNSSEC(t *testing.T) {
+	cases := []struct {
+		name              string
+		setupAWSMock      func(*mock.MockClientMockRecorder)
+		expectedKeyState  string
+		expectedDSRecords []string
+		expectedError     bool
+	}{
+		{
+			name: "create key-signing key and enable signing",
+			setupAWSMock: func(expect *mock.MockClientMockRecorder) {
+				mockGetDNSSEC(expect, "NOT_SIGNING")
+				expect.CreateKeySigningKey(gomock.Any()).DoAndReturn(func(input *route53.CreateKeySigningKeyInput) (*route53.CreateKeySigningKeyOutput, error) {
+					assert.Equal(t, "abcdef-6", aws.StringValue(input.CallerReference), "unexpected caller reference")
+					assert.Equal(t, testKMSKeyARN, aws.StringValue(input.KeyManagementServiceArn), "unexpected KMS key")
+					assert.Equal(t, awsKeySigningKeyStatusActive, aws.StringValue(input.Status), "unexpected key status")
+					return &route53.CreateKeySigningKeyOutput{KeySigningKey: awsKeySigningKey(awsKeySigningKeyStatusActive)}, nil
+				})
+				expect.EnableHostedZoneDNSSEC(gomock.Any()).Return(&route53.EnableHostedZoneDNSSECOutput{}, nil)
+			},
+			expectedKeyState:  awsKeySigningKeyStatusActive,
+			expectedDSRecords: []string{testDSRecord},
+		},
+		{
+			name: "already signed",
+			setupAWSMock: func(expect *mock.MockClientMockRecorder) {
+				mockGetDNSSEC(expect, awsServeSignatureSigning, awsKeySigningKey(awsKeySigningKeyStatusActive))
+			},
+			expectedKeyState:  awsKeySigningKeyStatusActive,
+			expectedDSRecords: []string{testDSRecord},
+		},
+		{
+			name: "activate inactive key-signing key",
+			setupAWSMock: func(expect *mock.MockClientMockRecorder) {
+				mockGetDNSSEC(expect, awsServeSignatureSigning, awsKeySigningKey(awsKeySigningKeyStatusInactive))
+				expect.ActivateKeySigningKey(gomock.Any()).Return(&route53.ActivateKeySigningKeyOutput{}, nil)
+			},
+			expectedKeyState:  awsKeySigningKeyStatusActive,
+			expectedDSRecords: []string{testDSRecord},
+		},
+		{
+			name: "key-signing key needs action",
+			setupAWSMock: func(expect *mock.MockClientMockRecorder) {
+				mockGetDNSSEC(expect, "NOT_SIGNING", awsKeySigningKey("ACTION_NEEDED"))
+			},
+			expectedKeyState: "ACTION_NEEDED",
+		},
+		{
+			name: "key-signing key uses a different KMS key",
+			setupAWSMock: func(expect *mock.MockClientMockRecorder) {
+				ksk := awsKeySigningKey(awsKeySigningKeyStatusActive)
+				ksk.KmsArn = aws.String("arn:aws:kms:us-east-1:123456789012:key/other")
+				mockGetDNSSEC(expect, awsServeSignatureSigning, ksk)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			zr := &AWSActuator{
+				logger:     log.WithField("controller", ControllerName),
+				awsClient:  mocks.mockAWSClient,
+				dnsZone:    validDNSZoneWithDNSSEC(),
+				hostedZone: &route53.HostedZone{Id: aws.String("1234")},
+			}
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			keyState, dsRecords, err := zr.EnableDNSSEC()
+			if tc.expectedError {
+				assert.Error(t, err, "expected error")
+				return
+			}
+			assert.NoError(t, err, "unexpected error")
+			assert.Equal(t, tc.expectedKeyState, keyState, "unexpected key state")
+			assert.Equal(t, tc.expectedDSRecords, dsRecords, "unexpected DS records")
+		})
+	}
+}
+
+// TestAWSDisableDNSSEC tests that signing is disabled and the key-signing key is removed from the hosted zone.
+func TestAWSDisableDNSSEC(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	zr := &AWSActuator{
+		logger:     log.WithField("controller", ControllerName),
+		awsClient:  mocks.mockAWSClient,
+		dnsZone:    validDNSZoneDisablingDNSSEC(),
+		hostedZone: &route53.HostedZone{Id: aws.String("1234")},
+	}
+	expect := mocks.mockAWSClient.EXPECT()
+	mockGetDNSSEC(expect, awsServeSignatureSigning, awsKeySigningKey(awsKeySigningKeyStatusActive))
+	gomock.InOrder(
+		expect.DisableHostedZoneDNSSEC(gomock.Any()).Return(&route53.DisableHostedZoneDNSSECOutput{}, nil),
+		expect.DeactivateKeySigningKey(gomock.Any()).Return(&route53.DeactivateKeySigningKeyOutput{}, nil),
+		expect.DeleteKeySigningKey(gomock.Any()).Return(&route53.DeleteKeySigningKeyOutput{}, nil),
+	)
+
+	err := zr.DisableDNSSEC()
+	assert.NoError(t, err, "unexpected error")
+}
+
+func TestAWSGetRecord(t *testing.T) {
+	cases := []struct {
+		name           string
+		recordSets     []*route53.ResourceRecordSet
+		expectedRecord *hivev1.DNSRecord
+	}{
+		{
+			name: "wildcard record",
+			recordSets: []*route53.ResourceRecordSet{{
+				Name:            aws.String(`\052.apps.blah.example.com.`),
+				Type:            aws.String("TXT"),
+				TTL:             aws.Int64(300),
+				ResourceRecords: []*route53.ResourceRecord{{Value: aws.String(`"some \"quoted\" text"`)}},
+			}},
+			expectedRecord: &hivev1.DNSRecord{
+				Name:   "*.apps.blah.example.com",
+				Type:   hivev1.DNSRecordTypeTXT,
+				TTL:    300,
+				Values: []string{`some "quoted" text`},
+			},
+		},
+		{
+			name: "no such record",
+			recordSets: []*route53.ResourceRecordSet{{
+				Name:            aws.String("api.blah.example.com."),
+				Type:            aws.String("A"),
+				TTL:             aws.Int64(60),
+				ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("192.0.2.1")}},
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			zr := &AWSActuator{
+				logger:     log.WithField("controller", ControllerName),
+				awsClient:  mocks.mockAWSClient,
+				dnsZone:    validDNSZone(),
+				hostedZone: &route53.HostedZone{Id: aws.String("1234")},
+			}
+			mocks.mockAWSClient.EXPECT().ListResourceRecordSets(gomock.Any()).
+				Return(&route53.ListResourceRecordSetsOutput{ResourceRecordSets: tc.recordSets}, nil)
+
+			record, err := zr.GetRecord("*.apps.blah.example.com", hivev1.DNSRecordTypeTXT)
+			assert.NoError(t, err, "unexpected error")
+			assert.Equal(t, tc.expectedRecord, record, "unexpected record")
+		})
+	}
+}
+
+func TestAWSUpsertRecord(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	zr := &AWSActuator{
+		logger:     log.WithField("controller", ControllerName),
+		awsClient:  mocks.mockAWSClient,
+		dnsZone:    validDNSZone(),
+		hostedZone: &route53.HostedZone{Id: aws.String("1234")},
+	}
+	mocks.mockAWSClient.EXPECT().ChangeResourceRecordSets(gomock.Any()).
+		DoAndReturn(func(input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+			if assert.Len(t, input.ChangeBatch.Changes, 1, "unexpected number of changes") {
+				change := input.ChangeBatch.Changes[0]
+				assert.Equal(t, route53.ChangeActionUpsert, aws.StringValue(change.Action), "unexpected action")
+				assert.Equal(t, "_acme-challenge.blah.example.com", aws.StringValue(change.ResourceRecordSet.Name), "unexpected name")
+				assert.Equal(t, int64(60), aws.Int64Value(change.ResourceRecordSet.TTL), "unexpected TTL")
+				assert.Equal(t, []*route53.ResourceRecord{{Value: aws.String(`"token"`)}}, change.ResourceRecordSet.ResourceRecords, "unexpected values")
+			}
+			return &route53.ChangeResourceRecordSetsOutput{}, nil
+		})
+
+	err := zr.UpsertRecord(&hivev1.DNSRecord{
+		Name:   "_acme-challenge.blah.example.com",
+		Type:   hivev1.DNSRecordTypeTXT,
+		TTL:    60,
+		Values: []string{"token"},
+	})
+	assert.NoError(t, err, "unexpected error")
+}
+
+func awsKeySigningKey(status string) *route53.KeySigningKey {
+	return &route53.KeySigningKey{
+		Name:     aws.String(awsKeySigningKeyName),
+		KmsArn:   aws.String(testKMSKeyARN),
+		Status:   aws.String(status),
+		DSRecord: aws.String(testDSRecord),
+	}
+}
+
+func mockGetDNSSEC(expect *mock.MockClientMockRecorder, serveSignature string, keys ...*route53.KeySigningKey) {
+	expect.GetDNSSEC(gomock.Any()).Return(&route53.GetDNSSECOutput{
+		KeySigningKeys: keys,
+		Status:         &route53.DNSSECStatus{ServeSignature: aws.String(serveSignature)},
+	}, nil).Times(1)
+}
+
 func mockAWSZoneExists(expect *mock.MockClientMockRecorder, zone *hivev1.DNSZone) {
 
 	if zone.Status.AWS == nil || aws.StringValue(zone.Status.AWS.ZoneID) == "" {
@@ -14,16 +14,22 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/alibabaclient"
 	awsclient "github.com/openshift/hive/pkg/awsclient"
 	"github.com/openshift/hive/pkg/azureclient"
 	"github.com/openshift/hive/pkg/constants"
+	"github.com/openshift/hive/pkg/controller/dnsendpoint/nameserver"
 	hivemetrics "github.com/openshift/hive/pkg/controller/metrics"
 	controllerutils "github.com/openshift/hive/pkg/controller/utils"
 	gcpclient "github.com/openshift/hive/pkg/gcpclient"
+	"github.com/openshift/hive/pkg/ibmclient"
+	"github.com/openshift/hive/pkg/manageddns"
+	"github.com/openshift/hive/pkg/rfc2136client"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,6 +46,8 @@ const (
 	ControllerName                  = hivev1.DNSZoneControllerName
 	zoneResyncDuration              = 2 * time.Hour
 	domainAvailabilityCheckInterval = 30 * time.Second
+	defaultRecordTTL                = 60
+	maxTXTStringLength              = 255
 	dnsClientTimeout                = 30 * time.Second
 	resolverConfigFile              = "/etc/resolv.conf"
 	zoneCheckDNSServersEnvVar       = "ZONE_CHECK_DNS_SERVERS"
@@ -75,15 +83,21 @@ func Add(mgr manager.Manager) error {
 		logger.WithError(err).Error("could not get controller configurations")
 		return err
 	}
-	return add(mgr, newReconciler(mgr, clientRateLimiter), concurrentReconciles, queueRateLimiter)
+	managedDomains, err := manageddns.ReadManagedDomainsFile()
+	if err != nil {
+		logger.WithError(err).Error("unable to read managed domains file")
+		return err
+	}
+	return add(mgr, newReconciler(mgr, clientRateLimiter, managedDomains), concurrentReconciles, queueRateLimiter)
 }
 
 // newReconciler returns a new reconcile.Reconciler
-func newReconciler(mgr manager.Manager, rateLimiter flowcontrol.RateLimiter) *ReconcileDNSZone {
+func newReconciler(mgr manager.Manager, rateLimiter flowcontrol.RateLimiter, managedDomains []hivev1.ManageDNSConfig) *ReconcileDNSZone {
 	return &ReconcileDNSZone{
-		Client:    controllerutils.NewClientWithMetricsOrDie(mgr, ControllerName, &rateLimiter),
-		logger:    log.WithField("controller", ControllerName),
-		soaLookup: lookupSOARecord,
+		Client:         controllerutils.NewClientWithMetricsOrDie(mgr, ControllerName, &rateLimiter),
+		logger:         log.WithField("controller", ControllerName),
+		soaLookup:      lookupSOARecord,
+		managedDomains: managedDomains,
 	}
 }
 
@@ -128,6 +142,10 @@ type ReconcileDNSZone struct {
 
 	// soaLookup is a function that looks up a zone's SOA record
 	soaLookup func(string, log.FieldLogger) (bool, error)
+
+	// managedDomains are the managed domains of the HiveConfig. The TSIG keys of the RFC 2136 managed domains are
+	// used for the DNSZones under them that do not reference a TSIG key of their own.
+	managedDomains []hivev1.ManageDNSConfig
 }
 
 // Reconcile reads that state of the cluster for a DNSZone object and makes changes based on the state read
@@ -324,6 +342,18 @@ func (r *ReconcileDNSZone) reconcileDNSProvider(actuator Actuator, dnsZone *hive
 		}
 	}
 
+	dnssecStatus, dnssecPending, err := r.reconcileDNSSEC(actuator, dnsZone, logger)
+	if err != nil {
+		logger.WithError(err).Error("Failed to reconcile DNSSEC signing of hosted zone")
+		return reconcile.Result{}, err
+	}
+
+	recordStatuses, err := r.reconcileRecords(actuator, dnsZone, logger)
+	if err != nil {
+		logger.WithError(err).Error("Failed to reconcile records of hosted zone")
+		return reconcile.Result{}, err
+	}
+
 	nameServers, err := actuator.GetNameServers()
 	if err != nil {
 		logger.WithError(err).Error("Failed to get hosted zone name servers")
@@ -340,8 +370,293 @@ func (r *ReconcileDNSZone) reconcileDNSProvider(actuator Actuator, dnsZone *hive
 		logger.Info("SOA record for DNS zone not available")
 		reconcileResult.RequeueAfter = domainAvailabilityCheckInterval
 	}
+	if dnssecPending || recordsPending(recordStatuses) {
+		reconcileResult.RequeueAfter = domainAvailabilityCheckInterval
+	}
+
+	return reconcileResult, r.updateStatus(nameServers, isZoneSOAAvailable, dnssecStatus, recordStatuses, dnsZone, logger)
+}
+
+// reconcileRecords deletes the records that Hive created and that have been removed from the spec, and syncs the
+// records from the spec to the zone. It returns the status of the records.
+func (r *ReconcileDNSZone) reconcileRecords(actuator Actuator, dnsZone *hivev1.DNSZone, logger log.FieldLogger) ([]hivev1.DNSRecordStatus, error) {
+	if len(dnsZone.Spec.Records) == 0 && len(dnsZone.Status.Records) == 0 {
+		return nil, nil
+	}
+	recordActuator, ok := actuator.(RecordActuator)
+	if !ok {
+		return nil, errors.New("records are not supported for the platform of the DNSZone")
+	}
+
+	desiredKeys := sets.NewString()
+	for _, record := range dnsZone.Spec.Records {
+		desiredKeys.Insert(recordKey(record.Name, record.Type))
+	}
+	owned := sets.NewString()
+	var statuses []hivev1.DNSRecordStatus
+	for _, status := range dnsZone.Status.Records {
+		if !status.Owned {
+			continue
+		}
+		key := recordKey(status.Name, status.Type)
+		if desiredKeys.Has(key) {
+			owned.Insert(key)
+			continue
+		}
+		// Records are deleted before the records from the spec are synced so that a record can be replaced by a
+		// record of another type, such as an A record by a CNAME record.
+		if err := deleteRecord(recordActuator, dnsZone.Spec.Zone, status, logger); err != nil {
+			status.State = hivev1.DNSRecordStateDeleting
+			status.Message = controllerutils.ErrorScrub(err)
+			statuses = append(statuses, status)
+		}
+	}
+
+	for _, record := range dnsZone.Spec.Records {
+		record := record
+		claim := func() error {
+			return r.claimRecord(dnsZone, record)
+		}
+		statuses = append(statuses, syncRecord(recordActuator, dnsZone.Spec.Zone, record, owned.Has(recordKey(record.Name, record.Type)), claim, logger))
+	}
+	return statuses, nil
+}
+
+// claimRecord saves the ownership of a record in the status of the DNSZone before the record is created in the zone.
+// Otherwise, the ownership would be lost if the status update at the end of the reconcile failed, and the record
+// would be reported as a conflict from then on.
+func (r *ReconcileDNSZone) claimRecord(dnsZone *hivev1.DNSZone, record hivev1.DNSRecord) error {
+	key := recordKey(record.Name, record.Type)
+	var statuses []hivev1.DNSRecordStatus
+	for _, status := range dnsZone.Status.Records {
+		if recordKey(status.Name, status.Type) != key {
+			statuses = append(statuses, status)
+		}
+	}
+	dnsZone.Status.Records = append(statuses, hivev1.DNSRecordStatus{
+		Name:  record.Name,
+		Type:  record.Type,
+		Owned: true,
+		State: hivev1.DNSRecordStateCreating,
+	})
+	return r.Status().Update(context.TODO(), dnsZone)
+}
+
+// syncRecord creates or updates the record in the zone. A record that already exists in the zone is only updated
+// when Hive owns it. Before creating a record that it does not own yet, syncRecord calls claim to save the ownership
+// of the record.
+func syncRecord(recordActuator RecordActuator, zone string, record hivev1.DNSRecord, owned bool, claim func() error, logger log.FieldLogger) hivev1.DNSRecordStatus {
+	status := hivev1.DNSRecordStatus{
+		Name:  record.Name,
+		Type:  record.Type,
+		Owned: owned,
+	}
+	desired := &hivev1.DNSRecord{
+		Name:   recordFQDN(record.Name, zone),
+		Type:   record.Type,
+		TTL:    record.TTL,
+		Values: record.Values,
+	}
+	if desired.TTL == 0 {
+		desired.TTL = defaultRecordTTL
+	}
+	recordLogger := logger.WithField("record", desired.Name).WithField("type", desired.Type)
+
+	current, err := recordActuator.GetRecord(desired.Name, desired.Type)
+	if err != nil {
+		recordLogger.WithError(err).Error("failed to get record from zone")
+		status.State = hivev1.DNSRecordStateFailed
+		status.Message = controllerutils.ErrorScrub(err)
+		return status
+	}
+	switch {
+	case current != nil && recordsEqual(current, desired):
+		status.State = hivev1.DNSRecordStateSynced
+		return status
+	case current != nil && !owned:
+		recordLogger.Warn("record that was not created by Hive already exists in zone")
+		status.State = hivev1.DNSRecordStateConflict
+		status.Message = "A record with the same name and type that was not created by Hive already exists in the zone"
+		return status
+	}
+
+	if !owned {
+		if err := claim(); err != nil {
+			recordLogger.WithError(err).Log(controllerutils.LogLevel(err), "failed to save ownership of record")
+			status.State = hivev1.DNSRecordStateFailed
+			status.Message = controllerutils.ErrorScrub(err)
+			return status
+		}
+		status.Owned = true
+	}
+
+	recordLogger.Info("syncing record to zone")
+	if err := recordActuator.UpsertRecord(desired); err != nil {
+		recordLogger.WithError(err).Error("failed to sync record to zone")
+		status.State = hivev1.DNSRecordStateFailed
+		status.Message = controllerutils.ErrorScrub(err)
+		return status
+	}
+	status.State = hivev1.DNSRecordStateSynced
+	return status
+}
+
+// deleteRecord deletes a record that Hive created from the zone.
+func deleteRecord(recordActuator RecordActuator, zone string, status hivev1.DNSRecordStatus, logger log.FieldLogger) error {
+	name := recordFQDN(status.Name, zone)
+	recordLogger := logger.WithField("record", name).WithField("type", status.Type)
+	current, err := recordActuator.GetRecord(name, status.Type)
+	if err != nil {
+		recordLogger.WithError(err).Error("failed to get record from zone")
+		return err
+	}
+	if current == nil {
+		return nil
+	}
+	recordLogger.Info("deleting record that was removed from the spec")
+	if err := recordActuator.DeleteRecord(current); err != nil {
+		recordLogger.WithError(err).Error("failed to delete record from zone")
+		return err
+	}
+	return nil
+}
+
+// recordFQDN returns the fully qualified name of a record from its name relative to the zone.
+func recordFQDN(name, zone string) string {
+	if name == "@" {
+		return zone
+	}
+	return name + "." + zone
+}
+
+func recordKey(name string, recordType hivev1.DNSRecordType) string {
+	return fmt.Sprintf("%s/%s", recordType, strings.ToLower(name))
+}
+
+// recordsEqual returns true when the records have the same TTL and values. CNAME values are compared regardless of
+// their case and of a trailing dot.
+func recordsEqual(a, b *hivev1.DNSRecord) bool {
+	if a.TTL != b.TTL {
+		return false
+	}
+	normalize := func(record *hivev1.DNSRecord) sets.String {
+		values := sets.NewString()
+		for _, v := range record.Values {
+			if record.Type == hivev1.DNSRecordTypeCNAME {
+				v = strings.ToLower(controllerutils.Undotted(v))
+			}
+			values.Insert(v)
+		}
+		return values
+	}
+	return normalize(a).Equal(normalize(b))
+}
+
+// quoteTXT returns the value of a TXT record in presentation format. Values longer than 255 characters are split
+// into several strings.
+func quoteTXT(value string) string {
+	var quoted []string
+	for {
+		chunk := value
+		if len(chunk) > maxTXTStringLength {
+			chunk = chunk[:maxTXTStringLength]
+		}
+		value = value[len(chunk):]
+		chunk = strings.ReplaceAll(chunk, `\`, `\\`)
+		chunk = strings.ReplaceAll(chunk, `"`, `\"`)
+		quoted = append(quoted, `"`+chunk+`"`)
+		if value == "" {
+			return strings.Join(quoted, " ")
+		}
+	}
+}
+
+// unquoteTXT returns the value of a TXT record from its presentation format by concatenating its strings.
+func unquoteTXT(value string) string {
+	if !strings.HasPrefix(value, `"`) {
+		return value
+	}
+	var unquoted strings.Builder
+	inString, escaped := false, false
+	for _, c := range value {
+		switch {
+		case escaped:
+			unquoted.WriteRune(c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			inString = !inString
+		case inString:
+			unquoted.WriteRune(c)
+		}
+	}
+	return unquoted.String()
+}
 
-	return reconcileResult, r.updateStatus(nameServers, isZoneSOAAvailable, dnsZone, logger)
+// recordsPending returns true when some records failed to sync or to be deleted, or were not created yet.
+func recordsPending(statuses []hivev1.DNSRecordStatus) bool {
+	for _, status := range statuses {
+		switch status.State {
+		case hivev1.DNSRecordStateFailed, hivev1.DNSRecordStateDeleting, hivev1.DNSRecordStateCreating:
+			return true
+		}
+	}
+	return false
+}
+
+// reconcileDNSSEC enables or disables the DNSSEC signing of the zone as requested in the spec. It returns the
+// DNSSEC status of the zone and whether the zone needs to be synced again to complete the change.
+func (r *ReconcileDNSZone) reconcileDNSSEC(actuator Actuator, dnsZone *hivev1.DNSZone, logger log.FieldLogger) (*hivev1.DNSSECStatus, bool, error) {
+	currentStatus := dnsZone.Status.DNSSEC
+	if dnsZone.Spec.DNSSEC == nil && currentStatus == nil {
+		return nil, false, nil
+	}
+	dnssecActuator, ok := actuator.(DNSSECActuator)
+	if !ok {
+		return nil, false, errors.New("DNSSEC is not supported for the platform of the DNSZone")
+	}
+
+	if dnsZone.Spec.DNSSEC != nil {
+		keyState, dsRecords, err := dnssecActuator.EnableDNSSEC()
+		if err != nil {
+			return nil, false, err
+		}
+		return &hivev1.DNSSECStatus{KeyState: keyState, DSRecords: dsRecords}, len(dsRecords) == 0, nil
+	}
+
+	// The DS records must be removed from the parent domain, and must have expired from the caches of the
+	// resolvers, before the zone stops being signed. Otherwise, validating resolvers would fail to resolve
+	// names in the zone.
+	if dnsZone.Spec.LinkToParentDomain {
+		if len(dnsZone.Status.PublishedDSRecords) > 0 {
+			logger.Info("waiting for DS records to be removed from the parent domain before disabling DNSSEC")
+			return &hivev1.DNSSECStatus{KeyState: currentStatus.KeyState}, true, nil
+		}
+		if wait := dsRecordsExpiry(dnsZone); wait > 0 {
+			logger.WithField("wait", wait).Info("waiting for DS records removed from the parent domain to expire before disabling DNSSEC")
+			return &hivev1.DNSSECStatus{KeyState: currentStatus.KeyState}, true, nil
+		}
+	}
+	logger.Info("disabling DNSSEC signing of zone")
+	if err := dnssecActuator.DisableDNSSEC(); err != nil {
+		return nil, false, err
+	}
+	return nil, false, nil
+}
+
+// dsRecordsExpiry returns how long resolvers may still cache the DS records of the zone that were removed from the
+// parent domain.
+func dsRecordsExpiry(dnsZone *hivev1.DNSZone) time.Duration {
+	cond := controllerutils.FindCondition(dnsZone.Status.Conditions, hivev1.DSRecordsPublishedCondition)
+	if cond == nil {
+		// DS records were never published for the zone.
+		return 0
+	}
+	if cond.Status == corev1.ConditionTrue {
+		return nameserver.DSRecordTTL
+	}
+	return time.Until(cond.LastTransitionTime.Add(nameserver.DSRecordTTL))
 }
 
 func (r *ReconcileDNSZone) removeDNSZoneFinalizer(dnsZone *hivev1.DNSZone, logger log.FieldLogger) error {
@@ -372,6 +687,14 @@ func shouldSync(desiredState *hivev1.DNSZone) (bool, time.Duration) {
 		return true, 0 // Spec has changed since last sync, sync now.
 	}
 
+	if dnssecPending(desiredState) {
+		return true, 0 // DNSSEC signing is being enabled or disabled, sync now.
+	}
+
+	if recordsPending(desiredState.Status.Records) {
+		return true, 0 // Some records failed to sync, sync now.
+	}
+
 	if desiredState.Spec.LinkToParentDomain {
 		availableCondition := controllerutils.FindCondition(desiredState.Status.Conditions, hivev1.ZoneAvailableDNSZoneCondition)
 		if availableCondition == nil || availableCondition.Status == corev1.ConditionFalse {
@@ -389,6 +712,14 @@ func shouldSync(desiredState *hivev1.DNSZone) (bool, time.Duration) {
 	return false, delta
 }
 
+// dnssecPending returns true when the DNSSEC signing of the zone has not yet reached the state requested in the spec.
+func dnssecPending(dnsZone *hivev1.DNSZone) bool {
+	if dnsZone.Spec.DNSSEC == nil {
+		return dnsZone.Status.DNSSEC != nil
+	}
+	return dnsZone.Status.DNSSEC == nil || len(dnsZone.Status.DNSSEC.DSRecords) == 0
+}
+
 func (r *ReconcileDNSZone) getActuator(dnsZone *hivev1.DNSZone, dnsLog log.FieldLogger) (Actuator, error) {
 	if dnsZone.Spec.AWS != nil {
 		credentials := awsclient.CredentialsSource{
@@ -438,10 +769,63 @@ func (r *ReconcileDNSZone) getActuator(dnsZone *hivev1.DNSZone, dnsLog log.Field
 		return NewAzureActuator(dnsLog, secret, dnsZone, azureclient.NewClientFromSecret)
 	}
 
+	if dnsZone.Spec.IBMCloud != nil {
+		secret := &corev1.Secret{}
+		err := r.Get(context.TODO(),
+			types.NamespacedName{
+				Name:      dnsZone.Spec.IBMCloud.CredentialsSecretRef.Name,
+				Namespace: dnsZone.Namespace,
+			},
+			secret)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewIBMCloudActuator(dnsLog, secret, dnsZone, func(secret *corev1.Secret) (ibmclient.API, error) {
+			return ibmclient.NewClientFromSecret(secret)
+		})
+	}
+
+	if dnsZone.Spec.AlibabaCloud != nil {
+		secret := &corev1.Secret{}
+		err := r.Get(context.TODO(),
+			types.NamespacedName{
+				Name:      dnsZone.Spec.AlibabaCloud.CredentialsSecretRef.Name,
+				Namespace: dnsZone.Namespace,
+			},
+			secret)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewAlibabaCloudActuator(dnsLog, secret, dnsZone, alibabaclient.NewClientFromSecret)
+	}
+
+	if dnsZone.Spec.RFC2136 != nil {
+		secretName := types.NamespacedName{Namespace: dnsZone.Namespace}
+		if ref := dnsZone.Spec.RFC2136.TSIGSecretRef; ref != nil {
+			secretName.Name = ref.Name
+		} else {
+			managedDomain := rfc2136ManagedDomain(r.managedDomains, dnsZone)
+			if managedDomain == nil {
+				return nil, fmt.Errorf("zone %s is not under an RFC 2136 managed domain with server %s and TSIG key %s, so tsigSecretRef must be set",
+					dnsZone.Spec.Zone, dnsZone.Spec.RFC2136.Server, dnsZone.Spec.RFC2136.TSIGKeyName)
+			}
+			// The TSIG key of the managed domain is only ever read from the Hive namespace.
+			secretName = types.NamespacedName{Namespace: controllerutils.GetHiveNamespace(), Name: managedDomain.TSIGSecretRef.Name}
+		}
+		secret := &corev1.Secret{}
+		if err := r.Get(context.TODO(), secretName, secret); err != nil {
+			return nil, err
+		}
+
+		return NewRFC2136Actuator(dnsLog, secret, dnsZone, rfc2136client.NewClientFromSecret)
+	}
+
 	return nil, errors.New("unable to determine which actuator to use")
 }
 
-func (r *ReconcileDNSZone) updateStatus(nameServers []string, isSOAAvailable bool, dnsZone *hivev1.DNSZone, logger log.FieldLogger) error {
+func (r *ReconcileDNSZone) updateStatus(nameServers []string, isSOAAvailable bool, dnssecStatus *hivev1.DNSSECStatus, recordStatuses []hivev1.DNSRecordStatus, dnsZone *hivev1.DNSZone, logger log.FieldLogger) error {
 	orig := dnsZone.DeepCopy()
 
 	dnsZone.Status.NameServers = nameServers
@@ -470,6 +854,69 @@ func (r *ReconcileDNSZone) updateStatus(nameServers []string, isSOAAvailable boo
 		availableMessage,
 		controllerutils.UpdateConditionNever)
 
+	dnsZone.Status.DNSSEC = dnssecStatus
+	var signingStatus corev1.ConditionStatus
+	var signingReason, signingMessage string
+	setSigningCondition := true
+	switch {
+	case dnsZone.Spec.DNSSEC != nil && dnssecStatus != nil && len(dnssecStatus.DSRecords) > 0:
+		signingStatus = corev1.ConditionTrue
+		signingReason = "ZoneSigned"
+		signingMessage = "Zone is signed with DNSSEC"
+	case dnsZone.Spec.DNSSEC != nil:
+		signingStatus = corev1.ConditionFalse
+		signingReason = "KeySigningKeyNotActive"
+		signingMessage = "Key-signing key for zone is not active"
+		if dnssecStatus != nil && dnssecStatus.KeyState != "" {
+			signingMessage = fmt.Sprintf("Key-signing key for zone is in state %s", dnssecStatus.KeyState)
+		}
+	case dnssecStatus != nil:
+		signingStatus = corev1.ConditionFalse
+		signingReason = "DisablingDNSSEC"
+		signingMessage = "Waiting for DS records to be removed from the parent domain"
+	case controllerutils.FindCondition(dnsZone.Status.Conditions, hivev1.DNSSECSigningCondition) != nil:
+		// The zone was signed before. Zones that never used DNSSEC do not get the condition.
+		signingStatus = corev1.ConditionFalse
+		signingReason = "DNSSECDisabled"
+		signingMessage = "DNSSEC is not enabled for zone"
+	default:
+		setSigningCondition = false
+	}
+	if setSigningCondition {
+		dnsZone.Status.Conditions = controllerutils.SetDNSZoneCondition(
+			dnsZone.Status.Conditions,
+			hivev1.DNSSECSigningCondition,
+			signingStatus,
+			signingReason,
+			signingMessage,
+			controllerutils.UpdateConditionIfReasonOrMessageChange)
+	}
+
+	dnsZone.Status.Records = recordStatuses
+	if len(dnsZone.Spec.Records) > 0 || len(recordStatuses) > 0 {
+		recordsStatus := corev1.ConditionTrue
+		recordsReason := "RecordsSynced"
+		recordsMessage := "All records are synced to the zone"
+		var unsynced []string
+		for _, status := range recordStatuses {
+			if status.State != hivev1.DNSRecordStateSynced {
+				unsynced = append(unsynced, fmt.Sprintf("%s %s: %s", status.Type, status.Name, status.State))
+			}
+		}
+		if len(unsynced) > 0 {
+			recordsStatus = corev1.ConditionFalse
+			recordsReason = "RecordsNotSynced"
+			recordsMessage = fmt.Sprintf("Records are not synced to the zone: %s", strings.Join(unsynced, ", "))
+		}
+		dnsZone.Status.Conditions = controllerutils.SetDNSZoneCondition(
+			dnsZone.Status.Conditions,
+			hivev1.RecordsSyncedCondition,
+			recordsStatus,
+			recordsReason,
+			recordsMessage,
+			controllerutils.UpdateConditionIfReasonOrMessageChange)
+	}
+
 	if !reflect.DeepEqual(orig.Status, dnsZone.Status) {
 		logger.Debug("Updating DNSZone status")
 		err := r.Client.Status().Update(context.TODO(), dnsZone)
@@ -2,25 +2,34 @@ package dnszone
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/golang/mock/gomock"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	alibabamock "github.com/openshift/hive/pkg/alibabaclient/mock"
 	"github.com/openshift/hive/pkg/awsclient"
 	awsmock "github.com/openshift/hive/pkg/awsclient/mock"
 	azuremock "github.com/openshift/hive/pkg/azureclient/mock"
 	controllerutils "github.com/openshift/hive/pkg/controller/utils"
 	gcpmock "github.com/openshift/hive/pkg/gcpclient/mock"
+	ibmmock "github.com/openshift/hive/pkg/ibmclient/mock"
+	rfc2136mock "github.com/openshift/hive/pkg/rfc2136client/mock"
 	testdnszone "github.com/openshift/hive/pkg/test/dnszone"
 	testgeneric "github.com/openshift/hive/pkg/test/generic"
 )
@@ -174,6 +183,191 @@ func TestReconcileDNSProviderForAWS(t *testing.T) {
 				assert.NotNil(t, condition, "zone available condition should be set on dnszone")
 			},
 		},
+		{
+			name:    "Existing zone, enable DNSSEC",
+			dnsZone: validDNSZoneWithDNSSEC(),
+			setupAWSMock: func(expect *awsmock.MockClientMockRecorder) {
+				mockAWSZoneExists(expect, validDNSZoneWithDNSSEC())
+				mockExistingAWSTags(expect)
+				mockGetDNSSEC(expect, "NOT_SIGNING", awsKeySigningKey(awsKeySigningKeyStatusActive))
+				expect.EnableHostedZoneDNSSEC(gomock.Any()).Return(&route53.EnableHostedZoneDNSSECOutput{}, nil)
+				mockAWSGetNSRecord(expect)
+			},
+			validateZone: func(t *testing.T, zone *hivev1.DNSZone) {
+				if assert.NotNil(t, zone.Status.DNSSEC, "DNSSEC status should be set") {
+					assert.Equal(t, awsKeySigningKeyStatusActive, zone.Status.DNSSEC.KeyState, "unexpected key state")
+					assert.Equal(t, []string{testDSRecord}, zone.Status.DNSSEC.DSRecords, "unexpected DS records")
+				}
+				condition := controllerutils.FindCondition(zone.Status.Conditions, hivev1.DNSSECSigningCondition)
+				if assert.NotNil(t, condition, "DNSSEC signing condition should be set on dnszone") {
+					assert.Equal(t, corev1.ConditionTrue, condition.Status, "unexpected DNSSEC signing condition status")
+				}
+			},
+		},
+		{
+			name:    "Existing zone, disable DNSSEC with published DS records",
+			dnsZone: validDNSZoneDisablingDNSSEC(),
+			setupAWSMock: func(expect *awsmock.MockClientMockRecorder) {
+				mockAWSZoneExists(expect, validDNSZoneDisablingDNSSEC())
+				mockExistingAWSTags(expect)
+				mockAWSGetNSRecord(expect)
+			},
+			validateZone: func(t *testing.T, zone *hivev1.DNSZone) {
+				if assert.NotNil(t, zone.Status.DNSSEC, "DNSSEC status should be kept until the DS records are removed") {
+					assert.Empty(t, zone.Status.DNSSEC.DSRecords, "DS records should be cleared")
+				}
+				assert.Equal(t, []string{testDSRecord}, zone.Status.PublishedDSRecords, "published DS records should be kept")
+			},
+		},
+		{
+			name: "Existing zone, disable DNSSEC with recently removed DS records",
+			dnsZone: func() *hivev1.DNSZone {
+				zone := validDNSZoneDisablingDNSSEC()
+				zone.Status.PublishedDSRecords = nil
+				zone.Status.Conditions[0].Status = corev1.ConditionFalse
+				return zone
+			}(),
+			setupAWSMock: func(expect *awsmock.MockClientMockRecorder) {
+				mockAWSZoneExists(expect, validDNSZoneDisablingDNSSEC())
+				mockExistingAWSTags(expect)
+				mockAWSGetNSRecord(expect)
+			},
+			validateZone: func(t *testing.T, zone *hivev1.DNSZone) {
+				assert.NotNil(t, zone.Status.DNSSEC, "DNSSEC status should be kept until the removed DS records expire")
+			},
+		},
+		{
+			name: "Existing zone, disable DNSSEC",
+			dnsZone: func() *hivev1.DNSZone {
+				zone := validDNSZoneDisablingDNSSEC()
+				zone.Status.PublishedDSRecords = nil
+				zone.Status.Conditions[0].Status = corev1.ConditionFalse
+				zone.Status.Conditions[0].LastTransitionTime = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+				return zone
+			}(),
+			setupAWSMock: func(expect *awsmock.MockClientMockRecorder) {
+				mockAWSZoneExists(expect, validDNSZoneDisablingDNSSEC())
+				mockExistingAWSTags(expect)
+				mockGetDNSSEC(expect, awsServeSignatureSigning, awsKeySigningKey(awsKeySigningKeyStatusActive))
+				expect.DisableHostedZoneDNSSEC(gomock.Any()).Return(&route53.DisableHostedZoneDNSSECOutput{}, nil)
+				expect.DeactivateKeySigningKey(gomock.Any()).Return(&route53.DeactivateKeySigningKeyOutput{}, nil)
+				expect.DeleteKeySigningKey(gomock.Any()).Return(&route53.DeleteKeySigningKeyOutput{}, nil)
+				mockAWSGetNSRecord(expect)
+			},
+			validateZone: func(t *testing.T, zone *hivev1.DNSZone) {
+				assert.Nil(t, zone.Status.DNSSEC, "DNSSEC status should be cleared")
+			},
+		},
+		{
+			name:    "Existing zone, create record",
+			dnsZone: validDNSZoneWithRecords(),
+			setupAWSMock: func(expect *awsmock.MockClientMockRecorder) {
+				mockAWSZoneExists(expect, validDNSZoneWithRecords())
+				mockExistingAWSTags(expect)
+				expect.ListResourceRecordSets(gomock.Any()).Return(&route53.ListResourceRecordSetsOutput{}, nil).Times(1)
+				expect.ChangeResourceRecordSets(gomock.Any()).Return(&route53.ChangeResourceRecordSetsOutput{}, nil).Times(1)
+				mockAWSGetNSRecord(expect)
+			},
+			validateZone: func(t *testing.T, zone *hivev1.DNSZone) {
+				assert.Equal(t, []hivev1.DNSRecordStatus{{
+					Name:  "www",
+					Type:  hivev1.DNSRecordTypeA,
+					Owned: true,
+					State: hivev1.DNSRecordStateSynced,
+				}}, zone.Status.Records, "unexpected record status")
+				condition := controllerutils.FindCondition(zone.Status.Conditions, hivev1.RecordsSyncedCondition)
+				if assert.NotNil(t, condition, "records synced condition should be set on dnszone") {
+					assert.Equal(t, corev1.ConditionTrue, condition.Status, "unexpected records synced condition status")
+				}
+			},
+		},
+		{
+			name: "Existing zone, record conflicts with existing record",
+			dnsZone: func() *hivev1.DNSZone {
+				zone := validDNSZoneWithRecords()
+				zone.Status.Conditions = []hivev1.DNSZoneCondition{{
+					Type:   hivev1.RecordsSyncedCondition,
+					Status: corev1.ConditionTrue,
+					Reason: "RecordsSynced",
+				}}
+				return zone
+			}(),
+			setupAWSMock: func(expect *awsmock.MockClientMockRecorder) {
+				mockAWSZoneExists(expect, validDNSZoneWithRecords())
+				mockExistingAWSTags(expect)
+				expect.ListResourceRecordSets(gomock.Any()).Return(&route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []*route53.ResourceRecordSet{{
+						Name:            aws.String("www.blah.example.com."),
+						Type:            aws.String("A"),
+						TTL:             aws.Int64(60),
+						ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("192.0.2.99")}},
+					}},
+				}, nil).Times(1)
+				mockAWSGetNSRecord(expect)
+			},
+			validateZone: func(t *testing.T, zone *hivev1.DNSZone) {
+				if assert.Len(t, zone.Status.Records, 1, "unexpected number of record statuses") {
+					assert.False(t, zone.Status.Records[0].Owned, "conflicting record should not be owned")
+					assert.Equal(t, hivev1.DNSRecordStateConflict, zone.Status.Records[0].State, "unexpected record state")
+				}
+				condition := controllerutils.FindCondition(zone.Status.Conditions, hivev1.RecordsSyncedCondition)
+				if assert.NotNil(t, condition, "records synced condition should be set on dnszone") {
+					assert.Equal(t, corev1.ConditionFalse, condition.Status, "unexpected records synced condition status")
+				}
+			},
+		},
+		{
+			name: "Existing zone, delete record removed from spec",
+			dnsZone: func() *hivev1.DNSZone {
+				zone := validDNSZone()
+				zone.Status.Records = []hivev1.DNSRecordStatus{{
+					Name:  "_acme-challenge",
+					Type:  hivev1.DNSRecordTypeTXT,
+					Owned: true,
+					State: hivev1.DNSRecordStateSynced,
+				}}
+				return zone
+			}(),
+			setupAWSMock: func(expect *awsmock.MockClientMockRecorder) {
+				mockAWSZoneExists(expect, validDNSZone())
+				mockExistingAWSTags(expect)
+				expect.ListResourceRecordSets(gomock.Any()).Return(&route53.ListResourceRecordSetsOutput{
+					ResourceRecordSets: []*route53.ResourceRecordSet{{
+						Name:            aws.String("_acme-challenge.blah.example.com."),
+						Type:            aws.String("TXT"),
+						TTL:             aws.Int64(60),
+						ResourceRecords: []*route53.ResourceRecord{{Value: aws.String(`"token"`)}},
+					}},
+				}, nil).Times(1)
+				expect.ChangeResourceRecordSets(gomock.Any()).
+					DoAndReturn(func(input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+						assert.Equal(t, route53.ChangeActionDelete, aws.StringValue(input.ChangeBatch.Changes[0].Action), "unexpected action")
+						return &route53.ChangeResourceRecordSetsOutput{}, nil
+					}).Times(1)
+				mockAWSGetNSRecord(expect)
+			},
+			validateZone: func(t *testing.T, zone *hivev1.DNSZone) {
+				assert.Empty(t, zone.Status.Records, "record status should be removed")
+			},
+		},
+		{
+			name: "Delete hosted zone with DNSSEC",
+			dnsZone: func() *hivev1.DNSZone {
+				zone := validDNSZoneBeingDeleted()
+				zone.Spec.DNSSEC = validDNSZoneWithDNSSEC().Spec.DNSSEC
+				return zone
+			}(),
+			setupAWSMock: func(expect *awsmock.MockClientMockRecorder) {
+				mockAWSZoneExists(expect, validDNSZoneWithAdditionalTags())
+				mockExistingAWSTags(expect)
+				mockGetDNSSEC(expect, awsServeSignatureSigning, awsKeySigningKey(awsKeySigningKeyStatusActive))
+				expect.DisableHostedZoneDNSSEC(gomock.Any()).Return(&route53.DisableHostedZoneDNSSECOutput{}, nil)
+				expect.DeactivateKeySigningKey(gomock.Any()).Return(&route53.DeactivateKeySigningKeyOutput{}, nil)
+				expect.DeleteKeySigningKey(gomock.Any()).Return(&route53.DeleteKeySigningKeyOutput{}, nil)
+				mockDeleteAWSZone(expect)
+			},
+			expectZoneDeleted: true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -509,6 +703,340 @@ func TestReconcileDNSProviderForAzure(t *testing.T) {
 	}
 }
 
+// TestReconcileDNSProviderForIBMCloud tests that ReconcileDNSProvider reacts properly under different reconciliation states on IBM Cloud.
+func TestReconcileDNSProviderForIBMCloud(t *testing.T) {
+
+	log.SetLevel(log.DebugLevel)
+
+	cases := []struct {
+		name              string
+		dnsZone           *hivev1.DNSZone
+		setupIBMCloudMock func(*ibmmock.MockAPIMockRecorder)
+		expectZoneDeleted bool
+		validateZone      func(*testing.T, *hivev1.DNSZone)
+		errorExpected     bool
+	}{
+		{
+			name:    "Create managed zone",
+			dnsZone: validIBMCloudDNSZone(),
+			setupIBMCloudMock: func(expect *ibmmock.MockAPIMockRecorder) {
+				mockIBMCloudGetParentZone(expect)
+				mockIBMCloudZoneDoesntExist(expect)
+				mockCreateIBMCloudZone(expect)
+			},
+			validateZone: func(t *testing.T, zone *hivev1.DNSZone) {
+				assert.Equal(t, []string{"ns1.example.com", "ns2.example.com"}, zone.Status.NameServers, "nameservers must be set in status")
+				if assert.NotNil(t, zone.Status.IBMCloud, "IBM Cloud status must be set") {
+					assert.Equal(t, testCISInstanceCRN, *zone.Status.IBMCloud.CISInstanceCRN, "unexpected CIS instance CRN in status")
+					assert.Equal(t, "zoneid", *zone.Status.IBMCloud.ZoneID, "unexpected zone ID in status")
+				}
+			},
+		},
+		{
+			name:    "Adopt existing zone",
+			dnsZone: validIBMCloudDNSZone(),
+			setupIBMCloudMock: func(expect *ibmmock.MockAPIMockRecorder) {
+				mockIBMCloudGetParentZone(expect)
+				mockIBMCloudZoneExists(expect)
+			},
+			validateZone: func(t *testing.T, zone *hivev1.DNSZone) {
+				assert.Equal(t, []string{"ns1.example.com", "ns2.example.com"}, zone.Status.NameServers, "nameservers must be set in status")
+			},
+		},
+		{
+			name: "Delete managed zone",
+			dnsZone: func() *hivev1.DNSZone {
+				zone := validIBMCloudDNSZone()
+				zone.DeletionTimestamp = kubeTimeNow
+				return zone
+			}(),
+			setupIBMCloudMock: func(expect *ibmmock.MockAPIMockRecorder) {
+				mockIBMCloudGetParentZone(expect)
+				mockIBMCloudZoneExists(expect)
+				mockDeleteIBMCloudZone(expect)
+			},
+			expectZoneDeleted: true,
+		},
+		{
+			name: "Delete non-existent managed zone",
+			dnsZone: func() *hivev1.DNSZone {
+				zone := validIBMCloudDNSZone()
+				zone.DeletionTimestamp = kubeTimeNow
+				return zone
+			}(),
+			setupIBMCloudMock: func(expect *ibmmock.MockAPIMockRecorder) {
+				mockIBMCloudGetParentZone(expect)
+				mockIBMCloudZoneDoesntExist(expect)
+			},
+			expectZoneDeleted: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mocks := setupDefaultMocks(t, tc.dnsZone)
+
+			zr, _ := NewIBMCloudActuator(
+				log.WithField("controller", ControllerName),
+				validIBMCloudSecret(),
+				tc.dnsZone,
+				fakeIBMCloudClientBuilder(mocks.mockIBMCloudClient),
+			)
+
+			r := ReconcileDNSZone{
+				Client: mocks.fakeKubeClient,
+				logger: zr.logger,
+			}
+
+			r.soaLookup = func(string, log.FieldLogger) (bool, error) {
+				return true, nil
+			}
+
+			if tc.setupIBMCloudMock != nil {
+				tc.setupIBMCloudMock(mocks.mockIBMCloudClient.EXPECT())
+			}
+
+			// Act
+			_, err := r.reconcileDNSProvider(zr, tc.dnsZone, zr.logger)
+
+			// Assert
+			if tc.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// Validate
+			zone := &hivev1.DNSZone{}
+			err = mocks.fakeKubeClient.Get(context.TODO(), types.NamespacedName{Namespace: tc.dnsZone.Namespace, Name: tc.dnsZone.Name}, zone)
+			if tc.expectZoneDeleted {
+				assert.True(t, apierrors.IsNotFound(err), "expected DNSZone to be deleted")
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected: %v", err)
+			}
+			if tc.validateZone != nil {
+				tc.validateZone(t, zone)
+			}
+		})
+	}
+}
+
+// TestReconcileDNSProviderForAlibabaCloud tests that ReconcileDNSProvider reacts properly under different reconciliation states on Alibaba Cloud.
+func TestReconcileDNSProviderForAlibabaCloud(t *testing.T) {
+
+	log.SetLevel(log.DebugLevel)
+
+	cases := []struct {
+		name                  string
+		dnsZone               *hivev1.DNSZone
+		setupAlibabaCloudMock func(*alibabamock.MockAPIMockRecorder)
+		expectZoneDeleted     bool
+		validateZone          func(*testing.T, *hivev1.DNSZone)
+		errorExpected         bool
+	}{
+		{
+			name:    "Create managed zone",
+			dnsZone: validAlibabaCloudDNSZone(),
+			setupAlibabaCloudMock: func(expect *alibabamock.MockAPIMockRecorder) {
+				mockAlibabaCloudZoneDoesntExist(expect)
+				mockCreateAlibabaCloudZone(expect)
+			},
+			validateZone: func(t *testing.T, zone *hivev1.DNSZone) {
+				assert.Equal(t, []string{"ns1.example.com", "ns2.example.com"}, zone.Status.NameServers, "nameservers must be set in status")
+			},
+		},
+		{
+			name:    "Adopt existing zone",
+			dnsZone: validAlibabaCloudDNSZone(),
+			setupAlibabaCloudMock: func(expect *alibabamock.MockAPIMockRecorder) {
+				mockAlibabaCloudZoneExists(expect)
+			},
+			validateZone: func(t *testing.T, zone *hivev1.DNSZone) {
+				assert.Equal(t, []string{"ns1.example.com", "ns2.example.com"}, zone.Status.NameServers, "nameservers must be set in status")
+			},
+		},
+		{
+			name: "Delete managed zone",
+			dnsZone: func() *hivev1.DNSZone {
+				zone := validAlibabaCloudDNSZone()
+				zone.DeletionTimestamp = kubeTimeNow
+				return zone
+			}(),
+			setupAlibabaCloudMock: func(expect *alibabamock.MockAPIMockRecorder) {
+				mockAlibabaCloudZoneExists(expect)
+				mockDeleteAlibabaCloudZone(expect)
+			},
+			expectZoneDeleted: true,
+		},
+		{
+			name: "Delete non-existent managed zone",
+			dnsZone: func() *hivev1.DNSZone {
+				zone := validAlibabaCloudDNSZone()
+				zone.DeletionTimestamp = kubeTimeNow
+				return zone
+			}(),
+			setupAlibabaCloudMock: func(expect *alibabamock.MockAPIMockRecorder) {
+				mockAlibabaCloudZoneDoesntExist(expect)
+			},
+			expectZoneDeleted: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mocks := setupDefaultMocks(t, tc.dnsZone)
+
+			zr, _ := NewAlibabaCloudActuator(
+				log.WithField("controller", ControllerName),
+				validAlibabaCloudSecret(),
+				tc.dnsZone,
+				fakeAlibabaCloudClientBuilder(mocks.mockAlibabaCloudClient),
+			)
+
+			r := ReconcileDNSZone{
+				Client: mocks.fakeKubeClient,
+				logger: zr.logger,
+			}
+
+			r.soaLookup = func(string, log.FieldLogger) (bool, error) {
+				return true, nil
+			}
+
+			if tc.setupAlibabaCloudMock != nil {
+				tc.setupAlibabaCloudMock(mocks.mockAlibabaCloudClient.EXPECT())
+			}
+
+			// Act
+			_, err := r.reconcileDNSProvider(zr, tc.dnsZone, zr.logger)
+
+			// Assert
+			if tc.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// Validate
+			zone := &hivev1.DNSZone{}
+			err = mocks.fakeKubeClient.Get(context.TODO(), types.NamespacedName{Namespace: tc.dnsZone.Namespace, Name: tc.dnsZone.Name}, zone)
+			if tc.expectZoneDeleted {
+				assert.True(t, apierrors.IsNotFound(err), "expected DNSZone to be deleted")
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected: %v", err)
+			}
+			if tc.validateZone != nil {
+				tc.validateZone(t, zone)
+			}
+		})
+	}
+}
+
+// TestReconcileDNSProviderForRFC2136 tests that ReconcileDNSProvider reacts properly under different reconciliation states with RFC 2136.
+func TestReconcileDNSProviderForRFC2136(t *testing.T) {
+
+	log.SetLevel(log.DebugLevel)
+
+	cases := []struct {
+		name              string
+		dnsZone           *hivev1.DNSZone
+		setupRFC2136Mock  func(*rfc2136mock.MockClientMockRecorder)
+		expectZoneDeleted bool
+		validateZone      func(*testing.T, *hivev1.DNSZone)
+		errorExpected     bool
+	}{
+		{
+			name:    "Existing zone",
+			dnsZone: validRFC2136DNSZone(),
+			setupRFC2136Mock: func(expect *rfc2136mock.MockClientMockRecorder) {
+				mockRFC2136ZoneExists(expect)
+				mockRFC2136GetNSRecords(expect)
+			},
+			validateZone: func(t *testing.T, zone *hivev1.DNSZone) {
+				assert.Equal(t, []string{"ns1.example.com", "ns2.example.com"}, zone.Status.NameServers, "nameservers must be set in status")
+			},
+		},
+		{
+			name:    "Zone not on DNS server",
+			dnsZone: validRFC2136DNSZone(),
+			setupRFC2136Mock: func(expect *rfc2136mock.MockClientMockRecorder) {
+				mockRFC2136ZoneDoesntExist(expect)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "Delete zone",
+			dnsZone: func() *hivev1.DNSZone {
+				zone := validRFC2136DNSZone()
+				zone.DeletionTimestamp = kubeTimeNow
+				zone.Status.Records = []hivev1.DNSRecordStatus{
+					{Name: "*.apps", Type: hivev1.DNSRecordTypeA, Owned: true, State: hivev1.DNSRecordStateSynced},
+					{Name: "api", Type: hivev1.DNSRecordTypeA, State: hivev1.DNSRecordStateConflict},
+				}
+				return zone
+			}(),
+			setupRFC2136Mock: func(expect *rfc2136mock.MockClientMockRecorder) {
+				mockRFC2136ZoneExists(expect)
+				mockDeleteRFC2136Records(expect)
+			},
+			expectZoneDeleted: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mocks := setupDefaultMocks(t, tc.dnsZone)
+
+			zr, _ := NewRFC2136Actuator(
+				log.WithField("controller", ControllerName),
+				validRFC2136Secret(),
+				tc.dnsZone,
+				fakeRFC2136ClientBuilder(mocks.mockRFC2136Client),
+			)
+
+			r := ReconcileDNSZone{
+				Client: mocks.fakeKubeClient,
+				logger: zr.logger,
+			}
+
+			r.soaLookup = func(string, log.FieldLogger) (bool, error) {
+				return true, nil
+			}
+
+			if tc.setupRFC2136Mock != nil {
+				tc.setupRFC2136Mock(mocks.mockRFC2136Client.EXPECT())
+			}
+
+			// Act
+			_, err := r.reconcileDNSProvider(zr, tc.dnsZone, zr.logger)
+
+			// Assert
+			if tc.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// Validate
+			zone := &hivev1.DNSZone{}
+			err = mocks.fakeKubeClient.Get(context.TODO(), types.NamespacedName{Namespace: tc.dnsZone.Namespace, Name: tc.dnsZone.Name}, zone)
+			if tc.expectZoneDeleted {
+				assert.True(t, apierrors.IsNotFound(err), "expected DNSZone to be deleted")
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected: %v", err)
+			}
+			if tc.validateZone != nil {
+				tc.validateZone(t, zone)
+			}
+		})
+	}
+}
+
 // TestReconcileDNSProviderForAWSWithConditions tests that expected conditions are set after calling ReconcileDNSProvider for AWS
 func TestReconcileDNSProviderForAWSWithConditions(t *testing.T) {
 	log.SetLevel(log.DebugLevel)
@@ -1114,3 +1642,101 @@ func testOptInRequiredError() error {
 		fmt.Errorf("The AWS Access Key Id needs a subscription for the service"))
 	return optInReqErr
 }
+
+func TestQuoteTXT(t *testing.T) {
+	long := strings.Repeat("a", 300)
+	cases := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{
+			name:     "simple value",
+			value:    "token",
+			expected: `"token"`,
+		},
+		{
+			name:     "value with quotes",
+			value:    `v="1" \ end`,
+			expected: `"v=\"1\" \\ end"`,
+		},
+		{
+			name:     "long value",
+			value:    long,
+			expected: `"` + long[:255] + `" "` + long[255:] + `"`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			quoted := quoteTXT(tc.value)
+			assert.Equal(t, tc.expected, quoted, "unexpected quoted value")
+			assert.Equal(t, tc.value, unquoteTXT(quoted), "unexpected unquoted value")
+		})
+	}
+}
+
+type fakeRecordActuator struct {
+	records map[string]*hivev1.DNSRecord
+	calls   *[]string
+}
+
+func (a *fakeRecordActuator) GetRecord(name string, recordType hivev1.DNSRecordType) (*hivev1.DNSRecord, error) {
+	return a.records[recordKey(name, recordType)], nil
+}
+
+func (a *fakeRecordActuator) UpsertRecord(record *hivev1.DNSRecord) error {
+	*a.calls = append(*a.calls, "upsert")
+	a.records[recordKey(record.Name, record.Type)] = record
+	return nil
+}
+
+func (a *fakeRecordActuator) DeleteRecord(record *hivev1.DNSRecord) error {
+	delete(a.records, recordKey(record.Name, record.Type))
+	return nil
+}
+
+func TestSyncRecordClaimsOwnership(t *testing.T) {
+	record := hivev1.DNSRecord{Name: "www", Type: hivev1.DNSRecordTypeA, Values: []string{"192.0.2.1"}}
+	cases := []struct {
+		name          string
+		owned         bool
+		claimErr      error
+		expectedCalls []string
+		expectedState hivev1.DNSRecordState
+		expectedOwned bool
+	}{
+		{
+			name:          "new record is claimed before it is created",
+			expectedCalls: []string{"claim", "upsert"},
+			expectedState: hivev1.DNSRecordStateSynced,
+			expectedOwned: true,
+		},
+		{
+			name:          "owned record is not claimed again",
+			owned:         true,
+			expectedCalls: []string{"upsert"},
+			expectedState: hivev1.DNSRecordStateSynced,
+			expectedOwned: true,
+		},
+		{
+			name:          "record is not created when claim fails",
+			claimErr:      errors.New("conflict"),
+			expectedCalls: []string{"claim"},
+			expectedState: hivev1.DNSRecordStateFailed,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls []string
+			actuator := &fakeRecordActuator{records: map[string]*hivev1.DNSRecord{}, calls: &calls}
+			claim := func() error {
+				calls = append(calls, "claim")
+				return tc.claimErr
+			}
+			status := syncRecord(actuator, "blah.example.com", record, tc.owned, claim, log.WithField("controller", "dnszone"))
+			assert.Equal(t, tc.expectedCalls, calls, "unexpected calls")
+			assert.Equal(t, tc.expectedState, status.State, "unexpected record state")
+			assert.Equal(t, tc.expectedOwned, status.Owned, "unexpected record ownership")
+		})
+	}
+}
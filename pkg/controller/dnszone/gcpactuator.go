@@ -1,6 +1,7 @@
 package dnszone
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -18,6 +19,30 @@ import (
 
 const (
 	zoneNotEmptyReason = "containerNotEmpty"
+
+	gcpDNSSECStateOn        = "on"
+	gcpDNSSECStateOff       = "off"
+	gcpKeySigningKeyType    = "keySigning"
+	gcpKeySigningKeyActive  = "active"
+	gcpKeySigningKeyPending = "pending"
+)
+
+var (
+	// gcpDNSSECAlgorithms maps the GCP names of the DNSSEC algorithms to their DNS security algorithm numbers
+	gcpDNSSECAlgorithms = map[string]int{
+		"rsasha1":         5,
+		"rsasha256":       8,
+		"rsasha512":       10,
+		"ecdsap256sha256": 13,
+		"ecdsap384sha384": 14,
+	}
+
+	// gcpDNSSECDigestTypes maps the GCP names of the DS digest types to their digest type numbers
+	gcpDNSSECDigestTypes = map[string]int{
+		"sha1":   1,
+		"sha256": 2,
+		"sha384": 4,
+	}
 )
 
 // GCPActuator attempts to make the current state reflect the given desired state.
@@ -64,6 +89,12 @@ func NewGCPActuator(
 // Ensure GCPActuator implements the Actuator interface. This will fail at compile time when false.
 var _ Actuator = &GCPActuator{}
 
+// Ensure GCPActuator implements the DNSSECActuator interface. This will fail at compile time when false.
+var _ DNSSECActuator = &GCPActuator{}
+
+// Ensure GCPActuator implements the RecordActuator interface. This will fail at compile time when false.
+var _ RecordActuator = &GCPActuator{}
+
 // Create implements the Create call of the actuator interface
 func (a *GCPActuator) Create() error {
 	logger := a.logger.WithField("zone", a.dnsZone.Spec.Zone)
@@ -72,7 +103,7 @@ func (a *GCPActuator) Create() error {
 	zone := a.dnsZone.Spec.Zone
 	managedZone, err := a.gcpClient.CreateManagedZone(
 		&dns.ManagedZone{
-			Name:        generateManagedZoneName(zone),
+			Name:        gcpclient.ManagedZoneName(zone),
 			Description: managedByHiveDescription,
 			DnsName:     controllerutils.Dotted(zone),
 		},
@@ -204,7 +235,7 @@ func (a *GCPActuator) Refresh() error {
 
 	if len(zoneName) == 0 {
 		a.logger.Debug("Zone Name is not set in status, looking up by generated name")
-		zoneName = generateManagedZoneName(a.dnsZone.Spec.Zone)
+		zoneName = gcpclient.ManagedZoneName(a.dnsZone.Spec.Zone)
 	}
 
 	// Fetch the managed zone
@@ -234,6 +265,181 @@ func (a *GCPActuator) Refresh() error {
 	return nil
 }
 
+// EnableDNSSEC turns on managed DNSSEC for the managed zone and returns the DS records of its active key-signing key.
+func (a *GCPActuator) EnableDNSSEC() (string, []string, error) {
+	if a.managedZone == nil {
+		return "", nil, errors.New("managedZone is unpopulated")
+	}
+
+	logger := a.logger.WithField("zoneName", a.managedZone.Name)
+	if a.managedZone.DnssecConfig == nil || a.managedZone.DnssecConfig.State != gcpDNSSECStateOn {
+		logger.Info("Enabling DNSSEC for managed zone")
+		if err := a.gcpClient.PatchManagedZone(a.managedZone.Name, &dns.ManagedZone{
+			DnssecConfig: &dns.ManagedZoneDnsSecConfig{State: gcpDNSSECStateOn},
+		}); err != nil {
+			logger.WithError(err).Error("Cannot enable DNSSEC for managed zone")
+			return "", nil, err
+		}
+	}
+
+	logger.Debug("Listing DNS keys of managed zone")
+	keys, err := a.gcpClient.ListDNSKeys(a.managedZone.Name)
+	if err != nil {
+		logger.WithError(err).Error("Cannot list DNS keys of managed zone")
+		return "", nil, err
+	}
+	for _, key := range keys {
+		if key.Type != gcpKeySigningKeyType || !key.IsActive {
+			continue
+		}
+		dsRecords, err := gcpDSRecords(key)
+		if err != nil {
+			return "", nil, err
+		}
+		return gcpKeySigningKeyActive, dsRecords, nil
+	}
+	logger.Info("No active key-signing key found for managed zone yet")
+	return gcpKeySigningKeyPending, nil, nil
+}
+
+// DisableDNSSEC turns off managed DNSSEC for the managed zone.
+func (a *GCPActuator) DisableDNSSEC() error {
+	if a.managedZone == nil {
+		return errors.New("managedZone is unpopulated")
+	}
+	if a.managedZone.DnssecConfig == nil || a.managedZone.DnssecConfig.State == gcpDNSSECStateOff {
+		return nil
+	}
+
+	logger := a.logger.WithField("zoneName", a.managedZone.Name)
+	logger.Info("Disabling DNSSEC for managed zone")
+	if err := a.gcpClient.PatchManagedZone(a.managedZone.Name, &dns.ManagedZone{
+		DnssecConfig: &dns.ManagedZoneDnsSecConfig{State: gcpDNSSECStateOff},
+	}); err != nil {
+		logger.WithError(err).Error("Cannot disable DNSSEC for managed zone")
+		return err
+	}
+	return nil
+}
+
+// GetRecord returns the record with the specified name and type from the managed zone.
+func (a *GCPActuator) GetRecord(name string, recordType hivev1.DNSRecordType) (*hivev1.DNSRecord, error) {
+	recordSet, err := a.getRecordSet(name, recordType)
+	if err != nil || recordSet == nil {
+		return nil, err
+	}
+	record := &hivev1.DNSRecord{
+		Name: name,
+		Type: recordType,
+		TTL:  recordSet.Ttl,
+	}
+	for _, value := range recordSet.Rrdatas {
+		if recordType == hivev1.DNSRecordTypeTXT {
+			value = unquoteTXT(value)
+		}
+		record.Values = append(record.Values, value)
+	}
+	return record, nil
+}
+
+// UpsertRecord creates the record in the managed zone, or replaces the existing record with the same name and type.
+func (a *GCPActuator) UpsertRecord(record *hivev1.DNSRecord) error {
+	current, err := a.getRecordSet(record.Name, record.Type)
+	if err != nil {
+		return err
+	}
+
+	logger := a.logger.WithField("zoneName", a.managedZone.Name).WithField("record", record.Name).WithField("type", record.Type)
+	if current == nil {
+		logger.Debug("Adding record to managed zone")
+		err = a.gcpClient.AddResourceRecordSet(a.managedZone.Name, gcpRecordSet(record))
+	} else {
+		logger.Debug("Updating record in managed zone")
+		err = a.gcpClient.UpdateResourceRecordSet(a.managedZone.Name, gcpRecordSet(record), current)
+	}
+	if err != nil {
+		logger.WithError(err).Error("Cannot change record in managed zone")
+		return err
+	}
+	return nil
+}
+
+// DeleteRecord deletes the record from the managed zone.
+func (a *GCPActuator) DeleteRecord(record *hivev1.DNSRecord) error {
+	if a.managedZone == nil {
+		return errors.New("managedZone is unpopulated")
+	}
+
+	logger := a.logger.WithField("zoneName", a.managedZone.Name).WithField("record", record.Name).WithField("type", record.Type)
+	logger.Debug("Deleting record from managed zone")
+	if err := a.gcpClient.DeleteResourceRecordSet(a.managedZone.Name, gcpRecordSet(record)); err != nil {
+		logger.WithError(err).Error("Cannot delete record from managed zone")
+		return err
+	}
+	return nil
+}
+
+func (a *GCPActuator) getRecordSet(name string, recordType hivev1.DNSRecordType) (*dns.ResourceRecordSet, error) {
+	if a.managedZone == nil {
+		return nil, errors.New("managedZone is unpopulated")
+	}
+
+	logger := a.logger.WithField("zoneName", a.managedZone.Name).WithField("record", name).WithField("type", recordType)
+	logger.Debug("Listing managed zone records")
+	listOutput, err := a.gcpClient.ListResourceRecordSets(a.managedZone.Name, gcpclient.ListResourceRecordSetsOptions{
+		MaxResults: 1,
+		Name:       controllerutils.Dotted(name),
+		Type:       string(recordType),
+	})
+	if err != nil {
+		logger.WithError(err).Error("Cannot list managed zone records")
+		return nil, err
+	}
+	if len(listOutput.Rrsets) == 0 {
+		return nil, nil
+	}
+	return listOutput.Rrsets[0], nil
+}
+
+// gcpRecordSet returns the GCP record set for the record. Cloud DNS requires CNAME values to be fully qualified.
+func gcpRecordSet(record *hivev1.DNSRecord) *dns.ResourceRecordSet {
+	recordSet := &dns.ResourceRecordSet{
+		Name: controllerutils.Dotted(record.Name),
+		Type: string(record.Type),
+		Ttl:  record.TTL,
+	}
+	for _, value := range record.Values {
+		switch record.Type {
+		case hivev1.DNSRecordTypeCNAME:
+			value = controllerutils.Dotted(value)
+		case hivev1.DNSRecordTypeTXT:
+			value = quoteTXT(value)
+		}
+		recordSet.Rrdatas = append(recordSet.Rrdatas, value)
+	}
+	return recordSet
+}
+
+// gcpDSRecords returns the DS records for the digests of the specified key in presentation format.
+func gcpDSRecords(key *dns.DnsKey) ([]string, error) {
+	algorithm, ok := gcpDNSSECAlgorithms[key.Algorithm]
+	if !ok {
+		return nil, fmt.Errorf("unsupported DNSSEC algorithm %q", key.Algorithm)
+	}
+	var records []string
+	for _, digest := range key.Digests {
+		digestType, ok := gcpDNSSECDigestTypes[digest.Type]
+		if !ok {
+			continue
+		}
+		records = append(records, fmt.Sprintf("%d %d %d %s", key.KeyTag, algorithm, digestType, strings.ToUpper(digest.Digest)))
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no supported digests found for key-signing key %d", key.KeyTag)
+	}
+	return records, nil
+}
+
 // SetConditionsForError sets conditions on the dnszone given a specific error. Returns true if conditions changed.
 func (a *GCPActuator) SetConditionsForError(err error) bool {
 	// other conditions not implemented for GCP yet, so set generic condition
@@ -263,9 +469,3 @@ func (a *GCPActuator) SetConditionsForError(err error) bool {
 	}
 	return cloudErrorsCondsChanged
 }
-
-func generateManagedZoneName(zone string) string {
-	tmp := strings.ToLower(zone)
-	tmp = strings.ReplaceAll(tmp, ".", "-")
-	return "hive-" + tmp
-}
@@ -53,6 +53,130 @@ func TestNewGCPActuator(t *testing.T) {
 	}
 }
 
+// TestGCPEnableDNSSEC tests that managed DNSSEC is turned on and the DS records of the active key-signing key are returned.
+func TestGCPEnableDNSSEC(t *testing.T) {
+	cases := []struct {
+		name              string
+		dnssecState       string
+		keys              []*dns.DnsKey
+		expectPatch       bool
+		expectedKeyState  string
+		expectedDSRecords []string
+	}{
+		{
+			name:             "turn on DNSSEC",
+			expectPatch:      true,
+			expectedKeyState: gcpKeySigningKeyPending,
+		},
+		{
+			name:        "active key-signing key",
+			dnssecState: gcpDNSSECStateOn,
+			keys: []*dns.DnsKey{
+				{Type: "zoneSigning", IsActive: true, KeyTag: 1111, Algorithm: "rsasha256"},
+				{Type: gcpKeySigningKeyType, IsActive: false, KeyTag: 2222, Algorithm: "rsasha256"},
+				{
+					Type:      gcpKeySigningKeyType,
+					IsActive:  true,
+					KeyTag:    12345,
+					Algorithm: "rsasha256",
+					Digests: []*dns.DnsKeyDigest{
+						{Type: "sha256", Digest: "abcdef0123456789"},
+					},
+				},
+			},
+			expectedKeyState:  gcpKeySigningKeyActive,
+			expectedDSRecords: []string{"12345 8 2 ABCDEF0123456789"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			managedZone := &dns.ManagedZone{Name: "hive-blah-example-com"}
+			if tc.dnssecState != "" {
+				managedZone.DnssecConfig = &dns.ManagedZoneDnsSecConfig{State: tc.dnssecState}
+			}
+			zr := &GCPActuator{
+				logger:      log.WithField("controller", ControllerName),
+				gcpClient:   mocks.mockGCPClient,
+				dnsZone:     validDNSZone(),
+				managedZone: managedZone,
+			}
+			if tc.expectPatch {
+				mocks.mockGCPClient.EXPECT().PatchManagedZone("hive-blah-example-com", gomock.Any()).
+					DoAndReturn(func(_ string, patch *dns.ManagedZone) error {
+						assert.Equal(t, gcpDNSSECStateOn, patch.DnssecConfig.State, "unexpected DNSSEC state")
+						return nil
+					})
+			}
+			mocks.mockGCPClient.EXPECT().ListDNSKeys("hive-blah-example-com").Return(tc.keys, nil)
+
+			keyState, dsRecords, err := zr.EnableDNSSEC()
+			assert.NoError(t, err, "unexpected error")
+			assert.Equal(t, tc.expectedKeyState, keyState, "unexpected key state")
+			assert.Equal(t, tc.expectedDSRecords, dsRecords, "unexpected DS records")
+		})
+	}
+}
+
+func TestGCPUpsertRecord(t *testing.T) {
+	cases := []struct {
+		name          string
+		existing      []*dns.ResourceRecordSet
+		expectAdd     bool
+		expectUpdated bool
+	}{
+		{
+			name:      "add record",
+			expectAdd: true,
+		},
+		{
+			name: "update record",
+			existing: []*dns.ResourceRecordSet{{
+				Name:    "www.blah.example.com.",
+				Type:    "CNAME",
+				Ttl:     60,
+				Rrdatas: []string{"old.example.com."},
+			}},
+			expectUpdated: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			zr := &GCPActuator{
+				logger:      log.WithField("controller", ControllerName),
+				gcpClient:   mocks.mockGCPClient,
+				dnsZone:     validDNSZone(),
+				managedZone: &dns.ManagedZone{Name: "hive-blah-example-com"},
+			}
+			expected := &dns.ResourceRecordSet{
+				Name:    "www.blah.example.com.",
+				Type:    "CNAME",
+				Ttl:     300,
+				Rrdatas: []string{"lb.example.com."},
+			}
+			mocks.mockGCPClient.EXPECT().ListResourceRecordSets("hive-blah-example-com", gomock.Any()).
+				Return(&dns.ResourceRecordSetsListResponse{Rrsets: tc.existing}, nil)
+			if tc.expectAdd {
+				mocks.mockGCPClient.EXPECT().AddResourceRecordSet("hive-blah-example-com", expected).Return(nil)
+			}
+			if tc.expectUpdated {
+				mocks.mockGCPClient.EXPECT().UpdateResourceRecordSet("hive-blah-example-com", expected, tc.existing[0]).Return(nil)
+			}
+
+			err := zr.UpsertRecord(&hivev1.DNSRecord{
+				Name:   "www.blah.example.com",
+				Type:   hivev1.DNSRecordTypeCNAME,
+				TTL:    300,
+				Values: []string{"lb.example.com"},
+			})
+			assert.NoError(t, err, "unexpected error")
+		})
+	}
+}
+
 func mockGCPZoneExists(expect *mock.MockClientMockRecorder) {
 	expect.GetManagedZone(gomock.Any()).Return(&dns.ManagedZone{
 		DnsName:     "blah.example.com",
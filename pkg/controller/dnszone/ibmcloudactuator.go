@@ -0,0 +1,249 @@
+package dnszone
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/networking-go-sdk/zonesv1"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+	"github.com/openshift/hive/pkg/ibmclient"
+)
+
+// IBMCloudActuator attempts to make the current state reflect the given desired state.
+type IBMCloudActuator struct {
+	// logger is the logger used for this controller
+	logger log.FieldLogger
+
+	// ibmClient is a utility for making it easy for controllers to interface with IBM Cloud
+	ibmClient ibmclient.API
+
+	// dnsZone is the DNSZone that represents the desired state.
+	dnsZone *hivev1.DNSZone
+
+	// cisInstanceCRN is the CRN of the Cloud Internet Services instance hosting the zone.
+	cisInstanceCRN string
+
+	// managedZone is the IBM Cloud Internet Services zone object.
+	managedZone *zonesv1.ZoneDetails
+}
+
+type ibmCloudClientBuilderType func(secret *corev1.Secret) (ibmclient.API, error)
+
+// NewIBMCloudActuator creates a new IBMCloudActuator object. A new IBMCloudActuator is expected to be created for each controller sync.
+func NewIBMCloudActuator(
+	logger log.FieldLogger,
+	secret *corev1.Secret,
+	dnsZone *hivev1.DNSZone,
+	ibmCloudClientBuilder ibmCloudClientBuilderType,
+) (*IBMCloudActuator, error) {
+	ibmClient, err := ibmCloudClientBuilder(secret)
+	if err != nil {
+		logger.WithError(err).Error("Error creating IBMCloudClient")
+		return nil, err
+	}
+
+	ibmCloudActuator := &IBMCloudActuator{
+		logger:    logger,
+		ibmClient: ibmClient,
+		dnsZone:   dnsZone,
+	}
+
+	return ibmCloudActuator, nil
+}
+
+// Ensure IBMCloudActuator implements the Actuator interface. This will fail at compile time when false.
+var _ Actuator = &IBMCloudActuator{}
+
+// Create implements the Create call of the actuator interface
+func (a *IBMCloudActuator) Create() error {
+	logger := a.logger.WithField("zone", a.dnsZone.Spec.Zone).WithField("cisInstanceCRN", a.cisInstanceCRN)
+	logger.Info("Creating managed zone")
+
+	managedZone, err := a.ibmClient.CreateDNSZone(context.TODO(), a.cisInstanceCRN, a.dnsZone.Spec.Zone)
+	if err != nil {
+		logger.WithError(err).Error("Error creating managed zone")
+		return err
+	}
+
+	logger.Debug("Managed zone successfully created")
+	a.managedZone = managedZone
+	if err := a.modifyStatus(); err != nil {
+		logger.WithError(err).Error("failed to modify DNSZone status")
+		return err
+	}
+	return nil
+}
+
+// Delete implements the Delete call of the actuator interface
+func (a *IBMCloudActuator) Delete() error {
+	if a.managedZone == nil {
+		return errors.New("managedZone is unpopulated")
+	}
+
+	logger := a.logger.WithField("zone", a.dnsZone.Spec.Zone).WithField("zoneID", *a.managedZone.ID)
+
+	logger.Info("Deleting records in managed zone")
+	if err := DeleteIBMCloudRecords(a.ibmClient, a.cisInstanceCRN, *a.managedZone.ID, a.dnsZone, logger); err != nil {
+		return err
+	}
+
+	logger.Info("Deleting managed zone")
+	err := a.ibmClient.DeleteDNSZone(context.TODO(), a.cisInstanceCRN, *a.managedZone.ID)
+	if err != nil {
+		log.WithError(err).Error("Cannot delete managed zone")
+	}
+
+	return err
+}
+
+// DeleteIBMCloudRecords will remove all non-essential records from the DNSZone provided.
+func DeleteIBMCloudRecords(ibmClient ibmclient.API, cisInstanceCRN, zoneID string, dnsZone *hivev1.DNSZone, logger log.FieldLogger) error {
+	records, err := ibmClient.GetDNSRecords(context.TODO(), cisInstanceCRN, zoneID)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if record.ID == nil || record.Name == nil || record.Type == nil {
+			logger.Warn("found record with missing ID, name or type")
+			continue
+		}
+		// Ignore the name server records of the zone itself
+		if *record.Name == dnsZone.Spec.Zone && *record.Type == "NS" {
+			continue
+		}
+		logger.WithField("name", *record.Name).WithField("type", *record.Type).Info("deleting record")
+		if err := ibmClient.DeleteDNSRecord(context.TODO(), cisInstanceCRN, zoneID, *record.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Exists implements the Exists call of the actuator interface
+func (a *IBMCloudActuator) Exists() (bool, error) {
+	return a.managedZone != nil, nil
+}
+
+// GetNameServers implements the GetNameServers call of the actuator interface
+func (a *IBMCloudActuator) GetNameServers() ([]string, error) {
+	if a.managedZone == nil {
+		return nil, errors.New("managedZone is unpopulated")
+	}
+
+	logger := a.logger.WithField("zone", a.dnsZone.Spec.Zone)
+	result := a.managedZone.NameServers
+	logger.WithField("nameservers", result).Debug("found managed zone name servers")
+	return result, nil
+}
+
+// modifyStatus updates the DnsZone's status with IBM Cloud specific information.
+func (a *IBMCloudActuator) modifyStatus() error {
+	if a.managedZone == nil {
+		return errors.New("managedZone is unpopulated")
+	}
+
+	a.dnsZone.Status.IBMCloud = &hivev1.IBMCloudDNSZoneStatus{
+		CISInstanceCRN: &a.cisInstanceCRN,
+		ZoneID:         a.managedZone.ID,
+	}
+	return nil
+}
+
+// Refresh implements the Refresh call of the actuator interface
+func (a *IBMCloudActuator) Refresh() error {
+	cisInstanceCRN, err := a.getCISInstanceCRN()
+	if err != nil {
+		a.logger.WithError(err).Error("Cannot determine CIS instance for zone")
+		return err
+	}
+	a.cisInstanceCRN = cisInstanceCRN
+
+	// Fetch the managed zone
+	logger := a.logger.WithField("zone", a.dnsZone.Spec.Zone).WithField("cisInstanceCRN", cisInstanceCRN)
+	logger.Debug("Fetching managed zone by zone name")
+	managedZone, err := a.ibmClient.GetDNSZoneByName(context.TODO(), cisInstanceCRN, a.dnsZone.Spec.Zone)
+	if err != nil {
+		logger.WithError(err).Error("Cannot get managed zone")
+		return err
+	}
+	if managedZone == nil {
+		logger.Debug("Zone not found, clearing out the cached object")
+		a.managedZone = nil
+		return nil
+	}
+
+	logger.Debug("Found managed zone")
+	a.managedZone = managedZone
+	if err := a.modifyStatus(); err != nil {
+		logger.WithError(err).Error("failed to modify DNSZone status")
+		return err
+	}
+	return nil
+}
+
+// getCISInstanceCRN returns the CRN of the CIS instance hosting the zone. It is, in order of preference, the
+// instance recorded in the status, the instance specified in the spec, or the instance hosting the zone of the
+// closest parent domain.
+func (a *IBMCloudActuator) getCISInstanceCRN() (string, error) {
+	if a.dnsZone.Status.IBMCloud != nil && a.dnsZone.Status.IBMCloud.CISInstanceCRN != nil {
+		return *a.dnsZone.Status.IBMCloud.CISInstanceCRN, nil
+	}
+	if crn := a.dnsZone.Spec.IBMCloud.CISInstanceCRN; crn != "" {
+		return crn, nil
+	}
+	zones, err := a.ibmClient.GetDNSZones(context.TODO())
+	if err != nil {
+		return "", err
+	}
+	for domain := a.dnsZone.Spec.Zone; strings.Contains(domain, "."); {
+		domain = domain[strings.Index(domain, ".")+1:]
+		for _, z := range zones {
+			if z.Name == domain {
+				return z.CISInstanceCRN, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no CIS instance found hosting a parent domain of %s", a.dnsZone.Spec.Zone)
+}
+
+// UpdateMetadata implements the UpdateMetadata call of the actuator interface
+func (a *IBMCloudActuator) UpdateMetadata() error {
+	return nil
+}
+
+// SetConditionsForError sets conditions on the dnszone given a specific error. Returns true if conditions changed.
+func (a *IBMCloudActuator) SetConditionsForError(err error) bool {
+	// other conditions not implemented for IBM Cloud yet, so set generic condition
+	var cloudErrorsConds []hivev1.DNSZoneCondition
+	var cloudErrorsCondsChanged bool
+	if err == nil {
+		cloudErrorsConds, cloudErrorsCondsChanged = controllerutils.SetDNSZoneConditionWithChangeCheck(
+			a.dnsZone.Status.Conditions,
+			hivev1.GenericDNSErrorsCondition,
+			corev1.ConditionFalse,
+			dnsNoErrorReason,
+			"No cloud errors occurred",
+			controllerutils.UpdateConditionIfReasonOrMessageChange,
+		)
+	} else {
+		cloudErrorsConds, cloudErrorsCondsChanged = controllerutils.SetDNSZoneConditionWithChangeCheck(
+			a.dnsZone.Status.Conditions,
+			hivev1.GenericDNSErrorsCondition,
+			corev1.ConditionTrue,
+			dnsCloudErrorReason,
+			controllerutils.ErrorScrub(err),
+			controllerutils.UpdateConditionIfReasonOrMessageChange,
+		)
+	}
+	if cloudErrorsCondsChanged {
+		a.dnsZone.Status.Conditions = cloudErrorsConds
+	}
+	return cloudErrorsCondsChanged
+}
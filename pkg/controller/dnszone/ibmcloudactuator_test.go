@@ -0,0 +1,191 @@
+package dnszone
+
+import (
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/IBM/networking-go-sdk/zonesv1"
+	"github.com/golang/mock/gomock"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/ibmclient"
+	"github.com/openshift/hive/pkg/ibmclient/mock"
+)
+
+const (
+	testCISInstanceCRN = "crn:v1:bluemix:public:internet-svcs:global:a/1234:5678::"
+)
+
+// TestNewIBMCloudActuator tests that a new IBMCloudActuator object can be created.
+func TestNewIBMCloudActuator(t *testing.T) {
+	cases := []struct {
+		name    string
+		dnsZone *hivev1.DNSZone
+		secret  *corev1.Secret
+	}{
+		{
+			name:    "Successfully create new zone",
+			dnsZone: validIBMCloudDNSZone(),
+			secret:  validIBMCloudSecret(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mocks := setupDefaultMocks(t)
+			expectedIBMCloudActuator := &IBMCloudActuator{
+				logger:  log.WithField("controller", ControllerName),
+				dnsZone: tc.dnsZone,
+			}
+
+			// Act
+			zr, err := NewIBMCloudActuator(
+				expectedIBMCloudActuator.logger,
+				tc.secret,
+				tc.dnsZone,
+				fakeIBMCloudClientBuilder(mocks.mockIBMCloudClient),
+			)
+			expectedIBMCloudActuator.ibmClient = zr.ibmClient // Function pointers can't be compared reliably. Don't compare.
+
+			// Assert
+			assert.Nil(t, err)
+			assert.NotNil(t, zr.ibmClient)
+			assert.Equal(t, expectedIBMCloudActuator, zr)
+		})
+	}
+}
+
+// TestIBMCloudGetCISInstanceCRN tests that the CIS instance is determined from the status, the spec, or the zone of
+// the closest parent domain.
+func TestIBMCloudGetCISInstanceCRN(t *testing.T) {
+	cases := []struct {
+		name          string
+		dnsZone       func() *hivev1.DNSZone
+		zones         []ibmclient.DNSZoneResponse
+		expectedCRN   string
+		expectedError bool
+	}{
+		{
+			name: "from status",
+			dnsZone: func() *hivev1.DNSZone {
+				zone := validIBMCloudDNSZone()
+				zone.Spec.IBMCloud.CISInstanceCRN = "spec-crn"
+				zone.Status.IBMCloud = &hivev1.IBMCloudDNSZoneStatus{CISInstanceCRN: core.StringPtr("status-crn")}
+				return zone
+			},
+			expectedCRN: "status-crn",
+		},
+		{
+			name: "from spec",
+			dnsZone: func() *hivev1.DNSZone {
+				zone := validIBMCloudDNSZone()
+				zone.Spec.IBMCloud.CISInstanceCRN = "spec-crn"
+				return zone
+			},
+			expectedCRN: "spec-crn",
+		},
+		{
+			name:    "from closest parent domain",
+			dnsZone: validIBMCloudDNSZone,
+			zones: []ibmclient.DNSZoneResponse{
+				{Name: "com", CISInstanceCRN: "com-crn"},
+				{Name: "example.com", CISInstanceCRN: "example-crn"},
+				{Name: "blah.example.com", CISInstanceCRN: "self-crn"},
+			},
+			expectedCRN: "example-crn",
+		},
+		{
+			name:    "no parent domain",
+			dnsZone: validIBMCloudDNSZone,
+			zones: []ibmclient.DNSZoneResponse{
+				{Name: "example.org", CISInstanceCRN: "example-crn"},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			zr := &IBMCloudActuator{
+				logger:    log.WithField("controller", ControllerName),
+				ibmClient: mocks.mockIBMCloudClient,
+				dnsZone:   tc.dnsZone(),
+			}
+			if tc.zones != nil {
+				mocks.mockIBMCloudClient.EXPECT().GetDNSZones(gomock.Any()).Return(tc.zones, nil)
+			}
+
+			crn, err := zr.getCISInstanceCRN()
+			if tc.expectedError {
+				assert.Error(t, err, "expected error")
+				return
+			}
+			assert.NoError(t, err, "unexpected error")
+			assert.Equal(t, tc.expectedCRN, crn, "unexpected CIS instance CRN")
+		})
+	}
+}
+
+// TestDeleteIBMCloudRecords tests that all records other than the NS records at the apex of the zone are deleted.
+func TestDeleteIBMCloudRecords(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	mocks.mockIBMCloudClient.EXPECT().GetDNSRecords(gomock.Any(), testCISInstanceCRN, "zoneid").Return([]dnsrecordsv1.DnsrecordDetails{
+		ibmCloudRecord("1", "blah.example.com", "NS", "ns1.example.com"),
+		ibmCloudRecord("2", "blah.example.com", "TXT", "owner"),
+		ibmCloudRecord("3", "api.blah.example.com", "A", "10.0.0.1"),
+		ibmCloudRecord("4", "sub.blah.example.com", "NS", "ns3.example.com"),
+	}, nil)
+	for _, id := range []string{"2", "3", "4"} {
+		mocks.mockIBMCloudClient.EXPECT().DeleteDNSRecord(gomock.Any(), testCISInstanceCRN, "zoneid", id).Return(nil)
+	}
+
+	err := DeleteIBMCloudRecords(mocks.mockIBMCloudClient, testCISInstanceCRN, "zoneid", validIBMCloudDNSZone(), log.WithField("controller", ControllerName))
+	assert.NoError(t, err, "unexpected error deleting records")
+}
+
+func ibmCloudRecord(id, name, recordType, content string) dnsrecordsv1.DnsrecordDetails {
+	return dnsrecordsv1.DnsrecordDetails{
+		ID:      core.StringPtr(id),
+		Name:    core.StringPtr(name),
+		Type:    core.StringPtr(recordType),
+		Content: core.StringPtr(content),
+	}
+}
+
+func ibmCloudZone() *zonesv1.ZoneDetails {
+	return &zonesv1.ZoneDetails{
+		ID:          core.StringPtr("zoneid"),
+		Name:        core.StringPtr("blah.example.com"),
+		NameServers: []string{"ns1.example.com", "ns2.example.com"},
+	}
+}
+
+func mockIBMCloudGetParentZone(expect *mock.MockAPIMockRecorder) {
+	expect.GetDNSZones(gomock.Any()).Return([]ibmclient.DNSZoneResponse{
+		{Name: "example.com", ID: "parentzoneid", CISInstanceCRN: testCISInstanceCRN},
+	}, nil).Times(1)
+}
+
+func mockIBMCloudZoneExists(expect *mock.MockAPIMockRecorder) {
+	expect.GetDNSZoneByName(gomock.Any(), testCISInstanceCRN, "blah.example.com").Return(ibmCloudZone(), nil).Times(1)
+}
+
+func mockIBMCloudZoneDoesntExist(expect *mock.MockAPIMockRecorder) {
+	expect.GetDNSZoneByName(gomock.Any(), testCISInstanceCRN, "blah.example.com").Return(nil, nil).Times(1)
+}
+
+func mockCreateIBMCloudZone(expect *mock.MockAPIMockRecorder) {
+	expect.CreateDNSZone(gomock.Any(), testCISInstanceCRN, "blah.example.com").Return(ibmCloudZone(), nil).Times(1)
+}
+
+func mockDeleteIBMCloudZone(expect *mock.MockAPIMockRecorder) {
+	expect.GetDNSRecords(gomock.Any(), testCISInstanceCRN, "zoneid").Return(nil, nil).Times(1)
+	expect.DeleteDNSZone(gomock.Any(), testCISInstanceCRN, "zoneid").Return(nil).Times(1)
+}
@@ -0,0 +1,308 @@
+package dnszone
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/miekg/dns"
+	log "github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+	"github.com/openshift/hive/pkg/rfc2136client"
+)
+
+const (
+	// zoneNotConfiguredReason is the reason of the DNSError condition of a DNSZone whose zone is not configured on
+	// the DNS server.
+	zoneNotConfiguredReason = "ZoneNotConfigured"
+)
+
+// zoneNotConfiguredError is returned when the DNS server is not authoritative for the zone of a DNSZone. Zones cannot
+// be created with dynamic updates, so the zone must be configured on the DNS server before Hive can manage it.
+type zoneNotConfiguredError struct {
+	zone, server, keyName string
+}
+
+func (e *zoneNotConfiguredError) Error() string {
+	return fmt.Sprintf("zone %s must be configured on DNS server %s to allow updates and transfers with TSIG key %s; zones cannot be created with dynamic updates",
+		e.zone, e.server, e.keyName)
+}
+
+// RFC2136Actuator attempts to make the current state reflect the given desired state for a zone on a DNS server
+// managed with RFC 2136 dynamic updates.
+type RFC2136Actuator struct {
+	// logger is the logger used for this controller
+	logger log.FieldLogger
+
+	// rfc2136Client is a utility for making it easy for controllers to interface with the DNS server
+	rfc2136Client rfc2136client.Client
+
+	// dnsZone is the DNSZone that represents the desired state.
+	dnsZone *hivev1.DNSZone
+
+	// zoneExists is true if the DNS server is authoritative for the zone.
+	zoneExists bool
+}
+
+type rfc2136ClientBuilderType func(server, keyName string, algorithm hivev1.TSIGAlgorithm, secret *corev1.Secret) (rfc2136client.Client, error)
+
+// NewRFC2136Actuator creates a new RFC2136Actuator object. A new RFC2136Actuator is expected to be created for each controller sync.
+func NewRFC2136Actuator(
+	logger log.FieldLogger,
+	secret *corev1.Secret,
+	dnsZone *hivev1.DNSZone,
+	rfc2136ClientBuilder rfc2136ClientBuilderType,
+) (*RFC2136Actuator, error) {
+	spec := dnsZone.Spec.RFC2136
+	rfc2136Client, err := rfc2136ClientBuilder(spec.Server, spec.TSIGKeyName, spec.TSIGAlgorithm, secret)
+	if err != nil {
+		logger.WithError(err).Error("Error creating RFC2136Client")
+		return nil, err
+	}
+
+	return &RFC2136Actuator{
+		logger:        logger,
+		rfc2136Client: rfc2136Client,
+		dnsZone:       dnsZone,
+	}, nil
+}
+
+// Ensure RFC2136Actuator implements the Actuator interface. This will fail at compile time when false.
+var _ Actuator = &RFC2136Actuator{}
+
+// Create implements the Create call of the actuator interface. Zones cannot be created with dynamic updates, so
+// configuring the zone on the DNS server is a prerequisite of the DNSZone. Create reports that it is missing, which
+// sets the DNSError condition of the DNSZone with the ZoneNotConfigured reason.
+func (a *RFC2136Actuator) Create() error {
+	spec := a.dnsZone.Spec.RFC2136
+	return &zoneNotConfiguredError{zone: a.dnsZone.Spec.Zone, server: spec.Server, keyName: spec.TSIGKeyName}
+}
+
+// Delete implements the Delete call of the actuator interface. The zone itself cannot be deleted with dynamic
+// updates and is usually shared with records that Hive did not create, so only the records that Hive created are
+// removed.
+func (a *RFC2136Actuator) Delete() error {
+	logger := a.logger.WithField("zone", a.dnsZone.Spec.Zone)
+	logger.Info("Deleting records created by Hive in zone")
+	return DeleteRFC2136Records(a.rfc2136Client, a.dnsZone, logger)
+}
+
+// DeleteRFC2136Records will remove the records that Hive created, as recorded in the status of the DNSZone provided,
+// from its zone. All other records in the zone are left untouched.
+func DeleteRFC2136Records(rfc2136Client rfc2136client.Client, dnsZone *hivev1.DNSZone, logger log.FieldLogger) error {
+	var remove []dns.RR
+	for _, status := range dnsZone.Status.Records {
+		if !status.Owned {
+			continue
+		}
+		rrType, ok := dns.StringToType[string(status.Type)]
+		if !ok {
+			continue
+		}
+		name := dns.Fqdn(recordFQDN(status.Name, dnsZone.Spec.Zone))
+		logger.WithField("name", name).WithField("type", status.Type).Info("deleting recordset")
+		remove = append(remove, &dns.ANY{Hdr: dns.RR_Header{Name: name, Rrtype: rrType, Class: dns.ClassINET}})
+	}
+	if len(remove) == 0 {
+		return nil
+	}
+	return rfc2136Client.Update(dns.Fqdn(dnsZone.Spec.Zone), remove, nil)
+}
+
+// rfc2136ManagedDomain returns the RFC 2136 managed domain whose TSIG key is used for a DNSZone that does not
+// reference a TSIG key of its own. The zone must be a subdomain of the managed domain, managed on the same DNS server
+// with the same key, so that the key is not used for any zone or server that the managed domain could not be used for.
+func rfc2136ManagedDomain(managedDomains []hivev1.ManageDNSConfig, dnsZone *hivev1.DNSZone) *hivev1.ManageDNSRFC2136Config {
+	spec := dnsZone.Spec.RFC2136
+	zone := strings.ToLower(controllerutils.Undotted(dnsZone.Spec.Zone))
+	for _, md := range managedDomains {
+		if md.RFC2136 == nil || md.RFC2136.Server != spec.Server || md.RFC2136.TSIGKeyName != spec.TSIGKeyName {
+			continue
+		}
+		for _, domain := range md.Domains {
+			if strings.HasSuffix(zone, "."+strings.ToLower(controllerutils.Undotted(domain))) {
+				return md.RFC2136
+			}
+		}
+	}
+	return nil
+}
+
+// Exists implements the Exists call of the actuator interface
+func (a *RFC2136Actuator) Exists() (bool, error) {
+	return a.zoneExists, nil
+}
+
+// GetNameServers implements the GetNameServers call of the actuator interface
+func (a *RFC2136Actuator) GetNameServers() ([]string, error) {
+	if !a.zoneExists {
+		return nil, fmt.Errorf("zone %s does not exist on DNS server %s", a.dnsZone.Spec.Zone, a.dnsZone.Spec.RFC2136.Server)
+	}
+
+	logger := a.logger.WithField("zone", a.dnsZone.Spec.Zone)
+	records, err := a.rfc2136Client.Query(a.dnsZone.Spec.Zone, dns.TypeNS)
+	if err != nil {
+		logger.WithError(err).Error("Cannot get zone name servers")
+		return nil, err
+	}
+	var result []string
+	for _, rr := range records {
+		if ns, ok := rr.(*dns.NS); ok {
+			result = append(result, controllerutils.Undotted(ns.Ns))
+		}
+	}
+	sort.Strings(result)
+	logger.WithField("nameservers", result).Debug("found zone name servers")
+	return result, nil
+}
+
+// Refresh implements the Refresh call of the actuator interface
+func (a *RFC2136Actuator) Refresh() error {
+	logger := a.logger.WithField("zone", a.dnsZone.Spec.Zone)
+	logger.Debug("Checking whether the DNS server is authoritative for the zone")
+	exists, err := a.rfc2136Client.ZoneExists(a.dnsZone.Spec.Zone)
+	if err != nil {
+		logger.WithError(err).Error("Cannot query zone")
+		return err
+	}
+	a.zoneExists = exists
+	return nil
+}
+
+// UpdateMetadata implements the UpdateMetadata call of the actuator interface
+func (a *RFC2136Actuator) UpdateMetadata() error {
+	return nil
+}
+
+// Ensure RFC2136Actuator implements the RecordActuator interface. This will fail at compile time when false.
+var _ RecordActuator = &RFC2136Actuator{}
+
+// GetRecord returns the record with the specified name and type from the zone on the DNS server.
+func (a *RFC2136Actuator) GetRecord(name string, recordType hivev1.DNSRecordType) (*hivev1.DNSRecord, error) {
+	rrType, ok := dns.StringToType[string(recordType)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported record type %s", recordType)
+	}
+
+	logger := a.logger.WithField("zone", a.dnsZone.Spec.Zone).WithField("record", name).WithField("type", recordType)
+	logger.Debug("Querying record")
+	rrs, err := a.rfc2136Client.Query(name, rrType)
+	if err != nil {
+		logger.WithError(err).Error("Cannot query record")
+		return nil, err
+	}
+	if len(rrs) == 0 {
+		return nil, nil
+	}
+	record := &hivev1.DNSRecord{
+		Name: name,
+		Type: recordType,
+		TTL:  int64(rrs[0].Header().Ttl),
+	}
+	for _, rr := range rrs {
+		// The value of the record is its presentation format without the header.
+		value := strings.TrimPrefix(rr.String(), rr.Header().String())
+		if recordType == hivev1.DNSRecordTypeTXT {
+			value = unquoteTXT(value)
+		}
+		record.Values = append(record.Values, value)
+	}
+	return record, nil
+}
+
+// UpsertRecord replaces the record with the same name and type in the zone on the DNS server with the record.
+func (a *RFC2136Actuator) UpsertRecord(record *hivev1.DNSRecord) error {
+	rrs, err := rfc2136RRs(record)
+	if err != nil {
+		return err
+	}
+
+	logger := a.logger.WithField("zone", a.dnsZone.Spec.Zone).WithField("record", record.Name).WithField("type", record.Type)
+	logger.Debug("Replacing record")
+	if err := a.rfc2136Client.Update(a.dnsZone.Spec.Zone, rrs, rrs); err != nil {
+		logger.WithError(err).Error("Cannot replace record")
+		return err
+	}
+	return nil
+}
+
+// DeleteRecord deletes the record from the zone on the DNS server.
+func (a *RFC2136Actuator) DeleteRecord(record *hivev1.DNSRecord) error {
+	rrs, err := rfc2136RRs(record)
+	if err != nil {
+		return err
+	}
+
+	logger := a.logger.WithField("zone", a.dnsZone.Spec.Zone).WithField("record", record.Name).WithField("type", record.Type)
+	logger.Debug("Deleting record")
+	if err := a.rfc2136Client.Update(a.dnsZone.Spec.Zone, rrs, nil); err != nil {
+		logger.WithError(err).Error("Cannot delete record")
+		return err
+	}
+	return nil
+}
+
+// rfc2136RRs returns the resource records of the record. CNAME values are made fully qualified, as values without a
+// trailing dot would otherwise be relative to the zone.
+func rfc2136RRs(record *hivev1.DNSRecord) ([]dns.RR, error) {
+	var rrs []dns.RR
+	for _, value := range record.Values {
+		switch record.Type {
+		case hivev1.DNSRecordTypeTXT:
+			value = quoteTXT(value)
+		case hivev1.DNSRecordTypeCNAME:
+			value = dns.Fqdn(value)
+		}
+		rr, err := dns.NewRR(fmt.Sprintf("%s %d IN %s %s", dns.Fqdn(record.Name), record.TTL, record.Type, value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s record %s: %w", record.Type, record.Name, err)
+		}
+		rrs = append(rrs, rr)
+	}
+	return rrs, nil
+}
+
+// SetConditionsForError sets conditions on the dnszone given a specific error. Returns true if conditions changed.
+func (a *RFC2136Actuator) SetConditionsForError(err error) bool {
+	authenticationFailureStatus, authenticationFailureReason, authenticationFailureMessage :=
+		corev1.ConditionFalse, authenticationSucceededReason, "Credentials authenticated"
+	cloudErrorStatus, cloudErrorReason, cloudErrorMessage :=
+		corev1.ConditionFalse, dnsNoErrorReason, "No cloud errors occurred"
+	var notConfigured *zoneNotConfiguredError
+	switch {
+	case err == nil:
+	case errors.As(err, &notConfigured):
+		cloudErrorStatus, cloudErrorReason, cloudErrorMessage =
+			corev1.ConditionTrue, zoneNotConfiguredReason, err.Error()
+	case rfc2136client.IsAuthenticationError(err):
+		authenticationFailureStatus, authenticationFailureReason, authenticationFailureMessage =
+			corev1.ConditionTrue, authenticationFailedReason, controllerutils.ErrorScrub(err)
+	default:
+		cloudErrorStatus, cloudErrorReason, cloudErrorMessage =
+			corev1.ConditionTrue, dnsCloudErrorReason, controllerutils.ErrorScrub(err)
+	}
+
+	var authenticationFailureChanged, cloudErrorChanged bool
+	a.dnsZone.Status.Conditions, authenticationFailureChanged = controllerutils.SetDNSZoneConditionWithChangeCheck(
+		a.dnsZone.Status.Conditions,
+		hivev1.AuthenticationFailureCondition,
+		authenticationFailureStatus,
+		authenticationFailureReason,
+		authenticationFailureMessage,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+	)
+	a.dnsZone.Status.Conditions, cloudErrorChanged = controllerutils.SetDNSZoneConditionWithChangeCheck(
+		a.dnsZone.Status.Conditions,
+		hivev1.GenericDNSErrorsCondition,
+		cloudErrorStatus,
+		cloudErrorReason,
+		cloudErrorMessage,
+		controllerutils.UpdateConditionIfReasonOrMessageChange,
+	)
+	return authenticationFailureChanged || cloudErrorChanged
+}
@@ -0,0 +1,324 @@
+package dnszone
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/miekg/dns"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+	"github.com/openshift/hive/pkg/rfc2136client"
+	"github.com/openshift/hive/pkg/rfc2136client/mock"
+)
+
+// TestNewRFC2136Actuator tests that a new RFC2136Actuator object can be created.
+func TestNewRFC2136Actuator(t *testing.T) {
+	cases := []struct {
+		name    string
+		dnsZone *hivev1.DNSZone
+		secret  *corev1.Secret
+	}{
+		{
+			name:    "Successfully create new zone",
+			dnsZone: validRFC2136DNSZone(),
+			secret:  validRFC2136Secret(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mocks := setupDefaultMocks(t)
+			expectedRFC2136Actuator := &RFC2136Actuator{
+				logger:  log.WithField("controller", ControllerName),
+				dnsZone: tc.dnsZone,
+			}
+
+			// Act
+			zr, err := NewRFC2136Actuator(
+				expectedRFC2136Actuator.logger,
+				tc.secret,
+				tc.dnsZone,
+				fakeRFC2136ClientBuilder(mocks.mockRFC2136Client),
+			)
+			expectedRFC2136Actuator.rfc2136Client = zr.rfc2136Client // Function pointers can't be compared reliably. Don't compare.
+
+			// Assert
+			assert.Nil(t, err)
+			assert.NotNil(t, zr.rfc2136Client)
+			assert.Equal(t, expectedRFC2136Actuator, zr)
+		})
+	}
+}
+
+// TestDeleteRFC2136Records tests that only the records created by Hive are deleted.
+func TestDeleteRFC2136Records(t *testing.T) {
+	cases := []struct {
+		name           string
+		records        []hivev1.DNSRecordStatus
+		expectedRemove []string
+	}{
+		{
+			name: "no records",
+		},
+		{
+			name: "records not created by Hive",
+			records: []hivev1.DNSRecordStatus{
+				{Name: "api", Type: hivev1.DNSRecordTypeA, State: hivev1.DNSRecordStateConflict},
+			},
+		},
+		{
+			name: "records created by Hive",
+			records: []hivev1.DNSRecordStatus{
+				{Name: "*.apps", Type: hivev1.DNSRecordTypeCNAME, Owned: true, State: hivev1.DNSRecordStateSynced},
+				{Name: "api", Type: hivev1.DNSRecordTypeA, State: hivev1.DNSRecordStateConflict},
+				{Name: "@", Type: hivev1.DNSRecordTypeTXT, Owned: true, State: hivev1.DNSRecordStateSynced},
+			},
+			expectedRemove: []string{
+				"*.apps.blah.example.com. CNAME",
+				"blah.example.com. TXT",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockRFC2136Client := mock.NewMockClient(mockCtrl)
+
+			var actualRemove []string
+			if len(tc.expectedRemove) > 0 {
+				mockRFC2136Client.EXPECT().Update("blah.example.com.", gomock.Any(), gomock.Nil()).
+					DoAndReturn(func(_ string, remove []dns.RR, _ []dns.RR) error {
+						for _, rr := range remove {
+							actualRemove = append(actualRemove, rr.Header().Name+" "+dns.TypeToString[rr.Header().Rrtype])
+						}
+						return nil
+					})
+			}
+
+			dnsZone := validRFC2136DNSZone()
+			dnsZone.Status.Records = tc.records
+			err := DeleteRFC2136Records(mockRFC2136Client, dnsZone, log.WithField("controller", ControllerName))
+			assert.NoError(t, err, "unexpected error deleting records")
+			assert.Equal(t, tc.expectedRemove, actualRemove, "unexpected records removed")
+		})
+	}
+}
+
+// TestRFC2136Records tests getting, replacing and deleting records in the zone.
+func TestRFC2136Records(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockRFC2136Client := mock.NewMockClient(mockCtrl)
+	zr := &RFC2136Actuator{
+		logger:        log.WithField("controller", ControllerName),
+		rfc2136Client: mockRFC2136Client,
+		dnsZone:       validRFC2136DNSZone(),
+	}
+
+	txt, err := dns.NewRR(`_acme-challenge.blah.example.com. 60 IN TXT "part one" "part two"`)
+	if !assert.NoError(t, err, "unexpected error parsing record") {
+		return
+	}
+	mockRFC2136Client.EXPECT().Query("_acme-challenge.blah.example.com", dns.TypeTXT).Return([]dns.RR{txt}, nil)
+	mockRFC2136Client.EXPECT().Query("www.blah.example.com", dns.TypeCNAME).Return(nil, nil)
+	record, err := zr.GetRecord("_acme-challenge.blah.example.com", hivev1.DNSRecordTypeTXT)
+	assert.NoError(t, err, "unexpected error getting record")
+	assert.Equal(t, &hivev1.DNSRecord{
+		Name:   "_acme-challenge.blah.example.com",
+		Type:   hivev1.DNSRecordTypeTXT,
+		TTL:    60,
+		Values: []string{"part onepart two"},
+	}, record, "unexpected record")
+	record, err = zr.GetRecord("www.blah.example.com", hivev1.DNSRecordTypeCNAME)
+	assert.NoError(t, err, "unexpected error getting missing record")
+	assert.Nil(t, record, "expected no record")
+
+	cname := &hivev1.DNSRecord{Name: "www.blah.example.com", Type: hivev1.DNSRecordTypeCNAME, TTL: 60, Values: []string{"lb.example.com"}}
+	mockRFC2136Client.EXPECT().Update("blah.example.com", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ string, remove []dns.RR, insert []dns.RR) error {
+			assert.Equal(t, []string{"www.blah.example.com.\t60\tIN\tCNAME\tlb.example.com."}, rrStrings(remove), "unexpected records removed")
+			assert.Equal(t, []string{"www.blah.example.com.\t60\tIN\tCNAME\tlb.example.com."}, rrStrings(insert), "unexpected records inserted")
+			return nil
+		})
+	assert.NoError(t, zr.UpsertRecord(cname), "unexpected error replacing record")
+
+	mockRFC2136Client.EXPECT().Update("blah.example.com", gomock.Len(1), gomock.Nil()).Return(nil)
+	assert.NoError(t, zr.DeleteRecord(cname), "unexpected error deleting record")
+}
+
+func rrStrings(rrs []dns.RR) []string {
+	var result []string
+	for _, rr := range rrs {
+		result = append(result, rr.String())
+	}
+	return result
+}
+
+// TestSetConditionsForErrorForRFC2136 tests the conditions set for errors from the DNS server.
+func TestSetConditionsForErrorForRFC2136(t *testing.T) {
+	cases := []struct {
+		name               string
+		err                error
+		expectedConditions []hivev1.DNSZoneCondition
+	}{
+		{
+			name: "authentication error",
+			err:  &rfc2136client.AuthenticationError{},
+			expectedConditions: []hivev1.DNSZoneCondition{
+				{
+					Type:   hivev1.AuthenticationFailureCondition,
+					Status: corev1.ConditionTrue,
+					Reason: authenticationFailedReason,
+				},
+			},
+		},
+		{
+			name: "zone not configured",
+			err:  (&RFC2136Actuator{dnsZone: validRFC2136DNSZone()}).Create(),
+			expectedConditions: []hivev1.DNSZoneCondition{
+				{
+					Type:   hivev1.GenericDNSErrorsCondition,
+					Status: corev1.ConditionTrue,
+					Reason: zoneNotConfiguredReason,
+				},
+			},
+		},
+		{
+			name: "other error",
+			err:  errors.New("connection refused"),
+			expectedConditions: []hivev1.DNSZoneCondition{
+				{
+					Type:   hivev1.GenericDNSErrorsCondition,
+					Status: corev1.ConditionTrue,
+					Reason: dnsCloudErrorReason,
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			zr := &RFC2136Actuator{
+				logger:  log.WithField("controller", ControllerName),
+				dnsZone: validRFC2136DNSZone(),
+			}
+
+			assert.True(t, zr.SetConditionsForError(tc.err), "expected conditions to change")
+			assertDNSZoneConditions(t, zr.dnsZone, tc.expectedConditions)
+
+			assert.True(t, zr.SetConditionsForError(nil), "expected conditions to change")
+			for _, cond := range zr.dnsZone.Status.Conditions {
+				assert.Equal(t, corev1.ConditionFalse, cond.Status, "expected condition %s to be cleared", cond.Type)
+			}
+		})
+	}
+}
+
+// TestGetActuatorForRFC2136 tests that the TSIG key of a DNSZone is read from its own namespace, or from the Hive
+// namespace for the DNSZones under RFC 2136 managed domains that do not reference a key.
+func TestGetActuatorForRFC2136(t *testing.T) {
+	managedDomains := []hivev1.ManageDNSConfig{{
+		RFC2136: &hivev1.ManageDNSRFC2136Config{
+			Server:        "ns1.example.com",
+			TSIGKeyName:   "hive",
+			TSIGSecretRef: corev1.LocalObjectReference{Name: "managed-tsig-key"},
+		},
+		Domains: []string{"example.com"},
+	}}
+	managedSecret := validRFC2136Secret()
+	managedSecret.Namespace = controllerutils.GetHiveNamespace()
+	managedSecret.Name = "managed-tsig-key"
+	withoutSecretRef := func(zone *hivev1.DNSZone) *hivev1.DNSZone {
+		zone.Spec.RFC2136.TSIGSecretRef = nil
+		return zone
+	}
+	cases := []struct {
+		name          string
+		dnsZone       *hivev1.DNSZone
+		existing      []runtime.Object
+		expectedError bool
+	}{
+		{
+			name:     "own TSIG key",
+			dnsZone:  validRFC2136DNSZone(),
+			existing: []runtime.Object{validRFC2136Secret()},
+		},
+		{
+			name:     "TSIG key of managed domain",
+			dnsZone:  withoutSecretRef(validRFC2136DNSZone()),
+			existing: []runtime.Object{managedSecret},
+		},
+		{
+			name: "not under managed domain",
+			dnsZone: func() *hivev1.DNSZone {
+				zone := withoutSecretRef(validRFC2136DNSZone())
+				zone.Spec.Zone = "blah.example.org"
+				return zone
+			}(),
+			existing:      []runtime.Object{managedSecret},
+			expectedError: true,
+		},
+		{
+			name: "other server",
+			dnsZone: func() *hivev1.DNSZone {
+				zone := withoutSecretRef(validRFC2136DNSZone())
+				zone.Spec.RFC2136.Server = "ns1.example.org"
+				return zone
+			}(),
+			existing:      []runtime.Object{managedSecret},
+			expectedError: true,
+		},
+		{
+			name:          "TSIG key of managed domain is not read from namespace of DNSZone",
+			dnsZone:       withoutSecretRef(validRFC2136DNSZone()),
+			existing:      []runtime.Object{validRFC2136Secret()},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mocks := setupDefaultMocks(t, tc.existing...)
+			r := ReconcileDNSZone{
+				Client:         mocks.fakeKubeClient,
+				logger:         log.WithField("controller", ControllerName),
+				managedDomains: managedDomains,
+			}
+			actuator, err := r.getActuator(tc.dnsZone, r.logger)
+			if tc.expectedError {
+				assert.Error(t, err, "expected error getting actuator")
+				return
+			}
+			require.NoError(t, err, "unexpected error getting actuator")
+			assert.IsType(t, &RFC2136Actuator{}, actuator, "unexpected actuator")
+		})
+	}
+}
+
+func mockRFC2136ZoneExists(expect *mock.MockClientMockRecorder) {
+	expect.ZoneExists(gomock.Any()).Return(true, nil).Times(1)
+}
+
+func mockRFC2136ZoneDoesntExist(expect *mock.MockClientMockRecorder) {
+	expect.ZoneExists(gomock.Any()).Return(false, nil).Times(1)
+}
+
+func mockRFC2136GetNSRecords(expect *mock.MockClientMockRecorder) {
+	expect.Query(gomock.Any(), dns.TypeNS).Return([]dns.RR{
+		&dns.NS{Hdr: dns.RR_Header{Name: "blah.example.com.", Rrtype: dns.TypeNS, Class: dns.ClassINET}, Ns: "ns2.example.com."},
+		&dns.NS{Hdr: dns.RR_Header{Name: "blah.example.com.", Rrtype: dns.TypeNS, Class: dns.ClassINET}, Ns: "ns1.example.com."},
+	}, nil).Times(1)
+}
+
+func mockDeleteRFC2136Records(expect *mock.MockClientMockRecorder) {
+	expect.Update(gomock.Any(), gomock.Len(1), gomock.Nil()).Return(nil).Times(1)
+}
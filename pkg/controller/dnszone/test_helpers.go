@@ -11,18 +11,29 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/alibabaclient"
 	awsclient "github.com/openshift/hive/pkg/awsclient"
 	azureclient "github.com/openshift/hive/pkg/azureclient"
 	gcpclient "github.com/openshift/hive/pkg/gcpclient"
+	"github.com/openshift/hive/pkg/ibmclient"
+	"github.com/openshift/hive/pkg/rfc2136client"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/golang/mock/gomock"
+	mockalibaba "github.com/openshift/hive/pkg/alibabaclient/mock"
 	mockaws "github.com/openshift/hive/pkg/awsclient/mock"
 	mockazure "github.com/openshift/hive/pkg/azureclient/mock"
 	mockgcp "github.com/openshift/hive/pkg/gcpclient/mock"
+	mockibm "github.com/openshift/hive/pkg/ibmclient/mock"
+	mockrfc2136 "github.com/openshift/hive/pkg/rfc2136client/mock"
 	testfake "github.com/openshift/hive/pkg/test/fake"
 )
 
+const (
+	testKMSKeyARN = "arn:aws:kms:us-east-1:123456789012:key/abcdef"
+	testDSRecord  = "12345 13 2 ABCDEF0123456789"
+)
+
 var (
 	kubeTimeNow = func() *metav1.Time {
 		t := metav1.NewTime(time.Now())
@@ -81,6 +92,69 @@ var (
 		}
 	}
 
+	validIBMCloudDNSZone = func() *hivev1.DNSZone {
+		return &hivev1.DNSZone{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "dnszoneobject",
+				Namespace:  "ns",
+				Generation: 6,
+				Finalizers: []string{hivev1.FinalizerDNSZone},
+				UID:        types.UID("abcdef"),
+			},
+			Spec: hivev1.DNSZoneSpec{
+				Zone: "blah.example.com",
+				IBMCloud: &hivev1.IBMCloudDNSZoneSpec{
+					CredentialsSecretRef: corev1.LocalObjectReference{
+						Name: "somesecret",
+					},
+				},
+			},
+		}
+	}
+
+	validAlibabaCloudDNSZone = func() *hivev1.DNSZone {
+		return &hivev1.DNSZone{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "dnszoneobject",
+				Namespace:  "ns",
+				Generation: 6,
+				Finalizers: []string{hivev1.FinalizerDNSZone},
+				UID:        types.UID("abcdef"),
+			},
+			Spec: hivev1.DNSZoneSpec{
+				Zone: "blah.example.com",
+				AlibabaCloud: &hivev1.AlibabaCloudDNSZoneSpec{
+					CredentialsSecretRef: corev1.LocalObjectReference{
+						Name: "somesecret",
+					},
+					Region: "cn-hangzhou",
+				},
+			},
+		}
+	}
+
+	validRFC2136DNSZone = func() *hivev1.DNSZone {
+		return &hivev1.DNSZone{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "dnszoneobject",
+				Namespace:  "ns",
+				Generation: 6,
+				Finalizers: []string{hivev1.FinalizerDNSZone},
+				UID:        types.UID("abcdef"),
+			},
+			Spec: hivev1.DNSZoneSpec{
+				Zone: "blah.example.com",
+				RFC2136: &hivev1.RFC2136DNSZoneSpec{
+					Server:      "ns1.example.com",
+					TSIGKeyName: "hive",
+					TSIGSecretRef: &corev1.LocalObjectReference{
+						Name: "somesecret",
+					},
+				},
+			},
+		}
+	}
+
 	validGCPSecret = func() *corev1.Secret {
 		return &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
@@ -105,12 +179,83 @@ var (
 		}
 	}
 
+	validIBMCloudSecret = func() *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "somesecret",
+				Namespace: "ns",
+			},
+			Data: map[string][]byte{
+				"ibmcloud_api_key": []byte("notrealsecrettoken"),
+			},
+		}
+	}
+
+	validAlibabaCloudSecret = func() *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "somesecret",
+				Namespace: "ns",
+			},
+			Data: map[string][]byte{
+				"alibaba_cloud_access_key_id":     []byte("notrealaccesskeyid"),
+				"alibaba_cloud_access_key_secret": []byte("notrealaccesskeysecret"),
+			},
+		}
+	}
+
+	validRFC2136Secret = func() *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "somesecret",
+				Namespace: "ns",
+			},
+			Data: map[string][]byte{
+				"tsigSecret": []byte("bm90cmVhbHNlY3JldA=="),
+			},
+		}
+	}
+
 	validDNSZoneWithLinkToParent = func() *hivev1.DNSZone {
 		zone := validDNSZone()
 		zone.Spec.LinkToParentDomain = true
 		return zone
 	}
 
+	validDNSZoneWithDNSSEC = func() *hivev1.DNSZone {
+		zone := validDNSZone()
+		zone.Spec.DNSSEC = &hivev1.DNSSECSpec{
+			AWS: &hivev1.AWSDNSSECSpec{KMSKeyARN: testKMSKeyARN},
+		}
+		return zone
+	}
+
+	validDNSZoneDisablingDNSSEC = func() *hivev1.DNSZone {
+		zone := validDNSZoneWithLinkToParent()
+		zone.Status.DNSSEC = &hivev1.DNSSECStatus{
+			KeyState: awsKeySigningKeyStatusActive,
+		}
+		zone.Status.PublishedDSRecords = []string{testDSRecord}
+		zone.Status.Conditions = []hivev1.DNSZoneCondition{{
+			Type:               hivev1.DSRecordsPublishedCondition,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+		}}
+		return zone
+	}
+
+	validDNSZoneWithRecords = func() *hivev1.DNSZone {
+		zone := validDNSZone()
+		zone.Spec.Records = []hivev1.DNSRecord{
+			{
+				Name:   "www",
+				Type:   hivev1.DNSRecordTypeA,
+				Values: []string{"192.0.2.1"},
+			},
+		}
+		return zone
+	}
+
 	validAzureDNSZoneWithLinkToParent = func() *hivev1.DNSZone {
 		zone := validAzureDNSZone()
 		zone.Spec.LinkToParentDomain = true
@@ -176,11 +321,14 @@ var (
 )
 
 type mocks struct {
-	fakeKubeClient  client.Client
-	mockCtrl        *gomock.Controller
-	mockAWSClient   *mockaws.MockClient
-	mockGCPClient   *mockgcp.MockClient
-	mockAzureClient *mockazure.MockClient
+	fakeKubeClient         client.Client
+	mockCtrl               *gomock.Controller
+	mockAWSClient          *mockaws.MockClient
+	mockGCPClient          *mockgcp.MockClient
+	mockAzureClient        *mockazure.MockClient
+	mockIBMCloudClient     *mockibm.MockAPI
+	mockAlibabaCloudClient *mockalibaba.MockAPI
+	mockRFC2136Client      *mockrfc2136.MockClient
 }
 
 // setupDefaultMocks is an easy way to setup all of the default mocks
@@ -193,6 +341,9 @@ func setupDefaultMocks(t *testing.T, existing ...runtime.Object) *mocks {
 	mocks.mockAWSClient = mockaws.NewMockClient(mocks.mockCtrl)
 	mocks.mockGCPClient = mockgcp.NewMockClient(mocks.mockCtrl)
 	mocks.mockAzureClient = mockazure.NewMockClient(mocks.mockCtrl)
+	mocks.mockIBMCloudClient = mockibm.NewMockAPI(mocks.mockCtrl)
+	mocks.mockAlibabaCloudClient = mockalibaba.NewMockAPI(mocks.mockCtrl)
+	mocks.mockRFC2136Client = mockrfc2136.NewMockClient(mocks.mockCtrl)
 
 	return mocks
 }
@@ -214,3 +365,21 @@ func fakeAzureClientBuilder(mockAzureClient *mockazure.MockClient) azureClientBu
 		return mockAzureClient, nil
 	}
 }
+
+func fakeIBMCloudClientBuilder(mockIBMCloudClient *mockibm.MockAPI) ibmCloudClientBuilderType {
+	return func(secret *corev1.Secret) (ibmclient.API, error) {
+		return mockIBMCloudClient, nil
+	}
+}
+
+func fakeAlibabaCloudClientBuilder(mockAlibabaCloudClient *mockalibaba.MockAPI) alibabaCloudClientBuilderType {
+	return func(secret *corev1.Secret, regionID string) (alibabaclient.API, error) {
+		return mockAlibabaCloudClient, nil
+	}
+}
+
+func fakeRFC2136ClientBuilder(mockRFC2136Client *mockrfc2136.MockClient) rfc2136ClientBuilderType {
+	return func(server, keyName string, algorithm hivev1.TSIGAlgorithm, secret *corev1.Secret) (rfc2136client.Client, error) {
+		return mockRFC2136Client, nil
+	}
+}
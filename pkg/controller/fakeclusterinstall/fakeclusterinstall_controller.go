@@ -2,6 +2,8 @@ package fakeclusterinstall
 
 import (
 	"context"
+	"fmt"
+	"math/rand"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -12,9 +14,11 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/yaml"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -25,12 +29,19 @@ import (
 
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
 	hiveint "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	"github.com/openshift/hive/pkg/controller/clusterprovision"
 	hivemetrics "github.com/openshift/hive/pkg/controller/metrics"
 	controllerutils "github.com/openshift/hive/pkg/controller/utils"
 )
 
 const (
 	ControllerName = hivev1.FakeClusterInstallControllerName
+
+	// defaultInstallDuration is how long install attempts take when the FakeClusterInstall does not say.
+	defaultInstallDuration = 30 * time.Second
+
+	defaultFailureReason  = "UnknownError"
+	defaultFailureMessage = "Simulated cluster install failure"
 )
 
 // Add creates a new FakeClusterInstall controller and adds it to the manager with default RBAC.
@@ -47,9 +58,10 @@ func Add(mgr manager.Manager) error {
 // NewReconciler returns a new reconcile.Reconciler
 func NewReconciler(mgr manager.Manager, rateLimiter flowcontrol.RateLimiter) reconcile.Reconciler {
 	r := &ReconcileClusterInstall{
-		Client: controllerutils.NewClientWithMetricsOrDie(mgr, ControllerName, &rateLimiter),
-		scheme: mgr.GetScheme(),
-		logger: log.WithField("controller", ControllerName),
+		Client:   controllerutils.NewClientWithMetricsOrDie(mgr, ControllerName, &rateLimiter),
+		scheme:   mgr.GetScheme(),
+		logger:   log.WithField("controller", ControllerName),
+		randIntn: rand.Intn,
 	}
 	return r
 }
@@ -83,6 +95,9 @@ type ReconcileClusterInstall struct {
 	client.Client
 	scheme *runtime.Scheme
 	logger log.FieldLogger
+
+	// randIntn returns a random number in [0,n), to decide which install attempts fail.
+	randIntn func(n int) int
 }
 
 // Reconcile ensures that a given FakeClusterInstall resource exists and reflects the state of cluster operators from its target cluster
@@ -203,8 +218,11 @@ func (r *ReconcileClusterInstall) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{}, err
 	}
 
-	// NOTE: While this controller does not support a Stopped=True Completed=False state (it will try
-	// forever), most real implementations would want to check if it's time to give up here.
+	// Check if we gave up, having failed as many attempts as the ClusterDeployment allows.
+	if controllerutils.FindCondition(fci.Status.Conditions, hivev1.ClusterInstallStopped).Status == corev1.ConditionTrue {
+		logger.Info("cluster install stopped, no work left to be done")
+		return reconcile.Result{}, nil
+	}
 
 	// Ensure Stopped=False as we are actively working to reconcile:
 	newConditions, changed := controllerutils.SetClusterInstallConditionWithChangeCheck(
@@ -260,7 +278,7 @@ func (r *ReconcileClusterInstall) Reconcile(ctx context.Context, request reconci
 		}
 	}
 
-	// Simulate 30 second wait for Completed condition to go True:
+	// Simulate install attempts taking the install duration for Completed condition to go True:
 	switch completedCond.Status {
 	case corev1.ConditionUnknown:
 		logger.Info("setting Completed condition to False")
@@ -273,6 +291,8 @@ func (r *ReconcileClusterInstall) Reconcile(ctx context.Context, request reconci
 			controllerutils.UpdateConditionIfReasonOrMessageChange)
 		if changed {
 			fci.Status.Conditions = newConditions
+			now := metav1.Now()
+			fci.Status.AttemptStartedTime = &now
 			err := updateClusterInstallStatus(r.Client, fci, logger)
 			return reconcile.Result{}, err
 		}
@@ -290,12 +310,25 @@ func (r *ReconcileClusterInstall) Reconcile(ctx context.Context, request reconci
 			return reconcile.Result{}, r.Client.Update(context.Background(), fci)
 		}
 
-		// Check if it's been 30 seconds since we set condition to False:
-		delta := time.Since(completedCond.LastTransitionTime.Time)
-		if delta < 30*time.Second {
+		// Check if the install duration has passed since the attempt started:
+		attemptStarted := completedCond.LastTransitionTime.Time
+		if fci.Status.AttemptStartedTime != nil {
+			attemptStarted = fci.Status.AttemptStartedTime.Time
+		}
+		installDuration := defaultInstallDuration
+		if fci.Spec.InstallDuration != nil {
+			installDuration = fci.Spec.InstallDuration.Duration
+		}
+		delta := time.Since(attemptStarted)
+		if delta < installDuration {
 			// requeue for remainder of delta
-			return reconcile.Result{RequeueAfter: 30*time.Second - delta}, nil
+			return reconcile.Result{RequeueAfter: installDuration - delta}, nil
+		}
+
+		if r.attemptFails(fci) {
+			return reconcile.Result{}, r.failAttempt(fci, cd, logger)
 		}
+
 		logger.Info("setting Completed condition to True")
 		newConditions, changed := controllerutils.SetClusterInstallConditionWithChangeCheck(
 			fci.Status.Conditions,
@@ -316,6 +349,72 @@ func (r *ReconcileClusterInstall) Reconcile(ctx context.Context, request reconci
 	return reconcile.Result{}, nil
 }
 
+// attemptFails decides whether the current install attempt fails: the first FailedAttempts attempts do, and later
+// ones do with a chance of FailurePercent.
+func (r *ReconcileClusterInstall) attemptFails(fci *hiveint.FakeClusterInstall) bool {
+	if fci.Status.InstallRestarts < fci.Spec.FailedAttempts {
+		return true
+	}
+	return fci.Spec.FailurePercent > 0 && r.randIntn(100) < fci.Spec.FailurePercent
+}
+
+// failAttempt marks the current install attempt failed, and either starts the next attempt or, when the
+// ClusterDeployment allows no more attempts, gives up.
+func (r *ReconcileClusterInstall) failAttempt(fci *hiveint.FakeClusterInstall, cd *hivev1.ClusterDeployment, logger log.FieldLogger) error {
+	fci.Status.InstallRestarts++
+	reason, message := r.failureReasonAndMessage(fci.Spec.FailureReason, logger)
+	logger.WithField("reason", reason).WithField("attempt", fci.Status.InstallRestarts).Info("failing install attempt")
+	fci.Status.Conditions, _ = controllerutils.SetClusterInstallConditionWithChangeCheck(
+		fci.Status.Conditions,
+		hivev1.ClusterInstallFailed,
+		corev1.ConditionTrue,
+		reason,
+		message,
+		controllerutils.UpdateConditionIfReasonOrMessageChange)
+	if cd.Spec.InstallAttemptsLimit != nil && fci.Status.InstallRestarts >= int(*cd.Spec.InstallAttemptsLimit) {
+		logger.Info("install attempts limit reached, giving up")
+		fci.Status.Conditions, _ = controllerutils.SetClusterInstallConditionWithChangeCheck(
+			fci.Status.Conditions,
+			hivev1.ClusterInstallStopped,
+			corev1.ConditionTrue,
+			"InstallAttemptsLimitReached",
+			fmt.Sprintf("Install failed %d times, the limit of install attempts", fci.Status.InstallRestarts),
+			controllerutils.UpdateConditionIfReasonOrMessageChange)
+	} else {
+		now := metav1.Now()
+		fci.Status.AttemptStartedTime = &now
+	}
+	return updateClusterInstallStatus(r.Client, fci, logger)
+}
+
+// failureReasonAndMessage returns the reason to fail install attempts with, and the message the install log regexes
+// report for it.
+func (r *ReconcileClusterInstall) failureReasonAndMessage(reason string, logger log.FieldLogger) (string, string) {
+	if reason == "" {
+		reason = defaultFailureReason
+	}
+	for _, cmName := range []string{clusterprovision.RegexConfigMapName, clusterprovision.AdditionalRegexConfigMapName} {
+		cm := &corev1.ConfigMap{}
+		if err := r.Get(context.TODO(), types.NamespacedName{Namespace: controllerutils.GetHiveNamespace(), Name: cmName}, cm); err != nil {
+			if !apierrors.IsNotFound(err) {
+				logger.WithError(err).WithField("configmap", cmName).Warn("error loading install log regexes")
+			}
+			continue
+		}
+		regexes := []clusterprovision.InstallLogRegex{}
+		if err := yaml.Unmarshal([]byte(cm.Data[clusterprovision.RegexDataEntryName]), &regexes); err != nil {
+			logger.WithError(err).WithField("configmap", cmName).Warn("cannot unmarshal install log regexes")
+			continue
+		}
+		for _, regex := range regexes {
+			if regex.InstallFailingReason == reason {
+				return reason, regex.InstallFailingMessage
+			}
+		}
+	}
+	return reason, defaultFailureMessage
+}
+
 func updateClusterInstallStatus(c client.Client, fci *hiveint.FakeClusterInstall, logger log.FieldLogger) error {
 	// TODO: deepequals check
 	logger.Info("updating status")
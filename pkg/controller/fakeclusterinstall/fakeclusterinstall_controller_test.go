@@ -0,0 +1,295 @@
+package fakeclusterinstall
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveint "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	"github.com/openshift/hive/pkg/constants"
+	"github.com/openshift/hive/pkg/controller/clusterprovision"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+	testfake "github.com/openshift/hive/pkg/test/fake"
+	"github.com/openshift/hive/pkg/util/scheme"
+)
+
+const (
+	testNamespace = "test-namespace"
+	testName      = "test-cluster"
+	hiveNamespace = "hive"
+)
+
+func init() {
+	log.SetLevel(log.DebugLevel)
+}
+
+func TestReconcileInstallAttempts(t *testing.T) {
+	tests := []struct {
+		name                    string
+		spec                    hiveint.FakeClusterInstallSpec
+		installRestarts         int
+		attemptStarted          time.Duration
+		installAttemptsLimit    *int32
+		randIntn                func(int) int
+		expectRequeueAfter      bool
+		expectCompleted         bool
+		expectFailedReason      string
+		expectFailedMessage     string
+		expectStopped           bool
+		expectInstallRestarts   int
+		expectNewAttemptStarted bool
+	}{
+		{
+			name:               "attempt in progress",
+			attemptStarted:     10 * time.Second,
+			expectRequeueAfter: true,
+		},
+		{
+			name:            "attempt succeeds after default install duration",
+			attemptStarted:  time.Minute,
+			expectCompleted: true,
+		},
+		{
+			name: "attempt in progress for install duration",
+			spec: hiveint.FakeClusterInstallSpec{
+				InstallDuration: &metav1.Duration{Duration: 10 * time.Minute},
+			},
+			attemptStarted:     time.Minute,
+			expectRequeueAfter: true,
+		},
+		{
+			name: "failed attempt with reason of install log regex",
+			spec: hiveint.FakeClusterInstallSpec{
+				FailedAttempts: 2,
+				FailureReason:  "AWSInsufficientCapacity",
+			},
+			installRestarts:         1,
+			attemptStarted:          time.Minute,
+			expectFailedReason:      "AWSInsufficientCapacity",
+			expectFailedMessage:     "AWS does not have capacity",
+			expectInstallRestarts:   2,
+			expectNewAttemptStarted: true,
+		},
+		{
+			name: "failed attempt with unknown reason",
+			spec: hiveint.FakeClusterInstallSpec{
+				FailedAttempts: 1,
+			},
+			attemptStarted:          time.Minute,
+			expectFailedReason:      "UnknownError",
+			expectFailedMessage:     "Simulated cluster install failure",
+			expectInstallRestarts:   1,
+			expectNewAttemptStarted: true,
+		},
+		{
+			name: "attempt succeeds after failed attempts",
+			spec: hiveint.FakeClusterInstallSpec{
+				FailedAttempts: 2,
+			},
+			installRestarts:       2,
+			attemptStarted:        time.Minute,
+			expectCompleted:       true,
+			expectInstallRestarts: 2,
+		},
+		{
+			name: "attempt fails by chance",
+			spec: hiveint.FakeClusterInstallSpec{
+				FailurePercent: 30,
+			},
+			attemptStarted:          time.Minute,
+			randIntn:                func(int) int { return 29 },
+			expectFailedReason:      "UnknownError",
+			expectInstallRestarts:   1,
+			expectNewAttemptStarted: true,
+		},
+		{
+			name: "attempt succeeds by chance",
+			spec: hiveint.FakeClusterInstallSpec{
+				FailurePercent: 30,
+			},
+			attemptStarted:  time.Minute,
+			randIntn:        func(int) int { return 30 },
+			expectCompleted: true,
+		},
+		{
+			name: "give up at install attempts limit",
+			spec: hiveint.FakeClusterInstallSpec{
+				FailedAttempts: 5,
+			},
+			installRestarts:       2,
+			installAttemptsLimit:  pointer.Int32(3),
+			attemptStarted:        time.Minute,
+			expectFailedReason:    "UnknownError",
+			expectStopped:         true,
+			expectInstallRestarts: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv(constants.HiveNamespaceEnvVar, hiveNamespace)
+
+			attemptStarted := metav1.NewTime(time.Now().Add(-test.attemptStarted))
+			fci := testFakeClusterInstall(test.spec)
+			fci.Status.InstallRestarts = test.installRestarts
+			fci.Status.AttemptStartedTime = &attemptStarted
+			cd := testClusterDeployment()
+			cd.Spec.InstallAttemptsLimit = test.installAttemptsLimit
+			c := testfake.NewFakeClientBuilder().WithRuntimeObjects(fci, cd, testRegexConfigMap()).Build()
+
+			randIntn := test.randIntn
+			if randIntn == nil {
+				randIntn = func(int) int {
+					t.Fatal("unexpected random failure")
+					return 0
+				}
+			}
+			r := &ReconcileClusterInstall{
+				Client:   c,
+				scheme:   scheme.GetScheme(),
+				logger:   log.WithField("controller", ControllerName),
+				randIntn: randIntn,
+			}
+
+			result, err := r.Reconcile(context.TODO(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: testName},
+			})
+			require.NoError(t, err, "unexpected error from reconcile")
+			if test.expectRequeueAfter {
+				assert.NotZero(t, result.RequeueAfter, "expected requeue after")
+			}
+
+			fci = &hiveint.FakeClusterInstall{}
+			require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: testName}, fci))
+			completed := controllerutils.FindCondition(fci.Status.Conditions, hivev1.ClusterInstallCompleted)
+			if test.expectCompleted {
+				assert.Equal(t, corev1.ConditionTrue, completed.Status, "expected install completed")
+			} else {
+				assert.Equal(t, corev1.ConditionFalse, completed.Status, "unexpected install completed")
+			}
+			failed := controllerutils.FindCondition(fci.Status.Conditions, hivev1.ClusterInstallFailed)
+			if test.expectFailedReason != "" {
+				assert.Equal(t, corev1.ConditionTrue, failed.Status, "expected install failed")
+				assert.Equal(t, test.expectFailedReason, failed.Reason, "unexpected failure reason")
+				if test.expectFailedMessage != "" {
+					assert.Equal(t, test.expectFailedMessage, failed.Message, "unexpected failure message")
+				}
+			} else {
+				assert.NotEqual(t, corev1.ConditionTrue, failed.Status, "unexpected install failed")
+			}
+			stopped := controllerutils.FindCondition(fci.Status.Conditions, hivev1.ClusterInstallStopped)
+			if test.expectStopped {
+				assert.Equal(t, corev1.ConditionTrue, stopped.Status, "expected install stopped")
+				assert.Equal(t, "InstallAttemptsLimitReached", stopped.Reason, "unexpected stopped reason")
+			} else {
+				assert.Equal(t, corev1.ConditionFalse, stopped.Status, "unexpected install stopped")
+			}
+			assert.Equal(t, test.expectInstallRestarts, fci.Status.InstallRestarts, "unexpected install restarts")
+			if test.expectNewAttemptStarted {
+				assert.True(t, fci.Status.AttemptStartedTime.After(attemptStarted.Time), "expected new attempt to start")
+			}
+		})
+	}
+}
+
+func TestReconcileStoppedInstall(t *testing.T) {
+	fci := testFakeClusterInstall(hiveint.FakeClusterInstallSpec{})
+	fci.Status.Conditions, _ = controllerutils.SetClusterInstallConditionWithChangeCheck(fci.Status.Conditions,
+		hivev1.ClusterInstallStopped, corev1.ConditionTrue, "InstallAttemptsLimitReached", "", controllerutils.UpdateConditionAlways)
+	c := testfake.NewFakeClientBuilder().WithRuntimeObjects(fci, testClusterDeployment()).Build()
+	r := &ReconcileClusterInstall{
+		Client: c,
+		scheme: scheme.GetScheme(),
+		logger: log.WithField("controller", ControllerName),
+	}
+
+	result, err := r.Reconcile(context.TODO(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: testName},
+	})
+	require.NoError(t, err, "unexpected error from reconcile")
+	assert.Zero(t, result.RequeueAfter, "unexpected requeue")
+
+	fci = &hiveint.FakeClusterInstall{}
+	require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: testName}, fci))
+	stopped := controllerutils.FindCondition(fci.Status.Conditions, hivev1.ClusterInstallStopped)
+	assert.Equal(t, corev1.ConditionTrue, stopped.Status, "expected install to stay stopped")
+}
+
+// testFakeClusterInstall returns a FakeClusterInstall whose install is underway.
+func testFakeClusterInstall(spec hiveint.FakeClusterInstallSpec) *hiveint.FakeClusterInstall {
+	spec.ClusterDeploymentRef = corev1.LocalObjectReference{Name: testName}
+	spec.ClusterMetadata = &hivev1.ClusterMetadata{
+		ClusterID:                "not-a-real-cluster",
+		InfraID:                  "not-a-real-cluster",
+		AdminKubeconfigSecretRef: corev1.LocalObjectReference{Name: "admin-kubeconfig"},
+		AdminPasswordSecretRef:   &corev1.LocalObjectReference{Name: "admin-password"},
+	}
+	fci := &hiveint.FakeClusterInstall{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       testNamespace,
+			Name:            testName,
+			ResourceVersion: "1",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion:         hivev1.SchemeGroupVersion.String(),
+				Kind:               "ClusterDeployment",
+				Name:               testName,
+				UID:                types.UID("test-cd-uid"),
+				BlockOwnerDeletion: pointer.Bool(true),
+			}},
+		},
+		Spec: spec,
+	}
+	for _, cond := range []hivev1.ClusterInstallCondition{
+		{Type: hivev1.ClusterInstallCompleted, Status: corev1.ConditionFalse, Reason: "InProgress", Message: "Installation in progress"},
+		{Type: hivev1.ClusterInstallFailed, Status: corev1.ConditionUnknown},
+		{Type: hivev1.ClusterInstallStopped, Status: corev1.ConditionFalse, Reason: "InProgress", Message: "Cluster install in progress"},
+		{Type: hivev1.ClusterInstallRequirementsMet, Status: corev1.ConditionTrue, Reason: "AllRequirementsMet", Message: "All requirements met"},
+	} {
+		fci.Status.Conditions, _ = controllerutils.SetClusterInstallConditionWithChangeCheck(fci.Status.Conditions,
+			cond.Type, cond.Status, cond.Reason, cond.Message, controllerutils.UpdateConditionAlways)
+	}
+	return fci
+}
+
+func testClusterDeployment() *hivev1.ClusterDeployment {
+	return &hivev1.ClusterDeployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: hivev1.SchemeGroupVersion.String(),
+			Kind:       "ClusterDeployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testName,
+			UID:       types.UID("test-cd-uid"),
+		},
+	}
+}
+
+func testRegexConfigMap() runtime.Object {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: hiveNamespace,
+			Name:      clusterprovision.RegexConfigMapName,
+		},
+		Data: map[string]string{
+			clusterprovision.RegexDataEntryName: `
+- name: AWSInsufficientCapacity
+  searchRegexStrings:
+  - "InsufficientInstanceCapacity"
+  installFailingReason: AWSInsufficientCapacity
+  installFailingMessage: AWS does not have capacity
+`,
+		},
+	}
+}
@@ -342,14 +342,9 @@ func (r *hibernationReconciler) Reconcile(ctx context.Context, request reconcile
 				return reconcile.Result{Requeue: true, RequeueAfter: requeueAfter}, nil
 			}
 		}
-		// Hibernate fake cluster
-		if isFakeCluster && cd.Spec.HibernateAfter == nil && hibernatingCondition.Status != corev1.ConditionTrue {
-			r.setCDCondition(cd, hivev1.ClusterHibernatingCondition, hivev1.HibernatingReasonHibernating,
-				"Fake cluster is stopped", corev1.ConditionTrue, cdLog)
-			r.setCDCondition(cd, hivev1.ClusterReadyCondition, hivev1.ReadyReasonStoppingOrHibernating,
-				clusterHibernatingMsg, corev1.ConditionFalse, cdLog)
-			cd.Status.PowerState = hivev1.ClusterPowerStateHibernating
-			return reconcile.Result{}, r.updateClusterDeploymentStatus(cd, cdLog)
+		// Hibernate fake cluster, once the power state is set if it is hibernating after a while
+		if isFakeCluster && !readyToHibernate {
+			return r.hibernateFakeCluster(cd, hibernatingCondition, cdLog)
 		}
 		if readyToHibernate {
 			cd.Spec.PowerState = hivev1.ClusterPowerStateHibernating
@@ -367,17 +362,7 @@ func (r *hibernationReconciler) Reconcile(ctx context.Context, request reconcile
 	}
 	// If we get here, we're not supposed to be hibernating
 	if isFakeCluster {
-		changed := r.setCDCondition(cd, hivev1.ClusterHibernatingCondition, hivev1.HibernatingReasonResumingOrRunning,
-			clusterResumingOrRunningMsg, corev1.ConditionFalse, cdLog)
-		rChanged := r.setCDCondition(cd, hivev1.ClusterReadyCondition, hivev1.ReadyReasonRunning, clusterRunningMsg,
-			corev1.ConditionTrue, cdLog)
-		if changed || rChanged {
-			cd.Status.PowerState = hivev1.ClusterPowerStateRunning
-			if err := r.updateClusterDeploymentStatus(cd, cdLog); err != nil {
-				return reconcile.Result{}, err
-			}
-		}
-		return reconcile.Result{}, nil
+		return r.resumeFakeCluster(cd, hibernatingCondition, readyCondition, cdLog)
 	}
 	// Start machines if necessary; or poll whether they have started
 	if shouldStartMachines(cd, hibernatingCondition, readyCondition) {
@@ -386,6 +371,92 @@ func (r *hibernationReconciler) Reconcile(ctx context.Context, request reconcile
 	return r.checkClusterRunning(cd, syncSetsApplied, cdLog, readyCondition)
 }
 
+// hibernateFakeCluster stops a fake cluster, taking as long as the FakeClusterInstall that installed it says.
+func (r *hibernationReconciler) hibernateFakeCluster(cd *hivev1.ClusterDeployment, hibernatingCondition *hivev1.ClusterDeploymentCondition, logger log.FieldLogger) (reconcile.Result, error) {
+	if hibernatingCondition.Status == corev1.ConditionTrue {
+		return reconcile.Result{}, nil
+	}
+	if hibernateDuration, _ := r.fakeClusterPowerStateDurations(cd, logger); hibernateDuration > 0 {
+		if hibernatingCondition.Reason != hivev1.HibernatingReasonStopping {
+			logger.WithField("hibernateDuration", hibernateDuration).Info("Stopping fake cluster")
+			r.setCDCondition(cd, hivev1.ClusterHibernatingCondition, hivev1.HibernatingReasonStopping,
+				"Fake cluster is stopping", corev1.ConditionFalse, logger)
+			r.setCDCondition(cd, hivev1.ClusterReadyCondition, hivev1.ReadyReasonStoppingOrHibernating,
+				clusterHibernatingMsg, corev1.ConditionFalse, logger)
+			cd.Status.PowerState = hivev1.ClusterPowerStateStopping
+			if err := r.updateClusterDeploymentStatus(cd, logger); err != nil {
+				return reconcile.Result{}, err
+			}
+			return reconcile.Result{RequeueAfter: hibernateDuration}, nil
+		}
+		if remaining := hibernateDuration - time.Since(hibernatingCondition.LastProbeTime.Time); remaining > 0 {
+			return reconcile.Result{RequeueAfter: remaining}, nil
+		}
+	}
+	r.setCDCondition(cd, hivev1.ClusterHibernatingCondition, hivev1.HibernatingReasonHibernating,
+		"Fake cluster is stopped", corev1.ConditionTrue, logger)
+	r.setCDCondition(cd, hivev1.ClusterReadyCondition, hivev1.ReadyReasonStoppingOrHibernating,
+		clusterHibernatingMsg, corev1.ConditionFalse, logger)
+	cd.Status.PowerState = hivev1.ClusterPowerStateHibernating
+	return reconcile.Result{}, r.updateClusterDeploymentStatus(cd, logger)
+}
+
+// resumeFakeCluster starts a fake cluster. A hibernating fake cluster takes as long to become ready as the
+// FakeClusterInstall that installed it says.
+func (r *hibernationReconciler) resumeFakeCluster(cd *hivev1.ClusterDeployment, hibernatingCondition, readyCondition *hivev1.ClusterDeploymentCondition, logger log.FieldLogger) (reconcile.Result, error) {
+	resuming := hibernatingCondition.Status == corev1.ConditionTrue || readyCondition.Reason == hivev1.ReadyReasonStartingMachines
+	if _, resumeDuration := r.fakeClusterPowerStateDurations(cd, logger); resuming && resumeDuration > 0 {
+		if readyCondition.Reason != hivev1.ReadyReasonStartingMachines {
+			logger.WithField("resumeDuration", resumeDuration).Info("Starting fake cluster")
+			r.setCDCondition(cd, hivev1.ClusterHibernatingCondition, hivev1.HibernatingReasonResumingOrRunning,
+				clusterResumingOrRunningMsg, corev1.ConditionFalse, logger)
+			r.setCDCondition(cd, hivev1.ClusterReadyCondition, hivev1.ReadyReasonStartingMachines,
+				"Fake cluster is starting", corev1.ConditionFalse, logger)
+			cd.Status.PowerState = hivev1.ClusterPowerStateStartingMachines
+			if err := r.updateClusterDeploymentStatus(cd, logger); err != nil {
+				return reconcile.Result{}, err
+			}
+			return reconcile.Result{RequeueAfter: resumeDuration}, nil
+		}
+		if remaining := resumeDuration - time.Since(readyCondition.LastProbeTime.Time); remaining > 0 {
+			return reconcile.Result{RequeueAfter: remaining}, nil
+		}
+	}
+	changed := r.setCDCondition(cd, hivev1.ClusterHibernatingCondition, hivev1.HibernatingReasonResumingOrRunning,
+		clusterResumingOrRunningMsg, corev1.ConditionFalse, logger)
+	rChanged := r.setCDCondition(cd, hivev1.ClusterReadyCondition, hivev1.ReadyReasonRunning, clusterRunningMsg,
+		corev1.ConditionTrue, logger)
+	if changed || rChanged {
+		cd.Status.PowerState = hivev1.ClusterPowerStateRunning
+		if err := r.updateClusterDeploymentStatus(cd, logger); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+// fakeClusterPowerStateDurations returns how long a fake cluster takes to hibernate and to resume, as the
+// FakeClusterInstall that installed it says. Fake clusters not installed by a FakeClusterInstall change power state
+// at once.
+func (r *hibernationReconciler) fakeClusterPowerStateDurations(cd *hivev1.ClusterDeployment, logger log.FieldLogger) (hibernate, resume time.Duration) {
+	ref := cd.Spec.ClusterInstallRef
+	if ref == nil || ref.Group != hiveintv1alpha1.SchemeGroupVersion.Group || ref.Kind != "FakeClusterInstall" {
+		return 0, 0
+	}
+	fci := &hiveintv1alpha1.FakeClusterInstall{}
+	if err := r.Get(context.TODO(), types.NamespacedName{Namespace: cd.Namespace, Name: ref.Name}, fci); err != nil {
+		logger.WithError(err).Warn("could not get FakeClusterInstall, changing power state at once")
+		return 0, 0
+	}
+	if fci.Spec.HibernateDuration != nil {
+		hibernate = fci.Spec.HibernateDuration.Duration
+	}
+	if fci.Spec.ResumeDuration != nil {
+		resume = fci.Spec.ResumeDuration.Duration
+	}
+	return hibernate, resume
+}
+
 func (r *hibernationReconciler) startMachines(cd *hivev1.ClusterDeployment, logger log.FieldLogger) (reconcile.Result, error) {
 	actuator := r.getActuator(cd)
 	if actuator == nil {
@@ -717,6 +788,10 @@ func (r *hibernationReconciler) getActuator(cd *hivev1.ClusterDeployment) Hibern
 }
 
 func (r *hibernationReconciler) hibernationSupported(cd *hivev1.ClusterDeployment) (bool, string) {
+	// Fake clusters are hibernated without an actuator.
+	if controllerutils.IsFakeCluster(cd) {
+		return true, "Hibernation capable"
+	}
 	if r.getActuator(cd) == nil {
 		return false, "Unsupported platform: no actuator to handle it"
 	}
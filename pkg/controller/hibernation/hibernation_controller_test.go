@@ -60,6 +60,7 @@ func TestReconcile(t *testing.T) {
 		name                   string
 		cd                     *hivev1.ClusterDeployment
 		cs                     *hiveintv1alpha1.ClusterSync
+		fci                    *hiveintv1alpha1.FakeClusterInstall
 		hibernationUnsupported bool
 		setupActuator          func(actuator *mock.MockHibernationActuator)
 		setupCSRHelper         func(helper *mock.MockcsrHelper)
@@ -960,6 +961,82 @@ func TestReconcile(t *testing.T) {
 				assert.Equal(t, hivev1.ClusterPowerStateRunning, cd.Status.PowerState)
 			},
 		},
+		{
+			name: "hibernate fake cluster taking the hibernate duration",
+			cd: cdBuilder.Build(
+				o.shouldHibernate,
+				o.fakeClusterInstall,
+				testcd.InstalledTimestamp(time.Now().Add(-1*time.Hour))),
+			cs:                     csBuilder.Build(),
+			fci:                    testFakeClusterInstall(&metav1.Duration{Duration: 10 * time.Minute}, nil),
+			hibernationUnsupported: true,
+			validate: func(t *testing.T, cd *hivev1.ClusterDeployment) {
+				cond, runCond := getHibernatingAndRunningConditions(cd)
+				require.NotNil(t, cond)
+				assert.Equal(t, corev1.ConditionFalse, cond.Status)
+				assert.Equal(t, hivev1.HibernatingReasonStopping, cond.Reason)
+				require.NotNil(t, runCond)
+				assert.Equal(t, hivev1.ReadyReasonStoppingOrHibernating, runCond.Reason)
+				assert.Equal(t, hivev1.ClusterPowerStateStopping, cd.Status.PowerState)
+			},
+			expectRequeueAfter: 10 * time.Minute,
+		},
+		{
+			name: "fake cluster stopped after the hibernate duration",
+			cd: cdBuilder.Build(
+				o.shouldHibernate,
+				o.stopping,
+				o.fakeClusterInstall,
+				testcd.InstalledTimestamp(time.Now().Add(-1*time.Hour))),
+			cs:  csBuilder.Build(),
+			fci: testFakeClusterInstall(&metav1.Duration{Duration: 10 * time.Minute}, nil),
+			validate: func(t *testing.T, cd *hivev1.ClusterDeployment) {
+				cond, _ := getHibernatingAndRunningConditions(cd)
+				require.NotNil(t, cond)
+				assert.Equal(t, corev1.ConditionTrue, cond.Status)
+				assert.Equal(t, hivev1.HibernatingReasonHibernating, cond.Reason)
+				assert.Equal(t, hivev1.ClusterPowerStateHibernating, cd.Status.PowerState)
+			},
+		},
+		{
+			name: "resume fake cluster taking the resume duration",
+			cd: cdBuilder.Options(o.hibernating,
+				o.shouldRun,
+				o.fakeClusterInstall).Build(),
+			cs:  csBuilder.Build(),
+			fci: testFakeClusterInstall(nil, &metav1.Duration{Duration: 5 * time.Minute}),
+			validate: func(t *testing.T, cd *hivev1.ClusterDeployment) {
+				cond, runCond := getHibernatingAndRunningConditions(cd)
+				require.NotNil(t, cond)
+				assert.Equal(t, corev1.ConditionFalse, cond.Status)
+				assert.Equal(t, hivev1.HibernatingReasonResumingOrRunning, cond.Reason)
+				require.NotNil(t, runCond)
+				assert.Equal(t, corev1.ConditionFalse, runCond.Status)
+				assert.Equal(t, hivev1.ReadyReasonStartingMachines, runCond.Reason)
+				assert.Equal(t, hivev1.ClusterPowerStateStartingMachines, cd.Status.PowerState)
+			},
+			expectRequeueAfter: 5 * time.Minute,
+		},
+		{
+			name: "fake cluster still resuming",
+			cd: cdBuilder.Options(o.shouldRun,
+				o.fakeClusterInstall,
+				testcd.WithCondition(hivev1.ClusterDeploymentCondition{
+					Type:          hivev1.ClusterReadyCondition,
+					Status:        corev1.ConditionFalse,
+					Reason:        hivev1.ReadyReasonStartingMachines,
+					LastProbeTime: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+				})).Build(),
+			cs:  csBuilder.Build(),
+			fci: testFakeClusterInstall(nil, &metav1.Duration{Duration: 5 * time.Minute}),
+			validate: func(t *testing.T, cd *hivev1.ClusterDeployment) {
+				_, runCond := getHibernatingAndRunningConditions(cd)
+				require.NotNil(t, runCond)
+				assert.Equal(t, corev1.ConditionFalse, runCond.Status)
+				assert.Equal(t, hivev1.ReadyReasonStartingMachines, runCond.Reason)
+			},
+			expectRequeueAfter: 3 * time.Minute,
+		},
 	}
 
 	for _, test := range tests {
@@ -979,12 +1056,14 @@ func TestReconcile(t *testing.T) {
 				test.setupCSRHelper(mockCSRHelper)
 			}
 			actuators = []HibernationActuator{mockActuator}
-			var c client.Client
+			objs := []runtime.Object{test.cd}
 			if test.cs != nil {
-				c = testfake.NewFakeClientBuilder().WithRuntimeObjects(test.cd, test.cs).Build()
-			} else {
-				c = testfake.NewFakeClientBuilder().WithRuntimeObjects(test.cd).Build()
+				objs = append(objs, test.cs)
 			}
+			if test.fci != nil {
+				objs = append(objs, test.fci)
+			}
+			c := testfake.NewFakeClientBuilder().WithRuntimeObjects(objs...).Build()
 
 			reconciler := hibernationReconciler{
 				Client: c,
@@ -1306,6 +1385,18 @@ func (*clusterDeploymentOptions) hibernating(cd *hivev1.ClusterDeployment) {
 		Status: corev1.ConditionTrue,
 	})
 }
+func (*clusterDeploymentOptions) fakeClusterInstall(cd *hivev1.ClusterDeployment) {
+	if cd.Annotations == nil {
+		cd.Annotations = map[string]string{}
+	}
+	cd.Annotations[constants.HiveFakeClusterAnnotation] = "true"
+	cd.Spec.ClusterInstallRef = &hivev1.ClusterInstallLocalReference{
+		Group:   hiveintv1alpha1.SchemeGroupVersion.Group,
+		Version: hiveintv1alpha1.SchemeGroupVersion.Version,
+		Kind:    "FakeClusterInstall",
+		Name:    cdName,
+	}
+}
 func (*clusterDeploymentOptions) unsupported(cd *hivev1.ClusterDeployment) {
 	cd.Status.Conditions = append(cd.Status.Conditions, hivev1.ClusterDeploymentCondition{
 		Type:   hivev1.ClusterHibernatingCondition,
@@ -1314,6 +1405,19 @@ func (*clusterDeploymentOptions) unsupported(cd *hivev1.ClusterDeployment) {
 	})
 }
 
+func testFakeClusterInstall(hibernateDuration, resumeDuration *metav1.Duration) *hiveintv1alpha1.FakeClusterInstall {
+	return &hiveintv1alpha1.FakeClusterInstall{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      cdName,
+		},
+		Spec: hiveintv1alpha1.FakeClusterInstallSpec{
+			HibernateDuration: hibernateDuration,
+			ResumeDuration:    resumeDuration,
+		},
+	}
+}
+
 func getHibernatingAndRunningConditions(cd *hivev1.ClusterDeployment) (*hivev1.ClusterDeploymentCondition, *hivev1.ClusterDeploymentCondition) {
 	var hibCond *hivev1.ClusterDeploymentCondition
 	var runCond *hivev1.ClusterDeploymentCondition
@@ -80,6 +80,14 @@ var (
 		Name: "hive_syncsets_unapplied_total",
 		Help: "Total number of SyncSetsInstances referencing non-selector SyncSets that have not successfully applied all resources/patches/secrets.",
 	})
+	metricSelectorSyncSetClustersByHealth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "hive_selectorsyncset_clusters_by_health",
+		Help: "Total number of SyncSetInstances for each SelectorSyncSet with a health check by the health of the resources.",
+	}, []string{"name", "health"})
+	metricSyncSetsByHealth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "hive_syncsets_by_health",
+		Help: "Total number of SyncSetInstances referencing non-selector SyncSets with a health check by the health of the resources.",
+	}, []string{"health"})
 
 	// TODO: convert all metrics with both namespace and name as labels to namespaced_name (logged as $namespace/$name)
 
@@ -184,6 +192,8 @@ func init() {
 	metrics.Registry.MustRegister(metricSelectorSyncSetClustersUnappliedTotal)
 	metrics.Registry.MustRegister(metricSyncSetsTotal)
 	metrics.Registry.MustRegister(metricSyncSetsUnappliedTotal)
+	metrics.Registry.MustRegister(metricSelectorSyncSetClustersByHealth)
+	metrics.Registry.MustRegister(metricSyncSetsByHealth)
 	metrics.Registry.MustRegister(metricControllerReconcileTime)
 	metrics.Registry.MustRegister(metricClusterDeploymentSyncsetPaused)
 }
@@ -435,6 +445,9 @@ func (mc *Calculator) calculateSyncSetMetrics(mcLog log.FieldLogger) {
 
 	ssInstancesTotal := 0
 	ssInstancesUnappliedTotal := 0
+	// Health is only reported for syncsets with a health check.
+	sssInstancesByHealth := map[string]map[hiveintv1alpha1.SyncSetHealth]int{}
+	ssInstancesByHealth := map[hiveintv1alpha1.SyncSetHealth]int{}
 	for _, cs := range clusterSyncList.Items {
 
 		for _, sss := range cs.Status.SelectorSyncSets {
@@ -442,12 +455,21 @@ func (mc *Calculator) calculateSyncSetMetrics(mcLog log.FieldLogger) {
 			if sss.Result != hiveintv1alpha1.SuccessSyncSetResult {
 				sssInstancesUnappliedTotal[sss.Name]++
 			}
+			if sss.Health != "" {
+				if sssInstancesByHealth[sss.Name] == nil {
+					sssInstancesByHealth[sss.Name] = map[hiveintv1alpha1.SyncSetHealth]int{}
+				}
+				sssInstancesByHealth[sss.Name][sss.Health]++
+			}
 		}
 		for _, ss := range cs.Status.SyncSets {
 			ssInstancesTotal++
 			if ss.Result != hiveintv1alpha1.SuccessSyncSetResult {
 				ssInstancesUnappliedTotal++
 			}
+			if ss.Health != "" {
+				ssInstancesByHealth[ss.Health]++
+			}
 		}
 	}
 	for k, v := range sssInstancesTotal {
@@ -467,6 +489,18 @@ func (mc *Calculator) calculateSyncSetMetrics(mcLog log.FieldLogger) {
 	}
 	metricSyncSetsTotal.Set(float64(ssInstancesTotal))
 	metricSyncSetsUnappliedTotal.Set(float64(ssInstancesUnappliedTotal))
+
+	// Reset the health metrics so that syncsets which no longer have a health check, or no longer exist, are cleared.
+	metricSelectorSyncSetClustersByHealth.Reset()
+	for name, byHealth := range sssInstancesByHealth {
+		for health, v := range byHealth {
+			metricSelectorSyncSetClustersByHealth.WithLabelValues(name, string(health)).Set(float64(v))
+		}
+	}
+	metricSyncSetsByHealth.Reset()
+	for health, v := range ssInstancesByHealth {
+		metricSyncSetsByHealth.WithLabelValues(string(health)).Set(float64(v))
+	}
 }
 
 func processJobs(jobs []batchv1.Job) (runningTotal, succeededTotal, failedTotal map[string]int) {
@@ -0,0 +1,340 @@
+package selectorsyncsetrollout
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/client-go/util/workqueue"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveintv1alpha1 "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	hivemetrics "github.com/openshift/hive/pkg/controller/metrics"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+)
+
+const (
+	ControllerName = hivev1.SelectorSyncSetRolloutControllerName
+)
+
+// Add creates a new SelectorSyncSetRollout Controller and adds it to the Manager with default RBAC. The Manager will set
+// fields on the Controller and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	logger := log.WithField("controller", ControllerName)
+	concurrentReconciles, clientRateLimiter, queueRateLimiter, err := controllerutils.GetControllerConfig(mgr.GetClient(), ControllerName)
+	if err != nil {
+		logger.WithError(err).Error("could not get controller configurations")
+		return err
+	}
+	return AddToManager(mgr, NewReconciler(mgr, clientRateLimiter), concurrentReconciles, queueRateLimiter)
+}
+
+// NewReconciler returns a new reconcile.Reconciler
+func NewReconciler(mgr manager.Manager, rateLimiter flowcontrol.RateLimiter) *ReconcileSelectorSyncSetRollout {
+	return &ReconcileSelectorSyncSetRollout{
+		Client: controllerutils.NewClientWithMetricsOrDie(mgr, ControllerName, &rateLimiter),
+		logger: log.WithField("controller", ControllerName),
+	}
+}
+
+// AddToManager adds a new Controller to mgr with r as the reconcile.Reconciler
+func AddToManager(mgr manager.Manager, r *ReconcileSelectorSyncSetRollout, concurrentReconciles int, rateLimiter workqueue.RateLimiter) error {
+	// Create a new controller
+	c, err := controller.New(ControllerName.String()+"-controller", mgr, controller.Options{
+		Reconciler:              controllerutils.NewDelayingReconciler(r, r.logger),
+		MaxConcurrentReconciles: concurrentReconciles,
+		RateLimiter:             rateLimiter,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to SelectorSyncSets
+	if err := c.Watch(source.Kind(mgr.GetCache(), &hivev1.SelectorSyncSet{}), &handler.EnqueueRequestForObject{}); err != nil {
+		return err
+	}
+
+	// Watch for changes to ClusterSyncs, which report the generation of each SelectorSyncSet applied to a cluster.
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &hiveintv1alpha1.ClusterSync{}),
+		handler.EnqueueRequestsFromMapFunc(requestsForClusterSync)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func requestsForClusterSync(ctx context.Context, o client.Object) []reconcile.Request {
+	clusterSync, ok := o.(*hiveintv1alpha1.ClusterSync)
+	if !ok {
+		return nil
+	}
+	requests := make([]reconcile.Request, len(clusterSync.Status.SelectorSyncSets))
+	for i, status := range clusterSync.Status.SelectorSyncSets {
+		requests[i].Name = status.Name
+	}
+	return requests
+}
+
+var _ reconcile.Reconciler = &ReconcileSelectorSyncSetRollout{}
+
+// ReconcileSelectorSyncSetRollout reconciles a SelectorSyncSet to progress the rollout of its current generation to the
+// clusters it applies to.
+type ReconcileSelectorSyncSetRollout struct {
+	client.Client
+	logger log.FieldLogger
+}
+
+// Reconcile tallies which of the clusters matching a SelectorSyncSet have applied its current generation, and expands,
+// halts, or completes the rollout of that generation accordingly.
+func (r *ReconcileSelectorSyncSetRollout) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := controllerutils.BuildControllerLogger(ControllerName, "selectorSyncSet", request.NamespacedName)
+	logger.Info("reconciling SelectorSyncSet rollout")
+	recobsrv := hivemetrics.NewReconcileObserver(ControllerName, logger)
+	defer recobsrv.ObserveControllerReconcileTime()
+
+	sss := &hivev1.SelectorSyncSet{}
+	if err := r.Get(context.TODO(), request.NamespacedName, sss); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Debug("SelectorSyncSet not found")
+			return reconcile.Result{}, nil
+		}
+		logger.WithError(err).Error("failed to get SelectorSyncSet")
+		return reconcile.Result{}, err
+	}
+
+	if sss.DeletionTimestamp != nil {
+		logger.Debug("SelectorSyncSet is being deleted")
+		return reconcile.Result{}, nil
+	}
+
+	strategy := sss.Spec.RolloutStrategy
+	if strategy == nil {
+		if sss.Status.Rollout == nil {
+			return reconcile.Result{}, nil
+		}
+		logger.Info("clearing rollout status since the SelectorSyncSet no longer has a rollout strategy")
+		sss.Status.Rollout = nil
+		return reconcile.Result{}, r.updateStatus(sss, logger)
+	}
+
+	origRollout := sss.Status.Rollout.DeepCopy()
+	rollout := sss.Status.Rollout
+	if rollout == nil || rollout.ObservedGeneration != sss.Generation {
+		logger.WithField("generation", sss.Generation).Info("starting rollout of new generation")
+		now := metav1.Now()
+		rollout = &hivev1.SelectorSyncSetRolloutStatus{
+			ObservedGeneration: sss.Generation,
+			Phase:              hivev1.ProgressingSelectorSyncSetRolloutPhase,
+			Percentage:         initialPercentage(strategy),
+			WaveStartTime:      &now,
+			LastTransitionTime: now,
+		}
+		sss.Status.Rollout = rollout
+	}
+	logger = logger.WithField("generation", rollout.ObservedGeneration).WithField("wave", rollout.Wave)
+
+	cds, err := r.getClusterDeploymentsForSelectorSyncSet(sss, logger)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	tally, err := r.tallyClusters(sss, cds, logger)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	rollout.TotalClusters = tally.total
+	rollout.UpdatedClusters = tally.updated
+	rollout.FailedClusters = tally.failed
+	rollout.OutdatedClusters = tally.total - tally.updated - tally.failed
+
+	requeueAfter := progressRollout(rollout, strategy, tally, logger)
+
+	if !reflect.DeepEqual(origRollout, rollout) {
+		if err := r.updateStatus(sss, logger); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	if requeueAfter > 0 {
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+// rolloutTally is the state of the clusters matching a SelectorSyncSet with respect to the generation being rolled out.
+type rolloutTally struct {
+	// total, updated and failed count all of the matching clusters.
+	total   int32
+	updated int32
+	failed  int32
+	// inWave, inWaveUpdated and inWaveFailed count only the clusters that are part of the rollout so far.
+	inWave        int32
+	inWaveUpdated int32
+	inWaveFailed  int32
+}
+
+func (r *ReconcileSelectorSyncSetRollout) tallyClusters(sss *hivev1.SelectorSyncSet, cds []hivev1.ClusterDeployment, logger log.FieldLogger) (*rolloutTally, error) {
+	tally := &rolloutTally{}
+	for i := range cds {
+		cd := &cds[i]
+		clusterSync := &hiveintv1alpha1.ClusterSync{}
+		var syncStatus *hiveintv1alpha1.SyncStatus
+		switch err := r.Get(context.TODO(), types.NamespacedName{Namespace: cd.Namespace, Name: cd.Name}, clusterSync); {
+		case apierrors.IsNotFound(err):
+			// The cluster has not been synced yet.
+		case err != nil:
+			logger.WithError(err).Log(controllerutils.LogLevel(err), "could not get ClusterSync")
+			return nil, err
+		default:
+			for j, status := range clusterSync.Status.SelectorSyncSets {
+				if status.Name == sss.Name {
+					syncStatus = &clusterSync.Status.SelectorSyncSets[j]
+					break
+				}
+			}
+		}
+
+		updated, failed := false, false
+		if syncStatus != nil && syncStatus.ObservedGeneration == sss.Generation {
+			updated = syncStatus.Result == hiveintv1alpha1.SuccessSyncSetResult
+			failed = syncStatus.Result == hiveintv1alpha1.FailureSyncSetResult
+		}
+
+		tally.total++
+		if updated {
+			tally.updated++
+		}
+		if failed {
+			tally.failed++
+		}
+		if controllerutils.IsClusterInSelectorSyncSetRollout(sss, cd, logger) {
+			tally.inWave++
+			if updated {
+				tally.inWaveUpdated++
+			}
+			if failed {
+				tally.inWaveFailed++
+			}
+		}
+	}
+	return tally, nil
+}
+
+// progressRollout updates the phase and wave of the rollout based on the tally of clusters. It returns how long to wait
+// before the rollout should be checked again, or zero if there is no need to check again until something changes.
+func progressRollout(rollout *hivev1.SelectorSyncSetRolloutStatus, strategy *hivev1.SelectorSyncSetRolloutStrategy, tally *rolloutTally, logger log.FieldLogger) time.Duration {
+	now := metav1.Now()
+	setPhase := func(phase hivev1.SelectorSyncSetRolloutPhase, message string) {
+		if rollout.Phase != phase {
+			logger.WithField("phase", phase).Info(message)
+			rollout.LastTransitionTime = now
+		}
+		rollout.Phase = phase
+		rollout.Message = message
+	}
+
+	switch rollout.Phase {
+	case hivev1.HaltedSelectorSyncSetRolloutPhase:
+		// A halted rollout stays halted until the SelectorSyncSet is changed again.
+		return 0
+	case hivev1.CompleteSelectorSyncSetRolloutPhase:
+		return 0
+	}
+
+	if tally.updated == tally.total {
+		setPhase(hivev1.CompleteSelectorSyncSetRolloutPhase, "All clusters have applied the current generation")
+		rollout.WaveSucceededTime = nil
+		return 0
+	}
+
+	if tally.inWaveFailed > 0 && tally.inWaveFailed*100 > strategy.MaxFailurePercentage*tally.inWave {
+		setPhase(hivev1.HaltedSelectorSyncSetRolloutPhase,
+			fmt.Sprintf("Rollout halted: %d of %d clusters in the rollout failed to apply the current generation", tally.inWaveFailed, tally.inWave))
+		rollout.WaveSucceededTime = nil
+		return 0
+	}
+
+	if tally.inWaveUpdated < tally.inWave {
+		setPhase(hivev1.ProgressingSelectorSyncSetRolloutPhase,
+			fmt.Sprintf("Waiting for %d of %d clusters in wave %d to apply the current generation", tally.inWave-tally.inWaveUpdated, tally.inWave, rollout.Wave))
+		rollout.WaveSucceededTime = nil
+		return 0
+	}
+
+	// Every cluster in the current wave has applied the current generation. If the wave contains no clusters, there
+	// is nothing to wait for.
+	if tally.inWave > 0 {
+		if rollout.WaveSucceededTime == nil {
+			rollout.WaveSucceededTime = &now
+		}
+		if wait := controllerutils.RolloutWaveInterval(strategy) - now.Sub(rollout.WaveSucceededTime.Time); wait > 0 {
+			setPhase(hivev1.ProgressingSelectorSyncSetRolloutPhase,
+				fmt.Sprintf("Wave %d succeeded; waiting before expanding the rollout", rollout.Wave))
+			return wait
+		}
+	}
+
+	rollout.Wave++
+	rollout.Percentage += controllerutils.RolloutWavePercentage(strategy)
+	if rollout.Percentage > 100 {
+		rollout.Percentage = 100
+	}
+	rollout.WaveStartTime = &now
+	rollout.WaveSucceededTime = nil
+	logger.WithField("percentage", rollout.Percentage).Info("expanding rollout to next wave")
+	setPhase(hivev1.ProgressingSelectorSyncSetRolloutPhase,
+		fmt.Sprintf("Rolling out to %d%% of clusters in wave %d", rollout.Percentage, rollout.Wave))
+	return 0
+}
+
+// initialPercentage is the percentage of clusters in the first wave of a rollout.
+func initialPercentage(strategy *hivev1.SelectorSyncSetRolloutStrategy) int32 {
+	if strategy.Canary == nil {
+		return controllerutils.RolloutWavePercentage(strategy)
+	}
+	return strategy.Canary.Percentage
+}
+
+func (r *ReconcileSelectorSyncSetRollout) getClusterDeploymentsForSelectorSyncSet(sss *hivev1.SelectorSyncSet, logger log.FieldLogger) ([]hivev1.ClusterDeployment, error) {
+	labelSelector, err := metav1.LabelSelectorAsSelector(&sss.Spec.ClusterDeploymentSelector)
+	if err != nil {
+		logger.WithError(err).Error("unable to convert selector")
+		return nil, err
+	}
+	cdList := &hivev1.ClusterDeploymentList{}
+	if err := r.List(context.TODO(), cdList, client.MatchingLabelsSelector{Selector: labelSelector}); err != nil {
+		logger.WithError(err).Log(controllerutils.LogLevel(err), "could not list ClusterDeployments matching SelectorSyncSet")
+		return nil, err
+	}
+	cds := make([]hivev1.ClusterDeployment, 0, len(cdList.Items))
+	for _, cd := range cdList.Items {
+		// Only installed clusters are synced.
+		if cd.DeletionTimestamp != nil || !cd.Spec.Installed {
+			continue
+		}
+		cds = append(cds, cd)
+	}
+	return cds, nil
+}
+
+func (r *ReconcileSelectorSyncSetRollout) updateStatus(sss *hivev1.SelectorSyncSet, logger log.FieldLogger) error {
+	if err := r.Status().Update(context.TODO(), sss); err != nil {
+		logger.WithError(err).Log(controllerutils.LogLevel(err), "could not update SelectorSyncSet status")
+		return err
+	}
+	return nil
+}
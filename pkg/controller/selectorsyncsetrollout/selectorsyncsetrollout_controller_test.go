@@ -0,0 +1,330 @@
+package selectorsyncsetrollout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveintv1alpha1 "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	testcd "github.com/openshift/hive/pkg/test/clusterdeployment"
+	testcs "github.com/openshift/hive/pkg/test/clustersync"
+	testfake "github.com/openshift/hive/pkg/test/fake"
+	testsss "github.com/openshift/hive/pkg/test/selectorsyncset"
+	"github.com/openshift/hive/pkg/util/scheme"
+)
+
+const (
+	testNamespace  = "test-namespace"
+	testSSSName    = "test-sss"
+	testGeneration = 2
+)
+
+func TestReconcileSelectorSyncSetRollout(t *testing.T) {
+	scheme := scheme.GetScheme()
+
+	strategy := &hivev1.SelectorSyncSetRolloutStrategy{
+		Canary: &hivev1.SelectorSyncSetCanary{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+		},
+		WavePercentage: pointer.Int32(50),
+		WaveInterval:   &metav1.Duration{Duration: time.Hour},
+	}
+
+	sssBuilder := testsss.FullBuilder(testSSSName, scheme).Options(
+		testsss.WithGeneration(testGeneration),
+		testsss.WithLabelSelector("foo", "bar"),
+		testsss.WithRolloutStrategy(strategy),
+	)
+
+	cdBuilder := func(name string, canary bool) testcd.Builder {
+		b := testcd.FullBuilder(testNamespace, name, scheme).Options(
+			testcd.Installed(),
+			testcd.WithLabel("foo", "bar"),
+		)
+		if canary {
+			b = b.Options(testcd.WithLabel("canary", "true"))
+		}
+		return b
+	}
+
+	clusterSync := func(name string, generation int64, result hiveintv1alpha1.SyncSetResult) runtime.Object {
+		return testcs.FullBuilder(testNamespace, name, scheme).Build(
+			testcs.WithSelectorSyncSetStatus(hiveintv1alpha1.SyncStatus{
+				Name:               testSSSName,
+				ObservedGeneration: generation,
+				Result:             result,
+			}),
+		)
+	}
+
+	rolloutStatus := func(phase hivev1.SelectorSyncSetRolloutPhase, wave, percentage int32, waveSucceeded *time.Time) *hivev1.SelectorSyncSetRolloutStatus {
+		status := &hivev1.SelectorSyncSetRolloutStatus{
+			ObservedGeneration: testGeneration,
+			Phase:              phase,
+			Wave:               wave,
+			Percentage:         percentage,
+		}
+		if waveSucceeded != nil {
+			status.WaveSucceededTime = &metav1.Time{Time: *waveSucceeded}
+		}
+		return status
+	}
+
+	recently := time.Now().Add(-time.Minute)
+	longAgo := time.Now().Add(-2 * time.Hour)
+
+	cases := []struct {
+		name              string
+		sss               *hivev1.SelectorSyncSet
+		existing          []runtime.Object
+		expectedRollout   *hivev1.SelectorSyncSetRolloutStatus
+		expectRequeue     bool
+		expectWaveSuccess bool
+	}{
+		{
+			name: "no rollout strategy",
+			sss:  testsss.FullBuilder(testSSSName, scheme).Build(testsss.WithGeneration(testGeneration)),
+		},
+		{
+			name: "rollout strategy removed",
+			sss: testsss.FullBuilder(testSSSName, scheme).Build(
+				testsss.WithGeneration(testGeneration),
+				testsss.WithRolloutStatus(rolloutStatus(hivev1.ProgressingSelectorSyncSetRolloutPhase, 0, 0, nil)),
+			),
+		},
+		{
+			name: "start rollout",
+			sss:  sssBuilder.Build(),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(),
+				cdBuilder("other", false).Build(),
+			},
+			expectedRollout: &hivev1.SelectorSyncSetRolloutStatus{
+				Phase:            hivev1.ProgressingSelectorSyncSetRolloutPhase,
+				TotalClusters:    2,
+				OutdatedClusters: 2,
+			},
+		},
+		{
+			name: "start rollout of new generation",
+			sss: sssBuilder.Build(testsss.WithRolloutStatus(&hivev1.SelectorSyncSetRolloutStatus{
+				ObservedGeneration: testGeneration - 1,
+				Phase:              hivev1.CompleteSelectorSyncSetRolloutPhase,
+				Wave:               3,
+				Percentage:         100,
+			})),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(),
+				clusterSync("canary", testGeneration-1, hiveintv1alpha1.SuccessSyncSetResult),
+			},
+			expectedRollout: &hivev1.SelectorSyncSetRolloutStatus{
+				Phase:            hivev1.ProgressingSelectorSyncSetRolloutPhase,
+				TotalClusters:    1,
+				OutdatedClusters: 1,
+			},
+		},
+		{
+			name: "canary wave succeeded, waiting for interval",
+			sss:  sssBuilder.Build(testsss.WithRolloutStatus(rolloutStatus(hivev1.ProgressingSelectorSyncSetRolloutPhase, 0, 0, &recently))),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(),
+				clusterSync("canary", testGeneration, hiveintv1alpha1.SuccessSyncSetResult),
+				cdBuilder("other", false).Build(),
+			},
+			expectedRollout: &hivev1.SelectorSyncSetRolloutStatus{
+				Phase:            hivev1.ProgressingSelectorSyncSetRolloutPhase,
+				TotalClusters:    2,
+				UpdatedClusters:  1,
+				OutdatedClusters: 1,
+			},
+			expectRequeue:     true,
+			expectWaveSuccess: true,
+		},
+		{
+			name: "canary wave succeeded, interval elapsed",
+			sss:  sssBuilder.Build(testsss.WithRolloutStatus(rolloutStatus(hivev1.ProgressingSelectorSyncSetRolloutPhase, 0, 0, &longAgo))),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(),
+				clusterSync("canary", testGeneration, hiveintv1alpha1.SuccessSyncSetResult),
+				cdBuilder("other", false).Build(),
+			},
+			expectedRollout: &hivev1.SelectorSyncSetRolloutStatus{
+				Phase:            hivev1.ProgressingSelectorSyncSetRolloutPhase,
+				Wave:             1,
+				Percentage:       50,
+				TotalClusters:    2,
+				UpdatedClusters:  1,
+				OutdatedClusters: 1,
+			},
+		},
+		{
+			name: "wave percentage capped at 100",
+			sss:  sssBuilder.Build(testsss.WithRolloutStatus(rolloutStatus(hivev1.ProgressingSelectorSyncSetRolloutPhase, 2, 75, &longAgo))),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(),
+				clusterSync("canary", testGeneration, hiveintv1alpha1.SuccessSyncSetResult),
+				cdBuilder("other", false).Build(),
+			},
+			expectedRollout: &hivev1.SelectorSyncSetRolloutStatus{
+				Phase:            hivev1.ProgressingSelectorSyncSetRolloutPhase,
+				Wave:             3,
+				Percentage:       100,
+				TotalClusters:    2,
+				UpdatedClusters:  1,
+				OutdatedClusters: 1,
+			},
+		},
+		{
+			name: "canary wave waiting for clusters",
+			sss:  sssBuilder.Build(testsss.WithRolloutStatus(rolloutStatus(hivev1.ProgressingSelectorSyncSetRolloutPhase, 0, 0, nil))),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(),
+				clusterSync("canary", testGeneration-1, hiveintv1alpha1.SuccessSyncSetResult),
+				cdBuilder("other", false).Build(),
+			},
+			expectedRollout: &hivev1.SelectorSyncSetRolloutStatus{
+				Phase:            hivev1.ProgressingSelectorSyncSetRolloutPhase,
+				TotalClusters:    2,
+				OutdatedClusters: 2,
+			},
+		},
+		{
+			name: "canary wave failed",
+			sss:  sssBuilder.Build(testsss.WithRolloutStatus(rolloutStatus(hivev1.ProgressingSelectorSyncSetRolloutPhase, 0, 0, nil))),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(),
+				clusterSync("canary", testGeneration, hiveintv1alpha1.FailureSyncSetResult),
+				cdBuilder("other", false).Build(),
+			},
+			expectedRollout: &hivev1.SelectorSyncSetRolloutStatus{
+				Phase:            hivev1.HaltedSelectorSyncSetRolloutPhase,
+				TotalClusters:    2,
+				FailedClusters:   1,
+				OutdatedClusters: 1,
+			},
+		},
+		{
+			name: "canary wave failures within tolerance",
+			sss: testsss.FullBuilder(testSSSName, scheme).Build(
+				testsss.WithGeneration(testGeneration),
+				testsss.WithLabelSelector("foo", "bar"),
+				testsss.WithRolloutStrategy(&hivev1.SelectorSyncSetRolloutStrategy{
+					Canary:               strategy.Canary,
+					MaxFailurePercentage: 50,
+				}),
+				testsss.WithRolloutStatus(rolloutStatus(hivev1.ProgressingSelectorSyncSetRolloutPhase, 0, 0, nil)),
+			),
+			existing: []runtime.Object{
+				cdBuilder("canary-1", true).Build(),
+				clusterSync("canary-1", testGeneration, hiveintv1alpha1.FailureSyncSetResult),
+				cdBuilder("canary-2", true).Build(),
+				clusterSync("canary-2", testGeneration, hiveintv1alpha1.SuccessSyncSetResult),
+			},
+			expectedRollout: &hivev1.SelectorSyncSetRolloutStatus{
+				Phase:           hivev1.ProgressingSelectorSyncSetRolloutPhase,
+				TotalClusters:   2,
+				UpdatedClusters: 1,
+				FailedClusters:  1,
+			},
+		},
+		{
+			name: "halted rollout stays halted",
+			sss:  sssBuilder.Build(testsss.WithRolloutStatus(rolloutStatus(hivev1.HaltedSelectorSyncSetRolloutPhase, 0, 0, nil))),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(),
+				clusterSync("canary", testGeneration, hiveintv1alpha1.SuccessSyncSetResult),
+				cdBuilder("other", false).Build(),
+			},
+			expectedRollout: &hivev1.SelectorSyncSetRolloutStatus{
+				Phase:            hivev1.HaltedSelectorSyncSetRolloutPhase,
+				TotalClusters:    2,
+				UpdatedClusters:  1,
+				OutdatedClusters: 1,
+			},
+		},
+		{
+			name: "rollout complete",
+			sss:  sssBuilder.Build(testsss.WithRolloutStatus(rolloutStatus(hivev1.ProgressingSelectorSyncSetRolloutPhase, 2, 100, nil))),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(),
+				clusterSync("canary", testGeneration, hiveintv1alpha1.SuccessSyncSetResult),
+				cdBuilder("other", false).Build(),
+				clusterSync("other", testGeneration, hiveintv1alpha1.SuccessSyncSetResult),
+			},
+			expectedRollout: &hivev1.SelectorSyncSetRolloutStatus{
+				Phase:           hivev1.CompleteSelectorSyncSetRolloutPhase,
+				Wave:            2,
+				Percentage:      100,
+				TotalClusters:   2,
+				UpdatedClusters: 2,
+			},
+		},
+		{
+			name: "uninstalled and unselected clusters ignored",
+			sss:  sssBuilder.Build(testsss.WithRolloutStatus(rolloutStatus(hivev1.ProgressingSelectorSyncSetRolloutPhase, 0, 0, nil))),
+			existing: []runtime.Object{
+				cdBuilder("canary", true).Build(),
+				clusterSync("canary", testGeneration, hiveintv1alpha1.SuccessSyncSetResult),
+				testcd.FullBuilder(testNamespace, "uninstalled", scheme).Build(testcd.WithLabel("foo", "bar")),
+				testcd.FullBuilder(testNamespace, "unselected", scheme).Build(testcd.Installed()),
+			},
+			expectedRollout: &hivev1.SelectorSyncSetRolloutStatus{
+				Phase:           hivev1.CompleteSelectorSyncSetRolloutPhase,
+				TotalClusters:   1,
+				UpdatedClusters: 1,
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := log.New()
+			logger.SetLevel(log.DebugLevel)
+			c := testfake.NewFakeClientBuilder().WithRuntimeObjects(append(tc.existing, tc.sss)...).Build()
+			rcd := &ReconcileSelectorSyncSetRollout{
+				Client: c,
+				logger: logger,
+			}
+			result, err := rcd.Reconcile(context.TODO(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: testSSSName},
+			})
+			require.NoError(t, err, "unexpected error from Reconcile")
+			if tc.expectRequeue {
+				assert.Positive(t, result.RequeueAfter, "expected requeue")
+			} else {
+				assert.Zero(t, result.RequeueAfter, "unexpected requeue")
+			}
+
+			sss := &hivev1.SelectorSyncSet{}
+			require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: testSSSName}, sss), "could not get SelectorSyncSet")
+			if tc.expectedRollout == nil {
+				assert.Nil(t, sss.Status.Rollout, "expected no rollout status")
+				return
+			}
+			rollout := sss.Status.Rollout
+			require.NotNil(t, rollout, "expected rollout status")
+			assert.Equal(t, sss.Generation, rollout.ObservedGeneration, "unexpected observed generation")
+			assert.Equal(t, tc.expectedRollout.Phase, rollout.Phase, "unexpected phase")
+			assert.Equal(t, tc.expectedRollout.Wave, rollout.Wave, "unexpected wave")
+			assert.Equal(t, tc.expectedRollout.Percentage, rollout.Percentage, "unexpected percentage")
+			assert.Equal(t, tc.expectedRollout.TotalClusters, rollout.TotalClusters, "unexpected total clusters")
+			assert.Equal(t, tc.expectedRollout.UpdatedClusters, rollout.UpdatedClusters, "unexpected updated clusters")
+			assert.Equal(t, tc.expectedRollout.FailedClusters, rollout.FailedClusters, "unexpected failed clusters")
+			assert.Equal(t, tc.expectedRollout.OutdatedClusters, rollout.OutdatedClusters, "unexpected outdated clusters")
+			if tc.expectWaveSuccess {
+				assert.NotNil(t, rollout.WaveSucceededTime, "expected wave succeeded time")
+			} else {
+				assert.Nil(t, rollout.WaveSucceededTime, "unexpected wave succeeded time")
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package syncidentityprovider
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveintv1alpha1 "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	"github.com/openshift/hive/pkg/constants"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+)
+
+// addStatusController adds a controller to mgr which gathers the status of the application of the identity providers
+// to each cluster into the status of the SyncIdentityProviders and SelectorSyncIdentityProviders. The status of each
+// identity provider is written by a reconcile of the identity provider itself, rather than by the reconcile of each of
+// the clusters that it applies to, in the same way that the status of each cluster's syncsets is gathered in its
+// ClusterSync.
+func addStatusController(mgr manager.Manager, r *ReconcileIdentityProviderStatus, concurrentReconciles int) error {
+	c, err := controller.New(ControllerName.String()+"-status-controller", mgr, controller.Options{
+		Reconciler:              controllerutils.NewDelayingReconciler(r, r.logger),
+		MaxConcurrentReconciles: concurrentReconciles,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to the spec of SyncIdentityProviders and SelectorSyncIdentityProviders
+	if err := c.Watch(source.Kind(mgr.GetCache(), &hivev1.SyncIdentityProvider{}),
+		&handler.EnqueueRequestForObject{}, predicate.GenerationChangedPredicate{}); err != nil {
+		return err
+	}
+	if err := c.Watch(source.Kind(mgr.GetCache(), &hivev1.SelectorSyncIdentityProvider{}),
+		&handler.EnqueueRequestForObject{}, predicate.GenerationChangedPredicate{}); err != nil {
+		return err
+	}
+
+	// Watch for ClusterDeployments being added, removed or relabeled, which changes the clusters that the identity
+	// providers apply to.
+	if err := c.Watch(source.Kind(mgr.GetCache(), &hivev1.ClusterDeployment{}),
+		handler.EnqueueRequestsFromMapFunc(r.requestsForClusterDeployment), predicate.LabelChangedPredicate{}); err != nil {
+		return err
+	}
+
+	// Watch for changes to the identity provider syncsets of clusters
+	if err := c.Watch(source.Kind(mgr.GetCache(), &hivev1.SyncSet{}),
+		handler.EnqueueRequestsFromMapFunc(r.requestsForCluster(constants.ClusterDeploymentNameLabel)),
+		predicate.And(isIdentityProviderSyncSet(), predicate.GenerationChangedPredicate{})); err != nil {
+		return err
+	}
+
+	// Watch for changes to the result of applying the identity provider syncsets of clusters
+	if err := c.Watch(source.Kind(mgr.GetCache(), &hiveintv1alpha1.ClusterSync{}),
+		handler.EnqueueRequestsFromMapFunc(r.requestsForCluster("")),
+		identityProviderSyncStatusChanged()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// isIdentityProviderSyncSet filters out the syncsets other than those generated for the identity providers of clusters.
+func isIdentityProviderSyncSet() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.GetLabels()[constants.SyncSetTypeLabel] == constants.SyncSetTypeIdentityProvider
+	})
+}
+
+// identityProviderSyncStatusChanged filters out updates to ClusterSyncs which do not change the status of the identity
+// provider syncset of the cluster.
+func identityProviderSyncStatusChanged() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldClusterSync, ok := e.ObjectOld.(*hiveintv1alpha1.ClusterSync)
+			if !ok {
+				return true
+			}
+			newClusterSync, ok := e.ObjectNew.(*hiveintv1alpha1.ClusterSync)
+			if !ok {
+				return true
+			}
+			ssName := GenerateIdentityProviderSyncSetName(newClusterSync.Name)
+			return !reflect.DeepEqual(findSyncStatus(oldClusterSync, ssName), findSyncStatus(newClusterSync, ssName))
+		},
+	}
+}
+
+func findSyncStatus(clusterSync *hiveintv1alpha1.ClusterSync, name string) *hiveintv1alpha1.SyncStatus {
+	for i, syncStatus := range clusterSync.Status.SyncSets {
+		if syncStatus.Name == name {
+			return &clusterSync.Status.SyncSets[i]
+		}
+	}
+	return nil
+}
+
+var _ reconcile.Reconciler = &ReconcileIdentityProviderStatus{}
+
+// ReconcileIdentityProviderStatus reconciles the status of a SyncIdentityProvider or SelectorSyncIdentityProvider.
+// SelectorSyncIdentityProviders are cluster-scoped, so requests without a namespace are for
+// SelectorSyncIdentityProviders.
+type ReconcileIdentityProviderStatus struct {
+	client.Client
+	logger log.FieldLogger
+
+	// idps determines the identity providers of clusters in the same way as the controller which syncs them.
+	idps *ReconcileSyncIdentityProviders
+}
+
+// requestsForClusterDeployment returns requests for the identity providers which apply to the cluster.
+func (r *ReconcileIdentityProviderStatus) requestsForClusterDeployment(ctx context.Context, o client.Object) []reconcile.Request {
+	cd, ok := o.(*hivev1.ClusterDeployment)
+	if !ok {
+		return nil
+	}
+	logger := controllerutils.BuildControllerLogger(ControllerName, "clusterDeployment", client.ObjectKeyFromObject(cd))
+	related, err := r.idps.getRelatedIdentityProviders(cd, logger)
+	if err != nil {
+		logger.WithError(err).Error("could not get identity providers of cluster")
+		return nil
+	}
+	requests := make([]reconcile.Request, len(related))
+	for i, source := range related {
+		requests[i].NamespacedName = client.ObjectKeyFromObject(source.object)
+	}
+	return requests
+}
+
+// requestsForCluster returns a function which returns requests for the identity providers which apply to the cluster
+// of an object in the namespace of the cluster. The cluster is named by the label, or has the name of the object if the
+// label is empty.
+func (r *ReconcileIdentityProviderStatus) requestsForCluster(label string) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		name := o.GetName()
+		if label != "" {
+			name = o.GetLabels()[label]
+		}
+		cd := &hivev1.ClusterDeployment{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: o.GetNamespace(), Name: name}, cd); err != nil {
+			if !errors.IsNotFound(err) {
+				r.logger.WithError(err).Error("could not get cluster deployment")
+			}
+			return nil
+		}
+		return r.requestsForClusterDeployment(ctx, cd)
+	}
+}
+
+// Reconcile sets the status of the application of the identity providers of a SyncIdentityProvider or
+// SelectorSyncIdentityProvider to each of the clusters to which it applies.
+func (r *ReconcileIdentityProviderStatus) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := controllerutils.BuildControllerLogger(ControllerName, "identityProvider", request.NamespacedName)
+	logger.Debug("reconciling identity provider status")
+
+	var (
+		object client.Object
+		status *hivev1.IdentityProviderStatus
+		cds    []*hivev1.ClusterDeployment
+	)
+	if request.Namespace == "" {
+		ssidp := &hivev1.SelectorSyncIdentityProvider{}
+		if err := r.Get(ctx, request.NamespacedName, ssidp); err != nil {
+			if errors.IsNotFound(err) {
+				return reconcile.Result{}, nil
+			}
+			logger.WithError(err).Error("could not get SelectorSyncIdentityProvider")
+			return reconcile.Result{}, err
+		}
+		object, status = ssidp, &ssidp.Status
+		labelSelector, err := metav1.LabelSelectorAsSelector(&ssidp.Spec.ClusterDeploymentSelector)
+		if err != nil {
+			logger.WithError(err).Error("error converting LabelSelector to Selector")
+			return reconcile.Result{}, nil
+		}
+		cdList := &hivev1.ClusterDeploymentList{}
+		if err := r.List(ctx, cdList, client.MatchingLabelsSelector{Selector: labelSelector}); err != nil {
+			logger.WithError(err).Error("could not list cluster deployments")
+			return reconcile.Result{}, err
+		}
+		for i := range cdList.Items {
+			cds = append(cds, &cdList.Items[i])
+		}
+	} else {
+		sidp := &hivev1.SyncIdentityProvider{}
+		if err := r.Get(ctx, request.NamespacedName, sidp); err != nil {
+			if errors.IsNotFound(err) {
+				return reconcile.Result{}, nil
+			}
+			logger.WithError(err).Error("could not get SyncIdentityProvider")
+			return reconcile.Result{}, err
+		}
+		object, status = sidp, &sidp.Status
+		for _, ref := range sidp.Spec.ClusterDeploymentRefs {
+			cd := &hivev1.ClusterDeployment{}
+			switch err := r.Get(ctx, types.NamespacedName{Namespace: sidp.Namespace, Name: ref.Name}, cd); {
+			case errors.IsNotFound(err):
+				continue
+			case err != nil:
+				logger.WithError(err).Error("could not get cluster deployment")
+				return reconcile.Result{}, err
+			}
+			cds = append(cds, cd)
+		}
+	}
+
+	var clusterStatuses []hivev1.IdentityProviderClusterStatus
+	result := reconcile.Result{}
+	for _, cd := range cds {
+		cdLogger := controllerutils.AddLogFields(controllerutils.MetaObjectLogTagger{Object: cd}, logger)
+		clusterStatus, err := r.idps.getClusterStatus(cd, cdLogger)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if clusterStatus == nil {
+			continue
+		}
+		clusterStatuses = append(clusterStatuses, *clusterStatus)
+		// Objects referenced by identity providers are not watched, so check again for clusters that are failing
+		// because of them.
+		if clusterStatus.Result == hivev1.FailedIdentityProviderApplyResult {
+			result.RequeueAfter = missingReferencedObjectRequeueInterval
+		}
+	}
+
+	if !setClusterStatuses(status, clusterStatuses) {
+		return result, nil
+	}
+	logger.Info("updating identity provider status")
+	if err := r.Status().Update(ctx, object); err != nil {
+		logger.WithError(err).Log(controllerutils.LogLevel(err), "could not update identity provider status")
+		return reconcile.Result{}, err
+	}
+	return result, nil
+}
+
+// setClusterStatuses replaces the statuses of the clusters in the status of an identity provider, keeping the last
+// transition time of clusters whose status is unchanged. It returns true if the status was changed.
+func setClusterStatuses(status *hivev1.IdentityProviderStatus, clusterStatuses []hivev1.IdentityProviderClusterStatus) bool {
+	now := metav1.Now()
+	for i, clusterStatus := range clusterStatuses {
+		clusterStatuses[i].LastTransitionTime = now
+		for _, existing := range status.ClusterDeployments {
+			if existing.Namespace == clusterStatus.Namespace && existing.Name == clusterStatus.Name &&
+				existing.Result == clusterStatus.Result && existing.Message == clusterStatus.Message {
+				clusterStatuses[i].LastTransitionTime = existing.LastTransitionTime
+				break
+			}
+		}
+	}
+	sort.Slice(clusterStatuses, func(i, j int) bool {
+		a, b := clusterStatuses[i], clusterStatuses[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	if len(clusterStatuses) == 0 && len(status.ClusterDeployments) == 0 ||
+		reflect.DeepEqual(clusterStatuses, status.ClusterDeployments) {
+		return false
+	}
+	status.ClusterDeployments = clusterStatuses
+	return true
+}
@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"sort"
 	"strconv"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
@@ -31,6 +32,7 @@ import (
 
 	apihelpers "github.com/openshift/hive/apis/helpers"
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveintv1alpha1 "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
 	"github.com/openshift/hive/pkg/constants"
 	hivemetrics "github.com/openshift/hive/pkg/controller/metrics"
 	controllerutils "github.com/openshift/hive/pkg/controller/utils"
@@ -43,6 +45,14 @@ const (
 	oauthAPIVersion = "config.openshift.io/v1"
 	oauthKind       = "OAuth"
 	oauthObjectName = "cluster"
+
+	// openshiftConfigNamespace is the namespace in the cluster of the Secrets and ConfigMaps referenced by the OAuth
+	// configuration.
+	openshiftConfigNamespace = "openshift-config"
+
+	// missingReferencedObjectRequeueInterval is how long to wait before checking again for a Secret or ConfigMap
+	// referenced by an identity provider that does not exist on the hub.
+	missingReferencedObjectRequeueInterval = time.Minute
 )
 
 // Add creates a new IdentityProvider Controller and adds it to the Manager with default RBAC. The Manager will set fields on the
@@ -96,7 +106,15 @@ func AddToManager(mgr manager.Manager, r reconcile.Reconciler, concurrentReconci
 
 	// Watch for changes to ClusterDeployment (easy case)
 	err = c.Watch(source.Kind(mgr.GetCache(), &hivev1.ClusterDeployment{}), &handler.EnqueueRequestForObject{})
-	return err
+	if err != nil {
+		return err
+	}
+
+	return addStatusController(mgr, &ReconcileIdentityProviderStatus{
+		Client: reconciler.Client,
+		logger: reconciler.logger,
+		idps:   reconciler,
+	}, concurrentReconciles)
 }
 
 func (r *ReconcileSyncIdentityProviders) syncIdentityProviderWatchHandler(ctx context.Context, a client.Object) []reconcile.Request {
@@ -168,6 +186,20 @@ type identityProviderPatch struct {
 
 type identityProviderPatchSpec struct {
 	IdentityProviders []openshiftapiv1.IdentityProvider `json:"identityProviders"`
+	TokenConfig       *openshiftapiv1.TokenConfig       `json:"tokenConfig,omitempty"`
+}
+
+// identityProviderSource is a SyncIdentityProvider or SelectorSyncIdentityProvider.
+type identityProviderSource struct {
+	object client.Object
+	spec   *hivev1.SyncIdentityProviderCommonSpec
+	status *hivev1.IdentityProviderStatus
+}
+
+// referencedObject is a Secret or ConfigMap referenced by an identity provider.
+type referencedObject struct {
+	kind hivev1.IdentityProviderObjectKind
+	name string
 }
 
 // Reconcile reads that state of the cluster for a ClusterDeployment object and makes changes to the
@@ -212,13 +244,20 @@ func (r *ReconcileSyncIdentityProviders) Reconcile(ctx context.Context, request
 		return reconcile.Result{}, nil
 	}
 
-	return reconcile.Result{}, r.syncIdentityProviders(cd, contextLogger)
+	return r.syncIdentityProviders(cd, contextLogger)
 }
 
-func (r *ReconcileSyncIdentityProviders) createSyncSetSpec(cd *hivev1.ClusterDeployment, idps []openshiftapiv1.IdentityProvider) (*hivev1.SyncSetSpec, error) {
+func (r *ReconcileSyncIdentityProviders) createSyncSetSpec(
+	cd *hivev1.ClusterDeployment,
+	idps []openshiftapiv1.IdentityProvider,
+	tokenConfig *openshiftapiv1.TokenConfig,
+	resources []runtime.RawExtension,
+	secrets []hivev1.SecretMapping,
+) (*hivev1.SyncSetSpec, error) {
 	idpPatch := identityProviderPatch{
 		Spec: identityProviderPatchSpec{
 			IdentityProviders: idps,
+			TokenConfig:       tokenConfig,
 		},
 	}
 
@@ -234,6 +273,8 @@ func (r *ReconcileSyncIdentityProviders) createSyncSetSpec(cd *hivev1.ClusterDep
 			},
 		},
 		SyncSetCommonSpec: hivev1.SyncSetCommonSpec{
+			Resources: resources,
+			Secrets:   secrets,
 			Patches: []hivev1.SyncObjectPatch{
 				{
 					APIVersion: oauthAPIVersion,
@@ -252,36 +293,62 @@ func GenerateIdentityProviderSyncSetName(clusterDeploymentName string) string {
 	return apihelpers.GetResourceName(clusterDeploymentName, constants.IdentityProviderSuffix)
 }
 
-func (r *ReconcileSyncIdentityProviders) syncIdentityProviders(cd *hivev1.ClusterDeployment, contextLogger *log.Entry) error {
-	idpsFromSSIDP, err := r.getRelatedSelectorSyncIdentityProviders(cd, contextLogger)
+func (r *ReconcileSyncIdentityProviders) syncIdentityProviders(cd *hivev1.ClusterDeployment, contextLogger *log.Entry) (reconcile.Result, error) {
+	ssidps, err := r.getRelatedSelectorSyncIdentityProviders(cd, contextLogger)
 	if err != nil {
-		return err
+		return reconcile.Result{}, err
 	}
 
-	idpsFromSIDP, err := r.getRelatedSyncIdentityProviders(cd)
+	sidps, err := r.getRelatedSyncIdentityProviders(cd)
 	if err != nil {
-		return err
+		return reconcile.Result{}, err
+	}
+
+	var idpsFromSSIDP, idpsFromSIDP []openshiftapiv1.IdentityProvider
+	for _, ssidp := range ssidps {
+		idpsFromSSIDP = append(idpsFromSSIDP, ssidp.spec.IdentityProviders...)
+	}
+	for _, sidp := range sidps {
+		idpsFromSIDP = append(idpsFromSIDP, sidp.spec.IdentityProviders...)
 	}
 
-	allIdps := append([]openshiftapiv1.IdentityProvider{}, idpsFromSSIDP...)
-	allIdps = append(allIdps, idpsFromSIDP...)
+	// Sort so that the patch is consistent
+	allIdps := append([]openshiftapiv1.IdentityProvider{}, sortIdentityProviders(idpsFromSSIDP)...)
+	allIdps = append(allIdps, sortIdentityProviders(idpsFromSIDP)...)
+
+	// SyncIdentityProviders take precedence over SelectorSyncIdentityProviders for the token config.
+	related := append(append([]identityProviderSource{}, sidps...), ssidps...)
+	var tokenConfig *openshiftapiv1.TokenConfig
+	for _, source := range related {
+		if source.spec.TokenConfig != nil {
+			tokenConfig = source.spec.TokenConfig
+			break
+		}
+	}
+
+	resources, secrets, err := r.getReferencedObjects(cd, related)
+	if err != nil {
+		contextLogger.WithError(err).Warn("could not copy objects referenced by identity providers")
+		return reconcile.Result{RequeueAfter: missingReferencedObjectRequeueInterval}, nil
+	}
 
 	// Create a SyncSetSpec that includes all IdentityProviders as a patch
-	newSyncSetSpec, err := r.createSyncSetSpec(cd, allIdps)
+	newSyncSetSpec, err := r.createSyncSetSpec(cd, allIdps, tokenConfig, resources, secrets)
 	if err != nil {
-		return err
+		return reconcile.Result{}, err
 	}
 
 	ssName := GenerateIdentityProviderSyncSetName(cd.Name)
 
 	ss := &hivev1.SyncSet{}
 	err = r.Get(context.TODO(), types.NamespacedName{Name: ssName, Namespace: cd.Namespace}, ss)
-	if errors.IsNotFound(err) {
+	switch {
+	case errors.IsNotFound(err):
 		if len(allIdps) == 0 {
 			// The IDP list is empty -and- an existing syncset wasn't found, which means that IDPs on this cluster
 			// haven't been managed previously. Therefore, DO NOT write out a syncset.
 			contextLogger.Debug("IDP list empty and syncset not found. Not writing out syncset with empty IDP list.")
-			return nil
+			return reconcile.Result{}, nil
 		}
 
 		ss = &hivev1.SyncSet{
@@ -298,37 +365,243 @@ func (r *ReconcileSyncIdentityProviders) syncIdentityProviders(cd *hivev1.Cluste
 		ss.Labels = k8slabels.AddLabel(ss.Labels, constants.SyncSetTypeLabel, constants.SyncSetTypeIdentityProvider)
 		if err := controllerutil.SetControllerReference(cd, ss, r.scheme); err != nil {
 			contextLogger.WithError(err).Error("error setting controller reference on syncset")
-			return err
+			return reconcile.Result{}, err
 		}
 
 		if err := r.Create(context.TODO(), ss); err != nil {
 			contextLogger.WithError(err).Log(controllerutils.LogLevel(err), "error creating syncset")
-			return err
+			return reconcile.Result{}, err
 		}
 
-		// we successfully created it.
-		return nil
-	}
-
-	if err != nil {
+	case err != nil:
 		contextLogger.WithError(err).Error("error checking for existing syncset")
-		return err
-	}
+		return reconcile.Result{}, err
 
 	// update the syncset if there have been changes
-	if !reflect.DeepEqual(ss.Spec, *newSyncSetSpec) {
+	case !reflect.DeepEqual(ss.Spec, *newSyncSetSpec):
 		ss.Spec = *newSyncSetSpec
 		if err := r.Update(context.TODO(), ss); err != nil {
 			errDetails := fmt.Errorf("error updating existing syncset: %v", err)
 			contextLogger.Error(errDetails)
-			return errDetails
+			return reconcile.Result{}, errDetails
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// getReferencedObjects returns the ConfigMaps to sync as resources and the Secrets to sync as secret mappings for the
+// Secrets and ConfigMaps referenced by the identity providers that copy their referenced objects.
+func (r *ReconcileSyncIdentityProviders) getReferencedObjects(cd *hivev1.ClusterDeployment, sources []identityProviderSource) ([]runtime.RawExtension, []hivev1.SecretMapping, error) {
+	sourceNames := map[referencedObject]string{}
+	var objects []referencedObject
+	for _, source := range sources {
+		if source.spec.ReferencedObjects == nil {
+			continue
+		}
+		mappings := map[referencedObject]string{}
+		for _, m := range source.spec.ReferencedObjects.Mappings {
+			mappings[referencedObject{kind: m.Kind, name: m.Name}] = m.SourceName
+		}
+		for _, idp := range source.spec.IdentityProviders {
+			for _, obj := range referencedObjects(&idp.IdentityProviderConfig) {
+				sourceName, ok := mappings[obj]
+				if !ok {
+					sourceName = obj.name
+				}
+				if existing, ok := sourceNames[obj]; ok {
+					if existing != sourceName {
+						return nil, nil, fmt.Errorf("%s %s is copied from both %s and %s", obj.kind, obj.name, existing, sourceName)
+					}
+					continue
+				}
+				sourceNames[obj] = sourceName
+				objects = append(objects, obj)
+			}
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		if objects[i].kind != objects[j].kind {
+			return objects[i].kind < objects[j].kind
+		}
+		return objects[i].name < objects[j].name
+	})
+
+	var resources []runtime.RawExtension
+	var secrets []hivev1.SecretMapping
+	for _, obj := range objects {
+		key := types.NamespacedName{Namespace: cd.Namespace, Name: sourceNames[obj]}
+		switch obj.kind {
+		case hivev1.SecretIdentityProviderObjectKind:
+			// Secrets are copied by the syncset so that their data is not stored in the syncset.
+			if err := r.Get(context.TODO(), key, &corev1.Secret{}); err != nil {
+				return nil, nil, referencedObjectError(obj, key, err)
+			}
+			secrets = append(secrets, hivev1.SecretMapping{
+				SourceRef: hivev1.SecretReference{Name: key.Name},
+				TargetRef: hivev1.SecretReference{Namespace: openshiftConfigNamespace, Name: obj.name},
+			})
+		case hivev1.ConfigMapIdentityProviderObjectKind:
+			cm := &corev1.ConfigMap{}
+			if err := r.Get(context.TODO(), key, cm); err != nil {
+				return nil, nil, referencedObjectError(obj, key, err)
+			}
+			raw, err := configMapResource(obj.name, cm)
+			if err != nil {
+				return nil, nil, err
+			}
+			resources = append(resources, runtime.RawExtension{Raw: raw})
+		}
+	}
+	return resources, secrets, nil
+}
+
+func referencedObjectError(obj referencedObject, key types.NamespacedName, err error) error {
+	if errors.IsNotFound(err) {
+		return fmt.Errorf("%s %s referenced by an identity provider does not exist on the hub as %s", obj.kind, obj.name, key)
+	}
+	return fmt.Errorf("could not get %s %s: %w", obj.kind, key, err)
+}
+
+// configMapResource returns the manifest of a copy of the ConfigMap in the openshift-config namespace. The manifest is
+// encoded from a map so that it has the same encoding as the resource read back from the syncset.
+func configMapResource(name string, cm *corev1.ConfigMap) ([]byte, error) {
+	resource := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"namespace": openshiftConfigNamespace,
+			"name":      name,
+		},
+	}
+	if len(cm.Data) > 0 {
+		resource["data"] = cm.Data
+	}
+	if len(cm.BinaryData) > 0 {
+		resource["binaryData"] = cm.BinaryData
+	}
+	return json.Marshal(resource)
+}
+
+// referencedObjects returns the Secrets and ConfigMaps in the openshift-config namespace referenced by an identity
+// provider.
+func referencedObjects(config *openshiftapiv1.IdentityProviderConfig) []referencedObject {
+	var objects []referencedObject
+	secret := func(ref openshiftapiv1.SecretNameReference) {
+		if ref.Name != "" {
+			objects = append(objects, referencedObject{kind: hivev1.SecretIdentityProviderObjectKind, name: ref.Name})
 		}
 	}
+	configMap := func(ref openshiftapiv1.ConfigMapNameReference) {
+		if ref.Name != "" {
+			objects = append(objects, referencedObject{kind: hivev1.ConfigMapIdentityProviderObjectKind, name: ref.Name})
+		}
+	}
+	remote := func(info openshiftapiv1.OAuthRemoteConnectionInfo) {
+		configMap(info.CA)
+		secret(info.TLSClientCert)
+		secret(info.TLSClientKey)
+	}
+	switch {
+	case config.BasicAuth != nil:
+		remote(config.BasicAuth.OAuthRemoteConnectionInfo)
+	case config.GitHub != nil:
+		secret(config.GitHub.ClientSecret)
+		configMap(config.GitHub.CA)
+	case config.GitLab != nil:
+		secret(config.GitLab.ClientSecret)
+		configMap(config.GitLab.CA)
+	case config.Google != nil:
+		secret(config.Google.ClientSecret)
+	case config.HTPasswd != nil:
+		secret(config.HTPasswd.FileData)
+	case config.Keystone != nil:
+		remote(config.Keystone.OAuthRemoteConnectionInfo)
+	case config.LDAP != nil:
+		secret(config.LDAP.BindPassword)
+		configMap(config.LDAP.CA)
+	case config.OpenID != nil:
+		secret(config.OpenID.ClientSecret)
+		configMap(config.OpenID.CA)
+	case config.RequestHeader != nil:
+		configMap(config.RequestHeader.ClientCA)
+	}
+	return objects
+}
+
+// getClusterStatus determines whether the identity providers of the cluster have been applied to the cluster from the
+// ClusterSync of the cluster. It returns nil if the identity providers of the cluster are not managed.
+func (r *ReconcileSyncIdentityProviders) getClusterStatus(cd *hivev1.ClusterDeployment, contextLogger *log.Entry) (*hivev1.IdentityProviderClusterStatus, error) {
+	related, err := r.getRelatedIdentityProviders(cd, contextLogger)
+	if err != nil {
+		return nil, err
+	}
+	clusterStatus := func(result hivev1.IdentityProviderApplyResult, message string) (*hivev1.IdentityProviderClusterStatus, error) {
+		return &hivev1.IdentityProviderClusterStatus{
+			Namespace: cd.Namespace,
+			Name:      cd.Name,
+			Result:    result,
+			Message:   message,
+		}, nil
+	}
+	pending := func() (*hivev1.IdentityProviderClusterStatus, error) {
+		return clusterStatus(hivev1.PendingIdentityProviderApplyResult, "waiting for the identity providers to be applied to the cluster")
+	}
+
+	if _, _, err := r.getReferencedObjects(cd, related); err != nil {
+		return clusterStatus(hivev1.FailedIdentityProviderApplyResult, err.Error())
+	}
 
-	return nil
+	ss := &hivev1.SyncSet{}
+	switch err := r.Get(context.TODO(), types.NamespacedName{Namespace: cd.Namespace, Name: GenerateIdentityProviderSyncSetName(cd.Name)}, ss); {
+	case errors.IsNotFound(err):
+		for _, source := range related {
+			if len(source.spec.IdentityProviders) > 0 {
+				return pending()
+			}
+		}
+		// No syncset is written for clusters without identity providers which have not been managed previously.
+		return nil, nil
+	case err != nil:
+		contextLogger.WithError(err).Log(controllerutils.LogLevel(err), "could not get identity provider syncset")
+		return nil, err
+	}
+
+	clusterSync := &hiveintv1alpha1.ClusterSync{}
+	switch err := r.Get(context.TODO(), types.NamespacedName{Namespace: cd.Namespace, Name: cd.Name}, clusterSync); {
+	case errors.IsNotFound(err):
+		return pending()
+	case err != nil:
+		contextLogger.WithError(err).Log(controllerutils.LogLevel(err), "could not get ClusterSync")
+		return nil, err
+	}
+	for _, syncStatus := range clusterSync.Status.SyncSets {
+		if syncStatus.Name != ss.Name || syncStatus.ObservedGeneration != ss.Generation {
+			continue
+		}
+		if syncStatus.Result == hiveintv1alpha1.SuccessSyncSetResult {
+			return clusterStatus(hivev1.AppliedIdentityProviderApplyResult, "")
+		}
+		return clusterStatus(hivev1.FailedIdentityProviderApplyResult, syncStatus.FailureMessage)
+	}
+	return pending()
 }
 
-func (r *ReconcileSyncIdentityProviders) getRelatedSelectorSyncIdentityProviders(cd *hivev1.ClusterDeployment, contextLogger *log.Entry) ([]openshiftapiv1.IdentityProvider, error) {
+// getRelatedIdentityProviders returns the SyncIdentityProviders followed by the SelectorSyncIdentityProviders which
+// apply to the cluster.
+func (r *ReconcileSyncIdentityProviders) getRelatedIdentityProviders(cd *hivev1.ClusterDeployment, contextLogger *log.Entry) ([]identityProviderSource, error) {
+	ssidps, err := r.getRelatedSelectorSyncIdentityProviders(cd, contextLogger)
+	if err != nil {
+		return nil, err
+	}
+	sidps, err := r.getRelatedSyncIdentityProviders(cd)
+	if err != nil {
+		return nil, err
+	}
+	return append(sidps, ssidps...), nil
+}
+
+func (r *ReconcileSyncIdentityProviders) getRelatedSelectorSyncIdentityProviders(cd *hivev1.ClusterDeployment, contextLogger *log.Entry) ([]identityProviderSource, error) {
 	list := &hivev1.SelectorSyncIdentityProviderList{}
 	err := r.Client.List(context.TODO(), list)
 	if err != nil {
@@ -336,8 +609,8 @@ func (r *ReconcileSyncIdentityProviders) getRelatedSelectorSyncIdentityProviders
 	}
 
 	cdLabelSet := labels.Set(cd.Labels)
-	var idps []openshiftapiv1.IdentityProvider
-	for _, ssidp := range list.Items {
+	var sources []identityProviderSource
+	for i, ssidp := range list.Items {
 		labelSelector, err := metav1.LabelSelectorAsSelector(&ssidp.Spec.ClusterDeploymentSelector)
 		if err != nil {
 			contextLogger.WithError(err).Error("error converting LabelSelector to Selector")
@@ -345,37 +618,43 @@ func (r *ReconcileSyncIdentityProviders) getRelatedSelectorSyncIdentityProviders
 		}
 
 		if labelSelector.Matches(cdLabelSet) {
-			idps = append(idps, ssidp.Spec.IdentityProviders...)
+			sources = append(sources, identityProviderSource{
+				object: &list.Items[i],
+				spec:   &list.Items[i].Spec.SyncIdentityProviderCommonSpec,
+				status: &list.Items[i].Status,
+			})
 		}
 	}
 
-	// Sort so that the patch is consistent
-	idps = sortIdentityProviders(idps)
+	sortIdentityProviderSources(sources)
 
-	return idps, err
+	return sources, nil
 }
 
-func (r *ReconcileSyncIdentityProviders) getRelatedSyncIdentityProviders(cd *hivev1.ClusterDeployment) ([]openshiftapiv1.IdentityProvider, error) {
+func (r *ReconcileSyncIdentityProviders) getRelatedSyncIdentityProviders(cd *hivev1.ClusterDeployment) ([]identityProviderSource, error) {
 	list := &hivev1.SyncIdentityProviderList{}
 	err := r.Client.List(context.TODO(), list, client.InNamespace(cd.Namespace))
 	if err != nil {
 		return nil, err
 	}
 
-	var idps []openshiftapiv1.IdentityProvider
-	for _, sip := range list.Items {
+	var sources []identityProviderSource
+	for i, sip := range list.Items {
 		for _, cdRef := range sip.Spec.ClusterDeploymentRefs {
 			if cdRef.Name == cd.Name {
-				idps = append(idps, sip.Spec.IdentityProviders...)
+				sources = append(sources, identityProviderSource{
+					object: &list.Items[i],
+					spec:   &list.Items[i].Spec.SyncIdentityProviderCommonSpec,
+					status: &list.Items[i].Status,
+				})
 				break // This cluster deployment won't be listed twice in the ClusterDeploymentRefs
 			}
 		}
 	}
 
-	// Sort so that the patch is consistent
-	idps = sortIdentityProviders(idps)
+	sortIdentityProviderSources(sources)
 
-	return idps, err
+	return sources, nil
 }
 
 func addSelectorSyncIdentityProviderLoggerFields(logger log.FieldLogger, ssidp *hivev1.SelectorSyncIdentityProvider) *log.Entry {
@@ -398,6 +677,12 @@ func generateOwnershipUniqueKeys(owner hivev1.MetaRuntimeObject) []*controllerut
 	}
 }
 
+func sortIdentityProviderSources(sources []identityProviderSource) {
+	sort.Slice(sources, func(i, j int) bool {
+		return sources[i].object.GetName() < sources[j].object.GetName()
+	})
+}
+
 func sortIdentityProviders(idps []openshiftapiv1.IdentityProvider) []openshiftapiv1.IdentityProvider {
 	sort.Slice(idps, func(i, j int) bool {
 		return idps[i].Name < idps[j].Name
@@ -5,17 +5,21 @@ import (
 	"encoding/json"
 	"reflect"
 	"testing"
+	"time"
 
 	openshiftapiv1 "github.com/openshift/api/config/v1"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveintv1alpha1 "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
 	testfake "github.com/openshift/hive/pkg/test/fake"
 	"github.com/openshift/hive/pkg/util/scheme"
 
@@ -523,3 +527,270 @@ func generatePatch(identityProviders []openshiftapiv1.IdentityProvider) string {
 
 	return string(idppRaw)
 }
+
+func TestReconcileReferencedObjects(t *testing.T) {
+	githubWithCA := func(name string) openshiftapiv1.IdentityProvider {
+		idp := githubIdentityProvider(name)
+		idp.GitHub.CA = openshiftapiv1.ConfigMapNameReference{Name: "github-ca"}
+		return idp
+	}
+	clientSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "someclusterdeployment-github"},
+		Data:       map[string][]byte{"clientSecret": []byte("secret")},
+	}
+	ca := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "github-ca"},
+		Data:       map[string]string{"ca.crt": "CERT"},
+	}
+	tokenConfig := &openshiftapiv1.TokenConfig{
+		AccessTokenMaxAgeSeconds:     3600,
+		AccessTokenInactivityTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+	}
+	sidp := func() *hivev1.SyncIdentityProvider {
+		sidp := syncIdentityProvidersThatReferencesEmptyClusterDeployment(sidpName, githubWithCA(sidpName))
+		sidp.Spec.TokenConfig = tokenConfig
+		sidp.Spec.ReferencedObjects = &hivev1.IdentityProviderReferencedObjects{
+			Mappings: []hivev1.IdentityProviderObjectMapping{{
+				Kind:       hivev1.SecretIdentityProviderObjectKind,
+				Name:       "foo-github-client-secret",
+				SourceName: "someclusterdeployment-github",
+			}},
+		}
+		return sidp
+	}
+
+	tests := []struct {
+		name            string
+		existing        []runtime.Object
+		expectedResult  reconcile.Result
+		expectedSyncSet bool
+		expectedStatus  hivev1.IdentityProviderApplyResult
+		expectedMessage string
+	}{
+		{
+			name:            "referenced objects copied",
+			existing:        []runtime.Object{emptyClusterDeployment(), sidp(), clientSecret, ca},
+			expectedSyncSet: true,
+			expectedStatus:  hivev1.PendingIdentityProviderApplyResult,
+			expectedMessage: "waiting for the identity providers to be applied to the cluster",
+		},
+		{
+			name:            "missing secret",
+			existing:        []runtime.Object{emptyClusterDeployment(), sidp(), ca},
+			expectedResult:  reconcile.Result{RequeueAfter: missingReferencedObjectRequeueInterval},
+			expectedStatus:  hivev1.FailedIdentityProviderApplyResult,
+			expectedMessage: "Secret foo-github-client-secret referenced by an identity provider does not exist on the hub as default/someclusterdeployment-github",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &ReconcileSyncIdentityProviders{
+				Client: testfake.NewFakeClientBuilder().WithRuntimeObjects(test.existing...).Build(),
+				scheme: scheme.GetScheme(),
+				logger: log.WithField("controller", "syncidentityprovider"),
+			}
+
+			result, err := r.Reconcile(context.TODO(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: "someclusterdeployment", Namespace: "default"},
+			})
+			require.NoError(t, err, "unexpected error from reconcile")
+			assert.Equal(t, test.expectedResult, result, "unexpected result from reconcile")
+
+			ssList := &hivev1.SyncSetList{}
+			require.NoError(t, r.Client.List(context.TODO(), ssList))
+			if test.expectedSyncSet {
+				require.Len(t, ssList.Items, 1, "expected syncset")
+				spec := ssList.Items[0].Spec
+				assert.Equal(t, []hivev1.SecretMapping{{
+					SourceRef: hivev1.SecretReference{Name: "someclusterdeployment-github"},
+					TargetRef: hivev1.SecretReference{Namespace: "openshift-config", Name: "foo-github-client-secret"},
+				}}, spec.Secrets, "unexpected secret mappings")
+				if assert.Len(t, spec.Resources, 1, "expected CA configmap resource") {
+					assert.JSONEq(t,
+						`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"github-ca","namespace":"openshift-config"},"data":{"ca.crt":"CERT"}}`,
+						string(spec.Resources[0].Raw), "unexpected CA configmap resource")
+				}
+				patch := identityProviderPatch{}
+				require.NoError(t, json.Unmarshal([]byte(spec.Patches[0].Patch), &patch))
+				assert.Equal(t, tokenConfig, patch.Spec.TokenConfig, "unexpected token config")
+				assert.Equal(t, []openshiftapiv1.IdentityProvider{githubWithCA(sidpName)}, patch.Spec.IdentityProviders, "unexpected identity providers")
+			} else {
+				assert.Empty(t, ssList.Items, "expected no syncset")
+			}
+
+			actual := &hivev1.SyncIdentityProvider{}
+			reconcileStatus(t, r, sidp(), actual)
+			if assert.Len(t, actual.Status.ClusterDeployments, 1, "expected cluster status") {
+				assert.Equal(t, test.expectedStatus, actual.Status.ClusterDeployments[0].Result, "unexpected result")
+				assert.Equal(t, test.expectedMessage, actual.Status.ClusterDeployments[0].Message, "unexpected message")
+			}
+		})
+	}
+}
+
+// reconcileStatus reconciles the status of an identity provider and reads back the identity provider.
+func reconcileStatus(t *testing.T, r *ReconcileSyncIdentityProviders, idp client.Object, actual client.Object) reconcile.Result {
+	statusReconciler := &ReconcileIdentityProviderStatus{Client: r.Client, logger: r.logger, idps: r}
+	result, err := statusReconciler.Reconcile(context.TODO(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(idp)})
+	require.NoError(t, err, "unexpected error from status reconcile")
+	require.NoError(t, r.Client.Get(context.TODO(), client.ObjectKeyFromObject(idp), actual))
+	return result
+}
+
+func TestReconcileStatus(t *testing.T) {
+	existingSyncSet := func() *hivev1.SyncSet {
+		ss := syncSetWithIdentityProviders(githubIdentityProvider(ssidpName))
+		ss.Generation = 2
+		return &ss
+	}
+	clusterSync := func(syncStatus hiveintv1alpha1.SyncStatus) *hiveintv1alpha1.ClusterSync {
+		return &hiveintv1alpha1.ClusterSync{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "someclusterdeployment"},
+			Status: hiveintv1alpha1.ClusterSyncStatus{
+				SyncSets: []hiveintv1alpha1.SyncStatus{syncStatus},
+			},
+		}
+	}
+	staleStatus := func(ssidp *hivev1.SelectorSyncIdentityProvider) *hivev1.SelectorSyncIdentityProvider {
+		ssidp.Status.ClusterDeployments = []hivev1.IdentityProviderClusterStatus{{
+			Namespace: "default",
+			Name:      "someclusterdeployment",
+			Result:    hivev1.AppliedIdentityProviderApplyResult,
+		}}
+		return ssidp
+	}
+
+	tests := []struct {
+		name            string
+		existing        []runtime.Object
+		expectedStatus  hivev1.IdentityProviderApplyResult
+		expectedMessage string
+	}{
+		{
+			name:            "no clustersync",
+			existing:        []runtime.Object{clusterDeploymentWithLabels(labelMap), existingSyncSet()},
+			expectedStatus:  hivev1.PendingIdentityProviderApplyResult,
+			expectedMessage: "waiting for the identity providers to be applied to the cluster",
+		},
+		{
+			name: "previous generation applied",
+			existing: []runtime.Object{clusterDeploymentWithLabels(labelMap), existingSyncSet(), clusterSync(hiveintv1alpha1.SyncStatus{
+				Name:               "someclusterdeployment-idp",
+				ObservedGeneration: 1,
+				Result:             hiveintv1alpha1.SuccessSyncSetResult,
+			})},
+			expectedStatus:  hivev1.PendingIdentityProviderApplyResult,
+			expectedMessage: "waiting for the identity providers to be applied to the cluster",
+		},
+		{
+			name: "applied",
+			existing: []runtime.Object{clusterDeploymentWithLabels(labelMap), existingSyncSet(), clusterSync(hiveintv1alpha1.SyncStatus{
+				Name:               "someclusterdeployment-idp",
+				ObservedGeneration: 2,
+				Result:             hiveintv1alpha1.SuccessSyncSetResult,
+			})},
+			expectedStatus: hivev1.AppliedIdentityProviderApplyResult,
+		},
+		{
+			name: "failed",
+			existing: []runtime.Object{clusterDeploymentWithLabels(labelMap), existingSyncSet(), clusterSync(hiveintv1alpha1.SyncStatus{
+				Name:               "someclusterdeployment-idp",
+				ObservedGeneration: 2,
+				Result:             hiveintv1alpha1.FailureSyncSetResult,
+				FailureMessage:     "failed to apply patch 0",
+			})},
+			expectedStatus:  hivev1.FailedIdentityProviderApplyResult,
+			expectedMessage: "failed to apply patch 0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// SelectorSyncIdentityProviders are cluster-scoped.
+			unrelated := staleStatus(selectorSyncIdentityProviders(ssidpName2, githubIdentityProvider(ssidpName2)))
+			unrelated.Namespace = ""
+			unrelated.Spec.ClusterDeploymentSelector.MatchLabels = map[string]string{"company": "othercorp"}
+			related := selectorSyncIdentityProviders(ssidpName, githubIdentityProvider(ssidpName))
+			related.Namespace = ""
+			existing := append(test.existing, related, unrelated)
+			r := &ReconcileSyncIdentityProviders{
+				Client: testfake.NewFakeClientBuilder().WithRuntimeObjects(existing...).Build(),
+				scheme: scheme.GetScheme(),
+				logger: log.WithField("controller", "syncidentityprovider"),
+			}
+
+			actual := &hivev1.SelectorSyncIdentityProvider{}
+			reconcileStatus(t, r, related, actual)
+			if assert.Len(t, actual.Status.ClusterDeployments, 1, "expected cluster status") {
+				status := actual.Status.ClusterDeployments[0]
+				assert.Equal(t, "default", status.Namespace, "unexpected namespace")
+				assert.Equal(t, "someclusterdeployment", status.Name, "unexpected name")
+				assert.Equal(t, test.expectedStatus, status.Result, "unexpected result")
+				assert.Equal(t, test.expectedMessage, status.Message, "unexpected message")
+				assert.False(t, status.LastTransitionTime.IsZero(), "expected last transition time")
+			}
+
+			reconcileStatus(t, r, unrelated, actual)
+			assert.Empty(t, actual.Status.ClusterDeployments, "expected cluster to be removed from status of unrelated identity provider")
+		})
+	}
+}
+
+func TestReconcileStatusClusterDeploymentDeleted(t *testing.T) {
+	lastTransitionTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	sidp := syncIdentityProvidersThatReferencesEmptyClusterDeployment(sidpName, githubIdentityProvider(sidpName))
+	sidp.Spec.ClusterDeploymentRefs = append(sidp.Spec.ClusterDeploymentRefs, corev1.LocalObjectReference{Name: "otherclusterdeployment"})
+	sidp.Status.ClusterDeployments = []hivev1.IdentityProviderClusterStatus{
+		{
+			Namespace:          "default",
+			Name:               "otherclusterdeployment",
+			Result:             hivev1.AppliedIdentityProviderApplyResult,
+			LastTransitionTime: lastTransitionTime,
+		},
+		{
+			Namespace:          "default",
+			Name:               "someclusterdeployment",
+			Result:             hivev1.PendingIdentityProviderApplyResult,
+			Message:            "waiting for the identity providers to be applied to the cluster",
+			LastTransitionTime: lastTransitionTime,
+		},
+	}
+	r := &ReconcileSyncIdentityProviders{
+		Client: testfake.NewFakeClientBuilder().WithRuntimeObjects(sidp, emptyClusterDeployment()).Build(),
+		scheme: scheme.GetScheme(),
+		logger: log.WithField("controller", "syncidentityprovider"),
+	}
+
+	actual := &hivev1.SyncIdentityProvider{}
+	reconcileStatus(t, r, sidp, actual)
+	if assert.Len(t, actual.Status.ClusterDeployments, 1, "expected one cluster status") {
+		status := actual.Status.ClusterDeployments[0]
+		assert.Equal(t, "someclusterdeployment", status.Name, "expected deleted cluster to be removed")
+		assert.True(t, lastTransitionTime.Equal(&status.LastTransitionTime), "expected last transition time of unchanged status to be kept")
+	}
+}
+
+func TestStatusRequestsForClusterDeployment(t *testing.T) {
+	ssidp := selectorSyncIdentityProviders(ssidpName, githubIdentityProvider(ssidpName))
+	ssidp.Namespace = ""
+	otherSSIDP := selectorSyncIdentityProviders(ssidpName2, githubIdentityProvider(ssidpName2))
+	otherSSIDP.Namespace = ""
+	otherSSIDP.Spec.ClusterDeploymentSelector.MatchLabels = map[string]string{"company": "othercorp"}
+	sidp := syncIdentityProvidersThatReferencesEmptyClusterDeployment(sidpName, githubIdentityProvider(sidpName))
+	otherSIDP := emptySyncIdentityProvider(sidpName2)
+	cd := clusterDeploymentWithLabels(labelMap)
+	c := testfake.NewFakeClientBuilder().WithRuntimeObjects(ssidp, otherSSIDP, sidp, otherSIDP, cd).Build()
+	idps := &ReconcileSyncIdentityProviders{Client: c, scheme: scheme.GetScheme(), logger: log.WithField("controller", "syncidentityprovider")}
+	r := &ReconcileIdentityProviderStatus{Client: c, logger: idps.logger, idps: idps}
+
+	expected := []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: sidp.Name}},
+		{NamespacedName: types.NamespacedName{Name: ssidp.Name}},
+	}
+	assert.Equal(t, expected, r.requestsForClusterDeployment(context.TODO(), cd), "unexpected requests for cluster deployment")
+	clusterSync := &hiveintv1alpha1.ClusterSync{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: cd.Name}}
+	assert.Equal(t, expected, r.requestsForCluster("")(context.TODO(), clusterSync), "unexpected requests for ClusterSync")
+	syncSet := syncSetWithIdentityProviders()
+	assert.Equal(t, expected, r.requestsForCluster(constants.ClusterDeploymentNameLabel)(context.TODO(), &syncSet), "unexpected requests for syncset")
+}
@@ -0,0 +1,203 @@
+package syncrbac
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveintv1alpha1 "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	"github.com/openshift/hive/pkg/constants"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+)
+
+// addStatusController adds a controller to mgr which gathers the status of the groups and role bindings in each
+// cluster into the status of the SelectorSyncRBACs. The status of each SelectorSyncRBAC is written by a reconcile of
+// the SelectorSyncRBAC itself, rather than by the reconcile of each of the clusters that it applies to.
+func addStatusController(mgr manager.Manager, r *ReconcileSyncRBACStatus, concurrentReconciles int) error {
+	c, err := controller.New(ControllerName.String()+"-status-controller", mgr, controller.Options{
+		Reconciler:              controllerutils.NewDelayingReconciler(r, r.logger),
+		MaxConcurrentReconciles: concurrentReconciles,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to the spec of SelectorSyncRBACs
+	if err := c.Watch(source.Kind(mgr.GetCache(), &hivev1.SelectorSyncRBAC{}),
+		&handler.EnqueueRequestForObject{}, predicate.GenerationChangedPredicate{}); err != nil {
+		return err
+	}
+
+	// Watch for ClusterDeployments being added, removed or relabeled, which changes the clusters that the
+	// SelectorSyncRBACs apply to.
+	if err := c.Watch(source.Kind(mgr.GetCache(), &hivev1.ClusterDeployment{}),
+		handler.EnqueueRequestsFromMapFunc(r.requestsForClusterDeployment), predicate.LabelChangedPredicate{}); err != nil {
+		return err
+	}
+
+	// Watch for changes to the groups and role bindings syncsets of clusters, and to the drift recorded on them
+	if err := c.Watch(source.Kind(mgr.GetCache(), &hivev1.SyncSet{}),
+		handler.EnqueueRequestsFromMapFunc(r.requestsForCluster(constants.ClusterDeploymentNameLabel)),
+		predicate.And(isRBACSyncSet(), predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))); err != nil {
+		return err
+	}
+
+	// Watch for changes to the result of applying the groups and role bindings syncsets of clusters
+	if err := c.Watch(source.Kind(mgr.GetCache(), &hiveintv1alpha1.ClusterSync{}),
+		handler.EnqueueRequestsFromMapFunc(r.requestsForCluster("")),
+		rbacSyncStatusChanged()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// isRBACSyncSet filters out the syncsets other than those generated for the groups and role bindings of clusters.
+func isRBACSyncSet() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.GetLabels()[constants.SyncSetTypeLabel] == constants.SyncSetTypeRBAC
+	})
+}
+
+var _ reconcile.Reconciler = &ReconcileSyncRBACStatus{}
+
+// ReconcileSyncRBACStatus reconciles the status of a SelectorSyncRBAC.
+type ReconcileSyncRBACStatus struct {
+	client.Client
+	logger log.FieldLogger
+
+	// rbac determines the groups and role bindings of clusters in the same way as the controller which syncs them.
+	rbac *ReconcileSyncRBAC
+}
+
+// requestsForClusterDeployment returns requests for the SelectorSyncRBACs which apply to the cluster.
+func (r *ReconcileSyncRBACStatus) requestsForClusterDeployment(ctx context.Context, o client.Object) []reconcile.Request {
+	cd, ok := o.(*hivev1.ClusterDeployment)
+	if !ok {
+		return nil
+	}
+	logger := controllerutils.BuildControllerLogger(ControllerName, "clusterDeployment", client.ObjectKeyFromObject(cd))
+	related, err := r.rbac.getRelatedSelectorSyncRBACs(cd, logger)
+	if err != nil {
+		logger.WithError(err).Error("could not get SelectorSyncRBACs of cluster")
+		return nil
+	}
+	requests := make([]reconcile.Request, len(related))
+	for i, ssrbac := range related {
+		requests[i].Name = ssrbac.Name
+	}
+	return requests
+}
+
+// requestsForCluster returns a function which returns requests for the SelectorSyncRBACs which apply to the cluster
+// of an object in the namespace of the cluster. The cluster is named by the label, or has the name of the object if
+// the label is empty.
+func (r *ReconcileSyncRBACStatus) requestsForCluster(label string) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		name := o.GetName()
+		if label != "" {
+			name = o.GetLabels()[label]
+		}
+		cd := &hivev1.ClusterDeployment{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: o.GetNamespace(), Name: name}, cd); err != nil {
+			if !errors.IsNotFound(err) {
+				r.logger.WithError(err).Error("could not get cluster deployment")
+			}
+			return nil
+		}
+		return r.requestsForClusterDeployment(ctx, cd)
+	}
+}
+
+// Reconcile sets the status of the groups and role bindings of a SelectorSyncRBAC in each of the clusters to which
+// it applies.
+func (r *ReconcileSyncRBACStatus) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := controllerutils.BuildControllerLogger(ControllerName, "selectorSyncRBAC", request.NamespacedName)
+	logger.Debug("reconciling SelectorSyncRBAC status")
+
+	ssrbac := &hivev1.SelectorSyncRBAC{}
+	if err := r.Get(ctx, request.NamespacedName, ssrbac); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		logger.WithError(err).Error("could not get SelectorSyncRBAC")
+		return reconcile.Result{}, err
+	}
+	labelSelector, err := metav1.LabelSelectorAsSelector(&ssrbac.Spec.ClusterDeploymentSelector)
+	if err != nil {
+		logger.WithError(err).Error("error converting LabelSelector to Selector")
+		return reconcile.Result{}, nil
+	}
+	cdList := &hivev1.ClusterDeploymentList{}
+	if err := r.List(ctx, cdList, client.MatchingLabelsSelector{Selector: labelSelector}); err != nil {
+		logger.WithError(err).Error("could not list cluster deployments")
+		return reconcile.Result{}, err
+	}
+
+	var clusterStatuses []hivev1.SyncRBACClusterStatus
+	for i := range cdList.Items {
+		cd := &cdList.Items[i]
+		cdLogger := controllerutils.AddLogFields(controllerutils.MetaObjectLogTagger{Object: cd}, logger)
+		clusterStatus, err := r.rbac.getClusterStatus(cd, ssrbac.Name, cdLogger)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if clusterStatus != nil {
+			clusterStatuses = append(clusterStatuses, *clusterStatus)
+		}
+	}
+
+	if !setClusterStatuses(&ssrbac.Status, clusterStatuses) {
+		return reconcile.Result{}, nil
+	}
+	logger.Info("updating SelectorSyncRBAC status")
+	if err := r.Status().Update(ctx, ssrbac); err != nil {
+		logger.WithError(err).Log(controllerutils.LogLevel(err), "could not update SelectorSyncRBAC status")
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, nil
+}
+
+// setClusterStatuses replaces the statuses of the clusters in the status of a SelectorSyncRBAC, keeping the last
+// transition time of clusters whose result and message are unchanged. It returns true if the status was changed.
+func setClusterStatuses(status *hivev1.SelectorSyncRBACStatus, clusterStatuses []hivev1.SyncRBACClusterStatus) bool {
+	now := metav1.Now()
+	for i, clusterStatus := range clusterStatuses {
+		clusterStatuses[i].LastTransitionTime = now
+		for _, existing := range status.ClusterDeployments {
+			if existing.Namespace == clusterStatus.Namespace && existing.Name == clusterStatus.Name &&
+				existing.Result == clusterStatus.Result && existing.Message == clusterStatus.Message {
+				clusterStatuses[i].LastTransitionTime = existing.LastTransitionTime
+				break
+			}
+		}
+	}
+	sort.Slice(clusterStatuses, func(i, j int) bool {
+		a, b := clusterStatuses[i], clusterStatuses[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	if len(clusterStatuses) == 0 && len(status.ClusterDeployments) == 0 ||
+		reflect.DeepEqual(clusterStatuses, status.ClusterDeployments) {
+		return false
+	}
+	status.ClusterDeployments = clusterStatuses
+	return true
+}
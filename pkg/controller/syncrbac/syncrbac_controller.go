@@ -0,0 +1,793 @@
+package syncrbac
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/client-go/util/workqueue"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	userv1 "github.com/openshift/api/user/v1"
+
+	apihelpers "github.com/openshift/hive/apis/helpers"
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveintv1alpha1 "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	"github.com/openshift/hive/pkg/constants"
+	hivemetrics "github.com/openshift/hive/pkg/controller/metrics"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+	"github.com/openshift/hive/pkg/remoteclient"
+	k8slabels "github.com/openshift/hive/pkg/util/labels"
+)
+
+const (
+	ControllerName = hivev1.SyncRBACControllerName
+
+	groupAPIVersion = "user.openshift.io/v1"
+	groupKind       = "Group"
+	rbacAPIVersion  = "rbac.authorization.k8s.io/v1"
+
+	// driftCheckInterval is how often the groups and role bindings in a cluster are compared with those declared by
+	// the SelectorSyncRBACs.
+	driftCheckInterval = 30 * time.Minute
+)
+
+// Add creates a new SyncRBAC Controller and adds it to the Manager with default RBAC. The Manager will set fields on the
+// Controller and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	logger := log.WithField("controller", ControllerName)
+	concurrentReconciles, clientRateLimiter, queueRateLimiter, err := controllerutils.GetControllerConfig(mgr.GetClient(), ControllerName)
+	if err != nil {
+		logger.WithError(err).Error("could not get controller configurations")
+		return err
+	}
+	return AddToManager(mgr, NewReconciler(mgr, clientRateLimiter), concurrentReconciles, queueRateLimiter)
+}
+
+// NewReconciler returns a new reconcile.Reconciler
+func NewReconciler(mgr manager.Manager, rateLimiter flowcontrol.RateLimiter) reconcile.Reconciler {
+	c := controllerutils.NewClientWithMetricsOrDie(mgr, ControllerName, &rateLimiter)
+	return &ReconcileSyncRBAC{
+		Client: c,
+		scheme: mgr.GetScheme(),
+		logger: log.WithField("controller", ControllerName),
+		remoteClusterAPIClientBuilder: func(cd *hivev1.ClusterDeployment) remoteclient.Builder {
+			return remoteclient.NewBuilder(c, cd, ControllerName)
+		},
+	}
+}
+
+// AddToManager adds a new Controller to mgr with r as the reconcile.Reconciler
+func AddToManager(mgr manager.Manager, r reconcile.Reconciler, concurrentReconciles int, rateLimiter workqueue.RateLimiter) error {
+	// Create a new controller
+	c, err := controller.New(ControllerName.String()+"-controller", mgr, controller.Options{
+		Reconciler:              controllerutils.NewDelayingReconciler(r, log.WithField("controller", ControllerName)),
+		MaxConcurrentReconciles: concurrentReconciles,
+		RateLimiter:             rateLimiter,
+	})
+	if err != nil {
+		return err
+	}
+
+	reconciler := r.(*ReconcileSyncRBAC)
+
+	// Watch for changes to SelectorSyncRBAC
+	err = c.Watch(source.Kind(mgr.GetCache(), &hivev1.SelectorSyncRBAC{}),
+		handler.EnqueueRequestsFromMapFunc(reconciler.selectorSyncRBACWatchHandler))
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to ClusterDeployment
+	err = c.Watch(source.Kind(mgr.GetCache(), &hivev1.ClusterDeployment{}), &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to ClusterSync, which has the same name and namespace as its ClusterDeployment, to check the
+	// cluster for drift once the groups and role bindings have been applied to it.
+	err = c.Watch(source.Kind(mgr.GetCache(), &hiveintv1alpha1.ClusterSync{}), &handler.EnqueueRequestForObject{},
+		rbacSyncStatusChanged())
+	if err != nil {
+		return err
+	}
+
+	return addStatusController(mgr, &ReconcileSyncRBACStatus{
+		Client: reconciler.Client,
+		logger: reconciler.logger,
+		rbac:   reconciler,
+	}, concurrentReconciles)
+}
+
+// rbacSyncStatusChanged filters out updates to ClusterSyncs which do not change the status of the syncset of groups
+// and role bindings of the cluster.
+func rbacSyncStatusChanged() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldClusterSync, ok := e.ObjectOld.(*hiveintv1alpha1.ClusterSync)
+			if !ok {
+				return true
+			}
+			newClusterSync, ok := e.ObjectNew.(*hiveintv1alpha1.ClusterSync)
+			if !ok {
+				return true
+			}
+			ssName := GenerateRBACSyncSetName(newClusterSync.Name)
+			return !reflect.DeepEqual(findSyncStatus(oldClusterSync, ssName), findSyncStatus(newClusterSync, ssName))
+		},
+	}
+}
+
+func findSyncStatus(clusterSync *hiveintv1alpha1.ClusterSync, name string) *hiveintv1alpha1.SyncStatus {
+	for i, syncStatus := range clusterSync.Status.SyncSets {
+		if syncStatus.Name == name {
+			return &clusterSync.Status.SyncSets[i]
+		}
+	}
+	return nil
+}
+
+func (r *ReconcileSyncRBAC) selectorSyncRBACWatchHandler(ctx context.Context, a client.Object) []reconcile.Request {
+	retval := []reconcile.Request{}
+
+	ssrbac := a.(*hivev1.SelectorSyncRBAC)
+	if ssrbac == nil {
+		// Wasn't a SelectorSyncRBAC, bail out. This should not happen.
+		r.logger.Errorf("Error converting MapObject.Object to SelectorSyncRBAC. Value: %+v", a)
+		return retval
+	}
+
+	contextLogger := r.logger.WithField("selectorSyncRBAC", ssrbac.Name)
+
+	labelSelector, err := metav1.LabelSelectorAsSelector(&ssrbac.Spec.ClusterDeploymentSelector)
+	if err != nil {
+		contextLogger.WithError(err).Error("Error converting LabelSelector to Selector")
+		return retval
+	}
+
+	clusterDeployments := &hivev1.ClusterDeploymentList{}
+	if err := r.List(context.TODO(), clusterDeployments); err != nil {
+		contextLogger.WithError(err).Error("Error listing cluster deployments")
+		return retval
+	}
+
+	for _, clusterDeployment := range clusterDeployments.Items {
+		if labelSelector.Matches(labels.Set(clusterDeployment.Labels)) {
+			retval = append(retval, reconcile.Request{NamespacedName: types.NamespacedName{
+				Name:      clusterDeployment.Name,
+				Namespace: clusterDeployment.Namespace,
+			}})
+		}
+	}
+
+	// Also reconcile the clusters that the SelectorSyncRBAC no longer applies to, so that their groups and role
+	// bindings are updated.
+	for _, cluster := range ssrbac.Status.ClusterDeployments {
+		retval = append(retval, reconcile.Request{NamespacedName: types.NamespacedName{
+			Name:      cluster.Name,
+			Namespace: cluster.Namespace,
+		}})
+	}
+
+	return retval
+}
+
+var _ reconcile.Reconciler = &ReconcileSyncRBAC{}
+
+// ReconcileSyncRBAC reconciles the SyncSet of groups and role bindings generated for a ClusterDeployment from the
+// SelectorSyncRBACs that apply to it.
+type ReconcileSyncRBAC struct {
+	client.Client
+	scheme *runtime.Scheme
+
+	logger log.FieldLogger
+
+	// remoteClusterAPIClientBuilder is a function pointer to the function that gets a builder for building a client
+	// for the remote cluster's API server
+	remoteClusterAPIClientBuilder func(cd *hivev1.ClusterDeployment) remoteclient.Builder
+
+	// lastDriftChecks is when each cluster was last checked for drift. It is not persisted, so each cluster is
+	// checked again after the controller restarts.
+	lastDriftChecks     map[types.NamespacedName]time.Time
+	lastDriftChecksLock sync.Mutex
+}
+
+// rbacResource identifies a group or role binding in a cluster.
+type rbacResource struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+func (r rbacResource) String() string {
+	if r.namespace != "" {
+		return fmt.Sprintf("%s/%s/%s", r.kind, r.namespace, r.name)
+	}
+	return fmt.Sprintf("%s/%s", r.kind, r.name)
+}
+
+// binding is the merged role and subjects of a ClusterRoleBinding or RoleBinding.
+type binding struct {
+	roleRef    rbacv1.RoleRef
+	subjects   []rbacv1.Subject
+	declaredBy string
+}
+
+// mergedRBAC is the groups and role bindings of all of the SelectorSyncRBACs that apply to a cluster.
+type mergedRBAC struct {
+	groups   map[string]sets.String
+	bindings map[rbacResource]*binding
+
+	// declared is the resources declared by each SelectorSyncRBAC, excluding those that conflict.
+	declared map[string][]rbacResource
+
+	// conflicts is the role bindings of each SelectorSyncRBAC that conflict with those of a SelectorSyncRBAC before it
+	// by name.
+	conflicts map[string][]string
+}
+
+// Reconcile merges the groups and role bindings of the SelectorSyncRBACs that apply to a ClusterDeployment into a
+// SyncSet for the ClusterDeployment, and checks the cluster for drift from them.
+func (r *ReconcileSyncRBAC) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	contextLogger := controllerutils.BuildControllerLogger(ControllerName, "clusterDeployment", request.NamespacedName)
+	contextLogger.Info("reconciling selectorsyncrbacs and clusterdeployments")
+	recobsrv := hivemetrics.NewReconcileObserver(ControllerName, contextLogger)
+	defer recobsrv.ObserveControllerReconcileTime()
+
+	// Fetch the ClusterDeployment instance
+	cd := &hivev1.ClusterDeployment{}
+	err := r.Get(context.TODO(), request.NamespacedName, cd)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			contextLogger.Info("cluster deployment not found")
+			r.setLastDriftCheck(request.NamespacedName, nil)
+			return reconcile.Result{}, nil
+		}
+		contextLogger.WithError(err).Error("error looking up cluster deployment")
+		return reconcile.Result{}, err
+	}
+	contextLogger = controllerutils.AddLogFields(controllerutils.MetaObjectLogTagger{Object: cd}, contextLogger)
+
+	if paused, err := strconv.ParseBool(cd.Annotations[constants.ReconcilePauseAnnotation]); err == nil && paused {
+		contextLogger.Info("skipping reconcile due to ClusterDeployment pause annotation")
+		return reconcile.Result{}, nil
+	}
+
+	// Ensure owner references are correctly set
+	err = controllerutils.ReconcileOwnerReferences(cd, generateOwnershipUniqueKeys(cd), r, r.scheme, contextLogger)
+	if err != nil {
+		contextLogger.WithError(err).Error("Error reconciling object ownership")
+		return reconcile.Result{}, err
+	}
+
+	// If the clusterdeployment is deleted, do not reconcile.
+	if cd.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	return r.syncRBAC(cd, contextLogger)
+}
+
+// GenerateRBACSyncSetName generates the name of the SyncSet that holds the groups and role bindings to sync.
+func GenerateRBACSyncSetName(clusterDeploymentName string) string {
+	return apihelpers.GetResourceName(clusterDeploymentName, constants.RBACSuffix)
+}
+
+func (r *ReconcileSyncRBAC) syncRBAC(cd *hivev1.ClusterDeployment, contextLogger *log.Entry) (reconcile.Result, error) {
+	cdKey := types.NamespacedName{Namespace: cd.Namespace, Name: cd.Name}
+
+	related, err := r.getRelatedSelectorSyncRBACs(cd, contextLogger)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	merged := mergeRBAC(related)
+	resources, err := merged.resources()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	newSyncSetSpec := hivev1.SyncSetSpec{
+		ClusterDeploymentRefs: []corev1.LocalObjectReference{{Name: cd.Name}},
+		SyncSetCommonSpec: hivev1.SyncSetCommonSpec{
+			Resources: resources,
+			// Sync removes the groups and role bindings that are no longer declared from the cluster.
+			ResourceApplyMode: hivev1.SyncResourceApplyMode,
+		},
+	}
+
+	ssName := GenerateRBACSyncSetName(cd.Name)
+
+	ss := &hivev1.SyncSet{}
+	err = r.Get(context.TODO(), types.NamespacedName{Name: ssName, Namespace: cd.Namespace}, ss)
+	switch {
+	case errors.IsNotFound(err):
+		if len(resources) == 0 {
+			// There is nothing to sync and the groups and role bindings of this cluster have not been managed
+			// previously, so do not write out a syncset.
+			contextLogger.Debug("no groups or role bindings and syncset not found. Not writing out empty syncset.")
+			return reconcile.Result{}, nil
+		}
+
+		ss = &hivev1.SyncSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ssName,
+				Namespace: cd.Namespace,
+			},
+			Spec: newSyncSetSpec,
+		}
+
+		// ensure the syncset gets cleaned up when the clusterdeployment is deleted
+		ss.Labels = k8slabels.AddLabel(ss.Labels, constants.ClusterDeploymentNameLabel, cd.Name)
+		ss.Labels = k8slabels.AddLabel(ss.Labels, constants.SyncSetTypeLabel, constants.SyncSetTypeRBAC)
+		if err := controllerutil.SetControllerReference(cd, ss, r.scheme); err != nil {
+			contextLogger.WithError(err).Error("error setting controller reference on syncset")
+			return reconcile.Result{}, err
+		}
+
+		if err := r.Create(context.TODO(), ss); err != nil {
+			contextLogger.WithError(err).Log(controllerutils.LogLevel(err), "error creating syncset")
+			return reconcile.Result{}, err
+		}
+
+	case err != nil:
+		contextLogger.WithError(err).Error("error checking for existing syncset")
+		return reconcile.Result{}, err
+
+	// update the syncset if there have been changes
+	case !reflect.DeepEqual(ss.Spec, newSyncSetSpec):
+		ss.Spec = newSyncSetSpec
+		// The drift found in the cluster is for the previous groups and role bindings, so the cluster is checked
+		// again once the new ones have been applied.
+		delete(ss.Annotations, constants.SyncRBACDriftedResourcesAnnotation)
+		r.setLastDriftCheck(cdKey, nil)
+		if err := r.Update(context.TODO(), ss); err != nil {
+			contextLogger.WithError(err).Log(controllerutils.LogLevel(err), "error updating existing syncset")
+			return reconcile.Result{}, err
+		}
+	}
+
+	if len(resources) == 0 || !r.canCheckDrift(cd) {
+		return reconcile.Result{}, nil
+	}
+	syncSetStatus, err := r.getSyncSetStatus(cd, ss, contextLogger)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if syncSetStatus.Result != hivev1.AppliedSyncRBACResult {
+		// The cluster is checked for drift once the groups and role bindings have been applied.
+		return reconcile.Result{}, nil
+	}
+	if wait := r.timeUntilDriftCheck(cdKey); wait > 0 {
+		return reconcile.Result{RequeueAfter: wait}, nil
+	}
+
+	now := time.Now()
+	r.setLastDriftCheck(cdKey, &now)
+	drifted, err := r.findDrift(cd, merged, contextLogger)
+	if err != nil {
+		// Drift is checked again after the drift check interval; the drift found previously is reported in the
+		// meantime.
+		contextLogger.WithError(err).Warn("could not check groups and role bindings in the cluster for drift")
+		return reconcile.Result{RequeueAfter: driftCheckInterval}, nil
+	}
+	if value := strings.Join(drifted.List(), ","); value != ss.Annotations[constants.SyncRBACDriftedResourcesAnnotation] {
+		if value == "" {
+			delete(ss.Annotations, constants.SyncRBACDriftedResourcesAnnotation)
+		} else {
+			ss.Annotations = k8slabels.AddLabel(ss.Annotations, constants.SyncRBACDriftedResourcesAnnotation, value)
+		}
+		if err := r.Update(context.TODO(), ss); err != nil {
+			contextLogger.WithError(err).Log(controllerutils.LogLevel(err), "error recording drift on syncset")
+			return reconcile.Result{}, err
+		}
+	}
+	return reconcile.Result{RequeueAfter: driftCheckInterval}, nil
+}
+
+// timeUntilDriftCheck returns how long to wait before the cluster is checked for drift again.
+func (r *ReconcileSyncRBAC) timeUntilDriftCheck(cdKey types.NamespacedName) time.Duration {
+	r.lastDriftChecksLock.Lock()
+	defer r.lastDriftChecksLock.Unlock()
+	lastCheck, ok := r.lastDriftChecks[cdKey]
+	if !ok {
+		return 0
+	}
+	return driftCheckInterval - time.Since(lastCheck)
+}
+
+// setLastDriftCheck records when the cluster was last checked for drift, or forgets it if the time is nil so that the
+// cluster is checked again as soon as possible.
+func (r *ReconcileSyncRBAC) setLastDriftCheck(cdKey types.NamespacedName, lastCheck *time.Time) {
+	r.lastDriftChecksLock.Lock()
+	defer r.lastDriftChecksLock.Unlock()
+	if lastCheck == nil {
+		delete(r.lastDriftChecks, cdKey)
+		return
+	}
+	if r.lastDriftChecks == nil {
+		r.lastDriftChecks = map[types.NamespacedName]time.Time{}
+	}
+	r.lastDriftChecks[cdKey] = *lastCheck
+}
+
+// getClusterStatus determines the status of the groups and role bindings of the SelectorSyncRBAC in the cluster. It
+// returns nil if the groups and role bindings of the cluster are not managed.
+func (r *ReconcileSyncRBAC) getClusterStatus(cd *hivev1.ClusterDeployment, ssrbacName string, contextLogger *log.Entry) (*hivev1.SyncRBACClusterStatus, error) {
+	related, err := r.getRelatedSelectorSyncRBACs(cd, contextLogger)
+	if err != nil {
+		return nil, err
+	}
+	merged := mergeRBAC(related)
+	if conflicts := merged.conflicts[ssrbacName]; len(conflicts) > 0 {
+		return &hivev1.SyncRBACClusterStatus{
+			Namespace: cd.Namespace,
+			Name:      cd.Name,
+			Result:    hivev1.FailedSyncRBACResult,
+			Message:   strings.Join(conflicts, "; "),
+		}, nil
+	}
+
+	var status *hivev1.SyncRBACClusterStatus
+	ss := &hivev1.SyncSet{}
+	switch err := r.Get(context.TODO(), types.NamespacedName{Namespace: cd.Namespace, Name: GenerateRBACSyncSetName(cd.Name)}, ss); {
+	case errors.IsNotFound(err):
+		if len(merged.groups) == 0 && len(merged.bindings) == 0 {
+			// No syncset is written for clusters without groups or role bindings which have not been managed
+			// previously.
+			return nil, nil
+		}
+		status = pendingClusterStatus()
+	case err != nil:
+		contextLogger.WithError(err).Log(controllerutils.LogLevel(err), "could not get syncset of groups and role bindings")
+		return nil, err
+	default:
+		if status, err = r.getSyncSetStatus(cd, ss, contextLogger); err != nil {
+			return nil, err
+		}
+	}
+	status.Namespace = cd.Namespace
+	status.Name = cd.Name
+
+	drifted := sets.NewString()
+	if status.Result == hivev1.AppliedSyncRBACResult {
+		if value := ss.Annotations[constants.SyncRBACDriftedResourcesAnnotation]; value != "" {
+			drifted.Insert(strings.Split(value, ",")...)
+		}
+	}
+	var groupNames []string
+	for _, res := range merged.declared[ssrbacName] {
+		if res.kind == groupKind {
+			groupNames = append(groupNames, res.name)
+		}
+		if drifted.Has(res.String()) {
+			status.DriftedResources = append(status.DriftedResources, res.String())
+		}
+	}
+	sort.Strings(groupNames)
+	for _, name := range groupNames {
+		group := hivev1.SyncRBACGroup{Name: name}
+		if merged.groups[name].Len() > 0 {
+			group.Users = merged.groups[name].List()
+		}
+		status.Groups = append(status.Groups, group)
+	}
+	if len(status.DriftedResources) > 0 {
+		sort.Strings(status.DriftedResources)
+		status.Result = hivev1.DriftedSyncRBACResult
+		status.Message = "groups or role bindings have been changed in the cluster"
+	}
+	return status, nil
+}
+
+// mergeRBAC merges the groups and role bindings of SelectorSyncRBACs sorted by name. The users of groups and the
+// subjects of role bindings with the same role are combined. A role binding that binds a different role than a role
+// binding with the same name declared by a SelectorSyncRBAC before it by name is a conflict and is not synced.
+func mergeRBAC(ssrbacs []*hivev1.SelectorSyncRBAC) *mergedRBAC {
+	merged := &mergedRBAC{
+		groups:    map[string]sets.String{},
+		bindings:  map[rbacResource]*binding{},
+		declared:  map[string][]rbacResource{},
+		conflicts: map[string][]string{},
+	}
+	addBinding := func(ssrbac *hivev1.SelectorSyncRBAC, res rbacResource, roleRef rbacv1.RoleRef, subjects []rbacv1.Subject) {
+		roleRef = normalizeRoleRef(roleRef)
+		existing, ok := merged.bindings[res]
+		if !ok {
+			existing = &binding{roleRef: roleRef, declaredBy: ssrbac.Name}
+			merged.bindings[res] = existing
+		} else if existing.roleRef != roleRef {
+			merged.conflicts[ssrbac.Name] = append(merged.conflicts[ssrbac.Name],
+				fmt.Sprintf("%s binds %s %s, but it binds %s %s in SelectorSyncRBAC %s",
+					res, roleRef.Kind, roleRef.Name, existing.roleRef.Kind, existing.roleRef.Name, existing.declaredBy))
+			return
+		}
+		for _, subject := range subjects {
+			subject = normalizeSubject(subject)
+			if !containsSubject(existing.subjects, subject) {
+				existing.subjects = append(existing.subjects, subject)
+			}
+		}
+		merged.declared[ssrbac.Name] = append(merged.declared[ssrbac.Name], res)
+	}
+
+	for _, ssrbac := range ssrbacs {
+		for _, group := range ssrbac.Spec.Groups {
+			if _, ok := merged.groups[group.Name]; !ok {
+				merged.groups[group.Name] = sets.NewString()
+			}
+			merged.groups[group.Name].Insert(group.Users...)
+			merged.declared[ssrbac.Name] = append(merged.declared[ssrbac.Name], rbacResource{kind: groupKind, name: group.Name})
+		}
+		for _, crb := range ssrbac.Spec.ClusterRoleBindings {
+			addBinding(ssrbac, rbacResource{kind: "ClusterRoleBinding", name: crb.Name}, crb.RoleRef, crb.Subjects)
+		}
+		for _, rb := range ssrbac.Spec.RoleBindings {
+			addBinding(ssrbac, rbacResource{kind: "RoleBinding", namespace: rb.Namespace, name: rb.Name}, rb.RoleRef, rb.Subjects)
+		}
+	}
+	return merged
+}
+
+// resources returns the manifests of the groups and role bindings sorted by kind, namespace and name. The manifests
+// are encoded from maps so that they have the same encoding as the resources read back from the syncset.
+func (m *mergedRBAC) resources() ([]runtime.RawExtension, error) {
+	var resources []runtime.RawExtension
+	add := func(resource map[string]interface{}) error {
+		raw, err := json.Marshal(resource)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, runtime.RawExtension{Raw: raw})
+		return nil
+	}
+
+	for _, name := range sets.StringKeySet(m.groups).List() {
+		if err := add(map[string]interface{}{
+			"apiVersion": groupAPIVersion,
+			"kind":       groupKind,
+			"metadata":   map[string]interface{}{"name": name},
+			"users":      append([]string{}, m.groups[name].List()...),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	keys := make([]rbacResource, 0, len(m.bindings))
+	for key := range m.bindings {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].kind != keys[j].kind {
+			return keys[i].kind < keys[j].kind
+		}
+		if keys[i].namespace != keys[j].namespace {
+			return keys[i].namespace < keys[j].namespace
+		}
+		return keys[i].name < keys[j].name
+	})
+	for _, key := range keys {
+		b := m.bindings[key]
+		metadata := map[string]interface{}{"name": key.name}
+		if key.namespace != "" {
+			metadata["namespace"] = key.namespace
+		}
+		subjects := []interface{}{}
+		for _, subject := range b.subjects {
+			s := map[string]interface{}{"kind": subject.Kind, "name": subject.Name}
+			if subject.APIGroup != "" {
+				s["apiGroup"] = subject.APIGroup
+			}
+			if subject.Namespace != "" {
+				s["namespace"] = subject.Namespace
+			}
+			subjects = append(subjects, s)
+		}
+		if err := add(map[string]interface{}{
+			"apiVersion": rbacAPIVersion,
+			"kind":       key.kind,
+			"metadata":   metadata,
+			"roleRef": map[string]interface{}{
+				"apiGroup": b.roleRef.APIGroup,
+				"kind":     b.roleRef.Kind,
+				"name":     b.roleRef.Name,
+			},
+			"subjects": subjects,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
+
+// normalizeRoleRef defaults the API group of a role reference as the API server requires it.
+func normalizeRoleRef(roleRef rbacv1.RoleRef) rbacv1.RoleRef {
+	if roleRef.APIGroup == "" {
+		roleRef.APIGroup = rbacv1.GroupName
+	}
+	return roleRef
+}
+
+// normalizeSubject defaults the API group of a subject as the API server does, so that subjects declared with and
+// without the API group are the same and match those read from the cluster.
+func normalizeSubject(subject rbacv1.Subject) rbacv1.Subject {
+	if subject.APIGroup == "" && (subject.Kind == rbacv1.UserKind || subject.Kind == rbacv1.GroupKind) {
+		subject.APIGroup = rbacv1.GroupName
+	}
+	return subject
+}
+
+func containsSubject(subjects []rbacv1.Subject, subject rbacv1.Subject) bool {
+	for _, s := range subjects {
+		if s == subject {
+			return true
+		}
+	}
+	return false
+}
+
+// canCheckDrift returns true if the groups and role bindings in the cluster can be read to check for drift.
+func (r *ReconcileSyncRBAC) canCheckDrift(cd *hivev1.ClusterDeployment) bool {
+	if !cd.Spec.Installed || controllerutils.IsFakeCluster(cd) {
+		return false
+	}
+	unreachable, _ := remoteclient.Unreachable(cd)
+	return !unreachable
+}
+
+// findDrift returns the groups and role bindings that differ in the cluster from those declared.
+func (r *ReconcileSyncRBAC) findDrift(cd *hivev1.ClusterDeployment, merged *mergedRBAC, contextLogger log.FieldLogger) (sets.String, error) {
+	remoteClient, err := r.remoteClusterAPIClientBuilder(cd).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	drifted := sets.NewString()
+	for name, users := range merged.groups {
+		group := &userv1.Group{}
+		switch err := remoteClient.Get(context.TODO(), types.NamespacedName{Name: name}, group); {
+		case errors.IsNotFound(err):
+		case err != nil:
+			return nil, err
+		case sets.NewString(group.Users...).Equal(users):
+			continue
+		}
+		drifted.Insert(rbacResource{kind: groupKind, name: name}.String())
+	}
+
+	for key, b := range merged.bindings {
+		var roleRef rbacv1.RoleRef
+		var subjects []rbacv1.Subject
+		var err error
+		if key.kind == "ClusterRoleBinding" {
+			crb := &rbacv1.ClusterRoleBinding{}
+			err = remoteClient.Get(context.TODO(), types.NamespacedName{Name: key.name}, crb)
+			roleRef, subjects = crb.RoleRef, crb.Subjects
+		} else {
+			rb := &rbacv1.RoleBinding{}
+			err = remoteClient.Get(context.TODO(), types.NamespacedName{Namespace: key.namespace, Name: key.name}, rb)
+			roleRef, subjects = rb.RoleRef, rb.Subjects
+		}
+		switch {
+		case errors.IsNotFound(err):
+		case err != nil:
+			return nil, err
+		case roleRef == b.roleRef && sameSubjects(subjects, b.subjects):
+			continue
+		}
+		drifted.Insert(key.String())
+	}
+
+	if drifted.Len() > 0 {
+		contextLogger.WithField("driftedResources", drifted.List()).Info("groups or role bindings have drifted in the cluster")
+	}
+	return drifted, nil
+}
+
+// sameSubjects returns true if the subjects read from a cluster are the same as those declared, regardless of order.
+func sameSubjects(actual, declared []rbacv1.Subject) bool {
+	if len(actual) != len(declared) {
+		return false
+	}
+	for _, subject := range actual {
+		if !containsSubject(declared, normalizeSubject(subject)) {
+			return false
+		}
+	}
+	return true
+}
+
+func pendingClusterStatus() *hivev1.SyncRBACClusterStatus {
+	return &hivev1.SyncRBACClusterStatus{
+		Result:  hivev1.PendingSyncRBACResult,
+		Message: "waiting for the groups and role bindings to be applied to the cluster",
+	}
+}
+
+// getSyncSetStatus determines whether the groups and role bindings syncset has been applied to the cluster from the
+// ClusterSync of the cluster.
+func (r *ReconcileSyncRBAC) getSyncSetStatus(cd *hivev1.ClusterDeployment, ss *hivev1.SyncSet, contextLogger *log.Entry) (*hivev1.SyncRBACClusterStatus, error) {
+	clusterSync := &hiveintv1alpha1.ClusterSync{}
+	switch err := r.Get(context.TODO(), types.NamespacedName{Namespace: cd.Namespace, Name: cd.Name}, clusterSync); {
+	case errors.IsNotFound(err):
+		return pendingClusterStatus(), nil
+	case err != nil:
+		contextLogger.WithError(err).Log(controllerutils.LogLevel(err), "could not get ClusterSync")
+		return nil, err
+	}
+	if syncStatus := findSyncStatus(clusterSync, ss.Name); syncStatus != nil && syncStatus.ObservedGeneration == ss.Generation {
+		if syncStatus.Result == hiveintv1alpha1.SuccessSyncSetResult {
+			return &hivev1.SyncRBACClusterStatus{Result: hivev1.AppliedSyncRBACResult}, nil
+		}
+		return &hivev1.SyncRBACClusterStatus{
+			Result:  hivev1.FailedSyncRBACResult,
+			Message: syncStatus.FailureMessage,
+		}, nil
+	}
+	return pendingClusterStatus(), nil
+}
+
+// getRelatedSelectorSyncRBACs returns the SelectorSyncRBACs that apply to the cluster sorted by name.
+func (r *ReconcileSyncRBAC) getRelatedSelectorSyncRBACs(cd *hivev1.ClusterDeployment, contextLogger *log.Entry) ([]*hivev1.SelectorSyncRBAC, error) {
+	list := &hivev1.SelectorSyncRBACList{}
+	if err := r.List(context.TODO(), list); err != nil {
+		contextLogger.WithError(err).Log(controllerutils.LogLevel(err), "could not list SelectorSyncRBACs")
+		return nil, err
+	}
+
+	cdLabelSet := labels.Set(cd.Labels)
+	var related []*hivev1.SelectorSyncRBAC
+	for i, ssrbac := range list.Items {
+		labelSelector, err := metav1.LabelSelectorAsSelector(&ssrbac.Spec.ClusterDeploymentSelector)
+		if err != nil {
+			contextLogger.WithError(err).WithField("selectorSyncRBAC", ssrbac.Name).Error("error converting LabelSelector to Selector")
+			continue
+		}
+		if labelSelector.Matches(cdLabelSet) {
+			related = append(related, &list.Items[i])
+		}
+	}
+	sort.Slice(related, func(i, j int) bool {
+		return related[i].Name < related[j].Name
+	})
+	return related, nil
+}
+
+func generateOwnershipUniqueKeys(owner hivev1.MetaRuntimeObject) []*controllerutils.OwnershipUniqueKey {
+	return []*controllerutils.OwnershipUniqueKey{
+		{
+			TypeToList: &hivev1.SyncSetList{},
+			LabelSelector: map[string]string{
+				constants.ClusterDeploymentNameLabel: owner.GetName(),
+				constants.SyncSetTypeLabel:           constants.SyncSetTypeRBAC,
+			},
+			Controlled: true,
+		},
+	}
+}
@@ -0,0 +1,530 @@
+package syncrbac
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	userv1 "github.com/openshift/api/user/v1"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveintv1alpha1 "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+	"github.com/openshift/hive/pkg/constants"
+	"github.com/openshift/hive/pkg/remoteclient"
+	remoteclientmock "github.com/openshift/hive/pkg/remoteclient/mock"
+	testcd "github.com/openshift/hive/pkg/test/clusterdeployment"
+	testfake "github.com/openshift/hive/pkg/test/fake"
+	"github.com/openshift/hive/pkg/util/scheme"
+)
+
+const (
+	testNamespace = "default"
+	testCDName    = "somecluster"
+	ssName        = "somecluster-rbac"
+)
+
+var labelMap = map[string]string{"company": "giantcorp"}
+
+func testClusterDeployment(opts ...testcd.Option) *hivev1.ClusterDeployment {
+	return testcd.FullBuilder(testNamespace, testCDName, scheme.GetScheme()).
+		Options(testcd.WithLabel("company", "giantcorp")).
+		Build(opts...)
+}
+
+func reachable() testcd.Option {
+	return testcd.WithCondition(hivev1.ClusterDeploymentCondition{
+		Type:   hivev1.UnreachableCondition,
+		Status: corev1.ConditionFalse,
+	})
+}
+
+func testSelectorSyncRBAC(name string, spec hivev1.SelectorSyncRBACSpec) *hivev1.SelectorSyncRBAC {
+	spec.ClusterDeploymentSelector = metav1.LabelSelector{MatchLabels: labelMap}
+	return &hivev1.SelectorSyncRBAC{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       spec,
+	}
+}
+
+func group(name string, users ...string) hivev1.SyncRBACGroup {
+	return hivev1.SyncRBACGroup{Name: name, Users: users}
+}
+
+func groupSubject(name string) rbacv1.Subject {
+	return rbacv1.Subject{Kind: rbacv1.GroupKind, Name: name}
+}
+
+func clusterRoleBinding(name, role string, subjects ...rbacv1.Subject) hivev1.SyncRBACClusterRoleBinding {
+	return hivev1.SyncRBACClusterRoleBinding{
+		Name:     name,
+		RoleRef:  rbacv1.RoleRef{Kind: "ClusterRole", Name: role},
+		Subjects: subjects,
+	}
+}
+
+func testClusterSync(generation int64, result hiveintv1alpha1.SyncSetResult) *hiveintv1alpha1.ClusterSync {
+	return &hiveintv1alpha1.ClusterSync{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testCDName},
+		Status: hiveintv1alpha1.ClusterSyncStatus{
+			SyncSets: []hiveintv1alpha1.SyncStatus{{
+				Name:               ssName,
+				ObservedGeneration: generation,
+				Result:             result,
+				FailureMessage:     "failed to apply",
+			}},
+		},
+	}
+}
+
+// decodeResources decodes the resources of a syncset to maps for comparison.
+func decodeResources(t *testing.T, ss *hivev1.SyncSet) []map[string]interface{} {
+	var resources []map[string]interface{}
+	for _, raw := range ss.Spec.Resources {
+		resource := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(raw.Raw, &resource))
+		resources = append(resources, resource)
+	}
+	return resources
+}
+
+func newReconciler(t *testing.T, existing []runtime.Object, remoteClient client.Client) (*ReconcileSyncRBAC, client.Client) {
+	mockCtrl := gomock.NewController(t)
+	c := testfake.NewFakeClientBuilder().WithRuntimeObjects(existing...).Build()
+	return &ReconcileSyncRBAC{
+		Client: c,
+		scheme: scheme.GetScheme(),
+		logger: log.WithField("controller", "syncrbac"),
+		remoteClusterAPIClientBuilder: func(*hivev1.ClusterDeployment) remoteclient.Builder {
+			builder := remoteclientmock.NewMockBuilder(mockCtrl)
+			builder.EXPECT().Build().Return(remoteClient, nil).AnyTimes()
+			return builder
+		},
+	}, c
+}
+
+func reconcileCD(t *testing.T, r *ReconcileSyncRBAC) reconcile.Result {
+	result, err := r.Reconcile(context.TODO(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: testCDName},
+	})
+	require.NoError(t, err)
+	return result
+}
+
+func reconcileStatus(t *testing.T, r *ReconcileSyncRBAC, names ...string) {
+	statusReconciler := &ReconcileSyncRBACStatus{Client: r.Client, logger: r.logger, rbac: r}
+	for _, name := range names {
+		_, err := statusReconciler.Reconcile(context.TODO(), reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: name},
+		})
+		require.NoError(t, err)
+	}
+}
+
+func getSyncSet(t *testing.T, c client.Client) *hivev1.SyncSet {
+	ss := &hivev1.SyncSet{}
+	require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: ssName}, ss))
+	return ss
+}
+
+func getSelectorSyncRBAC(t *testing.T, c client.Client, name string) *hivev1.SelectorSyncRBAC {
+	ssrbac := &hivev1.SelectorSyncRBAC{}
+	require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: name}, ssrbac))
+	return ssrbac
+}
+
+func TestReconcileSyncSet(t *testing.T) {
+	tests := []struct {
+		name              string
+		existing          []runtime.Object
+		expectSyncSet     bool
+		expectedResources []map[string]interface{}
+	}{
+		{
+			name:     "no selectorsyncrbacs",
+			existing: []runtime.Object{testClusterDeployment()},
+		},
+		{
+			name: "non-matching selectorsyncrbac",
+			existing: []runtime.Object{
+				testClusterDeployment(),
+				&hivev1.SelectorSyncRBAC{
+					ObjectMeta: metav1.ObjectMeta{Name: "other"},
+					Spec: hivev1.SelectorSyncRBACSpec{
+						Groups:                    []hivev1.SyncRBACGroup{group("admins", "alice")},
+						ClusterDeploymentSelector: metav1.LabelSelector{MatchLabels: map[string]string{"company": "other"}},
+					},
+				},
+			},
+		},
+		{
+			name: "groups and bindings",
+			existing: []runtime.Object{
+				testClusterDeployment(),
+				testSelectorSyncRBAC("team-a", hivev1.SelectorSyncRBACSpec{
+					Groups:              []hivev1.SyncRBACGroup{group("team-a-admins", "bob", "alice")},
+					ClusterRoleBindings: []hivev1.SyncRBACClusterRoleBinding{clusterRoleBinding("team-a-admins", "cluster-admin", groupSubject("team-a-admins"))},
+					RoleBindings: []hivev1.SyncRBACRoleBinding{{
+						Namespace: "team-a",
+						Name:      "team-a-edit",
+						RoleRef:   rbacv1.RoleRef{Kind: "ClusterRole", Name: "edit"},
+						Subjects:  []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Namespace: "ci", Name: "deployer"}},
+					}},
+				}),
+			},
+			expectSyncSet: true,
+			expectedResources: []map[string]interface{}{
+				{
+					"apiVersion": "user.openshift.io/v1",
+					"kind":       "Group",
+					"metadata":   map[string]interface{}{"name": "team-a-admins"},
+					"users":      []interface{}{"alice", "bob"},
+				},
+				{
+					"apiVersion": "rbac.authorization.k8s.io/v1",
+					"kind":       "ClusterRoleBinding",
+					"metadata":   map[string]interface{}{"name": "team-a-admins"},
+					"roleRef":    map[string]interface{}{"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "cluster-admin"},
+					"subjects": []interface{}{
+						map[string]interface{}{"apiGroup": "rbac.authorization.k8s.io", "kind": "Group", "name": "team-a-admins"},
+					},
+				},
+				{
+					"apiVersion": "rbac.authorization.k8s.io/v1",
+					"kind":       "RoleBinding",
+					"metadata":   map[string]interface{}{"namespace": "team-a", "name": "team-a-edit"},
+					"roleRef":    map[string]interface{}{"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "edit"},
+					"subjects": []interface{}{
+						map[string]interface{}{"kind": "ServiceAccount", "namespace": "ci", "name": "deployer"},
+					},
+				},
+			},
+		},
+		{
+			name: "merged groups and bindings",
+			existing: []runtime.Object{
+				testClusterDeployment(),
+				testSelectorSyncRBAC("team-b", hivev1.SelectorSyncRBACSpec{
+					Groups:              []hivev1.SyncRBACGroup{group("admins", "carol", "alice")},
+					ClusterRoleBindings: []hivev1.SyncRBACClusterRoleBinding{clusterRoleBinding("admins", "cluster-admin", groupSubject("admins"), groupSubject("sre"))},
+				}),
+				testSelectorSyncRBAC("team-a", hivev1.SelectorSyncRBACSpec{
+					Groups:              []hivev1.SyncRBACGroup{group("admins", "alice", "bob")},
+					ClusterRoleBindings: []hivev1.SyncRBACClusterRoleBinding{clusterRoleBinding("admins", "cluster-admin", groupSubject("admins"))},
+				}),
+			},
+			expectSyncSet: true,
+			expectedResources: []map[string]interface{}{
+				{
+					"apiVersion": "user.openshift.io/v1",
+					"kind":       "Group",
+					"metadata":   map[string]interface{}{"name": "admins"},
+					"users":      []interface{}{"alice", "bob", "carol"},
+				},
+				{
+					"apiVersion": "rbac.authorization.k8s.io/v1",
+					"kind":       "ClusterRoleBinding",
+					"metadata":   map[string]interface{}{"name": "admins"},
+					"roleRef":    map[string]interface{}{"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "cluster-admin"},
+					"subjects": []interface{}{
+						map[string]interface{}{"apiGroup": "rbac.authorization.k8s.io", "kind": "Group", "name": "admins"},
+						map[string]interface{}{"apiGroup": "rbac.authorization.k8s.io", "kind": "Group", "name": "sre"},
+					},
+				},
+			},
+		},
+		{
+			name: "existing syncset emptied when no longer declared",
+			existing: []runtime.Object{
+				testClusterDeployment(),
+				&hivev1.SyncSet{
+					ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: ssName},
+					Spec: hivev1.SyncSetSpec{
+						SyncSetCommonSpec: hivev1.SyncSetCommonSpec{
+							Resources: []runtime.RawExtension{{Raw: []byte(`{"apiVersion":"user.openshift.io/v1","kind":"Group","metadata":{"name":"admins"},"users":[]}`)}},
+						},
+					},
+				},
+			},
+			expectSyncSet: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, c := newReconciler(t, test.existing, nil)
+			reconcileCD(t, r)
+
+			ss := &hivev1.SyncSet{}
+			err := c.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: ssName}, ss)
+			if !test.expectSyncSet {
+				assert.True(t, err != nil, "expected no syncset")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, hivev1.SyncResourceApplyMode, ss.Spec.ResourceApplyMode, "unexpected resource apply mode")
+			assert.Equal(t, []corev1.LocalObjectReference{{Name: testCDName}}, ss.Spec.ClusterDeploymentRefs)
+			assert.Equal(t, test.expectedResources, decodeResources(t, ss), "unexpected syncset resources")
+		})
+	}
+}
+
+func TestReconcileConflict(t *testing.T) {
+	r, c := newReconciler(t, []runtime.Object{
+		testClusterDeployment(),
+		testSelectorSyncRBAC("team-a", hivev1.SelectorSyncRBACSpec{
+			ClusterRoleBindings: []hivev1.SyncRBACClusterRoleBinding{clusterRoleBinding("admins", "cluster-admin", groupSubject("team-a"))},
+		}),
+		testSelectorSyncRBAC("team-b", hivev1.SelectorSyncRBACSpec{
+			Groups:              []hivev1.SyncRBACGroup{group("team-b", "bob")},
+			ClusterRoleBindings: []hivev1.SyncRBACClusterRoleBinding{clusterRoleBinding("admins", "view", groupSubject("team-b"))},
+		}),
+	}, nil)
+	reconcileCD(t, r)
+	reconcileStatus(t, r, "team-a", "team-b")
+
+	resources := decodeResources(t, getSyncSet(t, c))
+	require.Len(t, resources, 2, "expected the group and the binding of the first selectorsyncrbac")
+	assert.Equal(t, map[string]interface{}{"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "cluster-admin"}, resources[1]["roleRef"])
+
+	teamA := getSelectorSyncRBAC(t, c, "team-a")
+	require.Len(t, teamA.Status.ClusterDeployments, 1)
+	assert.Equal(t, hivev1.PendingSyncRBACResult, teamA.Status.ClusterDeployments[0].Result)
+
+	teamB := getSelectorSyncRBAC(t, c, "team-b")
+	require.Len(t, teamB.Status.ClusterDeployments, 1)
+	assert.Equal(t, hivev1.FailedSyncRBACResult, teamB.Status.ClusterDeployments[0].Result)
+	assert.Equal(t, "ClusterRoleBinding/admins binds ClusterRole view, but it binds ClusterRole cluster-admin in SelectorSyncRBAC team-a",
+		teamB.Status.ClusterDeployments[0].Message)
+}
+
+func TestReconcileStatus(t *testing.T) {
+	teamA := func() *hivev1.SelectorSyncRBAC {
+		return testSelectorSyncRBAC("team-a", hivev1.SelectorSyncRBACSpec{
+			Groups:              []hivev1.SyncRBACGroup{group("admins", "alice")},
+			ClusterRoleBindings: []hivev1.SyncRBACClusterRoleBinding{clusterRoleBinding("admins", "cluster-admin", groupSubject("admins"))},
+		})
+	}
+	teamB := func() *hivev1.SelectorSyncRBAC {
+		return testSelectorSyncRBAC("team-b", hivev1.SelectorSyncRBACSpec{
+			Groups: []hivev1.SyncRBACGroup{group("admins", "bob")},
+		})
+	}
+	existingSyncSet := func(generation int64) *hivev1.SyncSet {
+		r, c := newReconciler(t, []runtime.Object{testClusterDeployment(), teamA(), teamB()}, nil)
+		reconcileCD(t, r)
+		ss := getSyncSet(t, c)
+		ss.ResourceVersion = ""
+		ss.Generation = generation
+		return ss
+	}
+	inCluster := func() []runtime.Object {
+		return []runtime.Object{
+			&userv1.Group{
+				ObjectMeta: metav1.ObjectMeta{Name: "admins"},
+				Users:      userv1.OptionalNames{"bob", "alice"},
+			},
+			&rbacv1.ClusterRoleBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "admins"},
+				RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "cluster-admin"},
+				Subjects:   []rbacv1.Subject{{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: "admins"}},
+			},
+		}
+	}
+	tests := []struct {
+		name                 string
+		cd                   *hivev1.ClusterDeployment
+		clusterSync          *hiveintv1alpha1.ClusterSync
+		remote               []runtime.Object
+		expectedResult       hivev1.SyncRBACResult
+		expectedMessage      string
+		expectedDrifted      []string
+		expectedTeamBDrifted []string
+		expectedRequeueAfter time.Duration
+	}{
+		{
+			name:            "no clustersync",
+			cd:              testClusterDeployment(),
+			expectedResult:  hivev1.PendingSyncRBACResult,
+			expectedMessage: "waiting for the groups and role bindings to be applied to the cluster",
+		},
+		{
+			name:            "old generation applied",
+			cd:              testClusterDeployment(),
+			clusterSync:     testClusterSync(1, hiveintv1alpha1.SuccessSyncSetResult),
+			expectedResult:  hivev1.PendingSyncRBACResult,
+			expectedMessage: "waiting for the groups and role bindings to be applied to the cluster",
+		},
+		{
+			name:            "failed",
+			cd:              testClusterDeployment(),
+			clusterSync:     testClusterSync(2, hiveintv1alpha1.FailureSyncSetResult),
+			expectedResult:  hivev1.FailedSyncRBACResult,
+			expectedMessage: "failed to apply",
+		},
+		{
+			name:           "applied unreachable cluster",
+			cd:             testClusterDeployment(testcd.Installed()),
+			clusterSync:    testClusterSync(2, hiveintv1alpha1.SuccessSyncSetResult),
+			expectedResult: hivev1.AppliedSyncRBACResult,
+		},
+		{
+			name:                 "applied without drift",
+			cd:                   testClusterDeployment(testcd.Installed(), reachable()),
+			clusterSync:          testClusterSync(2, hiveintv1alpha1.SuccessSyncSetResult),
+			remote:               inCluster(),
+			expectedResult:       hivev1.AppliedSyncRBACResult,
+			expectedRequeueAfter: driftCheckInterval,
+		},
+		{
+			name:        "drifted group and deleted binding",
+			cd:          testClusterDeployment(testcd.Installed(), reachable()),
+			clusterSync: testClusterSync(2, hiveintv1alpha1.SuccessSyncSetResult),
+			remote: []runtime.Object{&userv1.Group{
+				ObjectMeta: metav1.ObjectMeta{Name: "admins"},
+				Users:      userv1.OptionalNames{"alice", "bob", "mallory"},
+			}},
+			expectedResult:       hivev1.DriftedSyncRBACResult,
+			expectedMessage:      "groups or role bindings have been changed in the cluster",
+			expectedDrifted:      []string{"ClusterRoleBinding/admins", "Group/admins"},
+			expectedTeamBDrifted: []string{"Group/admins"},
+			expectedRequeueAfter: driftCheckInterval,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			existing := []runtime.Object{test.cd, teamA(), teamB(), existingSyncSet(2)}
+			if test.clusterSync != nil {
+				existing = append(existing, test.clusterSync)
+			}
+			remoteClient := testfake.NewFakeClientBuilder().WithRuntimeObjects(test.remote...).Build()
+			r, c := newReconciler(t, existing, remoteClient)
+			result := reconcileCD(t, r)
+			assert.Equal(t, test.expectedRequeueAfter, result.RequeueAfter, "unexpected requeue after")
+			reconcileStatus(t, r, "team-a", "team-b")
+
+			status := getSelectorSyncRBAC(t, c, "team-a").Status
+			require.Len(t, status.ClusterDeployments, 1)
+			clusterStatus := status.ClusterDeployments[0]
+			assert.Equal(t, testNamespace, clusterStatus.Namespace)
+			assert.Equal(t, testCDName, clusterStatus.Name)
+			assert.Equal(t, test.expectedResult, clusterStatus.Result, "unexpected result")
+			assert.Equal(t, test.expectedMessage, clusterStatus.Message, "unexpected message")
+			assert.Equal(t, test.expectedDrifted, clusterStatus.DriftedResources, "unexpected drifted resources")
+			assert.Equal(t, []hivev1.SyncRBACGroup{group("admins", "alice", "bob")}, clusterStatus.Groups, "unexpected group membership")
+
+			// team-b only declares the group, so the binding does not drift for it.
+			teamBStatus := getSelectorSyncRBAC(t, c, "team-b").Status
+			require.Len(t, teamBStatus.ClusterDeployments, 1)
+			assert.Equal(t, []hivev1.SyncRBACGroup{group("admins", "alice", "bob")}, teamBStatus.ClusterDeployments[0].Groups)
+			assert.Equal(t, test.expectedTeamBDrifted, teamBStatus.ClusterDeployments[0].DriftedResources, "unexpected drifted resources for team-b")
+		})
+	}
+}
+
+func TestReconcileDriftCheckInterval(t *testing.T) {
+	ssrbac := testSelectorSyncRBAC("team-a", hivev1.SelectorSyncRBACSpec{
+		Groups: []hivev1.SyncRBACGroup{group("admins", "alice")},
+	})
+	existing := []runtime.Object{testClusterDeployment(testcd.Installed(), reachable()), ssrbac}
+	r, c := newReconciler(t, existing, nil)
+	reconcileCD(t, r)
+	ss := getSyncSet(t, c)
+	require.NoError(t, c.Create(context.TODO(), testClusterSync(ss.Generation, hiveintv1alpha1.SuccessSyncSetResult)))
+
+	remoteGroup := &userv1.Group{
+		ObjectMeta: metav1.ObjectMeta{Name: "admins"},
+		Users:      userv1.OptionalNames{"alice", "mallory"},
+	}
+	remoteClient := testfake.NewFakeClientBuilder().WithRuntimeObjects(remoteGroup).Build()
+	r.remoteClusterAPIClientBuilder = func(*hivev1.ClusterDeployment) remoteclient.Builder {
+		builder := remoteclientmock.NewMockBuilder(gomock.NewController(t))
+		builder.EXPECT().Build().Return(remoteClient, nil).AnyTimes()
+		return builder
+	}
+
+	result := reconcileCD(t, r)
+	assert.Equal(t, driftCheckInterval, result.RequeueAfter, "unexpected requeue after")
+	assert.Equal(t, "Group/admins", getSyncSet(t, c).Annotations[constants.SyncRBACDriftedResourcesAnnotation],
+		"expected drift to be recorded on the syncset")
+
+	// The drift is not checked again until the drift check interval has passed.
+	remoteGroup.Users = userv1.OptionalNames{"alice"}
+	require.NoError(t, remoteClient.Update(context.TODO(), remoteGroup))
+	result = reconcileCD(t, r)
+	assert.True(t, result.RequeueAfter > 0 && result.RequeueAfter <= driftCheckInterval, "unexpected requeue after %v", result.RequeueAfter)
+	assert.Equal(t, "Group/admins", getSyncSet(t, c).Annotations[constants.SyncRBACDriftedResourcesAnnotation],
+		"expected drift not to be checked again")
+
+	lastCheck := time.Now().Add(-driftCheckInterval)
+	r.setLastDriftCheck(types.NamespacedName{Namespace: testNamespace, Name: testCDName}, &lastCheck)
+	result = reconcileCD(t, r)
+	assert.Equal(t, driftCheckInterval, result.RequeueAfter, "unexpected requeue after")
+	assert.NotContains(t, getSyncSet(t, c).Annotations, constants.SyncRBACDriftedResourcesAnnotation,
+		"expected drift to be cleared from the syncset")
+}
+
+func TestReconcileClusterDeploymentDeletedStatus(t *testing.T) {
+	ssrbac := testSelectorSyncRBAC("team-a", hivev1.SelectorSyncRBACSpec{
+		Groups: []hivev1.SyncRBACGroup{group("admins", "alice")},
+	})
+	ssrbac.Status.ClusterDeployments = []hivev1.SyncRBACClusterStatus{
+		{Namespace: testNamespace, Name: testCDName, Result: hivev1.AppliedSyncRBACResult},
+		{Namespace: testNamespace, Name: "othercluster", Result: hivev1.AppliedSyncRBACResult},
+	}
+	otherCD := testcd.FullBuilder(testNamespace, "othercluster", scheme.GetScheme()).
+		Build(testcd.WithLabel("company", "giantcorp"))
+	r, c := newReconciler(t, []runtime.Object{ssrbac, otherCD}, nil)
+	reconcileStatus(t, r, "team-a")
+
+	status := getSelectorSyncRBAC(t, c, "team-a").Status
+	require.Len(t, status.ClusterDeployments, 1)
+	assert.Equal(t, "othercluster", status.ClusterDeployments[0].Name)
+	assert.Equal(t, hivev1.PendingSyncRBACResult, status.ClusterDeployments[0].Result)
+}
+
+func TestStatusRequestsForCluster(t *testing.T) {
+	r, _ := newReconciler(t, []runtime.Object{
+		testClusterDeployment(),
+		testSelectorSyncRBAC("team-b", hivev1.SelectorSyncRBACSpec{}),
+		testSelectorSyncRBAC("team-a", hivev1.SelectorSyncRBACSpec{}),
+		&hivev1.SelectorSyncRBAC{
+			ObjectMeta: metav1.ObjectMeta{Name: "other"},
+			Spec: hivev1.SelectorSyncRBACSpec{
+				ClusterDeploymentSelector: metav1.LabelSelector{MatchLabels: map[string]string{"company": "other"}},
+			},
+		},
+	}, nil)
+	statusReconciler := &ReconcileSyncRBACStatus{Client: r.Client, logger: r.logger, rbac: r}
+	ss := &hivev1.SyncSet{ObjectMeta: metav1.ObjectMeta{
+		Namespace: testNamespace,
+		Name:      ssName,
+		Labels:    map[string]string{constants.ClusterDeploymentNameLabel: testCDName},
+	}}
+	expected := []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Name: "team-a"}},
+		{NamespacedName: types.NamespacedName{Name: "team-b"}},
+	}
+	assert.Equal(t, expected, statusReconciler.requestsForCluster(constants.ClusterDeploymentNameLabel)(context.TODO(), ss))
+	assert.Equal(t, expected, statusReconciler.requestsForCluster("")(context.TODO(), testClusterSync(1, hiveintv1alpha1.SuccessSyncSetResult)))
+}
+
+func TestGenerateOwnershipUniqueKeys(t *testing.T) {
+	keys := generateOwnershipUniqueKeys(testClusterDeployment())
+	require.Len(t, keys, 1)
+	assert.Equal(t, map[string]string{
+		constants.ClusterDeploymentNameLabel: testCDName,
+		constants.SyncSetTypeLabel:           constants.SyncSetTypeRBAC,
+	}, keys[0].LabelSelector)
+}
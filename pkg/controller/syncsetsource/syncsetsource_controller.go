@@ -0,0 +1,365 @@
+package syncsetsource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/client-go/util/workqueue"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/constants"
+	hivemetrics "github.com/openshift/hive/pkg/controller/metrics"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+	"github.com/openshift/hive/pkg/syncsetsource"
+)
+
+const (
+	ControllerName = hivev1.SyncSetSourceControllerName
+
+	// fetchTimeout limits how long a single fetch of a source may take.
+	fetchTimeout = 5 * time.Minute
+
+	// maxCacheSize is the largest size of the gzipped manifests that fit in the cache secret along with its metadata.
+	maxCacheSize = 1000 * 1024
+)
+
+// Add creates a new SyncSetSource Controller and adds it to the Manager with default RBAC. The Manager will set fields on
+// the Controller and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	logger := log.WithField("controller", ControllerName)
+	concurrentReconciles, clientRateLimiter, queueRateLimiter, err := controllerutils.GetControllerConfig(mgr.GetClient(), ControllerName)
+	if err != nil {
+		logger.WithError(err).Error("could not get controller configurations")
+		return err
+	}
+	return AddToManager(mgr, NewReconciler(mgr, clientRateLimiter), concurrentReconciles, queueRateLimiter)
+}
+
+// NewReconciler returns a new reconcile.Reconciler
+func NewReconciler(mgr manager.Manager, rateLimiter flowcontrol.RateLimiter) *ReconcileSyncSetSource {
+	logger := log.WithField("controller", ControllerName)
+	var insecureRegistries []string
+	if registries := os.Getenv(constants.SyncSetInsecureRegistriesEnvVar); registries != "" {
+		insecureRegistries = strings.Split(registries, ",")
+	}
+	var helmChartRepoHosts []string
+	if hosts := os.Getenv(constants.SyncSetHelmChartRepoHostsEnvVar); hosts != "" {
+		helmChartRepoHosts = strings.Split(hosts, ",")
+	}
+	return &ReconcileSyncSetSource{
+		Client:  controllerutils.NewClientWithMetricsOrDie(mgr, ControllerName, &rateLimiter),
+		logger:  logger,
+		fetcher: syncsetsource.NewFetcher(filepath.Join(os.TempDir(), "syncset-sources"), insecureRegistries, helmChartRepoHosts, logger),
+	}
+}
+
+// AddToManager adds a new Controller to mgr with r as the reconcile.Reconciler
+func AddToManager(mgr manager.Manager, r *ReconcileSyncSetSource, concurrentReconciles int, rateLimiter workqueue.RateLimiter) error {
+	// Create a new controller
+	c, err := controller.New(ControllerName.String()+"-controller", mgr, controller.Options{
+		Reconciler:              controllerutils.NewDelayingReconciler(r, r.logger),
+		MaxConcurrentReconciles: concurrentReconciles,
+		RateLimiter:             rateLimiter,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to SyncSets. Requests for SyncSets are namespaced.
+	if err := c.Watch(source.Kind(mgr.GetCache(), &hivev1.SyncSet{}), &handler.EnqueueRequestForObject{}); err != nil {
+		return err
+	}
+
+	// Watch for changes to SelectorSyncSets. Requests for SelectorSyncSets have no namespace.
+	if err := c.Watch(source.Kind(mgr.GetCache(), &hivev1.SelectorSyncSet{}), &handler.EnqueueRequestForObject{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _ reconcile.Reconciler = &ReconcileSyncSetSource{}
+
+// ReconcileSyncSetSource reconciles the Source of SyncSets and SelectorSyncSets
+type ReconcileSyncSetSource struct {
+	client.Client
+	logger  log.FieldLogger
+	fetcher syncsetsource.Fetcher
+}
+
+// syncSetWithSource gives access to the parts of a SyncSet or SelectorSyncSet used by the controller.
+type syncSetWithSource struct {
+	obj    client.Object
+	source *hivev1.SyncSetSource
+	// helmChart is the Helm chart pulled from its chart repository, if the syncset has one.
+	helmChart *hivev1.HelmChartSyncSetRender
+	status    **hivev1.SyncSetSourceStatus
+	// rendered is true if the manifests of the syncset are rendered from the files of the source.
+	rendered bool
+	// cacheNamespace is the namespace of the cache secret.
+	cacheNamespace string
+	// nameLabel is the label on the cache secret identifying the syncset.
+	nameLabel string
+}
+
+// Reconcile fetches the manifests from the Source of a SyncSet or SelectorSyncSet, or the Helm chart of the syncset from
+// its chart repository, into the cache secret from which the clustersync controller applies them, and records the
+// revision fetched in the status of the syncset.
+func (r *ReconcileSyncSetSource) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := controllerutils.BuildControllerLogger(ControllerName, "syncSet", request.NamespacedName)
+	logger.Info("reconciling syncset source")
+	recobsrv := hivemetrics.NewReconcileObserver(ControllerName, logger)
+	defer recobsrv.ObserveControllerReconcileTime()
+
+	syncSet, err := r.getSyncSet(request.NamespacedName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Debug("syncset not found")
+			return reconcile.Result{}, nil
+		}
+		logger.WithError(err).Error("failed to get syncset")
+		return reconcile.Result{}, err
+	}
+
+	if syncSet.obj.GetDeletionTimestamp() != nil {
+		logger.Debug("syncset is being deleted")
+		return reconcile.Result{}, nil
+	}
+
+	cacheSecret := &corev1.Secret{}
+	cacheSecretKey := types.NamespacedName{
+		Namespace: syncSet.cacheNamespace,
+		Name:      syncsetsource.CacheSecretName(syncSet.obj.GetName()),
+	}
+	switch err := r.Get(context.TODO(), cacheSecretKey, cacheSecret); {
+	case apierrors.IsNotFound(err):
+		cacheSecret = nil
+	case err != nil:
+		logger.WithError(err).Log(controllerutils.LogLevel(err), "could not get cache secret")
+		return reconcile.Result{}, err
+	}
+
+	if syncSet.source == nil && syncSet.helmChart == nil {
+		if cacheSecret != nil && metav1.IsControlledBy(cacheSecret, syncSet.obj) {
+			logger.Info("deleting cache secret since the syncset no longer has a source")
+			if err := r.Delete(context.TODO(), cacheSecret); err != nil && !apierrors.IsNotFound(err) {
+				logger.WithError(err).Log(controllerutils.LogLevel(err), "could not delete cache secret")
+				return reconcile.Result{}, err
+			}
+		}
+		if *syncSet.status == nil {
+			return reconcile.Result{}, nil
+		}
+		*syncSet.status = nil
+		return reconcile.Result{}, r.updateStatus(syncSet.obj, logger)
+	}
+
+	refreshInterval := syncsetsource.RefreshInterval(syncSet.source)
+	origStatus := (*syncSet.status).DeepCopy()
+	status := origStatus.DeepCopy()
+	if status == nil {
+		status = &hivev1.SyncSetSourceStatus{}
+	}
+
+	// Skip fetching if the revision in the cache was fetched recently for the current generation.
+	if status.ObservedGeneration == syncSet.obj.GetGeneration() && status.FailureMessage == "" &&
+		status.LastFetchTime != nil && cacheSecret != nil &&
+		string(cacheSecret.Data[syncsetsource.RevisionSecretKey]) == status.ResolvedRevision {
+		if wait := refreshInterval - time.Since(status.LastFetchTime.Time); wait > 0 {
+			logger.WithField("revision", status.ResolvedRevision).Debug("source was fetched recently")
+			return reconcile.Result{RequeueAfter: wait}, nil
+		}
+	}
+
+	revision, fetchErr := r.fetch(syncSet, cacheSecret, logger)
+	status.ObservedGeneration = syncSet.obj.GetGeneration()
+	if fetchErr != nil {
+		logger.WithError(fetchErr).Warn("failed to fetch source")
+		status.FailureMessage = controllerutils.ErrorScrub(fetchErr)
+	} else {
+		status.FailureMessage = ""
+		if status.ResolvedRevision != revision {
+			logger.WithField("revision", revision).Info("fetched new revision of source")
+		}
+		// The clustersync controller ignores updates to the syncset that only change the fetch time, so updating
+		// it on every fetch does not reconcile every cluster using the syncset.
+		now := metav1.Now()
+		status.LastFetchTime = &now
+		status.ResolvedRevision = revision
+	}
+
+	if !reflect.DeepEqual(origStatus, status) {
+		*syncSet.status = status
+		if err := r.updateStatus(syncSet.obj, logger); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	if fetchErr != nil {
+		return reconcile.Result{}, fetchErr
+	}
+	return reconcile.Result{RequeueAfter: refreshInterval}, nil
+}
+
+func (r *ReconcileSyncSetSource) getSyncSet(key types.NamespacedName) (*syncSetWithSource, error) {
+	if key.Namespace == "" {
+		sss := &hivev1.SelectorSyncSet{}
+		if err := r.Get(context.TODO(), key, sss); err != nil {
+			return nil, err
+		}
+		return &syncSetWithSource{
+			obj:            sss,
+			source:         sss.Spec.Source,
+			helmChart:      repoHelmChart(&sss.Spec.SyncSetCommonSpec),
+			status:         &sss.Status.Source,
+			rendered:       syncsetsource.IsRendered(&sss.Spec.SyncSetCommonSpec),
+			cacheNamespace: controllerutils.GetHiveNamespace(),
+			nameLabel:      constants.SelectorSyncSetNameLabel,
+		}, nil
+	}
+	ss := &hivev1.SyncSet{}
+	if err := r.Get(context.TODO(), key, ss); err != nil {
+		return nil, err
+	}
+	return &syncSetWithSource{
+		obj:            ss,
+		source:         ss.Spec.Source,
+		helmChart:      repoHelmChart(&ss.Spec.SyncSetCommonSpec),
+		status:         &ss.Status.Source,
+		rendered:       syncsetsource.IsRendered(&ss.Spec.SyncSetCommonSpec),
+		cacheNamespace: ss.Namespace,
+		nameLabel:      constants.SyncSetNameLabel,
+	}, nil
+}
+
+// repoHelmChart returns the Helm chart of the syncset if it is pulled from a chart repository.
+func repoHelmChart(spec *hivev1.SyncSetCommonSpec) *hivev1.HelmChartSyncSetRender {
+	if spec.Source != nil || spec.HelmChart == nil || spec.HelmChart.Repo == "" {
+		return nil
+	}
+	return spec.HelmChart
+}
+
+// fetch fetches the manifests from the source, or the Helm chart from its chart repository, and stores them in the
+// cache secret, returning the revision fetched.
+func (r *ReconcileSyncSetSource) fetch(syncSet *syncSetWithSource, cacheSecret *corev1.Secret, logger log.FieldLogger) (string, error) {
+	var creds *syncsetsource.Credentials
+	if syncSet.source != nil && syncSet.source.CredentialsSecretRef != nil {
+		ref := syncSet.source.CredentialsSecretRef
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = syncSet.obj.GetNamespace()
+		}
+		secret := &corev1.Secret{}
+		if err := r.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+			logger.WithError(err).Log(controllerutils.LogLevel(err), "could not get credentials secret")
+			return "", err
+		}
+		var err error
+		if creds, err = syncsetsource.CredentialsFromSecret(secret); err != nil {
+			return "", err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+	var (
+		revision string
+		files    map[string][]byte
+		err      error
+	)
+	if syncSet.source != nil {
+		revision, files, err = r.fetcher.Fetch(ctx, syncSet.source, creds)
+	} else {
+		revision, files, err = r.fetcher.FetchHelmChart(ctx, syncSet.helmChart)
+	}
+	if err != nil {
+		return "", err
+	}
+	// Rendered syncsets need the files of the source rather than the manifests in them.
+	dataKey := syncsetsource.ManifestsSecretKey
+	if syncSet.rendered {
+		dataKey = syncsetsource.FilesSecretKey
+	}
+	if cacheSecret != nil && string(cacheSecret.Data[syncsetsource.RevisionSecretKey]) == revision && cacheSecret.Data[dataKey] != nil {
+		return revision, nil
+	}
+	var data []byte
+	if syncSet.rendered {
+		data, err = syncsetsource.EncodeFiles(files)
+	} else {
+		var manifests []runtime.RawExtension
+		if manifests, err = syncsetsource.ManifestsFromFiles(files); err == nil {
+			data, err = syncsetsource.EncodeManifests(manifests)
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(data) > maxCacheSize {
+		return "", errors.Errorf("source is %d bytes when compressed, which is larger than the limit of %d bytes", len(data), maxCacheSize)
+	}
+
+	create := cacheSecret == nil
+	if create {
+		cacheSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: syncSet.cacheNamespace,
+				Name:      syncsetsource.CacheSecretName(syncSet.obj.GetName()),
+			},
+		}
+	} else if !metav1.IsControlledBy(cacheSecret, syncSet.obj) {
+		return "", errors.Errorf("secret %s/%s already exists and is not owned by the syncset", cacheSecret.Namespace, cacheSecret.Name)
+	}
+	if cacheSecret.Labels == nil {
+		cacheSecret.Labels = map[string]string{}
+	}
+	cacheSecret.Labels[constants.SecretTypeLabel] = constants.SecretTypeSyncSetSource
+	cacheSecret.Labels[syncSet.nameLabel] = syncSet.obj.GetName()
+	cacheSecret.Data = map[string][]byte{
+		dataKey:                         data,
+		syncsetsource.RevisionSecretKey: []byte(revision),
+	}
+	if err := controllerutil.SetControllerReference(syncSet.obj, cacheSecret, r.Scheme()); err != nil {
+		logger.WithError(err).Error("could not set owner of cache secret")
+		return "", err
+	}
+	if create {
+		err = r.Create(context.TODO(), cacheSecret)
+	} else {
+		err = r.Update(context.TODO(), cacheSecret)
+	}
+	if err != nil {
+		logger.WithError(err).Log(controllerutils.LogLevel(err), "could not save cache secret")
+		return "", err
+	}
+	return revision, nil
+}
+
+func (r *ReconcileSyncSetSource) updateStatus(obj client.Object, logger log.FieldLogger) error {
+	if err := r.Status().Update(context.TODO(), obj); err != nil {
+		logger.WithError(err).Log(controllerutils.LogLevel(err), "could not update syncset status")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,361 @@
+package syncsetsource
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/constants"
+	"github.com/openshift/hive/pkg/syncsetsource"
+	testfake "github.com/openshift/hive/pkg/test/fake"
+	testsecret "github.com/openshift/hive/pkg/test/secret"
+	testselectorsyncset "github.com/openshift/hive/pkg/test/selectorsyncset"
+	testsyncset "github.com/openshift/hive/pkg/test/syncset"
+	"github.com/openshift/hive/pkg/util/scheme"
+)
+
+const (
+	testNamespace = "test-namespace"
+	testName      = "test-syncset"
+	oldRevision   = "1111111111111111111111111111111111111111"
+	newRevision   = "2222222222222222222222222222222222222222"
+)
+
+type fakeFetcher struct {
+	revision string
+	err      error
+
+	calls      int
+	chartCalls int
+	creds      *syncsetsource.Credentials
+}
+
+func (f *fakeFetcher) Fetch(ctx context.Context, source *hivev1.SyncSetSource, creds *syncsetsource.Credentials) (string, map[string][]byte, error) {
+	f.calls++
+	f.creds = creds
+	if f.err != nil {
+		return "", nil, f.err
+	}
+	return f.revision, map[string][]byte{
+		"cm.yaml": []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"` + f.revision + `"}}`),
+	}, nil
+}
+
+func (f *fakeFetcher) FetchHelmChart(ctx context.Context, chart *hivev1.HelmChartSyncSetRender) (string, map[string][]byte, error) {
+	f.chartCalls++
+	if f.err != nil {
+		return "", nil, f.err
+	}
+	return f.revision, map[string][]byte{"cm.yaml": []byte("chart")}, nil
+}
+
+func testSource() *hivev1.SyncSetSource {
+	return &hivev1.SyncSetSource{
+		Git:             &hivev1.GitSyncSetSource{URL: "https://example.com/manifests.git"},
+		RefreshInterval: &metav1.Duration{Duration: 10 * time.Minute},
+	}
+}
+
+func testSourceStatus(revision string, fetched time.Time) *hivev1.SyncSetSourceStatus {
+	t := metav1.NewTime(fetched)
+	return &hivev1.SyncSetSourceStatus{
+		ObservedGeneration: 1,
+		ResolvedRevision:   revision,
+		LastFetchTime:      &t,
+	}
+}
+
+func testSyncSet(opts ...testsyncset.Option) *hivev1.SyncSet {
+	return testsyncset.FullBuilder(testNamespace, testName, scheme.GetScheme()).Build(
+		append([]testsyncset.Option{testsyncset.WithGeneration(1)}, opts...)...,
+	)
+}
+
+func testCacheSecret(owner client.Object, namespace, revision string) *corev1.Secret {
+	secret := testsecret.FullBuilder(namespace, syncsetsource.CacheSecretName(testName), scheme.GetScheme()).Build(
+		testsecret.WithDataKeyValue(syncsetsource.RevisionSecretKey, []byte(revision)),
+	)
+	if owner != nil {
+		controllerutil.SetControllerReference(owner, secret, scheme.GetScheme())
+	}
+	return secret
+}
+
+func TestReconcileSyncSetSource(t *testing.T) {
+	now := time.Now()
+	recent := now.Add(-time.Minute)
+	stale := now.Add(-time.Hour)
+
+	cases := []struct {
+		name               string
+		syncSet            client.Object
+		existing           []runtime.Object
+		fetcher            *fakeFetcher
+		expectErr          bool
+		expectFetch        bool
+		expectedStatus     *hivev1.SyncSetSourceStatus
+		expectFetchTimeNow bool
+		expectNoSecret     bool
+		expectedRevision   string
+		expectedCreds      *syncsetsource.Credentials
+		expectFiles        bool
+		expectChartFetch   bool
+	}{
+		{
+			name:               "fetch new source",
+			syncSet:            testSyncSet(testsyncset.WithSource(testSource())),
+			fetcher:            &fakeFetcher{revision: newRevision},
+			expectFetch:        true,
+			expectedStatus:     &hivev1.SyncSetSourceStatus{ObservedGeneration: 1, ResolvedRevision: newRevision},
+			expectFetchTimeNow: true,
+			expectedRevision:   newRevision,
+		},
+		{
+			name: "skip recently fetched source",
+			syncSet: testSyncSet(
+				testsyncset.WithSource(testSource()),
+				testsyncset.WithSourceStatus(testSourceStatus(oldRevision, recent)),
+			),
+			existing:         []runtime.Object{testCacheSecret(testSyncSet(), testNamespace, oldRevision)},
+			fetcher:          &fakeFetcher{revision: newRevision},
+			expectedStatus:   testSourceStatus(oldRevision, recent),
+			expectedRevision: oldRevision,
+		},
+		{
+			name: "refresh source without new revision",
+			syncSet: testSyncSet(
+				testsyncset.WithSource(testSource()),
+				testsyncset.WithSourceStatus(testSourceStatus(oldRevision, stale)),
+			),
+			existing:           []runtime.Object{testCacheSecret(testSyncSet(), testNamespace, oldRevision)},
+			fetcher:            &fakeFetcher{revision: oldRevision},
+			expectFetch:        true,
+			expectedStatus:     &hivev1.SyncSetSourceStatus{ObservedGeneration: 1, ResolvedRevision: oldRevision},
+			expectFetchTimeNow: true,
+			expectedRevision:   oldRevision,
+		},
+		{
+			name: "refresh source with new revision",
+			syncSet: testSyncSet(
+				testsyncset.WithSource(testSource()),
+				testsyncset.WithSourceStatus(testSourceStatus(oldRevision, stale)),
+			),
+			existing:           []runtime.Object{testCacheSecret(testSyncSet(), testNamespace, oldRevision)},
+			fetcher:            &fakeFetcher{revision: newRevision},
+			expectFetch:        true,
+			expectedStatus:     &hivev1.SyncSetSourceStatus{ObservedGeneration: 1, ResolvedRevision: newRevision},
+			expectFetchTimeNow: true,
+			expectedRevision:   newRevision,
+		},
+		{
+			name: "fetch on generation change",
+			syncSet: testSyncSet(
+				testsyncset.WithGeneration(2),
+				testsyncset.WithSource(testSource()),
+				testsyncset.WithSourceStatus(testSourceStatus(oldRevision, recent)),
+			),
+			existing:           []runtime.Object{testCacheSecret(testSyncSet(), testNamespace, oldRevision)},
+			fetcher:            &fakeFetcher{revision: newRevision},
+			expectFetch:        true,
+			expectedStatus:     &hivev1.SyncSetSourceStatus{ObservedGeneration: 2, ResolvedRevision: newRevision},
+			expectFetchTimeNow: true,
+			expectedRevision:   newRevision,
+		},
+		{
+			name: "fetch failure",
+			syncSet: testSyncSet(
+				testsyncset.WithSource(testSource()),
+				testsyncset.WithSourceStatus(testSourceStatus(oldRevision, stale)),
+			),
+			existing:    []runtime.Object{testCacheSecret(testSyncSet(), testNamespace, oldRevision)},
+			fetcher:     &fakeFetcher{err: errors.New("repository not found")},
+			expectErr:   true,
+			expectFetch: true,
+			expectedStatus: func() *hivev1.SyncSetSourceStatus {
+				s := testSourceStatus(oldRevision, stale)
+				s.FailureMessage = "repository not found"
+				return s
+			}(),
+			expectedRevision: oldRevision,
+		},
+		{
+			name: "credentials",
+			syncSet: testSyncSet(testsyncset.WithSource(func() *hivev1.SyncSetSource {
+				s := testSource()
+				s.CredentialsSecretRef = &hivev1.SecretReference{Name: "creds"}
+				return s
+			}())),
+			existing: []runtime.Object{
+				testsecret.FullBuilder(testNamespace, "creds", scheme.GetScheme()).Build(
+					testsecret.WithDataKeyValue("username", []byte("user")),
+					testsecret.WithDataKeyValue("password", []byte("pass")),
+				),
+			},
+			fetcher:            &fakeFetcher{revision: newRevision},
+			expectFetch:        true,
+			expectedStatus:     &hivev1.SyncSetSourceStatus{ObservedGeneration: 1, ResolvedRevision: newRevision},
+			expectFetchTimeNow: true,
+			expectedRevision:   newRevision,
+			expectedCreds:      &syncsetsource.Credentials{Username: "user", Password: "pass", SecretKey: testNamespace + "/creds/1"},
+		},
+		{
+			name:        "cache secret owned by another object",
+			syncSet:     testSyncSet(testsyncset.WithSource(testSource())),
+			existing:    []runtime.Object{testCacheSecret(nil, testNamespace, oldRevision)},
+			fetcher:     &fakeFetcher{revision: newRevision},
+			expectErr:   true,
+			expectFetch: true,
+			expectedStatus: &hivev1.SyncSetSourceStatus{
+				ObservedGeneration: 1,
+				FailureMessage:     "secret test-namespace/test-syncset-source already exists and is not owned by the syncset",
+			},
+			expectedRevision: oldRevision,
+		},
+		{
+			name: "helm chart from chart repository",
+			syncSet: testSyncSet(testsyncset.WithHelmChart(&hivev1.HelmChartSyncSetRender{
+				Chart: "nginx",
+				Repo:  "https://charts.example.com",
+			})),
+			fetcher:            &fakeFetcher{revision: "nginx-1.2.3"},
+			expectChartFetch:   true,
+			expectedStatus:     &hivev1.SyncSetSourceStatus{ObservedGeneration: 1, ResolvedRevision: "nginx-1.2.3"},
+			expectFetchTimeNow: true,
+			expectedRevision:   "nginx-1.2.3",
+			expectFiles:        true,
+		},
+		{
+			name: "rendered syncset caches files",
+			syncSet: testSyncSet(
+				testsyncset.WithSource(testSource()),
+				testsyncset.WithKustomize(&hivev1.KustomizeSyncSetRender{}),
+			),
+			fetcher:            &fakeFetcher{revision: newRevision},
+			expectFetch:        true,
+			expectedStatus:     &hivev1.SyncSetSourceStatus{ObservedGeneration: 1, ResolvedRevision: newRevision},
+			expectFetchTimeNow: true,
+			expectedRevision:   newRevision,
+			expectFiles:        true,
+		},
+		{
+			name: "rendered syncset replaces cached manifests",
+			syncSet: testSyncSet(
+				testsyncset.WithGeneration(2),
+				testsyncset.WithSource(testSource()),
+				testsyncset.WithKustomize(&hivev1.KustomizeSyncSetRender{}),
+				testsyncset.WithSourceStatus(testSourceStatus(newRevision, recent)),
+			),
+			existing:           []runtime.Object{testCacheSecret(testSyncSet(), testNamespace, newRevision)},
+			fetcher:            &fakeFetcher{revision: newRevision},
+			expectFetch:        true,
+			expectedStatus:     &hivev1.SyncSetSourceStatus{ObservedGeneration: 2, ResolvedRevision: newRevision},
+			expectFetchTimeNow: true,
+			expectedRevision:   newRevision,
+			expectFiles:        true,
+		},
+		{
+			name:           "source removed",
+			syncSet:        testSyncSet(testsyncset.WithSourceStatus(testSourceStatus(oldRevision, stale))),
+			existing:       []runtime.Object{testCacheSecret(testSyncSet(), testNamespace, oldRevision)},
+			fetcher:        &fakeFetcher{revision: newRevision},
+			expectNoSecret: true,
+		},
+		{
+			name: "selectorsyncset",
+			syncSet: testselectorsyncset.FullBuilder(testName, scheme.GetScheme()).Build(
+				testselectorsyncset.WithGeneration(1),
+				testselectorsyncset.WithSource(testSource()),
+			),
+			fetcher:            &fakeFetcher{revision: newRevision},
+			expectFetch:        true,
+			expectedStatus:     &hivev1.SyncSetSourceStatus{ObservedGeneration: 1, ResolvedRevision: newRevision},
+			expectFetchTimeNow: true,
+			expectedRevision:   newRevision,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			existing := append([]runtime.Object{tc.syncSet}, tc.existing...)
+			c := testfake.NewFakeClientBuilder().WithRuntimeObjects(existing...).Build()
+			rcd := &ReconcileSyncSetSource{
+				Client:  c,
+				logger:  log.WithField("controller", "syncsetsource"),
+				fetcher: tc.fetcher,
+			}
+			key := client.ObjectKeyFromObject(tc.syncSet)
+			result, err := rcd.Reconcile(context.TODO(), reconcile.Request{NamespacedName: key})
+			if tc.expectErr {
+				assert.Error(t, err, "expected error from reconcile")
+			} else {
+				require.NoError(t, err, "unexpected error from reconcile")
+				if !tc.expectNoSecret {
+					assert.Positive(t, result.RequeueAfter, "expected requeue")
+					assert.LessOrEqual(t, result.RequeueAfter, 10*time.Minute, "unexpected requeue")
+				}
+			}
+			assert.Equal(t, tc.expectFetch, tc.fetcher.calls > 0, "unexpected fetch")
+			assert.Equal(t, tc.expectChartFetch, tc.fetcher.chartCalls > 0, "unexpected chart fetch")
+			assert.Equal(t, tc.expectedCreds, tc.fetcher.creds, "unexpected credentials")
+
+			var status *hivev1.SyncSetSourceStatus
+			cacheNamespace := key.Namespace
+			if key.Namespace == "" {
+				sss := &hivev1.SelectorSyncSet{}
+				require.NoError(t, c.Get(context.TODO(), key, sss))
+				status = sss.Status.Source
+				cacheNamespace = constants.DefaultHiveNamespace
+			} else {
+				ss := &hivev1.SyncSet{}
+				require.NoError(t, c.Get(context.TODO(), key, ss))
+				status = ss.Status.Source
+			}
+			if tc.expectFetchTimeNow {
+				if assert.NotNil(t, status, "expected source status") && assert.NotNil(t, status.LastFetchTime, "expected fetch time") {
+					assert.WithinDuration(t, now, status.LastFetchTime.Time, time.Minute, "unexpected fetch time")
+					status.LastFetchTime = nil
+				}
+			} else if status != nil && status.LastFetchTime != nil {
+				// Round-tripping through the client drops sub-second precision
+				assert.WithinDuration(t, tc.expectedStatus.LastFetchTime.Time, status.LastFetchTime.Time, time.Second, "unexpected fetch time")
+				status.LastFetchTime = tc.expectedStatus.LastFetchTime
+			}
+			assert.Equal(t, tc.expectedStatus, status, "unexpected source status")
+
+			secret := &corev1.Secret{}
+			err = c.Get(context.TODO(), types.NamespacedName{Namespace: cacheNamespace, Name: syncsetsource.CacheSecretName(testName)}, secret)
+			if tc.expectNoSecret {
+				assert.True(t, apierrors.IsNotFound(err), "expected cache secret to be deleted")
+				return
+			}
+			require.NoError(t, err, "unexpected error getting cache secret")
+			assert.Equal(t, tc.expectedRevision, string(secret.Data[syncsetsource.RevisionSecretKey]), "unexpected cached revision")
+			switch {
+			case tc.expectFiles:
+				assert.NotContains(t, secret.Data, syncsetsource.ManifestsSecretKey, "unexpected cached manifests")
+				files, err := syncsetsource.DecodeFiles(secret.Data[syncsetsource.FilesSecretKey])
+				require.NoError(t, err, "unexpected error decoding cached files")
+				assert.Contains(t, files, "cm.yaml", "expected cached file")
+			case tc.expectedRevision == newRevision:
+				assert.Equal(t, constants.SecretTypeSyncSetSource, secret.Labels[constants.SecretTypeLabel], "unexpected secret type label")
+				assert.NotContains(t, secret.Data, syncsetsource.FilesSecretKey, "unexpected cached files")
+				manifests, err := syncsetsource.DecodeManifests(secret.Data[syncsetsource.ManifestsSecretKey])
+				require.NoError(t, err, "unexpected error decoding cached manifests")
+				assert.Len(t, manifests, 1, "unexpected number of cached manifests")
+			}
+		})
+	}
+}
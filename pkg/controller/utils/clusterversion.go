@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// FindClusterVersionCondition returns the condition of the ClusterVersion of the given type, or nil if it has none.
+func FindClusterVersionCondition(clusterVersion *configv1.ClusterVersion, condType configv1.ClusterStatusConditionType) *configv1.ClusterOperatorStatusCondition {
+	for i, cond := range clusterVersion.Status.Conditions {
+		if cond.Type == condType {
+			return &clusterVersion.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// ClusterOperatorsNotAvailable returns a message naming the ClusterOperators of the remote cluster that are not
+// Available or are Degraded, or an empty string if there are none.
+func ClusterOperatorsNotAvailable(remoteClient client.Client) (string, error) {
+	coList := &configv1.ClusterOperatorList{}
+	if err := remoteClient.List(context.TODO(), coList); err != nil {
+		return "", errors.Wrap(err, "failed to list ClusterOperators")
+	}
+	if len(coList.Items) == 0 {
+		return "cluster is not reporting any ClusterOperators", nil
+	}
+	var notAvailable []string
+	for _, co := range coList.Items {
+		available, degraded := false, false
+		for _, cond := range co.Status.Conditions {
+			switch cond.Type {
+			case configv1.OperatorAvailable:
+				available = cond.Status == configv1.ConditionTrue
+			case configv1.OperatorDegraded:
+				degraded = cond.Status == configv1.ConditionTrue
+			}
+		}
+		if !available || degraded {
+			notAvailable = append(notAvailable, co.Name)
+		}
+	}
+	if len(notAvailable) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("ClusterOperators not available or degraded: %s", strings.Join(notAvailable, ", ")), nil
+}
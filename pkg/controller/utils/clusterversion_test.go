@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	testfake "github.com/openshift/hive/pkg/test/fake"
+)
+
+func TestClusterOperatorsNotAvailable(t *testing.T) {
+	clusterOperator := func(name string, available, degraded configv1.ConditionStatus) runtime.Object {
+		return &configv1.ClusterOperator{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: configv1.ClusterOperatorStatus{
+				Conditions: []configv1.ClusterOperatorStatusCondition{
+					{Type: configv1.OperatorAvailable, Status: available},
+					{Type: configv1.OperatorDegraded, Status: degraded},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name            string
+		existing        []runtime.Object
+		expectedMessage string
+	}{
+		{
+			name:            "no cluster operators",
+			expectedMessage: "cluster is not reporting any ClusterOperators",
+		},
+		{
+			name: "all available",
+			existing: []runtime.Object{
+				clusterOperator("dns", configv1.ConditionTrue, configv1.ConditionFalse),
+				clusterOperator("network", configv1.ConditionTrue, configv1.ConditionFalse),
+			},
+		},
+		{
+			name: "not available or degraded",
+			existing: []runtime.Object{
+				clusterOperator("dns", configv1.ConditionTrue, configv1.ConditionFalse),
+				clusterOperator("ingress", configv1.ConditionFalse, configv1.ConditionFalse),
+				clusterOperator("network", configv1.ConditionTrue, configv1.ConditionTrue),
+			},
+			expectedMessage: "ClusterOperators not available or degraded: ingress, network",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := testfake.NewFakeClientBuilder().WithRuntimeObjects(test.existing...).Build()
+			message, err := ClusterOperatorsNotAvailable(c)
+			require.NoError(t, err, "unexpected error")
+			assert.Equal(t, test.expectedMessage, message, "unexpected message")
+		})
+	}
+}
@@ -56,6 +56,11 @@ var (
 			Resources: []string{"machinepools"},
 			Verbs:     []string{"get", "list", "update"},
 		},
+		{
+			APIGroups: []string{"hiveinternal.openshift.io"},
+			Resources: []string{"agentimageclusterinstalls", "agentimageclusterinstalls/status"},
+			Verbs:     []string{"get", "update"},
+		},
 	}
 
 	uninstallRoleRules = []rbacv1.PolicyRule{
@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"hash/fnv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+)
+
+const (
+	// DefaultRolloutWavePercentage is the percentage of clusters added to a SelectorSyncSet rollout in each wave
+	// when the RolloutStrategy does not specify one.
+	DefaultRolloutWavePercentage int32 = 25
+
+	// DefaultRolloutWaveInterval is how long a SelectorSyncSet rollout wave must be successful before the rollout
+	// expands when the RolloutStrategy does not specify one.
+	DefaultRolloutWaveInterval = 10 * time.Minute
+)
+
+// RolloutWavePercentage returns the wave percentage of the rollout strategy, applying the default if unset.
+func RolloutWavePercentage(strategy *hivev1.SelectorSyncSetRolloutStrategy) int32 {
+	if strategy.WavePercentage == nil {
+		return DefaultRolloutWavePercentage
+	}
+	return *strategy.WavePercentage
+}
+
+// RolloutWaveInterval returns the wave interval of the rollout strategy, applying the default if unset.
+func RolloutWaveInterval(strategy *hivev1.SelectorSyncSetRolloutStrategy) time.Duration {
+	if strategy.WaveInterval == nil {
+		return DefaultRolloutWaveInterval
+	}
+	return strategy.WaveInterval.Duration
+}
+
+// RolloutBucket deterministically places a cluster into one of 100 buckets for the rollout of a SelectorSyncSet.
+// A cluster is part of a rollout at percentage P when its bucket is less than P. Hashing with the name of the
+// SelectorSyncSet spreads the canary clusters of different SelectorSyncSets across the fleet.
+func RolloutBucket(sss *hivev1.SelectorSyncSet, cd *hivev1.ClusterDeployment) int32 {
+	h := fnv.New32a()
+	h.Write([]byte(sss.Name + "/" + cd.Namespace + "/" + cd.Name))
+	return int32(h.Sum32() % 100)
+}
+
+// IsClusterInSelectorSyncSetRollout determines whether the current generation of the SelectorSyncSet may be
+// applied to the cluster. This is always true for SelectorSyncSets without a RolloutStrategy. For SelectorSyncSets
+// with a RolloutStrategy, it is true once the rollout of the current generation has reached the cluster, either
+// because the cluster is selected by the canary selector or because it falls within the rollout percentage.
+func IsClusterInSelectorSyncSetRollout(sss *hivev1.SelectorSyncSet, cd *hivev1.ClusterDeployment, logger log.FieldLogger) bool {
+	strategy := sss.Spec.RolloutStrategy
+	if strategy == nil {
+		return true
+	}
+	rollout := sss.Status.Rollout
+	if rollout == nil || rollout.ObservedGeneration != sss.Generation {
+		// The rollout of this generation has not been started yet.
+		return false
+	}
+	if rollout.Phase == hivev1.CompleteSelectorSyncSetRolloutPhase {
+		return true
+	}
+	if canary := strategy.Canary; canary != nil && canary.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(canary.Selector)
+		if err != nil {
+			logger.WithError(err).Error("unable to convert canary selector")
+		} else if selector.Matches(labels.Set(cd.Labels)) {
+			return true
+		}
+	}
+	return RolloutBucket(sss, cd) < rollout.Percentage
+}
@@ -2,6 +2,7 @@ package gcpclient
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"os"
 	"strings"
@@ -16,6 +17,7 @@ import (
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
 	serviceusage "google.golang.org/api/serviceusage/v1"
+	storage "google.golang.org/api/storage/v1"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -41,6 +43,10 @@ type Client interface {
 
 	DeleteManagedZone(managedZone string) error
 
+	PatchManagedZone(managedZone string, patch *dns.ManagedZone) error
+
+	ListDNSKeys(managedZone string) ([]*dns.DnsKey, error)
+
 	ListComputeZones(ListComputeZonesOptions) (*compute.ZoneList, error)
 
 	ListComputeImages(ListComputeImagesOptions) (*compute.ImageList, error)
@@ -50,6 +56,14 @@ type Client interface {
 	StopInstance(*compute.Instance) error
 
 	StartInstance(*compute.Instance) error
+
+	UploadObject(bucket, name string, body io.Reader) (*storage.Object, error)
+
+	ListObjects(bucket, prefix string) ([]*storage.Object, error)
+
+	GetObject(bucket, name string) (io.ReadCloser, error)
+
+	DeleteObject(bucket, name string) error
 }
 
 // ListManagedZonesOptions are the options for listing managed zones.
@@ -59,6 +73,13 @@ type ListManagedZonesOptions struct {
 	DNSName    string
 }
 
+// ManagedZoneName returns the name Hive gives the managed zone it creates for the given DNS zone.
+func ManagedZoneName(zone string) string {
+	tmp := strings.ToLower(zone)
+	tmp = strings.ReplaceAll(tmp, ".", "-")
+	return "hive-" + tmp
+}
+
 // ListResourceRecordSetsOptions are the options for listing resource record sets.
 type ListResourceRecordSetsOptions struct {
 	MaxResults int64
@@ -79,10 +100,15 @@ type gcpClient struct {
 	computeClient              *compute.Service
 	serviceUsageClient         *serviceusage.Service
 	dnsClient                  *dns.Service
+	storageClient              *storage.Service
 }
 
 const (
 	defaultCallTimeout = 2 * time.Minute
+
+	// uploadCallTimeout is the timeout for uploading objects, which may be considerably larger than the
+	// payloads of the other calls.
+	uploadCallTimeout = 10 * time.Minute
 )
 
 func contextWithTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
@@ -125,6 +151,24 @@ func (c *gcpClient) DeleteManagedZone(managedZone string) error {
 	return c.dnsClient.ManagedZones.Delete(c.projectName, managedZone).Context(ctx).Do()
 }
 
+func (c *gcpClient) PatchManagedZone(managedZone string, patch *dns.ManagedZone) error {
+	ctx, cancel := contextWithTimeout(context.TODO())
+	defer cancel()
+	_, err := c.dnsClient.ManagedZones.Patch(c.projectName, managedZone, patch).Context(ctx).Do()
+	return err
+}
+
+func (c *gcpClient) ListDNSKeys(managedZone string) ([]*dns.DnsKey, error) {
+	ctx, cancel := contextWithTimeout(context.TODO())
+	defer cancel()
+	var keys []*dns.DnsKey
+	err := c.dnsClient.DnsKeys.List(c.projectName, managedZone).Pages(ctx, func(page *dns.DnsKeysListResponse) error {
+		keys = append(keys, page.DnsKeys...)
+		return nil
+	})
+	return keys, err
+}
+
 func (c *gcpClient) ListResourceRecordSets(managedZone string, opts ListResourceRecordSetsOptions) (*dns.ResourceRecordSetsListResponse, error) {
 	ctx, cancel := contextWithTimeout(context.TODO())
 	defer cancel()
@@ -265,6 +309,38 @@ func (c *gcpClient) StartInstance(instance *compute.Instance) error {
 	return nil
 }
 
+func (c *gcpClient) UploadObject(bucket, name string, body io.Reader) (*storage.Object, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), uploadCallTimeout)
+	defer cancel()
+	return c.storageClient.Objects.Insert(bucket, &storage.Object{Name: name}).Media(body).Context(ctx).Do()
+}
+
+func (c *gcpClient) ListObjects(bucket, prefix string) ([]*storage.Object, error) {
+	ctx, cancel := contextWithTimeout(context.TODO())
+	defer cancel()
+	var objects []*storage.Object
+	err := c.storageClient.Objects.List(bucket).Prefix(prefix).Pages(ctx, func(page *storage.Objects) error {
+		objects = append(objects, page.Items...)
+		return nil
+	})
+	return objects, err
+}
+
+// GetObject returns the contents of the object. The caller is responsible for closing them.
+func (c *gcpClient) GetObject(bucket, name string) (io.ReadCloser, error) {
+	resp, err := c.storageClient.Objects.Get(bucket, name).Download()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (c *gcpClient) DeleteObject(bucket, name string) error {
+	ctx, cancel := contextWithTimeout(context.TODO())
+	defer cancel()
+	return c.storageClient.Objects.Delete(bucket, name).Context(ctx).Do()
+}
+
 // NewClient creates our client wrapper object for interacting with GCP. The supplied byte slice contains the GCP creds.
 func NewClient(authJSON []byte) (Client, error) {
 	return newClient(authJSONPassthroughSource(authJSON))
@@ -276,6 +352,20 @@ func NewClientFromSecret(secret *corev1.Secret) (Client, error) {
 	return newClient(authJSONFromSecretSource(secret))
 }
 
+// NewClientFromSecretForProject creates our client wrapper object for interacting with GCP resources in the
+// specified project rather than in the project of the GCP creds. The GCP creds are read from the specified secret.
+// If the project is empty, the project of the GCP creds is used.
+func NewClientFromSecretForProject(secret *corev1.Secret, projectID string) (Client, error) {
+	client, err := newClient(authJSONFromSecretSource(secret))
+	if err != nil {
+		return nil, err
+	}
+	if projectID != "" {
+		client.projectName = projectID
+	}
+	return client, nil
+}
+
 // NewClientFromFile creates our client wrapper object for interacting with GCP. The GCP creds are read from the
 // specified file.
 func NewClientFromFile(filename string) (Client, error) {
@@ -348,6 +438,11 @@ func newClient(authJSONSource func() ([]byte, error)) (*gcpClient, error) {
 		return nil, err
 	}
 
+	storageClient, err := storage.NewService(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
 	return &gcpClient{
 		projectName:                creds.ProjectID,
 		creds:                      creds,
@@ -355,6 +450,7 @@ func newClient(authJSONSource func() ([]byte, error)) (*gcpClient, error) {
 		computeClient:              computeClient,
 		serviceUsageClient:         serviceUsageClient,
 		dnsClient:                  dnsClient,
+		storageClient:              storageClient,
 	}, nil
 }
 
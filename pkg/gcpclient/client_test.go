@@ -0,0 +1,62 @@
+package gcpclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openshift/hive/pkg/constants"
+)
+
+const testAuthJSON = `{
+	"type": "service_account",
+	"project_id": "creds-project",
+	"private_key_id": "test-key-id",
+	"private_key": "test-key",
+	"client_email": "test@creds-project.iam.gserviceaccount.com",
+	"client_id": "123456789",
+	"token_uri": "https://oauth2.googleapis.com/token"
+}`
+
+func TestNewClientFromSecretForProject(t *testing.T) {
+	cases := []struct {
+		name            string
+		secretData      map[string][]byte
+		projectID       string
+		expectedProject string
+		expectErr       bool
+	}{
+		{
+			name:            "project of the creds",
+			secretData:      map[string][]byte{constants.GCPCredentialsName: []byte(testAuthJSON)},
+			expectedProject: "creds-project",
+		},
+		{
+			name:            "other project",
+			secretData:      map[string][]byte{constants.GCPCredentialsName: []byte(testAuthJSON)},
+			projectID:       "other-project",
+			expectedProject: "other-project",
+		},
+		{
+			name:       "missing creds",
+			secretData: map[string][]byte{"other": []byte(testAuthJSON)},
+			projectID:  "other-project",
+			expectErr:  true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			secret := &corev1.Secret{Data: tc.secretData}
+			client, err := NewClientFromSecretForProject(secret, tc.projectID)
+			if tc.expectErr {
+				assert.Error(t, err, "expected error creating client")
+				return
+			}
+			require.NoError(t, err, "unexpected error creating client")
+			assert.Equal(t, tc.expectedProject, client.(*gcpClient).projectName, "unexpected project")
+		})
+	}
+}
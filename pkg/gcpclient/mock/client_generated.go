@@ -5,12 +5,14 @@
 package mock
 
 import (
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
 	gcpclient "github.com/openshift/hive/pkg/gcpclient"
 	compute "google.golang.org/api/compute/v1"
 	dns "google.golang.org/api/dns/v1"
+	storage "google.golang.org/api/storage/v1"
 )
 
 // MockClient is a mock of Client interface.
@@ -79,6 +81,20 @@ func (mr *MockClientMockRecorder) DeleteManagedZone(managedZone interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManagedZone", reflect.TypeOf((*MockClient)(nil).DeleteManagedZone), managedZone)
 }
 
+// DeleteObject mocks base method.
+func (m *MockClient) DeleteObject(bucket, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObject", bucket, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObject indicates an expected call of DeleteObject.
+func (mr *MockClientMockRecorder) DeleteObject(bucket, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockClient)(nil).DeleteObject), bucket, name)
+}
+
 // DeleteResourceRecordSet mocks base method.
 func (m *MockClient) DeleteResourceRecordSet(managedZone string, recordSet *dns.ResourceRecordSet) error {
 	m.ctrl.T.Helper()
@@ -122,6 +138,21 @@ func (mr *MockClientMockRecorder) GetManagedZone(managedZone interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagedZone", reflect.TypeOf((*MockClient)(nil).GetManagedZone), managedZone)
 }
 
+// GetObject mocks base method.
+func (m *MockClient) GetObject(bucket, name string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObject", bucket, name)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObject indicates an expected call of GetObject.
+func (mr *MockClientMockRecorder) GetObject(bucket, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockClient)(nil).GetObject), bucket, name)
+}
+
 // ListComputeImages mocks base method.
 func (m *MockClient) ListComputeImages(arg0 gcpclient.ListComputeImagesOptions) (*compute.ImageList, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +197,21 @@ func (mr *MockClientMockRecorder) ListComputeZones(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComputeZones", reflect.TypeOf((*MockClient)(nil).ListComputeZones), arg0)
 }
 
+// ListDNSKeys mocks base method.
+func (m *MockClient) ListDNSKeys(managedZone string) ([]*dns.DnsKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDNSKeys", managedZone)
+	ret0, _ := ret[0].([]*dns.DnsKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDNSKeys indicates an expected call of ListDNSKeys.
+func (mr *MockClientMockRecorder) ListDNSKeys(managedZone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDNSKeys", reflect.TypeOf((*MockClient)(nil).ListDNSKeys), managedZone)
+}
+
 // ListManagedZones mocks base method.
 func (m *MockClient) ListManagedZones(opts gcpclient.ListManagedZonesOptions) (*dns.ManagedZonesListResponse, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +227,21 @@ func (mr *MockClientMockRecorder) ListManagedZones(opts interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagedZones", reflect.TypeOf((*MockClient)(nil).ListManagedZones), opts)
 }
 
+// ListObjects mocks base method.
+func (m *MockClient) ListObjects(bucket, prefix string) ([]*storage.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", bucket, prefix)
+	ret0, _ := ret[0].([]*storage.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockClientMockRecorder) ListObjects(bucket, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockClient)(nil).ListObjects), bucket, prefix)
+}
+
 // ListResourceRecordSets mocks base method.
 func (m *MockClient) ListResourceRecordSets(managedZone string, opts gcpclient.ListResourceRecordSetsOptions) (*dns.ResourceRecordSetsListResponse, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +257,20 @@ func (mr *MockClientMockRecorder) ListResourceRecordSets(managedZone, opts inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceRecordSets", reflect.TypeOf((*MockClient)(nil).ListResourceRecordSets), managedZone, opts)
 }
 
+// PatchManagedZone mocks base method.
+func (m *MockClient) PatchManagedZone(managedZone string, patch *dns.ManagedZone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchManagedZone", managedZone, patch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchManagedZone indicates an expected call of PatchManagedZone.
+func (mr *MockClientMockRecorder) PatchManagedZone(managedZone, patch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchManagedZone", reflect.TypeOf((*MockClient)(nil).PatchManagedZone), managedZone, patch)
+}
+
 // StartInstance mocks base method.
 func (m *MockClient) StartInstance(arg0 *compute.Instance) error {
 	m.ctrl.T.Helper()
@@ -237,3 +312,18 @@ func (mr *MockClientMockRecorder) UpdateResourceRecordSet(managedZone, addRecord
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceRecordSet", reflect.TypeOf((*MockClient)(nil).UpdateResourceRecordSet), managedZone, addRecordSet, removeRecordSet)
 }
+
+// UploadObject mocks base method.
+func (m *MockClient) UploadObject(bucket, name string, body io.Reader) (*storage.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadObject", bucket, name, body)
+	ret0, _ := ret[0].(*storage.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadObject indicates an expected call of UploadObject.
+func (mr *MockClientMockRecorder) UploadObject(bucket, name, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadObject", reflect.TypeOf((*MockClient)(nil).UploadObject), bucket, name, body)
+}
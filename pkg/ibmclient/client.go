@@ -24,11 +24,17 @@ import (
 
 // API represents the calls made to the API.
 type API interface {
+	CreateDNSRecord(ctx context.Context, crnstr string, zoneID string, recordType string, name string, content string, ttl int64) error
+	CreateDNSZone(ctx context.Context, crnstr string, name string) (*zonesv1.ZoneDetails, error)
+	DeleteDNSRecord(ctx context.Context, crnstr string, zoneID string, recordID string) error
+	DeleteDNSZone(ctx context.Context, crnstr string, zoneID string) error
 	GetAuthenticatorAPIKeyDetails(ctx context.Context) (*iamidentityv1.APIKey, error)
 	GetCISInstance(ctx context.Context, crnstr string) (*resourcecontrollerv2.ResourceInstance, error)
 	GetDedicatedHostByName(ctx context.Context, name string, region string) (*vpcv1.DedicatedHost, error)
 	GetDedicatedHostProfiles(ctx context.Context, region string) ([]vpcv1.DedicatedHostProfile, error)
+	GetDNSRecords(ctx context.Context, crnstr string, zoneID string) ([]dnsrecordsv1.DnsrecordDetails, error)
 	GetDNSRecordsByName(ctx context.Context, crnstr string, zoneID string, recordName string) ([]dnsrecordsv1.DnsrecordDetails, error)
+	GetDNSZoneByName(ctx context.Context, crnstr string, name string) (*zonesv1.ZoneDetails, error)
 	GetDNSZoneIDByName(ctx context.Context, name string) (string, error)
 	GetDNSZones(ctx context.Context) ([]DNSZoneResponse, error)
 	GetEncryptionKey(ctx context.Context, keyCRN string) (*EncryptionKeyResponse, error)
@@ -55,6 +61,14 @@ type Client struct {
 // cisServiceID is the Cloud Internet Services' catalog service ID.
 const cisServiceID = "75874a60-cb12-11e7-948e-37ac098eb1b9"
 
+const (
+	// dnsRecordsPageSize is the number of DNS records requested per page.
+	dnsRecordsPageSize = 100
+
+	// dnsZonesPageSize is the number of DNS zones requested per page.
+	dnsZonesPageSize = 50
+)
+
 // VPCResourceNotFoundError represents an error for a VPC resoruce that is not found.
 type VPCResourceNotFoundError struct{}
 
@@ -124,6 +138,68 @@ func (c *Client) loadSDKServices() error {
 	return nil
 }
 
+// CreateDNSRecord creates a DNS record in a specific Cloud Internet Services instance
+// by its CRN and zone ID.
+func (c *Client) CreateDNSRecord(ctx context.Context, crnstr string, zoneID string, recordType string, name string, content string, ttl int64) error {
+	dnsService, err := c.newDNSRecordsService(crnstr, zoneID)
+	if err != nil {
+		return err
+	}
+
+	options := dnsService.NewCreateDnsRecordOptions()
+	options.SetType(recordType)
+	options.SetName(name)
+	options.SetContent(content)
+	options.SetTTL(ttl)
+	if _, _, err := dnsService.CreateDnsRecordWithContext(ctx, options); err != nil {
+		return errors.Wrap(err, "could not create DNS record")
+	}
+	return nil
+}
+
+// CreateDNSZone creates a DNS zone in a specific Cloud Internet Services instance by its CRN.
+func (c *Client) CreateDNSZone(ctx context.Context, crnstr string, name string) (*zonesv1.ZoneDetails, error) {
+	zonesService, err := c.newZonesService(crnstr)
+	if err != nil {
+		return nil, err
+	}
+
+	options := zonesService.NewCreateZoneOptions()
+	options.SetName(name)
+	zone, _, err := zonesService.CreateZoneWithContext(ctx, options)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create DNS zone")
+	}
+	return zone.Result, nil
+}
+
+// DeleteDNSRecord deletes a DNS record in a specific Cloud Internet Services instance
+// by its CRN, zone ID, and DNS record ID.
+func (c *Client) DeleteDNSRecord(ctx context.Context, crnstr string, zoneID string, recordID string) error {
+	dnsService, err := c.newDNSRecordsService(crnstr, zoneID)
+	if err != nil {
+		return err
+	}
+
+	if _, _, err := dnsService.DeleteDnsRecordWithContext(ctx, dnsService.NewDeleteDnsRecordOptions(recordID)); err != nil {
+		return errors.Wrap(err, "could not delete DNS record")
+	}
+	return nil
+}
+
+// DeleteDNSZone deletes a DNS zone in a specific Cloud Internet Services instance by its CRN and zone ID.
+func (c *Client) DeleteDNSZone(ctx context.Context, crnstr string, zoneID string) error {
+	zonesService, err := c.newZonesService(crnstr)
+	if err != nil {
+		return err
+	}
+
+	if _, _, err := zonesService.DeleteZoneWithContext(ctx, zonesService.NewDeleteZoneOptions(zoneID)); err != nil {
+		return errors.Wrap(err, "could not delete DNS zone")
+	}
+	return nil
+}
+
 // GetAuthenticatorAPIKeyDetails gets detailed information on the API key used
 // for authentication to the IBM Cloud APIs
 func (c *Client) GetAuthenticatorAPIKeyDetails(ctx context.Context) (*iamidentityv1.APIKey, error) {
@@ -199,6 +275,30 @@ func (c *Client) GetDedicatedHostProfiles(ctx context.Context, region string) ([
 	return profiles.Profiles, nil
 }
 
+// GetDNSRecords gets all of the DNS records in specific Cloud Internet Services instance
+// by its CRN and zone ID.
+func (c *Client) GetDNSRecords(ctx context.Context, crnstr string, zoneID string) ([]dnsrecordsv1.DnsrecordDetails, error) {
+	dnsService, err := c.newDNSRecordsService(crnstr, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	var allRecords []dnsrecordsv1.DnsrecordDetails
+	options := dnsService.NewListAllDnsRecordsOptions()
+	options.SetPerPage(dnsRecordsPageSize)
+	for page := int64(1); ; page++ {
+		options.SetPage(page)
+		records, _, err := dnsService.ListAllDnsRecordsWithContext(ctx, options)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve DNS records")
+		}
+		allRecords = append(allRecords, records.Result...)
+		if records.ResultInfo == nil || records.ResultInfo.TotalCount == nil || int64(len(allRecords)) >= *records.ResultInfo.TotalCount || len(records.Result) == 0 {
+			return allRecords, nil
+		}
+	}
+}
+
 // GetDNSRecordsByName gets DNS records in specific Cloud Internet Services instance
 // by its CRN, zone ID, and DNS record name.
 func (c *Client) GetDNSRecordsByName(ctx context.Context, crnstr string, zoneID string, recordName string) ([]dnsrecordsv1.DnsrecordDetails, error) {
@@ -244,6 +344,34 @@ func (c *Client) GetDNSZoneIDByName(ctx context.Context, name string) (string, e
 	return "", fmt.Errorf("DNS zone %q not found", name)
 }
 
+// GetDNSZoneByName gets a DNS zone in a specific Cloud Internet Services instance by its CRN and domain name,
+// whether or not the zone is active. Returns nil if there is no such zone.
+func (c *Client) GetDNSZoneByName(ctx context.Context, crnstr string, name string) (*zonesv1.ZoneDetails, error) {
+	zonesService, err := c.newZonesService(crnstr)
+	if err != nil {
+		return nil, err
+	}
+
+	options := zonesService.NewListZonesOptions()
+	options.SetPerPage(dnsZonesPageSize)
+	for page := int64(1); ; page++ {
+		options.SetPage(page)
+		zones, _, err := zonesService.ListZonesWithContext(ctx, options)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not list DNS zones")
+		}
+		for idx, zone := range zones.Result {
+			if zone.Name != nil && *zone.Name == name {
+				return &zones.Result[idx], nil
+			}
+		}
+		if zones.ResultInfo == nil || zones.ResultInfo.Page == nil || zones.ResultInfo.TotalCount == nil ||
+			*zones.ResultInfo.Page*dnsZonesPageSize >= *zones.ResultInfo.TotalCount || len(zones.Result) == 0 {
+			return nil, nil
+		}
+	}
+}
+
 // GetDNSZones returns all of the active DNS zones managed by CIS.
 func (c *Client) GetDNSZones(ctx context.Context) ([]DNSZoneResponse, error) {
 	_, cancel := context.WithTimeout(ctx, 1*time.Minute)
@@ -417,6 +545,29 @@ func (c *Client) getVPCRegions(ctx context.Context) ([]vpcv1.Region, error) {
 	return listRegionsResponse.Regions, nil
 }
 
+func (c *Client) newDNSRecordsService(crnstr string, zoneID string) (*dnsrecordsv1.DnsRecordsV1, error) {
+	authenticator, err := NewIamAuthenticator(c.APIKey)
+	if err != nil {
+		return nil, err
+	}
+	return dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+		Authenticator:  authenticator,
+		Crn:            core.StringPtr(crnstr),
+		ZoneIdentifier: core.StringPtr(zoneID),
+	})
+}
+
+func (c *Client) newZonesService(crnstr string) (*zonesv1.ZonesV1, error) {
+	authenticator, err := NewIamAuthenticator(c.APIKey)
+	if err != nil {
+		return nil, err
+	}
+	return zonesv1.NewZonesV1(&zonesv1.ZonesV1Options{
+		Authenticator: authenticator,
+		Crn:           core.StringPtr(crnstr),
+	})
+}
+
 func (c *Client) loadResourceManagementAPI() error {
 	authenticator, err := NewIamAuthenticator(c.APIKey)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	dnsrecordsv1 "github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	zonesv1 "github.com/IBM/networking-go-sdk/zonesv1"
 	iamidentityv1 "github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	resourcecontrollerv2 "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	resourcemanagerv2 "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
@@ -40,6 +41,63 @@ func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
 	return m.recorder
 }
 
+// CreateDNSRecord mocks base method.
+func (m *MockAPI) CreateDNSRecord(ctx context.Context, crnstr, zoneID, recordType, name, content string, ttl int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDNSRecord", ctx, crnstr, zoneID, recordType, name, content, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDNSRecord indicates an expected call of CreateDNSRecord.
+func (mr *MockAPIMockRecorder) CreateDNSRecord(ctx, crnstr, zoneID, recordType, name, content, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDNSRecord", reflect.TypeOf((*MockAPI)(nil).CreateDNSRecord), ctx, crnstr, zoneID, recordType, name, content, ttl)
+}
+
+// CreateDNSZone mocks base method.
+func (m *MockAPI) CreateDNSZone(ctx context.Context, crnstr, name string) (*zonesv1.ZoneDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDNSZone", ctx, crnstr, name)
+	ret0, _ := ret[0].(*zonesv1.ZoneDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDNSZone indicates an expected call of CreateDNSZone.
+func (mr *MockAPIMockRecorder) CreateDNSZone(ctx, crnstr, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDNSZone", reflect.TypeOf((*MockAPI)(nil).CreateDNSZone), ctx, crnstr, name)
+}
+
+// DeleteDNSRecord mocks base method.
+func (m *MockAPI) DeleteDNSRecord(ctx context.Context, crnstr, zoneID, recordID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDNSRecord", ctx, crnstr, zoneID, recordID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDNSRecord indicates an expected call of DeleteDNSRecord.
+func (mr *MockAPIMockRecorder) DeleteDNSRecord(ctx, crnstr, zoneID, recordID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDNSRecord", reflect.TypeOf((*MockAPI)(nil).DeleteDNSRecord), ctx, crnstr, zoneID, recordID)
+}
+
+// DeleteDNSZone mocks base method.
+func (m *MockAPI) DeleteDNSZone(ctx context.Context, crnstr, zoneID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDNSZone", ctx, crnstr, zoneID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDNSZone indicates an expected call of DeleteDNSZone.
+func (mr *MockAPIMockRecorder) DeleteDNSZone(ctx, crnstr, zoneID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDNSZone", reflect.TypeOf((*MockAPI)(nil).DeleteDNSZone), ctx, crnstr, zoneID)
+}
+
 // GetAuthenticatorAPIKeyDetails mocks base method.
 func (m *MockAPI) GetAuthenticatorAPIKeyDetails(ctx context.Context) (*iamidentityv1.APIKey, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +128,21 @@ func (mr *MockAPIMockRecorder) GetCISInstance(ctx, crnstr interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCISInstance", reflect.TypeOf((*MockAPI)(nil).GetCISInstance), ctx, crnstr)
 }
 
+// GetDNSRecords mocks base method.
+func (m *MockAPI) GetDNSRecords(ctx context.Context, crnstr, zoneID string) ([]dnsrecordsv1.DnsrecordDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDNSRecords", ctx, crnstr, zoneID)
+	ret0, _ := ret[0].([]dnsrecordsv1.DnsrecordDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDNSRecords indicates an expected call of GetDNSRecords.
+func (mr *MockAPIMockRecorder) GetDNSRecords(ctx, crnstr, zoneID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDNSRecords", reflect.TypeOf((*MockAPI)(nil).GetDNSRecords), ctx, crnstr, zoneID)
+}
+
 // GetDNSRecordsByName mocks base method.
 func (m *MockAPI) GetDNSRecordsByName(ctx context.Context, crnstr, zoneID, recordName string) ([]dnsrecordsv1.DnsrecordDetails, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +158,21 @@ func (mr *MockAPIMockRecorder) GetDNSRecordsByName(ctx, crnstr, zoneID, recordNa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDNSRecordsByName", reflect.TypeOf((*MockAPI)(nil).GetDNSRecordsByName), ctx, crnstr, zoneID, recordName)
 }
 
+// GetDNSZoneByName mocks base method.
+func (m *MockAPI) GetDNSZoneByName(ctx context.Context, crnstr, name string) (*zonesv1.ZoneDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDNSZoneByName", ctx, crnstr, name)
+	ret0, _ := ret[0].(*zonesv1.ZoneDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDNSZoneByName indicates an expected call of GetDNSZoneByName.
+func (mr *MockAPIMockRecorder) GetDNSZoneByName(ctx, crnstr, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDNSZoneByName", reflect.TypeOf((*MockAPI)(nil).GetDNSZoneByName), ctx, crnstr, name)
+}
+
 // GetDNSZoneIDByName mocks base method.
 func (m *MockAPI) GetDNSZoneIDByName(ctx context.Context, name string) (string, error) {
 	m.ctrl.T.Helper()
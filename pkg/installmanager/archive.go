@@ -0,0 +1,317 @@
+package installmanager
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/constants"
+)
+
+const (
+	// archiveRelativeDir is the directory, relative to our WorkDir, in which the files to archive are staged.
+	archiveRelativeDir = ".hive-archive"
+
+	archivedInstallConfigFile = "install-config.yaml"
+	archivedManifestsFile     = "manifests.tar.gz"
+	archivedInstallLogFile    = "openshift_install.log"
+
+	redactedValue = "REDACTED"
+)
+
+// archiveEnabled returns true if the files of every provision attempt are to be archived.
+func archiveEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(constants.InstallLogsArchiveEnvVar))
+	return enabled
+}
+
+func (m *InstallManager) archiveDir() string {
+	return filepath.Join(m.WorkDir, archiveRelativeDir)
+}
+
+// stageArchivedInstallConfig writes the install config, with its secrets redacted, to the archive directory.
+func (m *InstallManager) stageArchivedInstallConfig(icData []byte) error {
+	redacted, err := redactInstallConfig(icData)
+	if err != nil {
+		return errors.Wrap(err, "error redacting install config")
+	}
+	if err := os.MkdirAll(m.archiveDir(), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(m.archiveDir(), archivedInstallConfigFile), redacted, 0600)
+}
+
+// stageArchivedManifests writes the manifests generated by the installer, along with any user-provided manifests, to
+// a compressed tarball in the archive directory. Secrets are left out.
+func (m *InstallManager) stageArchivedManifests() error {
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+
+	for _, dir := range []string{"manifests", "openshift"} {
+		root := filepath.Join(m.WorkDir, dir)
+		if _, err := os.Stat(root); os.IsNotExist(err) {
+			continue
+		}
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if !isArchivableManifest(content) {
+				m.log.WithField("manifest", path).Debug("not archiving manifest")
+				return nil
+			}
+			name, err := filepath.Rel(m.WorkDir, path)
+			if err != nil {
+				return err
+			}
+			if err := tw.WriteHeader(&tar.Header{
+				Name:    name,
+				Mode:    0600,
+				Size:    int64(len(content)),
+				ModTime: time.Now(),
+			}); err != nil {
+				return err
+			}
+			_, err = tw.Write(content)
+			return err
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error archiving %s", dir)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gzw.Close(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(m.archiveDir(), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(m.archiveDir(), archivedManifestsFile), buf.Bytes(), 0600)
+}
+
+// archiveAttempt uploads the installer log, along with the install config and manifests staged earlier, to the
+// archive folder of this provision attempt. Failures are logged but not fatal, as archiving is a best effort. Archived
+// files are never deleted by Hive; their retention is left to lifecycle rules of the object store.
+func (m *InstallManager) archiveAttempt(scrubInstallLog bool) {
+	if m.actuator == nil {
+		m.log.Debug("Unable to find log storage actuator. Disabling archiving of provision attempt.")
+		return
+	}
+
+	if fullLog, err := os.ReadFile(filepath.Join(m.WorkDir, installerFullLogFile)); err == nil {
+		logData := string(fullLog)
+		if scrubInstallLog {
+			logData = cleanupLogOutput(logData)
+		}
+		if err := os.MkdirAll(m.archiveDir(), 0700); err != nil {
+			m.log.WithError(err).Warn("error creating archive directory")
+			return
+		}
+		if err := os.WriteFile(filepath.Join(m.archiveDir(), archivedInstallLogFile), []byte(logData), 0600); err != nil {
+			m.log.WithError(err).Warn("error staging installer log for archiving")
+		}
+	} else {
+		m.log.WithError(err).Warn("error reading installer log for archiving")
+	}
+
+	files, err := os.ReadDir(m.archiveDir())
+	if err != nil {
+		m.log.WithError(err).Warn("error reading archive directory")
+		return
+	}
+	filepaths := []string{}
+	for _, file := range files {
+		if !file.IsDir() {
+			filepaths = append(filepaths, filepath.Join(m.archiveDir(), file.Name()))
+		}
+	}
+
+	folder := ArchiveFolder(m.ClusterName, m.Namespace, m.ClusterProvision.Spec.Attempt)
+	urls, err := m.actuator.ArchiveLogs(folder, m.ClusterProvision, m.DynamicClient, m.log, filepaths...)
+	if err != nil {
+		m.log.WithError(err).Error("error archiving provision attempt")
+	}
+	m.recordLogURLs(urls)
+}
+
+// recordLogURLs adds the URLs of uploaded logs to the status of the ClusterProvision.
+func (m *InstallManager) recordLogURLs(urls []string) {
+	if len(urls) == 0 {
+		return
+	}
+	if err := m.updateClusterProvisionStatus(
+		m,
+		func(provision *hivev1.ClusterProvision) {
+			for _, url := range urls {
+				if !contains(provision.Status.LogURLs, url) {
+					provision.Status.LogURLs = append(provision.Status.LogURLs, url)
+				}
+			}
+		},
+	); err != nil {
+		m.log.WithError(err).Warning("error updating cluster provision with uploaded log URLs")
+	}
+}
+
+// redactedInstallConfigFields are the paths of the install config fields that hold credentials. "*" matches every
+// element of a list.
+var redactedInstallConfigFields = [][]string{
+	{"pullSecret"},
+	{"platform", "vsphere", "password"},
+	{"platform", "vsphere", "vcenters", "*", "password"},
+	{"platform", "baremetal", "hosts", "*", "bmc", "username"},
+	{"platform", "baremetal", "hosts", "*", "bmc", "password"},
+	{"platform", "nutanix", "prismCentral", "password"},
+}
+
+// installConfigURLFields are the paths of the install config fields that hold URLs, which may embed credentials as
+// userinfo.
+var installConfigURLFields = [][]string{
+	{"proxy", "httpProxy"},
+	{"proxy", "httpsProxy"},
+	{"platform", "baremetal", "hosts", "*", "bmc", "address"},
+}
+
+// redactInstallConfig replaces the values of the install config fields that hold credentials, strips the userinfo
+// from the URLs in the install config, and replaces the values of any other field whose name contains "password".
+func redactInstallConfig(icData []byte) ([]byte, error) {
+	ic := map[string]interface{}{}
+	if err := yaml.Unmarshal(icData, &ic); err != nil {
+		return nil, err
+	}
+	for _, path := range redactedInstallConfigFields {
+		redactField(ic, path, func(interface{}) interface{} { return redactedValue })
+	}
+	for _, path := range installConfigURLFields {
+		redactField(ic, path, stripUserinfo)
+	}
+	redactValues(ic)
+	return yaml.Marshal(ic)
+}
+
+// redactField replaces the values of the field at the path in the object with the result of redact.
+func redactField(obj interface{}, path []string, redact func(interface{}) interface{}) {
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		v, ok := o[path[0]]
+		if !ok {
+			return
+		}
+		if len(path) == 1 {
+			o[path[0]] = redact(v)
+			return
+		}
+		redactField(v, path[1:], redact)
+	case []interface{}:
+		if path[0] != "*" {
+			return
+		}
+		for i, v := range o {
+			if len(path) == 1 {
+				o[i] = redact(v)
+				continue
+			}
+			redactField(v, path[1:], redact)
+		}
+	}
+}
+
+// stripUserinfo removes the userinfo from a URL. Values that cannot be parsed as URLs without credentials are
+// redacted altogether.
+func stripUserinfo(v interface{}) interface{} {
+	s, ok := v.(string)
+	if !ok {
+		return redactedValue
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return redactedValue
+	}
+	u.User = nil
+	if stripped := u.String(); !strings.Contains(stripped, "@") {
+		return stripped
+	}
+	return redactedValue
+}
+
+func redactValues(obj interface{}) {
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		for k, v := range o {
+			if strings.Contains(strings.ToLower(k), "password") {
+				o[k] = redactedValue
+				continue
+			}
+			redactValues(v)
+		}
+	case []interface{}:
+		for _, v := range o {
+			redactValues(v)
+		}
+	}
+}
+
+// isArchivableManifest returns false for manifests that contain Secrets, including in the items of lists, or that
+// cannot be parsed to tell.
+func isArchivableManifest(content []byte) bool {
+	for _, doc := range strings.Split(string(content), "\n---") {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		if !isArchivableObject([]byte(doc)) {
+			return false
+		}
+	}
+	return true
+}
+
+// isArchivableObject returns false for Secrets, and for lists, such as a List or SecretList, whose items may be
+// Secrets. The items of a typed list like SecretList usually leave out their kind, which is implied by the kind of
+// the list.
+func isArchivableObject(data []byte) bool {
+	obj := struct {
+		Kind  string            `json:"kind"`
+		Items []json.RawMessage `json:"items"`
+	}{}
+	if err := yaml.Unmarshal(data, &obj); err != nil || obj.Kind == "Secret" || obj.Kind == "SecretList" {
+		return false
+	}
+	for _, item := range obj.Items {
+		if !isArchivableObject(item) {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
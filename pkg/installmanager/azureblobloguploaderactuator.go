@@ -0,0 +1,157 @@
+package installmanager
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/azureclient"
+	"github.com/openshift/hive/pkg/constants"
+
+	"github.com/pkg/errors"
+)
+
+// Ensure azureBlobLogUploaderActuator implements the Actuator interface. This will fail at compile time when false.
+var _ LogUploaderActuator = &azureBlobLogUploaderActuator{}
+
+// azureBlobLogUploaderActuator uploads logs to an Azure Blob Storage container.
+type azureBlobLogUploaderActuator struct {
+	// azureClientFn is the function to build an Azure client, here for lazy loading the client.
+	azureClientFn func(c client.Client, secretName, namespace, cloudName string, logger log.FieldLogger) (azureclient.Client, error)
+}
+
+// azureBlobLocation is the container of a storage account to which logs are uploaded.
+type azureBlobLocation struct {
+	storageAccount string
+	container      string
+}
+
+// IsConfigured returns true if the actuator can handle a particular ClusterDeprovision
+func (a *azureBlobLogUploaderActuator) IsConfigured() bool {
+	provider, foundProviderEnvVar := os.LookupEnv(constants.InstallLogsUploadProviderEnvVar)
+	if !foundProviderEnvVar {
+		log.Debug("Couldn't find install logs provider environment variable. Skipping.")
+		return false
+	}
+
+	return provider == constants.InstallLogsUploadProviderAzure
+}
+
+// UploadLogs uploads installer logs to the provider's storage mechanism.
+func (a *azureBlobLogUploaderActuator) UploadLogs(clusterName string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger, filenames ...string) ([]string, error) {
+	azurec, loc, err := a.loadClient(clusterprovision, c, log)
+	if err != nil {
+		return nil, err
+	}
+
+	folder := logFolder(clusterName, clusterprovision)
+
+	log.Infof("Uploading log(s) to Azure Blob Storage: account %v, container %v, folder %v/", loc.storageAccount, loc.container, folder)
+
+	return uploadFiles(a.upload(azurec, loc), func(stat os.FileInfo) string {
+		return logKey(folder, clusterprovision, stat)
+	}, filenames...)
+}
+
+// ArchiveLogs uploads the files into the folder of the provider's storage mechanism.
+func (a *azureBlobLogUploaderActuator) ArchiveLogs(folder string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger, filenames ...string) ([]string, error) {
+	azurec, loc, err := a.loadClient(clusterprovision, c, log)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Archiving file(s) to Azure Blob Storage: account %v, container %v, folder %v/", loc.storageAccount, loc.container, folder)
+
+	return uploadFiles(a.upload(azurec, loc), func(stat os.FileInfo) string {
+		return folder + "/" + stat.Name()
+	}, filenames...)
+}
+
+// SaveInstallState uploads the install state to the key of the provider's storage mechanism.
+func (a *azureBlobLogUploaderActuator) SaveInstallState(key string, state []byte, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger) error {
+	azurec, loc, err := a.loadClient(clusterprovision, c, log)
+	if err != nil {
+		return err
+	}
+	_, err = azurec.UploadBlob(context.TODO(), loc.storageAccount, loc.container, key, bytes.NewReader(state), int64(len(state)))
+	return err
+}
+
+// LoadInstallState downloads the install state saved to the key of the provider's storage mechanism.
+func (a *azureBlobLogUploaderActuator) LoadInstallState(key string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger) ([]byte, error) {
+	azurec, loc, err := a.loadClient(clusterprovision, c, log)
+	if err != nil {
+		return nil, err
+	}
+	body, err := azurec.GetBlob(context.TODO(), loc.storageAccount, loc.container, key)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
+
+// DeleteInstallState deletes the install state saved to the key of the provider's storage mechanism.
+func (a *azureBlobLogUploaderActuator) DeleteInstallState(key string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger) error {
+	azurec, loc, err := a.loadClient(clusterprovision, c, log)
+	if err != nil {
+		return err
+	}
+	return azurec.DeleteBlob(context.TODO(), loc.storageAccount, loc.container, key)
+}
+
+// loadClient builds the Azure client from the environment, returning it along with the container to use.
+func (a *azureBlobLogUploaderActuator) loadClient(clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger) (azureclient.Client, azureBlobLocation, error) {
+	loc := azureBlobLocation{}
+	secretName, foundSecretName := os.LookupEnv(constants.InstallLogsCredentialsSecretRefEnvVar)
+	if !foundSecretName {
+		return nil, loc, errors.New("couldn't find secret name in environment variable. Skipping upload")
+	}
+
+	storageAccount, foundStorageAccountEnvVar := os.LookupEnv(constants.InstallLogsAzureStorageAccountEnvVar)
+	if !foundStorageAccountEnvVar {
+		return nil, loc, errors.New("couldn't find storage account in environment variable. Skipping upload")
+	}
+
+	container, foundContainerEnvVar := os.LookupEnv(constants.InstallLogsAzureContainerEnvVar)
+	if !foundContainerEnvVar {
+		return nil, loc, errors.New("couldn't find container in environment variable. Skipping upload")
+	}
+
+	// An empty cloud name means the Azure public cloud.
+	cloudName := os.Getenv(constants.InstallLogsAzureCloudNameEnvVar)
+
+	azurec, err := a.azureClientFn(c, secretName, clusterprovision.Namespace, cloudName, log)
+	if err != nil {
+		return nil, loc, err
+	}
+	loc.storageAccount = storageAccount
+	loc.container = container
+	return azurec, loc, nil
+}
+
+func (a *azureBlobLogUploaderActuator) upload(azurec azureclient.Client, loc azureBlobLocation) func(string, *os.File, int64) (string, error) {
+	return func(key string, file *os.File, size int64) (string, error) {
+		return azurec.UploadBlob(context.TODO(), loc.storageAccount, loc.container, key, file, size)
+	}
+}
+
+func getAzureClient(c client.Client, secretName, namespace, cloudName string, logger log.FieldLogger) (azureclient.Client, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Name: secretName, Namespace: namespace}, secret); err != nil {
+		logger.WithError(err).Error("failed to get Azure credentials secret")
+		return nil, err
+	}
+	azureClient, err := azureclient.NewClientFromSecret(secret, cloudName)
+	if err != nil {
+		logger.WithError(err).Error("failed to get Azure client")
+	}
+	return azureClient, err
+}
@@ -0,0 +1,98 @@
+package installmanager
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openshift/hive/pkg/azureclient"
+	"github.com/openshift/hive/pkg/constants"
+)
+
+func TestAzureBlobUploadLogs(t *testing.T) {
+	const blobURL = "https://account1.blob.core.windows.net/container1/notarealcluster-test-namespace/test-provision-issue"
+	tests := []struct {
+		name                    string
+		existing                []runtime.Object
+		uploadError             error
+		setupUploadMock         bool
+		setupEnvVars            bool
+		expectedUploadLogsError bool
+		expectedURLs            []string
+	}{
+		{
+			name:                    "missing env vars",
+			existing:                []runtime.Object{},
+			expectedUploadLogsError: true,
+		},
+		{
+			name:            "successfully upload blobs",
+			existing:        []runtime.Object{},
+			setupUploadMock: true,
+			setupEnvVars:    true,
+			expectedURLs:    []string{blobURL},
+		},
+		{
+			name:                    "failed upload",
+			existing:                []runtime.Object{},
+			uploadError:             errors.New("upload failed"),
+			setupUploadMock:         true,
+			setupEnvVars:            true,
+			expectedUploadLogsError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			mocks := setupDefaultMocks(t, test.existing...)
+
+			if test.setupEnvVars {
+				os.Setenv(constants.InstallLogsUploadProviderEnvVar, constants.InstallLogsUploadProviderAzure)
+				os.Setenv(constants.InstallLogsCredentialsSecretRefEnvVar, "notarealsecret")
+				os.Setenv(constants.InstallLogsAzureStorageAccountEnvVar, "account1")
+				os.Setenv(constants.InstallLogsAzureContainerEnvVar, "container1")
+				os.Setenv(constants.InstallLogsAzureCloudNameEnvVar, "AzurePublicCloud")
+			}
+			if test.setupUploadMock {
+				url := ""
+				if test.uploadError == nil {
+					url = blobURL
+				}
+				mocks.mockAzureClient.EXPECT().
+					UploadBlob(gomock.Any(), "account1", "container1", "notarealcluster-test-namespace/test-provision-issue", gomock.Any(), gomock.Any()).
+					Return(url, test.uploadError)
+			}
+
+			actuator := &azureBlobLogUploaderActuator{azureClientFn: func(_ client.Client, _, _, cloudName string, _ log.FieldLogger) (azureclient.Client, error) {
+				assert.Equal(t, "AzurePublicCloud", cloudName, "unexpected cloud name")
+				return mocks.mockAzureClient, nil
+			}}
+			provision := testClusterProvision()
+
+			// Act
+			urls, err := actuator.UploadLogs("notarealcluster", provision, mocks.fakeKubeClient, log.New(), "/etc/issue")
+
+			// Assert
+			if test.expectedUploadLogsError {
+				assert.Error(t, err, "Function didn't error as expected")
+			} else {
+				assert.NoError(t, err, "Function errored unexpectedly")
+			}
+			assert.Equal(t, test.expectedURLs, nonNilURLs(urls), "unexpected log URLs")
+
+			if test.setupEnvVars {
+				os.Unsetenv(constants.InstallLogsUploadProviderEnvVar)
+				os.Unsetenv(constants.InstallLogsCredentialsSecretRefEnvVar)
+				os.Unsetenv(constants.InstallLogsAzureStorageAccountEnvVar)
+				os.Unsetenv(constants.InstallLogsAzureContainerEnvVar)
+				os.Unsetenv(constants.InstallLogsAzureCloudNameEnvVar)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package installmanager
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/constants"
+	"github.com/openshift/hive/pkg/gcpclient"
+
+	"github.com/pkg/errors"
+)
+
+// Ensure gcsLogUploaderActuator implements the Actuator interface. This will fail at compile time when false.
+var _ LogUploaderActuator = &gcsLogUploaderActuator{}
+
+// gcsLogUploaderActuator uploads logs to a Google Cloud Storage bucket.
+type gcsLogUploaderActuator struct {
+	// gcpClientFn is the function to build a GCP client, here for lazy loading the client.
+	gcpClientFn func(c client.Client, secretName, namespace string, logger log.FieldLogger) (gcpclient.Client, error)
+}
+
+// IsConfigured returns true if the actuator can handle a particular ClusterDeprovision
+func (a *gcsLogUploaderActuator) IsConfigured() bool {
+	provider, foundProviderEnvVar := os.LookupEnv(constants.InstallLogsUploadProviderEnvVar)
+	if !foundProviderEnvVar {
+		log.Debug("Couldn't find install logs provider environment variable. Skipping.")
+		return false
+	}
+
+	return provider == constants.InstallLogsUploadProviderGCP
+}
+
+// UploadLogs uploads installer logs to the provider's storage mechanism.
+func (a *gcsLogUploaderActuator) UploadLogs(clusterName string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger, filenames ...string) ([]string, error) {
+	gcpc, bucket, err := a.loadClient(clusterprovision, c, log)
+	if err != nil {
+		return nil, err
+	}
+
+	folder := logFolder(clusterName, clusterprovision)
+
+	log.Infof("Uploading log(s) to GCS: gs://%v/%v/", bucket, folder)
+
+	return uploadFiles(a.upload(gcpc, bucket), func(stat os.FileInfo) string {
+		return logKey(folder, clusterprovision, stat)
+	}, filenames...)
+}
+
+// ArchiveLogs uploads the files into the folder of the provider's storage mechanism.
+func (a *gcsLogUploaderActuator) ArchiveLogs(folder string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger, filenames ...string) ([]string, error) {
+	gcpc, bucket, err := a.loadClient(clusterprovision, c, log)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Archiving file(s) to GCS: gs://%v/%v/", bucket, folder)
+
+	return uploadFiles(a.upload(gcpc, bucket), func(stat os.FileInfo) string {
+		return folder + "/" + stat.Name()
+	}, filenames...)
+}
+
+// SaveInstallState uploads the install state to the key of the provider's storage mechanism.
+func (a *gcsLogUploaderActuator) SaveInstallState(key string, state []byte, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger) error {
+	gcpc, bucket, err := a.loadClient(clusterprovision, c, log)
+	if err != nil {
+		return err
+	}
+	_, err = gcpc.UploadObject(bucket, key, bytes.NewReader(state))
+	return err
+}
+
+// LoadInstallState downloads the install state saved to the key of the provider's storage mechanism.
+func (a *gcsLogUploaderActuator) LoadInstallState(key string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger) ([]byte, error) {
+	gcpc, bucket, err := a.loadClient(clusterprovision, c, log)
+	if err != nil {
+		return nil, err
+	}
+	body, err := gcpc.GetObject(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
+
+// DeleteInstallState deletes the install state saved to the key of the provider's storage mechanism.
+func (a *gcsLogUploaderActuator) DeleteInstallState(key string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger) error {
+	gcpc, bucket, err := a.loadClient(clusterprovision, c, log)
+	if err != nil {
+		return err
+	}
+	return gcpc.DeleteObject(bucket, key)
+}
+
+// loadClient builds the GCP client from the environment, returning it along with the bucket to use.
+func (a *gcsLogUploaderActuator) loadClient(clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger) (gcpclient.Client, string, error) {
+	secretName, foundSecretName := os.LookupEnv(constants.InstallLogsCredentialsSecretRefEnvVar)
+	if !foundSecretName {
+		return nil, "", errors.New("couldn't find secret name in environment variable. Skipping upload")
+	}
+
+	bucket, foundBucketEnvVar := os.LookupEnv(constants.InstallLogsGCPBucketEnvVar)
+	if !foundBucketEnvVar {
+		return nil, "", errors.New("couldn't find bucket in environment variable. Skipping upload")
+	}
+
+	gcpc, err := a.gcpClientFn(c, secretName, clusterprovision.Namespace, log)
+	if err != nil {
+		return nil, "", err
+	}
+	return gcpc, bucket, nil
+}
+
+func (a *gcsLogUploaderActuator) upload(gcpc gcpclient.Client, bucket string) func(string, *os.File, int64) (string, error) {
+	return func(key string, file *os.File, _ int64) (string, error) {
+		if _, err := gcpc.UploadObject(bucket, key, file); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("gs://%v/%v", bucket, key), nil
+	}
+}
+
+func getGCPClient(c client.Client, secretName, namespace string, logger log.FieldLogger) (gcpclient.Client, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Name: secretName, Namespace: namespace}, secret); err != nil {
+		logger.WithError(err).Error("failed to get GCP credentials secret")
+		return nil, err
+	}
+	gcpClient, err := gcpclient.NewClientFromSecret(secret)
+	if err != nil {
+		logger.WithError(err).Error("failed to get GCP client")
+	}
+	return gcpClient, err
+}
@@ -0,0 +1,89 @@
+package installmanager
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	storage "google.golang.org/api/storage/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openshift/hive/pkg/constants"
+	"github.com/openshift/hive/pkg/gcpclient"
+)
+
+func TestGCSUploadLogs(t *testing.T) {
+	tests := []struct {
+		name                    string
+		existing                []runtime.Object
+		uploadError             error
+		setupUploadMock         bool
+		setupEnvVars            bool
+		expectedUploadLogsError bool
+		expectedURLs            []string
+	}{
+		{
+			name:                    "missing env vars",
+			existing:                []runtime.Object{},
+			expectedUploadLogsError: true,
+		},
+		{
+			name:            "successfully upload objects",
+			existing:        []runtime.Object{},
+			setupUploadMock: true,
+			setupEnvVars:    true,
+			expectedURLs:    []string{"gs://bucket1/notarealcluster-test-namespace/test-provision-issue"},
+		},
+		{
+			name:                    "failed upload",
+			existing:                []runtime.Object{},
+			uploadError:             errors.New("upload failed"),
+			setupUploadMock:         true,
+			setupEnvVars:            true,
+			expectedUploadLogsError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Arrange
+			mocks := setupDefaultMocks(t, test.existing...)
+
+			if test.setupEnvVars {
+				os.Setenv(constants.InstallLogsUploadProviderEnvVar, constants.InstallLogsUploadProviderGCP)
+				os.Setenv(constants.InstallLogsCredentialsSecretRefEnvVar, "notarealsecret")
+				os.Setenv(constants.InstallLogsGCPBucketEnvVar, "bucket1")
+			}
+			if test.setupUploadMock {
+				mocks.mockGCPClient.EXPECT().
+					UploadObject("bucket1", "notarealcluster-test-namespace/test-provision-issue", gomock.Any()).
+					Return(&storage.Object{}, test.uploadError)
+			}
+
+			actuator := &gcsLogUploaderActuator{gcpClientFn: func(client.Client, string, string, log.FieldLogger) (gcpclient.Client, error) {
+				return mocks.mockGCPClient, nil
+			}}
+			provision := testClusterProvision()
+
+			// Act
+			urls, err := actuator.UploadLogs("notarealcluster", provision, mocks.fakeKubeClient, log.New(), "/etc/issue")
+
+			// Assert
+			if test.expectedUploadLogsError {
+				assert.Error(t, err, "Function didn't error as expected")
+			} else {
+				assert.NoError(t, err, "Function errored unexpectedly")
+			}
+			assert.Equal(t, test.expectedURLs, nonNilURLs(urls), "unexpected log URLs")
+
+			if test.setupEnvVars {
+				os.Unsetenv(constants.InstallLogsUploadProviderEnvVar)
+				os.Unsetenv(constants.InstallLogsCredentialsSecretRefEnvVar)
+				os.Unsetenv(constants.InstallLogsGCPBucketEnvVar)
+			}
+		})
+	}
+}
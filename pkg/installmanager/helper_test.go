@@ -5,6 +5,8 @@ import (
 
 	"github.com/golang/mock/gomock"
 	mockaws "github.com/openshift/hive/pkg/awsclient/mock"
+	mockazure "github.com/openshift/hive/pkg/azureclient/mock"
+	mockgcp "github.com/openshift/hive/pkg/gcpclient/mock"
 	testfake "github.com/openshift/hive/pkg/test/fake"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -16,9 +18,11 @@ import (
 )
 
 type mocks struct {
-	fakeKubeClient client.Client
-	mockCtrl       *gomock.Controller
-	mockAWSClient  *mockaws.MockClient
+	fakeKubeClient  client.Client
+	mockCtrl        *gomock.Controller
+	mockAWSClient   *mockaws.MockClient
+	mockGCPClient   *mockgcp.MockClient
+	mockAzureClient *mockazure.MockClient
 }
 
 // setupDefaultMocks is an easy way to setup all of the default mocks
@@ -29,6 +33,8 @@ func setupDefaultMocks(t *testing.T, initObjs ...runtime.Object) *mocks {
 	}
 
 	mocks.mockAWSClient = mockaws.NewMockClient(mocks.mockCtrl)
+	mocks.mockGCPClient = mockgcp.NewMockClient(mocks.mockCtrl)
+	mocks.mockAzureClient = mockazure.NewMockClient(mocks.mockCtrl)
 
 	return mocks
 }
@@ -129,16 +129,21 @@ type InstallManager struct {
 	loadSecrets                      func(*InstallManager, *hivev1.ClusterDeployment)
 	cleanupFailedProvision           func(dynamicClient client.Client, cd *hivev1.ClusterDeployment, infraID string, logger log.FieldLogger) error
 	updateClusterProvision           func(*InstallManager, provisionMutation) error
+	updateClusterProvisionStatus     func(*InstallManager, provisionMutation) error
 	readClusterMetadata              func(*InstallManager) ([]byte, *installertypes.ClusterMetadata, error)
 	uploadAdminKubeconfig            func(*InstallManager) (*corev1.Secret, error)
 	uploadAdminPassword              func(*InstallManager) (*corev1.Secret, error)
 	loadAdminPassword                func(*InstallManager) (string, error)
 	provisionCluster                 func(*InstallManager) error
+	resumeCluster                    func(*InstallManager, installStage) error
 	readInstallerLog                 func(*InstallManager, bool) (string, error)
 	waitForProvisioningStage         func(*InstallManager) error
 	waitForInstallCompleteExecutions int
 	binaryDir                        string
 	actuator                         LogUploaderActuator
+	// installCtx is the context of the installer commands, cancelled to interrupt the installer when the pod is
+	// terminated.
+	installCtx context.Context
 }
 
 // NewInstallManagerCommand is the entrypoint to create the 'install-manager' subcommand
@@ -156,14 +161,27 @@ completing install, and monitors for artifacts that need to be uploaded back to
 The following environment variables, if present, configure the Install Manager to upload logs for
 failed provisions:
 
-HIVE_INSTALL_LOGS_UPLOAD_PROVIDER: The cloud provider hosting the object store. At this time only
-	"aws" is supported.
+HIVE_INSTALL_LOGS_UPLOAD_PROVIDER: The cloud provider hosting the object store. One of "aws",
+	"gcp" or "azure".
 HIVE_INSTALL_LOGS_CREDENTIALS_SECRET: The name of a secret in the current namespace containing
 	credentials sufficient to write data to the specified bucket. For example, for AWS, the secret
 	data could contain base64-encoded values for "aws_access_key_id" and "aws_secret_access_key".
 HIVE_INSTALL_LOGS_AWS_REGION: The region containing the specified bucket.
+HIVE_INSTALL_LOGS_AWS_S3_URL: The endpoint of an S3 compatible object store, such as MinIO, to use
+	instead of AWS S3.
 HIVE_INSTALL_LOGS_AWS_S3_BUCKET: The name of the S3 bucket to which to upload the logs. The bucket
 	must exist and be writable using the specified credentials.
+HIVE_INSTALL_LOGS_GCP_BUCKET: The name of the GCS bucket to which to upload the logs. The bucket
+	must exist and be writable using the specified credentials.
+HIVE_INSTALL_LOGS_AZURE_STORAGE_ACCOUNT: The name of the Azure storage account containing the
+	specified container.
+HIVE_INSTALL_LOGS_AZURE_CONTAINER: The name of the blob container to which to upload the logs. The
+	container must exist and be writable using the specified credentials.
+HIVE_INSTALL_LOGS_AZURE_CLOUD_NAME: The Azure cloud environment of the storage account. Defaults to
+	"AzurePublicCloud".
+HIVE_INSTALL_LOGS_ARCHIVE: If "true", the installer log, the redacted install config and the
+	manifests of every provision attempt, failed or not, are also archived to the object store
+	under the "archive/" prefix.
 SSH_PRIV_KEY_PATH: File system path of a file containing the SSH private key corresponding to the
 	public key in the install config.`,
 		Run: func(cmd *cobra.Command, args []string) {
@@ -214,6 +232,7 @@ func (m *InstallManager) Complete(args []string) error {
 	// Connect up structure's function pointers
 	m.loadSecrets = loadSecrets
 	m.updateClusterProvision = updateClusterProvisionWithRetries
+	m.updateClusterProvisionStatus = updateClusterProvisionStatusWithRetries
 	m.readClusterMetadata = readClusterMetadata
 	m.uploadAdminKubeconfig = uploadAdminKubeconfig
 	m.uploadAdminPassword = uploadAdminPassword
@@ -221,7 +240,9 @@ func (m *InstallManager) Complete(args []string) error {
 	m.readInstallerLog = readInstallerLog
 	m.cleanupFailedProvision = cleanupFailedProvision
 	m.provisionCluster = provisionCluster
+	m.resumeCluster = resumeCluster
 	m.waitForProvisioningStage = waitForProvisioningStage
+	m.installCtx = context.Background()
 
 	var err error
 	m.log, err = contributils.NewLogger(m.LogLevel)
@@ -250,6 +271,7 @@ func (m *InstallManager) Complete(args []string) error {
 		m.loadAdminPassword = fakeLoadAdminPassword
 		m.readClusterMetadata = fakeReadClusterMetadata
 		m.provisionCluster = fakeProvisionCluster
+		m.resumeCluster = func(m *InstallManager, _ installStage) error { return fakeProvisionCluster(m) }
 	}
 
 	return nil
@@ -351,12 +373,27 @@ func (m *InstallManager) Run() error {
 		m.log.WithError(err).Error("error adding pull secret to install-config.yaml")
 		return err
 	}
+	if len(m.ClusterProvision.Spec.ExcludedZones) > 0 {
+		icData, err = m.excludeZones(icData, cd)
+		if err != nil {
+			m.log.WithError(err).Error("error excluding zones from install-config.yaml")
+			return err
+		}
+	}
 	destInstallConfigPath := filepath.Join(m.WorkDir, "install-config.yaml")
 	if err := os.WriteFile(destInstallConfigPath, icData, 0644); err != nil {
 		m.log.WithError(err).Error("error writing install-config.yaml")
 	}
 	m.log.Infof("copied %s to %s", m.InstallConfigMountPath, destInstallConfigPath)
 
+	if archiveEnabled() {
+		if err := m.stageArchivedInstallConfig(icData); err != nil {
+			// Not a fatal error.
+			m.log.WithError(err).Warn("error staging install-config.yaml for archiving")
+		}
+		defer m.archiveAttempt(scrubInstallLog)
+	}
+
 	if cd.Spec.Provisioning != nil && len(cd.Spec.Provisioning.SSHKnownHosts) > 0 {
 		err = m.writeSSHKnownHosts(getHomeDir(), cd.Spec.Provisioning.SSHKnownHosts)
 		if err != nil {
@@ -366,8 +403,31 @@ func (m *InstallManager) Run() error {
 	}
 
 	// If the cluster provision has a prevInfraID set, this implies we failed a previous
-	// cluster provision attempt. Cleanup any resources that may have been provisioned.
-	if m.ClusterProvision.Spec.PrevInfraID != nil {
+	// cluster provision attempt. If the previous install was interrupted and saved its
+	// state, resume it. Otherwise cleanup any resources that may have been provisioned.
+	resuming := false
+	var stage installStage
+	if m.ClusterProvision.Spec.PrevInfraID != nil && resumeEnabled(cd) {
+		// The installer must not see an install-config.yaml on disk, or it will generate new assets.
+		if err := os.Remove(destInstallConfigPath); err != nil {
+			m.log.WithError(err).Error("error removing install-config.yaml")
+			return err
+		}
+		stage, resuming, err = m.restoreInstallState(*m.ClusterProvision.Spec.PrevProvisionName, *m.ClusterProvision.Spec.PrevInfraID)
+		if err != nil {
+			// Not a fatal error, we can still start over.
+			m.log.WithError(err).Warn("error restoring install state of previous provision attempt")
+		}
+		if !resuming {
+			if err := os.WriteFile(destInstallConfigPath, icData, 0644); err != nil {
+				m.log.WithError(err).Error("error writing install-config.yaml")
+				return err
+			}
+		}
+	}
+	if resuming {
+		m.log.WithField("stage", stage).Info("resuming install of previous provision attempt")
+	} else if m.ClusterProvision.Spec.PrevInfraID != nil {
 		m.log.Info("cleaning up resources from previous provision attempt")
 		if err := m.cleanupFailedInstall(cd, *m.ClusterProvision.Spec.PrevInfraID, *m.ClusterProvision.Spec.PrevProvisionName, m.Namespace); err != nil {
 			m.log.WithError(err).Error("error while trying to preemptively clean up")
@@ -402,9 +462,12 @@ func (m *InstallManager) Run() error {
 		return err
 	}
 
-	// Generate installer assets we need to modify or upload.
+	// Generate installer assets we need to modify or upload, unless they were restored with the state of the
+	// install we are resuming.
 	m.log.Info("generating assets")
-	if err := m.generateAssets(cd, workerMachinePool); err != nil {
+	if resuming {
+		m.log.Info("using assets of resumed install")
+	} else if err := m.generateAssets(cd, workerMachinePool); err != nil {
 		m.log.Info("reading installer log")
 		installLog, readErr := m.readInstallerLog(m, scrubInstallLog)
 		if readErr != nil {
@@ -481,7 +544,27 @@ func (m *InstallManager) Run() error {
 		}
 	}
 
-	installErr := m.provisionCluster(m)
+	var installErr error
+	if resumeEnabled(cd) {
+		if !resuming {
+			stage = installStageAssetsGenerated
+		}
+		stopSaving := m.saveInstallStateWhileRunning(stage, metadata.InfraID)
+		if resuming {
+			installErr = m.resumeCluster(m, stage)
+		} else {
+			installErr = m.provisionCluster(m)
+		}
+		if stopSaving() {
+			// The next provision attempt resumes the install from its saved state, so its resources are left be.
+			return errors.New("install pod terminated, install state saved to resume from")
+		}
+		// Only interrupted installs are resumed. Failed ones are cleaned up and started over.
+		m.deleteInstallState()
+	} else {
+		installErr = m.provisionCluster(m)
+	}
+	m.recordMilestonesFromLog()
 	if installErr != nil {
 		m.log.WithError(installErr).Error("error running openshift-install, running deprovision to clean up")
 
@@ -587,6 +670,8 @@ func getActuator() LogUploaderActuator {
 	// As we add more LogUploaderActuators, add them here
 	actuators := []LogUploaderActuator{
 		&s3LogUploaderActuator{awsClientFn: getAWSClient},
+		&gcsLogUploaderActuator{gcpClientFn: getGCPClient},
+		&azureBlobLogUploaderActuator{azureClientFn: getAzureClient},
 	}
 
 	for _, a := range actuators {
@@ -936,6 +1021,13 @@ func (m *InstallManager) generateAssets(cd *hivev1.ClusterDeployment, workerMach
 		}
 	}
 
+	if archiveEnabled() {
+		if err := m.stageArchivedManifests(); err != nil {
+			// Not a fatal error.
+			m.log.WithError(err).Warn("error staging manifests for archiving")
+		}
+	}
+
 	m.log.Info("running openshift-install create ignition-configs")
 	if err := m.runOpenShiftInstallCommand("create", "ignition-configs"); err != nil {
 		m.log.WithError(err).Error("error generating installer assets")
@@ -1029,8 +1121,11 @@ func provisionCluster(m *InstallManager) error {
 
 func (m *InstallManager) runOpenShiftInstallCommand(args ...string) error {
 	m.log.WithField("args", args).Info("running openshift-install binary")
-	cmd := exec.Command(filepath.Join(m.binaryDir, "openshift-install"), args...)
+	cmd := exec.CommandContext(m.installCtx, filepath.Join(m.binaryDir, "openshift-install"), args...)
 	cmd.Dir = m.WorkDir
+	// Interrupt rather than kill the installer, so that it records the state of the resources it was creating.
+	cmd.Cancel = func() error { return cmd.Process.Signal(os.Interrupt) }
+	cmd.WaitDelay = installerInterruptTimeout
 
 	// save the commands' stdout/stderr to a file
 	stdOutAndErrOutput, err := os.OpenFile(installerConsoleLogFilePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0666)
@@ -1093,6 +1188,8 @@ func (m *InstallManager) tailFullInstallLog(scrubInstallLog bool) {
 		}
 	}
 
+	reached := map[hivev1.ProvisionMilestoneName]bool{}
+
 	// this loop will store up a full line worth of text into fullLine before
 	// passing through regex and then out to stdout
 	//
@@ -1117,6 +1214,8 @@ func (m *InstallManager) tailFullInstallLog(scrubInstallLog bool) {
 			continue
 		}
 
+		m.recordMilestone(fullLine, reached)
+
 		if scrubInstallLog {
 			cleanLine := cleanupLogOutput(fullLine)
 			fmt.Println(cleanLine + suffix)
@@ -1236,10 +1335,13 @@ func (m *InstallManager) gatherLogs(cd *hivev1.ClusterDeployment, sshPrivKeyPath
 		filepaths = append(filepaths, filepath.Join(m.LogsDir, file.Name()))
 	}
 
-	uploadErr := m.actuator.UploadLogs(cd.Spec.ClusterName, m.ClusterProvision, m.DynamicClient, m.log, filepaths...)
+	urls, uploadErr := m.actuator.UploadLogs(cd.Spec.ClusterName, m.ClusterProvision, m.DynamicClient, m.log, filepaths...)
 	if uploadErr != nil {
 		m.log.WithError(uploadErr).Error("error uploading logs")
 	}
+
+	// Record where the logs went, even if only some of them made it, so people can find them.
+	m.recordLogURLs(urls)
 }
 
 func (m *InstallManager) gatherClusterLogs(cd *hivev1.ClusterDeployment) error {
@@ -1723,6 +1825,30 @@ func updateClusterProvisionWithRetries(m *InstallManager, mutation provisionMuta
 	return nil
 }
 
+func updateClusterProvisionStatusWithRetries(m *InstallManager, mutation provisionMutation) error {
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		// read in a fresh clusterprovision
+		if err := m.loadClusterProvision(); err != nil {
+			m.log.WithError(err).Warn("error reading in fresh clusterprovision")
+			return err
+		}
+
+		// make the needed modifications to the clusterprovision status
+		mutation(m.ClusterProvision)
+
+		if err := m.DynamicClient.Status().Update(context.Background(), m.ClusterProvision); err != nil {
+			m.log.WithError(err).Warn("error updating clusterprovision status")
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		m.log.WithError(err).Error("error trying to update clusterprovision status")
+		return err
+	}
+	return nil
+}
+
 func cleanupLogOutput(fullLog string) string {
 	// The console log may have carriage returns as well as newlines,
 	// and they may be escaped (this especially happens with the
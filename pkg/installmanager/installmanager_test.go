@@ -96,6 +96,8 @@ func TestInstallManager(t *testing.T) {
 		expectProvisionMetadataUpdate bool
 		expectProvisionLogUpdate      bool
 		expectError                   bool
+		expectCleanup                 bool
+		expectResumeStage             installStage
 	}{
 		{
 			name:                          "successful install",
@@ -149,9 +151,57 @@ func TestInstallManager(t *testing.T) {
 			expectProvisionMetadataUpdate: true,
 		},
 		{
-			name:        "infraID already set on cluster provision", // fatal error
-			existing:    []runtime.Object{testClusterDeployment(), testClusterProvisionWithInfraIDSet()},
-			expectError: true,
+			name:          "infraID already set on cluster provision", // fatal error
+			existing:      []runtime.Object{testClusterDeployment(), testClusterProvisionWithInfraIDSet()},
+			expectError:   true,
+			expectCleanup: true,
+		},
+		{
+			name:                          "previous attempt cleaned up",
+			existing:                      []runtime.Object{testClusterDeployment(), testClusterProvisionWithPrevInfraID()},
+			expectKubeconfigSecret:        true,
+			expectPasswordSecret:          true,
+			expectProvisionMetadataUpdate: true,
+			expectProvisionLogUpdate:      true,
+			expectCleanup:                 true,
+		},
+		{
+			name: "interrupted previous attempt resumed",
+			existing: []runtime.Object{
+				testResumableClusterDeployment(),
+				testClusterProvisionWithPrevInfraID(),
+				testInstallStateSecret(t, testPrevProvisionName, testInfraID, installStageBootstrapComplete),
+			},
+			expectKubeconfigSecret:        true,
+			expectPasswordSecret:          true,
+			expectProvisionMetadataUpdate: true,
+			expectProvisionLogUpdate:      true,
+			expectResumeStage:             installStageBootstrapComplete,
+		},
+		{
+			name: "previous attempt without saved state cleaned up",
+			existing: []runtime.Object{
+				testResumableClusterDeployment(),
+				testClusterProvisionWithPrevInfraID(),
+			},
+			expectKubeconfigSecret:        true,
+			expectPasswordSecret:          true,
+			expectProvisionMetadataUpdate: true,
+			expectProvisionLogUpdate:      true,
+			expectCleanup:                 true,
+		},
+		{
+			name: "previous attempt with state saved for another infra ID cleaned up",
+			existing: []runtime.Object{
+				testResumableClusterDeployment(),
+				testClusterProvisionWithPrevInfraID(),
+				testInstallStateSecret(t, testPrevProvisionName, "other-infra-id", installStageBootstrapComplete),
+			},
+			expectKubeconfigSecret:        true,
+			expectPasswordSecret:          true,
+			expectProvisionMetadataUpdate: true,
+			expectProvisionLogUpdate:      true,
+			expectCleanup:                 true,
 		},
 	}
 	for _, test := range tests {
@@ -247,10 +297,20 @@ func TestInstallManager(t *testing.T) {
 			}
 
 			// We don't want to run the uninstaller, so stub it out
-			im.cleanupFailedProvision = alwaysSucceedCleanupFailedProvision
+			cleanups := 0
+			im.cleanupFailedProvision = func(c client.Client, cd *hivev1.ClusterDeployment, infraID string, logger log.FieldLogger) error {
+				cleanups++
+				return alwaysSucceedCleanupFailedProvision(c, cd, infraID, logger)
+			}
+
+			var resumedStage installStage
+			im.resumeCluster = func(m *InstallManager, stage installStage) error {
+				resumedStage = stage
+				return resumeCluster(m, stage)
+			}
 
 			// Save the list of actuators so that it can be restored at the end of this test
-			im.actuator = &s3LogUploaderActuator{awsClientFn: func(c client.Client, secretName, namespace, region string, logger log.FieldLogger) (awsclient.Client, error) {
+			im.actuator = &s3LogUploaderActuator{awsClientFn: func(c client.Client, secretName, namespace, region, serviceEndpoint string, logger log.FieldLogger) (awsclient.Client, error) {
 				return mocks.mockAWSClient, nil
 			}}
 
@@ -261,6 +321,19 @@ func TestInstallManager(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 			}
+			assert.Equal(t, test.expectCleanup, cleanups > 0, "unexpected cleanup of previous attempt")
+			assert.Equal(t, test.expectResumeStage, resumedStage, "unexpected stage of resumed install")
+			if test.expectResumeStage != "" {
+				assert.FileExists(t, filepath.Join(tempDir, "terraform.tfstate"), "expected install state to be restored")
+			}
+			installState := &corev1.Secret{}
+			err = mocks.fakeKubeClient.Get(context.Background(),
+				types.NamespacedName{
+					Namespace: testNamespace,
+					Name:      fmt.Sprintf(installStateSecretStringTemplate, testProvisionName),
+				},
+				installState)
+			assert.True(t, apierrors.IsNotFound(err), "expected install state secret to be deleted after install: %v", err)
 
 			adminKubeconfig := &corev1.Secret{}
 			err = mocks.fakeKubeClient.Get(context.Background(),
@@ -1,8 +1,13 @@
 package installmanager
 
 import (
+	"fmt"
+	"os"
+
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -11,6 +16,87 @@ type LogUploaderActuator interface {
 	// IsConfigured returns true if the actuator can handle a particular case
 	IsConfigured() bool
 
-	// UploadLogs uploads installer logs to the provider's storage mechanism.
-	UploadLogs(clusterName string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger, filenames ...string) error
+	// UploadLogs uploads installer logs to the provider's storage mechanism, returning the URLs of the logs
+	// that were uploaded successfully.
+	UploadLogs(clusterName string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger, filenames ...string) ([]string, error)
+
+	// ArchiveLogs uploads the files into the folder of the provider's storage mechanism, returning the URLs of the
+	// files that were uploaded successfully.
+	ArchiveLogs(folder string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger, filenames ...string) ([]string, error)
+
+	// SaveInstallState uploads the install state to the key of the provider's storage mechanism, replacing any state
+	// saved there before.
+	SaveInstallState(key string, state []byte, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger) error
+
+	// LoadInstallState downloads the install state saved to the key of the provider's storage mechanism.
+	LoadInstallState(key string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger) ([]byte, error)
+
+	// DeleteInstallState deletes the install state saved to the key of the provider's storage mechanism.
+	DeleteInstallState(key string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger) error
+}
+
+// logFolder returns the folder in the object store under which the logs of the cluster are uploaded.
+func logFolder(clusterName string, clusterprovision *hivev1.ClusterProvision) string {
+	return fmt.Sprintf("%v-%v", clusterName, clusterprovision.Namespace)
+}
+
+// logKey returns the key in the object store to which the log file is uploaded.
+func logKey(folder string, clusterprovision *hivev1.ClusterProvision, stat os.FileInfo) string {
+	return fmt.Sprintf("%v/%v-%v", folder, clusterprovision.Name, stat.Name())
+}
+
+// archivePrefix is the prefix in the object store of all archived files, for lifecycle rules to limit how long they
+// are kept.
+const archivePrefix = "archive"
+
+// ArchiveFolder returns the folder in the object store under which the files of the given provision attempt of the
+// cluster are archived.
+func ArchiveFolder(clusterName, namespace string, attempt int) string {
+	return fmt.Sprintf("%v/%v-%v/attempts/%d", archivePrefix, clusterName, namespace, attempt)
+}
+
+// ArchiveClusterFolder returns the folder in the object store under which the files of all provision attempts of
+// the cluster are archived.
+func ArchiveClusterFolder(clusterName, namespace string) string {
+	return fmt.Sprintf("%v/%v-%v/attempts", archivePrefix, clusterName, namespace)
+}
+
+// installStatePrefix is the prefix in the object store of all saved install states.
+const installStatePrefix = "install-state"
+
+// InstallStateKey returns the key in the object store to which the install state of the cluster is saved. Each
+// provision attempt of the cluster replaces the state saved by the previous one.
+func InstallStateKey(clusterName, namespace string) string {
+	return fmt.Sprintf("%v/%v-%v/state.tar.gz", installStatePrefix, clusterName, namespace)
+}
+
+// uploadFiles uploads each of the files with the upload function, under the key returned for the file by keyFn,
+// returning the URLs of the files that were uploaded successfully.
+func uploadFiles(upload func(key string, file *os.File, size int64) (string, error), keyFn func(os.FileInfo) string, filenames ...string) ([]string, error) {
+	retvalErrs := []error{}
+	urls := []string{}
+
+	for _, filename := range filenames {
+		file, err := os.Open(filename)
+		if err != nil {
+			retvalErrs = append(retvalErrs, errors.Wrapf(err, "Failed opening log file: %v", filename))
+			continue
+		}
+		defer file.Close()
+
+		stat, err := file.Stat()
+		if err != nil {
+			retvalErrs = append(retvalErrs, errors.Wrapf(err, "Failed stat on log file: %v", filename))
+			continue
+		}
+
+		url, err := upload(keyFn(stat), file, stat.Size())
+		if err != nil {
+			retvalErrs = append(retvalErrs, errors.Wrapf(err, "Failed uploading log file: %v", filename))
+			continue
+		}
+		urls = append(urls, url)
+	}
+
+	return urls, utilerrors.NewAggregate(retvalErrs)
 }
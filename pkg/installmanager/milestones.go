@@ -0,0 +1,96 @@
+package installmanager
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+)
+
+// installMilestones are the milestones of the install, with the installer log lines that mark them.
+var installMilestones = []struct {
+	name  hivev1.ProvisionMilestoneName
+	regex *regexp.Regexp
+}{
+	{name: hivev1.ProvisionMilestoneInfrastructureCreated, regex: regexp.MustCompile(`Waiting up to \S+ .*for the Kubernetes API at`)},
+	{name: hivev1.ProvisionMilestoneAPIUp, regex: regexp.MustCompile(`API v\S+ up`)},
+	{name: hivev1.ProvisionMilestoneBootstrapComplete, regex: bootstrapCompleteRegex},
+	{name: hivev1.ProvisionMilestoneInstallComplete, regex: regexp.MustCompile(`Install complete!`)},
+}
+
+// logTimeRegex matches the timestamp of a line of the full installer log.
+var logTimeRegex = regexp.MustCompile(`time="([^"]+)"`)
+
+// parseMilestone returns the milestone the installer log line marks, if any, and when it was reached: the timestamp
+// of the line if it has one, or now.
+func parseMilestone(line string) (*hivev1.ProvisionMilestone, bool) {
+	for _, milestone := range installMilestones {
+		if !milestone.regex.MatchString(line) {
+			continue
+		}
+		reached := time.Now()
+		if match := logTimeRegex.FindStringSubmatch(line); match != nil {
+			if t, err := time.Parse(time.RFC3339, match[1]); err == nil {
+				reached = t
+			}
+		}
+		return &hivev1.ProvisionMilestone{Name: milestone.name, Time: metav1.NewTime(reached)}, true
+	}
+	return nil, false
+}
+
+// recordMilestone adds the milestone the installer log line marks, if any, to the status of the ClusterProvision,
+// unless it is in reached, to which it is then added. As it is called while the log is tailed, concurrently with
+// other updates of the ClusterProvision, it works on its own copy of the ClusterProvision.
+func (m *InstallManager) recordMilestone(line string, reached map[hivev1.ProvisionMilestoneName]bool) {
+	milestone, ok := parseMilestone(line)
+	if !ok || reached[milestone.Name] {
+		return
+	}
+	reached[milestone.Name] = true
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		provision := &hivev1.ClusterProvision{}
+		if err := m.DynamicClient.Get(context.Background(), types.NamespacedName{Namespace: m.Namespace, Name: m.ClusterProvisionName}, provision); err != nil {
+			return err
+		}
+		for _, existing := range provision.Status.Milestones {
+			if existing.Name == milestone.Name {
+				return nil
+			}
+		}
+		provision.Status.Milestones = append(provision.Status.Milestones, *milestone)
+		sort.SliceStable(provision.Status.Milestones, func(i, j int) bool {
+			return provision.Status.Milestones[i].Time.Before(&provision.Status.Milestones[j].Time)
+		})
+		if err := m.DynamicClient.Status().Update(context.Background(), provision); err != nil {
+			return err
+		}
+		m.log.WithField("milestone", milestone.Name).Info("install reached milestone")
+		return nil
+	}); err != nil {
+		m.log.WithError(err).Warning("error updating cluster provision with install milestone")
+	}
+}
+
+// recordMilestonesFromLog records the milestones of the full installer log, catching any the tail of the log has not
+// reached when the installer exits.
+func (m *InstallManager) recordMilestonesFromLog() {
+	installLog, err := os.ReadFile(filepath.Join(m.WorkDir, installerFullLogFile))
+	if err != nil {
+		m.log.WithError(err).Warn("error reading installer log for install milestones")
+		return
+	}
+	reached := map[hivev1.ProvisionMilestoneName]bool{}
+	for _, line := range strings.Split(string(installLog), "\n") {
+		m.recordMilestone(line, reached)
+	}
+}
@@ -0,0 +1,108 @@
+package installmanager
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+)
+
+func TestParseMilestone(t *testing.T) {
+	tests := []struct {
+		name              string
+		line              string
+		expectedMilestone hivev1.ProvisionMilestoneName
+		expectedTime      string
+	}{
+		{
+			name:              "infrastructure created",
+			line:              `time="2022-06-01T10:05:00Z" level=info msg="Waiting up to 20m0s (until 10:25AM) for the Kubernetes API at https://api.test.example.com:6443..."`,
+			expectedMilestone: hivev1.ProvisionMilestoneInfrastructureCreated,
+			expectedTime:      "2022-06-01T10:05:00Z",
+		},
+		{
+			name:              "API up",
+			line:              `time="2022-06-01T10:08:00Z" level=info msg="API v1.24.0+9546431 up"`,
+			expectedMilestone: hivev1.ProvisionMilestoneAPIUp,
+			expectedTime:      "2022-06-01T10:08:00Z",
+		},
+		{
+			name:              "bootstrap complete",
+			line:              `time="2022-06-01T10:20:00Z" level=info msg="It is now safe to remove the bootstrap resources"`,
+			expectedMilestone: hivev1.ProvisionMilestoneBootstrapComplete,
+			expectedTime:      "2022-06-01T10:20:00Z",
+		},
+		{
+			name:              "install complete without timestamp",
+			line:              `level=info msg="Install complete!"`,
+			expectedMilestone: hivev1.ProvisionMilestoneInstallComplete,
+		},
+		{
+			name: "not a milestone",
+			line: `time="2022-06-01T10:00:00Z" level=info msg="Creating infrastructure resources..."`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			milestone, ok := parseMilestone(test.line)
+			if test.expectedMilestone == "" {
+				assert.False(t, ok, "unexpected milestone")
+				return
+			}
+			require.True(t, ok, "expected milestone")
+			assert.Equal(t, test.expectedMilestone, milestone.Name, "unexpected milestone")
+			if test.expectedTime != "" {
+				assert.Equal(t, test.expectedTime, milestone.Time.UTC().Format(time.RFC3339), "unexpected milestone time")
+			} else {
+				assert.WithinDuration(t, time.Now(), milestone.Time.Time, time.Minute, "expected milestone time to be now")
+			}
+		})
+	}
+}
+
+func TestRecordMilestonesFromLog(t *testing.T) {
+	workDir := t.TempDir()
+	installLog := `time="2022-06-01T10:00:00Z" level=info msg="Creating infrastructure resources..."
+time="2022-06-01T10:05:00Z" level=info msg="Waiting up to 20m0s (until 10:25AM) for the Kubernetes API at https://api.test.example.com:6443..."
+time="2022-06-01T10:08:00Z" level=info msg="API v1.24.0+9546431 up"
+time="2022-06-01T10:20:00Z" level=info msg="It is now safe to remove the bootstrap resources"
+time="2022-06-01T10:45:00Z" level=info msg="Install complete!"
+`
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, installerFullLogFile), []byte(installLog), 0600))
+
+	mocks := setupDefaultMocks(t, testClusterProvision())
+	im := &InstallManager{
+		WorkDir:              workDir,
+		Namespace:            testNamespace,
+		ClusterProvisionName: testProvisionName,
+		DynamicClient:        mocks.fakeKubeClient,
+		log:                  log.WithField("test", t.Name()),
+	}
+
+	// The tail of the log recorded the first milestone already.
+	im.recordMilestone(`time="2022-06-01T10:05:00Z" level=info msg="Waiting up to 20m0s (until 10:25AM) for the Kubernetes API at https://api.test.example.com:6443..."`,
+		map[hivev1.ProvisionMilestoneName]bool{})
+	im.recordMilestonesFromLog()
+
+	provision := &hivev1.ClusterProvision{}
+	require.NoError(t, mocks.fakeKubeClient.Get(context.Background(), types.NamespacedName{Namespace: testNamespace, Name: testProvisionName}, provision))
+	names := []hivev1.ProvisionMilestoneName{}
+	for _, milestone := range provision.Status.Milestones {
+		names = append(names, milestone.Name)
+	}
+	assert.Equal(t, []hivev1.ProvisionMilestoneName{
+		hivev1.ProvisionMilestoneInfrastructureCreated,
+		hivev1.ProvisionMilestoneAPIUp,
+		hivev1.ProvisionMilestoneBootstrapComplete,
+		hivev1.ProvisionMilestoneInstallComplete,
+	}, names, "unexpected milestones")
+}
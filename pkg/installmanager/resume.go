@@ -0,0 +1,421 @@
+package installmanager
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/constants"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+	k8slabels "github.com/openshift/hive/pkg/util/labels"
+	"github.com/openshift/hive/pkg/util/scheme"
+)
+
+const (
+	installStateSecretStringTemplate = "%s-install-state"
+	installStateSecretStateKey       = "state.tar.gz"
+	installStateSecretStageKey       = "stage"
+	installStateSecretInfraIDKey     = "infraID"
+	installStateSecretObjectKey      = "objectKey"
+
+	// installStateSaveInterval is how often the install state is saved while the installer runs.
+	installStateSaveInterval = 2 * time.Minute
+
+	// maxInstallStateSize is the largest compressed install state that is saved in the install state Secret, when no
+	// object store is configured for install logs. Secrets are limited to 1MiB.
+	maxInstallStateSize = 1000 * 1024
+
+	// installerInterruptTimeout is how long an interrupted installer is given to exit before it is killed.
+	installerInterruptTimeout = 10 * time.Second
+)
+
+// installStage is the last completed stage of an install whose state is saved.
+type installStage string
+
+const (
+	// installStageAssetsGenerated means the installer generated its assets, and may have started creating the
+	// cluster infrastructure.
+	installStageAssetsGenerated installStage = "AssetsGenerated"
+	// installStageBootstrapComplete means the control plane of the cluster came up, but the bootstrap resources
+	// may not have been destroyed yet.
+	installStageBootstrapComplete installStage = "BootstrapComplete"
+	// installStageBootstrapDestroyed means the bootstrap resources were destroyed, and the installer was waiting for
+	// the cluster to initialize.
+	installStageBootstrapDestroyed installStage = "BootstrapDestroyed"
+)
+
+var (
+	bootstrapCompleteRegex  = regexp.MustCompile(`It is now safe to remove the bootstrap resources`)
+	bootstrapDestroyedRegex = regexp.MustCompile(`Waiting up to \S+ .*for the cluster at \S+ to initialize`)
+
+	// unsavedInstallStateFiles are files of the WorkDir that are not part of the install state: the binaries copied
+	// there for us, the logs, and files staged for archiving.
+	unsavedInstallStateFiles = []string{"openshift-install", "oc", installerFullLogFile, archiveRelativeDir}
+)
+
+// resumeEnabled returns true if interrupted installs of the cluster are to be resumed.
+func resumeEnabled(cd *hivev1.ClusterDeployment) bool {
+	return cd.Spec.Provisioning != nil && cd.Spec.Provisioning.ResumeInterruptedInstall
+}
+
+// detectInstallStage returns the last stage completed according to the installer log, which is never earlier than the
+// current stage.
+func detectInstallStage(installLog string, current installStage) installStage {
+	switch {
+	case current == installStageBootstrapDestroyed || bootstrapDestroyedRegex.MatchString(installLog):
+		return installStageBootstrapDestroyed
+	case current == installStageBootstrapComplete || bootstrapCompleteRegex.MatchString(installLog):
+		return installStageBootstrapComplete
+	}
+	return current
+}
+
+// installStateInObjectStore returns true if the install state is saved to the object store configured for install
+// logs, rather than in the install state Secret.
+func (m *InstallManager) installStateInObjectStore() bool {
+	return m.actuator != nil && m.actuator.IsConfigured()
+}
+
+// saveInstallState saves the WorkDir, which holds the state of the installer, along with the stage of the install.
+// The state is uploaded to the object store configured for install logs if any, and the install state Secret of the
+// ClusterProvision records where. Otherwise the state is saved in the Secret itself, as long as it fits.
+func (m *InstallManager) saveInstallState(stage installStage, infraID string) error {
+	state, err := tarInstallState(m.WorkDir)
+	if err != nil {
+		return errors.Wrap(err, "error archiving install state")
+	}
+	data := map[string][]byte{
+		installStateSecretStageKey:   []byte(stage),
+		installStateSecretInfraIDKey: []byte(infraID),
+	}
+	if m.installStateInObjectStore() {
+		key := InstallStateKey(m.ClusterName, m.Namespace)
+		if err := m.actuator.SaveInstallState(key, state, m.ClusterProvision, m.DynamicClient, m.log); err != nil {
+			return errors.Wrap(err, "error uploading install state")
+		}
+		data[installStateSecretObjectKey] = []byte(key)
+	} else {
+		if len(state) > maxInstallStateSize {
+			return fmt.Errorf("install state of %d bytes exceeds the maximum of %d bytes that can be saved without an object store for install logs", len(state), maxInstallStateSize)
+		}
+		data[installStateSecretStateKey] = state
+	}
+
+	secret := &corev1.Secret{}
+	name := types.NamespacedName{Namespace: m.Namespace, Name: fmt.Sprintf(installStateSecretStringTemplate, m.ClusterProvisionName)}
+	err = m.DynamicClient.Get(context.Background(), name, secret)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, "error getting install state secret")
+	}
+	exists := err == nil
+	if !exists {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name.Name,
+				Namespace: name.Namespace,
+			},
+		}
+		secret.Labels = k8slabels.AddLabel(secret.Labels, constants.ClusterProvisionNameLabel, m.ClusterProvision.Name)
+		secret.Labels = k8slabels.AddLabel(secret.Labels, constants.SecretTypeLabel, constants.SecretTypeInstallState)
+		provisionGVK, err := apiutil.GVKForObject(m.ClusterProvision, scheme.GetScheme())
+		if err != nil {
+			return errors.Wrap(err, "error getting GVK for provision")
+		}
+		secret.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion:         provisionGVK.GroupVersion().String(),
+			Kind:               provisionGVK.Kind,
+			Name:               m.ClusterProvision.Name,
+			UID:                m.ClusterProvision.UID,
+			BlockOwnerDeletion: pointer.BoolPtr(true),
+		}}
+	}
+	secret.Data = data
+	if exists {
+		err = m.DynamicClient.Update(context.Background(), secret)
+	} else {
+		err = m.DynamicClient.Create(context.Background(), secret)
+	}
+	if err != nil {
+		return errors.Wrap(err, "error saving install state secret")
+	}
+	m.log.WithField("stage", stage).WithField("size", len(state)).Info("saved install state")
+	return nil
+}
+
+// restoreInstallState restores the install state saved by the ClusterProvision into the WorkDir, and returns the stage
+// of the saved install. It returns false if the ClusterProvision saved no install state for the infra ID.
+func (m *InstallManager) restoreInstallState(provisionName, infraID string) (installStage, bool, error) {
+	secret := &corev1.Secret{}
+	name := types.NamespacedName{Namespace: m.Namespace, Name: fmt.Sprintf(installStateSecretStringTemplate, provisionName)}
+	switch err := m.DynamicClient.Get(context.Background(), name, secret); {
+	case apierrors.IsNotFound(err):
+		return "", false, nil
+	case err != nil:
+		return "", false, errors.Wrap(err, "error getting install state secret")
+	}
+	if saved := string(secret.Data[installStateSecretInfraIDKey]); saved != infraID {
+		m.log.WithField("savedInfraID", saved).Warn("install state was saved for another infra ID, not resuming")
+		return "", false, nil
+	}
+	stage := installStage(secret.Data[installStateSecretStageKey])
+	state := secret.Data[installStateSecretStateKey]
+	if key := string(secret.Data[installStateSecretObjectKey]); key != "" {
+		if !m.installStateInObjectStore() {
+			return "", false, errors.New("install state was saved to an object store that is no longer configured")
+		}
+		var err error
+		if state, err = m.actuator.LoadInstallState(key, m.ClusterProvision, m.DynamicClient, m.log); err != nil {
+			return "", false, errors.Wrap(err, "error downloading install state")
+		}
+	}
+	if err := untarInstallState(state, m.WorkDir); err != nil {
+		return "", false, errors.Wrap(err, "error restoring install state")
+	}
+	m.log.WithField("stage", stage).WithField("provision", provisionName).Info("restored install state")
+	return stage, true, nil
+}
+
+// deleteInstallState deletes the install state saved by the ClusterProvision, so that the install is not resumed.
+func (m *InstallManager) deleteInstallState() {
+	secret := &corev1.Secret{}
+	name := types.NamespacedName{Namespace: m.Namespace, Name: fmt.Sprintf(installStateSecretStringTemplate, m.ClusterProvisionName)}
+	switch err := m.DynamicClient.Get(context.Background(), name, secret); {
+	case apierrors.IsNotFound(err):
+		return
+	case err != nil:
+		m.log.WithError(err).Warn("error getting install state secret")
+		return
+	}
+	if key := string(secret.Data[installStateSecretObjectKey]); key != "" && m.installStateInObjectStore() {
+		if err := m.actuator.DeleteInstallState(key, m.ClusterProvision, m.DynamicClient, m.log); err != nil {
+			m.log.WithError(err).Warn("error deleting install state from object store")
+		}
+	}
+	if err := m.deleteAnyExistingObject(name, &corev1.Secret{}); err != nil {
+		m.log.WithError(err).Warn("error deleting install state secret")
+	}
+}
+
+// recordInstallStateSave sets the InstallStateSaveFailed condition of the ClusterProvision when saving the install
+// state starts or stops failing.
+func (m *InstallManager) recordInstallStateSave(saveErr error) {
+	failed := false
+	if cond := controllerutils.FindCondition(m.ClusterProvision.Status.Conditions, hivev1.InstallStateSaveFailedCondition); cond != nil {
+		failed = cond.Status == corev1.ConditionTrue
+	}
+	if failed == (saveErr != nil) {
+		return
+	}
+	status, reason, message := corev1.ConditionFalse, "InstallStateSaved", "install state saved"
+	if saveErr != nil {
+		status, reason, message = corev1.ConditionTrue, "InstallStateSaveFailed", saveErr.Error()
+	}
+	if err := m.updateClusterProvisionStatus(
+		m,
+		func(provision *hivev1.ClusterProvision) {
+			provision.Status.Conditions = controllerutils.SetClusterProvisionCondition(
+				provision.Status.Conditions,
+				hivev1.InstallStateSaveFailedCondition,
+				status,
+				reason,
+				message,
+				controllerutils.UpdateConditionIfReasonOrMessageChange,
+			)
+		},
+	); err != nil {
+		m.log.WithError(err).Warning("error updating cluster provision with install state save condition")
+	}
+}
+
+// saveInstallStateWhileRunning saves the install state right away, then periodically as the stage of the install
+// advances, until the returned function is called. When the pod is terminated, it saves the install state and
+// interrupts the installer. The returned function stops saving, and returns true if the pod was terminated, after
+// saving the state the interrupted installer left.
+func (m *InstallManager) saveInstallStateWhileRunning(stage installStage, infraID string) func() bool {
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	terminated := make(chan os.Signal, 1)
+	signal.Notify(terminated, syscall.SIGTERM)
+	ctx, cancel := context.WithCancel(context.Background())
+	m.installCtx = ctx
+	wasTerminated := false
+
+	save := func() {
+		if installLog, err := os.ReadFile(filepath.Join(m.WorkDir, installerFullLogFile)); err == nil {
+			stage = detectInstallStage(string(installLog), stage)
+		}
+		err := m.saveInstallState(stage, infraID)
+		if err != nil {
+			// Not a fatal error, the install can still complete.
+			m.log.WithError(err).Warn("error saving install state")
+		}
+		m.recordInstallStateSave(err)
+	}
+
+	save()
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(installStateSaveInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				save()
+			case <-terminated:
+				m.log.Warn("install pod terminated, saving install state to resume from")
+				save()
+				wasTerminated = true
+				cancel()
+				return
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return func() bool {
+		signal.Stop(terminated)
+		close(stop)
+		<-stopped
+		cancel()
+		m.installCtx = context.Background()
+		if wasTerminated {
+			save()
+		}
+		return wasTerminated
+	}
+}
+
+// resumeCluster continues an install from the stage its saved state was at.
+func resumeCluster(m *InstallManager, stage installStage) error {
+	switch stage {
+	case installStageBootstrapComplete:
+		m.log.Info("running openshift-install destroy bootstrap")
+		if err := m.runOpenShiftInstallCommand("destroy", "bootstrap"); err != nil {
+			m.log.WithError(err).Error("error destroying bootstrap resources")
+			return err
+		}
+		fallthrough
+	case installStageBootstrapDestroyed:
+		m.log.Info("running openshift-install wait-for install-complete")
+		if err := m.runOpenShiftInstallCommand("wait-for", "install-complete"); err != nil {
+			m.log.WithError(err).Error("error waiting for install to complete")
+			return err
+		}
+		return nil
+	default:
+		return provisionCluster(m)
+	}
+}
+
+// tarInstallState returns a compressed tarball of the install state in the directory.
+func tarInstallState(dir string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if name == "." {
+			return nil
+		}
+		if contains(unsavedInstallStateFiles, name) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    filepath.ToSlash(name),
+			Mode:    0600,
+			Size:    int64(len(content)),
+			ModTime: time.Now(),
+		}); err != nil {
+			return err
+		}
+		_, err = tw.Write(content)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gzw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// untarInstallState writes the files of the install state tarball to the directory. The whole tarball is read before
+// any file is written, so that a corrupt tarball leaves the directory untouched.
+func untarInstallState(state []byte, dir string) error {
+	gzr, err := gzip.NewReader(bytes.NewReader(state))
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(gzr)
+	files := map[string][]byte{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		name := filepath.Clean(filepath.FromSlash(header.Name))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("invalid file name in install state: %s", header.Name)
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		files[name] = content
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, content, 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
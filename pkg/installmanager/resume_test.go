@@ -0,0 +1,329 @@
+package installmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/golang/mock/gomock"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	awsclient "github.com/openshift/hive/pkg/awsclient"
+	"github.com/openshift/hive/pkg/constants"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+)
+
+const (
+	testPrevProvisionName = "test-prev-provision"
+	testInfraID           = "test-cluster-fe9531"
+)
+
+func testResumableClusterDeployment() *hivev1.ClusterDeployment {
+	cd := testClusterDeployment()
+	cd.Spec.Provisioning.ResumeInterruptedInstall = true
+	return cd
+}
+
+func testClusterProvisionWithPrevInfraID() *hivev1.ClusterProvision {
+	provision := testClusterProvision()
+	provision.Spec.PrevProvisionName = pointer.String(testPrevProvisionName)
+	provision.Spec.PrevInfraID = pointer.String(testInfraID)
+	return provision
+}
+
+func testInstallStateSecret(t *testing.T, provisionName, infraID string, stage installStage) *corev1.Secret {
+	dir := t.TempDir()
+	files := map[string]string{
+		"terraform.tfstate":         "{}",
+		metadataRelativePath:        fmt.Sprintf(`{"clusterName":"test-cluster","infraID":%q,"clusterID":"fe953108-f64c-4166-bb8e-20da7665ba00"}`, infraID),
+		adminKubeConfigRelativePath: "fakekubeconfig\n",
+		adminPasswordRelativePath:   "fakepassword\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+	state, err := tarInstallState(dir)
+	require.NoError(t, err, "unexpected error archiving install state")
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf(installStateSecretStringTemplate, provisionName),
+			Namespace: testNamespace,
+		},
+		Data: map[string][]byte{
+			installStateSecretStateKey:   state,
+			installStateSecretStageKey:   []byte(stage),
+			installStateSecretInfraIDKey: []byte(infraID),
+		},
+	}
+}
+
+func TestDetectInstallStage(t *testing.T) {
+	tests := []struct {
+		name     string
+		log      string
+		current  installStage
+		expected installStage
+	}{
+		{
+			name:     "assets generated",
+			log:      `level=info msg="Creating infrastructure resources..."`,
+			current:  installStageAssetsGenerated,
+			expected: installStageAssetsGenerated,
+		},
+		{
+			name: "bootstrap complete",
+			log: `level=info msg="Waiting up to 30m0s (until 10:15AM) for bootstrapping to complete..."
+level=info msg="It is now safe to remove the bootstrap resources"
+level=info msg="Destroying the bootstrap resources..."`,
+			current:  installStageAssetsGenerated,
+			expected: installStageBootstrapComplete,
+		},
+		{
+			name: "bootstrap destroyed",
+			log: `level=info msg="It is now safe to remove the bootstrap resources"
+level=info msg="Destroying the bootstrap resources..."
+level=info msg="Waiting up to 40m0s (until 10:56AM) for the cluster at https://api.test.example.com:6443 to initialize..."`,
+			current:  installStageAssetsGenerated,
+			expected: installStageBootstrapDestroyed,
+		},
+		{
+			name:     "never goes back",
+			log:      `level=info msg="Consuming Install Config from target directory"`,
+			current:  installStageBootstrapComplete,
+			expected: installStageBootstrapComplete,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, detectInstallStage(test.log, test.current))
+		})
+	}
+}
+
+func TestInstallStateRoundTrip(t *testing.T) {
+	workDir := t.TempDir()
+	files := map[string]string{
+		".openshift_install_state.json": `{"*installconfig.ClusterID":{}}`,
+		"metadata.json":                 `{"infraID":"test-cluster-fe9531"}`,
+		"auth/kubeconfig":               "fakekubeconfig",
+		"terraform.tfstate":             "{}",
+		"openshift-install":             "binary",
+		"oc":                            "binary",
+		installerFullLogFile:            "log",
+		filepath.Join(archiveRelativeDir, "logs"): "staged",
+	}
+	for name, content := range files {
+		path := filepath.Join(workDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+
+	state, err := tarInstallState(workDir)
+	require.NoError(t, err, "unexpected error archiving install state")
+
+	restoreDir := t.TempDir()
+	require.NoError(t, untarInstallState(state, restoreDir), "unexpected error restoring install state")
+	for name, content := range files {
+		restored, err := os.ReadFile(filepath.Join(restoreDir, name))
+		if contains(unsavedInstallStateFiles, name) || filepath.Dir(name) == archiveRelativeDir {
+			assert.True(t, os.IsNotExist(err), "unexpected file %s in install state", name)
+			continue
+		}
+		if assert.NoError(t, err, "missing file %s in install state", name) {
+			assert.Equal(t, content, string(restored), "unexpected contents of %s", name)
+		}
+	}
+
+	assert.Error(t, untarInstallState([]byte("not a tarball"), restoreDir), "expected error restoring corrupt install state")
+}
+
+// writeRealisticInstallState writes files of the sizes an install usually has to the directory: a Terraform state of
+// several MiB that compresses about as well as a real one, and the ignition configs. It returns the files written.
+func writeRealisticInstallState(t *testing.T, dir string) map[string][]byte {
+	rnd := rand.New(rand.NewSource(1))
+	random := func(size int) []byte {
+		b := make([]byte, size/2)
+		rnd.Read(b)
+		return []byte(hex.EncodeToString(b))
+	}
+	files := map[string][]byte{
+		".openshift_install_state.json": random(512 * 1024),
+		"terraform.tfstate":             random(6 * 1024 * 1024),
+		"bootstrap.ign":                 random(300 * 1024),
+		"master.ign":                    random(2 * 1024),
+		"worker.ign":                    random(2 * 1024),
+		metadataRelativePath:            []byte(fmt.Sprintf(`{"clusterName":"test-cluster","infraID":%q}`, testInfraID)),
+		adminKubeConfigRelativePath:     random(8 * 1024),
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, content, 0600))
+	}
+	return files
+}
+
+func TestInstallStateInObjectStore(t *testing.T) {
+	provision := testClusterProvision()
+	mocks := setupDefaultMocks(t, provision)
+	t.Setenv(constants.InstallLogsUploadProviderEnvVar, constants.InstallLogsUploadProviderAWS)
+	t.Setenv(constants.InstallLogsCredentialsSecretRefEnvVar, "notarealsecret")
+	t.Setenv(constants.InstallLogsAWSRegionEnvVar, "region1")
+	t.Setenv(constants.InstallLogsAWSS3BucketEnvVar, "bucket1")
+
+	objects := map[string][]byte{}
+	mocks.mockAWSClient.EXPECT().Upload(gomock.Any()).
+		DoAndReturn(func(input *s3manager.UploadInput) (*s3manager.UploadOutput, error) {
+			body, err := io.ReadAll(input.Body)
+			if err != nil {
+				return nil, err
+			}
+			objects[aws.StringValue(input.Key)] = body
+			return &s3manager.UploadOutput{}, nil
+		}).AnyTimes()
+	mocks.mockAWSClient.EXPECT().GetObject(gomock.Any()).
+		DoAndReturn(func(input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+			body, ok := objects[aws.StringValue(input.Key)]
+			if !ok {
+				return nil, errors.New("no such key")
+			}
+			return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(body))}, nil
+		}).AnyTimes()
+	mocks.mockAWSClient.EXPECT().DeleteObject(gomock.Any()).
+		DoAndReturn(func(input *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error) {
+			delete(objects, aws.StringValue(input.Key))
+			return &s3.DeleteObjectOutput{}, nil
+		}).AnyTimes()
+
+	newInstallManager := func(workDir string) *InstallManager {
+		return &InstallManager{
+			WorkDir:              workDir,
+			ClusterName:          "test-cluster",
+			ClusterProvisionName: testProvisionName,
+			ClusterProvision:     provision,
+			Namespace:            testNamespace,
+			DynamicClient:        mocks.fakeKubeClient,
+			actuator: &s3LogUploaderActuator{awsClientFn: func(_ client.Client, _, _, _, _ string, _ log.FieldLogger) (awsclient.Client, error) {
+				return mocks.mockAWSClient, nil
+			}},
+			log: log.WithField("test", t.Name()),
+		}
+	}
+
+	workDir := t.TempDir()
+	files := writeRealisticInstallState(t, workDir)
+	im := newInstallManager(workDir)
+	require.NoError(t, im.saveInstallState(installStageBootstrapComplete, testInfraID), "unexpected error saving install state")
+
+	key := InstallStateKey("test-cluster", testNamespace)
+	require.Contains(t, objects, key, "expected install state to be uploaded")
+	assert.Greater(t, len(objects[key]), maxInstallStateSize, "expected realistic install state not to fit in a secret")
+	secret := &corev1.Secret{}
+	require.NoError(t, mocks.fakeKubeClient.Get(context.Background(),
+		types.NamespacedName{Namespace: testNamespace, Name: fmt.Sprintf(installStateSecretStringTemplate, testProvisionName)}, secret))
+	assert.Equal(t, key, string(secret.Data[installStateSecretObjectKey]), "unexpected object key in install state secret")
+	assert.NotContains(t, secret.Data, installStateSecretStateKey, "unexpected install state in install state secret")
+
+	restoreDir := t.TempDir()
+	stage, resuming, err := newInstallManager(restoreDir).restoreInstallState(testProvisionName, testInfraID)
+	require.NoError(t, err, "unexpected error restoring install state")
+	assert.True(t, resuming, "expected install state to be restored")
+	assert.Equal(t, installStageBootstrapComplete, stage, "unexpected stage of restored install state")
+	for name, content := range files {
+		restored, err := os.ReadFile(filepath.Join(restoreDir, name))
+		if assert.NoError(t, err, "missing file %s in install state", name) {
+			assert.True(t, bytes.Equal(content, restored), "unexpected contents of %s", name)
+		}
+	}
+
+	im.deleteInstallState()
+	assert.Empty(t, objects, "expected install state to be deleted from object store")
+	err = mocks.fakeKubeClient.Get(context.Background(),
+		types.NamespacedName{Namespace: testNamespace, Name: fmt.Sprintf(installStateSecretStringTemplate, testProvisionName)}, secret)
+	assert.True(t, apierrors.IsNotFound(err), "expected install state secret to be deleted: %v", err)
+}
+
+func TestInstallStateSaveFailedCondition(t *testing.T) {
+	provision := testClusterProvision()
+	mocks := setupDefaultMocks(t, provision)
+	workDir := t.TempDir()
+	writeRealisticInstallState(t, workDir)
+	im := &InstallManager{
+		WorkDir:                      workDir,
+		ClusterName:                  "test-cluster",
+		ClusterProvisionName:         testProvisionName,
+		ClusterProvision:             provision,
+		Namespace:                    testNamespace,
+		DynamicClient:                mocks.fakeKubeClient,
+		updateClusterProvisionStatus: updateClusterProvisionStatusWithRetries,
+		log:                          log.WithField("test", t.Name()),
+	}
+
+	assertCondition := func(expected corev1.ConditionStatus) {
+		actual := &hivev1.ClusterProvision{}
+		require.NoError(t, mocks.fakeKubeClient.Get(context.Background(), types.NamespacedName{Namespace: testNamespace, Name: testProvisionName}, actual))
+		cond := controllerutils.FindCondition(actual.Status.Conditions, hivev1.InstallStateSaveFailedCondition)
+		if assert.NotNil(t, cond, "missing InstallStateSaveFailed condition") {
+			assert.Equal(t, expected, cond.Status, "unexpected status of InstallStateSaveFailed condition")
+		}
+	}
+
+	// Without an object store, the realistic install state does not fit in the install state secret.
+	stopSaving := im.saveInstallStateWhileRunning(installStageAssetsGenerated, testInfraID)
+	assert.False(t, stopSaving(), "unexpected termination")
+	assertCondition(corev1.ConditionTrue)
+
+	require.NoError(t, os.Remove(filepath.Join(workDir, "terraform.tfstate")))
+	stopSaving = im.saveInstallStateWhileRunning(installStageAssetsGenerated, testInfraID)
+	assert.False(t, stopSaving(), "unexpected termination")
+	assertCondition(corev1.ConditionFalse)
+}
+
+func TestSaveInstallStateWhileRunningTerminated(t *testing.T) {
+	mocks := setupDefaultMocks(t, testClusterProvision())
+	im := &InstallManager{
+		WorkDir:                      t.TempDir(),
+		ClusterProvisionName:         testProvisionName,
+		ClusterProvision:             testClusterProvision(),
+		Namespace:                    testNamespace,
+		DynamicClient:                mocks.fakeKubeClient,
+		updateClusterProvisionStatus: updateClusterProvisionStatusWithRetries,
+		log:                          log.WithField("test", t.Name()),
+	}
+
+	stopSaving := im.saveInstallStateWhileRunning(installStageAssetsGenerated, testInfraID)
+	installCtx := im.installCtx
+	require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGTERM))
+	require.Eventually(t, func() bool { return installCtx.Err() != nil }, 10*time.Second, 10*time.Millisecond,
+		"expected installer to be interrupted")
+	assert.True(t, stopSaving(), "expected termination")
+
+	secret := &corev1.Secret{}
+	assert.NoError(t, mocks.fakeKubeClient.Get(context.Background(),
+		types.NamespacedName{Namespace: testNamespace, Name: fmt.Sprintf(installStateSecretStringTemplate, testProvisionName)}, secret),
+		"expected install state to be saved")
+}
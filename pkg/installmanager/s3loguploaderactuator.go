@@ -1,10 +1,12 @@
 package installmanager
 
 import (
-	"fmt"
+	"bytes"
+	"io"
 	"os"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	log "github.com/sirupsen/logrus"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -14,16 +16,18 @@ import (
 	"github.com/openshift/hive/pkg/constants"
 
 	"github.com/pkg/errors"
-	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 )
 
+// defaultS3Region is the region used when FailedProvisionAWSConfig.Region is not set.
+const defaultS3Region = "us-east-1"
+
 // Ensure s3LogUploaderActuator implements the Actuator interface. This will fail at compile time when false.
 var _ LogUploaderActuator = &s3LogUploaderActuator{}
 
 // s3LogUploaderActuator manages getting the desired state, getting the current state and reconciling the two.
 type s3LogUploaderActuator struct {
 	// awsClientFn is the function to build an AWS client, here for lazy loading the client.
-	awsClientFn func(client.Client, string, string, string, log.FieldLogger) (awsclient.Client, error)
+	awsClientFn func(c client.Client, secretName, namespace, region, serviceEndpoint string, logger log.FieldLogger) (awsclient.Client, error)
 }
 
 // IsConfigured returns true if the actuator can handle a particular ClusterDeprovision
@@ -38,65 +42,131 @@ func (a *s3LogUploaderActuator) IsConfigured() bool {
 }
 
 // UploadLogs uploads installer logs to the provider's storage mechanism.
-func (a *s3LogUploaderActuator) UploadLogs(clusterName string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger, filenames ...string) error {
-	secretName, foundSecretName := os.LookupEnv(constants.InstallLogsCredentialsSecretRefEnvVar)
-	if !foundSecretName {
-		return errors.New("couldn't find secret name in environment variable. Skipping upload")
+func (a *s3LogUploaderActuator) UploadLogs(clusterName string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger, filenames ...string) ([]string, error) {
+	awsc, bucket, err := a.loadClient(clusterprovision, c, log)
+	if err != nil {
+		return nil, err
 	}
 
-	region, foundRegionEnvVar := os.LookupEnv(constants.InstallLogsAWSRegionEnvVar)
-	if !foundRegionEnvVar {
-		return errors.New("couldn't find region in environment variable. Skipping upload")
-	}
+	folder := logFolder(clusterName, clusterprovision)
 
-	bucket, foundBucketEnvVar := os.LookupEnv(constants.InstallLogsAWSS3BucketEnvVar)
-	if !foundBucketEnvVar {
-		return errors.New("couldn't find bucket in environment variable. Skipping upload")
+	log.Infof("Uploading log(s) to S3: s3://%v/%v/", bucket, folder)
+
+	return uploadFiles(a.upload(awsc, bucket), func(stat os.FileInfo) string {
+		return logKey(folder, clusterprovision, stat)
+	}, filenames...)
+}
+
+// ArchiveLogs uploads the files into the folder of the provider's storage mechanism.
+func (a *s3LogUploaderActuator) ArchiveLogs(folder string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger, filenames ...string) ([]string, error) {
+	awsc, bucket, err := a.loadClient(clusterprovision, c, log)
+	if err != nil {
+		return nil, err
 	}
 
-	awsc, err := a.awsClientFn(c, secretName, clusterprovision.Namespace, region, log)
+	log.Infof("Archiving file(s) to S3: s3://%v/%v/", bucket, folder)
+
+	return uploadFiles(a.upload(awsc, bucket), func(stat os.FileInfo) string {
+		return folder + "/" + stat.Name()
+	}, filenames...)
+}
+
+// SaveInstallState uploads the install state to the key of the provider's storage mechanism.
+func (a *s3LogUploaderActuator) SaveInstallState(key string, state []byte, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger) error {
+	awsc, bucket, err := a.loadClient(clusterprovision, c, log)
 	if err != nil {
 		return err
 	}
+	_, err = awsc.Upload(&s3manager.UploadInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(state),
+	})
+	return err
+}
 
-	retvalErrs := []error{}
+// LoadInstallState downloads the install state saved to the key of the provider's storage mechanism.
+func (a *s3LogUploaderActuator) LoadInstallState(key string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger) ([]byte, error) {
+	awsc, bucket, err := a.loadClient(clusterprovision, c, log)
+	if err != nil {
+		return nil, err
+	}
+	out, err := awsc.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer out.Body.Close()
+	return io.ReadAll(out.Body)
+}
 
-	folder := fmt.Sprintf("%v-%v", clusterName, clusterprovision.Namespace)
+// DeleteInstallState deletes the install state saved to the key of the provider's storage mechanism.
+func (a *s3LogUploaderActuator) DeleteInstallState(key string, clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger) error {
+	awsc, bucket, err := a.loadClient(clusterprovision, c, log)
+	if err != nil {
+		return err
+	}
+	_, err = awsc.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
 
-	log.Infof("Uploading log(s) to S3: s3://%v/%v/", bucket, folder)
+// loadClient builds the AWS client from the environment, returning it along with the bucket to use.
+func (a *s3LogUploaderActuator) loadClient(clusterprovision *hivev1.ClusterProvision, c client.Client, log log.FieldLogger) (awsclient.Client, string, error) {
+	secretName, foundSecretName := os.LookupEnv(constants.InstallLogsCredentialsSecretRefEnvVar)
+	if !foundSecretName {
+		return nil, "", errors.New("couldn't find secret name in environment variable. Skipping upload")
+	}
 
-	for _, filename := range filenames {
-		file, err := os.Open(filename)
-		if err != nil {
-			retvalErrs = append(retvalErrs, errors.Wrapf(err, "Failed opening log file: %v", filename))
-			continue
-		}
-		defer file.Close()
+	region, foundRegionEnvVar := os.LookupEnv(constants.InstallLogsAWSRegionEnvVar)
+	if !foundRegionEnvVar {
+		return nil, "", errors.New("couldn't find region in environment variable. Skipping upload")
+	}
+	if region == "" {
+		region = defaultS3Region
+	}
 
-		stat, err := file.Stat()
-		if err != nil {
-			retvalErrs = append(retvalErrs, errors.Wrapf(err, "Failed stat on log file: %v", filename))
-			continue
-		}
+	bucket, foundBucketEnvVar := os.LookupEnv(constants.InstallLogsAWSS3BucketEnvVar)
+	if !foundBucketEnvVar {
+		return nil, "", errors.New("couldn't find bucket in environment variable. Skipping upload")
+	}
+
+	// An empty endpoint means AWS S3 itself.
+	serviceEndpoint := os.Getenv(constants.InstallLogsAWSServiceEndpointEnvVar)
 
-		logkey := fmt.Sprintf("%v/%v-%v", folder, clusterprovision.Name, stat.Name())
+	awsc, err := a.awsClientFn(c, secretName, clusterprovision.Namespace, region, serviceEndpoint, log)
+	if err != nil {
+		return nil, "", err
+	}
+	return awsc, bucket, nil
+}
 
-		_, err = awsc.Upload(&s3manager.UploadInput{
+func (a *s3LogUploaderActuator) upload(awsc awsclient.Client, bucket string) func(string, *os.File, int64) (string, error) {
+	return func(key string, file *os.File, _ int64) (string, error) {
+		out, err := awsc.Upload(&s3manager.UploadInput{
 			Bucket: aws.String(bucket),
-			Key:    aws.String(logkey),
+			Key:    aws.String(key),
 			Body:   file,
 		})
-
 		if err != nil {
-			retvalErrs = append(retvalErrs, errors.Wrapf(err, "Failed uploading log file: %v", filename))
+			return "", err
 		}
+		return out.Location, nil
 	}
-
-	return utilerrors.NewAggregate(retvalErrs)
 }
 
-func getAWSClient(c client.Client, secretName, namespace, region string, logger log.FieldLogger) (awsclient.Client, error) {
-	awsClient, err := awsclient.NewClient(c, secretName, namespace, region)
+func getAWSClient(c client.Client, secretName, namespace, region, serviceEndpoint string, logger log.FieldLogger) (awsclient.Client, error) {
+	var awsClient awsclient.Client
+	var err error
+	if serviceEndpoint != "" {
+		awsClient, err = awsclient.NewS3CompatibleClient(c, secretName, namespace, region, serviceEndpoint)
+	} else {
+		awsClient, err = awsclient.NewClient(c, secretName, namespace, region)
+	}
 	if err != nil {
 		logger.WithError(err).Error("failed to get AWS client")
 	}
@@ -1,9 +1,12 @@
 package installmanager
 
 import (
+	"errors"
 	"os"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/golang/mock/gomock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -27,7 +30,9 @@ func TestUploadLogs(t *testing.T) {
 		putObjectError          error
 		setupPutObjectMock      bool
 		setupEnvVars            bool
+		serviceEndpoint         string
 		expectedUploadLogsError bool
+		expectedURLs            []string
 	}{
 		{
 			name:                    "missing env vars",
@@ -39,6 +44,23 @@ func TestUploadLogs(t *testing.T) {
 			existing:           []runtime.Object{},
 			setupPutObjectMock: true,
 			setupEnvVars:       true,
+			expectedURLs:       []string{"https://bucket1.s3.region1.amazonaws.com/notarealcluster-test-namespace/test-provision-issue"},
+		},
+		{
+			name:               "successfully upload objects to S3 compatible store",
+			existing:           []runtime.Object{},
+			setupPutObjectMock: true,
+			setupEnvVars:       true,
+			serviceEndpoint:    "https://minio.example.com",
+			expectedURLs:       []string{"https://bucket1.s3.region1.amazonaws.com/notarealcluster-test-namespace/test-provision-issue"},
+		},
+		{
+			name:                    "failed upload",
+			existing:                []runtime.Object{},
+			putObjectError:          errors.New("upload failed"),
+			setupPutObjectMock:      true,
+			setupEnvVars:            true,
+			expectedUploadLogsError: true,
 		},
 	}
 	for _, test := range tests {
@@ -54,20 +76,26 @@ func TestUploadLogs(t *testing.T) {
 				os.Setenv(constants.InstallLogsCredentialsSecretRefEnvVar, "notarealsecret")
 				os.Setenv(constants.InstallLogsAWSRegionEnvVar, "region1")
 				os.Setenv(constants.InstallLogsAWSS3BucketEnvVar, "bucket1")
+				os.Setenv(constants.InstallLogsAWSServiceEndpointEnvVar, test.serviceEndpoint)
 			}
 			if test.setupPutObjectMock {
+				var output *s3manager.UploadOutput
+				if test.putObjectError == nil {
+					output = &s3manager.UploadOutput{Location: "https://bucket1.s3.region1.amazonaws.com/notarealcluster-test-namespace/test-provision-issue"}
+				}
 				mocks.mockAWSClient.EXPECT().
 					Upload(gomock.Any()).
-					Return(nil, test.putObjectError)
+					Return(output, test.putObjectError)
 			}
 
-			actuator := &s3LogUploaderActuator{awsClientFn: func(client.Client, string, string, string, log.FieldLogger) (awsclient.Client, error) {
+			actuator := &s3LogUploaderActuator{awsClientFn: func(_ client.Client, _, _, _, serviceEndpoint string, _ log.FieldLogger) (awsclient.Client, error) {
+				assert.Equal(t, test.serviceEndpoint, serviceEndpoint, "unexpected service endpoint")
 				return mocks.mockAWSClient, nil
 			}}
 			provision := testClusterProvision()
 
 			// Act
-			err := actuator.UploadLogs("notarealcluster", provision, mocks.fakeKubeClient, log.New(), "/etc/issue")
+			urls, err := actuator.UploadLogs("notarealcluster", provision, mocks.fakeKubeClient, log.New(), "/etc/issue")
 
 			// Assert
 			if test.expectedUploadLogsError {
@@ -75,13 +103,52 @@ func TestUploadLogs(t *testing.T) {
 			} else {
 				assert.NoError(t, err, "Function errored unexpectedly")
 			}
+			assert.Equal(t, test.expectedURLs, nonNilURLs(urls), "unexpected log URLs")
 
 			if test.setupEnvVars {
 				os.Unsetenv(constants.InstallLogsUploadProviderEnvVar)
 				os.Unsetenv(constants.InstallLogsCredentialsSecretRefEnvVar)
 				os.Unsetenv(constants.InstallLogsAWSRegionEnvVar)
 				os.Unsetenv(constants.InstallLogsAWSS3BucketEnvVar)
+				os.Unsetenv(constants.InstallLogsAWSServiceEndpointEnvVar)
 			}
 		})
 	}
 }
+
+// nonNilURLs maps an empty list of URLs to nil so that it compares equal to an unset expectation.
+func nonNilURLs(urls []string) []string {
+	if len(urls) == 0 {
+		return nil
+	}
+	return urls
+}
+
+func TestArchiveLogs(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	os.Setenv(constants.InstallLogsCredentialsSecretRefEnvVar, "notarealsecret")
+	os.Setenv(constants.InstallLogsAWSRegionEnvVar, "region1")
+	os.Setenv(constants.InstallLogsAWSS3BucketEnvVar, "bucket1")
+	defer func() {
+		os.Unsetenv(constants.InstallLogsCredentialsSecretRefEnvVar)
+		os.Unsetenv(constants.InstallLogsAWSRegionEnvVar)
+		os.Unsetenv(constants.InstallLogsAWSS3BucketEnvVar)
+	}()
+
+	mocks.mockAWSClient.EXPECT().
+		Upload(gomock.Any()).
+		DoAndReturn(func(input *s3manager.UploadInput) (*s3manager.UploadOutput, error) {
+			assert.Equal(t, "bucket1", aws.StringValue(input.Bucket), "unexpected bucket")
+			assert.Equal(t, "archive/notarealcluster-test-namespace/attempts/2/issue", aws.StringValue(input.Key), "unexpected key")
+			return &s3manager.UploadOutput{Location: "https://bucket1.s3.region1.amazonaws.com/" + aws.StringValue(input.Key)}, nil
+		})
+
+	actuator := &s3LogUploaderActuator{awsClientFn: func(_ client.Client, _, _, _, _ string, _ log.FieldLogger) (awsclient.Client, error) {
+		return mocks.mockAWSClient, nil
+	}}
+
+	urls, err := actuator.ArchiveLogs(ArchiveFolder("notarealcluster", "test-namespace", 2), testClusterProvision(), mocks.fakeKubeClient, log.New(), "/etc/issue")
+
+	assert.NoError(t, err, "unexpected error archiving logs")
+	assert.Equal(t, []string{"https://bucket1.s3.region1.amazonaws.com/archive/notarealcluster-test-namespace/attempts/2/issue"}, urls, "unexpected archive URLs")
+}
@@ -0,0 +1,149 @@
+package installmanager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/awsclient"
+)
+
+// zonePlatforms are the install config platforms whose machine pools have zones.
+var zonePlatforms = []string{"aws", "gcp", "azure"}
+
+// excludeZones removes the zones excluded from the ClusterProvision from the install config.
+func (m *InstallManager) excludeZones(icData []byte, cd *hivev1.ClusterDeployment) ([]byte, error) {
+	awsZones := func() ([]string, error) {
+		if cd.Spec.Platform.AWS == nil {
+			return nil, errors.New("not an AWS cluster")
+		}
+		awsClient, err := awsclient.NewClientFromSecret(nil, cd.Spec.Platform.AWS.Region)
+		if err != nil {
+			return nil, err
+		}
+		return availableAWSZones(awsClient)
+	}
+	return excludeZonesFromInstallConfig(icData, m.ClusterProvision.Spec.ExcludedZones, awsZones, m.log)
+}
+
+// excludeZonesFromInstallConfig removes the excluded zones from the zones of the machine pools of the install config.
+// If no machine pool lists zones, all zones of the region are used, which can only be excluded on AWS, where the
+// available zones are listed with awsZones and set as the zones of the default machine platform. Zones are left
+// unchanged where excluding them would leave none.
+func excludeZonesFromInstallConfig(icData []byte, excluded []string, awsZones func() ([]string, error), logger log.FieldLogger) ([]byte, error) {
+	ic := map[string]interface{}{}
+	if err := yaml.Unmarshal(icData, &ic); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal InstallConfig")
+	}
+	platforms, _ := ic["platform"].(map[string]interface{})
+	platform := ""
+	for _, p := range zonePlatforms {
+		if _, ok := platforms[p]; ok {
+			platform = p
+			break
+		}
+	}
+	if platform == "" {
+		logger.Warn("excluding zones is not supported on the platform of the cluster")
+		return icData, nil
+	}
+
+	// The platform sections of the machine pools, which may hold zones.
+	pools := []map[string]interface{}{}
+	addPool := func(pool interface{}, path ...string) {
+		for _, key := range path {
+			m, ok := pool.(map[string]interface{})
+			if !ok {
+				return
+			}
+			pool = m[key]
+		}
+		if m, ok := pool.(map[string]interface{}); ok {
+			pools = append(pools, m)
+		}
+	}
+	addPool(ic["controlPlane"], "platform", platform)
+	if compute, ok := ic["compute"].([]interface{}); ok {
+		for _, pool := range compute {
+			addPool(pool, "platform", platform)
+		}
+	}
+	addPool(platforms[platform], "defaultMachinePlatform")
+
+	found := false
+	for _, pool := range pools {
+		zones, ok := pool["zones"].([]interface{})
+		if !ok || len(zones) == 0 {
+			continue
+		}
+		found = true
+		remaining := []interface{}{}
+		for _, z := range zones {
+			if zone, _ := z.(string); !contains(excluded, zone) {
+				remaining = append(remaining, z)
+			}
+		}
+		if len(remaining) == 0 {
+			logger.WithField("zones", zones).Warn("not excluding zones, as no zones of the machine pool would be left")
+			continue
+		}
+		pool["zones"] = remaining
+	}
+
+	if !found {
+		if platform != "aws" {
+			logger.Warn("cannot exclude zones as the install config does not list the zones of its machine pools")
+			return icData, nil
+		}
+		available, err := awsZones()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not list the zones of the region")
+		}
+		remaining := []interface{}{}
+		for _, zone := range available {
+			if !contains(excluded, zone) {
+				remaining = append(remaining, zone)
+			}
+		}
+		if len(remaining) == 0 {
+			logger.WithField("zones", available).Warn("not excluding zones, as no zones of the region would be left")
+			return icData, nil
+		}
+		awsPlatform, _ := platforms[platform].(map[string]interface{})
+		if awsPlatform == nil {
+			awsPlatform = map[string]interface{}{}
+			platforms[platform] = awsPlatform
+		}
+		defaultMachinePlatform, _ := awsPlatform["defaultMachinePlatform"].(map[string]interface{})
+		if defaultMachinePlatform == nil {
+			defaultMachinePlatform = map[string]interface{}{}
+			awsPlatform["defaultMachinePlatform"] = defaultMachinePlatform
+		}
+		defaultMachinePlatform["zones"] = remaining
+	}
+
+	logger.WithField("excludedZones", excluded).Info("excluded zones from install config")
+	return yaml.Marshal(ic)
+}
+
+// availableAWSZones lists the available availability zones of the region of the client. Local and wavelength zones
+// are left out, as the installer does.
+func availableAWSZones(awsClient awsclient.Client) ([]string, error) {
+	out, err := awsClient.DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("state"), Values: aws.StringSlice([]string{"available"})},
+			{Name: aws.String("zone-type"), Values: aws.StringSlice([]string{"availability-zone"})},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	zones := []string{}
+	for _, z := range out.AvailabilityZones {
+		zones = append(zones, aws.StringValue(z.ZoneName))
+	}
+	return zones, nil
+}
@@ -0,0 +1,170 @@
+package installmanager
+
+import (
+	"errors"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+func TestExcludeZonesFromInstallConfig(t *testing.T) {
+	tests := []struct {
+		name             string
+		installConfig    string
+		excluded         []string
+		awsZones         []string
+		awsZonesErr      error
+		expectErr        bool
+		expectUnchanged  bool
+		expectedZones    map[string][]string
+		expectAWSLookups int
+	}{
+		{
+			name: "zones of machine pools",
+			installConfig: `
+controlPlane:
+  platform:
+    aws:
+      zones: [us-east-1a, us-east-1b, us-east-1c]
+compute:
+- name: worker
+  platform:
+    aws:
+      zones: [us-east-1b, us-east-1c]
+platform:
+  aws:
+    region: us-east-1
+`,
+			excluded: []string{"us-east-1b"},
+			expectedZones: map[string][]string{
+				"controlPlane": {"us-east-1a", "us-east-1c"},
+				"compute":      {"us-east-1c"},
+			},
+		},
+		{
+			name: "pool left with no zones unchanged",
+			installConfig: `
+compute:
+- name: worker
+  platform:
+    gcp:
+      zones: [us-east1-b]
+platform:
+  gcp:
+    region: us-east1
+    defaultMachinePlatform:
+      zones: [us-east1-b, us-east1-c]
+`,
+			excluded: []string{"us-east1-b"},
+			expectedZones: map[string][]string{
+				"compute":                {"us-east1-b"},
+				"defaultMachinePlatform": {"us-east1-c"},
+			},
+		},
+		{
+			name: "no zones listed on AWS",
+			installConfig: `
+platform:
+  aws:
+    region: us-east-1
+`,
+			excluded: []string{"us-east-1b"},
+			awsZones: []string{"us-east-1a", "us-east-1b", "us-east-1c"},
+			expectedZones: map[string][]string{
+				"defaultMachinePlatform": {"us-east-1a", "us-east-1c"},
+			},
+			expectAWSLookups: 1,
+		},
+		{
+			name: "no zones listed on AWS, all excluded",
+			installConfig: `
+platform:
+  aws:
+    region: us-east-1
+`,
+			excluded:         []string{"us-east-1a"},
+			awsZones:         []string{"us-east-1a"},
+			expectUnchanged:  true,
+			expectAWSLookups: 1,
+		},
+		{
+			name: "no zones listed on AWS, lookup fails",
+			installConfig: `
+platform:
+  aws:
+    region: us-east-1
+`,
+			excluded:         []string{"us-east-1a"},
+			awsZonesErr:      errors.New("boom"),
+			expectErr:        true,
+			expectAWSLookups: 1,
+		},
+		{
+			name: "no zones listed on Azure",
+			installConfig: `
+platform:
+  azure:
+    region: eastus
+`,
+			excluded:        []string{"1"},
+			expectUnchanged: true,
+		},
+		{
+			name: "unsupported platform",
+			installConfig: `
+platform:
+  vsphere:
+    vCenter: vcenter.example.com
+`,
+			excluded:        []string{"a"},
+			expectUnchanged: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lookups := 0
+			awsZones := func() ([]string, error) {
+				lookups++
+				return test.awsZones, test.awsZonesErr
+			}
+			icData, err := excludeZonesFromInstallConfig([]byte(test.installConfig), test.excluded, awsZones, log.WithField("test", t.Name()))
+			assert.Equal(t, test.expectAWSLookups, lookups, "unexpected number of AWS zone lookups")
+			if test.expectErr {
+				assert.Error(t, err, "expected error")
+				return
+			}
+			require.NoError(t, err, "unexpected error")
+			if test.expectUnchanged {
+				assert.Equal(t, test.installConfig, string(icData), "expected unchanged install config")
+				return
+			}
+
+			ic := map[string]interface{}{}
+			require.NoError(t, yaml.Unmarshal(icData, &ic), "install config does not parse")
+			platforms := ic["platform"].(map[string]interface{})
+			var platform string
+			for p := range platforms {
+				platform = p
+			}
+			for pool, expected := range test.expectedZones {
+				var section interface{}
+				switch pool {
+				case "controlPlane":
+					section = ic["controlPlane"].(map[string]interface{})["platform"].(map[string]interface{})[platform]
+				case "compute":
+					section = ic["compute"].([]interface{})[0].(map[string]interface{})["platform"].(map[string]interface{})[platform]
+				case "defaultMachinePlatform":
+					section = platforms[platform].(map[string]interface{})["defaultMachinePlatform"]
+				}
+				zones := []string{}
+				for _, z := range section.(map[string]interface{})["zones"].([]interface{}) {
+					zones = append(zones, z.(string))
+				}
+				assert.Equal(t, expected, zones, "unexpected zones of %s", pool)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package hive
 
 import (
 	"context"
+	"strings"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -12,6 +13,7 @@ import (
 	"k8s.io/utils/pointer"
 
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/constants"
 	"github.com/openshift/hive/pkg/controller/images"
 	controllerutils "github.com/openshift/hive/pkg/controller/utils"
 	"github.com/openshift/hive/pkg/operator/assets"
@@ -85,6 +87,29 @@ func (r *ReconcileHiveConfig) deployClusterSync(hLog log.FieldLogger, h resource
 		hiveContainer.Env = append(hiveContainer.Env, syncsetReapplyIntervalEnvVar)
 	}
 
+	if vaultAddresses := hiveconfig.Spec.SyncSetVaultAddresses; len(vaultAddresses) > 0 {
+		hiveContainer.Env = append(hiveContainer.Env, corev1.EnvVar{
+			Name:  constants.SyncSetVaultAddressesEnvVar,
+			Value: strings.Join(vaultAddresses, ","),
+		})
+	}
+
+	// Mount the volume holding the secrets of the file secret sources of SelectorSyncSets.
+	if secretsVolume := hiveconfig.Spec.SyncSetSecretsVolume; secretsVolume != nil {
+		podSpec := &newClusterSyncStatefulSet.Spec.Template.Spec
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: "syncset-secrets",
+			VolumeSource: corev1.VolumeSource{
+				CSI: secretsVolume,
+			},
+		})
+		hiveContainer.VolumeMounts = append(hiveContainer.VolumeMounts, corev1.VolumeMount{
+			Name:      "syncset-secrets",
+			MountPath: constants.SyncSetSecretsDir,
+			ReadOnly:  true,
+		})
+	}
+
 	hiveNSName := GetHiveNamespace(hiveconfig)
 
 	// Load namespaced assets, decode them, set to our target namespace, and apply:
@@ -137,6 +137,20 @@ func (r *ReconcileHiveConfig) deployHive(hLog log.FieldLogger, h resource.Helper
 		hiveContainer.Env = append(hiveContainer.Env, syncsetReapplyIntervalEnvVar)
 	}
 
+	if insecureRegistries := instance.Spec.SyncSetInsecureRegistries; len(insecureRegistries) > 0 {
+		hiveContainer.Env = append(hiveContainer.Env, corev1.EnvVar{
+			Name:  constants.SyncSetInsecureRegistriesEnvVar,
+			Value: strings.Join(insecureRegistries, ","),
+		})
+	}
+
+	if helmChartRepoHosts := instance.Spec.SyncSetHelmChartRepoHosts; len(helmChartRepoHosts) > 0 {
+		hiveContainer.Env = append(hiveContainer.Env, corev1.EnvVar{
+			Name:  constants.SyncSetHelmChartRepoHostsEnvVar,
+			Value: strings.Join(helmChartRepoHosts, ","),
+		})
+	}
+
 	addConfigVolume(&hiveDeployment.Spec.Template.Spec, managedDomainsConfigMapInfo, hiveContainer)
 	addConfigVolume(&hiveDeployment.Spec.Template.Spec, awsPrivateLinkConfigMapInfo, hiveContainer)
 	addConfigVolume(&hiveDeployment.Spec.Template.Spec, failedProvisionConfigMapInfo, hiveContainer)
@@ -146,10 +160,10 @@ func (r *ReconcileHiveConfig) deployHive(hLog log.FieldLogger, h resource.Helper
 	// It would be neat if it did that purely based on the FailedProvisionConfig ConfigMap, to
 	// which it does have access, but that code path is shared by other things that need the
 	// same copied secret.
-	if awsSpec := instance.Spec.FailedProvisionConfig.AWS; awsSpec != nil {
+	if secretName := failedProvisionCredentialsSecretName(instance.Spec.FailedProvisionConfig); secretName != "" {
 		hiveContainer.Env = append(hiveContainer.Env, corev1.EnvVar{
 			Name:  constants.InstallLogsCredentialsSecretRefEnvVar,
-			Value: awsSpec.CredentialsSecretRef.Name,
+			Value: secretName,
 		})
 	}
 
@@ -564,3 +578,17 @@ func (r *ReconcileHiveConfig) discoverProxyVars() (string, string, string, error
 	}
 	return proxy.Status.HTTPProxy, proxy.Status.HTTPSProxy, proxy.Status.NoProxy, nil
 }
+
+// failedProvisionCredentialsSecretName returns the name of the secret with the credentials used to upload the logs
+// of failed provisions, honoring the same precedence among providers as the clusterdeployment controller.
+func failedProvisionCredentialsSecretName(config hivev1.FailedProvisionConfig) string {
+	switch {
+	case config.AWS != nil:
+		return config.AWS.CredentialsSecretRef.Name
+	case config.GCP != nil:
+		return config.GCP.CredentialsSecretRef.Name
+	case config.Azure != nil:
+		return config.Azure.CredentialsSecretRef.Name
+	}
+	return ""
+}
@@ -42,10 +42,16 @@ const (
 
 // Apply applies the given resource bytes to the target cluster specified by kubeconfig
 func (r *helper) Apply(obj []byte) (ApplyResult, error) {
+	result, _, err := r.apply(obj)
+	return result, err
+}
+
+// apply applies the given resource bytes to the target cluster, returning the object that resulted.
+func (r *helper) apply(obj []byte) (ApplyResult, runtime.Object, error) {
 	factory, err := r.getFactory("")
 	if err != nil {
 		r.logger.WithError(err).Error("failed to obtain factory for apply")
-		return "", err
+		return "", nil, err
 	}
 	ioStreams := genericclioptions.IOStreams{
 		In:     &bytes.Buffer{},
@@ -55,7 +61,7 @@ func (r *helper) Apply(obj []byte) (ApplyResult, error) {
 	applyOptions, changeTracker, err := r.setupApplyCommand(factory, obj, ioStreams)
 	if err != nil {
 		r.logger.WithError(err).Error("failed to setup apply command")
-		return "", err
+		return "", nil, err
 	}
 
 	err = applyOptions.Run()
@@ -63,9 +69,9 @@ func (r *helper) Apply(obj []byte) (ApplyResult, error) {
 		r.logger.WithError(err).
 			WithField("stdout", ioStreams.Out.(*bytes.Buffer).String()).
 			WithField("stderr", ioStreams.ErrOut.(*bytes.Buffer).String()).Warn("running the apply command failed")
-		return "", err
+		return "", nil, err
 	}
-	return changeTracker.GetResult(), nil
+	return changeTracker.GetResult(), changeTracker.object, nil
 }
 
 // ApplyRuntimeObject serializes an object and applies it to the target cluster specified by the kubeconfig.
@@ -86,7 +92,7 @@ func (r *helper) CreateOrUpdate(obj []byte) (ApplyResult, error) {
 	}
 
 	errOut := &bytes.Buffer{}
-	result, err := r.createOrUpdate(factory, obj, errOut)
+	result, _, err := r.createOrUpdate(factory, obj, errOut)
 	if err != nil {
 		r.logger.WithError(err).
 			WithField("stderr", errOut.String()).Warn("running the apply command failed")
@@ -110,7 +116,7 @@ func (r *helper) Create(obj []byte) (ApplyResult, error) {
 		r.logger.WithError(err).Error("failed to obtain factory for apply")
 		return "", err
 	}
-	result, err := r.createOnly(factory, obj)
+	result, _, err := r.createOnly(factory, obj)
 	if err != nil {
 		r.logger.WithError(err).Warn("running the create command failed")
 		return "", err
@@ -127,17 +133,17 @@ func (r *helper) CreateRuntimeObject(obj runtime.Object, scheme *runtime.Scheme)
 	return r.Create(data)
 }
 
-func (r *helper) createOnly(f cmdutil.Factory, obj []byte) (ApplyResult, error) {
+func (r *helper) createOnly(f cmdutil.Factory, obj []byte) (ApplyResult, runtime.Object, error) {
 	info, err := r.getResourceInternalInfo(f, obj)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 	if info == nil {
 		r.logger.Debug("err getting info")
 	}
 	c, err := f.DynamicClient()
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 	// Name may be empty if the object wants to use GenerateName. In this case we don't check
 	// whether the object already exists -- GenerateName indicates we always want to create a
@@ -145,64 +151,72 @@ func (r *helper) createOnly(f cmdutil.Factory, obj []byte) (ApplyResult, error)
 	if info.Name != "" {
 		err = info.Get()
 		if err == nil {
-			return UnchangedApplyResult, nil
+			return UnchangedApplyResult, info.Object, nil
 		}
 		if !errors.IsNotFound(err) {
-			return "", err
+			return "", nil, err
 		}
 	}
 	// Object doesn't exist yet, create it
 	gvr := info.ResourceMapping().Resource
-	_, err = c.Resource(gvr).Namespace(info.Namespace).Create(context.TODO(), info.Object.(*unstructured.Unstructured), metav1.CreateOptions{})
+	created, err := c.Resource(gvr).Namespace(info.Namespace).Create(context.TODO(), info.Object.(*unstructured.Unstructured), r.createOptions())
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
-	return CreatedApplyResult, nil
+	return CreatedApplyResult, created, nil
 }
 
-func (r *helper) createOrUpdate(f cmdutil.Factory, obj []byte, errOut io.Writer) (ApplyResult, error) {
+func (r *helper) createOrUpdate(f cmdutil.Factory, obj []byte, errOut io.Writer) (ApplyResult, runtime.Object, error) {
 	info, err := r.getResourceInternalInfo(f, obj)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 	c, err := f.DynamicClient()
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 	sourceObj := info.Object.DeepCopyObject()
 	if err = info.Get(); err != nil {
 		if !errors.IsNotFound(err) {
-			return "", err
+			return "", nil, err
 		}
 		// Object doesn't exist yet, create it
 		gvr := info.ResourceMapping().Resource
-		_, err := c.Resource(gvr).Namespace(info.Namespace).Create(context.TODO(), info.Object.(*unstructured.Unstructured), metav1.CreateOptions{})
+		created, err := c.Resource(gvr).Namespace(info.Namespace).Create(context.TODO(), info.Object.(*unstructured.Unstructured), r.createOptions())
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
-		return CreatedApplyResult, nil
+		return CreatedApplyResult, created, nil
 	}
 	openAPISchema, _ := f.OpenAPISchema()
 	patcher := kcmdapply.Patcher{
 		Mapping:       info.Mapping,
-		Helper:        kresource.NewHelper(info.Client, info.Mapping),
+		Helper:        kresource.NewHelper(info.Client, info.Mapping).DryRun(r.dryRun),
 		Overwrite:     true,
 		BackOff:       clockwork.NewRealClock(),
 		OpenapiSchema: openAPISchema,
 	}
 	sourceBytes, err := runtime.Encode(unstructured.UnstructuredJSONScheme, sourceObj)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
-	patch, _, err := patcher.Patch(info.Object, sourceBytes, info.Source, info.Namespace, info.Name, errOut)
+	patch, patched, err := patcher.Patch(info.Object, sourceBytes, info.Source, info.Namespace, info.Name, errOut)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 	result := ConfiguredApplyResult
 	if string(patch) == "{}" {
 		result = UnchangedApplyResult
 	}
-	return result, nil
+	return result, patched, nil
+}
+
+// createOptions are the options of the requests that create objects, which are dry runs for dry-run helpers.
+func (r *helper) createOptions() metav1.CreateOptions {
+	if r.dryRun {
+		return metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}
+	}
+	return metav1.CreateOptions{}
 }
 
 func (r *helper) setupApplyCommand(f cmdutil.Factory, obj []byte, ioStreams genericclioptions.IOStreams) (*kcmdapply.ApplyOptions, *changeTracker, error) {
@@ -223,11 +237,16 @@ func (r *helper) setupApplyCommand(f cmdutil.Factory, obj []byte, ioStreams gene
 		return nil, nil, err
 	}
 	o.DeleteOptions, err = delete.NewDeleteFlags("").ToOptions(dynamicClient, ioStreams)
-	o.DeleteOptions.ForceDeletion = true
 	if err != nil {
 		r.logger.WithError(err).Error("cannot create delete options")
 		return nil, nil, err
 	}
+	// Objects that cannot be patched are deleted and created again, which cannot be done with dry runs, since the
+	// deletion of the object is waited for.
+	o.DeleteOptions.ForceDeletion = !r.dryRun
+	if r.dryRun {
+		o.DryRunStrategy = cmdutil.DryRunServer
+	}
 	// Re-use the openAPISchema that should have been initialized in the constructor.
 	o.OpenAPISchema = r.openAPISchema
 	o.Validator, err = f.Validator(metav1.FieldValidationIgnore)
@@ -262,6 +281,7 @@ func (r *helper) setupApplyCommand(f cmdutil.Factory, obj []byte, ioStreams gene
 
 type trackerPrinter struct {
 	setResult       func()
+	setObject       func(runtime.Object)
 	internalPrinter printers.ResourcePrinter
 }
 
@@ -269,11 +289,14 @@ func (p *trackerPrinter) PrintObj(o runtime.Object, w io.Writer) error {
 	if p.setResult != nil {
 		p.setResult()
 	}
+	p.setObject(o)
 	return p.internalPrinter.PrintObj(o, w)
 }
 
 type changeTracker struct {
-	result            []ApplyResult
+	result []ApplyResult
+	// object is the object that resulted from the apply.
+	object            runtime.Object
 	internalToPrinter func(string) (printers.ResourcePrinter, error)
 }
 
@@ -301,5 +324,6 @@ func (t *changeTracker) ToPrinter(name string) (printers.ResourcePrinter, error)
 	return &trackerPrinter{
 		internalPrinter: p,
 		setResult:       f,
+		setObject:       func(o runtime.Object) { t.object = o },
 	}, nil
 }
@@ -0,0 +1,80 @@
+package resource
+
+import (
+	"fmt"
+	"io"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+)
+
+// DryRunHelper previews the apply and patch operations of a Helper without changing the target cluster. Each
+// operation makes the same requests to the target cluster as the Helper would, with the requests that change objects
+// made as server-side dry runs, and returns the object that the operation would result in.
+type DryRunHelper interface {
+	// Apply previews Helper.Apply.
+	Apply(obj []byte) (ApplyResult, *unstructured.Unstructured, error)
+	// CreateOrUpdate previews Helper.CreateOrUpdate.
+	CreateOrUpdate(obj []byte) (ApplyResult, *unstructured.Unstructured, error)
+	// Create previews Helper.Create. The object in the target cluster is returned when it already exists.
+	Create(obj []byte) (ApplyResult, *unstructured.Unstructured, error)
+	// Patch previews Helper.Patch.
+	Patch(name types.NamespacedName, kind, apiVersion string, patch []byte, patchType string) (*unstructured.Unstructured, error)
+}
+
+type dryRunHelper struct {
+	*helper
+}
+
+// NewDryRunHelperFromRESTConfig returns a new object that allows dry runs of apply and patch operations
+func NewDryRunHelperFromRESTConfig(restConfig *rest.Config, logger log.FieldLogger) (DryRunHelper, error) {
+	r := &helper{
+		logger:     logger,
+		cacheDir:   getCacheDir(logger),
+		restConfig: restConfig,
+		dryRun:     true,
+	}
+	r.getFactory = r.getRESTConfigFactory
+	err := r.cacheOpenAPISchema()
+	return dryRunHelper{r}, err
+}
+
+func (r dryRunHelper) Apply(obj []byte) (ApplyResult, *unstructured.Unstructured, error) {
+	return toUnstructuredResult(r.helper.apply(obj))
+}
+
+func (r dryRunHelper) CreateOrUpdate(obj []byte) (ApplyResult, *unstructured.Unstructured, error) {
+	factory, err := r.getFactory("")
+	if err != nil {
+		return "", nil, err
+	}
+	return toUnstructuredResult(r.createOrUpdate(factory, obj, io.Discard))
+}
+
+func (r dryRunHelper) Create(obj []byte) (ApplyResult, *unstructured.Unstructured, error) {
+	factory, err := r.getFactory("")
+	if err != nil {
+		return "", nil, err
+	}
+	return toUnstructuredResult(r.createOnly(factory, obj))
+}
+
+func (r dryRunHelper) Patch(name types.NamespacedName, kind, apiVersion string, patch []byte, patchType string) (*unstructured.Unstructured, error) {
+	obj, err := r.helper.patch(name, kind, apiVersion, patch, patchType)
+	_, u, err := toUnstructuredResult("", obj, err)
+	return u, err
+}
+
+func toUnstructuredResult(result ApplyResult, obj runtime.Object, err error) (ApplyResult, *unstructured.Unstructured, error) {
+	if err != nil {
+		return "", nil, err
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return "", nil, fmt.Errorf("unexpected type of resulting object: %T", obj)
+	}
+	return result, u, nil
+}
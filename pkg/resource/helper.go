@@ -48,6 +48,8 @@ type helper struct {
 	restConfig     *rest.Config
 	getFactory     func(namespace string) (cmdutil.Factory, error)
 	openAPISchema  openapi.Resources
+	// dryRun makes the requests that change objects server-side dry runs.
+	dryRun bool
 }
 
 // cacheOpenAPISchema builds the very expensive OpenAPISchema (>3s commonly) once, and stores
@@ -3,10 +3,13 @@ package resource
 import (
 	"bytes"
 	"fmt"
+	"io"
 
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
 	kcmdpatch "k8s.io/kubectl/pkg/cmd/patch"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 )
@@ -21,7 +24,12 @@ var (
 
 // Patch invokes the kubectl patch command with the given resource, patch and patch type
 func (r *helper) Patch(name types.NamespacedName, kind, apiVersion string, patch []byte, patchType string) error {
+	_, err := r.patch(name, kind, apiVersion, patch, patchType)
+	return err
+}
 
+// patch invokes the kubectl patch command, returning the patched object.
+func (r *helper) patch(name types.NamespacedName, kind, apiVersion string, patch []byte, patchType string) (runtime.Object, error) {
 	ioStreams := genericclioptions.IOStreams{
 		In:     &bytes.Buffer{},
 		Out:    &bytes.Buffer{},
@@ -29,30 +37,46 @@ func (r *helper) Patch(name types.NamespacedName, kind, apiVersion string, patch
 	}
 	factory, err := r.getFactory(name.Namespace)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	patchOptions, err := r.setupPatchCommand(name.Name, kind, apiVersion, patchType, factory, string(patch), ioStreams)
 	if err != nil {
 		r.logger.WithError(err).Error("failed to setup patch command")
-		return err
+		return nil, err
+	}
+	var patched runtime.Object
+	toPrinter := patchOptions.ToPrinter
+	patchOptions.ToPrinter = func(operation string) (printers.ResourcePrinter, error) {
+		p, err := toPrinter(operation)
+		if err != nil {
+			return nil, err
+		}
+		return printers.ResourcePrinterFunc(func(o runtime.Object, w io.Writer) error {
+			patched = o
+			return p.PrintObj(o, w)
+		}), nil
 	}
 	err = patchOptions.RunPatch()
 	if err != nil {
 		r.logger.WithError(err).
 			WithField("stdout", ioStreams.Out.(*bytes.Buffer).String()).
 			WithField("stderr", ioStreams.ErrOut.(*bytes.Buffer).String()).Warn("running the patch command failed")
-		return err
+		return nil, err
 	}
 	r.logger.
 		WithField("stdout", ioStreams.Out.(*bytes.Buffer).String()).
 		WithField("stderr", ioStreams.ErrOut.(*bytes.Buffer).String()).Info("patch command successful")
-	return nil
+	return patched, nil
 }
 
 func (r *helper) setupPatchCommand(name, kind, apiVersion, patchType string, f cmdutil.Factory, patch string, ioStreams genericclioptions.IOStreams) (*kcmdpatch.PatchOptions, error) {
 
 	cmd := kcmdpatch.NewCmdPatch(f, ioStreams)
-	cmd.Flags().Parse([]string{})
+	var flags []string
+	if r.dryRun {
+		flags = append(flags, "--dry-run=server")
+	}
+	cmd.Flags().Parse(flags)
 
 	gv, err := schema.ParseGroupVersion(apiVersion)
 	if err != nil {
@@ -0,0 +1,227 @@
+package rfc2136client
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/constants"
+)
+
+//go:generate mockgen -source=./client.go -destination=./mock/client_generated.go -package=mock
+
+const (
+	defaultPort    = "53"
+	defaultTimeout = 30 * time.Second
+)
+
+// Client is a wrapper object for a DNS server managed with RFC 2136 dynamic updates to allow for easier
+// mocking/testing.
+type Client interface {
+	// ZoneExists returns true if the server is authoritative for the zone.
+	ZoneExists(zone string) (bool, error)
+
+	// Query returns the records of the specified name and type in the zone served by the server.
+	Query(name string, rrType uint16) ([]dns.RR, error)
+
+	// ListRecords returns all of the records of the zone with a zone transfer.
+	ListRecords(zone string) ([]dns.RR, error)
+
+	// Update sends a dynamic update to the server that removes the RRsets with the names and types of the records in
+	// remove, and then adds the records in insert.
+	Update(zone string, remove []dns.RR, insert []dns.RR) error
+}
+
+// AuthenticationError is returned when the server rejects the TSIG signature of a request.
+type AuthenticationError struct {
+	msg string
+}
+
+func (e *AuthenticationError) Error() string {
+	return e.msg
+}
+
+// IsAuthenticationError returns true if the error is an AuthenticationError.
+func IsAuthenticationError(err error) bool {
+	_, ok := errors.Cause(err).(*AuthenticationError)
+	return ok
+}
+
+type rfc2136Client struct {
+	server    string
+	keyName   string
+	algorithm string
+	secret    string
+	timeout   time.Duration
+}
+
+// NewClient creates our client wrapper object for the DNS server at server, as host or host:port, signing requests
+// with the TSIG key with the specified name, algorithm and base64-encoded secret.
+func NewClient(server, keyName string, algorithm hivev1.TSIGAlgorithm, secret string) (Client, error) {
+	if server == "" {
+		return nil, errors.New("no DNS server specified")
+	}
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, defaultPort)
+	}
+	if keyName == "" {
+		return nil, errors.New("no TSIG key name specified")
+	}
+	if algorithm == "" {
+		algorithm = hivev1.TSIGAlgorithmHMACSHA256
+	}
+	switch algorithm {
+	case hivev1.TSIGAlgorithmHMACSHA1, hivev1.TSIGAlgorithmHMACSHA224, hivev1.TSIGAlgorithmHMACSHA256,
+		hivev1.TSIGAlgorithmHMACSHA384, hivev1.TSIGAlgorithmHMACSHA512:
+	default:
+		return nil, fmt.Errorf("unsupported TSIG algorithm %q", algorithm)
+	}
+	return &rfc2136Client{
+		server:    server,
+		keyName:   dns.CanonicalName(keyName),
+		algorithm: dns.Fqdn(string(algorithm)),
+		secret:    secret,
+		timeout:   defaultTimeout,
+	}, nil
+}
+
+// NewClientFromSecret creates our client wrapper object for the DNS server at server, signing requests with the TSIG
+// key with the specified name and algorithm and the secret in the specified Secret.
+func NewClientFromSecret(server, keyName string, algorithm hivev1.TSIGAlgorithm, secret *corev1.Secret) (Client, error) {
+	tsigSecret, ok := secret.Data[constants.TSIGSecretKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s does not contain the %q key", secret.Name, constants.TSIGSecretKey)
+	}
+	return NewClient(server, keyName, algorithm, strings.TrimSpace(string(tsigSecret)))
+}
+
+// isTSIGError returns true if the error is from the verification of the TSIG signature of a response.
+func isTSIGError(err error) bool {
+	return err == dns.ErrSig || err == dns.ErrSecret || err == dns.ErrKey || err == dns.ErrAuth
+}
+
+func (c *rfc2136Client) dnsClient() *dns.Client {
+	return &dns.Client{
+		Net:        "tcp",
+		Timeout:    c.timeout,
+		TsigSecret: map[string]string{c.keyName: c.secret},
+	}
+}
+
+func (c *rfc2136Client) exchange(m *dns.Msg, sign bool) (*dns.Msg, error) {
+	if sign {
+		m.SetTsig(c.keyName, c.algorithm, 300, time.Now().Unix())
+	}
+	in, _, err := c.dnsClient().Exchange(m, c.server)
+	if err != nil {
+		if isTSIGError(err) {
+			return nil, &AuthenticationError{msg: fmt.Sprintf("TSIG verification of the response from %s failed: %v", c.server, err)}
+		}
+		return nil, errors.Wrapf(err, "error exchanging message with %s", c.server)
+	}
+	if t := in.IsTsig(); t != nil && t.Error != dns.RcodeSuccess {
+		return nil, &AuthenticationError{msg: fmt.Sprintf("%s rejected the TSIG key %s: %s", c.server, c.keyName, dns.RcodeToString[int(t.Error)])}
+	}
+	return in, nil
+}
+
+// ZoneExists implements Client.ZoneExists.
+func (c *rfc2136Client) ZoneExists(zone string) (bool, error) {
+	m := &dns.Msg{}
+	m.SetQuestion(dns.Fqdn(zone), dns.TypeSOA)
+	m.RecursionDesired = false
+	in, err := c.exchange(m, false)
+	if err != nil {
+		return false, err
+	}
+	if in.Rcode != dns.RcodeSuccess || !in.Authoritative {
+		return false, nil
+	}
+	for _, rr := range in.Answer {
+		if soa, ok := rr.(*dns.SOA); ok && strings.EqualFold(soa.Hdr.Name, dns.Fqdn(zone)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Query implements Client.Query.
+func (c *rfc2136Client) Query(name string, rrType uint16) ([]dns.RR, error) {
+	m := &dns.Msg{}
+	m.SetQuestion(dns.Fqdn(name), rrType)
+	m.RecursionDesired = false
+	in, err := c.exchange(m, false)
+	if err != nil {
+		return nil, err
+	}
+	switch in.Rcode {
+	case dns.RcodeSuccess, dns.RcodeNameError:
+	default:
+		return nil, fmt.Errorf("query for %s %s failed: %s", name, dns.TypeToString[rrType], dns.RcodeToString[in.Rcode])
+	}
+	var records []dns.RR
+	for _, rr := range in.Answer {
+		if rr.Header().Rrtype == rrType && strings.EqualFold(rr.Header().Name, dns.Fqdn(name)) {
+			records = append(records, rr)
+		}
+	}
+	return records, nil
+}
+
+// ListRecords implements Client.ListRecords.
+func (c *rfc2136Client) ListRecords(zone string) ([]dns.RR, error) {
+	m := &dns.Msg{}
+	m.SetAxfr(dns.Fqdn(zone))
+	m.SetTsig(c.keyName, c.algorithm, 300, time.Now().Unix())
+	t := &dns.Transfer{
+		DialTimeout:  c.timeout,
+		ReadTimeout:  c.timeout,
+		WriteTimeout: c.timeout,
+		TsigSecret:   map[string]string{c.keyName: c.secret},
+	}
+	env, err := t.In(m, c.server)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error starting transfer of zone %s from %s", zone, c.server)
+	}
+	var records []dns.RR
+	for e := range env {
+		if e.Error != nil {
+			if isTSIGError(e.Error) {
+				return nil, &AuthenticationError{msg: fmt.Sprintf("TSIG verification of the transfer of zone %s from %s failed: %v", zone, c.server, e.Error)}
+			}
+			return nil, errors.Wrapf(e.Error, "error transferring zone %s from %s", zone, c.server)
+		}
+		records = append(records, e.RR...)
+	}
+	return records, nil
+}
+
+// Update implements Client.Update.
+func (c *rfc2136Client) Update(zone string, remove []dns.RR, insert []dns.RR) error {
+	m := &dns.Msg{}
+	m.SetUpdate(dns.Fqdn(zone))
+	if len(remove) > 0 {
+		m.RemoveRRset(remove)
+	}
+	if len(insert) > 0 {
+		m.Insert(insert)
+	}
+	in, err := c.exchange(m, true)
+	if err != nil {
+		return err
+	}
+	switch in.Rcode {
+	case dns.RcodeSuccess:
+		return nil
+	case dns.RcodeNotAuth, dns.RcodeRefused:
+		return &AuthenticationError{msg: fmt.Sprintf("update of zone %s was refused by %s: %s", zone, c.server, dns.RcodeToString[in.Rcode])}
+	default:
+		return fmt.Errorf("update of zone %s failed: %s", zone, dns.RcodeToString[in.Rcode])
+	}
+}
@@ -0,0 +1,229 @@
+package rfc2136client
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+)
+
+const (
+	testZone      = "example.com."
+	testKeyName   = "hive-key."
+	testKeySecret = "c2VjcmV0LXNlY3JldC1zZWNyZXQtc2VjcmV0"
+)
+
+// testServer is an in-process authoritative DNS server for a single zone that accepts dynamic updates and zone
+// transfers signed with the test TSIG key.
+type testServer struct {
+	mutex   sync.Mutex
+	records []dns.RR
+	server  *dns.Server
+}
+
+func newTestServer(t *testing.T, records ...string) *testServer {
+	s := &testServer{}
+	for _, r := range records {
+		rr, err := dns.NewRR(r)
+		require.NoError(t, err, "unexpected error parsing record")
+		s.records = append(s.records, rr)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err, "unexpected error listening")
+	started := make(chan struct{})
+	s.server = &dns.Server{
+		Listener:          listener,
+		Net:               "tcp",
+		Handler:           s,
+		TsigSecret:        map[string]string{testKeyName: testKeySecret},
+		NotifyStartedFunc: func() { close(started) },
+		// The default accept function rejects dynamic updates.
+		MsgAcceptFunc: func(dns.Header) dns.MsgAcceptAction { return dns.MsgAccept },
+	}
+	go s.server.ActivateAndServe()
+	<-started
+	t.Cleanup(func() { s.server.Shutdown() })
+	return s
+}
+
+func (s *testServer) addr() string {
+	return s.server.Listener.Addr().String()
+}
+
+func (s *testServer) soa() dns.RR {
+	for _, rr := range s.records {
+		if rr.Header().Rrtype == dns.TypeSOA {
+			return rr
+		}
+	}
+	return nil
+}
+
+// ServeDNS implements dns.Handler.
+func (s *testServer) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	m := &dns.Msg{}
+	m.SetReply(req)
+	m.Authoritative = true
+	q := req.Question[0]
+	signed := req.IsTsig() != nil
+	switch {
+	case signed && w.TsigStatus() != nil:
+		m.Rcode = dns.RcodeNotAuth
+	case !strings.HasSuffix(dns.CanonicalName(q.Name), testZone):
+		m.Authoritative = false
+		m.Rcode = dns.RcodeRefused
+	case req.Opcode == dns.OpcodeUpdate:
+		if !signed {
+			m.Rcode = dns.RcodeRefused
+			break
+		}
+		s.update(req.Ns)
+	case q.Qtype == dns.TypeAXFR:
+		if !signed {
+			m.Rcode = dns.RcodeRefused
+			break
+		}
+		soa := s.soa()
+		m.Answer = []dns.RR{soa}
+		for _, rr := range s.records {
+			if rr != soa {
+				m.Answer = append(m.Answer, rr)
+			}
+		}
+		m.Answer = append(m.Answer, soa)
+	default:
+		for _, rr := range s.records {
+			if strings.EqualFold(rr.Header().Name, q.Name) && rr.Header().Rrtype == q.Qtype {
+				m.Answer = append(m.Answer, rr)
+			}
+		}
+	}
+	if signed {
+		m.SetTsig(testKeyName, dns.HmacSHA256, 300, time.Now().Unix())
+	}
+	w.WriteMsg(m)
+}
+
+func (s *testServer) update(updates []dns.RR) {
+	for _, u := range updates {
+		hdr := u.Header()
+		switch hdr.Class {
+		case dns.ClassANY:
+			var kept []dns.RR
+			for _, rr := range s.records {
+				if !strings.EqualFold(rr.Header().Name, hdr.Name) || rr.Header().Rrtype != hdr.Rrtype {
+					kept = append(kept, rr)
+				}
+			}
+			s.records = kept
+		case dns.ClassINET:
+			s.records = append(s.records, u)
+		}
+	}
+}
+
+func (s *testServer) recordStrings() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var result []string
+	for _, rr := range s.records {
+		result = append(result, rr.String())
+	}
+	return result
+}
+
+func testRecords() []string {
+	return []string{
+		"example.com. 3600 IN SOA ns1.example.com. admin.example.com. 1 3600 600 86400 60",
+		"example.com. 3600 IN NS ns1.example.com.",
+		"example.com. 3600 IN NS ns2.example.com.",
+		"sub.example.com. 60 IN NS ns3.example.com.",
+	}
+}
+
+func TestZoneExists(t *testing.T) {
+	server := newTestServer(t, testRecords()...)
+	client, err := NewClient(server.addr(), testKeyName, hivev1.TSIGAlgorithmHMACSHA256, testKeySecret)
+	require.NoError(t, err, "unexpected error creating client")
+
+	exists, err := client.ZoneExists("example.com")
+	if assert.NoError(t, err, "unexpected error checking zone") {
+		assert.True(t, exists, "expected zone to exist")
+	}
+
+	exists, err = client.ZoneExists("example.org")
+	if assert.NoError(t, err, "unexpected error checking zone") {
+		assert.False(t, exists, "expected zone not to exist")
+	}
+}
+
+func TestQuery(t *testing.T) {
+	server := newTestServer(t, testRecords()...)
+	client, err := NewClient(server.addr(), testKeyName, hivev1.TSIGAlgorithmHMACSHA256, testKeySecret)
+	require.NoError(t, err, "unexpected error creating client")
+
+	records, err := client.Query("example.com", dns.TypeNS)
+	require.NoError(t, err, "unexpected error querying")
+	var nameServers []string
+	for _, rr := range records {
+		nameServers = append(nameServers, rr.(*dns.NS).Ns)
+	}
+	assert.Equal(t, []string{"ns1.example.com.", "ns2.example.com."}, nameServers, "unexpected name servers")
+}
+
+func TestListRecordsAndUpdate(t *testing.T) {
+	server := newTestServer(t, testRecords()...)
+	client, err := NewClient(server.addr(), testKeyName, hivev1.TSIGAlgorithmHMACSHA256, testKeySecret)
+	require.NoError(t, err, "unexpected error creating client")
+
+	records, err := client.ListRecords("example.com")
+	require.NoError(t, err, "unexpected error listing records")
+	// The SOA record is at both the start and the end of a zone transfer.
+	assert.Len(t, records, len(testRecords())+1, "unexpected number of records")
+
+	remove := []dns.RR{&dns.NS{Hdr: dns.RR_Header{Name: "sub.example.com.", Rrtype: dns.TypeNS, Class: dns.ClassINET}}}
+	insert, err := dns.NewRR("sub.example.com. 60 IN NS ns4.example.com.")
+	require.NoError(t, err, "unexpected error parsing record")
+	err = client.Update("example.com", remove, []dns.RR{insert})
+	require.NoError(t, err, "unexpected error updating zone")
+
+	assert.Equal(t,
+		[]string{
+			"example.com.\t3600\tIN\tSOA\tns1.example.com. admin.example.com. 1 3600 600 86400 60",
+			"example.com.\t3600\tIN\tNS\tns1.example.com.",
+			"example.com.\t3600\tIN\tNS\tns2.example.com.",
+			"sub.example.com.\t60\tIN\tNS\tns4.example.com.",
+		},
+		server.recordStrings(),
+		"unexpected records after update",
+	)
+}
+
+func TestAuthenticationError(t *testing.T) {
+	server := newTestServer(t, testRecords()...)
+	client, err := NewClient(server.addr(), testKeyName, hivev1.TSIGAlgorithmHMACSHA256, "d3Jvbmctc2VjcmV0")
+	require.NoError(t, err, "unexpected error creating client")
+
+	_, err = client.ListRecords("example.com")
+	assert.True(t, IsAuthenticationError(err), "expected authentication error from zone transfer, got %v", err)
+
+	err = client.Update("example.com", nil, nil)
+	assert.True(t, IsAuthenticationError(err), "expected authentication error from update, got %v", err)
+}
+
+func TestNewClientInvalid(t *testing.T) {
+	_, err := NewClient("", testKeyName, "", testKeySecret)
+	assert.Error(t, err, "expected error for missing server")
+	_, err = NewClient("127.0.0.1", testKeyName, "hmac-md5", testKeySecret)
+	assert.Error(t, err, "expected error for unsupported algorithm")
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./client.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	dns "github.com/miekg/dns"
+)
+
+// MockClient is a mock of Client interface.
+type MockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientMockRecorder
+}
+
+// MockClientMockRecorder is the mock recorder for MockClient.
+type MockClientMockRecorder struct {
+	mock *MockClient
+}
+
+// NewMockClient creates a new mock instance.
+func NewMockClient(ctrl *gomock.Controller) *MockClient {
+	mock := &MockClient{ctrl: ctrl}
+	mock.recorder = &MockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClient) EXPECT() *MockClientMockRecorder {
+	return m.recorder
+}
+
+// ListRecords mocks base method.
+func (m *MockClient) ListRecords(zone string) ([]dns.RR, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecords", zone)
+	ret0, _ := ret[0].([]dns.RR)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecords indicates an expected call of ListRecords.
+func (mr *MockClientMockRecorder) ListRecords(zone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecords", reflect.TypeOf((*MockClient)(nil).ListRecords), zone)
+}
+
+// Query mocks base method.
+func (m *MockClient) Query(name string, rrType uint16) ([]dns.RR, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", name, rrType)
+	ret0, _ := ret[0].([]dns.RR)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockClientMockRecorder) Query(name, rrType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockClient)(nil).Query), name, rrType)
+}
+
+// Update mocks base method.
+func (m *MockClient) Update(zone string, remove, insert []dns.RR) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", zone, remove, insert)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockClientMockRecorder) Update(zone, remove, insert interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockClient)(nil).Update), zone, remove, insert)
+}
+
+// ZoneExists mocks base method.
+func (m *MockClient) ZoneExists(zone string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ZoneExists", zone)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ZoneExists indicates an expected call of ZoneExists.
+func (mr *MockClientMockRecorder) ZoneExists(zone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZoneExists", reflect.TypeOf((*MockClient)(nil).ZoneExists), zone)
+}
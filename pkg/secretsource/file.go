@@ -0,0 +1,49 @@
+package secretsource
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// readFiles reads the files in a directory under root as the data of a secret. Hidden files are skipped, which
+// include the timestamped directories and symlinks used by the kubelet to update the files of volumes atomically.
+func readFiles(root, dir string) (map[string][]byte, error) {
+	dir = filepath.Join(root, filepath.FromSlash(path.Clean("/"+dir)))
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read secret directory")
+	}
+	data := map[string][]byte{}
+	size := 0
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		name := filepath.Join(dir, entry.Name())
+		// Stat follows the symlinks to the files of a volume.
+		info, err := os.Stat(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read secret file %s", entry.Name())
+		}
+		if info.IsDir() {
+			continue
+		}
+		if size += int(info.Size()); size > maxSecretSize {
+			return nil, fmt.Errorf("secret files exceed %d bytes", maxSecretSize)
+		}
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read secret file %s", entry.Name())
+		}
+		data[entry.Name()] = content
+	}
+	if len(data) == 0 {
+		return nil, errors.New("no secret files found")
+	}
+	return data, nil
+}
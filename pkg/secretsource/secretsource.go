@@ -0,0 +1,199 @@
+// Package secretsource reads the secrets referenced by the ExternalSource of the SecretMappings of SyncSets and
+// SelectorSyncSets from secret stores outside of the management cluster.
+package secretsource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/constants"
+	controllerutils "github.com/openshift/hive/pkg/controller/utils"
+)
+
+const (
+	// DefaultRefreshInterval is how long a secret is cached when its source does not specify a RefreshInterval.
+	DefaultRefreshInterval = 5 * time.Minute
+
+	// evictionInterval is how long a cached secret is kept after it was last resolved, so that the secrets of
+	// deleted syncsets do not stay in memory.
+	evictionInterval = time.Hour
+
+	// maxSecretSize limits the size of a secret read from a secret store.
+	maxSecretSize = 1 << 20
+)
+
+// RefreshInterval returns how long the secret of the source is cached before it is read again.
+func RefreshInterval(source *hivev1.ExternalSecretSource) time.Duration {
+	if source.RefreshInterval != nil && source.RefreshInterval.Duration > 0 {
+		return source.RefreshInterval.Duration
+	}
+	return DefaultRefreshInterval
+}
+
+// Resolver reads secrets from external secret stores, caching them for the RefreshInterval of their sources.
+type Resolver struct {
+	client   client.Client
+	fileRoot string
+	logger   log.FieldLogger
+	now      func() time.Time
+	// vaultAddresses are the URLs of the Vault servers from which secrets may be read, without trailing slashes.
+	vaultAddresses sets.Set[string]
+
+	mu        sync.Mutex
+	cache     map[string]*cacheEntry
+	lastEvict time.Time
+}
+
+type cacheEntry struct {
+	data         map[string][]byte
+	fetchTime    time.Time
+	lastUsedTime time.Time
+}
+
+// NewResolver returns a Resolver which reads the credentials for secret stores with the client, reads Vault sources
+// only from the vaultAddresses, and reads file sources from the directory in which the SyncSetSecretsVolume of the
+// HiveConfig is mounted.
+func NewResolver(c client.Client, vaultAddresses []string, logger log.FieldLogger) *Resolver {
+	r := &Resolver{
+		client:         c,
+		fileRoot:       constants.SyncSetSecretsDir,
+		logger:         logger,
+		now:            time.Now,
+		vaultAddresses: sets.New[string](),
+		cache:          map[string]*cacheEntry{},
+	}
+	for _, address := range vaultAddresses {
+		r.vaultAddresses.Insert(strings.TrimRight(address, "/"))
+	}
+	return r
+}
+
+// Resolve returns the data of the secret referenced by the source. The syncSetNamespace is empty for
+// SelectorSyncSets. When a cached secret cannot be read again, the cached data continues to be used until the
+// secret can be read.
+func (r *Resolver) Resolve(ctx context.Context, source *hivev1.ExternalSecretSource, syncSetNamespace string) (map[string][]byte, error) {
+	key, err := json.Marshal(struct {
+		Namespace string                       `json:"namespace"`
+		Source    *hivev1.ExternalSecretSource `json:"source"`
+	}{syncSetNamespace, source})
+	if err != nil {
+		return nil, err
+	}
+	now := r.now()
+
+	r.mu.Lock()
+	r.evict(now)
+	entry := r.cache[string(key)]
+	if entry != nil {
+		entry.lastUsedTime = now
+	}
+	r.mu.Unlock()
+	if entry != nil && now.Sub(entry.fetchTime) < RefreshInterval(source) {
+		return entry.data, nil
+	}
+
+	data, err := r.read(ctx, source, syncSetNamespace)
+	if err != nil {
+		if entry != nil {
+			r.logger.WithError(err).Warn("could not refresh secret from external store, using cached secret")
+			return entry.data, nil
+		}
+		return nil, err
+	}
+	r.mu.Lock()
+	r.cache[string(key)] = &cacheEntry{data: data, fetchTime: now, lastUsedTime: now}
+	r.mu.Unlock()
+	return data, nil
+}
+
+// evict removes the secrets that have not been resolved recently from the cache. The caller must hold the lock.
+func (r *Resolver) evict(now time.Time) {
+	if now.Sub(r.lastEvict) < evictionInterval {
+		return
+	}
+	r.lastEvict = now
+	for key, entry := range r.cache {
+		if now.Sub(entry.lastUsedTime) >= evictionInterval {
+			delete(r.cache, key)
+		}
+	}
+}
+
+func (r *Resolver) read(ctx context.Context, source *hivev1.ExternalSecretSource, syncSetNamespace string) (map[string][]byte, error) {
+	switch {
+	case source.Vault != nil && source.File != nil:
+		return nil, errors.New("only one of vault or file may be set")
+	case source.Vault != nil:
+		if !r.vaultAddresses.Has(strings.TrimRight(source.Vault.Address, "/")) {
+			return nil, fmt.Errorf("Vault address %s is not allowed by the HiveConfig", source.Vault.Address)
+		}
+		secret, err := r.getTokenSecret(ctx, source.Vault.TokenSecretRef, syncSetNamespace)
+		if err != nil {
+			return nil, err
+		}
+		token := secret.Data[tokenSecretKey]
+		if len(token) == 0 {
+			return nil, fmt.Errorf("secret %s/%s has no %q key", secret.Namespace, secret.Name, tokenSecretKey)
+		}
+		return readVault(ctx, source.Vault, string(token), secret.Data[caSecretKey])
+	case source.File != nil:
+		if syncSetNamespace != "" {
+			return nil, errors.New("file sources may only be used by SelectorSyncSets")
+		}
+		return readFiles(r.fileRoot, source.File.Path)
+	default:
+		return nil, errors.New("one of vault or file is required")
+	}
+}
+
+func (r *Resolver) getTokenSecret(ctx context.Context, ref hivev1.SecretReference, syncSetNamespace string) (*corev1.Secret, error) {
+	namespace := ref.Namespace
+	switch {
+	case namespace == "" && syncSetNamespace == "":
+		return nil, errors.New("namespace must be specified for token secret")
+	case namespace == "":
+		namespace = syncSetNamespace
+	case syncSetNamespace != "" && namespace != syncSetNamespace:
+		return nil, errors.New("token secret must be in same namespace as SyncSet")
+	}
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+		r.logger.WithError(err).Log(controllerutils.LogLevel(err), "cannot read token secret")
+		return nil, errors.Wrap(err, "failed to read token secret")
+	}
+	return secret, nil
+}
+
+// Hash returns a hash of the data of secrets, which changes when any of the secrets change.
+func Hash(secrets ...map[string][]byte) string {
+	h := sha256.New()
+	for _, data := range secrets {
+		keys := make([]string, 0, len(data))
+		for k := range data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		fmt.Fprintf(h, "%d\n", len(keys))
+		for _, k := range keys {
+			fmt.Fprintf(h, "%d:%s%d:", len(k), k, len(data[k]))
+			h.Write(data[k])
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
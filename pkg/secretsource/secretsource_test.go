@@ -0,0 +1,323 @@
+package secretsource
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	testfake "github.com/openshift/hive/pkg/test/fake"
+)
+
+const (
+	testNamespace = "test-namespace"
+	testToken     = "test-token"
+)
+
+// fakeVault serves the versions of a secret in the KV secrets engines mounted at "secret" (version 2) and "kv"
+// (version 1). The version of the secret returned by the version 1 engine and by version 2 requests without a
+// version is the last one.
+type fakeVault struct {
+	versions []map[string]interface{}
+	requests int
+}
+
+func (v *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v.requests++
+	if r.Header.Get("X-Vault-Token") != testToken {
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]interface{}{"errors": []string{"permission denied"}})
+		return
+	}
+	latest := v.versions[len(v.versions)-1]
+	switch r.URL.Path {
+	case "/v1/secret/data/app/moved":
+		http.Redirect(w, r, "/v1/secret/data/app/config", http.StatusTemporaryRedirect)
+	case "/v1/kv/app/config":
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": latest})
+	case "/v1/secret/data/app/config":
+		data := latest
+		if version := r.URL.Query().Get("version"); version != "" {
+			var i int
+			if err := json.Unmarshal([]byte(version), &i); err != nil || i < 1 || i > len(v.versions) {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			data = v.versions[i-1]
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"data": data}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]interface{}{"errors": []string{}})
+	}
+}
+
+func testTokenSecret(token string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "vault-token"},
+		Data:       map[string][]byte{tokenSecretKey: []byte(token)},
+	}
+}
+
+func TestResolveVault(t *testing.T) {
+	vault := &fakeVault{versions: []map[string]interface{}{
+		{"password": "first"},
+		{"password": "second", "port": 8080},
+	}}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	cases := []struct {
+		name             string
+		vault            hivev1.VaultSecretSource
+		syncSetNamespace string
+		token            string
+		expected         map[string][]byte
+		expectErr        bool
+	}{
+		{
+			name:             "address with trailing slash",
+			vault:            hivev1.VaultSecretSource{Address: server.URL + "/", Path: "app/config"},
+			syncSetNamespace: testNamespace,
+			expected:         map[string][]byte{"password": []byte("second"), "port": []byte("8080")},
+		},
+		{
+			name:             "address not allowed",
+			vault:            hivev1.VaultSecretSource{Address: "http://127.0.0.1:1", Path: "app/config"},
+			syncSetNamespace: testNamespace,
+			expectErr:        true,
+		},
+		{
+			name:             "redirect",
+			vault:            hivev1.VaultSecretSource{Path: "app/moved"},
+			syncSetNamespace: testNamespace,
+			expectErr:        true,
+		},
+		{
+			name:             "kv version 2",
+			vault:            hivev1.VaultSecretSource{Path: "app/config"},
+			syncSetNamespace: testNamespace,
+			expected:         map[string][]byte{"password": []byte("second"), "port": []byte("8080")},
+		},
+		{
+			name:             "pinned version",
+			vault:            hivev1.VaultSecretSource{Path: "/app/config", Version: pointer.Int(1)},
+			syncSetNamespace: testNamespace,
+			expected:         map[string][]byte{"password": []byte("first")},
+		},
+		{
+			name:             "kv version 1",
+			vault:            hivev1.VaultSecretSource{Mount: "kv", KVVersion: 1, Path: "app/config"},
+			syncSetNamespace: testNamespace,
+			expected:         map[string][]byte{"password": []byte("second"), "port": []byte("8080")},
+		},
+		{
+			name: "selector syncset",
+			vault: hivev1.VaultSecretSource{
+				Path:           "app/config",
+				TokenSecretRef: hivev1.SecretReference{Namespace: testNamespace, Name: "vault-token"},
+			},
+			expected: map[string][]byte{"password": []byte("second"), "port": []byte("8080")},
+		},
+		{
+			name:             "missing version",
+			vault:            hivev1.VaultSecretSource{Path: "app/config", Version: pointer.Int(3)},
+			syncSetNamespace: testNamespace,
+			expectErr:        true,
+		},
+		{
+			name:             "missing secret",
+			vault:            hivev1.VaultSecretSource{Path: "app/missing"},
+			syncSetNamespace: testNamespace,
+			expectErr:        true,
+		},
+		{
+			name:             "bad token",
+			vault:            hivev1.VaultSecretSource{Path: "app/config"},
+			syncSetNamespace: testNamespace,
+			token:            "bad-token",
+			expectErr:        true,
+		},
+		{
+			name: "token secret in other namespace",
+			vault: hivev1.VaultSecretSource{
+				Path:           "app/config",
+				TokenSecretRef: hivev1.SecretReference{Namespace: "other-namespace", Name: "vault-token"},
+			},
+			syncSetNamespace: testNamespace,
+			expectErr:        true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			token := tc.token
+			if token == "" {
+				token = testToken
+			}
+			c := testfake.NewFakeClientBuilder().WithRuntimeObjects(testTokenSecret(token)).Build()
+			r := NewResolver(c, []string{server.URL + "/"}, log.StandardLogger())
+			source := tc.vault
+			if source.Address == "" {
+				source.Address = server.URL
+			}
+			if source.TokenSecretRef.Name == "" {
+				source.TokenSecretRef.Name = "vault-token"
+			}
+			data, err := r.Resolve(context.Background(), &hivev1.ExternalSecretSource{Vault: &source}, tc.syncSetNamespace)
+			if tc.expectErr {
+				assert.Error(t, err, "expected error resolving secret")
+				return
+			}
+			require.NoError(t, err, "unexpected error resolving secret")
+			assert.Equal(t, tc.expected, data, "unexpected secret data")
+		})
+	}
+}
+
+func TestResolveCaching(t *testing.T) {
+	vault := &fakeVault{versions: []map[string]interface{}{{"password": "first"}}}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	c := testfake.NewFakeClientBuilder().WithRuntimeObjects(testTokenSecret(testToken)).Build()
+	r := NewResolver(c, []string{server.URL}, log.StandardLogger())
+	now := time.Now()
+	r.now = func() time.Time { return now }
+	source := &hivev1.ExternalSecretSource{
+		Vault: &hivev1.VaultSecretSource{
+			Address:        server.URL,
+			Path:           "app/config",
+			TokenSecretRef: hivev1.SecretReference{Name: "vault-token"},
+		},
+		RefreshInterval: &metav1.Duration{Duration: time.Minute},
+	}
+	resolve := func() map[string][]byte {
+		data, err := r.Resolve(context.Background(), source, testNamespace)
+		require.NoError(t, err, "unexpected error resolving secret")
+		return data
+	}
+
+	assert.Equal(t, map[string][]byte{"password": []byte("first")}, resolve(), "unexpected secret data")
+	vault.versions = append(vault.versions, map[string]interface{}{"password": "second"})
+	assert.Equal(t, map[string][]byte{"password": []byte("first")}, resolve(), "expected cached secret data")
+	assert.Equal(t, 1, vault.requests, "expected secret to be cached")
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, map[string][]byte{"password": []byte("second")}, resolve(), "expected refreshed secret data")
+	assert.Equal(t, 2, vault.requests, "expected secret to be refreshed")
+
+	server.Close()
+	now = now.Add(time.Minute)
+	assert.Equal(t, map[string][]byte{"password": []byte("second")}, resolve(), "expected cached secret data when store is unavailable")
+
+	now = now.Add(evictionInterval)
+	_, err := r.Resolve(context.Background(), source, testNamespace)
+	assert.Error(t, err, "expected error resolving evicted secret when store is unavailable")
+}
+
+func TestResolveVaultContextDone(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-unblock:
+		}
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	c := testfake.NewFakeClientBuilder().WithRuntimeObjects(testTokenSecret(testToken)).Build()
+	r := NewResolver(c, []string{server.URL}, log.StandardLogger())
+	source := &hivev1.ExternalSecretSource{
+		Vault: &hivev1.VaultSecretSource{
+			Address:        server.URL,
+			Path:           "app/config",
+			TokenSecretRef: hivev1.SecretReference{Name: "vault-token"},
+		},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := r.Resolve(ctx, source, testNamespace)
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "expected request to Vault to end with the context")
+}
+
+func TestResolveFile(t *testing.T) {
+	root := t.TempDir()
+	dataDir := filepath.Join(root, "..2022_01_01_00_00_00.000000000")
+	require.NoError(t, os.MkdirAll(dataDir, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "password"), []byte("secret"), 0600))
+	require.NoError(t, os.Symlink(filepath.Base(dataDir), filepath.Join(root, "..data")))
+	require.NoError(t, os.Symlink(filepath.Join("..data", "password"), filepath.Join(root, "password")))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "empty"), 0700))
+
+	cases := []struct {
+		name             string
+		path             string
+		syncSetNamespace string
+		expected         map[string][]byte
+		expectErr        bool
+	}{
+		{
+			name:     "symlinked files",
+			path:     ".",
+			expected: map[string][]byte{"password": []byte("secret")},
+		},
+		{
+			name:     "escaping root",
+			path:     "../..",
+			expected: map[string][]byte{"password": []byte("secret")},
+		},
+		{
+			name:      "no files",
+			path:      "empty",
+			expectErr: true,
+		},
+		{
+			name:      "missing directory",
+			path:      "missing",
+			expectErr: true,
+		},
+		{
+			name:             "syncset",
+			path:             ".",
+			syncSetNamespace: testNamespace,
+			expectErr:        true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewResolver(testfake.NewFakeClientBuilder().Build(), nil, log.StandardLogger())
+			r.fileRoot = root
+			data, err := r.Resolve(context.Background(), &hivev1.ExternalSecretSource{
+				File: &hivev1.FileSecretSource{Path: tc.path},
+			}, tc.syncSetNamespace)
+			if tc.expectErr {
+				assert.Error(t, err, "expected error resolving secret")
+				return
+			}
+			require.NoError(t, err, "unexpected error resolving secret")
+			assert.Equal(t, tc.expected, data, "unexpected secret data")
+		})
+	}
+}
+
+func TestHash(t *testing.T) {
+	a := map[string][]byte{"a": []byte("1"), "b": []byte("2")}
+	assert.Equal(t, Hash(a), Hash(map[string][]byte{"b": []byte("2"), "a": []byte("1")}), "expected hash to ignore key order")
+	assert.NotEqual(t, Hash(a), Hash(map[string][]byte{"a": []byte("1"), "b": []byte("3")}), "expected hash to change with data")
+	assert.NotEqual(t, Hash(a), Hash(map[string][]byte{"a": []byte("12")}), "expected hash to change with keys")
+	assert.NotEqual(t, Hash(a, nil), Hash(nil, a), "expected hash to change with order of secrets")
+}
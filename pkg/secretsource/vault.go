@@ -0,0 +1,130 @@
+package secretsource
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+)
+
+const (
+	tokenSecretKey = "token"
+	caSecretKey    = "ca.crt"
+
+	defaultVaultMount = "secret"
+
+	// vaultRequestTimeout bounds a request to Vault, so that an unresponsive Vault does not block the reconcile of a
+	// cluster.
+	vaultRequestTimeout = 30 * time.Second
+)
+
+// vaultResponse is the response from reading a secret from a KV secrets engine. The data of the secret is Data for
+// version 1 of the secrets engine, and Data.Data for version 2.
+type vaultResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []string               `json:"errors"`
+}
+
+// readVault reads a secret from a KV secrets engine of Vault using the HTTP API.
+func readVault(ctx context.Context, source *hivev1.VaultSecretSource, token string, caBundle []byte) (map[string][]byte, error) {
+	client := &http.Client{
+		Timeout: vaultRequestTimeout,
+		// Redirects are not followed, since the Vault token would be sent along to wherever they point.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	if len(caBundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBundle) {
+			return nil, errors.New("no certificates found in CA bundle")
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+		client.Transport = transport
+	}
+
+	mount := strings.Trim(source.Mount, "/")
+	if mount == "" {
+		mount = defaultVaultMount
+	}
+	secretPath := strings.Trim(source.Path, "/")
+	kvV2 := source.KVVersion != 1
+	u := strings.TrimRight(source.Address, "/") + "/v1/" + mount + "/"
+	if kvV2 {
+		u += "data/"
+	}
+	u += secretPath
+	if kvV2 && source.Version != nil {
+		u += "?" + url.Values{"version": []string{strconv.Itoa(*source.Version)}}.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if source.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", source.Namespace)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read secret from Vault")
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSecretSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read secret from Vault")
+	}
+	if len(body) > maxSecretSize {
+		return nil, fmt.Errorf("secret from Vault exceeds %d bytes", maxSecretSize)
+	}
+	response := &vaultResponse{}
+	if err := json.Unmarshal(body, response); err != nil && resp.StatusCode == http.StatusOK {
+		return nil, errors.Wrap(err, "failed to decode secret from Vault")
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("secret %s not found in Vault", secretPath)
+	case resp.StatusCode != http.StatusOK:
+		if len(response.Errors) > 0 {
+			return nil, fmt.Errorf("failed to read secret from Vault: %s: %s", resp.Status, strings.Join(response.Errors, "; "))
+		}
+		return nil, fmt.Errorf("failed to read secret from Vault: %s", resp.Status)
+	}
+
+	data := response.Data
+	if kvV2 {
+		// The data of a deleted or destroyed version of a secret is null.
+		d, ok := data["data"].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("secret %s in Vault has no data", secretPath)
+		}
+		data = d
+	}
+	secretData := make(map[string][]byte, len(data))
+	for k, v := range data {
+		if s, ok := v.(string); ok {
+			secretData[k] = []byte(s)
+			continue
+		}
+		// Values that are not strings are synced as JSON.
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		secretData[k] = b
+	}
+	return secretData, nil
+}
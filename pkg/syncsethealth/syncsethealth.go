@@ -0,0 +1,294 @@
+// Package syncsethealth assesses the health of the resources applied to clusters by SyncSets and SelectorSyncSets.
+package syncsethealth
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/lru"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveintv1alpha1 "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+)
+
+// rule assesses the health of a resource, returning a message describing why the resource is not healthy.
+type rule func(obj *unstructured.Unstructured) (hiveintv1alpha1.SyncSetHealth, string, error)
+
+var builtInRules = map[schema.GroupKind]rule{
+	{Group: "apps", Kind: "Deployment"}:                            deploymentHealth,
+	{Group: "apps", Kind: "DaemonSet"}:                             daemonSetHealth,
+	{Group: "batch", Kind: "Job"}:                                  jobHealth,
+	{Group: "operators.coreos.com", Kind: "Subscription"}:          subscriptionHealth,
+	{Group: "operators.coreos.com", Kind: "ClusterServiceVersion"}: clusterServiceVersionHealth,
+}
+
+// Assessor assesses the health of resources with the built-in rules and the custom rules of a syncset.
+type Assessor struct {
+	custom map[schema.GroupKind]*hivev1.CustomHealthRule
+}
+
+// NewAssessor returns an Assessor for the health check of a syncset.
+func NewAssessor(healthCheck *hivev1.SyncSetHealthCheck) *Assessor {
+	a := &Assessor{custom: map[schema.GroupKind]*hivev1.CustomHealthRule{}}
+	if healthCheck != nil {
+		for i, r := range healthCheck.CustomRules {
+			gv, _ := schema.ParseGroupVersion(r.APIVersion)
+			a.custom[schema.GroupKind{Group: gv.Group, Kind: r.Kind}] = &healthCheck.CustomRules[i]
+		}
+	}
+	return a
+}
+
+// Assesses returns true if there is a rule to assess the health of resources of the kind.
+func (a *Assessor) Assesses(apiVersion, kind string) bool {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return false
+	}
+	gk := schema.GroupKind{Group: gv.Group, Kind: kind}
+	_, custom := a.custom[gk]
+	_, builtIn := builtInRules[gk]
+	return custom || builtIn
+}
+
+// Assess assesses the health of a resource, returning a message describing why the resource is not healthy. The
+// resource must be of a kind for which Assesses returns true.
+func (a *Assessor) Assess(obj *unstructured.Unstructured) (hiveintv1alpha1.SyncSetHealth, string, error) {
+	gk := obj.GroupVersionKind().GroupKind()
+	if r, ok := a.custom[gk]; ok {
+		return customHealth(r, obj)
+	}
+	if r, ok := builtInRules[gk]; ok {
+		return r(obj)
+	}
+	return "", "", fmt.Errorf("no health rule for %s", gk)
+}
+
+// Rollup returns the health of a set of resources, which is the worst health of the resources.
+func Rollup(resources []hiveintv1alpha1.ResourceHealth) hiveintv1alpha1.SyncSetHealth {
+	health := hiveintv1alpha1.HealthySyncSetHealth
+	for _, r := range resources {
+		switch r.Health {
+		case hiveintv1alpha1.DegradedSyncSetHealth:
+			return hiveintv1alpha1.DegradedSyncSetHealth
+		case hiveintv1alpha1.ProgressingSyncSetHealth:
+			health = hiveintv1alpha1.ProgressingSyncSetHealth
+		}
+	}
+	return health
+}
+
+func deploymentHealth(obj *unstructured.Unstructured) (hiveintv1alpha1.SyncSetHealth, string, error) {
+	if cond := findCondition(obj, "Progressing"); cond["status"] == "False" && cond["reason"] == "ProgressDeadlineExceeded" {
+		return hiveintv1alpha1.DegradedSyncSetHealth, fmt.Sprintf("rollout exceeded its progress deadline: %s", cond["message"]), nil
+	}
+	if msg := observedGenerationMessage(obj); msg != "" {
+		return hiveintv1alpha1.ProgressingSyncSetHealth, msg, nil
+	}
+	replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if !found {
+		replicas = 1
+	}
+	updated, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedReplicas")
+	available, _, _ := unstructured.NestedInt64(obj.Object, "status", "availableReplicas")
+	total, _, _ := unstructured.NestedInt64(obj.Object, "status", "replicas")
+	switch {
+	case updated < replicas:
+		return hiveintv1alpha1.ProgressingSyncSetHealth, fmt.Sprintf("%d of %d replicas updated", updated, replicas), nil
+	case total > updated:
+		return hiveintv1alpha1.ProgressingSyncSetHealth, fmt.Sprintf("%d old replicas pending termination", total-updated), nil
+	case available < replicas:
+		return hiveintv1alpha1.ProgressingSyncSetHealth, fmt.Sprintf("%d of %d replicas available", available, replicas), nil
+	}
+	return hiveintv1alpha1.HealthySyncSetHealth, "", nil
+}
+
+func daemonSetHealth(obj *unstructured.Unstructured) (hiveintv1alpha1.SyncSetHealth, string, error) {
+	if msg := observedGenerationMessage(obj); msg != "" {
+		return hiveintv1alpha1.ProgressingSyncSetHealth, msg, nil
+	}
+	desired, _, _ := unstructured.NestedInt64(obj.Object, "status", "desiredNumberScheduled")
+	updated, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedNumberScheduled")
+	available, _, _ := unstructured.NestedInt64(obj.Object, "status", "numberAvailable")
+	switch {
+	case updated < desired:
+		return hiveintv1alpha1.ProgressingSyncSetHealth, fmt.Sprintf("%d of %d pods updated", updated, desired), nil
+	case available < desired:
+		return hiveintv1alpha1.ProgressingSyncSetHealth, fmt.Sprintf("%d of %d pods available", available, desired), nil
+	}
+	return hiveintv1alpha1.HealthySyncSetHealth, "", nil
+}
+
+func jobHealth(obj *unstructured.Unstructured) (hiveintv1alpha1.SyncSetHealth, string, error) {
+	if cond := findCondition(obj, "Failed"); cond["status"] == "True" {
+		return hiveintv1alpha1.DegradedSyncSetHealth, fmt.Sprintf("job failed: %s", cond["message"]), nil
+	}
+	if cond := findCondition(obj, "Complete"); cond["status"] == "True" {
+		return hiveintv1alpha1.HealthySyncSetHealth, "", nil
+	}
+	return hiveintv1alpha1.ProgressingSyncSetHealth, "job has not completed", nil
+}
+
+func subscriptionHealth(obj *unstructured.Unstructured) (hiveintv1alpha1.SyncSetHealth, string, error) {
+	for _, t := range []string{"ResolutionFailed", "CatalogSourcesUnhealthy", "InstallPlanFailed"} {
+		if cond := findCondition(obj, t); cond["status"] == "True" {
+			return hiveintv1alpha1.DegradedSyncSetHealth, fmt.Sprintf("%s: %s", t, cond["message"]), nil
+		}
+	}
+	state, _, _ := unstructured.NestedString(obj.Object, "status", "state")
+	if state != "AtLatestKnown" {
+		if state == "" {
+			state = "unknown"
+		}
+		return hiveintv1alpha1.ProgressingSyncSetHealth, fmt.Sprintf("subscription state is %s", state), nil
+	}
+	return hiveintv1alpha1.HealthySyncSetHealth, "", nil
+}
+
+func clusterServiceVersionHealth(obj *unstructured.Unstructured) (hiveintv1alpha1.SyncSetHealth, string, error) {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	message, _, _ := unstructured.NestedString(obj.Object, "status", "message")
+	switch phase {
+	case "Succeeded":
+		return hiveintv1alpha1.HealthySyncSetHealth, "", nil
+	case "Failed":
+		return hiveintv1alpha1.DegradedSyncSetHealth, fmt.Sprintf("phase is Failed: %s", message), nil
+	case "":
+		phase = "unknown"
+	}
+	return hiveintv1alpha1.ProgressingSyncSetHealth, fmt.Sprintf("phase is %s", phase), nil
+}
+
+func observedGenerationMessage(obj *unstructured.Unstructured) string {
+	observed, _, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if observed < obj.GetGeneration() {
+		return fmt.Sprintf("generation %d has not been observed", obj.GetGeneration())
+	}
+	return ""
+}
+
+// findCondition returns the fields of the condition of the resource with the type, or nil if there is none.
+func findCondition(obj *unstructured.Unstructured, conditionType string) map[string]interface{} {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		if cond, ok := c.(map[string]interface{}); ok && cond["type"] == conditionType {
+			return cond
+		}
+	}
+	return nil
+}
+
+func customHealth(r *hivev1.CustomHealthRule, obj *unstructured.Unstructured) (hiveintv1alpha1.SyncSetHealth, string, error) {
+	if r.Degraded != "" {
+		degraded, err := evaluate(r.Degraded, obj)
+		if err != nil {
+			return "", "", errors.Wrap(err, "failed to evaluate degraded expression")
+		}
+		if degraded {
+			return hiveintv1alpha1.DegradedSyncSetHealth, fmt.Sprintf("%s is true", r.Degraded), nil
+		}
+	}
+	healthy, err := evaluate(r.Healthy, obj)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to evaluate healthy expression")
+	}
+	if !healthy {
+		return hiveintv1alpha1.ProgressingSyncSetHealth, fmt.Sprintf("%s is false", r.Healthy), nil
+	}
+	return hiveintv1alpha1.HealthySyncSetHealth, "", nil
+}
+
+const (
+	// celCostLimit limits the cost of evaluating an expression of a custom health rule, so that an expensive
+	// expression cannot hold up the assessment of the health of a cluster. It is the limit that Kubernetes uses for
+	// each expression of a validation rule of a CRD.
+	celCostLimit = 1000000
+
+	// celEvalTimeout limits the time taken to evaluate an expression of a custom health rule, for the comprehensions
+	// whose cost cannot be estimated.
+	celEvalTimeout = time.Second
+
+	// celInterruptCheckFrequency is the number of iterations of a comprehension between checks of whether the
+	// evaluation of an expression has timed out.
+	celInterruptCheckFrequency = 100
+
+	// programCacheSize is the number of compiled expressions that are cached, so that the programs of deleted
+	// syncsets do not stay in memory.
+	programCacheSize = 1000
+)
+
+var (
+	celEnvOnce sync.Once
+	celEnv     *cel.Env
+	celEnvErr  error
+
+	programsLock sync.Mutex
+	programs     = lru.New(programCacheSize)
+)
+
+// Compile compiles a CEL expression of a custom health rule, which must evaluate to a bool.
+func Compile(expression string) (cel.Program, error) {
+	programsLock.Lock()
+	defer programsLock.Unlock()
+	if p, ok := programs.Get(expression); ok {
+		return p.(cel.Program), nil
+	}
+	celEnvOnce.Do(func() {
+		celEnv, celEnvErr = cel.NewEnv(cel.Variable("object", cel.DynType))
+	})
+	if celEnvErr != nil {
+		return nil, celEnvErr
+	}
+	ast, issues := celEnv.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if t := ast.OutputType(); t != cel.BoolType && t != cel.DynType {
+		return nil, fmt.Errorf("expression must evaluate to a bool, not %s", t)
+	}
+	p, err := celEnv.Program(ast,
+		cel.CostLimit(celCostLimit),
+		cel.InterruptCheckFrequency(celInterruptCheckFrequency),
+	)
+	if err != nil {
+		return nil, err
+	}
+	programs.Add(expression, p)
+	return p, nil
+}
+
+func evaluate(expression string, obj *unstructured.Unstructured) (bool, error) {
+	p, err := Compile(expression)
+	if err != nil {
+		return false, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), celEvalTimeout)
+	defer cancel()
+	out, _, err := p.ContextEval(ctx, map[string]interface{}{"object": obj.Object})
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression evaluated to %v rather than a bool", out.Value())
+	}
+	return result, nil
+}
+
+// SortResources sorts the health of resources by their kind, namespace and name.
+func SortResources(resources []hiveintv1alpha1.ResourceHealth) {
+	sort.Slice(resources, func(i, j int) bool {
+		a, b := resources[i], resources[j]
+		return strings.Join([]string{a.APIVersion, a.Kind, a.Namespace, a.Name}, "/") <
+			strings.Join([]string{b.APIVersion, b.Kind, b.Namespace, b.Name}, "/")
+	})
+}
@@ -0,0 +1,238 @@
+package syncsethealth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	hiveintv1alpha1 "github.com/openshift/hive/apis/hiveinternal/v1alpha1"
+)
+
+func TestAssess(t *testing.T) {
+	cases := []struct {
+		name           string
+		healthCheck    *hivev1.SyncSetHealthCheck
+		object         map[string]interface{}
+		expectedHealth hiveintv1alpha1.SyncSetHealth
+		expectError    bool
+	}{
+		{
+			name: "deployment available",
+			object: object("apps/v1", "Deployment", 2, map[string]interface{}{
+				"spec":   map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{"observedGeneration": int64(2), "replicas": int64(3), "updatedReplicas": int64(3), "availableReplicas": int64(3)},
+			}),
+			expectedHealth: hiveintv1alpha1.HealthySyncSetHealth,
+		},
+		{
+			name: "deployment not observed",
+			object: object("apps/v1", "Deployment", 2, map[string]interface{}{
+				"spec":   map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{"observedGeneration": int64(1), "replicas": int64(3), "updatedReplicas": int64(3), "availableReplicas": int64(3)},
+			}),
+			expectedHealth: hiveintv1alpha1.ProgressingSyncSetHealth,
+		},
+		{
+			name: "deployment rolling out",
+			object: object("apps/v1", "Deployment", 2, map[string]interface{}{
+				"spec":   map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{"observedGeneration": int64(2), "replicas": int64(4), "updatedReplicas": int64(1), "availableReplicas": int64(3)},
+			}),
+			expectedHealth: hiveintv1alpha1.ProgressingSyncSetHealth,
+		},
+		{
+			name: "deployment progress deadline exceeded",
+			object: object("apps/v1", "Deployment", 2, map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{
+					"observedGeneration": int64(2), "replicas": int64(4), "updatedReplicas": int64(1), "availableReplicas": int64(3),
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded"},
+					},
+				},
+			}),
+			expectedHealth: hiveintv1alpha1.DegradedSyncSetHealth,
+		},
+		{
+			name: "daemonset available",
+			object: object("apps/v1", "DaemonSet", 1, map[string]interface{}{
+				"status": map[string]interface{}{"observedGeneration": int64(1), "desiredNumberScheduled": int64(3), "updatedNumberScheduled": int64(3), "numberAvailable": int64(3)},
+			}),
+			expectedHealth: hiveintv1alpha1.HealthySyncSetHealth,
+		},
+		{
+			name: "daemonset unavailable",
+			object: object("apps/v1", "DaemonSet", 1, map[string]interface{}{
+				"status": map[string]interface{}{"observedGeneration": int64(1), "desiredNumberScheduled": int64(3), "updatedNumberScheduled": int64(3), "numberAvailable": int64(2)},
+			}),
+			expectedHealth: hiveintv1alpha1.ProgressingSyncSetHealth,
+		},
+		{
+			name: "job complete",
+			object: object("batch/v1", "Job", 1, map[string]interface{}{
+				"status": map[string]interface{}{"conditions": []interface{}{map[string]interface{}{"type": "Complete", "status": "True"}}},
+			}),
+			expectedHealth: hiveintv1alpha1.HealthySyncSetHealth,
+		},
+		{
+			name: "job failed",
+			object: object("batch/v1", "Job", 1, map[string]interface{}{
+				"status": map[string]interface{}{"conditions": []interface{}{map[string]interface{}{"type": "Failed", "status": "True"}}},
+			}),
+			expectedHealth: hiveintv1alpha1.DegradedSyncSetHealth,
+		},
+		{
+			name:           "job running",
+			object:         object("batch/v1", "Job", 1, map[string]interface{}{}),
+			expectedHealth: hiveintv1alpha1.ProgressingSyncSetHealth,
+		},
+		{
+			name: "subscription at latest",
+			object: object("operators.coreos.com/v1alpha1", "Subscription", 1, map[string]interface{}{
+				"status": map[string]interface{}{"state": "AtLatestKnown"},
+			}),
+			expectedHealth: hiveintv1alpha1.HealthySyncSetHealth,
+		},
+		{
+			name: "subscription resolution failed",
+			object: object("operators.coreos.com/v1alpha1", "Subscription", 1, map[string]interface{}{
+				"status": map[string]interface{}{"conditions": []interface{}{map[string]interface{}{"type": "ResolutionFailed", "status": "True"}}},
+			}),
+			expectedHealth: hiveintv1alpha1.DegradedSyncSetHealth,
+		},
+		{
+			name: "csv installing",
+			object: object("operators.coreos.com/v1alpha1", "ClusterServiceVersion", 1, map[string]interface{}{
+				"status": map[string]interface{}{"phase": "Installing"},
+			}),
+			expectedHealth: hiveintv1alpha1.ProgressingSyncSetHealth,
+		},
+		{
+			name: "csv failed",
+			object: object("operators.coreos.com/v1alpha1", "ClusterServiceVersion", 1, map[string]interface{}{
+				"status": map[string]interface{}{"phase": "Failed"},
+			}),
+			expectedHealth: hiveintv1alpha1.DegradedSyncSetHealth,
+		},
+		{
+			name:        "custom healthy",
+			healthCheck: customRule(`has(object.status) && has(object.status.phase) && object.status.phase == "Ready"`, `has(object.status) && has(object.status.phase) && object.status.phase == "Error"`),
+			object: object("example.com/v1", "Widget", 1, map[string]interface{}{
+				"status": map[string]interface{}{"phase": "Ready"},
+			}),
+			expectedHealth: hiveintv1alpha1.HealthySyncSetHealth,
+		},
+		{
+			name:           "custom progressing",
+			healthCheck:    customRule(`has(object.status) && has(object.status.phase) && object.status.phase == "Ready"`, `has(object.status) && has(object.status.phase) && object.status.phase == "Error"`),
+			object:         object("example.com/v1", "Widget", 1, map[string]interface{}{}),
+			expectedHealth: hiveintv1alpha1.ProgressingSyncSetHealth,
+		},
+		{
+			name:        "custom degraded",
+			healthCheck: customRule(`has(object.status) && has(object.status.phase) && object.status.phase == "Ready"`, `has(object.status) && has(object.status.phase) && object.status.phase == "Error"`),
+			object: object("example.com/v1", "Widget", 1, map[string]interface{}{
+				"status": map[string]interface{}{"phase": "Error"},
+			}),
+			expectedHealth: hiveintv1alpha1.DegradedSyncSetHealth,
+		},
+		{
+			name:        "custom evaluation error",
+			healthCheck: customRule(`object.status.phase == "Ready"`, ""),
+			object:      object("example.com/v1", "Widget", 1, map[string]interface{}{}),
+			expectError: true,
+		},
+		{
+			name:        "custom expression over cost limit",
+			healthCheck: customRule(`object.spec.items.all(a, object.spec.items.all(b, object.spec.items.all(c, a + b + c >= 0)))`, ""),
+			object: object("example.com/v1", "Widget", 1, map[string]interface{}{
+				"spec": map[string]interface{}{"items": func() []interface{} {
+					items := make([]interface{}, 200)
+					for i := range items {
+						items[i] = int64(i)
+					}
+					return items
+				}()},
+			}),
+			expectError: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{Object: tc.object}
+			assessor := NewAssessor(tc.healthCheck)
+			require.True(t, assessor.Assesses(obj.GetAPIVersion(), obj.GetKind()), "expected kind to be assessed")
+			health, message, err := assessor.Assess(obj)
+			if tc.expectError {
+				assert.Error(t, err, "expected error")
+				return
+			}
+			require.NoError(t, err, "unexpected error")
+			assert.Equal(t, tc.expectedHealth, health, "unexpected health")
+			if health == hiveintv1alpha1.HealthySyncSetHealth {
+				assert.Empty(t, message, "expected no message for healthy resource")
+			} else {
+				assert.NotEmpty(t, message, "expected message for unhealthy resource")
+			}
+		})
+	}
+}
+
+func TestAssesses(t *testing.T) {
+	assessor := NewAssessor(customRule("true", ""))
+	assert.True(t, assessor.Assesses("apps/v1", "Deployment"))
+	assert.True(t, assessor.Assesses("example.com/v1", "Widget"))
+	assert.True(t, assessor.Assesses("example.com/v2", "Widget"))
+	assert.False(t, assessor.Assesses("v1", "ConfigMap"))
+	assert.False(t, NewAssessor(nil).Assesses("example.com/v1", "Widget"))
+}
+
+func TestCompile(t *testing.T) {
+	_, err := Compile(`object.metadata.name == "test"`)
+	assert.NoError(t, err, "unexpected error for valid expression")
+	_, err = Compile(`object.metadata.name ==`)
+	assert.Error(t, err, "expected error for invalid expression")
+	_, err = Compile(`"not a bool"`)
+	assert.Error(t, err, "expected error for non-bool expression")
+}
+
+func TestRollup(t *testing.T) {
+	healthy := hiveintv1alpha1.ResourceHealth{Health: hiveintv1alpha1.HealthySyncSetHealth}
+	progressing := hiveintv1alpha1.ResourceHealth{Health: hiveintv1alpha1.ProgressingSyncSetHealth}
+	degraded := hiveintv1alpha1.ResourceHealth{Health: hiveintv1alpha1.DegradedSyncSetHealth}
+	assert.Equal(t, hiveintv1alpha1.HealthySyncSetHealth, Rollup(nil))
+	assert.Equal(t, hiveintv1alpha1.HealthySyncSetHealth, Rollup([]hiveintv1alpha1.ResourceHealth{healthy}))
+	assert.Equal(t, hiveintv1alpha1.ProgressingSyncSetHealth, Rollup([]hiveintv1alpha1.ResourceHealth{healthy, progressing}))
+	assert.Equal(t, hiveintv1alpha1.DegradedSyncSetHealth, Rollup([]hiveintv1alpha1.ResourceHealth{progressing, degraded, healthy}))
+}
+
+func object(apiVersion, kind string, generation int64, fields map[string]interface{}) map[string]interface{} {
+	obj := map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name":       "test",
+			"namespace":  "test-namespace",
+			"generation": generation,
+		},
+	}
+	for k, v := range fields {
+		obj[k] = v
+	}
+	return obj
+}
+
+func customRule(healthy, degraded string) *hivev1.SyncSetHealthCheck {
+	return &hivev1.SyncSetHealthCheck{
+		CustomRules: []hivev1.CustomHealthRule{{
+			APIVersion: "example.com/v1",
+			Kind:       "Widget",
+			Healthy:    healthy,
+			Degraded:   degraded,
+		}},
+	}
+}
@@ -0,0 +1,210 @@
+package syncsetsource
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+)
+
+var commitSHARegexp = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// gitURLSchemes are the schemes of the repository URLs that may be fetched. Other transports, such as ext:: or
+// file://, would let the author of a syncset run commands or read files on the hub.
+var gitURLSchemes = sets.New("https", "ssh")
+
+// ValidateGitURL checks that a Git repository URL uses an allowed transport and cannot be taken for an option by git.
+func ValidateGitURL(repoURL string) error {
+	return validateGitURL(repoURL, gitURLSchemes)
+}
+
+func validateGitURL(repoURL string, schemes sets.Set[string]) error {
+	if strings.HasPrefix(repoURL, "-") {
+		return errors.New("must not start with -")
+	}
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return err
+	}
+	if !schemes.Has(u.Scheme) {
+		return errors.Errorf("scheme must be one of %s", strings.Join(sets.List(schemes), ", "))
+	}
+	if u.Scheme != "file" && u.Host == "" {
+		return errors.New("must have a host")
+	}
+	// ssh is run with the host and user as arguments.
+	if strings.HasPrefix(u.Hostname(), "-") || strings.HasPrefix(u.User.Username(), "-") {
+		return errors.New("host and user must not start with -")
+	}
+	return nil
+}
+
+// ValidateGitRevision checks that a revision names a branch, tag or commit and cannot be taken for an option by git.
+func ValidateGitRevision(revision string) error {
+	if strings.HasPrefix(revision, "-") {
+		return errors.New("must not start with -")
+	}
+	// The revision is fetched as a refspec, which must not name a local ref to update.
+	if strings.ContainsAny(revision, ": \t\n") {
+		return errors.New("must be a branch, tag or commit")
+	}
+	return nil
+}
+
+// gitFetcher fetches manifests from Git repositories using the git binary. Each repository is kept as a bare
+// repository under the cache directory so that only new objects are transferred on each fetch. Repositories fetched
+// with different credentials are kept apart, so that a commit fetched with the credentials of one syncset is never
+// served to a syncset without access to the repository.
+type gitFetcher struct {
+	cacheDir string
+	logger   log.FieldLogger
+	// urlSchemes are the schemes of the repository URLs that may be fetched.
+	urlSchemes sets.Set[string]
+
+	// locks serializes the use of each cached repository.
+	locks sync.Map
+}
+
+func (g *gitFetcher) fetch(ctx context.Context, source *hivev1.GitSyncSetSource, dir string, creds *Credentials) (string, map[string][]byte, error) {
+	// The source is validated on admission, but is checked again since it ends up in the arguments of git.
+	if err := validateGitURL(source.URL, g.urlSchemes); err != nil {
+		return "", nil, errors.Wrap(err, "invalid git url")
+	}
+	if err := ValidateGitRevision(source.Revision); err != nil {
+		return "", nil, errors.Wrap(err, "invalid git revision")
+	}
+
+	cacheKey := source.URL
+	if creds != nil {
+		cacheKey += "\x00" + creds.SecretKey
+	}
+	sum := sha256.Sum256([]byte(cacheKey))
+	repoDir := filepath.Join(g.cacheDir, "git", hex.EncodeToString(sum[:])[:16])
+	lock, _ := g.locks.LoadOrStore(repoDir, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	logger := g.logger.WithField("url", source.URL).WithField("revision", source.Revision)
+
+	if _, err := os.Stat(repoDir); os.IsNotExist(err) {
+		logger.Info("initializing cached git repository")
+		if err := os.MkdirAll(filepath.Dir(repoDir), 0700); err != nil {
+			return "", nil, errors.Wrap(err, "could not create git cache directory")
+		}
+		if _, err := g.git(ctx, "", nil, "init", "--bare", "--quiet", repoDir); err != nil {
+			return "", nil, err
+		}
+	}
+
+	revision := source.Revision
+	if revision == "" {
+		revision = "HEAD"
+	}
+
+	var commit string
+	if commitSHARegexp.MatchString(revision) {
+		// Commits are immutable, so there is no need to fetch if the commit is already in the cache.
+		if _, err := g.git(ctx, repoDir, nil, "cat-file", "-e", revision+"^{commit}"); err != nil {
+			logger.Debug("fetching branches and tags to find commit")
+			if _, err := g.git(ctx, repoDir, creds, "fetch", "--quiet", "--force", "--prune", "--", source.URL,
+				"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*"); err != nil {
+				return "", nil, err
+			}
+		}
+		commit = revision
+	} else {
+		logger.Debug("fetching revision")
+		if _, err := g.git(ctx, repoDir, creds, "fetch", "--quiet", "--force", "--no-tags", "--", source.URL, revision); err != nil {
+			return "", nil, err
+		}
+		commit = "FETCH_HEAD"
+	}
+	out, err := g.git(ctx, repoDir, nil, "rev-parse", "--verify", "--quiet", commit+"^{commit}")
+	if err != nil {
+		return "", nil, errors.Errorf("revision %s not found", revision)
+	}
+	commit = strings.TrimSpace(string(out))
+
+	args := []string{"archive", "--format=tar", commit}
+	if dir = strings.Trim(filepath.Clean("/"+dir), "/"); dir != "" {
+		args = append(args, "--", dir)
+	}
+	archive, err := g.git(ctx, repoDir, nil, args...)
+	if err != nil {
+		return "", nil, err
+	}
+	files, err := readTar(bytes.NewReader(archive))
+	if err != nil {
+		return "", nil, errors.Wrap(err, "could not read git archive")
+	}
+	return commit, files, nil
+}
+
+// git runs a git command and returns its output. Credentials are passed in the environment as an HTTP header so that
+// they do not show up in the arguments of the process.
+func (g *gitFetcher) git(ctx context.Context, repoDir string, creds *Credentials, args ...string) ([]byte, error) {
+	subcommand := args[0]
+	if repoDir != "" {
+		args = append([]string{"--git-dir", repoDir}, args...)
+	}
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if creds != nil {
+		auth := base64.StdEncoding.EncodeToString([]byte(creds.Username + ":" + creds.Password))
+		cmd.Env = append(cmd.Env,
+			"GIT_CONFIG_COUNT=1",
+			"GIT_CONFIG_KEY_0=http.extraHeader",
+			"GIT_CONFIG_VALUE_0=Authorization: Basic "+auth,
+		)
+	}
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("git %s failed: %w: %s", subcommand, err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// readTar reads the regular files in a tar archive, keyed by their paths.
+func readTar(r io.Reader) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := io.ReadAll(io.LimitReader(tr, maxFileSize+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(content) > maxFileSize {
+			return nil, errors.Errorf("file %s is larger than %d bytes", hdr.Name, maxFileSize)
+		}
+		files[hdr.Name] = content
+	}
+}
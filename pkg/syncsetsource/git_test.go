@@ -0,0 +1,219 @@
+package syncsetsource
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+)
+
+// newTestRepo creates a Git repository with a commit for each set of files and returns its URL along with the
+// commits.
+func newTestRepo(t *testing.T, commits ...map[string]string) (string, []string) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	dir := t.TempDir()
+	run := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "git %v failed: %s", args, out)
+		return strings.TrimSpace(string(out))
+	}
+	run("init", "--quiet", "--initial-branch=main")
+	var shas []string
+	for _, files := range commits {
+		for name, content := range files {
+			p := filepath.Join(dir, name)
+			require.NoError(t, os.MkdirAll(filepath.Dir(p), 0700))
+			require.NoError(t, os.WriteFile(p, []byte(content), 0600))
+		}
+		run("add", "-A")
+		run("commit", "--quiet", "-m", "commit")
+		shas = append(shas, run("rev-parse", "HEAD"))
+	}
+	run("tag", "v1", shas[0])
+	return "file://" + dir, shas
+}
+
+func TestGitFetch(t *testing.T) {
+	url, shas := newTestRepo(t,
+		map[string]string{
+			"manifests/cm.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: first\n",
+			"README.md":         "readme",
+		},
+		map[string]string{
+			"manifests/cm.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: second\n",
+		},
+	)
+	f := NewFetcher(t.TempDir(), nil, nil, log.StandardLogger())
+	// The test repository is local.
+	f.(*fetcher).git.urlSchemes = sets.New("file")
+
+	cases := []struct {
+		name             string
+		revision         string
+		expectedRevision string
+		expectedName     string
+		expectErr        bool
+	}{
+		{
+			name:             "default branch",
+			expectedRevision: shas[1],
+			expectedName:     "second",
+		},
+		{
+			name:             "branch",
+			revision:         "main",
+			expectedRevision: shas[1],
+			expectedName:     "second",
+		},
+		{
+			name:             "tag",
+			revision:         "v1",
+			expectedRevision: shas[0],
+			expectedName:     "first",
+		},
+		{
+			name:             "commit",
+			revision:         shas[0],
+			expectedRevision: shas[0],
+			expectedName:     "first",
+		},
+		{
+			name:      "missing branch",
+			revision:  "missing",
+			expectErr: true,
+		},
+		{
+			name:      "option as revision",
+			revision:  "--upload-pack=touch /tmp/pwned",
+			expectErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			revision, files, err := f.Fetch(context.Background(), &hivev1.SyncSetSource{
+				Git:  &hivev1.GitSyncSetSource{URL: url, Revision: tc.revision},
+				Path: "manifests",
+			}, nil)
+			if tc.expectErr {
+				assert.Error(t, err, "expected error fetching revision")
+				return
+			}
+			require.NoError(t, err, "unexpected error fetching revision")
+			assert.Equal(t, tc.expectedRevision, revision, "unexpected revision")
+			assert.Equal(t, map[string][]byte{
+				"cm.yaml": []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: " + tc.expectedName + "\n"),
+			}, files, "unexpected files")
+		})
+	}
+}
+
+func TestValidateGit(t *testing.T) {
+	cases := []struct {
+		name      string
+		source    hivev1.GitSyncSetSource
+		expectErr bool
+	}{
+		{
+			name:   "https",
+			source: hivev1.GitSyncSetSource{URL: "https://github.com/example/manifests.git", Revision: "main"},
+		},
+		{
+			name:   "ssh",
+			source: hivev1.GitSyncSetSource{URL: "ssh://git@github.com/example/manifests.git", Revision: "v1.0.0"},
+		},
+		{
+			name:      "option as url",
+			source:    hivev1.GitSyncSetSource{URL: "--upload-pack=touch /tmp/pwned"},
+			expectErr: true,
+		},
+		{
+			name:      "option as revision",
+			source:    hivev1.GitSyncSetSource{URL: "https://github.com/example/manifests.git", Revision: "--upload-pack=id"},
+			expectErr: true,
+		},
+		{
+			name:      "refspec as revision",
+			source:    hivev1.GitSyncSetSource{URL: "https://github.com/example/manifests.git", Revision: "main:refs/heads/other"},
+			expectErr: true,
+		},
+		{
+			name:      "ext transport",
+			source:    hivev1.GitSyncSetSource{URL: "ext::sh -c touch% /tmp/pwned"},
+			expectErr: true,
+		},
+		{
+			name:      "file url",
+			source:    hivev1.GitSyncSetSource{URL: "file:///etc"},
+			expectErr: true,
+		},
+		{
+			name:      "plain http",
+			source:    hivev1.GitSyncSetSource{URL: "http://github.com/example/manifests.git"},
+			expectErr: true,
+		},
+		{
+			name:      "scp-like",
+			source:    hivev1.GitSyncSetSource{URL: "git@github.com:example/manifests.git"},
+			expectErr: true,
+		},
+		{
+			name:      "option as ssh host",
+			source:    hivev1.GitSyncSetSource{URL: "ssh://-oProxyCommand=id/example.git"},
+			expectErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateGitURL(tc.source.URL)
+			if err == nil {
+				err = ValidateGitRevision(tc.source.Revision)
+			}
+			if tc.expectErr {
+				assert.Error(t, err, "expected error")
+			} else {
+				assert.NoError(t, err, "unexpected error")
+			}
+		})
+	}
+}
+
+func TestGitFetchCacheIsolatedByCredentials(t *testing.T) {
+	url, shas := newTestRepo(t, map[string]string{
+		"cm.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: first\n",
+	})
+	f := NewFetcher(t.TempDir(), nil, nil, log.StandardLogger())
+	// The test repository is local.
+	f.(*fetcher).git.urlSchemes = sets.New("file")
+	source := &hivev1.SyncSetSource{Git: &hivev1.GitSyncSetSource{URL: url, Revision: shas[0]}}
+
+	_, _, err := f.Fetch(context.Background(), source, &Credentials{Username: "user", Password: "pass", SecretKey: "ns/creds/1"})
+	require.NoError(t, err, "unexpected error fetching with credentials")
+
+	// The commit is cached now, but must not be served to fetches with other or no credentials once the repository
+	// cannot be reached.
+	require.NoError(t, os.RemoveAll(strings.TrimPrefix(url, "file://")))
+	_, _, err = f.Fetch(context.Background(), source, nil)
+	assert.Error(t, err, "expected error fetching without credentials")
+	_, _, err = f.Fetch(context.Background(), source, &Credentials{Username: "user", Password: "pass", SecretKey: "other/creds/1"})
+	assert.Error(t, err, "expected error fetching with other credentials")
+	_, _, err = f.Fetch(context.Background(), source, &Credentials{Username: "user", Password: "pass", SecretKey: "ns/creds/1"})
+	assert.NoError(t, err, "unexpected error fetching cached commit with the same credentials")
+}
@@ -0,0 +1,186 @@
+package syncsetsource
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/repo"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+)
+
+const (
+	// helmRequestTimeout limits how long a single request to a chart repository may take.
+	helmRequestTimeout = 2 * time.Minute
+
+	// maxHelmIndexSize limits the size of the index of a chart repository.
+	maxHelmIndexSize = 32 << 20
+
+	// repoChartFile is the name of the file holding the packaged chart pulled from a chart repository among the
+	// files cached for a syncset.
+	repoChartFile = "chart.tgz"
+)
+
+// helmChartNameRegexp matches the names of charts in chart repositories.
+var helmChartNameRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// ValidateHelmChartRepo checks that a chart repository URL is an https URL.
+func ValidateHelmChartRepo(repoURL string) error {
+	return validateHelmURL(repoURL, nil)
+}
+
+// validateHelmURL checks that a URL of a chart repository or chart is an https URL, on one of the allowed hosts if
+// there are any.
+func validateHelmURL(rawURL string, allowedHosts sets.Set[string]) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" {
+		return errors.New("scheme must be https")
+	}
+	if u.Host == "" {
+		return errors.New("must have a host")
+	}
+	if allowedHosts.Len() > 0 && !allowedHosts.Has(u.Host) {
+		return errors.Errorf("host %s is not allowed by the HiveConfig", u.Host)
+	}
+	return nil
+}
+
+// ValidateHelmChartName checks that the name of a chart in a chart repository cannot be taken for a path.
+func ValidateHelmChartName(name string) error {
+	if !helmChartNameRegexp.MatchString(name) {
+		return errors.New("must consist of alphanumeric characters, '.', '_' or '-', and start with an alphanumeric character")
+	}
+	return nil
+}
+
+// ValidateHelmChartVersion checks that the version of a chart is a version or version constraint.
+func ValidateHelmChartVersion(version string) error {
+	_, err := semver.NewConstraint(version)
+	return err
+}
+
+// ValidateHelmReleaseName checks that a chart can be templated as a release of the name.
+func ValidateHelmReleaseName(name string) error {
+	return chartutil.ValidateReleaseName(name)
+}
+
+// helmFetcher pulls packaged charts from chart repositories.
+type helmFetcher struct {
+	logger log.FieldLogger
+	client *http.Client
+	// allowedHosts are the hosts, in the form host[:port], from which charts may be pulled. Charts may be pulled from
+	// any host if empty.
+	allowedHosts sets.Set[string]
+}
+
+func newHelmFetcher(allowedHosts []string, logger log.FieldLogger) *helmFetcher {
+	h := &helmFetcher{logger: logger, allowedHosts: sets.New(allowedHosts...)}
+	h.client = &http.Client{
+		Timeout: helmRequestTimeout,
+		// Redirects are held to the same rules as the URLs of the repository and of its charts.
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			return validateHelmURL(req.URL.String(), h.allowedHosts)
+		},
+	}
+	return h
+}
+
+// fetch pulls the chart from its chart repository, returning the name and version of the chart pulled as the revision
+// along with the packaged chart as the only file.
+func (h *helmFetcher) fetch(ctx context.Context, chart *hivev1.HelmChartSyncSetRender) (string, map[string][]byte, error) {
+	// The chart is validated on admission, but is checked again before anything is fetched.
+	if err := validateHelmURL(chart.Repo, h.allowedHosts); err != nil {
+		return "", nil, errors.Wrap(err, "invalid chart repository")
+	}
+	if err := ValidateHelmChartName(chart.Chart); err != nil {
+		return "", nil, errors.Wrap(err, "invalid chart name")
+	}
+	logger := h.logger.WithField("repo", chart.Repo).WithField("chart", chart.Chart)
+
+	indexURL, err := repo.ResolveReferenceURL(chart.Repo, "index.yaml")
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "invalid chart repository %s", chart.Repo)
+	}
+	logger.Debug("fetching index of chart repository")
+	index, err := h.get(ctx, indexURL, maxHelmIndexSize)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to get index of chart repository %s", chart.Repo)
+	}
+	dir, err := os.MkdirTemp("", "syncset-helm-")
+	if err != nil {
+		return "", nil, err
+	}
+	defer os.RemoveAll(dir)
+	indexFile := filepath.Join(dir, "index.yaml")
+	if err := os.WriteFile(indexFile, index, 0600); err != nil {
+		return "", nil, err
+	}
+	indexes, err := repo.LoadIndexFile(indexFile)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to load index of chart repository %s", chart.Repo)
+	}
+	chartVersion, err := indexes.Get(chart.Chart, chart.Version)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to find chart %s in %s", chart.Chart, chart.Repo)
+	}
+	if len(chartVersion.URLs) == 0 {
+		return "", nil, errors.Errorf("chart %s version %s in %s has no URL", chart.Chart, chartVersion.Version, chart.Repo)
+	}
+	chartURL, err := repo.ResolveReferenceURL(chart.Repo, chartVersion.URLs[0])
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "invalid URL of chart %s", chart.Chart)
+	}
+	// The index may point anywhere, so the URL of the chart is checked like the URL of the repository.
+	if err := validateHelmURL(chartURL, h.allowedHosts); err != nil {
+		return "", nil, errors.Wrapf(err, "invalid URL of chart %s", chart.Chart)
+	}
+	logger.WithField("version", chartVersion.Version).Debug("pulling chart")
+	archive, err := h.get(ctx, chartURL, maxBlobSize)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to pull chart %s from %s", chart.Chart, chart.Repo)
+	}
+	return fmt.Sprintf("%s-%s", chart.Chart, chartVersion.Version), map[string][]byte{repoChartFile: archive}, nil
+}
+
+func (h *helmFetcher) get(ctx context.Context, rawURL string, maxSize int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status %s", strings.TrimSpace(resp.Status))
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > maxSize {
+		return nil, errors.Errorf("response is larger than %d bytes", maxSize)
+	}
+	return body, nil
+}
@@ -0,0 +1,132 @@
+package syncsetsource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+)
+
+// newTestChartRepo serves the test chart as version 1.2.3 of the nginx chart over https. Version 2.0.0 of the chart
+// is hosted on another host, and version 3.0.0 redirects to plain http. The packaged chart is returned along with the
+// server.
+func newTestChartRepo(t *testing.T) (*httptest.Server, []byte) {
+	archive := tarGz(t, testChartFiles)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/index.yaml":
+			fmt.Fprint(w, `apiVersion: v1
+entries:
+  nginx:
+  - apiVersion: v2
+    name: nginx
+    version: 1.2.3
+    urls:
+    - charts/nginx-1.2.3.tgz
+  - apiVersion: v2
+    name: nginx
+    version: 2.0.0
+    urls:
+    - https://charts.example.com/nginx-2.0.0.tgz
+  - apiVersion: v2
+    name: nginx
+    version: 3.0.0
+    urls:
+    - charts/nginx-3.0.0.tgz
+`)
+		case "/charts/nginx-1.2.3.tgz":
+			w.Write(archive)
+		case "/charts/nginx-3.0.0.tgz":
+			http.Redirect(w, r, "http://"+r.Host+"/charts/nginx-1.2.3.tgz", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, archive
+}
+
+func TestHelmFetch(t *testing.T) {
+	server, archive := newTestChartRepo(t)
+	host := strings.TrimPrefix(server.URL, "https://")
+	cases := []struct {
+		name             string
+		repo             string
+		version          string
+		allowedHosts     []string
+		expectedRevision string
+		expectErr        bool
+	}{
+		{
+			name:             "latest allowed version",
+			repo:             server.URL,
+			version:          "~1.2",
+			expectedRevision: "nginx-1.2.3",
+		},
+		{
+			name:             "allowed host",
+			repo:             server.URL,
+			version:          "1.2.3",
+			allowedHosts:     []string{host},
+			expectedRevision: "nginx-1.2.3",
+		},
+		{
+			name:         "host not allowed",
+			repo:         server.URL,
+			version:      "1.2.3",
+			allowedHosts: []string{"charts.example.com"},
+			expectErr:    true,
+		},
+		{
+			name:      "plain http",
+			repo:      "http://" + host,
+			version:   "1.2.3",
+			expectErr: true,
+		},
+		{
+			name:         "chart on a host that is not allowed",
+			repo:         server.URL,
+			version:      "2.0.0",
+			allowedHosts: []string{host},
+			expectErr:    true,
+		},
+		{
+			name:      "redirect to plain http",
+			repo:      server.URL,
+			version:   "3.0.0",
+			expectErr: true,
+		},
+		{
+			name:      "missing version",
+			repo:      server.URL,
+			version:   "4.0.0",
+			expectErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHelmFetcher(tc.allowedHosts, log.StandardLogger())
+			h.client.Transport = server.Client().Transport
+			revision, files, err := h.fetch(context.Background(), &hivev1.HelmChartSyncSetRender{
+				Chart:   "nginx",
+				Repo:    tc.repo,
+				Version: tc.version,
+			})
+			if tc.expectErr {
+				assert.Error(t, err, "expected error fetching chart")
+				return
+			}
+			require.NoError(t, err, "unexpected error fetching chart")
+			assert.Equal(t, tc.expectedRevision, revision, "unexpected revision")
+			assert.Equal(t, map[string][]byte{repoChartFile: archive}, files, "unexpected files")
+		})
+	}
+}
@@ -0,0 +1,323 @@
+package syncsetsource
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+)
+
+const (
+	ociManifestMediaType    = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+
+	// ociTitleAnnotation names the file contained in a layer of an artifact pushed by tools such as oras.
+	ociTitleAnnotation = "org.opencontainers.image.title"
+
+	defaultRegistry = "registry-1.docker.io"
+
+	// ociRequestTimeout limits how long a single request to a registry may take.
+	ociRequestTimeout = 2 * time.Minute
+
+	// maxBlobSize limits the size of a layer of an artifact.
+	maxBlobSize = 64 << 20
+	// maxFileSize limits the size of a single file read from a source.
+	maxFileSize = 16 << 20
+)
+
+// ociFetcher fetches manifests from OCI artifacts using the OCI distribution API.
+type ociFetcher struct {
+	logger log.FieldLogger
+	client *http.Client
+	// insecureRegistries are the registries from which artifacts may be fetched over plain HTTP.
+	insecureRegistries sets.Set[string]
+}
+
+type ociReference struct {
+	registry   string
+	repository string
+	// reference is the tag or digest of the artifact.
+	reference string
+}
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type ociManifest struct {
+	MediaType string          `json:"mediaType"`
+	Layers    []ociDescriptor `json:"layers"`
+}
+
+// parseOCIReference parses an image reference of the form [registry/]repository[:tag][@digest].
+func parseOCIReference(image string) (*ociReference, error) {
+	ref := &ociReference{registry: defaultRegistry}
+	name := image
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, ref.reference = name[:i], name[i+1:]
+	}
+	if i := strings.Index(name, "/"); i >= 0 {
+		if first := name[:i]; strings.ContainsAny(first, ".:") || first == "localhost" {
+			ref.registry, name = first, name[i+1:]
+		}
+	}
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		if ref.reference == "" {
+			ref.reference = name[i+1:]
+		}
+		name = name[:i]
+	}
+	if name == "" {
+		return nil, errors.Errorf("invalid image reference %q", image)
+	}
+	if ref.registry == defaultRegistry && !strings.Contains(name, "/") {
+		name = "library/" + name
+	}
+	if ref.reference == "" {
+		ref.reference = "latest"
+	}
+	ref.repository = name
+	return ref, nil
+}
+
+func (o *ociFetcher) fetch(ctx context.Context, source *hivev1.OCISyncSetSource, creds *Credentials) (string, map[string][]byte, error) {
+	ref, err := parseOCIReference(source.Image)
+	if err != nil {
+		return "", nil, err
+	}
+	scheme := "https"
+	if source.Insecure {
+		if !o.insecureRegistries.Has(ref.registry) {
+			return "", nil, errors.Errorf("registry %s is not allowed to be insecure by the HiveConfig", ref.registry)
+		}
+		scheme = "http"
+	}
+	r := &registryClient{
+		client:   o.client,
+		baseURL:  fmt.Sprintf("%s://%s/v2/%s", scheme, ref.registry, ref.repository),
+		registry: ref.registry,
+		insecure: source.Insecure,
+		creds:    creds,
+	}
+	logger := o.logger.WithField("image", source.Image)
+
+	logger.Debug("fetching artifact manifest")
+	body, header, err := r.get(ctx, "/manifests/"+ref.reference, strings.Join([]string{ociManifestMediaType, dockerManifestMediaType}, ", "), maxFileSize)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "could not fetch artifact manifest")
+	}
+	revision := header.Get("Docker-Content-Digest")
+	if revision == "" {
+		revision = sha256Digest(body)
+	}
+	if strings.HasPrefix(ref.reference, "sha256:") {
+		if digest := sha256Digest(body); digest != ref.reference {
+			return "", nil, errors.Errorf("digest of artifact manifest %s does not match %s", digest, ref.reference)
+		}
+		revision = ref.reference
+	}
+	manifest := &ociManifest{}
+	if err := json.Unmarshal(body, manifest); err != nil {
+		return "", nil, errors.Wrap(err, "could not decode artifact manifest")
+	}
+	switch manifest.MediaType {
+	case "", ociManifestMediaType, dockerManifestMediaType:
+	default:
+		return "", nil, errors.Errorf("unsupported artifact manifest media type %s", manifest.MediaType)
+	}
+
+	files := map[string][]byte{}
+	for i, layer := range manifest.Layers {
+		logger.WithField("digest", layer.Digest).Debug("fetching artifact layer")
+		blob, _, err := r.get(ctx, "/blobs/"+layer.Digest, "", maxBlobSize)
+		if err != nil {
+			return "", nil, errors.Wrapf(err, "could not fetch layer %d", i)
+		}
+		if digest := sha256Digest(blob); digest != layer.Digest {
+			return "", nil, errors.Errorf("digest of layer %d is %s, expected %s", i, digest, layer.Digest)
+		}
+		if title := layer.Annotations[ociTitleAnnotation]; title != "" && !isTarMediaType(layer.MediaType) {
+			if len(blob) > maxFileSize {
+				return "", nil, errors.Errorf("file %s is larger than %d bytes", title, maxFileSize)
+			}
+			files[title] = blob
+			continue
+		}
+		var reader io.Reader = bytes.NewReader(blob)
+		if bytes.HasPrefix(blob, []byte{0x1f, 0x8b}) {
+			gz, err := gzip.NewReader(reader)
+			if err != nil {
+				return "", nil, errors.Wrapf(err, "could not decompress layer %d", i)
+			}
+			reader = gz
+		}
+		layerFiles, err := readTar(reader)
+		if err != nil {
+			return "", nil, errors.Wrapf(err, "could not read layer %d", i)
+		}
+		for name, content := range layerFiles {
+			files[name] = content
+		}
+	}
+	return revision, files, nil
+}
+
+func isTarMediaType(mediaType string) bool {
+	return strings.Contains(mediaType, ".tar") || strings.Contains(mediaType, "tar+")
+}
+
+func sha256Digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// registryClient makes requests to a repository of a registry, authenticating as challenged by the registry.
+type registryClient struct {
+	client  *http.Client
+	baseURL string
+	// registry is the host of the registry. Credentials are only sent to this host.
+	registry string
+	// insecure is true if the registry is accessed over plain HTTP.
+	insecure bool
+	creds    *Credentials
+	// authorization is the Authorization header obtained from the last challenge.
+	authorization string
+}
+
+func (r *registryClient) get(ctx context.Context, path, accept string, limit int64) ([]byte, http.Header, error) {
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.baseURL+path, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		if r.authorization != "" {
+			req.Header.Set("Authorization", r.authorization)
+		}
+		resp, err := r.client.Do(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		body, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+		resp.Body.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+		if resp.StatusCode == http.StatusUnauthorized && attempt == 0 {
+			if err := r.authorize(ctx, resp.Header.Get("WWW-Authenticate")); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, nil, errors.Errorf("unexpected status %s from %s", resp.Status, req.URL)
+		}
+		if int64(len(body)) > limit {
+			return nil, nil, errors.Errorf("response from %s is larger than %d bytes", req.URL, limit)
+		}
+		return body, resp.Header, nil
+	}
+}
+
+// authorize responds to a WWW-Authenticate challenge from the registry.
+func (r *registryClient) authorize(ctx context.Context, challenge string) error {
+	scheme, params := parseChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if r.creds == nil {
+			return errors.New("registry requires credentials")
+		}
+		r.authorization = "Basic " + base64.StdEncoding.EncodeToString([]byte(r.creds.Username+":"+r.creds.Password))
+		return nil
+	case "bearer":
+	default:
+		return errors.Errorf("unsupported registry authentication challenge %q", challenge)
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return errors.Errorf("invalid realm in registry authentication challenge %q", challenge)
+	}
+	if realm.Scheme != "https" && !(realm.Scheme == "http" && r.insecure) {
+		return errors.Errorf("unsupported scheme of realm %s in registry authentication challenge", realm.Redacted())
+	}
+	query := realm.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	if scope := params["scope"]; scope != "" {
+		query.Set("scope", scope)
+	}
+	realm.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return err
+	}
+	// A token may be requested anonymously from a realm on another host, but the credentials are only for the registry.
+	if r.creds != nil && realm.Host == r.registry {
+		req.SetBasicAuth(r.creds.Username, r.creds.Password)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not get registry token")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %s getting registry token", resp.Status)
+	}
+	token := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&token); err != nil {
+		return errors.Wrap(err, "could not decode registry token")
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	if token.Token == "" {
+		return errors.New("registry did not return a token")
+	}
+	r.authorization = "Bearer " + token.Token
+	return nil
+}
+
+// parseChallenge parses a WWW-Authenticate header of the form `Scheme key="value",key="value"`.
+func parseChallenge(challenge string) (string, map[string]string) {
+	params := map[string]string{}
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, ", "), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		if key = strings.TrimSpace(key); key != "" {
+			params[strings.ToLower(key)] = value
+		}
+	}
+	return scheme, params
+}
@@ -0,0 +1,232 @@
+package syncsetsource
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+)
+
+func TestParseOCIReference(t *testing.T) {
+	cases := []struct {
+		image    string
+		expected *ociReference
+	}{
+		{
+			image:    "manifests",
+			expected: &ociReference{registry: defaultRegistry, repository: "library/manifests", reference: "latest"},
+		},
+		{
+			image:    "quay.io/org/manifests:v1",
+			expected: &ociReference{registry: "quay.io", repository: "org/manifests", reference: "v1"},
+		},
+		{
+			image:    "localhost:5000/manifests@sha256:abc",
+			expected: &ociReference{registry: "localhost:5000", repository: "manifests", reference: "sha256:abc"},
+		},
+		{
+			image:    "org/manifests:v1@sha256:abc",
+			expected: &ociReference{registry: defaultRegistry, repository: "org/manifests", reference: "sha256:abc"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.image, func(t *testing.T) {
+			ref, err := parseOCIReference(tc.image)
+			require.NoError(t, err, "unexpected error")
+			assert.Equal(t, tc.expected, ref, "unexpected reference")
+		})
+	}
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:org/manifests:pull"`)
+	assert.Equal(t, "Bearer", scheme, "unexpected scheme")
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry.example.com",
+		"scope":   "repository:org/manifests:pull",
+	}, params, "unexpected params")
+}
+
+// testRegistry serves a single artifact from the "org/manifests:v1" repository, requiring a bearer token obtained
+// with the given credentials.
+type testRegistry struct {
+	manifest []byte
+	blobs    map[string][]byte
+	username string
+	password string
+}
+
+func newTestRegistry(t *testing.T, layers map[string][]byte, username, password string) (*httptest.Server, string) {
+	reg := &testRegistry{blobs: map[string][]byte{}, username: username, password: password}
+	manifest := ociManifest{MediaType: ociManifestMediaType}
+	for mediaType, blob := range layers {
+		digest := sha256Digest(blob)
+		reg.blobs[digest] = blob
+		desc := ociDescriptor{MediaType: mediaType, Digest: digest, Size: int64(len(blob))}
+		if !isTarMediaType(mediaType) {
+			desc.Annotations = map[string]string{ociTitleAnnotation: "manifests/b.yaml"}
+		}
+		manifest.Layers = append(manifest.Layers, desc)
+	}
+	var err error
+	reg.manifest, err = json.Marshal(manifest)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(reg)
+	t.Cleanup(server.Close)
+	return server, sha256Digest(reg.manifest)
+}
+
+func (reg *testRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/token" {
+		if u, p, _ := r.BasicAuth(); u != reg.username || p != reg.password {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"token":"secret-token"}`)
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer secret-token" {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="http://%s/token",service="test",scope="repository:org/manifests:pull"`, r.Host))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	switch {
+	case r.URL.Path == "/v2/org/manifests/manifests/v1" || r.URL.Path == "/v2/org/manifests/manifests/"+sha256Digest(reg.manifest):
+		w.Header().Set("Content-Type", ociManifestMediaType)
+		w.Write(reg.manifest)
+	case strings.HasPrefix(r.URL.Path, "/v2/org/manifests/blobs/"):
+		blob, ok := reg.blobs[strings.TrimPrefix(r.URL.Path, "/v2/org/manifests/blobs/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(blob)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func tarGz(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestOCIFetch(t *testing.T) {
+	layers := map[string][]byte{
+		"application/vnd.oci.image.layer.v1.tar+gzip": tarGz(t, map[string]string{
+			"manifests/a.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n",
+		}),
+		"application/yaml": []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: b\n"),
+	}
+	server, digest := newTestRegistry(t, layers, "user", "pass")
+	host := strings.TrimPrefix(server.URL, "http://")
+	fetcher := NewFetcher(t.TempDir(), []string{host}, nil, log.StandardLogger())
+
+	cases := []struct {
+		name          string
+		image         string
+		creds         *Credentials
+		expectedNames []string
+		expectErr     bool
+	}{
+		{
+			name:          "tag",
+			image:         host + "/org/manifests:v1",
+			creds:         &Credentials{Username: "user", Password: "pass"},
+			expectedNames: []string{"a", "b"},
+		},
+		{
+			name:          "digest",
+			image:         host + "/org/manifests@" + digest,
+			creds:         &Credentials{Username: "user", Password: "pass"},
+			expectedNames: []string{"a", "b"},
+		},
+		{
+			name:      "wrong credentials",
+			image:     host + "/org/manifests:v1",
+			creds:     &Credentials{Username: "user", Password: "wrong"},
+			expectErr: true,
+		},
+		{
+			name:      "insecure registry not allowed",
+			image:     "127.0.0.2" + strings.TrimPrefix(host, "127.0.0.1") + "/org/manifests:v1",
+			creds:     &Credentials{Username: "user", Password: "pass"},
+			expectErr: true,
+		},
+		{
+			name:      "missing tag",
+			image:     host + "/org/manifests:v2",
+			creds:     &Credentials{Username: "user", Password: "pass"},
+			expectErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			revision, files, err := fetcher.Fetch(context.Background(), &hivev1.SyncSetSource{
+				OCI: &hivev1.OCISyncSetSource{Image: tc.image, Insecure: true},
+			}, tc.creds)
+			if tc.expectErr {
+				assert.Error(t, err, "expected error fetching artifact")
+				return
+			}
+			require.NoError(t, err, "unexpected error fetching artifact")
+			assert.Equal(t, digest, revision, "unexpected revision")
+			manifests, err := ManifestsFromFiles(files)
+			require.NoError(t, err, "unexpected error decoding manifests")
+			if assert.Len(t, manifests, len(tc.expectedNames), "unexpected number of manifests") {
+				for i, name := range tc.expectedNames {
+					assert.Contains(t, string(manifests[i].Raw), `"name":"`+name+`"`, "unexpected manifest")
+				}
+			}
+		})
+	}
+}
+
+func TestOCIFetchTokenFromOtherHost(t *testing.T) {
+	var tokenAuthorization string
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenAuthorization = r.Header.Get("Authorization")
+		fmt.Fprint(w, `{"token":"secret-token"}`)
+	}))
+	t.Cleanup(tokenServer.Close)
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret-token" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token"`, strings.Replace(tokenServer.URL, "127.0.0.1", "localhost", 1)))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"layers":[]}`))
+	}))
+	t.Cleanup(registry.Close)
+	host := strings.TrimPrefix(registry.URL, "http://")
+
+	fetcher := NewFetcher(t.TempDir(), []string{host}, nil, log.StandardLogger())
+	_, _, err := fetcher.Fetch(context.Background(), &hivev1.SyncSetSource{
+		OCI: &hivev1.OCISyncSetSource{Image: host + "/org/manifests:v1", Insecure: true},
+	}, &Credentials{Username: "user", Password: "pass"})
+	require.NoError(t, err, "unexpected error fetching artifact")
+	assert.Empty(t, tokenAuthorization, "credentials sent to realm on another host")
+}
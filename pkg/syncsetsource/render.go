@@ -0,0 +1,189 @@
+package syncsetsource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"helm.sh/helm/v3/pkg/action"
+	helmchart "helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+)
+
+// IsRendered returns true if the manifests of the syncset are rendered with Kustomize or Helm. The files of the
+// source of a rendered syncset are cached rather than its manifests.
+func IsRendered(spec *hivev1.SyncSetCommonSpec) bool {
+	return spec.Kustomize != nil || spec.HelmChart != nil
+}
+
+// IsFetched returns true if the syncsetsource controller fetches the source of the syncset, or the Helm chart of the
+// syncset from a chart repository, into the cache secret of the syncset.
+func IsFetched(spec *hivev1.SyncSetCommonSpec) bool {
+	return spec.Source != nil || (spec.HelmChart != nil && spec.HelmChart.Repo != "")
+}
+
+// Render renders the manifests of a syncset with Kustomize or Helm from the files cached for it by the syncsetsource
+// controller, which may be empty if the syncset is not fetched. Nothing is fetched while rendering.
+func Render(ctx context.Context, spec *hivev1.SyncSetCommonSpec, syncSetName string, files map[string][]byte, cd *hivev1.ClusterDeployment) ([]runtime.RawExtension, error) {
+	switch {
+	case spec.Kustomize != nil && spec.HelmChart != nil:
+		return nil, errors.New("only one of kustomize or helmChart may be set")
+	case spec.Kustomize != nil:
+		return renderKustomize(spec.Kustomize, files)
+	case spec.HelmChart != nil:
+		return renderHelmChart(ctx, spec.HelmChart, syncSetName, files, cd)
+	default:
+		return nil, nil
+	}
+}
+
+// renderKustomize builds the overlay in memory, so bases outside of the files cannot be loaded.
+func renderKustomize(kustomize *hivev1.KustomizeSyncSetRender, files map[string][]byte) ([]runtime.RawExtension, error) {
+	fs := filesys.MakeFsInMemory()
+	write := func(name string, content []byte) error {
+		name = path.Clean("/" + name)
+		if err := fs.MkdirAll(path.Dir(name)); err != nil {
+			return err
+		}
+		return fs.WriteFile(name, content)
+	}
+	for name, content := range files {
+		if err := write(name, content); err != nil {
+			return nil, err
+		}
+	}
+	for name, content := range kustomize.Files {
+		if err := write(name, []byte(content)); err != nil {
+			return nil, err
+		}
+	}
+
+	resMap, err := krusty.MakeKustomizer(krusty.MakeDefaultOptions()).Run(fs, path.Clean("/"+kustomize.Path))
+	if err != nil {
+		return nil, errors.Wrap(err, "kustomize build failed")
+	}
+	manifests := make([]runtime.RawExtension, 0, resMap.Size())
+	for _, r := range resMap.Resources() {
+		raw, err := r.MarshalJSON()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to encode %s", r.CurId())
+		}
+		manifests = append(manifests, runtime.RawExtension{Raw: raw})
+	}
+	return manifests, nil
+}
+
+// renderHelmChart templates the chart in the same way as helm template. The chart is read from the files of the
+// source, or from the packaged chart pulled from the chart repository.
+func renderHelmChart(ctx context.Context, chart *hivev1.HelmChartSyncSetRender, syncSetName string, files map[string][]byte, cd *hivev1.ClusterDeployment) ([]runtime.RawExtension, error) {
+	values := chart.Values
+	if chart.TemplateValues {
+		var err error
+		if values, err = templateValues(values, cd); err != nil {
+			return nil, err
+		}
+	}
+	vals, err := chartutil.ReadValues([]byte(values))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse values")
+	}
+
+	chartPath := chart.Chart
+	if chart.Repo != "" {
+		chartPath = repoChartFile
+	}
+	loaded, err := loadHelmChart(chartPath, files)
+	if err != nil {
+		return nil, err
+	}
+
+	install := action.NewInstall(&action.Configuration{Log: func(string, ...interface{}) {}})
+	install.DryRun = true
+	install.ClientOnly = true
+	install.Replace = true
+	install.ReleaseName = chart.ReleaseName
+	if install.ReleaseName == "" {
+		install.ReleaseName = syncSetName
+	}
+	install.Namespace = chart.Namespace
+	if install.Namespace == "" {
+		install.Namespace = corev1.NamespaceDefault
+	}
+	install.IncludeCRDs = chart.IncludeCRDs
+	rel, err := install.RunWithContext(ctx, loaded, vals)
+	if err != nil {
+		return nil, errors.Wrap(err, "helm template failed")
+	}
+
+	manifests := &bytes.Buffer{}
+	manifests.WriteString(rel.Manifest)
+	for _, hook := range rel.Hooks {
+		fmt.Fprintf(manifests, "\n---\n# Source: %s\n%s\n", hook.Path, hook.Manifest)
+	}
+	return ManifestsFromFiles(map[string][]byte{"manifests.yaml": manifests.Bytes()})
+}
+
+// loadHelmChart loads the chart from the files of the source. The chart is either a packaged chart or the directory
+// containing the chart.
+func loadHelmChart(chartPath string, files map[string][]byte) (*helmchart.Chart, error) {
+	chartPath = strings.Trim(path.Clean("/"+chartPath), "/")
+	if archive, ok := files[chartPath]; ok {
+		loaded, err := loader.LoadArchive(bytes.NewReader(archive))
+		return loaded, errors.Wrapf(err, "failed to load chart %s", chartPath)
+	}
+	chartFiles := filesUnder(files, chartPath)
+	if len(chartFiles) == 0 {
+		return nil, errors.Errorf("chart %s not found in source", chartPath)
+	}
+	buffered := make([]*loader.BufferedFile, 0, len(chartFiles))
+	for name, content := range chartFiles {
+		buffered = append(buffered, &loader.BufferedFile{Name: name, Data: content})
+	}
+	loaded, err := loader.LoadFiles(buffered)
+	return loaded, errors.Wrapf(err, "failed to load chart %s", chartPath)
+}
+
+// valuesTemplateData is the data available to the template of the values of a Helm chart.
+type valuesTemplateData struct {
+	ClusterDeployment valuesTemplateClusterDeployment
+}
+
+type valuesTemplateClusterDeployment struct {
+	Name      string
+	Namespace string
+	Labels    map[string]string
+}
+
+// ParseValuesTemplate parses the values of a Helm chart as a template.
+func ParseValuesTemplate(values string) (*template.Template, error) {
+	return template.New("values").Option("missingkey=error").Parse(values)
+}
+
+func templateValues(values string, cd *hivev1.ClusterDeployment) (string, error) {
+	tmpl, err := ParseValuesTemplate(values)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse values template")
+	}
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, valuesTemplateData{
+		ClusterDeployment: valuesTemplateClusterDeployment{
+			Name:      cd.Name,
+			Namespace: cd.Namespace,
+			Labels:    cd.Labels,
+		},
+	}); err != nil {
+		return "", errors.Wrap(err, "failed to execute values template")
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,215 @@
+package syncsetsource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+)
+
+func TestRenderKustomize(t *testing.T) {
+	sourceFiles := map[string][]byte{
+		"base/kustomization.yaml": []byte("resources:\n- cm.yaml\n"),
+		"base/cm.yaml":            []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\ndata:\n  key: base\n"),
+	}
+	cases := []struct {
+		name      string
+		kustomize *hivev1.KustomizeSyncSetRender
+		files     map[string][]byte
+		expected  []string
+		expectErr bool
+	}{
+		{
+			name:      "source",
+			kustomize: &hivev1.KustomizeSyncSetRender{Path: "base"},
+			files:     sourceFiles,
+			expected: []string{
+				`{"apiVersion":"v1","data":{"key":"base"},"kind":"ConfigMap","metadata":{"name":"config"}}`,
+			},
+		},
+		{
+			name: "overlay on source",
+			kustomize: &hivev1.KustomizeSyncSetRender{
+				Files: map[string]string{
+					"kustomization.yaml": "resources:\n- base\nnamePrefix: prod-\nnamespace: prod\n",
+				},
+			},
+			files: sourceFiles,
+			expected: []string{
+				`{"apiVersion":"v1","data":{"key":"base"},"kind":"ConfigMap","metadata":{"name":"prod-config","namespace":"prod"}}`,
+			},
+		},
+		{
+			name: "no source",
+			kustomize: &hivev1.KustomizeSyncSetRender{
+				Files: map[string]string{
+					"kustomization.yaml": "configMapGenerator:\n- name: generated\n  literals:\n  - key=value\ngeneratorOptions:\n  disableNameSuffixHash: true\n",
+				},
+			},
+			expected: []string{
+				`{"apiVersion":"v1","data":{"key":"value"},"kind":"ConfigMap","metadata":{"name":"generated"}}`,
+			},
+		},
+		{
+			name:      "missing kustomization",
+			kustomize: &hivev1.KustomizeSyncSetRender{Path: "missing"},
+			files:     sourceFiles,
+			expectErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			manifests, err := Render(context.Background(), &hivev1.SyncSetCommonSpec{Kustomize: tc.kustomize}, "test-syncset", tc.files, nil)
+			if tc.expectErr {
+				assert.Error(t, err, "expected error rendering")
+				return
+			}
+			require.NoError(t, err, "unexpected error rendering")
+			actual := make([]string, len(manifests))
+			for i, m := range manifests {
+				actual[i] = string(m.Raw)
+			}
+			assert.Equal(t, tc.expected, actual, "unexpected manifests")
+		})
+	}
+}
+
+// testChartFiles are the files of a chart with a CRD and a hook, rendering a ConfigMap holding its values.
+var testChartFiles = map[string]string{
+	"nginx/Chart.yaml":  "apiVersion: v2\nname: nginx\nversion: 1.2.3\n",
+	"nginx/values.yaml": "replicas: 1\n",
+	"nginx/crds/widgets.yaml": `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+`,
+	"nginx/templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}
+  namespace: {{ .Release.Namespace }}
+data:
+  values: {{ toYaml .Values | quote }}
+`,
+	"nginx/templates/hook.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}-hook
+  annotations:
+    helm.sh/hook: pre-install
+`,
+}
+
+func TestRenderHelmChart(t *testing.T) {
+	sourceFiles := map[string][]byte{"charts/nginx-1.2.3.tgz": tarGz(t, testChartFiles)}
+	for name, content := range testChartFiles {
+		sourceFiles["charts/"+name] = []byte(content)
+	}
+	cd := &hivev1.ClusterDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "cluster-ns",
+			Name:      "cluster",
+			Labels:    map[string]string{"region": "us-east-1"},
+		},
+	}
+	cases := []struct {
+		name              string
+		chart             *hivev1.HelmChartSyncSetRender
+		expectedNames     []string
+		expectedNamespace string
+		expectedValues    string
+		expectErr         bool
+	}{
+		{
+			name: "repo",
+			chart: &hivev1.HelmChartSyncSetRender{
+				Chart:       "nginx",
+				Repo:        "https://charts.example.com",
+				Version:     "1.2.3",
+				Namespace:   "web",
+				IncludeCRDs: true,
+				Values:      "replicas: 2\n",
+			},
+			expectedNames:     []string{"widgets.example.com", "test-syncset", "test-syncset-hook"},
+			expectedNamespace: "web",
+			expectedValues:    "replicas: 2",
+		},
+		{
+			name: "source",
+			chart: &hivev1.HelmChartSyncSetRender{
+				Chart:       "charts/nginx",
+				ReleaseName: "web",
+			},
+			expectedNames:     []string{"web", "web-hook"},
+			expectedNamespace: "default",
+			expectedValues:    "replicas: 1",
+		},
+		{
+			name: "packaged chart in source",
+			chart: &hivev1.HelmChartSyncSetRender{
+				Chart: "charts/nginx-1.2.3.tgz",
+			},
+			expectedNames:     []string{"test-syncset", "test-syncset-hook"},
+			expectedNamespace: "default",
+			expectedValues:    "replicas: 1",
+		},
+		{
+			name: "missing chart in source",
+			chart: &hivev1.HelmChartSyncSetRender{
+				Chart: "charts/missing",
+			},
+			expectErr: true,
+		},
+		{
+			name: "templated values",
+			chart: &hivev1.HelmChartSyncSetRender{
+				Chart:          "charts/nginx",
+				Values:         "cluster: {{ .ClusterDeployment.Namespace }}/{{ .ClusterDeployment.Name }}\nregion: {{ .ClusterDeployment.Labels.region }}\n",
+				TemplateValues: true,
+			},
+			expectedNames:     []string{"test-syncset", "test-syncset-hook"},
+			expectedNamespace: "default",
+			expectedValues:    "cluster: cluster-ns/cluster\nregion: us-east-1\nreplicas: 1",
+		},
+		{
+			name: "templated values with missing label",
+			chart: &hivev1.HelmChartSyncSetRender{
+				Chart:          "charts/nginx",
+				Values:         "zone: {{ .ClusterDeployment.Labels.zone }}\n",
+				TemplateValues: true,
+			},
+			expectErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			files := sourceFiles
+			if tc.chart.Repo != "" {
+				files = map[string][]byte{repoChartFile: tarGz(t, testChartFiles)}
+			}
+			manifests, err := Render(context.Background(), &hivev1.SyncSetCommonSpec{HelmChart: tc.chart}, "test-syncset", files, cd)
+			if tc.expectErr {
+				assert.Error(t, err, "expected error rendering")
+				return
+			}
+			require.NoError(t, err, "unexpected error rendering")
+			var names []string
+			for _, m := range manifests {
+				obj := &unstructured.Unstructured{}
+				require.NoError(t, obj.UnmarshalJSON(m.Raw), "unexpected error decoding manifest")
+				names = append(names, obj.GetName())
+				if obj.GetName() == tc.expectedNames[len(tc.expectedNames)-2] {
+					assert.Equal(t, tc.expectedNamespace, obj.GetNamespace(), "unexpected namespace")
+					values, _, _ := unstructured.NestedString(obj.Object, "data", "values")
+					assert.Equal(t, tc.expectedValues, values, "unexpected values")
+				}
+			}
+			assert.Equal(t, tc.expectedNames, names, "unexpected manifests")
+		})
+	}
+}
@@ -0,0 +1,275 @@
+// Package syncsetsource fetches the manifests referenced by the Source of a SyncSet or SelectorSyncSet and packs
+// them into the cache secret from which the clustersync controller applies them.
+package syncsetsource
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	hivev1 "github.com/openshift/hive/apis/hive/v1"
+)
+
+const (
+	// DefaultRefreshInterval is how often a source is checked for a new revision when the source does not specify
+	// a RefreshInterval.
+	DefaultRefreshInterval = 5 * time.Minute
+
+	// ManifestsSecretKey is the key in the cache secret holding the gzipped manifests fetched from the source.
+	ManifestsSecretKey = "manifests.json.gz"
+
+	// FilesSecretKey is the key in the cache secret holding the gzipped tar archive of the files fetched from the
+	// source. Files are cached instead of manifests when the syncset renders its manifests with Kustomize or Helm.
+	// The packaged chart pulled from a chart repository is cached as the only file.
+	FilesSecretKey = "files.tar.gz"
+
+	// RevisionSecretKey is the key in the cache secret holding the revision of the manifests.
+	RevisionSecretKey = "revision"
+
+	// cacheSecretSuffix is appended to the name of the syncset to form the name of its cache secret.
+	cacheSecretSuffix = "-source"
+
+	usernameSecretKey = "username"
+	passwordSecretKey = "password"
+)
+
+// Credentials are used to authenticate with a Git server or OCI registry.
+type Credentials struct {
+	Username string
+	Password string
+	// SecretKey identifies the secret and the version of the secret the credentials were read from. Content fetched
+	// with the credentials is cached apart from content fetched with other credentials or without credentials.
+	SecretKey string
+}
+
+// CredentialsFromSecret reads the credentials from a secret referenced by a CredentialsSecretRef.
+func CredentialsFromSecret(secret *corev1.Secret) (*Credentials, error) {
+	username, password := secret.Data[usernameSecretKey], secret.Data[passwordSecretKey]
+	if len(username) == 0 && len(password) == 0 {
+		return nil, fmt.Errorf("secret %s/%s has neither a %q nor a %q key", secret.Namespace, secret.Name, usernameSecretKey, passwordSecretKey)
+	}
+	return &Credentials{
+		Username:  string(username),
+		Password:  string(password),
+		SecretKey: fmt.Sprintf("%s/%s/%s", secret.Namespace, secret.Name, secret.ResourceVersion),
+	}, nil
+}
+
+// Fetcher fetches the files from a source.
+type Fetcher interface {
+	// Fetch resolves the revision of the source and returns the files found under the path of the source at that
+	// revision, keyed by their paths relative to the path of the source.
+	Fetch(ctx context.Context, source *hivev1.SyncSetSource, creds *Credentials) (revision string, files map[string][]byte, err error)
+	// FetchHelmChart pulls the Helm chart from its chart repository and returns the name and version of the chart
+	// pulled as the revision, along with the files to render the chart from.
+	FetchHelmChart(ctx context.Context, chart *hivev1.HelmChartSyncSetRender) (revision string, files map[string][]byte, err error)
+}
+
+// NewFetcher returns a Fetcher that keeps clones of Git repositories under cacheDir. OCI sources may only be fetched
+// over plain HTTP from the insecureRegistries. Helm charts may only be pulled from the helmChartRepoHosts, or from any
+// host if there are none.
+func NewFetcher(cacheDir string, insecureRegistries, helmChartRepoHosts []string, logger log.FieldLogger) Fetcher {
+	return &fetcher{
+		git: &gitFetcher{cacheDir: cacheDir, logger: logger, urlSchemes: gitURLSchemes},
+		oci: &ociFetcher{
+			logger:             logger,
+			client:             &http.Client{Timeout: ociRequestTimeout},
+			insecureRegistries: sets.New(insecureRegistries...),
+		},
+		helm: newHelmFetcher(helmChartRepoHosts, logger),
+	}
+}
+
+type fetcher struct {
+	git  *gitFetcher
+	oci  *ociFetcher
+	helm *helmFetcher
+}
+
+func (f *fetcher) Fetch(ctx context.Context, source *hivev1.SyncSetSource, creds *Credentials) (string, map[string][]byte, error) {
+	var (
+		revision string
+		files    map[string][]byte
+		err      error
+	)
+	switch {
+	case source.Git != nil && source.OCI != nil:
+		return "", nil, errors.New("only one of git or oci may be set in the source")
+	case source.Git != nil:
+		revision, files, err = f.git.fetch(ctx, source.Git, source.Path, creds)
+	case source.OCI != nil:
+		revision, files, err = f.oci.fetch(ctx, source.OCI, creds)
+	default:
+		return "", nil, errors.New("one of git or oci must be set in the source")
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	return revision, filesUnder(files, source.Path), nil
+}
+
+func (f *fetcher) FetchHelmChart(ctx context.Context, chart *hivev1.HelmChartSyncSetRender) (string, map[string][]byte, error) {
+	return f.helm.fetch(ctx, chart)
+}
+
+// RefreshInterval returns the refresh interval of the source, applying the default if unset or if there is no source.
+func RefreshInterval(source *hivev1.SyncSetSource) time.Duration {
+	if source == nil || source.RefreshInterval == nil {
+		return DefaultRefreshInterval
+	}
+	return source.RefreshInterval.Duration
+}
+
+// CacheSecretName returns the name of the secret caching the manifests fetched from the source of the syncset.
+func CacheSecretName(syncSetName string) string {
+	return syncSetName + cacheSecretSuffix
+}
+
+// filesUnder returns the files under dir, keyed by their paths relative to dir.
+func filesUnder(files map[string][]byte, dir string) map[string][]byte {
+	dir = strings.Trim(path.Clean("/"+dir), "/")
+	filtered := map[string][]byte{}
+	for name, content := range files {
+		name = strings.TrimPrefix(path.Clean("/"+name), "/")
+		if dir != "" {
+			if !strings.HasPrefix(name, dir+"/") {
+				continue
+			}
+			name = strings.TrimPrefix(name, dir+"/")
+		}
+		filtered[name] = content
+	}
+	return filtered
+}
+
+// ManifestsFromFiles decodes the manifests in the files ending in .yaml, .yml, or .json. Files are read in lexical
+// order of their paths, and each file may contain multiple YAML documents or a single JSON document.
+func ManifestsFromFiles(files map[string][]byte) ([]runtime.RawExtension, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		switch path.Ext(name) {
+		case ".yaml", ".yml", ".json":
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var manifests []runtime.RawExtension
+	for _, name := range names {
+		decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(files[name]), 4096)
+		for i := 0; ; i++ {
+			u := &unstructured.Unstructured{}
+			if err := decoder.Decode(&u.Object); err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, errors.Wrapf(err, "failed to decode document %d of %s", i, name)
+			}
+			if len(u.Object) == 0 {
+				// Empty YAML document
+				continue
+			}
+			raw, err := json.Marshal(u.Object)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to encode document %d of %s", i, name)
+			}
+			manifests = append(manifests, runtime.RawExtension{Raw: raw})
+		}
+	}
+	return manifests, nil
+}
+
+// EncodeManifests packs the manifests for storage in the cache secret.
+func EncodeManifests(manifests []runtime.RawExtension) ([]byte, error) {
+	raw, err := json.Marshal(manifests)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	w := gzip.NewWriter(buf)
+	if _, err := w.Write(raw); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeManifests unpacks the manifests stored in the cache secret.
+func DecodeManifests(data []byte) ([]runtime.RawExtension, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var manifests []runtime.RawExtension
+	if err := json.Unmarshal(raw, &manifests); err != nil {
+		return nil, err
+	}
+	return manifests, nil
+}
+
+// EncodeFiles packs the files for storage in the cache secret.
+func EncodeFiles(files map[string][]byte) ([]byte, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	for _, name := range names {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0600,
+			Size:     int64(len(files[name])),
+			Typeflag: tar.TypeReg,
+		}); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeFiles unpacks the files stored in the cache secret.
+func DecodeFiles(data []byte) (map[string][]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return readTar(r)
+}
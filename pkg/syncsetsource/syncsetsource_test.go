@@ -0,0 +1,103 @@
+package syncsetsource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestManifestsFromFiles(t *testing.T) {
+	cases := []struct {
+		name     string
+		files    map[string][]byte
+		expected []string
+	}{
+		{
+			name: "multiple documents",
+			files: map[string][]byte{
+				"cm.yaml": []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n---\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: b\n"),
+			},
+			expected: []string{
+				`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a"}}`,
+				`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"b"}}`,
+			},
+		},
+		{
+			name: "sorted by path",
+			files: map[string][]byte{
+				"b/cm.json": []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"b"}}`),
+				"a.yml":     []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n"),
+			},
+			expected: []string{
+				`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a"}}`,
+				`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"b"}}`,
+			},
+		},
+		{
+			name: "filtered by extension",
+			files: map[string][]byte{
+				"cm.yaml":   []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n"),
+				"README.md": []byte("# not a manifest"),
+			},
+			expected: []string{
+				`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a"}}`,
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			manifests, err := ManifestsFromFiles(tc.files)
+			require.NoError(t, err, "unexpected error")
+			actual := make([]string, len(manifests))
+			for i, m := range manifests {
+				actual[i] = string(m.Raw)
+			}
+			assert.Equal(t, tc.expected, actual, "unexpected manifests")
+		})
+	}
+}
+
+func TestFilesUnder(t *testing.T) {
+	files := map[string][]byte{
+		"manifests/cm.yaml":       []byte("a"),
+		"manifests/nested/cm.yml": []byte("b"),
+		"manifests-other.yml":     []byte("c"),
+		"other/cm.yaml":           []byte("d"),
+	}
+	assert.Equal(t, map[string][]byte{
+		"cm.yaml":       []byte("a"),
+		"nested/cm.yml": []byte("b"),
+	}, filesUnder(files, "/manifests/"), "unexpected files under dir")
+	assert.Equal(t, files, filesUnder(files, ""), "unexpected files under root")
+}
+
+func TestManifestsFromFilesInvalid(t *testing.T) {
+	_, err := ManifestsFromFiles(map[string][]byte{"bad.yaml": []byte("key: [unterminated")})
+	assert.Error(t, err, "expected error decoding invalid manifest")
+}
+
+func TestEncodeDecodeManifests(t *testing.T) {
+	manifests := []runtime.RawExtension{
+		{Raw: []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a"}}`)},
+		{Raw: []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"b"}}`)},
+	}
+	data, err := EncodeManifests(manifests)
+	require.NoError(t, err, "unexpected error encoding manifests")
+	decoded, err := DecodeManifests(data)
+	require.NoError(t, err, "unexpected error decoding manifests")
+	assert.Equal(t, manifests, decoded, "unexpected decoded manifests")
+}
+
+func TestEncodeDecodeFiles(t *testing.T) {
+	files := map[string][]byte{
+		"kustomization.yaml": []byte("resources:\n- cm.yaml\n"),
+		"base/cm.yaml":       []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n"),
+	}
+	data, err := EncodeFiles(files)
+	require.NoError(t, err, "unexpected error encoding files")
+	decoded, err := DecodeFiles(data)
+	require.NoError(t, err, "unexpected error decoding files")
+	assert.Equal(t, files, decoded, "unexpected decoded files")
+}
@@ -146,6 +146,12 @@ func WithInstallAttemptsLimit(ial int32) Option {
 	}
 }
 
+func WithPostInstallValidation(validation *hivev1.PostInstallValidation) Option {
+	return func(clusterPool *hivev1.ClusterPool) {
+		clusterPool.Spec.PostInstallValidation = validation
+	}
+}
+
 func WithMaxSize(size int) Option {
 	return func(clusterPool *hivev1.ClusterPool) {
 		clusterPool.Spec.MaxSize = pointer.Int32Ptr(int32(size))
@@ -146,6 +146,24 @@ func WithFailureReason(reason string) Option {
 	}
 }
 
+func WithFailureClassification(classification *hivev1.ProvisionFailureClassification) Option {
+	return func(clusterProvision *hivev1.ClusterProvision) {
+		clusterProvision.Status.FailureClassification = classification
+	}
+}
+
+func WithPrevProvisionName(name string) Option {
+	return func(clusterProvision *hivev1.ClusterProvision) {
+		clusterProvision.Spec.PrevProvisionName = &name
+	}
+}
+
+func WithExcludedZones(zones ...string) Option {
+	return func(clusterProvision *hivev1.ClusterProvision) {
+		clusterProvision.Spec.ExcludedZones = zones
+	}
+}
+
 func WithCreationTimestamp(time time.Time) Option {
 	return Generic(generic.WithCreationTimestamp(time))
 }
@@ -110,3 +110,21 @@ func WithNoFirstSuccessTime() Option {
 		clusterSync.Status.FirstSuccessTime = nil
 	}
 }
+
+func WithResync(resync *hiveinternalv1alpha1.ClusterSyncResync) Option {
+	return func(clusterSync *hiveinternalv1alpha1.ClusterSync) {
+		clusterSync.Spec.Resync = resync
+	}
+}
+
+func WithLastResyncRequestID(requestID string) Option {
+	return func(clusterSync *hiveinternalv1alpha1.ClusterSync) {
+		clusterSync.Status.LastResyncRequestID = requestID
+	}
+}
+
+func WithSyncSetHistory(history hiveinternalv1alpha1.SyncHistory) Option {
+	return func(clusterSync *hiveinternalv1alpha1.ClusterSync) {
+		clusterSync.Status.SyncSetHistory = append(clusterSync.Status.SyncSetHistory, history)
+	}
+}
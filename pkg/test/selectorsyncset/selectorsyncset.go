@@ -125,3 +125,45 @@ func WithPatches(patches ...hivev1.SyncObjectPatch) Option {
 		selectorSyncSet.Spec.Patches = patches
 	}
 }
+
+func WithRolloutStrategy(strategy *hivev1.SelectorSyncSetRolloutStrategy) Option {
+	return func(selectorSyncSet *hivev1.SelectorSyncSet) {
+		selectorSyncSet.Spec.RolloutStrategy = strategy
+	}
+}
+
+func WithRolloutStatus(rollout *hivev1.SelectorSyncSetRolloutStatus) Option {
+	return func(selectorSyncSet *hivev1.SelectorSyncSet) {
+		selectorSyncSet.Status.Rollout = rollout
+	}
+}
+
+func WithSource(source *hivev1.SyncSetSource) Option {
+	return func(selectorSyncSet *hivev1.SelectorSyncSet) {
+		selectorSyncSet.Spec.Source = source
+	}
+}
+
+func WithSourceStatus(status *hivev1.SyncSetSourceStatus) Option {
+	return func(selectorSyncSet *hivev1.SelectorSyncSet) {
+		selectorSyncSet.Status.Source = status
+	}
+}
+
+func WithKustomize(kustomize *hivev1.KustomizeSyncSetRender) Option {
+	return func(selectorSyncSet *hivev1.SelectorSyncSet) {
+		selectorSyncSet.Spec.Kustomize = kustomize
+	}
+}
+
+func WithHelmChart(chart *hivev1.HelmChartSyncSetRender) Option {
+	return func(selectorSyncSet *hivev1.SelectorSyncSet) {
+		selectorSyncSet.Spec.HelmChart = chart
+	}
+}
+
+func WithHealthCheck(healthCheck *hivev1.SyncSetHealthCheck) Option {
+	return func(selectorSyncSet *hivev1.SelectorSyncSet) {
+		selectorSyncSet.Spec.HealthCheck = healthCheck
+	}
+}
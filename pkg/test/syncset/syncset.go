@@ -127,3 +127,33 @@ func WithPatches(patches ...hivev1.SyncObjectPatch) Option {
 		syncSet.Spec.Patches = patches
 	}
 }
+
+func WithSource(source *hivev1.SyncSetSource) Option {
+	return func(syncSet *hivev1.SyncSet) {
+		syncSet.Spec.Source = source
+	}
+}
+
+func WithSourceStatus(status *hivev1.SyncSetSourceStatus) Option {
+	return func(syncSet *hivev1.SyncSet) {
+		syncSet.Status.Source = status
+	}
+}
+
+func WithKustomize(kustomize *hivev1.KustomizeSyncSetRender) Option {
+	return func(syncSet *hivev1.SyncSet) {
+		syncSet.Spec.Kustomize = kustomize
+	}
+}
+
+func WithHelmChart(chart *hivev1.HelmChartSyncSetRender) Option {
+	return func(syncSet *hivev1.SyncSet) {
+		syncSet.Spec.HelmChart = chart
+	}
+}
+
+func WithHealthCheck(healthCheck *hivev1.SyncSetHealthCheck) Option {
+	return func(syncSet *hivev1.SyncSet) {
+		syncSet.Spec.HealthCheck = healthCheck
+	}
+}
@@ -21,6 +21,7 @@ import (
 	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
 	ingresscontroller "github.com/openshift/api/operator/v1"
 	routev1 "github.com/openshift/api/route/v1"
+	userv1 "github.com/openshift/api/user/v1"
 	autoscalingv1 "github.com/openshift/cluster-autoscaler-operator/pkg/apis/autoscaling/v1"
 	autoscalingv1beta1 "github.com/openshift/cluster-autoscaler-operator/pkg/apis/autoscaling/v1beta1"
 	"github.com/openshift/hive/apis"
@@ -71,6 +72,7 @@ func init() {
 	ovirtprovider.AddToScheme(hive_scheme)
 	rbacv1.AddToScheme(hive_scheme)
 	routev1.AddToScheme(hive_scheme)
+	userv1.Install(hive_scheme)
 	velerov1.AddToScheme(hive_scheme)
 
 }
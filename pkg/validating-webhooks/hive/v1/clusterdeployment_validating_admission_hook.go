@@ -54,10 +54,13 @@ var (
 type ClusterDeploymentValidatingAdmissionHook struct {
 	decoder *admission.Decoder
 
-	validManagedDomains  []string
-	fs                   *featureSet
-	awsPrivateLinkConfig *hivev1.AWSPrivateLinkConfig
-	supportedContracts   contracts.SupportedContractImplementationsList
+	validManagedDomains []string
+	// rfc2136ManagedDomains are the managed domains on DNS servers managed with RFC 2136 dynamic updates, which
+	// clusters on any platform can use.
+	rfc2136ManagedDomains []string
+	fs                    *featureSet
+	awsPrivateLinkConfig  *hivev1.AWSPrivateLinkConfig
+	supportedContracts    contracts.SupportedContractImplementationsList
 }
 
 // NewClusterDeploymentValidatingAdmissionHook constructs a new ClusterDeploymentValidatingAdmissionHook
@@ -68,8 +71,12 @@ func NewClusterDeploymentValidatingAdmissionHook(decoder *admission.Decoder) *Cl
 		logger.WithError(err).Fatal("Unable to read managedDomains file")
 	}
 	domains := []string{}
+	rfc2136Domains := []string{}
 	for _, md := range managedDomains {
 		domains = append(domains, md.Domains...)
+		if md.RFC2136 != nil {
+			rfc2136Domains = append(rfc2136Domains, md.Domains...)
+		}
 	}
 
 	aplConfig, err := awsprivatelink.ReadAWSPrivateLinkControllerConfigFile()
@@ -85,11 +92,12 @@ func NewClusterDeploymentValidatingAdmissionHook(decoder *admission.Decoder) *Cl
 
 	logger.WithField("managedDomains", domains).Info("Read managed domains")
 	return &ClusterDeploymentValidatingAdmissionHook{
-		decoder:              decoder,
-		validManagedDomains:  domains,
-		fs:                   newFeatureSet(),
-		awsPrivateLinkConfig: aplConfig,
-		supportedContracts:   supportContractsConfig,
+		decoder:               decoder,
+		validManagedDomains:   domains,
+		rfc2136ManagedDomains: rfc2136Domains,
+		fs:                    newFeatureSet(),
+		awsPrivateLinkConfig:  aplConfig,
+		supportedContracts:    supportContractsConfig,
 	}
 }
 
@@ -293,7 +301,7 @@ func (a *ClusterDeploymentValidatingAdmissionHook) validateCreate(admissionSpec
 	}
 
 	allErrs = append(allErrs, validateClusterPlatform(specPath.Child("platform"), cd.Spec.Platform)...)
-	allErrs = append(allErrs, validateCanManageDNSForClusterPlatform(specPath, cd.Spec)...)
+	allErrs = append(allErrs, validateCanManageDNSForClusterPlatform(specPath, cd.Spec, a.rfc2136ManagedDomains)...)
 
 	if cd.Spec.Platform.AWS != nil {
 		allErrs = append(allErrs, validateAWSPrivateLink(specPath.Child("platform", "aws"), cd.Spec.Platform.AWS, a.awsPrivateLinkConfig)...)
@@ -572,9 +580,12 @@ func validateClusterPlatform(path *field.Path, platform hivev1.Platform) field.E
 	return allErrs
 }
 
-func validateCanManageDNSForClusterPlatform(specPath *field.Path, spec hivev1.ClusterDeploymentSpec) field.ErrorList {
+func validateCanManageDNSForClusterPlatform(specPath *field.Path, spec hivev1.ClusterDeploymentSpec, rfc2136ManagedDomains []string) field.ErrorList {
 	allErrs := field.ErrorList{}
 	canManageDNS := false
+	if validateDomain(spec.BaseDomain, rfc2136ManagedDomains) {
+		canManageDNS = true
+	}
 	if spec.Platform.AWS != nil {
 		canManageDNS = true
 	}
@@ -584,6 +595,12 @@ func validateCanManageDNSForClusterPlatform(specPath *field.Path, spec hivev1.Cl
 	if spec.Platform.GCP != nil {
 		canManageDNS = true
 	}
+	if spec.Platform.IBMCloud != nil {
+		canManageDNS = true
+	}
+	if spec.Platform.AlibabaCloud != nil {
+		canManageDNS = true
+	}
 	if !canManageDNS && spec.ManageDNS {
 		allErrs = append(allErrs, field.Invalid(specPath.Child("manageDNS"), spec.ManageDNS, "cannot manage DNS for the selected platform"))
 	}
@@ -37,6 +37,10 @@ var validTestManagedDomains = []string{
 	"ccc.com",
 }
 
+var validTestRFC2136ManagedDomains = []string{
+	"ccc.com",
+}
+
 func clusterDeploymentTemplate() *hivev1.ClusterDeployment {
 	return &hivev1.ClusterDeployment{
 		// TODO: Remove TypeMeta field once https://github.com/kubernetes-sigs/controller-runtime/issues/2429 is fixed
@@ -564,6 +568,50 @@ func TestClusterDeploymentValidate(t *testing.T) {
 			operation:       admissionv1beta1.Create,
 			expectedAllowed: true,
 		},
+		{
+			name: "Test managed DNS is valid on IBM Cloud",
+			newObject: func() *hivev1.ClusterDeployment {
+				cd := validIBMCloudClusterDeployment()
+				cd.Spec.ManageDNS = true
+				cd.Spec.BaseDomain = "bar.foo.aaa.com"
+				return cd
+			}(),
+			operation:       admissionv1beta1.Create,
+			expectedAllowed: true,
+		},
+		{
+			name: "Test managed DNS is valid on Alibaba Cloud",
+			newObject: func() *hivev1.ClusterDeployment {
+				cd := validAlibabaCloudClusterDeployment()
+				cd.Spec.ManageDNS = true
+				cd.Spec.BaseDomain = "bar.foo.aaa.com"
+				return cd
+			}(),
+			operation:       admissionv1beta1.Create,
+			expectedAllowed: true,
+		},
+		{
+			name: "Test managed DNS is valid on vSphere with an RFC 2136 managed domain",
+			newObject: func() *hivev1.ClusterDeployment {
+				cd := validVSphereClusterDeployment()
+				cd.Spec.ManageDNS = true
+				cd.Spec.BaseDomain = "bar.ccc.com"
+				return cd
+			}(),
+			operation:       admissionv1beta1.Create,
+			expectedAllowed: true,
+		},
+		{
+			name: "Test managed DNS is invalid on vSphere with other managed domains",
+			newObject: func() *hivev1.ClusterDeployment {
+				cd := validVSphereClusterDeployment()
+				cd.Spec.ManageDNS = true
+				cd.Spec.BaseDomain = "bar.bbb.com"
+				return cd
+			}(),
+			operation:       admissionv1beta1.Create,
+			expectedAllowed: false,
+		},
 		{
 			name:      "Test allow modifying controlPlaneConfig",
 			oldObject: validAWSClusterDeployment(),
@@ -1466,8 +1514,9 @@ func TestClusterDeploymentValidate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Arrange
 			data := ClusterDeploymentValidatingAdmissionHook{
-				decoder:             createDecoder(t),
-				validManagedDomains: validTestManagedDomains,
+				decoder:               createDecoder(t),
+				validManagedDomains:   validTestManagedDomains,
+				rfc2136ManagedDomains: validTestRFC2136ManagedDomains,
 				fs: &featureSet{
 					FeatureGatesEnabled: &hivev1.FeatureGatesEnabled{
 						Enabled: tc.enabledFeatureGates,
@@ -1533,6 +1582,7 @@ func TestNewClusterDeploymentValidatingAdmissionHook(t *testing.T) {
 			Domains: []string{
 				"extra.domain.com",
 			},
+			RFC2136: &hivev1.ManageDNSRFC2136Config{Server: "ns1.domain.com"},
 		},
 	}
 
@@ -1560,4 +1610,5 @@ func TestNewClusterDeploymentValidatingAdmissionHook(t *testing.T) {
 	os.Setenv(constants.ManagedDomainsFileEnvVar, tempFile.Name())
 	webhook := NewClusterDeploymentValidatingAdmissionHook(createDecoder(t))
 	assert.Equal(t, webhook.validManagedDomains, expectedDomains, "valid domains must match expected")
+	assert.Equal(t, []string{"extra.domain.com"}, webhook.rfc2136ManagedDomains, "RFC 2136 domains must match expected")
 }
@@ -2,7 +2,9 @@ package v1
 
 import (
 	"fmt"
+	"net"
 	"net/http"
+	"regexp"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -23,6 +25,10 @@ const (
 	dnsZoneResource = "dnszones"
 )
 
+// recordNameRegexp matches the names of records relative to the zone. Labels may contain underscores, as in
+// "_acme-challenge", and the first label may be a wildcard.
+var recordNameRegexp = regexp.MustCompile(`^(\*|[a-zA-Z0-9_]([-a-zA-Z0-9_]*[a-zA-Z0-9_])?)(\.[a-zA-Z0-9_]([-a-zA-Z0-9_]*[a-zA-Z0-9_])?)*$`)
+
 // DNSZoneValidatingAdmissionHook is a struct that is used to reference what code should be run by the generic-admission-server.
 type DNSZoneValidatingAdmissionHook struct {
 	decoder *admission.Decoder
@@ -168,6 +174,21 @@ func (a *DNSZoneValidatingAdmissionHook) validateCreate(admissionSpec *admission
 		}
 	}
 
+	message := validateDNSSEC(&newObject.Spec)
+	if message == "" {
+		message = validateRecords(&newObject.Spec)
+	}
+	if message != "" {
+		contextLogger.Infof("Failed validation: %v", message)
+		return &admissionv1beta1.AdmissionResponse{
+			Allowed: false,
+			Result: &metav1.Status{
+				Status: metav1.StatusFailure, Code: http.StatusBadRequest, Reason: metav1.StatusReasonBadRequest,
+				Message: message,
+			},
+		}
+	}
+
 	// If we get here, then all checks passed, so the object is valid.
 	contextLogger.Info("Successful validation")
 	return &admissionv1beta1.AdmissionResponse{
@@ -228,9 +249,107 @@ func (a *DNSZoneValidatingAdmissionHook) validateUpdate(admissionSpec *admission
 		}
 	}
 
+	message := validateDNSSEC(&newObject.Spec)
+	if message == "" {
+		message = validateRecords(&newObject.Spec)
+	}
+	if message != "" {
+		contextLogger.Infof("Failed validation: %v", message)
+		return &admissionv1beta1.AdmissionResponse{
+			Allowed: false,
+			Result: &metav1.Status{
+				Status: metav1.StatusFailure, Code: http.StatusBadRequest, Reason: metav1.StatusReasonBadRequest,
+				Message: message,
+			},
+		}
+	}
+
 	// If we get here, then all checks passed, so the object is valid.
 	contextLogger.Info("Successful validation")
 	return &admissionv1beta1.AdmissionResponse{
 		Allowed: true,
 	}
 }
+
+// validateDNSSEC returns a message describing why the DNSSEC configuration of the DNSZone is invalid, or an empty
+// string when it is valid.
+func validateDNSSEC(spec *hivev1.DNSZoneSpec) string {
+	if spec.DNSSEC == nil {
+		return ""
+	}
+	switch {
+	case spec.AWS != nil:
+		if spec.DNSSEC.AWS == nil || spec.DNSSEC.AWS.KMSKeyARN == "" {
+			return "DNSZone.Spec.DNSSEC.AWS.KMSKeyARN is required for DNSSEC signing of AWS zones"
+		}
+	case spec.GCP != nil:
+		if spec.DNSSEC.AWS != nil {
+			return "DNSZone.Spec.DNSSEC.AWS cannot be set for GCP zones"
+		}
+	default:
+		return "DNSSEC signing is only supported for AWS and GCP zones"
+	}
+	return ""
+}
+
+// validateRecords returns a message describing why the records of the DNSZone are invalid, or an empty string when
+// they are valid.
+func validateRecords(spec *hivev1.DNSZoneSpec) string {
+	if len(spec.Records) == 0 {
+		return ""
+	}
+	if spec.AWS == nil && spec.GCP == nil && spec.RFC2136 == nil {
+		return "records are only supported for AWS, GCP and RFC 2136 zones"
+	}
+
+	typesByName := map[string]map[hivev1.DNSRecordType]bool{}
+	for i, record := range spec.Records {
+		field := fmt.Sprintf("DNSZone.Spec.Records[%d]", i)
+		if record.Name != "@" && !recordNameRegexp.MatchString(record.Name) {
+			return fmt.Sprintf("%s.Name %q is not a valid record name relative to the zone", field, record.Name)
+		}
+		if record.TTL < 0 {
+			return fmt.Sprintf("%s.TTL must not be negative", field)
+		}
+		if len(record.Values) == 0 {
+			return fmt.Sprintf("%s.Values must not be empty", field)
+		}
+		for _, value := range record.Values {
+			switch record.Type {
+			case hivev1.DNSRecordTypeA:
+				if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+					return fmt.Sprintf("%s.Values contains %q, which is not a valid IPv4 address", field, value)
+				}
+			case hivev1.DNSRecordTypeAAAA:
+				if ip := net.ParseIP(value); ip == nil || ip.To4() != nil {
+					return fmt.Sprintf("%s.Values contains %q, which is not a valid IPv6 address", field, value)
+				}
+			case hivev1.DNSRecordTypeCNAME:
+				if errs := dnsvalidation.IsDNS1123Subdomain(strings.TrimSuffix(value, ".")); len(errs) > 0 {
+					return fmt.Sprintf("%s.Values contains %q, which is not a valid domain name: %s", field, value, strings.Join(errs, ", "))
+				}
+			}
+		}
+		if record.Type == hivev1.DNSRecordTypeCNAME {
+			if len(record.Values) != 1 {
+				return fmt.Sprintf("%s.Values must contain exactly one value for a CNAME record", field)
+			}
+			if record.Name == "@" {
+				return fmt.Sprintf("%s cannot be a CNAME record at the apex of the zone", field)
+			}
+		}
+
+		name := strings.ToLower(record.Name)
+		if typesByName[name] == nil {
+			typesByName[name] = map[hivev1.DNSRecordType]bool{}
+		}
+		if typesByName[name][record.Type] {
+			return fmt.Sprintf("%s is a duplicate %s record for name %q", field, record.Type, record.Name)
+		}
+		typesByName[name][record.Type] = true
+		if typesByName[name][hivev1.DNSRecordTypeCNAME] && len(typesByName[name]) > 1 {
+			return fmt.Sprintf("%s: a CNAME record for name %q cannot coexist with other records", field, record.Name)
+		}
+	}
+	return ""
+}
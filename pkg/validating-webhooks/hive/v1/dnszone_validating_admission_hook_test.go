@@ -48,6 +48,7 @@ func TestDNSZoneValidate(t *testing.T) {
 		oldZoneStr      string
 		newObjectRaw    []byte
 		oldObjectRaw    []byte
+		newSpec         func(*hivev1.DNSZoneSpec)
 		operation       admissionv1beta1.Operation
 		expectedAllowed bool
 		gvr             *metav1.GroupVersionResource
@@ -104,6 +105,141 @@ func TestDNSZoneValidate(t *testing.T) {
 
 			expectedAllowed: true,
 		},
+		{
+			name:       "Test DNSSEC for AWS zone",
+			newZoneStr: "this.is.a.valid.zone",
+			newSpec: func(spec *hivev1.DNSZoneSpec) {
+				spec.AWS = &hivev1.AWSDNSZoneSpec{}
+				spec.DNSSEC = &hivev1.DNSSECSpec{AWS: &hivev1.AWSDNSSECSpec{KMSKeyARN: "arn:aws:kms:us-east-1:123456789012:key/test"}}
+			},
+			operation:       admissionv1beta1.Create,
+			expectedAllowed: true,
+		},
+		{
+			name:       "Test DNSSEC for AWS zone without KMS key",
+			newZoneStr: "this.is.a.valid.zone",
+			newSpec: func(spec *hivev1.DNSZoneSpec) {
+				spec.AWS = &hivev1.AWSDNSZoneSpec{}
+				spec.DNSSEC = &hivev1.DNSSECSpec{}
+			},
+			operation:       admissionv1beta1.Create,
+			expectedAllowed: false,
+		},
+		{
+			name:       "Test DNSSEC for GCP zone",
+			newZoneStr: "this.is.a.valid.zone",
+			oldZoneStr: "this.is.a.valid.zone",
+			newSpec: func(spec *hivev1.DNSZoneSpec) {
+				spec.GCP = &hivev1.GCPDNSZoneSpec{}
+				spec.DNSSEC = &hivev1.DNSSECSpec{}
+			},
+			operation:       admissionv1beta1.Update,
+			expectedAllowed: true,
+		},
+		{
+			name:       "Test DNSSEC for Azure zone",
+			newZoneStr: "this.is.a.valid.zone",
+			oldZoneStr: "this.is.a.valid.zone",
+			newSpec: func(spec *hivev1.DNSZoneSpec) {
+				spec.Azure = &hivev1.AzureDNSZoneSpec{}
+				spec.DNSSEC = &hivev1.DNSSECSpec{}
+			},
+			operation:       admissionv1beta1.Update,
+			expectedAllowed: false,
+		},
+		{
+			name:       "Test records for AWS zone",
+			newZoneStr: "this.is.a.valid.zone",
+			newSpec: func(spec *hivev1.DNSZoneSpec) {
+				spec.AWS = &hivev1.AWSDNSZoneSpec{}
+				spec.Records = []hivev1.DNSRecord{
+					{Name: "*.apps", Type: hivev1.DNSRecordTypeCNAME, Values: []string{"lb.example.com."}},
+					{Name: "_acme-challenge", Type: hivev1.DNSRecordTypeTXT, TTL: 300, Values: []string{"token"}},
+					{Name: "@", Type: hivev1.DNSRecordTypeA, Values: []string{"192.0.2.1", "192.0.2.2"}},
+					{Name: "@", Type: hivev1.DNSRecordTypeAAAA, Values: []string{"2001:db8::1"}},
+				}
+			},
+			operation:       admissionv1beta1.Create,
+			expectedAllowed: true,
+		},
+		{
+			name:       "Test records for RFC 2136 zone",
+			newZoneStr: "this.is.a.valid.zone",
+			newSpec: func(spec *hivev1.DNSZoneSpec) {
+				spec.RFC2136 = &hivev1.RFC2136DNSZoneSpec{}
+				spec.Records = []hivev1.DNSRecord{{Name: "*.apps", Type: hivev1.DNSRecordTypeA, Values: []string{"192.0.2.1"}}}
+			},
+			operation:       admissionv1beta1.Create,
+			expectedAllowed: true,
+		},
+		{
+			name:       "Test records for Azure zone",
+			newZoneStr: "this.is.a.valid.zone",
+			newSpec: func(spec *hivev1.DNSZoneSpec) {
+				spec.Azure = &hivev1.AzureDNSZoneSpec{}
+				spec.Records = []hivev1.DNSRecord{{Name: "www", Type: hivev1.DNSRecordTypeA, Values: []string{"192.0.2.1"}}}
+			},
+			operation:       admissionv1beta1.Create,
+			expectedAllowed: false,
+		},
+		{
+			name:       "Test record with invalid name",
+			newZoneStr: "this.is.a.valid.zone",
+			newSpec: func(spec *hivev1.DNSZoneSpec) {
+				spec.GCP = &hivev1.GCPDNSZoneSpec{}
+				spec.Records = []hivev1.DNSRecord{{Name: "www..apps", Type: hivev1.DNSRecordTypeA, Values: []string{"192.0.2.1"}}}
+			},
+			operation:       admissionv1beta1.Create,
+			expectedAllowed: false,
+		},
+		{
+			name:       "Test A record with IPv6 value",
+			newZoneStr: "this.is.a.valid.zone",
+			oldZoneStr: "this.is.a.valid.zone",
+			newSpec: func(spec *hivev1.DNSZoneSpec) {
+				spec.GCP = &hivev1.GCPDNSZoneSpec{}
+				spec.Records = []hivev1.DNSRecord{{Name: "www", Type: hivev1.DNSRecordTypeA, Values: []string{"2001:db8::1"}}}
+			},
+			operation:       admissionv1beta1.Update,
+			expectedAllowed: false,
+		},
+		{
+			name:       "Test CNAME record with several values",
+			newZoneStr: "this.is.a.valid.zone",
+			newSpec: func(spec *hivev1.DNSZoneSpec) {
+				spec.AWS = &hivev1.AWSDNSZoneSpec{}
+				spec.Records = []hivev1.DNSRecord{{Name: "www", Type: hivev1.DNSRecordTypeCNAME, Values: []string{"a.example.com", "b.example.com"}}}
+			},
+			operation:       admissionv1beta1.Create,
+			expectedAllowed: false,
+		},
+		{
+			name:       "Test CNAME record with other records for the same name",
+			newZoneStr: "this.is.a.valid.zone",
+			newSpec: func(spec *hivev1.DNSZoneSpec) {
+				spec.AWS = &hivev1.AWSDNSZoneSpec{}
+				spec.Records = []hivev1.DNSRecord{
+					{Name: "www", Type: hivev1.DNSRecordTypeTXT, Values: []string{"token"}},
+					{Name: "WWW", Type: hivev1.DNSRecordTypeCNAME, Values: []string{"lb.example.com"}},
+				}
+			},
+			operation:       admissionv1beta1.Create,
+			expectedAllowed: false,
+		},
+		{
+			name:       "Test duplicate records",
+			newZoneStr: "this.is.a.valid.zone",
+			oldZoneStr: "this.is.a.valid.zone",
+			newSpec: func(spec *hivev1.DNSZoneSpec) {
+				spec.AWS = &hivev1.AWSDNSZoneSpec{}
+				spec.Records = []hivev1.DNSRecord{
+					{Name: "www", Type: hivev1.DNSRecordTypeA, Values: []string{"192.0.2.1"}},
+					{Name: "www", Type: hivev1.DNSRecordTypeA, Values: []string{"192.0.2.2"}},
+				}
+			},
+			operation:       admissionv1beta1.Update,
+			expectedAllowed: false,
+		},
 		{
 			name:            "Test that we don't validate deletes",
 			operation:       admissionv1beta1.Delete,
@@ -147,6 +283,9 @@ func TestDNSZoneValidate(t *testing.T) {
 					Zone: tc.newZoneStr,
 				},
 			}
+			if tc.newSpec != nil {
+				tc.newSpec(&newObject.Spec)
+			}
 			oldObject := &hivev1.DNSZone{
 				Spec: hivev1.DNSZoneSpec{
 					Zone: tc.oldZoneStr,
@@ -157,8 +157,12 @@ func (a *SelectorSyncSetValidatingAdmissionHook) validateCreate(admissionSpec *a
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateResources(newObject.Spec.Resources, field.NewPath("spec").Child("resources"))...)
 	allErrs = append(allErrs, validatePatches(newObject.Spec.Patches, field.NewPath("spec").Child("patches"))...)
-	allErrs = append(allErrs, validateSecrets(newObject.Spec.Secrets, field.NewPath("spec").Child("secretMappings"))...)
+	allErrs = append(allErrs, validateSecrets(newObject.Spec.Secrets, "", field.NewPath("spec").Child("secretMappings"))...)
 	allErrs = append(allErrs, validateResourceApplyMode(newObject.Spec.ResourceApplyMode, field.NewPath("spec", "resourceApplyMode"))...)
+	allErrs = append(allErrs, validateSource(newObject.Spec.Source, "", field.NewPath("spec", "source"))...)
+	allErrs = append(allErrs, validateRender(&newObject.Spec.SyncSetCommonSpec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateHealthCheck(newObject.Spec.HealthCheck, field.NewPath("spec", "healthCheck"))...)
+	allErrs = append(allErrs, validateRolloutStrategy(newObject.Spec.RolloutStrategy, field.NewPath("spec", "rolloutStrategy"))...)
 
 	if len(allErrs) > 0 {
 		statusError := errors.NewInvalid(newObject.GroupVersionKind().GroupKind(), newObject.Name, allErrs).Status()
@@ -204,8 +208,12 @@ func (a *SelectorSyncSetValidatingAdmissionHook) validateUpdate(admissionSpec *a
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateResources(newObject.Spec.Resources, field.NewPath("spec", "resources"))...)
 	allErrs = append(allErrs, validatePatches(newObject.Spec.Patches, field.NewPath("spec", "patches"))...)
-	allErrs = append(allErrs, validateSecrets(newObject.Spec.Secrets, field.NewPath("spec", "secretMappings"))...)
+	allErrs = append(allErrs, validateSecrets(newObject.Spec.Secrets, "", field.NewPath("spec", "secretMappings"))...)
 	allErrs = append(allErrs, validateResourceApplyMode(newObject.Spec.ResourceApplyMode, field.NewPath("spec", "resourceApplyMode"))...)
+	allErrs = append(allErrs, validateSource(newObject.Spec.Source, "", field.NewPath("spec", "source"))...)
+	allErrs = append(allErrs, validateRender(&newObject.Spec.SyncSetCommonSpec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateHealthCheck(newObject.Spec.HealthCheck, field.NewPath("spec", "healthCheck"))...)
+	allErrs = append(allErrs, validateRolloutStrategy(newObject.Spec.RolloutStrategy, field.NewPath("spec", "rolloutStrategy"))...)
 
 	if len(allErrs) > 0 {
 		statusError := errors.NewInvalid(newObject.GroupVersionKind().GroupKind(), newObject.Name, allErrs).Status()
@@ -222,3 +230,19 @@ func (a *SelectorSyncSetValidatingAdmissionHook) validateUpdate(admissionSpec *a
 		Allowed: true,
 	}
 }
+
+func validateRolloutStrategy(strategy *hivev1.SelectorSyncSetRolloutStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if strategy == nil {
+		return allErrs
+	}
+	if canary := strategy.Canary; canary != nil && canary.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(canary.Selector); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("canary", "selector"), canary.Selector, err.Error()))
+		}
+	}
+	if strategy.WaveInterval != nil && strategy.WaveInterval.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("waveInterval"), strategy.WaveInterval.Duration.String(), "must not be negative"))
+	}
+	return allErrs
+}
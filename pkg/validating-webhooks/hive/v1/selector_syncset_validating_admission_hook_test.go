@@ -3,6 +3,7 @@ package v1
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
 	"github.com/stretchr/testify/assert"
@@ -148,6 +149,80 @@ func TestSelectorSyncSetValidate(t *testing.T) {
 			}(),
 			expectedAllowed: false,
 		},
+		{
+			name:            "Test valid file secret source create",
+			operation:       admissionv1beta1.Create,
+			selectorSyncSet: testFileSecretSelectorSyncSet("foo/bar"),
+			expectedAllowed: true,
+		},
+		{
+			name:            "Test invalid file secret source absolute path",
+			operation:       admissionv1beta1.Create,
+			selectorSyncSet: testFileSecretSelectorSyncSet("/etc/foo"),
+			expectedAllowed: false,
+		},
+		{
+			name:            "Test invalid file secret source path outside volume",
+			operation:       admissionv1beta1.Create,
+			selectorSyncSet: testFileSecretSelectorSyncSet("foo/../../bar"),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test valid health check",
+			operation: admissionv1beta1.Create,
+			selectorSyncSet: func() *hivev1.SelectorSyncSet {
+				ss := testSelectorSyncSet()
+				ss.Spec.HealthCheck = &hivev1.SyncSetHealthCheck{
+					CustomRules: []hivev1.CustomHealthRule{{APIVersion: "example.com/v1", Kind: "Widget", Healthy: "true"}},
+				}
+				return ss
+			}(),
+			expectedAllowed: true,
+		},
+		{
+			name:      "Test invalid health check missing kind",
+			operation: admissionv1beta1.Update,
+			selectorSyncSet: func() *hivev1.SelectorSyncSet {
+				ss := testSelectorSyncSet()
+				ss.Spec.HealthCheck = &hivev1.SyncSetHealthCheck{
+					CustomRules: []hivev1.CustomHealthRule{{APIVersion: "example.com/v1", Healthy: "true"}},
+				}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test valid vault secret source create",
+			operation: admissionv1beta1.Create,
+			selectorSyncSet: func() *hivev1.SelectorSyncSet {
+				ss := testFileSecretSelectorSyncSet("")
+				ss.Spec.Secrets[0].ExternalSource = &hivev1.ExternalSecretSource{
+					Vault: &hivev1.VaultSecretSource{
+						Address:        "https://vault.example.com:8200",
+						Path:           "foo/bar",
+						TokenSecretRef: hivev1.SecretReference{Name: "vault-token", Namespace: "foo"},
+					},
+				}
+				return ss
+			}(),
+			expectedAllowed: true,
+		},
+		{
+			name:      "Test invalid vault secret source no token namespace",
+			operation: admissionv1beta1.Create,
+			selectorSyncSet: func() *hivev1.SelectorSyncSet {
+				ss := testFileSecretSelectorSyncSet("")
+				ss.Spec.Secrets[0].ExternalSource = &hivev1.ExternalSecretSource{
+					Vault: &hivev1.VaultSecretSource{
+						Address:        "https://vault.example.com:8200",
+						Path:           "foo/bar",
+						TokenSecretRef: hivev1.SecretReference{Name: "vault-token"},
+					},
+				}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
 		{
 			name:      "Test valid empty string resourceApplyMode create",
 			operation: admissionv1beta1.Create,
@@ -228,6 +303,100 @@ func TestSelectorSyncSetValidate(t *testing.T) {
 			}(),
 			expectedAllowed: false,
 		},
+		{
+			name:      "Test valid source create",
+			operation: admissionv1beta1.Create,
+			selectorSyncSet: func() *hivev1.SelectorSyncSet {
+				ss := testSelectorSyncSet()
+				ss.Spec.Source = &hivev1.SyncSetSource{
+					Git:                  &hivev1.GitSyncSetSource{URL: "https://example.com/repo.git"},
+					CredentialsSecretRef: &hivev1.SecretReference{Name: "git-creds", Namespace: "hive"},
+				}
+				return ss
+			}(),
+			expectedAllowed: true,
+		},
+		{
+			name:      "Test invalid source credentials without namespace update",
+			operation: admissionv1beta1.Update,
+			selectorSyncSet: func() *hivev1.SelectorSyncSet {
+				ss := testSelectorSyncSet()
+				ss.Spec.Source = &hivev1.SyncSetSource{
+					Git:                  &hivev1.GitSyncSetSource{URL: "https://example.com/repo.git"},
+					CredentialsSecretRef: &hivev1.SecretReference{Name: "git-creds"},
+				}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test valid kustomize from source create",
+			operation: admissionv1beta1.Create,
+			selectorSyncSet: func() *hivev1.SelectorSyncSet {
+				ss := testSelectorSyncSet()
+				ss.Spec.Source = &hivev1.SyncSetSource{
+					Git: &hivev1.GitSyncSetSource{URL: "https://example.com/repo.git"},
+				}
+				ss.Spec.Kustomize = &hivev1.KustomizeSyncSetRender{Path: "overlays/prod"}
+				return ss
+			}(),
+			expectedAllowed: true,
+		},
+		{
+			name:      "Test invalid helm chart without chart update",
+			operation: admissionv1beta1.Update,
+			selectorSyncSet: func() *hivev1.SelectorSyncSet {
+				ss := testSelectorSyncSet()
+				ss.Spec.HelmChart = &hivev1.HelmChartSyncSetRender{Repo: "https://charts.example.com"}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test valid rolloutStrategy create",
+			operation: admissionv1beta1.Create,
+			selectorSyncSet: func() *hivev1.SelectorSyncSet {
+				ss := testSelectorSyncSet()
+				ss.Spec.RolloutStrategy = &hivev1.SelectorSyncSetRolloutStrategy{
+					Canary: &hivev1.SelectorSyncSetCanary{
+						Percentage: 5,
+						Selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+					},
+					WaveInterval: &metav1.Duration{Duration: time.Hour},
+				}
+				return ss
+			}(),
+			expectedAllowed: true,
+		},
+		{
+			name:      "Test invalid rolloutStrategy canary selector update",
+			operation: admissionv1beta1.Update,
+			selectorSyncSet: func() *hivev1.SelectorSyncSet {
+				ss := testSelectorSyncSet()
+				ss.Spec.RolloutStrategy = &hivev1.SelectorSyncSetRolloutStrategy{
+					Canary: &hivev1.SelectorSyncSetCanary{
+						Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+							Key:      "canary",
+							Operator: "bogus",
+						}}},
+					},
+				}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid rolloutStrategy negative waveInterval create",
+			operation: admissionv1beta1.Create,
+			selectorSyncSet: func() *hivev1.SelectorSyncSet {
+				ss := testSelectorSyncSet()
+				ss.Spec.RolloutStrategy = &hivev1.SelectorSyncSetRolloutStrategy{
+					WaveInterval: &metav1.Duration{Duration: -time.Minute},
+				}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
 		{
 			name:            "Test invalid unmarshalable TypeMeta Resource create",
 			operation:       admissionv1beta1.Create,
@@ -435,6 +604,26 @@ func testSecretReferenceSelectorSyncSet() *hivev1.SelectorSyncSet {
 	return ss
 }
 
+func testFileSecretSelectorSyncSet(path string) *hivev1.SelectorSyncSet {
+	ss := testSelectorSyncSet()
+	ss.Spec = hivev1.SelectorSyncSetSpec{
+		SyncSetCommonSpec: hivev1.SyncSetCommonSpec{
+			Secrets: []hivev1.SecretMapping{
+				{
+					ExternalSource: &hivev1.ExternalSecretSource{
+						File: &hivev1.FileSecretSource{Path: path},
+					},
+					TargetRef: hivev1.SecretReference{
+						Name:      "foo",
+						Namespace: "foo",
+					},
+				},
+			},
+		},
+	}
+	return ss
+}
+
 func testSelectorSyncSet() *hivev1.SelectorSyncSet {
 	return &hivev1.SelectorSyncSet{
 		ObjectMeta: metav1.ObjectMeta{
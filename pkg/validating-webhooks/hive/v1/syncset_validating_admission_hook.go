@@ -3,6 +3,10 @@ package v1
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"text/template"
 
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -12,11 +16,16 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/yaml"
 
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
+	"github.com/openshift/hive/pkg/syncsethealth"
+	"github.com/openshift/hive/pkg/syncsetsource"
 )
 
 const (
@@ -193,9 +202,12 @@ func (a *SyncSetValidatingAdmissionHook) validateCreate(admissionSpec *admission
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateResources(newObject.Spec.Resources, field.NewPath("spec").Child("resources"))...)
 	allErrs = append(allErrs, validatePatches(newObject.Spec.Patches, field.NewPath("spec").Child("patches"))...)
-	allErrs = append(allErrs, validateSecrets(newObject.Spec.Secrets, field.NewPath("spec").Child("secretMappings"))...)
+	allErrs = append(allErrs, validateSecrets(newObject.Spec.Secrets, newObject.Namespace, field.NewPath("spec").Child("secretMappings"))...)
 	allErrs = append(allErrs, validateSourceSecretInSyncSetNamespace(newObject.Spec.Secrets, newObject.Namespace, field.NewPath("spec", "secretMappings"))...)
 	allErrs = append(allErrs, validateResourceApplyMode(newObject.Spec.ResourceApplyMode, field.NewPath("spec", "resourceApplyMode"))...)
+	allErrs = append(allErrs, validateSource(newObject.Spec.Source, newObject.Namespace, field.NewPath("spec", "source"))...)
+	allErrs = append(allErrs, validateRender(&newObject.Spec.SyncSetCommonSpec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateHealthCheck(newObject.Spec.HealthCheck, field.NewPath("spec", "healthCheck"))...)
 
 	if len(allErrs) > 0 {
 		statusError := errors.NewInvalid(newObject.GroupVersionKind().GroupKind(), newObject.Name, allErrs).Status()
@@ -241,9 +253,12 @@ func (a *SyncSetValidatingAdmissionHook) validateUpdate(admissionSpec *admission
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateResources(newObject.Spec.Resources, field.NewPath("spec", "resources"))...)
 	allErrs = append(allErrs, validatePatches(newObject.Spec.Patches, field.NewPath("spec", "patches"))...)
-	allErrs = append(allErrs, validateSecrets(newObject.Spec.Secrets, field.NewPath("spec", "secretMappings"))...)
+	allErrs = append(allErrs, validateSecrets(newObject.Spec.Secrets, newObject.Namespace, field.NewPath("spec", "secretMappings"))...)
 	allErrs = append(allErrs, validateSourceSecretInSyncSetNamespace(newObject.Spec.Secrets, newObject.Namespace, field.NewPath("spec", "secretMappings"))...)
 	allErrs = append(allErrs, validateResourceApplyMode(newObject.Spec.ResourceApplyMode, field.NewPath("spec", "resourceApplyMode"))...)
+	allErrs = append(allErrs, validateSource(newObject.Spec.Source, newObject.Namespace, field.NewPath("spec", "source"))...)
+	allErrs = append(allErrs, validateRender(&newObject.Spec.SyncSetCommonSpec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateHealthCheck(newObject.Spec.HealthCheck, field.NewPath("spec", "healthCheck"))...)
 
 	if len(allErrs) > 0 {
 		statusError := errors.NewInvalid(newObject.GroupVersionKind().GroupKind(), newObject.Name, allErrs).Status()
@@ -305,15 +320,73 @@ func validateResource(resource runtime.RawExtension, fldPath *field.Path) field.
 	return allErrs
 }
 
-func validateSecrets(secrets []hivev1.SecretMapping, fldPath *field.Path) field.ErrorList {
+// validateSecrets validates the SecretMappings of a SyncSet or SelectorSyncSet. The syncSetNS is empty for
+// SelectorSyncSets.
+func validateSecrets(secrets []hivev1.SecretMapping, syncSetNS string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for i, secret := range secrets {
-		allErrs = append(allErrs, validateSecretRef(secret.SourceRef, fldPath.Index(i).Child("sourceRef"))...)
+		switch {
+		case secret.ExternalSource == nil:
+			allErrs = append(allErrs, validateSecretRef(secret.SourceRef, fldPath.Index(i).Child("sourceRef"))...)
+		case secret.SourceRef != (hivev1.SecretReference{}):
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), "sourceRef, externalSource", "only one of sourceRef or externalSource may be set"))
+		default:
+			allErrs = append(allErrs, validateExternalSecretSource(secret.ExternalSource, syncSetNS, fldPath.Index(i).Child("externalSource"))...)
+		}
 		allErrs = append(allErrs, validateSecretRef(secret.TargetRef, fldPath.Index(i).Child("targetRef"))...)
 	}
 	return allErrs
 }
 
+func validateExternalSecretSource(source *hivev1.ExternalSecretSource, syncSetNS string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch {
+	case source.Vault == nil && source.File == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "one of vault or file is required"))
+	case source.Vault != nil && source.File != nil:
+		allErrs = append(allErrs, field.Invalid(fldPath, "vault, file", "only one of vault or file may be set"))
+	case source.Vault != nil:
+		vault, vaultPath := source.Vault, fldPath.Child("vault")
+		if u, err := url.Parse(vault.Address); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(vaultPath.Child("address"), vault.Address, "must be an http or https URL"))
+		}
+		if strings.Trim(vault.Path, "/") == "" {
+			allErrs = append(allErrs, field.Required(vaultPath.Child("path"), "Path is required"))
+		}
+		if vault.Version != nil {
+			if vault.KVVersion == 1 {
+				allErrs = append(allErrs, field.Invalid(vaultPath.Child("version"), *vault.Version, "version may only be set for version 2 of the KV secrets engine"))
+			} else if *vault.Version < 1 {
+				allErrs = append(allErrs, field.Invalid(vaultPath.Child("version"), *vault.Version, "must be positive"))
+			}
+		}
+		refPath := vaultPath.Child("tokenSecretRef")
+		ref := vault.TokenSecretRef
+		allErrs = append(allErrs, validateSecretRef(ref, refPath)...)
+		switch {
+		case syncSetNS == "" && ref.Namespace == "":
+			allErrs = append(allErrs, field.Required(refPath.Child("namespace"), "Namespace is required"))
+		case syncSetNS != "" && ref.Namespace != "" && ref.Namespace != syncSetNS:
+			allErrs = append(allErrs, field.Invalid(refPath.Child("namespace"), ref.Namespace,
+				"token secret reference must be in same namespace as SyncSet"))
+		}
+	case source.File != nil:
+		filePath := fldPath.Child("file", "path")
+		switch {
+		case syncSetNS != "":
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("file"), "file sources may only be used by SelectorSyncSets"))
+		case source.File.Path == "":
+			allErrs = append(allErrs, field.Required(filePath, "Path is required"))
+		case path.IsAbs(source.File.Path) || strings.HasPrefix(path.Clean(source.File.Path), ".."):
+			allErrs = append(allErrs, field.Invalid(filePath, source.File.Path, "must be a relative path within the secrets volume"))
+		}
+	}
+	if source.RefreshInterval != nil && source.RefreshInterval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("refreshInterval"), source.RefreshInterval.Duration.String(), "must be positive"))
+	}
+	return allErrs
+}
+
 func validateSourceSecretInSyncSetNamespace(secrets []hivev1.SecretMapping, syncSetNS string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for i, secret := range secrets {
@@ -334,3 +407,141 @@ func validateSecretRef(ref hivev1.SecretReference, fldPath *field.Path) field.Er
 	}
 	return allErrs
 }
+
+// validateSource validates the Source of a SyncSet or SelectorSyncSet. The syncSetNS is empty for SelectorSyncSets.
+func validateSource(source *hivev1.SyncSetSource, syncSetNS string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if source == nil {
+		return allErrs
+	}
+	switch {
+	case source.Git == nil && source.OCI == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "one of git or oci is required"))
+	case source.Git != nil && source.OCI != nil:
+		allErrs = append(allErrs, field.Invalid(fldPath, "git, oci", "only one of git or oci may be set"))
+	case source.Git != nil:
+		if source.Git.URL == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("git", "url"), "URL is required"))
+		} else if err := syncsetsource.ValidateGitURL(source.Git.URL); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("git", "url"), source.Git.URL, err.Error()))
+		}
+		if err := syncsetsource.ValidateGitRevision(source.Git.Revision); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("git", "revision"), source.Git.Revision, err.Error()))
+		}
+	case source.OCI != nil:
+		if source.OCI.Image == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("oci", "image"), "Image is required"))
+		}
+	}
+	if source.RefreshInterval != nil && source.RefreshInterval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("refreshInterval"), source.RefreshInterval.Duration.String(), "must be positive"))
+	}
+	if ref := source.CredentialsSecretRef; ref != nil {
+		refPath := fldPath.Child("credentialsSecretRef")
+		allErrs = append(allErrs, validateSecretRef(*ref, refPath)...)
+		switch {
+		case syncSetNS == "" && ref.Namespace == "":
+			allErrs = append(allErrs, field.Required(refPath.Child("namespace"), "Namespace is required"))
+		case syncSetNS != "" && ref.Namespace != "" && ref.Namespace != syncSetNS:
+			allErrs = append(allErrs, field.Invalid(refPath.Child("namespace"), ref.Namespace,
+				"credentials secret reference must be in same namespace as SyncSet"))
+		}
+	}
+	return allErrs
+}
+
+// validateRender validates the Kustomize overlay or Helm chart rendered by a SyncSet or SelectorSyncSet.
+func validateRender(spec *hivev1.SyncSetCommonSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.Kustomize != nil && spec.HelmChart != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, "kustomize, helmChart", "only one of kustomize or helmChart may be set"))
+	}
+	if kustomize := spec.Kustomize; kustomize != nil && spec.Source == nil && len(kustomize.Files) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("kustomize", "files"), "files are required when there is no source"))
+	}
+	if chart := spec.HelmChart; chart != nil {
+		chartPath := fldPath.Child("helmChart")
+		if chart.Chart == "" {
+			allErrs = append(allErrs, field.Required(chartPath.Child("chart"), "chart is required"))
+		}
+		if chart.Repo == "" && spec.Source == nil {
+			allErrs = append(allErrs, field.Required(chartPath.Child("repo"), "repo is required when there is no source"))
+		}
+		if chart.Repo != "" && spec.Source != nil {
+			allErrs = append(allErrs, field.Invalid(chartPath.Child("repo"), chart.Repo, "repo may not be set when there is a source"))
+		}
+		if chart.Repo == "" && chart.Version != "" {
+			allErrs = append(allErrs, field.Invalid(chartPath.Child("version"), chart.Version, "version may only be set with repo"))
+		}
+		if chart.Repo != "" {
+			if err := syncsetsource.ValidateHelmChartRepo(chart.Repo); err != nil {
+				allErrs = append(allErrs, field.Invalid(chartPath.Child("repo"), chart.Repo, err.Error()))
+			}
+			if err := syncsetsource.ValidateHelmChartName(chart.Chart); chart.Chart != "" && err != nil {
+				allErrs = append(allErrs, field.Invalid(chartPath.Child("chart"), chart.Chart, err.Error()))
+			}
+			if err := syncsetsource.ValidateHelmChartVersion(chart.Version); chart.Version != "" && err != nil {
+				allErrs = append(allErrs, field.Invalid(chartPath.Child("version"), chart.Version, err.Error()))
+			}
+		}
+		if chart.ReleaseName != "" {
+			if err := syncsetsource.ValidateHelmReleaseName(chart.ReleaseName); err != nil {
+				allErrs = append(allErrs, field.Invalid(chartPath.Child("releaseName"), chart.ReleaseName, err.Error()))
+			}
+		}
+		if chart.Namespace != "" {
+			for _, msg := range validation.IsDNS1123Label(chart.Namespace) {
+				allErrs = append(allErrs, field.Invalid(chartPath.Child("namespace"), chart.Namespace, msg))
+			}
+		}
+		if chart.TemplateValues {
+			if _, err := template.New("values").Parse(chart.Values); err != nil {
+				allErrs = append(allErrs, field.Invalid(chartPath.Child("values"), chart.Values, err.Error()))
+			}
+		} else if chart.Values != "" {
+			values := map[string]interface{}{}
+			if err := yaml.Unmarshal([]byte(chart.Values), &values); err != nil {
+				allErrs = append(allErrs, field.Invalid(chartPath.Child("values"), chart.Values, err.Error()))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateHealthCheck validates the custom rules used to assess the health of the resources of a SyncSet or
+// SelectorSyncSet.
+func validateHealthCheck(healthCheck *hivev1.SyncSetHealthCheck, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if healthCheck == nil {
+		return allErrs
+	}
+	kinds := sets.NewString()
+	for i, rule := range healthCheck.CustomRules {
+		rulePath := fldPath.Child("customRules").Index(i)
+		if rule.APIVersion == "" {
+			allErrs = append(allErrs, field.Required(rulePath.Child("apiVersion"), "apiVersion is required"))
+		} else if _, err := schema.ParseGroupVersion(rule.APIVersion); err != nil {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("apiVersion"), rule.APIVersion, err.Error()))
+		}
+		if rule.Kind == "" {
+			allErrs = append(allErrs, field.Required(rulePath.Child("kind"), "kind is required"))
+		}
+		gv, _ := schema.ParseGroupVersion(rule.APIVersion)
+		if gk := (schema.GroupKind{Group: gv.Group, Kind: rule.Kind}).String(); kinds.Has(gk) {
+			allErrs = append(allErrs, field.Duplicate(rulePath, gk))
+		} else {
+			kinds.Insert(gk)
+		}
+		if rule.Healthy == "" {
+			allErrs = append(allErrs, field.Required(rulePath.Child("healthy"), "healthy is required"))
+		} else if _, err := syncsethealth.Compile(rule.Healthy); err != nil {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("healthy"), rule.Healthy, err.Error()))
+		}
+		if rule.Degraded != "" {
+			if _, err := syncsethealth.Compile(rule.Degraded); err != nil {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("degraded"), rule.Degraded, err.Error()))
+			}
+		}
+	}
+	return allErrs
+}
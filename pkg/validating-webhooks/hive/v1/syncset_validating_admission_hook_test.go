@@ -162,6 +162,412 @@ func TestSyncSetValidate(t *testing.T) {
 			}(),
 			expectedAllowed: false,
 		},
+		{
+			name:            "Test valid vault secret source create",
+			operation:       admissionv1beta1.Create,
+			syncSet:         testVaultSecretSyncSet(),
+			expectedAllowed: true,
+		},
+		{
+			name:      "Test valid vault secret source with pinned version",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testVaultSecretSyncSet()
+				version := 3
+				ss.Spec.Secrets[0].ExternalSource.Vault.Version = &version
+				return ss
+			}(),
+			expectedAllowed: true,
+		},
+		{
+			name:      "Test invalid secret with both source ref and external source",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testVaultSecretSyncSet()
+				ss.Spec.Secrets[0].SourceRef = hivev1.SecretReference{Name: "foo"}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid vault secret source address",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testVaultSecretSyncSet()
+				ss.Spec.Secrets[0].ExternalSource.Vault.Address = "vault.example.com"
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid vault secret source version with kv version 1",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testVaultSecretSyncSet()
+				version := 3
+				ss.Spec.Secrets[0].ExternalSource.Vault.KVVersion = 1
+				ss.Spec.Secrets[0].ExternalSource.Vault.Version = &version
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid vault secret source token not in SyncSet namespace",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testVaultSecretSyncSet()
+				ss.Spec.Secrets[0].ExternalSource.Vault.TokenSecretRef.Namespace = "anotherns"
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid file secret source in SyncSet",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testVaultSecretSyncSet()
+				ss.Spec.Secrets[0].ExternalSource = &hivev1.ExternalSecretSource{
+					File: &hivev1.FileSecretSource{Path: "foo"},
+				}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test valid health check",
+			operation: admissionv1beta1.Create,
+			syncSet: testHealthCheckSyncSet(hivev1.CustomHealthRule{
+				APIVersion: "example.com/v1",
+				Kind:       "Widget",
+				Healthy:    `has(object.status) && has(object.status.phase) && object.status.phase == "Ready"`,
+				Degraded:   `has(object.status) && has(object.status.phase) && object.status.phase == "Error"`,
+			}),
+			expectedAllowed: true,
+		},
+		{
+			name:      "Test invalid health check missing healthy expression",
+			operation: admissionv1beta1.Create,
+			syncSet: testHealthCheckSyncSet(hivev1.CustomHealthRule{
+				APIVersion: "example.com/v1",
+				Kind:       "Widget",
+			}),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid health check expression",
+			operation: admissionv1beta1.Update,
+			syncSet: testHealthCheckSyncSet(hivev1.CustomHealthRule{
+				APIVersion: "example.com/v1",
+				Kind:       "Widget",
+				Healthy:    `object.status.phase ==`,
+			}),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid health check expression not a bool",
+			operation: admissionv1beta1.Create,
+			syncSet: testHealthCheckSyncSet(hivev1.CustomHealthRule{
+				APIVersion: "example.com/v1",
+				Kind:       "Widget",
+				Healthy:    `"Ready"`,
+			}),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid health check duplicate kind",
+			operation: admissionv1beta1.Create,
+			syncSet: testHealthCheckSyncSet(
+				hivev1.CustomHealthRule{APIVersion: "example.com/v1", Kind: "Widget", Healthy: "true"},
+				hivev1.CustomHealthRule{APIVersion: "example.com/v2", Kind: "Widget", Healthy: "true"},
+			),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test valid git source create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.Source = &hivev1.SyncSetSource{
+					Git:                  &hivev1.GitSyncSetSource{URL: "https://example.com/repo.git", Revision: "main"},
+					Path:                 "manifests",
+					CredentialsSecretRef: &hivev1.SecretReference{Name: "git-creds"},
+				}
+				return ss
+			}(),
+			expectedAllowed: true,
+		},
+		{
+			name:      "Test valid oci source update",
+			operation: admissionv1beta1.Update,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.Source = &hivev1.SyncSetSource{
+					OCI: &hivev1.OCISyncSetSource{Image: "quay.io/example/manifests:v1"},
+				}
+				return ss
+			}(),
+			expectedAllowed: true,
+		},
+		{
+			name:      "Test invalid git source with option as url create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.Source = &hivev1.SyncSetSource{
+					Git: &hivev1.GitSyncSetSource{URL: "--upload-pack=touch /tmp/pwned"},
+				}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid git source with option as revision create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.Source = &hivev1.SyncSetSource{
+					Git: &hivev1.GitSyncSetSource{URL: "https://example.com/repo.git", Revision: "--upload-pack=id"},
+				}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid git source with ext transport create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.Source = &hivev1.SyncSetSource{
+					Git: &hivev1.GitSyncSetSource{URL: "ext::sh -c id"},
+				}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid source with git and oci create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.Source = &hivev1.SyncSetSource{
+					Git: &hivev1.GitSyncSetSource{URL: "https://example.com/repo.git"},
+					OCI: &hivev1.OCISyncSetSource{Image: "quay.io/example/manifests:v1"},
+				}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid empty source create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.Source = &hivev1.SyncSetSource{}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid git source without url update",
+			operation: admissionv1beta1.Update,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.Source = &hivev1.SyncSetSource{
+					Git: &hivev1.GitSyncSetSource{Revision: "main"},
+				}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid source credentials not in SyncSet namespace",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.Source = &hivev1.SyncSetSource{
+					OCI:                  &hivev1.OCISyncSetSource{Image: "quay.io/example/manifests:v1"},
+					CredentialsSecretRef: &hivev1.SecretReference{Name: "creds", Namespace: "anotherns"},
+				}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test valid kustomize create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.Kustomize = &hivev1.KustomizeSyncSetRender{
+					Files: map[string]string{"kustomization.yaml": "resources:\n- cm.yaml\n"},
+				}
+				return ss
+			}(),
+			expectedAllowed: true,
+		},
+		{
+			name:      "Test invalid kustomize without files or source create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.Kustomize = &hivev1.KustomizeSyncSetRender{Path: "overlays/prod"}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test valid helm chart with templated values create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.HelmChart = &hivev1.HelmChartSyncSetRender{
+					Chart:          "nginx",
+					Repo:           "https://charts.example.com",
+					Version:        "1.2.3",
+					Values:         "region: {{ index .ClusterDeployment.Labels \"region\" }}\n",
+					TemplateValues: true,
+				}
+				return ss
+			}(),
+			expectedAllowed: true,
+		},
+		{
+			name:      "Test valid helm chart from source update",
+			operation: admissionv1beta1.Update,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.Source = &hivev1.SyncSetSource{
+					Git: &hivev1.GitSyncSetSource{URL: "https://example.com/repo.git"},
+				}
+				ss.Spec.HelmChart = &hivev1.HelmChartSyncSetRender{Chart: "charts/nginx"}
+				return ss
+			}(),
+			expectedAllowed: true,
+		},
+		{
+			name:      "Test invalid helm chart without repo or source create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.HelmChart = &hivev1.HelmChartSyncSetRender{Chart: "nginx"}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid helm chart values template create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.HelmChart = &hivev1.HelmChartSyncSetRender{
+					Chart:          "nginx",
+					Repo:           "https://charts.example.com",
+					Values:         "region: {{ .ClusterDeployment.Labels.region\n",
+					TemplateValues: true,
+				}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid helm chart values update",
+			operation: admissionv1beta1.Update,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.HelmChart = &hivev1.HelmChartSyncSetRender{
+					Chart:  "nginx",
+					Repo:   "https://charts.example.com",
+					Values: "- not\n- a map\n",
+				}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid helm chart repo scheme create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.HelmChart = &hivev1.HelmChartSyncSetRender{Chart: "nginx", Repo: "file:///etc/charts"}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid helm chart repo over plain http create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.HelmChart = &hivev1.HelmChartSyncSetRender{Chart: "nginx", Repo: "http://charts.example.com"}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid helm chart repo with source create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.Source = &hivev1.SyncSetSource{
+					Git: &hivev1.GitSyncSetSource{URL: "https://example.com/repo.git"},
+				}
+				ss.Spec.HelmChart = &hivev1.HelmChartSyncSetRender{Chart: "nginx", Repo: "https://charts.example.com"}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid helm chart name create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.HelmChart = &hivev1.HelmChartSyncSetRender{Chart: "/etc/charts/nginx", Repo: "https://charts.example.com"}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid helm chart version create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.HelmChart = &hivev1.HelmChartSyncSetRender{Chart: "nginx", Repo: "https://charts.example.com", Version: "--devel"}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid helm chart release name create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.HelmChart = &hivev1.HelmChartSyncSetRender{Chart: "nginx", Repo: "https://charts.example.com", ReleaseName: "--debug"}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid helm chart namespace create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.HelmChart = &hivev1.HelmChartSyncSetRender{Chart: "nginx", Repo: "https://charts.example.com", Namespace: "-n"}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
+		{
+			name:      "Test invalid kustomize and helm chart create",
+			operation: admissionv1beta1.Create,
+			syncSet: func() *hivev1.SyncSet {
+				ss := testSyncSet()
+				ss.Spec.Kustomize = &hivev1.KustomizeSyncSetRender{
+					Files: map[string]string{"kustomization.yaml": "resources: []\n"},
+				}
+				ss.Spec.HelmChart = &hivev1.HelmChartSyncSetRender{Chart: "nginx", Repo: "https://charts.example.com"}
+				return ss
+			}(),
+			expectedAllowed: false,
+		},
 		{
 			name:      "Test valid empty string resourceApplyMode create",
 			operation: admissionv1beta1.Create,
@@ -455,6 +861,30 @@ func testSecretReferenceSyncSet() *hivev1.SyncSet {
 	return ss
 }
 
+func testVaultSecretSyncSet() *hivev1.SyncSet {
+	ss := testSyncSet()
+	ss.Spec = hivev1.SyncSetSpec{
+		SyncSetCommonSpec: hivev1.SyncSetCommonSpec{
+			Secrets: []hivev1.SecretMapping{
+				{
+					ExternalSource: &hivev1.ExternalSecretSource{
+						Vault: &hivev1.VaultSecretSource{
+							Address:        "https://vault.example.com:8200",
+							Path:           "foo/bar",
+							TokenSecretRef: hivev1.SecretReference{Name: "vault-token"},
+						},
+					},
+					TargetRef: hivev1.SecretReference{
+						Name:      "foo",
+						Namespace: "foo",
+					},
+				},
+			},
+		},
+	}
+	return ss
+}
+
 func testSyncSet() *hivev1.SyncSet {
 	return &hivev1.SyncSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -464,6 +894,12 @@ func testSyncSet() *hivev1.SyncSet {
 	}
 }
 
+func testHealthCheckSyncSet(rules ...hivev1.CustomHealthRule) *hivev1.SyncSet {
+	ss := testSyncSet()
+	ss.Spec.HealthCheck = &hivev1.SyncSetHealthCheck{CustomRules: rules}
+	return ss
+}
+
 func testSyncSetWithResources(resources ...string) *hivev1.SyncSet {
 	ss := testSyncSet()
 	for _, resource := range resources {